@@ -0,0 +1,145 @@
+package commands
+
+import (
+	"context"
+	"crypto_vault_service/internal/infrastructure/logger"
+	"crypto_vault_service/internal/infrastructure/settings"
+	"encoding/json"
+	"fmt"
+	"io"
+	"log"
+	"net/http"
+	"os"
+	"strings"
+	"time"
+
+	"github.com/spf13/cobra"
+)
+
+// masterKeyRotation mirrors the master key rotation response of the crypto vault REST API
+type masterKeyRotation struct {
+	ID               string `json:"id"`
+	TargetKEKVersion uint32 `json:"targetKEKVersion"`
+	Status           string `json:"status"`
+	ProcessedKeys    int    `json:"processedKeys"`
+	RewrappedKeys    int    `json:"rewrappedKeys"`
+	Error            string `json:"error"`
+}
+
+// AdminCommandHandler encapsulates logic for handling administrative operations against a running crypto vault service via CLI.
+type AdminCommandHandler struct {
+	httpClient *http.Client
+	Logger     logger.Logger
+}
+
+// NewAdminCommandHandler initializes and returns an AdminCommandHandler instance with
+// configured logger and HTTP client.
+func NewAdminCommandHandler() *AdminCommandHandler {
+	loggerSettings := &settings.LoggerSettings{
+		LogLevel: "info",
+		LogType:  "console",
+		FilePath: "",
+	}
+
+	logger, err := logger.GetLogger(loggerSettings)
+	if err != nil {
+		log.Panicf("Error creating logger: %v", err)
+		return nil
+	}
+
+	return &AdminCommandHandler{
+		httpClient: &http.Client{Timeout: 30 * time.Second},
+		Logger:     logger,
+	}
+}
+
+// RotateMasterKeyCmd starts a master key rotation and optionally waits until all stored keys are re-wrapped
+func (commandHandler *AdminCommandHandler) RotateMasterKeyCmd(cmd *cobra.Command, _ []string) {
+	endpoint, _ := cmd.Flags().GetString("endpoint")
+	wait, _ := cmd.Flags().GetBool("wait")
+	pollInterval, _ := cmd.Flags().GetDuration("poll-interval")
+	token, _ := cmd.Flags().GetString("token")
+	if token == "" {
+		token = os.Getenv("CRYPTO_VAULT_TOKEN")
+	}
+
+	rotationsURL := strings.TrimRight(endpoint, "/") + "/master-key/rotations"
+
+	rotation, err := commandHandler.requestMasterKeyRotation(cmd.Context(), http.MethodPost, rotationsURL, token, http.StatusAccepted)
+	if err != nil {
+		commandHandler.Logger.Error(fmt.Sprintf("%v", err))
+		return
+	}
+	commandHandler.Logger.Info(fmt.Sprintf("Started master key rotation %s to version %d", rotation.ID, rotation.TargetKEKVersion))
+
+	for wait && rotation.Status == "running" {
+		time.Sleep(pollInterval)
+
+		rotation, err = commandHandler.requestMasterKeyRotation(cmd.Context(), http.MethodGet, rotationsURL+"/"+rotation.ID, token, http.StatusOK)
+		if err != nil {
+			commandHandler.Logger.Error(fmt.Sprintf("%v", err))
+			return
+		}
+		commandHandler.Logger.Info(fmt.Sprintf("Master key rotation %s: %d keys processed, %d re-wrapped", rotation.ID, rotation.ProcessedKeys, rotation.RewrappedKeys))
+	}
+
+	switch rotation.Status {
+	case "failed":
+		commandHandler.Logger.Error(fmt.Sprintf("Master key rotation %s failed: %s", rotation.ID, rotation.Error))
+	case "completed":
+		commandHandler.Logger.Info(fmt.Sprintf("Master key rotation %s completed, re-wrapped %d keys", rotation.ID, rotation.RewrappedKeys))
+	}
+}
+
+// requestMasterKeyRotation sends a request authenticated by the bearer token to the master key rotation API and decodes the response
+func (commandHandler *AdminCommandHandler) requestMasterKeyRotation(ctx context.Context, method, url, token string, expectedStatus int) (*masterKeyRotation, error) {
+	req, err := http.NewRequestWithContext(ctx, method, url, nil)
+	if err != nil {
+		return nil, fmt.Errorf("failed to create request: %w", err)
+	}
+	if token != "" {
+		req.Header.Set("Authorization", "Bearer "+token)
+	}
+
+	resp, err := commandHandler.httpClient.Do(req)
+	if err != nil {
+		return nil, fmt.Errorf("request to %s failed: %w", url, err)
+	}
+	defer func() {
+		if err := resp.Body.Close(); err != nil {
+			commandHandler.Logger.Warn(fmt.Sprintf("failed to close response body: %v", err))
+		}
+	}()
+
+	body, err := io.ReadAll(resp.Body)
+	if err != nil {
+		return nil, fmt.Errorf("failed to read response body: %w", err)
+	}
+
+	if resp.StatusCode != expectedStatus {
+		return nil, fmt.Errorf("unexpected status %d from %s: %s", resp.StatusCode, url, string(body))
+	}
+
+	var rotation masterKeyRotation
+	if err := json.Unmarshal(body, &rotation); err != nil {
+		return nil, fmt.Errorf("failed to decode master key rotation: %w", err)
+	}
+
+	return &rotation, nil
+}
+
+// InitAdminCommands initializes all the administrative commands
+func InitAdminCommands(rootCmd *cobra.Command) {
+	handler := NewAdminCommandHandler()
+
+	var rotateMasterKeyCmd = &cobra.Command{
+		Use:   "rotate-master-key",
+		Short: "Rotate the master key of a crypto vault service and re-wrap all stored keys",
+		Run:   handler.RotateMasterKeyCmd,
+	}
+	rotateMasterKeyCmd.Flags().String("endpoint", "http://localhost:8080/api/v1/cvs", "Base URL of the crypto vault REST API")
+	rotateMasterKeyCmd.Flags().Bool("wait", false, "Wait until all stored keys are re-wrapped")
+	rotateMasterKeyCmd.Flags().Duration("poll-interval", 2*time.Second, "Interval for polling the rotation progress when waiting")
+	rotateMasterKeyCmd.Flags().String("token", "", "JWT of an admin authenticating the requests. Defaults to the CRYPTO_VAULT_TOKEN environment variable")
+	rootCmd.AddCommand(rotateMasterKeyCmd)
+}
@@ -73,6 +73,8 @@ func (commandHandler *AESCommandHandler) EncryptAESCmd(cmd *cobra.Command, _ []s
 	inputFilePath, _ := cmd.Flags().GetString("input-file")
 	outputFilePath, _ := cmd.Flags().GetString("output-file")
 	symmetricKey, _ := cmd.Flags().GetString("symmetric-key")
+	mode, _ := cmd.Flags().GetString("mode")
+	armor, _ := cmd.Flags().GetBool("armor")
 
 	plainText, err := os.ReadFile(filepath.Clean(inputFilePath))
 	if err != nil {
@@ -86,12 +88,30 @@ func (commandHandler *AESCommandHandler) EncryptAESCmd(cmd *cobra.Command, _ []s
 		return
 	}
 
-	encryptedData, err := commandHandler.aesProcessor.Encrypt(plainText, key)
+	keyID := keyIDFromPath(symmetricKey)
+
+	var encryptedData []byte
+	switch mode {
+	case cryptography.AESModeGCM:
+		encryptedData, err = commandHandler.aesProcessor.Encrypt(plainText, key, keyID, 0, nil)
+	case cryptography.AESModeCBC:
+		encryptedData, err = commandHandler.aesProcessor.EncryptCBC(plainText, key, keyID, 0)
+	default:
+		err = fmt.Errorf("unsupported AES mode: %s", mode)
+	}
 	if err != nil {
 		commandHandler.Logger.Error(fmt.Sprintf("%v", err))
 		return
 	}
 
+	if armor {
+		encryptedData, err = armorEnvelope(encryptedData)
+		if err != nil {
+			commandHandler.Logger.Error(fmt.Sprintf("%v", err))
+			return
+		}
+	}
+
 	err = os.WriteFile(outputFilePath, encryptedData, 0600)
 	if err != nil {
 		commandHandler.Logger.Error(fmt.Sprintf("%v", err))
@@ -106,20 +126,34 @@ func (commandHandler *AESCommandHandler) DecryptAESCmd(cmd *cobra.Command, _ []s
 	inputFilePath, _ := cmd.Flags().GetString("input-file")
 	outputFilePath, _ := cmd.Flags().GetString("output-file")
 	symmetricKey, _ := cmd.Flags().GetString("symmetric-key")
+	keyDir, _ := cmd.Flags().GetString("key-dir")
+	mode, _ := cmd.Flags().GetString("mode")
 
-	key, err := os.ReadFile(filepath.Clean(symmetricKey))
+	encryptedData, err := os.ReadFile(filepath.Clean(inputFilePath))
 	if err != nil {
 		commandHandler.Logger.Error(fmt.Sprintf("%v", err))
 		return
 	}
 
-	encryptedData, err := os.ReadFile(filepath.Clean(inputFilePath))
+	symmetricKey, err = resolveDecryptionKeyPath(encryptedData, symmetricKey, keyDir, "-symmetric-key.bin")
 	if err != nil {
 		commandHandler.Logger.Error(fmt.Sprintf("%v", err))
 		return
 	}
 
-	decryptedData, err := commandHandler.aesProcessor.Decrypt(encryptedData, key)
+	key, err := os.ReadFile(filepath.Clean(symmetricKey))
+	if err != nil {
+		commandHandler.Logger.Error(fmt.Sprintf("%v", err))
+		return
+	}
+
+	// Refuse ciphertexts of another mode to prevent downgrades to unauthenticated AES-CBC
+	if detectedMode := commandHandler.aesProcessor.DetectMode(encryptedData); detectedMode != mode {
+		commandHandler.Logger.Error(fmt.Sprintf("input file is encrypted with AES-%s, but AES-%s was requested", detectedMode, mode))
+		return
+	}
+
+	decryptedData, err := commandHandler.aesProcessor.Decrypt(encryptedData, key, nil)
 	if err != nil {
 		commandHandler.Logger.Error(fmt.Sprintf("%v", err))
 		return
@@ -155,6 +189,8 @@ func InitAESCommands(rootCmd *cobra.Command) {
 	encryptAESFileCmd.Flags().StringP("input-file", "", "", "Path to input file that needs to be encrypted")
 	encryptAESFileCmd.Flags().StringP("output-file", "", "", "Path to encrypted output file")
 	encryptAESFileCmd.Flags().StringP("symmetric-key", "", "", "Path to the symmetric key")
+	encryptAESFileCmd.Flags().StringP("mode", "", cryptography.AESModeGCM, "AES mode (GCM or CBC). CBC is unauthenticated and only meant for interoperability")
+	encryptAESFileCmd.Flags().BoolP("armor", "", false, "Write the encrypted envelope ASCII-armored instead of binary")
 	rootCmd.AddCommand(encryptAESFileCmd)
 
 	var decryptAESFileCmd = &cobra.Command{
@@ -164,6 +200,8 @@ func InitAESCommands(rootCmd *cobra.Command) {
 	}
 	decryptAESFileCmd.Flags().StringP("input-file", "", "", "Input encrypted file path")
 	decryptAESFileCmd.Flags().StringP("output-file", "", "", "Path to decrypted output file")
-	decryptAESFileCmd.Flags().StringP("symmetric-key", "", "", "Path to the symmetric key")
+	decryptAESFileCmd.Flags().StringP("symmetric-key", "", "", "Path to the symmetric key. If omitted, the key named by the input file is looked up in the key directory")
+	decryptAESFileCmd.Flags().StringP("key-dir", "", "", "Directory to look up the symmetric key named by the input file")
+	decryptAESFileCmd.Flags().StringP("mode", "", cryptography.AESModeGCM, "AES mode (GCM or CBC) the input file is expected to be encrypted with. Use CBC for files encrypted by earlier versions")
 	rootCmd.AddCommand(decryptAESFileCmd)
 }
@@ -1,2 +1,2 @@
-// Package commands encapsulates logic for handling AES, RSA, EC and PKCS#11 operations
+// Package commands encapsulates logic for handling AES, RSA, EC and PKCS#11 operations as well as administrative operations against the crypto vault service
 package commands
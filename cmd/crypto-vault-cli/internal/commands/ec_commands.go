@@ -103,7 +103,7 @@ func (commandHandler *ECCommandHandler) SignECCCmd(cmd *cobra.Command, _ []strin
 		return
 	}
 
-	privateKey, err := commandHandler.ecProcessor.ReadPrivateKey(privateKeyFilePath, elliptic.P256())
+	privateKey, err := commandHandler.ecProcessor.ReadPrivateKey(privateKeyFilePath)
 	if err != nil {
 		commandHandler.Logger.Error(fmt.Sprintf("%v", err))
 		return
@@ -130,7 +130,7 @@ func (commandHandler *ECCommandHandler) VerifyECCCmd(cmd *cobra.Command, _ []str
 	publicKeyPath, _ := cmd.Flags().GetString("public-key")
 	signatureFile, _ := cmd.Flags().GetString("signature-file")
 
-	publicKey, err := commandHandler.ecProcessor.ReadPublicKey(publicKeyPath, elliptic.P256())
+	publicKey, err := commandHandler.ecProcessor.ReadPublicKey(publicKeyPath)
 	if err != nil {
 		commandHandler.Logger.Error(fmt.Sprintf("%v", err))
 		return
@@ -0,0 +1,71 @@
+package commands
+
+import (
+	"crypto_vault_service/internal/infrastructure/cryptography"
+	"fmt"
+	"path/filepath"
+	"strings"
+)
+
+// keyFileSuffixes are the file name suffixes of keys generated by the cli, preceded by the key ID
+var keyFileSuffixes = []string{"-symmetric-key.bin", "-private-key.pem", "-public-key.pem"}
+
+// keyIDFromPath derives the key ID recorded in envelopes from the file name of a key.
+// For generated keys this is the UUID shared by the key files, otherwise the file name without extension.
+func keyIDFromPath(keyPath string) string {
+	name := filepath.Base(keyPath)
+	for _, suffix := range keyFileSuffixes {
+		if strings.HasSuffix(name, suffix) {
+			return strings.TrimSuffix(name, suffix)
+		}
+	}
+	return strings.TrimSuffix(name, filepath.Ext(name))
+}
+
+// armorEnvelope converts a binary envelope into its ASCII-armored form
+func armorEnvelope(ciphertext []byte) ([]byte, error) {
+	envelope, err := cryptography.ParseEnvelope(ciphertext)
+	if err != nil {
+		return nil, fmt.Errorf("%w", err)
+	}
+
+	armored, err := envelope.Armor()
+	if err != nil {
+		return nil, fmt.Errorf("%w", err)
+	}
+
+	return armored, nil
+}
+
+// resolveDecryptionKeyPath determines the key file for decrypting the given ciphertext.
+// Without an explicit key path, the key named by the envelope is looked up in keyDir with the given file suffix.
+// An explicit key path is rejected early if it does not match the key named by the envelope.
+func resolveDecryptionKeyPath(ciphertext []byte, keyPath, keyDir, keyFileSuffix string) (string, error) {
+	if !cryptography.IsEnvelope(ciphertext) {
+		if keyPath == "" {
+			return "", fmt.Errorf("input file does not name its key, a key file must be provided")
+		}
+		return keyPath, nil
+	}
+
+	envelope, err := cryptography.ParseEnvelope(ciphertext)
+	if err != nil {
+		return "", fmt.Errorf("%w", err)
+	}
+
+	if keyPath == "" {
+		if keyDir == "" {
+			return "", fmt.Errorf("either a key file or a key directory must be provided")
+		}
+		if envelope.KeyID == "" || strings.ContainsAny(envelope.KeyID, `/\`) || envelope.KeyID == ".." {
+			return "", fmt.Errorf("input file names invalid key ID '%s'", envelope.KeyID)
+		}
+		return filepath.Join(keyDir, envelope.KeyID+keyFileSuffix), nil
+	}
+
+	if keyID := keyIDFromPath(keyPath); keyID != envelope.KeyID {
+		return "", fmt.Errorf("input file is encrypted with key %s, but key %s was provided", envelope.KeyID, keyID)
+	}
+
+	return keyPath, nil
+}
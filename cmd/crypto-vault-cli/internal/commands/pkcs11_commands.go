@@ -8,6 +8,7 @@ import (
 	"fmt"
 	"log"
 	"os"
+	"path/filepath"
 
 	"github.com/spf13/cobra"
 )
@@ -166,10 +167,31 @@ func (commandHandler *PKCS11CommandsHandler) EncryptCmd(cmd *cobra.Command, _ []
 	outputFilePath, _ := cmd.Flags().GetString("output-file")
 	keyType, _ := cmd.Flags().GetString("key-type")
 
-	if err := commandHandler.pkcs11Handler.Encrypt(tokenLabel, objectLabel, inputFilePath, outputFilePath, keyType); err != nil {
+	mode, _ := cmd.Flags().GetString("mode")
+
+	plaintext, err := os.ReadFile(filepath.Clean(inputFilePath))
+	if err != nil {
+		commandHandler.Logger.Error(fmt.Sprintf("%v", err))
+		return
+	}
+
+	var ciphertext []byte
+	if keyType == "AES" {
+		ciphertext, err = commandHandler.pkcs11Handler.EncryptAES(tokenLabel, objectLabel, plaintext, nil, mode)
+	} else {
+		ciphertext, err = commandHandler.pkcs11Handler.Encrypt(tokenLabel, objectLabel, plaintext, keyType)
+	}
+	if err != nil {
+		commandHandler.Logger.Error(fmt.Sprintf("%v", err))
+		return
+	}
+
+	if err := os.WriteFile(outputFilePath, ciphertext, 0600); err != nil {
 		commandHandler.Logger.Error(fmt.Sprintf("%v", err))
 		return
 	}
+
+	commandHandler.Logger.Info(fmt.Sprintf("Encrypted data path %s", outputFilePath))
 }
 
 // DecryptCmd decrypts data using the PKCS#11 token
@@ -180,10 +202,87 @@ func (commandHandler *PKCS11CommandsHandler) DecryptCmd(cmd *cobra.Command, _ []
 	outputFilePath, _ := cmd.Flags().GetString("output-file")
 	keyType, _ := cmd.Flags().GetString("key-type")
 
-	if err := commandHandler.pkcs11Handler.Decrypt(tokenLabel, objectLabel, inputFilePath, outputFilePath, keyType); err != nil {
+	mode, _ := cmd.Flags().GetString("mode")
+
+	ciphertext, err := os.ReadFile(filepath.Clean(inputFilePath))
+	if err != nil {
+		commandHandler.Logger.Error(fmt.Sprintf("%v", err))
+		return
+	}
+
+	var plaintext []byte
+	if keyType == "AES" {
+		plaintext, err = commandHandler.pkcs11Handler.DecryptAES(tokenLabel, objectLabel, ciphertext, nil, mode)
+	} else {
+		plaintext, err = commandHandler.pkcs11Handler.Decrypt(tokenLabel, objectLabel, ciphertext, keyType)
+	}
+	if err != nil {
+		commandHandler.Logger.Error(fmt.Sprintf("%v", err))
+		return
+	}
+
+	if err := os.WriteFile(outputFilePath, plaintext, 0600); err != nil {
+		commandHandler.Logger.Error(fmt.Sprintf("%v", err))
+		return
+	}
+
+	commandHandler.Logger.Info(fmt.Sprintf("Decrypted data path %s", outputFilePath))
+}
+
+// WrapKeyCmd wraps key material using the PKCS#11 token
+func (commandHandler *PKCS11CommandsHandler) WrapKeyCmd(cmd *cobra.Command, _ []string) {
+	tokenLabel, _ := cmd.Flags().GetString("token-label")
+	objectLabel, _ := cmd.Flags().GetString("object-label")
+	inputFilePath, _ := cmd.Flags().GetString("input-file")
+	outputFilePath, _ := cmd.Flags().GetString("output-file")
+	keyType, _ := cmd.Flags().GetString("key-type")
+
+	plainKey, err := os.ReadFile(filepath.Clean(inputFilePath))
+	if err != nil {
+		commandHandler.Logger.Error(fmt.Sprintf("%v", err))
+		return
+	}
+
+	wrappedKey, err := commandHandler.pkcs11Handler.WrapKey(tokenLabel, objectLabel, plainKey, keyType)
+	if err != nil {
+		commandHandler.Logger.Error(fmt.Sprintf("%v", err))
+		return
+	}
+
+	if err := os.WriteFile(outputFilePath, wrappedKey, 0600); err != nil {
+		commandHandler.Logger.Error(fmt.Sprintf("%v", err))
+		return
+	}
+
+	commandHandler.Logger.Info(fmt.Sprintf("Wrapped key path %s", outputFilePath))
+}
+
+// UnwrapKeyCmd unwraps key material using the PKCS#11 token
+func (commandHandler *PKCS11CommandsHandler) UnwrapKeyCmd(cmd *cobra.Command, _ []string) {
+	tokenLabel, _ := cmd.Flags().GetString("token-label")
+	objectLabel, _ := cmd.Flags().GetString("object-label")
+	inputFilePath, _ := cmd.Flags().GetString("input-file")
+	outputFilePath, _ := cmd.Flags().GetString("output-file")
+	keyType, _ := cmd.Flags().GetString("key-type")
+
+	wrappedKey, err := os.ReadFile(filepath.Clean(inputFilePath))
+	if err != nil {
+		commandHandler.Logger.Error(fmt.Sprintf("%v", err))
+		return
+	}
+
+	plainKey, err := commandHandler.pkcs11Handler.UnwrapKey(tokenLabel, objectLabel, wrappedKey, keyType)
+	if err != nil {
 		commandHandler.Logger.Error(fmt.Sprintf("%v", err))
 		return
 	}
+
+	if err := os.WriteFile(outputFilePath, plainKey, 0600); err != nil {
+		commandHandler.Logger.Error(fmt.Sprintf("%v", err))
+		return
+	}
+
+	commandHandler.Logger.Info(fmt.Sprintf("Unwrapped key path %s", outputFilePath))
 }
 
 // SignCmd signs data using the PKCS#11 token
@@ -194,10 +293,24 @@ func (commandHandler *PKCS11CommandsHandler) SignCmd(cmd *cobra.Command, _ []str
 	signatureFilePath, _ := cmd.Flags().GetString("signature-file")
 	keyType, _ := cmd.Flags().GetString("key-type")
 
-	if err := commandHandler.pkcs11Handler.Sign(tokenLabel, objectLabel, dataFilePath, signatureFilePath, keyType); err != nil {
+	data, err := os.ReadFile(filepath.Clean(dataFilePath))
+	if err != nil {
 		commandHandler.Logger.Error(fmt.Sprintf("%v", err))
 		return
 	}
+
+	signature, err := commandHandler.pkcs11Handler.Sign(tokenLabel, objectLabel, data, keyType)
+	if err != nil {
+		commandHandler.Logger.Error(fmt.Sprintf("%v", err))
+		return
+	}
+
+	if err := os.WriteFile(signatureFilePath, signature, 0600); err != nil {
+		commandHandler.Logger.Error(fmt.Sprintf("%v", err))
+		return
+	}
+
+	commandHandler.Logger.Info(fmt.Sprintf("Signature file path %s", signatureFilePath))
 }
 
 // VerifyCmd verifies the signature using the PKCS#11 token
@@ -208,7 +321,19 @@ func (commandHandler *PKCS11CommandsHandler) VerifyCmd(cmd *cobra.Command, _ []s
 	signatureFilePath, _ := cmd.Flags().GetString("signature-file")
 	keyType, _ := cmd.Flags().GetString("key-type")
 
-	if _, err := commandHandler.pkcs11Handler.Verify(tokenLabel, objectLabel, dataFilePath, signatureFilePath, keyType); err != nil {
+	data, err := os.ReadFile(filepath.Clean(dataFilePath))
+	if err != nil {
+		commandHandler.Logger.Error(fmt.Sprintf("%v", err))
+		return
+	}
+
+	signature, err := os.ReadFile(filepath.Clean(signatureFilePath))
+	if err != nil {
+		commandHandler.Logger.Error(fmt.Sprintf("%v", err))
+		return
+	}
+
+	if _, err := commandHandler.pkcs11Handler.Verify(tokenLabel, objectLabel, data, signature, keyType); err != nil {
 		commandHandler.Logger.Error(fmt.Sprintf("%v", err))
 		return
 	}
@@ -243,13 +368,13 @@ func InitPKCS11Commands(rootCmd *cobra.Command) {
 
 	var pkcs11AddKeyCmd = &cobra.Command{
 		Use:   "add-key",
-		Short: "Add key (ECDSA or RSA) to the PKCS#11 token",
+		Short: "Add key (ECDSA, RSA or AES) to the PKCS#11 token",
 		Run:   handler.AddKeyCmd,
 	}
 	pkcs11AddKeyCmd.Flags().String("token-label", "", "Label of the PKCS#11 token")
 	pkcs11AddKeyCmd.Flags().String("object-label", "", "Label of the object (key)")
-	pkcs11AddKeyCmd.Flags().String("key-type", "", "Type of the key (ECDSA or RSA)")
-	pkcs11AddKeyCmd.Flags().Uint("key-size", 0, "Key size in bits (2048 for RSA, 256 for ECDSA)")
+	pkcs11AddKeyCmd.Flags().String("key-type", "", "Type of the key (ECDSA, RSA or AES)")
+	pkcs11AddKeyCmd.Flags().Uint("key-size", 0, "Key size in bits (2048 for RSA, 256 for ECDSA, 128, 192 or 256 for AES)")
 	rootCmd.AddCommand(pkcs11AddKeyCmd)
 
 	var pkcs11DeleteObjectCmd = &cobra.Command{
@@ -270,7 +395,8 @@ func InitPKCS11Commands(rootCmd *cobra.Command) {
 	}
 	pkcs11EncryptCmd.Flags().String("token-label", "", "Label of the PKCS#11 token")
 	pkcs11EncryptCmd.Flags().String("object-label", "", "Label of the object (key) for encryption")
-	pkcs11EncryptCmd.Flags().String("key-type", "", "Type of the key (ECDSA or RSA)")
+	pkcs11EncryptCmd.Flags().String("key-type", "", "Type of the key (RSA or AES)")
+	pkcs11EncryptCmd.Flags().String("mode", cryptography.AESModeGCM, "Mode of AES keys (GCM or CBC)")
 	pkcs11EncryptCmd.Flags().String("input-file", "", "Path to the unencrypted input file")
 	pkcs11EncryptCmd.Flags().String("output-file", "", "Path to encrypted output file")
 	rootCmd.AddCommand(pkcs11EncryptCmd)
@@ -283,11 +409,37 @@ func InitPKCS11Commands(rootCmd *cobra.Command) {
 	}
 	pkcs11DecryptCmd.Flags().String("token-label", "", "Label of the PKCS#11 token")
 	pkcs11DecryptCmd.Flags().String("object-label", "", "Label of the object (key) for decryption")
-	pkcs11DecryptCmd.Flags().String("key-type", "", "Type of the key (ECDSA or RSA)")
+	pkcs11DecryptCmd.Flags().String("key-type", "", "Type of the key (RSA or AES)")
+	pkcs11DecryptCmd.Flags().String("mode", cryptography.AESModeGCM, "Mode of AES keys (GCM or CBC)")
 	pkcs11DecryptCmd.Flags().String("input-file", "", "Path to the encrypted input file")
 	pkcs11DecryptCmd.Flags().String("output-file", "", "Path to decrypted output file")
 	rootCmd.AddCommand(pkcs11DecryptCmd)
 
+	// --------------------------- Key Wrapping Commands ---------------------------
+	var pkcs11WrapKeyCmd = &cobra.Command{
+		Use:   "wrap-key",
+		Short: "Wrap key material using a PKCS#11 token",
+		Run:   handler.WrapKeyCmd,
+	}
+	pkcs11WrapKeyCmd.Flags().String("token-label", "", "Label of the PKCS#11 token")
+	pkcs11WrapKeyCmd.Flags().String("object-label", "", "Label of the object (key) for key wrapping")
+	pkcs11WrapKeyCmd.Flags().String("key-type", "", "Type of the key (AES or RSA)")
+	pkcs11WrapKeyCmd.Flags().String("input-file", "", "Path to the key material input file")
+	pkcs11WrapKeyCmd.Flags().String("output-file", "", "Path to wrapped key output file")
+	rootCmd.AddCommand(pkcs11WrapKeyCmd)
+
+	var pkcs11UnwrapKeyCmd = &cobra.Command{
+		Use:   "unwrap-key",
+		Short: "Unwrap key material using a PKCS#11 token",
+		Run:   handler.UnwrapKeyCmd,
+	}
+	pkcs11UnwrapKeyCmd.Flags().String("token-label", "", "Label of the PKCS#11 token")
+	pkcs11UnwrapKeyCmd.Flags().String("object-label", "", "Label of the object (key) for key unwrapping")
+	pkcs11UnwrapKeyCmd.Flags().String("key-type", "", "Type of the key (AES or RSA)")
+	pkcs11UnwrapKeyCmd.Flags().String("input-file", "", "Path to the wrapped key input file")
+	pkcs11UnwrapKeyCmd.Flags().String("output-file", "", "Path to unwrapped key output file")
+	rootCmd.AddCommand(pkcs11UnwrapKeyCmd)
+
 	// --------------------------- Signature Command ---------------------------
 	var pkcs11SignCmd = &cobra.Command{
 		Use:   "sign",
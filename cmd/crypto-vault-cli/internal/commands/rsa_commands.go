@@ -80,6 +80,7 @@ func (commandHandler *RSACommandHandler) EncryptRSACmd(cmd *cobra.Command, _ []s
 	inputFile, _ := cmd.Flags().GetString("input-file")
 	outputFile, _ := cmd.Flags().GetString("output-file")
 	publicKeyPath, _ := cmd.Flags().GetString("public-key")
+	armor, _ := cmd.Flags().GetBool("armor")
 
 	publicKey, err := commandHandler.rsaProcessor.ReadPublicKey(publicKeyPath)
 	if err != nil {
@@ -93,12 +94,20 @@ func (commandHandler *RSACommandHandler) EncryptRSACmd(cmd *cobra.Command, _ []s
 		return
 	}
 
-	encryptedData, err := commandHandler.rsaProcessor.Encrypt(plainText, publicKey)
+	encryptedData, err := commandHandler.rsaProcessor.Encrypt(plainText, publicKey, keyIDFromPath(publicKeyPath), 0, nil)
 	if err != nil {
 		commandHandler.Logger.Error(fmt.Sprintf("%v", err))
 		return
 	}
 
+	if armor {
+		encryptedData, err = armorEnvelope(encryptedData)
+		if err != nil {
+			commandHandler.Logger.Error(fmt.Sprintf("%v", err))
+			return
+		}
+	}
+
 	err = os.WriteFile(outputFile, encryptedData, 0600)
 	if err != nil {
 		commandHandler.Logger.Error(fmt.Sprintf("%v", err))
@@ -113,20 +122,27 @@ func (commandHandler *RSACommandHandler) DecryptRSACmd(cmd *cobra.Command, _ []s
 	inputFile, _ := cmd.Flags().GetString("input-file")
 	outputFile, _ := cmd.Flags().GetString("output-file")
 	privateKeyPath, _ := cmd.Flags().GetString("private-key")
+	keyDir, _ := cmd.Flags().GetString("key-dir")
 
-	privateKey, err := commandHandler.rsaProcessor.ReadPrivateKey(privateKeyPath)
+	encryptedData, err := os.ReadFile(filepath.Clean(inputFile))
 	if err != nil {
 		commandHandler.Logger.Error(fmt.Sprintf("%v", err))
 		return
 	}
 
-	encryptedData, err := os.ReadFile(filepath.Clean(inputFile))
+	privateKeyPath, err = resolveDecryptionKeyPath(encryptedData, privateKeyPath, keyDir, "-private-key.pem")
+	if err != nil {
+		commandHandler.Logger.Error(fmt.Sprintf("%v", err))
+		return
+	}
+
+	privateKey, err := commandHandler.rsaProcessor.ReadPrivateKey(privateKeyPath)
 	if err != nil {
 		commandHandler.Logger.Error(fmt.Sprintf("%v", err))
 		return
 	}
 
-	decryptedData, err := commandHandler.rsaProcessor.Decrypt(encryptedData, privateKey)
+	decryptedData, err := commandHandler.rsaProcessor.Decrypt(encryptedData, privateKey, nil)
 	if err != nil {
 		commandHandler.Logger.Error(fmt.Sprintf("%v", err))
 		return
@@ -239,6 +255,7 @@ func InitRSACommands(rootCmd *cobra.Command) {
 	encryptRSAFileCmd.Flags().StringP("input-file", "", "", "Path to input file which needs to be encrypted")
 	encryptRSAFileCmd.Flags().StringP("output-file", "", "", "Path to encrypted output file")
 	encryptRSAFileCmd.Flags().StringP("public-key", "", "", "Path to RSA public private key")
+	encryptRSAFileCmd.Flags().BoolP("armor", "", false, "Write the encrypted envelope ASCII-armored instead of binary")
 	rootCmd.AddCommand(encryptRSAFileCmd)
 
 	var decryptRSAFileCmd = &cobra.Command{
@@ -248,7 +265,8 @@ func InitRSACommands(rootCmd *cobra.Command) {
 	}
 	decryptRSAFileCmd.Flags().StringP("input-file", "", "", "Path to encrypted file")
 	decryptRSAFileCmd.Flags().StringP("output-file", "", "", "Path to decrypted output file")
-	decryptRSAFileCmd.Flags().StringP("private-key", "", "", "Path to RSA private key")
+	decryptRSAFileCmd.Flags().StringP("private-key", "", "", "Path to RSA private key. If omitted, the key named by the input file is looked up in the key directory")
+	decryptRSAFileCmd.Flags().StringP("key-dir", "", "", "Directory to look up the RSA private key named by the input file")
 	rootCmd.AddCommand(decryptRSAFileCmd)
 
 	var signRSAFileCmd = &cobra.Command{
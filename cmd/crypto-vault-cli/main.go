@@ -1,5 +1,5 @@
 // Package main is the entry point for the crypto-vault-cli application.
-// It initializes the root command and registers various sub-commands (AES, RSA, ECDSA, PKCS#11)
+// It initializes the root command and registers various sub-commands (AES, RSA, ECDSA, PKCS#11, admin)
 // for the CLI, then executes the command-line interface.
 package main
 
@@ -17,6 +17,7 @@ func main() {
 	commands.InitAESCommands(rootCmd)
 	commands.InitRSACommands(rootCmd)
 	commands.InitECDSACommands(rootCmd)
+	commands.InitAdminCommands(rootCmd)
 
 	_, err := commands.ReadPkcs11SettingsFromEnv()
 	if err == nil {
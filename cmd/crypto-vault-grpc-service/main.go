@@ -18,7 +18,11 @@ import (
 	"crypto_vault_service/internal/app/services"
 	"crypto_vault_service/internal/domain/blobs"
 	"crypto_vault_service/internal/domain/keys"
+	"crypto_vault_service/internal/domain/permissions"
+	"crypto_vault_service/internal/infrastructure/authentication"
+	"crypto_vault_service/internal/infrastructure/authorization"
 	"crypto_vault_service/internal/infrastructure/connector"
+	"crypto_vault_service/internal/infrastructure/cryptography"
 	"crypto_vault_service/internal/infrastructure/logger"
 	"crypto_vault_service/internal/infrastructure/settings"
 	"crypto_vault_service/internal/persistence/repository"
@@ -109,8 +113,8 @@ func main() {
 		log.Fatalf("Unsupported database type: %s", config.Database.Type)
 	}
 
-	// Migrate the schema for Blob and CryptoKey
-	err = db.AutoMigrate(&blobs.BlobMeta{}, &keys.CryptoKeyMeta{})
+	// Migrate the schema for Blob, CryptoKey, MasterKeyRotation, LogicalKey, KeyImportKey and RelationTuple
+	err = db.AutoMigrate(&blobs.BlobMeta{}, &keys.CryptoKeyMeta{}, &keys.MasterKeyRotation{}, &keys.LogicalKey{}, &keys.KeyImportKey{}, &permissions.RelationTuple{}, &blobs.BlobLinkSigningKey{})
 	if err != nil {
 		log.Fatalf("Failed to migrate schema: %v", err)
 	}
@@ -124,43 +128,148 @@ func main() {
 	if err != nil {
 		log.Fatalf("Error creating crypto key repository instance: %v", err)
 	}
+	masterKeyRotationRepo, err := repository.NewGormMasterKeyRotationRepository(db, logger)
+	if err != nil {
+		log.Fatalf("Error creating master key rotation repository instance: %v", err)
+	}
+	logicalKeyRepo, err := repository.NewGormLogicalKeyRepository(db, logger)
+	if err != nil {
+		log.Fatalf("Error creating logical key repository instance: %v", err)
+	}
+	keyImportKeyRepo, err := repository.NewGormKeyImportKeyRepository(db, logger)
+	if err != nil {
+		log.Fatalf("Error creating key import key repository instance: %v", err)
+	}
+	relationTupleRepo, err := repository.NewGormRelationTupleRepository(db, logger)
+	if err != nil {
+		log.Fatalf("Error creating relation tuple repository instance: %v", err)
+	}
+	blobLinkSigningKeyRepo, err := repository.NewGormBlobLinkSigningKeyRepository(db, logger)
+	if err != nil {
+		log.Fatalf("Error creating blob link signing key repository instance: %v", err)
+	}
 
 	ctx := context.Background()
-	blobConnector, err := connector.NewAzureBlobConnector(ctx, &config.BlobConnector, logger)
+	blobConnector, err := connector.NewBlobConnector(ctx, &config.BlobConnector, logger)
+	if err != nil {
+		log.Fatalf("%v", err)
+	}
+
+	vaultConnector, err := connector.NewVaultConnector(ctx, &config.KeyConnector, &config.PKCS11, logger)
+	if err != nil {
+		log.Fatalf("%v", err)
+	}
+
+	// A first master key version is only generated for vaults not holding any wrapped keys yet
+	initializeMasterKey, err := services.MasterKeyInitializable(ctx, cryptoKeyRepo, keyImportKeyRepo, blobLinkSigningKeyRepo)
 	if err != nil {
 		log.Fatalf("%v", err)
 	}
 
-	vaultConnector, err := connector.NewAzureVaultConnector(ctx, &config.KeyConnector, logger)
+	masterKeyProvider, err := cryptography.NewMasterKeyProvider(&config.MasterKey, &config.PKCS11, initializeMasterKey, logger)
 	if err != nil {
 		log.Fatalf("%v", err)
 	}
 
+	permissionManagement, err := authorization.NewLocalPermissionManagement(relationTupleRepo, logger)
+	if err != nil {
+		log.Fatalf("%v", err)
+	}
+
+	// Record the ownership of blobs and keys stored before permissions were managed by relationship tuples
+	if err := services.SyncOwnershipTuples(ctx, permissionManagement, blobRepo, cryptoKeyRepo, logger); err != nil {
+		log.Fatalf("%v", err)
+	}
+
 	// Initialize services
-	blobUploadService, err := services.NewBlobUploadService(blobConnector, blobRepo, vaultConnector, cryptoKeyRepo, logger)
+	blobUploadService, err := services.NewBlobUploadService(blobConnector, blobRepo, vaultConnector, cryptoKeyRepo, logicalKeyRepo, masterKeyProvider, permissionManagement, logger)
+	if err != nil {
+		log.Fatalf("%v", err)
+	}
+	blobDownloadService, err := services.NewBlobDownloadService(blobConnector, blobRepo, vaultConnector, cryptoKeyRepo, masterKeyProvider, permissionManagement, logger)
+	if err != nil {
+		log.Fatalf("%v", err)
+	}
+	blobMetadataService, err := services.NewBlobMetadataService(blobRepo, blobConnector, permissionManagement, logger)
+	if err != nil {
+		log.Fatalf("%v", err)
+	}
+	blobGrantService, err := services.NewBlobGrantService(blobRepo, permissionManagement, logger)
+	if err != nil {
+		log.Fatalf("%v", err)
+	}
+	cryptoKeyUploadService, err := services.NewCryptoKeyUploadService(vaultConnector, cryptoKeyRepo, logicalKeyRepo, masterKeyProvider, permissionManagement, logger)
+	if err != nil {
+		log.Fatalf("%v", err)
+	}
+	if err := config.KeyExport.Validate(); err != nil {
+		log.Fatalf("invalid key export settings: %v", err)
+	}
+	trustedWrappingKeys, err := cryptography.ReadRSAWrappingKeys(config.KeyExport.TrustedWrappingKeyFiles)
+	if err != nil {
+		log.Fatalf("%v", err)
+	}
+	cryptoKeyDownloadService, err := services.NewCryptoKeyDownloadService(vaultConnector, cryptoKeyRepo, logicalKeyRepo, masterKeyProvider, permissionManagement, trustedWrappingKeys, logger)
 	if err != nil {
 		log.Fatalf("%v", err)
 	}
-	blobDownloadService, err := services.NewBlobDownloadService(blobConnector, blobRepo, vaultConnector, cryptoKeyRepo, logger)
+	if err := config.KeyDestruction.Validate(); err != nil {
+		log.Fatalf("invalid key destruction settings: %v", err)
+	}
+	cryptoKeyMetadataService, err := services.NewCryptoKeyMetadataService(vaultConnector, cryptoKeyRepo, blobRepo, permissionManagement, config.KeyDestruction.GracePeriod, logger)
 	if err != nil {
 		log.Fatalf("%v", err)
 	}
-	blobMetadataService, err := services.NewBlobMetadataService(blobRepo, blobConnector, logger)
+	cryptoKeyDestructionService, err := services.NewCryptoKeyDestructionService(vaultConnector, cryptoKeyRepo, config.KeyDestruction.Interval, logger)
 	if err != nil {
 		log.Fatalf("%v", err)
 	}
-	cryptoKeyUploadService, err := services.NewCryptoKeyUploadService(vaultConnector, cryptoKeyRepo, logger)
+	if err := config.KeyRotation.Validate(); err != nil {
+		log.Fatalf("invalid key rotation settings: %v", err)
+	}
+	cryptoKeyRotationService, err := services.NewCryptoKeyRotationService(vaultConnector, cryptoKeyRepo, logicalKeyRepo, masterKeyProvider, permissionManagement, config.KeyRotation.Interval, logger)
 	if err != nil {
 		log.Fatalf("%v", err)
 	}
-	cryptoKeyDownloadService, err := services.NewCryptoKeyDownloadService(vaultConnector, cryptoKeyRepo, logger)
+	cryptoKeyImportService, err := services.NewCryptoKeyImportService(vaultConnector, cryptoKeyRepo, logicalKeyRepo, keyImportKeyRepo, masterKeyProvider, permissionManagement, logger)
 	if err != nil {
 		log.Fatalf("%v", err)
 	}
-	cryptoKeyMetadataService, err := services.NewCryptoKeyMetadataService(vaultConnector, cryptoKeyRepo, logger)
+	masterKeyRotationService, err := services.NewMasterKeyRotationService(vaultConnector, cryptoKeyRepo, keyImportKeyRepo, blobLinkSigningKeyRepo, masterKeyRotationRepo, masterKeyProvider, logger)
 	if err != nil {
 		log.Fatalf("%v", err)
 	}
+	cryptoOperationService, err := services.NewCryptoOperationService(vaultConnector, cryptoKeyRepo, logicalKeyRepo, masterKeyProvider, permissionManagement, logger)
+	if err != nil {
+		log.Fatalf("%v", err)
+	}
+	pkcs11Handler, err := cryptography.NewPKCS11Handler(&config.PKCS11, logger)
+	if err != nil {
+		log.Fatalf("%v", err)
+	}
+	// Tokens holding the keys of the vault or the master key are off limits to the admin routes
+	var vaultTokenLabels []string
+	if config.KeyConnector.CloudProvider == "pkcs11" {
+		vaultTokenLabels = append(vaultTokenLabels, config.KeyConnector.TokenLabel)
+	}
+	if config.MasterKey.Source == "pkcs11" {
+		vaultTokenLabels = append(vaultTokenLabels, config.MasterKey.TokenLabel)
+	}
+	pkcs11AdminService, err := services.NewPKCS11AdminService(pkcs11Handler, config.PKCS11.SlotID, vaultTokenLabels, logger)
+	if err != nil {
+		log.Fatalf("%v", err)
+	}
+
+	// Continue re-wrapping stored keys if a previous master key rotation was interrupted
+	if err := masterKeyRotationService.Resume(ctx); err != nil {
+		log.Fatalf("%v", err)
+	}
+
+	// Delete the material of keys once their scheduled destruction is due
+	go cryptoKeyDestructionService.Run(ctx)
+
+	// Rotate logical keys once their rotation policy is due
+	go cryptoKeyRotationService.Run(ctx)
 
 	// Create gRPC server and register the gRPC services
 	blobUploadServer, err := v1.NewBlobUploadServer(blobUploadService)
@@ -178,6 +287,11 @@ func main() {
 		log.Fatalf("failed to create blob metadata server: %v", err)
 	}
 
+	blobGrantServer, err := v1.NewBlobGrantServer(blobGrantService)
+	if err != nil {
+		log.Fatalf("failed to create blob grant server: %v", err)
+	}
+
 	cryptoKeyUploadServer, err := v1.NewCryptoKeyUploadServer(cryptoKeyUploadService)
 	if err != nil {
 		log.Fatalf("failed to create crypto key upload server: %v", err)
@@ -193,16 +307,56 @@ func main() {
 		log.Fatalf("failed to create crypto key metadata server: %v", err)
 	}
 
-	grpcServer := grpc.NewServer()
+	cryptoKeyRotationServer, err := v1.NewCryptoKeyRotationServer(cryptoKeyRotationService)
+	if err != nil {
+		log.Fatalf("failed to create crypto key rotation server: %v", err)
+	}
+
+	cryptoKeyImportServer, err := v1.NewCryptoKeyImportServer(cryptoKeyImportService)
+	if err != nil {
+		log.Fatalf("failed to create crypto key import server: %v", err)
+	}
+
+	masterKeyRotationServer, err := v1.NewMasterKeyRotationServer(masterKeyRotationService)
+	if err != nil {
+		log.Fatalf("failed to create master key rotation server: %v", err)
+	}
+
+	cryptoOperationServer, err := v1.NewCryptoOperationServer(cryptoOperationService)
+	if err != nil {
+		log.Fatalf("failed to create crypto operation server: %v", err)
+	}
+
+	pkcs11AdminServer, err := v1.NewPkcs11AdminServer(pkcs11AdminService)
+	if err != nil {
+		log.Fatalf("failed to create pkcs11 admin server: %v", err)
+	}
+
+	tokenValidator, err := authentication.NewJWTValidator(&config.JWT, logger)
+	if err != nil {
+		log.Fatalf("failed to create token validator: %v", err)
+	}
+
+	// Every call must be authenticated by a JWT, whose subject identifies the caller towards the services
+	grpcServer := grpc.NewServer(
+		grpc.UnaryInterceptor(v1.UnaryAuthInterceptor(tokenValidator)),
+		grpc.StreamInterceptor(v1.StreamAuthInterceptor(tokenValidator)),
+	)
 
 	v1.RegisterBlobUploadServer(grpcServer, blobUploadServer)
 	v1.RegisterBlobDownloadServer(grpcServer, blobDownloadServer)
 	v1.RegisterBlobMetadataServer(grpcServer, blobMetadataServer)
+	v1.RegisterBlobGrantServer(grpcServer, blobGrantServer)
 	v1.RegisterCryptoKeyUploadServer(grpcServer, cryptoKeyUploadServer)
 	v1.RegisterCryptoKeyDownloadServer(grpcServer, cryptoKeyDownloadServer)
 	v1.RegisterCryptoKeyMetadataServer(grpcServer, cryptoKeyMetadataServer)
+	v1.RegisterCryptoKeyRotationServer(grpcServer, cryptoKeyRotationServer)
+	v1.RegisterCryptoKeyImportServer(grpcServer, cryptoKeyImportServer)
+	v1.RegisterMasterKeyRotationServer(grpcServer, masterKeyRotationServer)
+	v1.RegisterCryptoOperationServer(grpcServer, cryptoOperationServer)
+	v1.RegisterPkcs11AdminServer(grpcServer, pkcs11AdminServer)
 
-	// Enable reflection in order to list services via `grpcurl -plaintext localhost:50051 list`
+	// Enable reflection in order to list services via `grpcurl -plaintext -H "authorization: Bearer $TOKEN" localhost:50051 list`
 	reflection.Register(grpcServer)
 
 	// Set up listener for gRPC server
@@ -246,6 +400,10 @@ func main() {
 	if err != nil {
 		log.Fatalf("Failed to register blob metadata gateway: %v", err)
 	}
+	err = v1.RegisterBlobGrantGateway(context.Background(), gatewayTarget, gwmux, conn, creds)
+	if err != nil {
+		log.Fatalf("Failed to register blob grant gateway: %v", err)
+	}
 	err = v1.RegisterCryptoKeyUploadGateway(context.Background(), gatewayTarget, gwmux, conn, creds)
 	if err != nil {
 		log.Fatalf("Failed to register crypto key upload gateway: %v", err)
@@ -258,6 +416,26 @@ func main() {
 	if err != nil {
 		log.Fatalf("Failed to register crypto key metadata gateway: %v", err)
 	}
+	err = v1.RegisterCryptoKeyRotationGateway(context.Background(), gatewayTarget, gwmux, conn, creds)
+	if err != nil {
+		log.Fatalf("Failed to register crypto key rotation gateway: %v", err)
+	}
+	err = v1.RegisterCryptoKeyImportGateway(context.Background(), gatewayTarget, gwmux, conn, creds)
+	if err != nil {
+		log.Fatalf("Failed to register crypto key import gateway: %v", err)
+	}
+	err = v1.RegisterMasterKeyRotationGateway(context.Background(), gatewayTarget, gwmux, conn, creds)
+	if err != nil {
+		log.Fatalf("Failed to register master key rotation gateway: %v", err)
+	}
+	err = v1.RegisterCryptoOperationGateway(context.Background(), gatewayTarget, gwmux, conn, creds)
+	if err != nil {
+		log.Fatalf("Failed to register crypto operation gateway: %v", err)
+	}
+	err = v1.RegisterPkcs11AdminGateway(context.Background(), gatewayTarget, gwmux, conn, creds)
+	if err != nil {
+		log.Fatalf("Failed to register pkcs11 admin gateway: %v", err)
+	}
 
 	gatewayPort := config.GatewayPort
 	// Set up the HTTP server to serve the Gateway
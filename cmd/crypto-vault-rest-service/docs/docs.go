@@ -26,6 +26,11 @@ const docTemplate = `{
     "paths": {
         "/blobs": {
             "get": {
+                "security": [
+                    {
+                        "ApiKeyAuth": []
+                    }
+                ],
                 "description": "Fetch a list of metadata for blobs based on query filters like name, size, type, and creation date.",
                 "consumes": [
                     "application/json"
@@ -81,25 +86,36 @@ const docTemplate = `{
                         "schema": {
                             "type": "array",
                             "items": {
-                                "$ref": "#/definitions/v1.BlobMetaResponseDto"
+                                "$ref": "#/definitions/v1.BlobMetaResponse"
                             }
                         }
                     },
                     "400": {
                         "description": "Bad Request",
                         "schema": {
-                            "$ref": "#/definitions/v1.ErrorResponseDto"
+                            "$ref": "#/definitions/v1.ErrorResponse"
+                        }
+                    },
+                    "401": {
+                        "description": "Unauthorized",
+                        "schema": {
+                            "$ref": "#/definitions/v1.ErrorResponse"
                         }
                     },
                     "404": {
                         "description": "Not Found",
                         "schema": {
-                            "$ref": "#/definitions/v1.ErrorResponseDto"
+                            "$ref": "#/definitions/v1.ErrorResponse"
                         }
                     }
                 }
             },
             "post": {
+                "security": [
+                    {
+                        "ApiKeyAuth": []
+                    }
+                ],
                 "description": "Upload a blob to the system with optional encryption and signing using the provided keys",
                 "consumes": [
                     "multipart/form-data"
@@ -138,14 +154,26 @@ const docTemplate = `{
                         "schema": {
                             "type": "array",
                             "items": {
-                                "$ref": "#/definitions/v1.BlobMetaResponseDto"
+                                "$ref": "#/definitions/v1.BlobMetaResponse"
                             }
                         }
                     },
                     "400": {
                         "description": "Bad Request",
                         "schema": {
-                            "$ref": "#/definitions/v1.ErrorResponseDto"
+                            "$ref": "#/definitions/v1.ErrorResponse"
+                        }
+                    },
+                    "401": {
+                        "description": "Unauthorized",
+                        "schema": {
+                            "$ref": "#/definitions/v1.ErrorResponse"
+                        }
+                    },
+                    "403": {
+                        "description": "Forbidden",
+                        "schema": {
+                            "$ref": "#/definitions/v1.ErrorResponse"
                         }
                     }
                 }
@@ -153,6 +181,11 @@ const docTemplate = `{
         },
         "/blobs/{id}": {
             "get": {
+                "security": [
+                    {
+                        "ApiKeyAuth": []
+                    }
+                ],
                 "description": "Fetch the metadata of a specific blob by its unique ID, including its name, size, type, encryption and signing key IDs, and creation date.",
                 "consumes": [
                     "application/json"
@@ -177,18 +210,35 @@ const docTemplate = `{
                     "200": {
                         "description": "OK",
                         "schema": {
-                            "$ref": "#/definitions/v1.BlobMetaResponseDto"
+                            "$ref": "#/definitions/v1.BlobMetaResponse"
+                        }
+                    },
+                    "401": {
+                        "description": "Unauthorized",
+                        "schema": {
+                            "$ref": "#/definitions/v1.ErrorResponse"
+                        }
+                    },
+                    "403": {
+                        "description": "Forbidden",
+                        "schema": {
+                            "$ref": "#/definitions/v1.ErrorResponse"
                         }
                     },
                     "404": {
                         "description": "Not Found",
                         "schema": {
-                            "$ref": "#/definitions/v1.ErrorResponseDto"
+                            "$ref": "#/definitions/v1.ErrorResponse"
                         }
                     }
                 }
             },
             "delete": {
+                "security": [
+                    {
+                        "ApiKeyAuth": []
+                    }
+                ],
                 "description": "Delete a specific blob and its associated metadata by its ID.",
                 "consumes": [
                     "application/json"
@@ -213,13 +263,25 @@ const docTemplate = `{
                     "204": {
                         "description": "No Content",
                         "schema": {
-                            "$ref": "#/definitions/v1.InfoResponseDto"
+                            "$ref": "#/definitions/v1.InfoResponse"
+                        }
+                    },
+                    "401": {
+                        "description": "Unauthorized",
+                        "schema": {
+                            "$ref": "#/definitions/v1.ErrorResponse"
+                        }
+                    },
+                    "403": {
+                        "description": "Forbidden",
+                        "schema": {
+                            "$ref": "#/definitions/v1.ErrorResponse"
                         }
                     },
                     "404": {
                         "description": "Not Found",
                         "schema": {
-                            "$ref": "#/definitions/v1.ErrorResponseDto"
+                            "$ref": "#/definitions/v1.ErrorResponse"
                         }
                     }
                 }
@@ -227,7 +289,12 @@ const docTemplate = `{
         },
         "/blobs/{id}/file": {
             "get": {
-                "description": "Download the content of a specific blob by its ID, optionally decrypted with a provided decryption key ID.",
+                "security": [
+                    {
+                        "ApiKeyAuth": []
+                    }
+                ],
+                "description": "Download the content of a specific blob by its ID, optionally decrypted with a provided decryption key ID.\nInstead of a bearer token the token of a pre-signed link may be passed, which determines the decryption key.",
                 "consumes": [
                     "application/json"
                 ],
@@ -251,6 +318,12 @@ const docTemplate = `{
                         "description": "Decryption Key ID",
                         "name": "decryption_key_id",
                         "in": "query"
+                    },
+                    {
+                        "type": "string",
+                        "description": "Pre-signed Link Token",
+                        "name": "token",
+                        "in": "query"
                     }
                 ],
                 "responses": {
@@ -260,18 +333,35 @@ const docTemplate = `{
                             "type": "file"
                         }
                     },
+                    "401": {
+                        "description": "Unauthorized",
+                        "schema": {
+                            "$ref": "#/definitions/v1.ErrorResponse"
+                        }
+                    },
+                    "403": {
+                        "description": "Forbidden",
+                        "schema": {
+                            "$ref": "#/definitions/v1.ErrorResponse"
+                        }
+                    },
                     "404": {
                         "description": "Not Found",
                         "schema": {
-                            "$ref": "#/definitions/v1.ErrorResponseDto"
+                            "$ref": "#/definitions/v1.ErrorResponse"
                         }
                     }
                 }
             }
         },
-        "/keys": {
+        "/blobs/{id}/grants": {
             "get": {
-                "description": "Fetch a list of cryptographic key metadata based on filters like algorithm, type, and creation date, with pagination and sorting options.",
+                "security": [
+                    {
+                        "ApiKeyAuth": []
+                    }
+                ],
+                "description": "Fetch the users and groups a blob is shared with and the access they were granted. Expired grants are omitted.",
                 "consumes": [
                     "application/json"
                 ],
@@ -279,51 +369,16 @@ const docTemplate = `{
                     "application/json"
                 ],
                 "tags": [
-                    "Key"
+                    "Blob"
                 ],
-                "summary": "List cryptographic key metadata based on query parameters",
+                "summary": "List the grants of a blob",
                 "parameters": [
                     {
                         "type": "string",
-                        "description": "Cryptographic Algorithm",
-                        "name": "algorithm",
-                        "in": "query"
-                    },
-                    {
-                        "type": "string",
-                        "description": "Key Type",
-                        "name": "type",
-                        "in": "query"
-                    },
-                    {
-                        "type": "string",
-                        "description": "Key Creation Date (RFC3339)",
-                        "name": "dateTimeCreated",
-                        "in": "query"
-                    },
-                    {
-                        "type": "integer",
-                        "description": "Limit the number of results",
-                        "name": "limit",
-                        "in": "query"
-                    },
-                    {
-                        "type": "integer",
-                        "description": "Offset the results",
-                        "name": "offset",
-                        "in": "query"
-                    },
-                    {
-                        "type": "string",
-                        "description": "Sort by a specific field",
-                        "name": "sortBy",
-                        "in": "query"
-                    },
-                    {
-                        "type": "string",
-                        "description": "Sort order (asc/desc)",
-                        "name": "sortOrder",
-                        "in": "query"
+                        "description": "Blob ID",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
                     }
                 ],
                 "responses": {
@@ -332,26 +387,37 @@ const docTemplate = `{
                         "schema": {
                             "type": "array",
                             "items": {
-                                "$ref": "#/definitions/v1.CryptoKeyMetaResponseDto"
+                                "$ref": "#/definitions/v1.BlobGrantResponse"
                             }
                         }
                     },
-                    "400": {
-                        "description": "Bad Request",
+                    "401": {
+                        "description": "Unauthorized",
+                        "schema": {
+                            "$ref": "#/definitions/v1.ErrorResponse"
+                        }
+                    },
+                    "403": {
+                        "description": "Forbidden",
                         "schema": {
-                            "$ref": "#/definitions/v1.ErrorResponseDto"
+                            "$ref": "#/definitions/v1.ErrorResponse"
                         }
                     },
                     "404": {
                         "description": "Not Found",
                         "schema": {
-                            "$ref": "#/definitions/v1.ErrorResponseDto"
+                            "$ref": "#/definitions/v1.ErrorResponse"
                         }
                     }
                 }
             },
             "post": {
-                "description": "Generate cryptographic keys based on provided parameters and upload them to the system.",
+                "security": [
+                    {
+                        "ApiKeyAuth": []
+                    }
+                ],
+                "description": "Grant a user or the members of a group view-only or download access to a blob, optionally until an expiry. An existing grant of the grantee is replaced. Download access to an encrypted blob includes decrypting it with the key pair it was encrypted with.",
                 "consumes": [
                     "application/json"
                 ],
@@ -359,17 +425,24 @@ const docTemplate = `{
                     "application/json"
                 ],
                 "tags": [
-                    "Key"
+                    "Blob"
                 ],
-                "summary": "Upload cryptographic keys and metadata",
+                "summary": "Share a blob with a user or group",
                 "parameters": [
                     {
-                        "description": "Cryptographic Key Data",
+                        "type": "string",
+                        "description": "Blob ID",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "description": "Blob Grant Data",
                         "name": "requestBody",
                         "in": "body",
                         "required": true,
                         "schema": {
-                            "$ref": "#/definitions/v1.UploadKeyRequestDto"
+                            "$ref": "#/definitions/v1.CreateBlobGrantRequest"
                         }
                     }
                 ],
@@ -377,24 +450,38 @@ const docTemplate = `{
                     "201": {
                         "description": "Created",
                         "schema": {
-                            "type": "array",
-                            "items": {
-                                "$ref": "#/definitions/v1.CryptoKeyMetaResponseDto"
-                            }
+                            "$ref": "#/definitions/v1.BlobGrantResponse"
                         }
                     },
                     "400": {
                         "description": "Bad Request",
                         "schema": {
-                            "$ref": "#/definitions/v1.ErrorResponseDto"
+                            "$ref": "#/definitions/v1.ErrorResponse"
+                        }
+                    },
+                    "401": {
+                        "description": "Unauthorized",
+                        "schema": {
+                            "$ref": "#/definitions/v1.ErrorResponse"
+                        }
+                    },
+                    "403": {
+                        "description": "Forbidden",
+                        "schema": {
+                            "$ref": "#/definitions/v1.ErrorResponse"
                         }
                     }
                 }
             }
         },
-        "/keys/{id}": {
-            "get": {
-                "description": "Fetch the metadata of a specific cryptographic key by its unique ID, including algorithm, key size, and creation date.",
+        "/blobs/{id}/grants/{granteeType}/{granteeId}": {
+            "delete": {
+                "security": [
+                    {
+                        "ApiKeyAuth": []
+                    }
+                ],
+                "description": "Revoke the access a blob was shared with to a user or the members of a group.",
                 "consumes": [
                     "application/json"
                 ],
@@ -402,35 +489,68 @@ const docTemplate = `{
                     "application/json"
                 ],
                 "tags": [
-                    "Key"
+                    "Blob"
                 ],
-                "summary": "Retrieve metadata of a key by its ID",
+                "summary": "Revoke the grant of a user or group for a blob",
                 "parameters": [
                     {
                         "type": "string",
-                        "description": "Key ID",
+                        "description": "Blob ID",
                         "name": "id",
                         "in": "path",
                         "required": true
+                    },
+                    {
+                        "type": "string",
+                        "description": "Grantee Type (user, group)",
+                        "name": "granteeType",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "type": "string",
+                        "description": "Grantee ID",
+                        "name": "granteeId",
+                        "in": "path",
+                        "required": true
                     }
                 ],
                 "responses": {
-                    "200": {
-                        "description": "OK",
+                    "204": {
+                        "description": "No Content",
                         "schema": {
-                            "$ref": "#/definitions/v1.CryptoKeyMetaResponseDto"
+                            "$ref": "#/definitions/v1.InfoResponse"
+                        }
+                    },
+                    "401": {
+                        "description": "Unauthorized",
+                        "schema": {
+                            "$ref": "#/definitions/v1.ErrorResponse"
+                        }
+                    },
+                    "403": {
+                        "description": "Forbidden",
+                        "schema": {
+                            "$ref": "#/definitions/v1.ErrorResponse"
                         }
                     },
                     "404": {
                         "description": "Not Found",
                         "schema": {
-                            "$ref": "#/definitions/v1.ErrorResponseDto"
+                            "$ref": "#/definitions/v1.ErrorResponse"
                         }
                     }
                 }
-            },
-            "delete": {
-                "description": "Delete a specific cryptographic key and its associated metadata by its ID.",
+            }
+        },
+        "/blobs/{id}/links": {
+            "post": {
+                "security": [
+                    {
+                        "ApiKeyAuth": []
+                    }
+                ],
+                "description": "Issue an HMAC-signed token permitting to download a blob without a bearer token until it expires, optionally bound to a decryption key and a single use.\nThe download is authorized for the issuer, hence the link stops working once the issuer loses access to the blob. Access the issuer obtains through the admin role or groups is not delegated.",
                 "consumes": [
                     "application/json"
                 ],
@@ -438,146 +558,2848 @@ const docTemplate = `{
                     "application/json"
                 ],
                 "tags": [
-                    "Key"
+                    "Blob"
                 ],
-                "summary": "Delete a cryptographic key by its ID",
+                "summary": "Issue a pre-signed download link for a blob",
                 "parameters": [
                     {
                         "type": "string",
-                        "description": "Key ID",
+                        "description": "Blob ID",
                         "name": "id",
                         "in": "path",
                         "required": true
+                    },
+                    {
+                        "description": "Blob Link Data",
+                        "name": "requestBody",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/v1.CreateBlobLinkRequest"
+                        }
                     }
                 ],
                 "responses": {
-                    "204": {
-                        "description": "No Content",
+                    "201": {
+                        "description": "Created",
                         "schema": {
-                            "$ref": "#/definitions/v1.InfoResponseDto"
+                            "$ref": "#/definitions/v1.BlobLinkResponse"
                         }
                     },
-                    "404": {
-                        "description": "Not Found",
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "$ref": "#/definitions/v1.ErrorResponse"
+                        }
+                    },
+                    "401": {
+                        "description": "Unauthorized",
                         "schema": {
-                            "$ref": "#/definitions/v1.ErrorResponseDto"
+                            "$ref": "#/definitions/v1.ErrorResponse"
+                        }
+                    },
+                    "403": {
+                        "description": "Forbidden",
+                        "schema": {
+                            "$ref": "#/definitions/v1.ErrorResponse"
                         }
                     }
                 }
             }
         },
-        "/keys/{id}/file": {
-            "get": {
-                "description": "Download the content of a specific cryptographic key by its ID.",
+        "/blobs/{id}/links/{linkId}": {
+            "delete": {
+                "security": [
+                    {
+                        "ApiKeyAuth": []
+                    }
+                ],
+                "description": "Reject the token of a pre-signed link before it expires. Only callers who may share the blob revoke its links.",
                 "consumes": [
                     "application/json"
                 ],
                 "produces": [
-                    "application/octet-stream"
+                    "application/json"
                 ],
                 "tags": [
-                    "Key"
+                    "Blob"
                 ],
-                "summary": "Download a cryptographic key by its ID",
+                "summary": "Revoke a pre-signed download link for a blob",
                 "parameters": [
                     {
                         "type": "string",
-                        "description": "Key ID",
+                        "description": "Blob ID",
                         "name": "id",
                         "in": "path",
                         "required": true
+                    },
+                    {
+                        "type": "string",
+                        "description": "Link ID",
+                        "name": "linkId",
+                        "in": "path",
+                        "required": true
                     }
                 ],
                 "responses": {
-                    "200": {
-                        "description": "Cryptographic key content",
+                    "204": {
+                        "description": "No Content",
                         "schema": {
-                            "type": "file"
+                            "$ref": "#/definitions/v1.InfoResponse"
                         }
                     },
-                    "404": {
-                        "description": "Not Found",
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "$ref": "#/definitions/v1.ErrorResponse"
+                        }
+                    },
+                    "401": {
+                        "description": "Unauthorized",
+                        "schema": {
+                            "$ref": "#/definitions/v1.ErrorResponse"
+                        }
+                    },
+                    "403": {
+                        "description": "Forbidden",
                         "schema": {
-                            "$ref": "#/definitions/v1.ErrorResponseDto"
+                            "$ref": "#/definitions/v1.ErrorResponse"
                         }
                     }
                 }
             }
-        }
-    },
-    "definitions": {
-        "v1.BlobMetaResponseDto": {
-            "type": "object",
-            "properties": {
-                "dateTimeCreated": {
-                    "type": "string"
-                },
-                "encryptionKeyID": {
-                    "type": "string"
-                },
-                "id": {
-                    "type": "string"
-                },
-                "name": {
-                    "type": "string"
-                },
-                "signKeyID": {
-                    "type": "string"
-                },
-                "size": {
-                    "type": "integer"
-                },
-                "type": {
-                    "type": "string"
-                },
-                "userID": {
-                    "type": "string"
-                }
-            }
         },
-        "v1.CryptoKeyMetaResponseDto": {
-            "type": "object",
-            "properties": {
+        "/blobs/{id}/verify": {
+            "post": {
+                "security": [
+                    {
+                        "ApiKeyAuth": []
+                    }
+                ],
+                "description": "Check the detached signature of a signed blob against the public key of its sign key pair. Encrypted blobs require a decryption key ID, since signatures cover the plaintext.",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Blob"
+                ],
+                "summary": "Verify the signature of a blob by its ID",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "Blob ID",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "type": "string",
+                        "description": "Decryption Key ID",
+                        "name": "decryption_key_id",
+                        "in": "query"
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/v1.BlobVerificationResponse"
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "$ref": "#/definitions/v1.ErrorResponse"
+                        }
+                    },
+                    "401": {
+                        "description": "Unauthorized",
+                        "schema": {
+                            "$ref": "#/definitions/v1.ErrorResponse"
+                        }
+                    },
+                    "403": {
+                        "description": "Forbidden",
+                        "schema": {
+                            "$ref": "#/definitions/v1.ErrorResponse"
+                        }
+                    },
+                    "404": {
+                        "description": "Not Found",
+                        "schema": {
+                            "$ref": "#/definitions/v1.ErrorResponse"
+                        }
+                    }
+                }
+            }
+        },
+        "/keys": {
+            "get": {
+                "security": [
+                    {
+                        "ApiKeyAuth": []
+                    }
+                ],
+                "description": "Fetch a list of cryptographic key metadata based on filters like algorithm, type, and creation date, with pagination and sorting options.",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Key"
+                ],
+                "summary": "List cryptographic key metadata based on query parameters",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "Cryptographic Algorithm",
+                        "name": "algorithm",
+                        "in": "query"
+                    },
+                    {
+                        "type": "string",
+                        "description": "Key Type",
+                        "name": "type",
+                        "in": "query"
+                    },
+                    {
+                        "type": "string",
+                        "description": "Key Creation Date (RFC3339)",
+                        "name": "dateTimeCreated",
+                        "in": "query"
+                    },
+                    {
+                        "type": "integer",
+                        "description": "Limit the number of results",
+                        "name": "limit",
+                        "in": "query"
+                    },
+                    {
+                        "type": "integer",
+                        "description": "Offset the results",
+                        "name": "offset",
+                        "in": "query"
+                    },
+                    {
+                        "type": "string",
+                        "description": "Sort by a specific field",
+                        "name": "sortBy",
+                        "in": "query"
+                    },
+                    {
+                        "type": "string",
+                        "description": "Sort order (asc/desc)",
+                        "name": "sortOrder",
+                        "in": "query"
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "type": "array",
+                            "items": {
+                                "$ref": "#/definitions/v1.CryptoKeyMetaResponse"
+                            }
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "$ref": "#/definitions/v1.ErrorResponse"
+                        }
+                    },
+                    "401": {
+                        "description": "Unauthorized",
+                        "schema": {
+                            "$ref": "#/definitions/v1.ErrorResponse"
+                        }
+                    },
+                    "404": {
+                        "description": "Not Found",
+                        "schema": {
+                            "$ref": "#/definitions/v1.ErrorResponse"
+                        }
+                    }
+                }
+            },
+            "post": {
+                "security": [
+                    {
+                        "ApiKeyAuth": []
+                    }
+                ],
+                "description": "Generate cryptographic keys based on provided parameters and upload them to the system.",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Key"
+                ],
+                "summary": "Upload cryptographic keys and metadata",
+                "parameters": [
+                    {
+                        "description": "Cryptographic Key Data",
+                        "name": "requestBody",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/v1.UploadKeyRequest"
+                        }
+                    }
+                ],
+                "responses": {
+                    "201": {
+                        "description": "Created",
+                        "schema": {
+                            "type": "array",
+                            "items": {
+                                "$ref": "#/definitions/v1.CryptoKeyMetaResponse"
+                            }
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "$ref": "#/definitions/v1.ErrorResponse"
+                        }
+                    },
+                    "401": {
+                        "description": "Unauthorized",
+                        "schema": {
+                            "$ref": "#/definitions/v1.ErrorResponse"
+                        }
+                    }
+                }
+            }
+        },
+        "/keys/import": {
+            "post": {
+                "security": [
+                    {
+                        "ApiKeyAuth": []
+                    }
+                ],
+                "description": "Import AES, RSA or EC key material encoded as PEM, DER, JWK or raw AES key, optionally wrapped with the key import public key. The imported keys form version 1 of a new logical key.",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Key"
+                ],
+                "summary": "Import cryptographic key material",
+                "parameters": [
+                    {
+                        "description": "Key Material",
+                        "name": "requestBody",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/v1.ImportKeyRequest"
+                        }
+                    }
+                ],
+                "responses": {
+                    "201": {
+                        "description": "Created",
+                        "schema": {
+                            "type": "array",
+                            "items": {
+                                "$ref": "#/definitions/v1.CryptoKeyMetaResponse"
+                            }
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "$ref": "#/definitions/v1.ErrorResponse"
+                        }
+                    },
+                    "401": {
+                        "description": "Unauthorized",
+                        "schema": {
+                            "$ref": "#/definitions/v1.ErrorResponse"
+                        }
+                    }
+                }
+            }
+        },
+        "/keys/import-key": {
+            "get": {
+                "security": [
+                    {
+                        "ApiKeyAuth": []
+                    }
+                ],
+                "description": "Fetch the RSA public key to wrap key material with RSA_AES_KEY_WRAP_SHA_256 before importing it, so that it never travels in plaintext.",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Key"
+                ],
+                "summary": "Get the key import public key",
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/v1.KeyImportPublicKeyResponse"
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "$ref": "#/definitions/v1.ErrorResponse"
+                        }
+                    },
+                    "401": {
+                        "description": "Unauthorized",
+                        "schema": {
+                            "$ref": "#/definitions/v1.ErrorResponse"
+                        }
+                    }
+                }
+            }
+        },
+        "/keys/{id}": {
+            "get": {
+                "security": [
+                    {
+                        "ApiKeyAuth": []
+                    }
+                ],
+                "description": "Fetch the metadata of a specific cryptographic key by its unique ID, including algorithm, key size, and creation date.",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Key"
+                ],
+                "summary": "Retrieve metadata of a key by its ID",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "Key ID",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/v1.CryptoKeyMetaResponse"
+                        }
+                    },
+                    "401": {
+                        "description": "Unauthorized",
+                        "schema": {
+                            "$ref": "#/definitions/v1.ErrorResponse"
+                        }
+                    },
+                    "403": {
+                        "description": "Forbidden",
+                        "schema": {
+                            "$ref": "#/definitions/v1.ErrorResponse"
+                        }
+                    },
+                    "404": {
+                        "description": "Not Found",
+                        "schema": {
+                            "$ref": "#/definitions/v1.ErrorResponse"
+                        }
+                    }
+                }
+            },
+            "delete": {
+                "security": [
+                    {
+                        "ApiKeyAuth": []
+                    }
+                ],
+                "description": "Schedule the destruction of all keys of the key pair a key belongs to once the configured grace period has passed. The destruction can be cancelled until then. Keys blobs are still protected with are only scheduled for destruction if force is set. The material of destroyed keys is deleted, their metadata is kept.",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Key"
+                ],
+                "summary": "Schedule the destruction of a cryptographic key by its ID",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "Key ID",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "type": "boolean",
+                        "description": "Schedule the destruction even if blobs are still protected with the keys",
+                        "name": "force",
+                        "in": "query"
+                    }
+                ],
+                "responses": {
+                    "202": {
+                        "description": "Accepted",
+                        "schema": {
+                            "type": "array",
+                            "items": {
+                                "$ref": "#/definitions/v1.CryptoKeyMetaResponse"
+                            }
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "$ref": "#/definitions/v1.ErrorResponse"
+                        }
+                    },
+                    "401": {
+                        "description": "Unauthorized",
+                        "schema": {
+                            "$ref": "#/definitions/v1.ErrorResponse"
+                        }
+                    },
+                    "403": {
+                        "description": "Forbidden",
+                        "schema": {
+                            "$ref": "#/definitions/v1.ErrorResponse"
+                        }
+                    },
+                    "404": {
+                        "description": "Not Found",
+                        "schema": {
+                            "$ref": "#/definitions/v1.ErrorResponse"
+                        }
+                    },
+                    "409": {
+                        "description": "Conflict",
+                        "schema": {
+                            "$ref": "#/definitions/v1.ErrorResponse"
+                        }
+                    }
+                }
+            }
+        },
+        "/keys/{id}/decrypt": {
+            "post": {
+                "security": [
+                    {
+                        "ApiKeyAuth": []
+                    }
+                ],
+                "description": "Decrypt a base64 ciphertext returned by encrypt with an AES key or the private key of an RSA key pair. The associated data passed on encryption must be passed again.",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Crypto"
+                ],
+                "summary": "Decrypt a payload with a key",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "Key ID",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "description": "Operation Payload",
+                        "name": "requestBody",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/v1.CryptoOperationRequest"
+                        }
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/v1.CryptoOperationResponse"
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "$ref": "#/definitions/v1.ErrorResponse"
+                        }
+                    },
+                    "401": {
+                        "description": "Unauthorized",
+                        "schema": {
+                            "$ref": "#/definitions/v1.ErrorResponse"
+                        }
+                    },
+                    "403": {
+                        "description": "Forbidden",
+                        "schema": {
+                            "$ref": "#/definitions/v1.ErrorResponse"
+                        }
+                    }
+                }
+            }
+        },
+        "/keys/{id}/destruction": {
+            "delete": {
+                "security": [
+                    {
+                        "ApiKeyAuth": []
+                    }
+                ],
+                "description": "Cancel the scheduled destruction of all keys of the key pair a key belongs to, provided the grace period has not passed yet.",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Key"
+                ],
+                "summary": "Cancel the scheduled destruction of a cryptographic key by its ID",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "Key ID",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "type": "array",
+                            "items": {
+                                "$ref": "#/definitions/v1.CryptoKeyMetaResponse"
+                            }
+                        }
+                    },
+                    "401": {
+                        "description": "Unauthorized",
+                        "schema": {
+                            "$ref": "#/definitions/v1.ErrorResponse"
+                        }
+                    },
+                    "403": {
+                        "description": "Forbidden",
+                        "schema": {
+                            "$ref": "#/definitions/v1.ErrorResponse"
+                        }
+                    },
+                    "404": {
+                        "description": "Not Found",
+                        "schema": {
+                            "$ref": "#/definitions/v1.ErrorResponse"
+                        }
+                    },
+                    "409": {
+                        "description": "Conflict",
+                        "schema": {
+                            "$ref": "#/definitions/v1.ErrorResponse"
+                        }
+                    }
+                }
+            }
+        },
+        "/keys/{id}/encrypt": {
+            "post": {
+                "security": [
+                    {
+                        "ApiKeyAuth": []
+                    }
+                ],
+                "description": "Encrypt a base64 payload with an AES key or the public key of an RSA key pair, optionally binding associated data. The returned ciphertext is an envelope recording the key pair.",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Crypto"
+                ],
+                "summary": "Encrypt a payload with a key",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "Key ID",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "description": "Operation Payload",
+                        "name": "requestBody",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/v1.CryptoOperationRequest"
+                        }
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/v1.CryptoOperationResponse"
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "$ref": "#/definitions/v1.ErrorResponse"
+                        }
+                    },
+                    "401": {
+                        "description": "Unauthorized",
+                        "schema": {
+                            "$ref": "#/definitions/v1.ErrorResponse"
+                        }
+                    },
+                    "403": {
+                        "description": "Forbidden",
+                        "schema": {
+                            "$ref": "#/definitions/v1.ErrorResponse"
+                        }
+                    }
+                }
+            }
+        },
+        "/keys/{id}/export": {
+            "post": {
+                "security": [
+                    {
+                        "ApiKeyAuth": []
+                    }
+                ],
+                "description": "Export the content of a specific cryptographic key wrapped with either an AES key of the vault (AES_KEY_WRAP_PAD, RFC 5649) or an RSA public key supplied by the caller. Symmetric keys are wrapped with RSAES_OAEP_SHA_256, private and public keys with RSA_AES_KEY_WRAP_SHA_256. Only the wrapped key is returned, the wrapping algorithm is set in the X-Wrapping-Algorithm header.",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/octet-stream"
+                ],
+                "tags": [
+                    "Key"
+                ],
+                "summary": "Export a cryptographic key wrapped with another key by its ID",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "Key ID",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "description": "Wrapping Key",
+                        "name": "requestBody",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/v1.ExportKeyRequest"
+                        }
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "Wrapped cryptographic key content",
+                        "schema": {
+                            "type": "file"
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "$ref": "#/definitions/v1.ErrorResponse"
+                        }
+                    },
+                    "401": {
+                        "description": "Unauthorized",
+                        "schema": {
+                            "$ref": "#/definitions/v1.ErrorResponse"
+                        }
+                    },
+                    "403": {
+                        "description": "Forbidden",
+                        "schema": {
+                            "$ref": "#/definitions/v1.ErrorResponse"
+                        }
+                    },
+                    "409": {
+                        "description": "Conflict",
+                        "schema": {
+                            "$ref": "#/definitions/v1.ErrorResponse"
+                        }
+                    }
+                }
+            }
+        },
+        "/keys/{id}/export-policy": {
+            "put": {
+                "security": [
+                    {
+                        "ApiKeyAuth": []
+                    }
+                ],
+                "description": "Forbid the plaintext export of all keys of the key pair the key belongs to, so that their material can only be exported wrapped. Forbidding plaintext export cannot be undone, new versions of the logical key inherit it.",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Key"
+                ],
+                "summary": "Update the export policy of a cryptographic key by its ID",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "Key ID",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "description": "Export Policy",
+                        "name": "requestBody",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/v1.UpdateExportPolicyRequest"
+                        }
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "type": "array",
+                            "items": {
+                                "$ref": "#/definitions/v1.CryptoKeyMetaResponse"
+                            }
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "$ref": "#/definitions/v1.ErrorResponse"
+                        }
+                    },
+                    "401": {
+                        "description": "Unauthorized",
+                        "schema": {
+                            "$ref": "#/definitions/v1.ErrorResponse"
+                        }
+                    },
+                    "403": {
+                        "description": "Forbidden",
+                        "schema": {
+                            "$ref": "#/definitions/v1.ErrorResponse"
+                        }
+                    },
+                    "404": {
+                        "description": "Not Found",
+                        "schema": {
+                            "$ref": "#/definitions/v1.ErrorResponse"
+                        }
+                    }
+                }
+            }
+        },
+        "/keys/{id}/file": {
+            "get": {
+                "security": [
+                    {
+                        "ApiKeyAuth": []
+                    }
+                ],
+                "description": "Download the content of a specific cryptographic key by its ID. Private and symmetric keys whose export policy forbids plaintext export can only be exported wrapped.",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/octet-stream"
+                ],
+                "tags": [
+                    "Key"
+                ],
+                "summary": "Download a cryptographic key by its ID",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "Key ID",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "Cryptographic key content",
+                        "schema": {
+                            "type": "file"
+                        }
+                    },
+                    "401": {
+                        "description": "Unauthorized",
+                        "schema": {
+                            "$ref": "#/definitions/v1.ErrorResponse"
+                        }
+                    },
+                    "403": {
+                        "description": "Forbidden",
+                        "schema": {
+                            "$ref": "#/definitions/v1.ErrorResponse"
+                        }
+                    },
+                    "404": {
+                        "description": "Not Found",
+                        "schema": {
+                            "$ref": "#/definitions/v1.ErrorResponse"
+                        }
+                    }
+                }
+            }
+        },
+        "/keys/{id}/lifecycle": {
+            "patch": {
+                "security": [
+                    {
+                        "ApiKeyAuth": []
+                    }
+                ],
+                "description": "Transition all keys of the key pair the key belongs to into another lifecycle state (pre-active, active, suspended, deactivated, destroyed) and reschedule their activation or expiry. Pre-active keys can be activated or destroyed, active keys suspended or deactivated, suspended keys reactivated or deactivated, and deactivated keys destroyed. Destroying keys schedules their destruction once the grace period has passed and is refused while blobs are protected with the keys unless force is set.",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Key"
+                ],
+                "summary": "Update the lifecycle of a cryptographic key",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "Key ID",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "description": "Lifecycle Update",
+                        "name": "requestBody",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/v1.UpdateKeyLifecycleRequest"
+                        }
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "type": "array",
+                            "items": {
+                                "$ref": "#/definitions/v1.CryptoKeyMetaResponse"
+                            }
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "$ref": "#/definitions/v1.ErrorResponse"
+                        }
+                    },
+                    "401": {
+                        "description": "Unauthorized",
+                        "schema": {
+                            "$ref": "#/definitions/v1.ErrorResponse"
+                        }
+                    },
+                    "403": {
+                        "description": "Forbidden",
+                        "schema": {
+                            "$ref": "#/definitions/v1.ErrorResponse"
+                        }
+                    },
+                    "409": {
+                        "description": "Conflict",
+                        "schema": {
+                            "$ref": "#/definitions/v1.ErrorResponse"
+                        }
+                    }
+                }
+            }
+        },
+        "/keys/{id}/rotate": {
+            "post": {
+                "security": [
+                    {
+                        "ApiKeyAuth": []
+                    }
+                ],
+                "description": "Generate a new version of the logical key the key belongs to and make it the primary version. Older versions remain available to decrypt and verify existing data.",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Key"
+                ],
+                "summary": "Rotate the logical key of a cryptographic key by its ID",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "Key ID",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "201": {
+                        "description": "Created",
+                        "schema": {
+                            "type": "array",
+                            "items": {
+                                "$ref": "#/definitions/v1.CryptoKeyMetaResponse"
+                            }
+                        }
+                    },
+                    "401": {
+                        "description": "Unauthorized",
+                        "schema": {
+                            "$ref": "#/definitions/v1.ErrorResponse"
+                        }
+                    },
+                    "403": {
+                        "description": "Forbidden",
+                        "schema": {
+                            "$ref": "#/definitions/v1.ErrorResponse"
+                        }
+                    },
+                    "404": {
+                        "description": "Not Found",
+                        "schema": {
+                            "$ref": "#/definitions/v1.ErrorResponse"
+                        }
+                    }
+                }
+            }
+        },
+        "/keys/{id}/rotation-policy": {
+            "put": {
+                "security": [
+                    {
+                        "ApiKeyAuth": []
+                    }
+                ],
+                "description": "Set the period after which the logical key the key belongs to is rotated automatically. An empty period or \"0\" disables automatic rotation.",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Key"
+                ],
+                "summary": "Update the rotation policy of the logical key of a cryptographic key by its ID",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "Key ID",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "description": "Rotation Policy",
+                        "name": "requestBody",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/v1.UpdateRotationPolicyRequest"
+                        }
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/v1.LogicalKeyResponse"
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "$ref": "#/definitions/v1.ErrorResponse"
+                        }
+                    },
+                    "401": {
+                        "description": "Unauthorized",
+                        "schema": {
+                            "$ref": "#/definitions/v1.ErrorResponse"
+                        }
+                    },
+                    "403": {
+                        "description": "Forbidden",
+                        "schema": {
+                            "$ref": "#/definitions/v1.ErrorResponse"
+                        }
+                    },
+                    "404": {
+                        "description": "Not Found",
+                        "schema": {
+                            "$ref": "#/definitions/v1.ErrorResponse"
+                        }
+                    }
+                }
+            }
+        },
+        "/keys/{id}/sign": {
+            "post": {
+                "security": [
+                    {
+                        "ApiKeyAuth": []
+                    }
+                ],
+                "description": "Sign the SHA-256 digest of a base64 payload with the private key of an RSA or EC key pair.",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Crypto"
+                ],
+                "summary": "Sign a payload with a key",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "Key ID",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "description": "Operation Payload",
+                        "name": "requestBody",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/v1.CryptoOperationRequest"
+                        }
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/v1.CryptoOperationResponse"
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "$ref": "#/definitions/v1.ErrorResponse"
+                        }
+                    },
+                    "401": {
+                        "description": "Unauthorized",
+                        "schema": {
+                            "$ref": "#/definitions/v1.ErrorResponse"
+                        }
+                    },
+                    "403": {
+                        "description": "Forbidden",
+                        "schema": {
+                            "$ref": "#/definitions/v1.ErrorResponse"
+                        }
+                    }
+                }
+            }
+        },
+        "/keys/{id}/unwrap": {
+            "post": {
+                "security": [
+                    {
+                        "ApiKeyAuth": []
+                    }
+                ],
+                "description": "Unwrap base64 key material returned by wrap with an AES key or the private key of an RSA key pair.",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Crypto"
+                ],
+                "summary": "Unwrap key material with a key",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "Key ID",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "description": "Operation Payload",
+                        "name": "requestBody",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/v1.CryptoOperationRequest"
+                        }
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/v1.CryptoOperationResponse"
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "$ref": "#/definitions/v1.ErrorResponse"
+                        }
+                    },
+                    "401": {
+                        "description": "Unauthorized",
+                        "schema": {
+                            "$ref": "#/definitions/v1.ErrorResponse"
+                        }
+                    },
+                    "403": {
+                        "description": "Forbidden",
+                        "schema": {
+                            "$ref": "#/definitions/v1.ErrorResponse"
+                        }
+                    }
+                }
+            }
+        },
+        "/keys/{id}/verify": {
+            "post": {
+                "security": [
+                    {
+                        "ApiKeyAuth": []
+                    }
+                ],
+                "description": "Verify the base64 signature of a base64 payload with the public key of an RSA or EC key pair. A signature not matching the payload is reported as invalid.",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Crypto"
+                ],
+                "summary": "Verify the signature of a payload with a key",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "Key ID",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "description": "Operation Payload",
+                        "name": "requestBody",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/v1.CryptoOperationRequest"
+                        }
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/v1.CryptoOperationResponse"
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "$ref": "#/definitions/v1.ErrorResponse"
+                        }
+                    },
+                    "401": {
+                        "description": "Unauthorized",
+                        "schema": {
+                            "$ref": "#/definitions/v1.ErrorResponse"
+                        }
+                    },
+                    "403": {
+                        "description": "Forbidden",
+                        "schema": {
+                            "$ref": "#/definitions/v1.ErrorResponse"
+                        }
+                    }
+                }
+            }
+        },
+        "/keys/{id}/versions": {
+            "get": {
+                "security": [
+                    {
+                        "ApiKeyAuth": []
+                    }
+                ],
+                "description": "Fetch the key metadata of all versions of the logical key the key belongs to, newest version first.",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Key"
+                ],
+                "summary": "List the versions of the logical key of a cryptographic key by its ID",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "Key ID",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "type": "array",
+                            "items": {
+                                "$ref": "#/definitions/v1.CryptoKeyMetaResponse"
+                            }
+                        }
+                    },
+                    "401": {
+                        "description": "Unauthorized",
+                        "schema": {
+                            "$ref": "#/definitions/v1.ErrorResponse"
+                        }
+                    },
+                    "403": {
+                        "description": "Forbidden",
+                        "schema": {
+                            "$ref": "#/definitions/v1.ErrorResponse"
+                        }
+                    },
+                    "404": {
+                        "description": "Not Found",
+                        "schema": {
+                            "$ref": "#/definitions/v1.ErrorResponse"
+                        }
+                    }
+                }
+            }
+        },
+        "/keys/{id}/wrap": {
+            "post": {
+                "security": [
+                    {
+                        "ApiKeyAuth": []
+                    }
+                ],
+                "description": "Wrap base64 key material with an AES key (AES Key Wrap with Padding, RFC 5649) or the public key of an RSA key pair (RSA-OAEP with SHA-256).",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Crypto"
+                ],
+                "summary": "Wrap key material with a key",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "Key ID",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "description": "Operation Payload",
+                        "name": "requestBody",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/v1.CryptoOperationRequest"
+                        }
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/v1.CryptoOperationResponse"
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "$ref": "#/definitions/v1.ErrorResponse"
+                        }
+                    },
+                    "401": {
+                        "description": "Unauthorized",
+                        "schema": {
+                            "$ref": "#/definitions/v1.ErrorResponse"
+                        }
+                    },
+                    "403": {
+                        "description": "Forbidden",
+                        "schema": {
+                            "$ref": "#/definitions/v1.ErrorResponse"
+                        }
+                    }
+                }
+            }
+        },
+        "/master-key/rotations": {
+            "post": {
+                "security": [
+                    {
+                        "ApiKeyAuth": []
+                    }
+                ],
+                "description": "Introduce a new master key version and re-wrap all stored cryptographic keys in the background. A failed rotation is resumed with the keys it left to re-wrap instead.",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "MasterKey"
+                ],
+                "summary": "Rotate the master key",
+                "responses": {
+                    "202": {
+                        "description": "Accepted",
+                        "schema": {
+                            "$ref": "#/definitions/v1.MasterKeyRotationResponse"
+                        }
+                    },
+                    "401": {
+                        "description": "Unauthorized",
+                        "schema": {
+                            "$ref": "#/definitions/v1.ErrorResponse"
+                        }
+                    },
+                    "403": {
+                        "description": "Forbidden",
+                        "schema": {
+                            "$ref": "#/definitions/v1.ErrorResponse"
+                        }
+                    },
+                    "409": {
+                        "description": "Conflict",
+                        "schema": {
+                            "$ref": "#/definitions/v1.ErrorResponse"
+                        }
+                    }
+                }
+            }
+        },
+        "/master-key/rotations/{id}": {
+            "get": {
+                "security": [
+                    {
+                        "ApiKeyAuth": []
+                    }
+                ],
+                "description": "Fetch the status and progress of a master key rotation by its unique ID.",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "MasterKey"
+                ],
+                "summary": "Retrieve a master key rotation by its ID",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "Rotation ID",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/v1.MasterKeyRotationResponse"
+                        }
+                    },
+                    "401": {
+                        "description": "Unauthorized",
+                        "schema": {
+                            "$ref": "#/definitions/v1.ErrorResponse"
+                        }
+                    },
+                    "403": {
+                        "description": "Forbidden",
+                        "schema": {
+                            "$ref": "#/definitions/v1.ErrorResponse"
+                        }
+                    },
+                    "404": {
+                        "description": "Not Found",
+                        "schema": {
+                            "$ref": "#/definitions/v1.ErrorResponse"
+                        }
+                    }
+                }
+            }
+        },
+        "/pkcs11/slots": {
+            "get": {
+                "security": [
+                    {
+                        "ApiKeyAuth": []
+                    }
+                ],
+                "description": "Fetch the tokens present in the slots of the configured PKCS#11 module. Restricted to admins.",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "PKCS11"
+                ],
+                "summary": "List the PKCS#11 tokens",
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "type": "array",
+                            "items": {
+                                "$ref": "#/definitions/v1.PKCS11TokenResponse"
+                            }
+                        }
+                    },
+                    "401": {
+                        "description": "Unauthorized",
+                        "schema": {
+                            "$ref": "#/definitions/v1.ErrorResponse"
+                        }
+                    },
+                    "403": {
+                        "description": "Forbidden",
+                        "schema": {
+                            "$ref": "#/definitions/v1.ErrorResponse"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error",
+                        "schema": {
+                            "$ref": "#/definitions/v1.ErrorResponse"
+                        }
+                    }
+                }
+            }
+        },
+        "/pkcs11/tokens": {
+            "post": {
+                "security": [
+                    {
+                        "ApiKeyAuth": []
+                    }
+                ],
+                "description": "Initialize the token of the configured slot with a label and the configured pins. Tokens already carrying the label are left untouched. Restricted to admins.",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "PKCS11"
+                ],
+                "summary": "Initialize a PKCS#11 token",
+                "parameters": [
+                    {
+                        "description": "Token Data",
+                        "name": "requestBody",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/v1.InitializePKCS11TokenRequest"
+                        }
+                    }
+                ],
+                "responses": {
+                    "201": {
+                        "description": "Created",
+                        "schema": {
+                            "$ref": "#/definitions/v1.InfoResponse"
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "$ref": "#/definitions/v1.ErrorResponse"
+                        }
+                    },
+                    "401": {
+                        "description": "Unauthorized",
+                        "schema": {
+                            "$ref": "#/definitions/v1.ErrorResponse"
+                        }
+                    },
+                    "403": {
+                        "description": "Forbidden",
+                        "schema": {
+                            "$ref": "#/definitions/v1.ErrorResponse"
+                        }
+                    }
+                }
+            }
+        },
+        "/pkcs11/tokens/{label}/decrypt": {
+            "post": {
+                "security": [
+                    {
+                        "ApiKeyAuth": []
+                    }
+                ],
+                "description": "Decrypt a base64 ciphertext returned by encrypt with the private key of the RSA key pair or the AES key on a token. Restricted to admins.",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "PKCS11"
+                ],
+                "summary": "Decrypt a payload on a PKCS#11 token",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "Token Label",
+                        "name": "label",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "description": "Operation Payload",
+                        "name": "requestBody",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/v1.PKCS11OperationRequest"
+                        }
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/v1.PKCS11OperationResponse"
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "$ref": "#/definitions/v1.ErrorResponse"
+                        }
+                    },
+                    "401": {
+                        "description": "Unauthorized",
+                        "schema": {
+                            "$ref": "#/definitions/v1.ErrorResponse"
+                        }
+                    },
+                    "403": {
+                        "description": "Forbidden",
+                        "schema": {
+                            "$ref": "#/definitions/v1.ErrorResponse"
+                        }
+                    }
+                }
+            }
+        },
+        "/pkcs11/tokens/{label}/encrypt": {
+            "post": {
+                "security": [
+                    {
+                        "ApiKeyAuth": []
+                    }
+                ],
+                "description": "Encrypt a base64 payload with the public key of an RSA key pair (PKCS#1 v1.5) or with an AES key (AES-GCM, the IV prefixes the ciphertext) on a token. Restricted to admins.",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "PKCS11"
+                ],
+                "summary": "Encrypt a payload on a PKCS#11 token",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "Token Label",
+                        "name": "label",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "description": "Operation Payload",
+                        "name": "requestBody",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/v1.PKCS11OperationRequest"
+                        }
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/v1.PKCS11OperationResponse"
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "$ref": "#/definitions/v1.ErrorResponse"
+                        }
+                    },
+                    "401": {
+                        "description": "Unauthorized",
+                        "schema": {
+                            "$ref": "#/definitions/v1.ErrorResponse"
+                        }
+                    },
+                    "403": {
+                        "description": "Forbidden",
+                        "schema": {
+                            "$ref": "#/definitions/v1.ErrorResponse"
+                        }
+                    }
+                }
+            }
+        },
+        "/pkcs11/tokens/{label}/keys": {
+            "post": {
+                "security": [
+                    {
+                        "ApiKeyAuth": []
+                    }
+                ],
+                "description": "Generate an RSA or ECDSA key pair or an AES key on a token, whose private or secret key never leaves the token. Restricted to admins.",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "PKCS11"
+                ],
+                "summary": "Generate a key pair on a PKCS#11 token",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "Token Label",
+                        "name": "label",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "description": "Key Data",
+                        "name": "requestBody",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/v1.AddPKCS11KeyRequest"
+                        }
+                    }
+                ],
+                "responses": {
+                    "201": {
+                        "description": "Created",
+                        "schema": {
+                            "$ref": "#/definitions/v1.InfoResponse"
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "$ref": "#/definitions/v1.ErrorResponse"
+                        }
+                    },
+                    "401": {
+                        "description": "Unauthorized",
+                        "schema": {
+                            "$ref": "#/definitions/v1.ErrorResponse"
+                        }
+                    },
+                    "403": {
+                        "description": "Forbidden",
+                        "schema": {
+                            "$ref": "#/definitions/v1.ErrorResponse"
+                        }
+                    }
+                }
+            }
+        },
+        "/pkcs11/tokens/{label}/objects": {
+            "get": {
+                "security": [
+                    {
+                        "ApiKeyAuth": []
+                    }
+                ],
+                "description": "Fetch the keys and data objects stored on a token. Restricted to admins.",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "PKCS11"
+                ],
+                "summary": "List the objects of a PKCS#11 token",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "Token Label",
+                        "name": "label",
+                        "in": "path",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "type": "array",
+                            "items": {
+                                "$ref": "#/definitions/v1.PKCS11ObjectResponse"
+                            }
+                        }
+                    },
+                    "401": {
+                        "description": "Unauthorized",
+                        "schema": {
+                            "$ref": "#/definitions/v1.ErrorResponse"
+                        }
+                    },
+                    "403": {
+                        "description": "Forbidden",
+                        "schema": {
+                            "$ref": "#/definitions/v1.ErrorResponse"
+                        }
+                    },
+                    "404": {
+                        "description": "Not Found",
+                        "schema": {
+                            "$ref": "#/definitions/v1.ErrorResponse"
+                        }
+                    }
+                }
+            }
+        },
+        "/pkcs11/tokens/{label}/objects/{type}/{objectLabel}": {
+            "delete": {
+                "security": [
+                    {
+                        "ApiKeyAuth": []
+                    }
+                ],
+                "description": "Delete the objects of a type and label from a token. Object labels may contain slashes, e.g. those of keys stored by the pkcs11 vault connector. Restricted to admins.",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "PKCS11"
+                ],
+                "summary": "Delete objects from a PKCS#11 token",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "Token Label",
+                        "name": "label",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "type": "string",
+                        "description": "Object Type (privkey, pubkey, secrkey, cert, data)",
+                        "name": "type",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "type": "string",
+                        "description": "Object Label",
+                        "name": "objectLabel",
+                        "in": "path",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "204": {
+                        "description": "No Content",
+                        "schema": {
+                            "$ref": "#/definitions/v1.InfoResponse"
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "$ref": "#/definitions/v1.ErrorResponse"
+                        }
+                    },
+                    "401": {
+                        "description": "Unauthorized",
+                        "schema": {
+                            "$ref": "#/definitions/v1.ErrorResponse"
+                        }
+                    },
+                    "403": {
+                        "description": "Forbidden",
+                        "schema": {
+                            "$ref": "#/definitions/v1.ErrorResponse"
+                        }
+                    },
+                    "404": {
+                        "description": "Not Found",
+                        "schema": {
+                            "$ref": "#/definitions/v1.ErrorResponse"
+                        }
+                    }
+                }
+            }
+        },
+        "/pkcs11/tokens/{label}/sign": {
+            "post": {
+                "security": [
+                    {
+                        "ApiKeyAuth": []
+                    }
+                ],
+                "description": "Sign a base64 payload with the private key of an RSA (RSA-PSS over SHA-384) or ECDSA (over SHA-384, ASN.1 DER encoded) key pair on a token. Restricted to admins.",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "PKCS11"
+                ],
+                "summary": "Sign a payload on a PKCS#11 token",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "Token Label",
+                        "name": "label",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "description": "Operation Payload",
+                        "name": "requestBody",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/v1.PKCS11OperationRequest"
+                        }
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/v1.PKCS11OperationResponse"
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "$ref": "#/definitions/v1.ErrorResponse"
+                        }
+                    },
+                    "401": {
+                        "description": "Unauthorized",
+                        "schema": {
+                            "$ref": "#/definitions/v1.ErrorResponse"
+                        }
+                    },
+                    "403": {
+                        "description": "Forbidden",
+                        "schema": {
+                            "$ref": "#/definitions/v1.ErrorResponse"
+                        }
+                    }
+                }
+            }
+        },
+        "/pkcs11/tokens/{label}/verify": {
+            "post": {
+                "security": [
+                    {
+                        "ApiKeyAuth": []
+                    }
+                ],
+                "description": "Verify the base64 signature returned by sign of a base64 payload with the public key of the key pair on a token. A signature not matching the payload is reported as invalid. Restricted to admins.",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "PKCS11"
+                ],
+                "summary": "Verify the signature of a payload on a PKCS#11 token",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "Token Label",
+                        "name": "label",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "description": "Operation Payload",
+                        "name": "requestBody",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/v1.PKCS11OperationRequest"
+                        }
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/v1.PKCS11OperationResponse"
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "$ref": "#/definitions/v1.ErrorResponse"
+                        }
+                    },
+                    "401": {
+                        "description": "Unauthorized",
+                        "schema": {
+                            "$ref": "#/definitions/v1.ErrorResponse"
+                        }
+                    },
+                    "403": {
+                        "description": "Forbidden",
+                        "schema": {
+                            "$ref": "#/definitions/v1.ErrorResponse"
+                        }
+                    }
+                }
+            }
+        }
+    },
+    "definitions": {
+        "v1.AddPKCS11KeyRequest": {
+            "type": "object",
+            "required": [
+                "key_size",
+                "key_type",
+                "object_label"
+            ],
+            "properties": {
+                "key_size": {
+                    "type": "integer"
+                },
+                "key_type": {
+                    "type": "string",
+                    "enum": [
+                        "RSA",
+                        "ECDSA",
+                        "AES"
+                    ]
+                },
+                "object_label": {
+                    "type": "string"
+                }
+            }
+        },
+        "v1.BlobGrantResponse": {
+            "type": "object",
+            "properties": {
+                "access": {
+                    "description": "Access granted (view, download)",
+                    "type": "string"
+                },
+                "blobID": {
+                    "description": "Identifier of the shared blob",
+                    "type": "string"
+                },
+                "dateTimeCreated": {
+                    "description": "Timestamp when the grant was created",
+                    "type": "string"
+                },
+                "dateTimeExpires": {
+                    "description": "Optional timestamp when the grant expires",
+                    "type": "string"
+                },
+                "granteeID": {
+                    "description": "Identifier of the user or group the blob is shared with",
+                    "type": "string"
+                },
+                "granteeType": {
+                    "description": "Type of the grantee (user, group)",
+                    "type": "string"
+                }
+            }
+        },
+        "v1.BlobLinkResponse": {
+            "type": "object",
+            "properties": {
+                "blobID": {
+                    "description": "Identifier of the blob the link downloads",
+                    "type": "string"
+                },
+                "dateTimeExpires": {
+                    "description": "Timestamp when the link expires",
+                    "type": "string"
+                },
+                "decryptionKeyID": {
+                    "description": "Optional key the blob is decrypted with on download",
+                    "type": "string"
+                },
+                "id": {
+                    "description": "Unique identifier for the link, which it is revoked by",
+                    "type": "string"
+                },
+                "singleUse": {
+                    "description": "Whether the link is revoked when used",
+                    "type": "boolean"
+                },
+                "token": {
+                    "description": "Signed token to pass as token query parameter",
+                    "type": "string"
+                },
+                "url": {
+                    "description": "Path of the download including the token",
+                    "type": "string"
+                }
+            }
+        },
+        "v1.BlobMetaResponse": {
+            "type": "object",
+            "properties": {
+                "dateTimeCreated": {
+                    "description": "Timestamp when the blob was created",
+                    "type": "string"
+                },
+                "encryptionKeyID": {
+                    "description": "Optional encryption key ID for the blob",
+                    "type": "string"
+                },
+                "id": {
+                    "description": "Unique identifier for the blob",
+                    "type": "string"
+                },
+                "name": {
+                    "description": "Name of the blob",
+                    "type": "string"
+                },
+                "signKeyID": {
+                    "description": "Optional signature key ID for the blob",
+                    "type": "string"
+                },
+                "signatureName": {
+                    "description": "Optional name of the detached signature stored next to the blob",
+                    "type": "string"
+                },
+                "size": {
+                    "description": "Size of the blob in bytes",
+                    "type": "integer"
+                },
+                "type": {
+                    "description": "Type of the blob (e.g., file format)",
+                    "type": "string"
+                },
+                "userID": {
+                    "description": "User who uploaded the blob",
+                    "type": "string"
+                }
+            }
+        },
+        "v1.BlobVerificationResponse": {
+            "type": "object",
+            "properties": {
+                "id": {
+                    "description": "Unique identifier for the blob",
+                    "type": "string"
+                },
+                "valid": {
+                    "description": "Whether the signature matches the blob content",
+                    "type": "boolean"
+                }
+            }
+        },
+        "v1.CreateBlobGrantRequest": {
+            "type": "object",
+            "required": [
+                "access",
+                "grantee_id",
+                "grantee_type"
+            ],
+            "properties": {
+                "access": {
+                    "type": "string",
+                    "enum": [
+                        "view",
+                        "download"
+                    ]
+                },
+                "date_time_expires": {
+                    "type": "string"
+                },
+                "grantee_id": {
+                    "type": "string",
+                    "maxLength": 255,
+                    "minLength": 1
+                },
+                "grantee_type": {
+                    "type": "string",
+                    "enum": [
+                        "user",
+                        "group"
+                    ]
+                }
+            }
+        },
+        "v1.CreateBlobLinkRequest": {
+            "type": "object",
+            "required": [
+                "expires_in_seconds"
+            ],
+            "properties": {
+                "decryption_key_id": {
+                    "type": "string"
+                },
+                "expires_in_seconds": {
+                    "type": "integer",
+                    "maximum": 86400,
+                    "minimum": 1
+                },
+                "single_use": {
+                    "type": "boolean"
+                }
+            }
+        },
+        "v1.CryptoKeyMetaResponse": {
+            "type": "object",
+            "properties": {
                 "algorithm": {
+                    "description": "Cryptographic algorithm (e.g., AES, RSA, EC)",
+                    "type": "string"
+                },
+                "dateTimeActivation": {
+                    "description": "Timestamp from which the key is active",
                     "type": "string"
                 },
                 "dateTimeCreated": {
+                    "description": "Timestamp when the key was created",
+                    "type": "string"
+                },
+                "dateTimeDestruction": {
+                    "description": "Timestamp from which the key is destroyed if its destruction is scheduled",
+                    "type": "string"
+                },
+                "dateTimeExpires": {
+                    "description": "Timestamp from which the key is deactivated",
                     "type": "string"
                 },
                 "id": {
+                    "description": "Unique identifier for the cryptographic key",
                     "type": "string"
                 },
                 "keyPairID": {
+                    "description": "Identifier for the key pair the key belongs to",
                     "type": "string"
                 },
                 "keySize": {
+                    "description": "Size of the cryptographic key",
                     "type": "integer"
                 },
+                "logicalKeyID": {
+                    "description": "Identifier of the logical key the key pair is a version of",
+                    "type": "string"
+                },
+                "plaintextExportForbidden": {
+                    "description": "Whether the key may only be exported wrapped",
+                    "type": "boolean"
+                },
+                "state": {
+                    "description": "Lifecycle state of the key (pre-active, active, suspended, deactivated, destroyed)",
+                    "type": "string"
+                },
+                "tokenLabel": {
+                    "description": "Label of the PKCS#11 token the key was generated on, whose private key never leaves it",
+                    "type": "string"
+                },
                 "type": {
+                    "description": "Type of the cryptographic key (e.g., public, private)",
                     "type": "string"
                 },
                 "userID": {
+                    "description": "User who created the key",
+                    "type": "string"
+                },
+                "version": {
+                    "description": "Version of the logical key the key pair belongs to",
+                    "type": "integer"
+                }
+            }
+        },
+        "v1.CryptoOperationRequest": {
+            "type": "object",
+            "required": [
+                "payload"
+            ],
+            "properties": {
+                "associated_data": {
+                    "description": "Optional data bound to ciphertexts by encrypt and decrypt",
+                    "type": "array",
+                    "maxItems": 1048576,
+                    "items": {
+                        "type": "integer"
+                    }
+                },
+                "payload": {
+                    "type": "array",
+                    "maxItems": 1048576,
+                    "items": {
+                        "type": "integer"
+                    }
+                },
+                "signature": {
+                    "description": "Signature checked by verify",
+                    "type": "array",
+                    "maxItems": 1048576,
+                    "items": {
+                        "type": "integer"
+                    }
+                }
+            }
+        },
+        "v1.CryptoOperationResponse": {
+            "type": "object",
+            "properties": {
+                "algorithm": {
+                    "description": "Cryptographic algorithm of the key (e.g., AES, RSA, EC)",
+                    "type": "string"
+                },
+                "ciphertext": {
+                    "description": "Envelope returned by encrypt",
+                    "type": "array",
+                    "items": {
+                        "type": "integer"
+                    }
+                },
+                "key": {
+                    "description": "Key returned by unwrap",
+                    "type": "array",
+                    "items": {
+                        "type": "integer"
+                    }
+                },
+                "keyID": {
+                    "description": "Identifier of the key the operation was requested with",
                     "type": "string"
+                },
+                "keyPairID": {
+                    "description": "Identifier of the key pair whose key performed the operation",
+                    "type": "string"
+                },
+                "keyVersion": {
+                    "description": "Version of the logical key that performed the operation",
+                    "type": "integer"
+                },
+                "operation": {
+                    "description": "Performed operation (encrypt, decrypt, sign, verify, wrap, unwrap)",
+                    "type": "string"
+                },
+                "plaintext": {
+                    "description": "Plaintext returned by decrypt",
+                    "type": "array",
+                    "items": {
+                        "type": "integer"
+                    }
+                },
+                "signature": {
+                    "description": "Signature returned by sign",
+                    "type": "array",
+                    "items": {
+                        "type": "integer"
+                    }
+                },
+                "valid": {
+                    "description": "Whether the signature checked by verify is valid",
+                    "type": "boolean"
+                },
+                "wrappedKey": {
+                    "description": "Wrapped key returned by wrap",
+                    "type": "array",
+                    "items": {
+                        "type": "integer"
+                    }
                 }
             }
         },
-        "v1.ErrorResponseDto": {
+        "v1.ErrorResponse": {
             "type": "object",
             "properties": {
                 "message": {
+                    "description": "The error message",
+                    "type": "string"
+                }
+            }
+        },
+        "v1.ExportKeyRequest": {
+            "type": "object",
+            "properties": {
+                "public_key": {
+                    "description": "PEM or DER encoded PKIX or PKCS#1 RSA public key of at least 2048 bits, the key is wrapped with RSA-OAEP",
+                    "type": "array",
+                    "maxItems": 65536,
+                    "items": {
+                        "type": "integer"
+                    }
+                },
+                "wrapping_key_id": {
+                    "description": "ID of an AES key of the vault, the key is wrapped with AES_KEY_WRAP_PAD (RFC 5649)",
+                    "type": "string"
+                }
+            }
+        },
+        "v1.ImportKeyRequest": {
+            "type": "object",
+            "required": [
+                "algorithm",
+                "format",
+                "key_material"
+            ],
+            "properties": {
+                "algorithm": {
+                    "type": "string",
+                    "enum": [
+                        "AES",
+                        "RSA",
+                        "EC"
+                    ]
+                },
+                "date_time_activation": {
+                    "description": "Optional time from which pre-active keys are active",
                     "type": "string"
+                },
+                "date_time_expires": {
+                    "description": "Optional time from which the keys are deactivated",
+                    "type": "string"
+                },
+                "format": {
+                    "description": "Encoding of the key material: PEM or DER encoded PKCS#1, PKCS#8, SEC1 or PKIX keys, JWK, or raw AES keys",
+                    "type": "string",
+                    "enum": [
+                        "pem",
+                        "der",
+                        "jwk",
+                        "raw"
+                    ]
+                },
+                "key_material": {
+                    "description": "Key material, wrapped with the key import public key if wrapped is set",
+                    "type": "array",
+                    "maxItems": 65536,
+                    "items": {
+                        "type": "integer"
+                    }
+                },
+                "state": {
+                    "description": "Optional initial state, keys are pre-active if their activation is scheduled and active otherwise",
+                    "type": "string",
+                    "enum": [
+                        "pre-active",
+                        "active"
+                    ]
+                },
+                "wrapped": {
+                    "description": "Whether the key material is wrapped with RSA_AES_KEY_WRAP_SHA_256 under the key import public key",
+                    "type": "boolean"
                 }
             }
         },
-        "v1.InfoResponseDto": {
+        "v1.InfoResponse": {
             "type": "object",
             "properties": {
                 "message": {
+                    "description": "The informational message",
+                    "type": "string"
+                }
+            }
+        },
+        "v1.InitializePKCS11TokenRequest": {
+            "type": "object",
+            "required": [
+                "label"
+            ],
+            "properties": {
+                "label": {
+                    "description": "PKCS#11 token labels are limited to 32 characters",
+                    "type": "string",
+                    "maxLength": 32
+                }
+            }
+        },
+        "v1.KeyImportPublicKeyResponse": {
+            "type": "object",
+            "properties": {
+                "publicKey": {
+                    "description": "PEM encoded PKIX RSA public key",
+                    "type": "string"
+                },
+                "wrappingAlgorithm": {
+                    "description": "Algorithm key material is wrapped with (RSA_AES_KEY_WRAP_SHA_256)",
+                    "type": "string"
+                }
+            }
+        },
+        "v1.LogicalKeyResponse": {
+            "type": "object",
+            "properties": {
+                "algorithm": {
+                    "description": "Cryptographic algorithm of all versions (e.g., AES, RSA, EC)",
+                    "type": "string"
+                },
+                "dateTimeCreated": {
+                    "description": "Timestamp when the logical key was created",
+                    "type": "string"
+                },
+                "dateTimeNextRotation": {
+                    "description": "Timestamp of the next automatic rotation",
+                    "type": "string"
+                },
+                "dateTimeRotated": {
+                    "description": "Timestamp of the last rotation",
+                    "type": "string"
+                },
+                "id": {
+                    "description": "Unique identifier for the logical key",
+                    "type": "string"
+                },
+                "keySize": {
+                    "description": "Size of the keys of all versions",
+                    "type": "integer"
+                },
+                "latestVersion": {
+                    "description": "Highest version created so far",
+                    "type": "integer"
+                },
+                "primaryKeyPairID": {
+                    "description": "Identifier of the key pair of the primary version",
+                    "type": "string"
+                },
+                "primaryVersion": {
+                    "description": "Version used to encrypt and sign new data",
+                    "type": "integer"
+                },
+                "rotationPeriod": {
+                    "description": "Interval of automatic rotation, \"0s\" if disabled",
+                    "type": "string"
+                },
+                "userID": {
+                    "description": "User who created the logical key",
+                    "type": "string"
+                }
+            }
+        },
+        "v1.MasterKeyRotationResponse": {
+            "type": "object",
+            "properties": {
+                "dateTimeStarted": {
+                    "description": "Timestamp when the rotation was started",
+                    "type": "string"
+                },
+                "dateTimeUpdated": {
+                    "description": "Timestamp of the last recorded progress",
+                    "type": "string"
+                },
+                "error": {
+                    "description": "Reason why the rotation failed",
+                    "type": "string"
+                },
+                "id": {
+                    "description": "Unique identifier for the rotation",
+                    "type": "string"
+                },
+                "processedKeys": {
+                    "description": "Number of key metadata entries processed so far",
+                    "type": "integer"
+                },
+                "rewrappedKeys": {
+                    "description": "Number of keys re-wrapped with the target version",
+                    "type": "integer"
+                },
+                "status": {
+                    "description": "Status of the rotation (running, completed, failed)",
+                    "type": "string"
+                },
+                "targetKEKVersion": {
+                    "description": "Master key version the stored keys are re-wrapped with",
+                    "type": "integer"
+                }
+            }
+        },
+        "v1.PKCS11ObjectResponse": {
+            "type": "object",
+            "properties": {
+                "access": {
+                    "description": "Access controls of the object (e.g. sensitive, always sensitive)",
+                    "type": "string"
+                },
+                "label": {
+                    "description": "Label of the object",
+                    "type": "string"
+                },
+                "type": {
+                    "description": "Type of the object (e.g. RSA private key, ECDSA public key, data)",
+                    "type": "string"
+                },
+                "usage": {
+                    "description": "Operations the object may be used for (e.g. encrypt, sign, decrypt)",
+                    "type": "string"
+                }
+            }
+        },
+        "v1.PKCS11OperationRequest": {
+            "type": "object",
+            "required": [
+                "key_type",
+                "object_label",
+                "payload"
+            ],
+            "properties": {
+                "key_type": {
+                    "type": "string",
+                    "enum": [
+                        "RSA",
+                        "ECDSA",
+                        "AES"
+                    ]
+                },
+                "object_label": {
+                    "type": "string"
+                },
+                "payload": {
+                    "type": "array",
+                    "maxItems": 1048576,
+                    "items": {
+                        "type": "integer"
+                    }
+                },
+                "signature": {
+                    "description": "Signature checked by verify",
+                    "type": "array",
+                    "maxItems": 1048576,
+                    "items": {
+                        "type": "integer"
+                    }
+                }
+            }
+        },
+        "v1.PKCS11OperationResponse": {
+            "type": "object",
+            "properties": {
+                "ciphertext": {
+                    "description": "Ciphertext returned by encrypt",
+                    "type": "array",
+                    "items": {
+                        "type": "integer"
+                    }
+                },
+                "keyType": {
+                    "description": "Type of the key pair (RSA, ECDSA)",
+                    "type": "string"
+                },
+                "objectLabel": {
+                    "description": "Label of the key pair that performed the operation",
+                    "type": "string"
+                },
+                "operation": {
+                    "description": "Performed operation (encrypt, decrypt, sign, verify)",
+                    "type": "string"
+                },
+                "plaintext": {
+                    "description": "Plaintext returned by decrypt",
+                    "type": "array",
+                    "items": {
+                        "type": "integer"
+                    }
+                },
+                "signature": {
+                    "description": "Signature returned by sign",
+                    "type": "array",
+                    "items": {
+                        "type": "integer"
+                    }
+                },
+                "tokenLabel": {
+                    "description": "Label of the token that performed the operation",
+                    "type": "string"
+                },
+                "valid": {
+                    "description": "Whether the signature checked by verify is valid",
+                    "type": "boolean"
+                }
+            }
+        },
+        "v1.PKCS11TokenResponse": {
+            "type": "object",
+            "properties": {
+                "label": {
+                    "description": "Label of the token",
+                    "type": "string"
+                },
+                "manufacturer": {
+                    "description": "Manufacturer of the token",
+                    "type": "string"
+                },
+                "model": {
+                    "description": "Model of the token",
+                    "type": "string"
+                },
+                "serialNumber": {
+                    "description": "Serial number of the token",
+                    "type": "string"
+                },
+                "slotID": {
+                    "description": "Identifier of the slot holding the token, e.g. 0x0",
                     "type": "string"
                 }
             }
         },
-        "v1.UploadKeyRequestDto": {
+        "v1.UpdateExportPolicyRequest": {
+            "type": "object",
+            "required": [
+                "plaintext_export_forbidden"
+            ],
+            "properties": {
+                "plaintext_export_forbidden": {
+                    "description": "Whether the key may only be exported wrapped, once set it cannot be cleared",
+                    "type": "boolean"
+                }
+            }
+        },
+        "v1.UpdateKeyLifecycleRequest": {
+            "type": "object",
+            "properties": {
+                "date_time_activation": {
+                    "type": "string"
+                },
+                "date_time_expires": {
+                    "type": "string"
+                },
+                "force": {
+                    "description": "Schedule the destruction even if blobs are still protected with the keys",
+                    "type": "boolean"
+                },
+                "state": {
+                    "type": "string",
+                    "enum": [
+                        "pre-active",
+                        "active",
+                        "suspended",
+                        "deactivated",
+                        "destroyed"
+                    ]
+                }
+            }
+        },
+        "v1.UpdateRotationPolicyRequest": {
+            "type": "object",
+            "properties": {
+                "rotation_period": {
+                    "description": "e.g. \"720h\", empty or \"0\" disables automatic rotation",
+                    "type": "string"
+                }
+            }
+        },
+        "v1.UploadKeyRequest": {
             "type": "object",
             "properties": {
                 "algorithm": {
@@ -588,8 +3410,24 @@ const docTemplate = `{
                         "EC"
                     ]
                 },
+                "date_time_activation": {
+                    "description": "Optional time from which pre-active keys are active",
+                    "type": "string"
+                },
+                "date_time_expires": {
+                    "description": "Optional time from which the keys are deactivated",
+                    "type": "string"
+                },
                 "key_size": {
                     "type": "integer"
+                },
+                "state": {
+                    "description": "Optional initial state, keys are pre-active if their activation is scheduled and active otherwise",
+                    "type": "string",
+                    "enum": [
+                        "pre-active",
+                        "active"
+                    ]
                 }
             }
         }
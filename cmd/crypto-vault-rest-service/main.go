@@ -12,7 +12,11 @@ import (
 	"crypto_vault_service/internal/app/services"
 	"crypto_vault_service/internal/domain/blobs"
 	"crypto_vault_service/internal/domain/keys"
+	"crypto_vault_service/internal/domain/permissions"
+	"crypto_vault_service/internal/infrastructure/authentication"
+	"crypto_vault_service/internal/infrastructure/authorization"
 	"crypto_vault_service/internal/infrastructure/connector"
+	"crypto_vault_service/internal/infrastructure/cryptography"
 	"crypto_vault_service/internal/infrastructure/logger"
 	"crypto_vault_service/internal/infrastructure/settings"
 	"crypto_vault_service/internal/persistence/repository"
@@ -137,8 +141,8 @@ func main() {
 		log.Fatalf("Unsupported database type: %s", config.Database.Type)
 	}
 
-	// Migrate the schema for Blob and CryptoKey
-	err = db.AutoMigrate(&blobs.BlobMeta{}, &keys.CryptoKeyMeta{})
+	// Migrate the schema for Blob, CryptoKey, MasterKeyRotation, LogicalKey, KeyImportKey, RelationTuple and the blob link records
+	err = db.AutoMigrate(&blobs.BlobMeta{}, &keys.CryptoKeyMeta{}, &keys.MasterKeyRotation{}, &keys.LogicalKey{}, &keys.KeyImportKey{}, &permissions.RelationTuple{}, &blobs.BlobLinkSigningKey{}, &blobs.BlobLinkRevocation{})
 	if err != nil {
 		log.Fatalf("Failed to migrate schema: %v", err)
 	}
@@ -151,60 +155,186 @@ func main() {
 	if err != nil {
 		log.Fatalf("Error creating crypto key repository instance: %v", err)
 	}
+	masterKeyRotationRepo, err := repository.NewGormMasterKeyRotationRepository(db, logger)
+	if err != nil {
+		log.Fatalf("Error creating master key rotation repository instance: %v", err)
+	}
+	logicalKeyRepo, err := repository.NewGormLogicalKeyRepository(db, logger)
+	if err != nil {
+		log.Fatalf("Error creating logical key repository instance: %v", err)
+	}
+	keyImportKeyRepo, err := repository.NewGormKeyImportKeyRepository(db, logger)
+	if err != nil {
+		log.Fatalf("Error creating key import key repository instance: %v", err)
+	}
+	relationTupleRepo, err := repository.NewGormRelationTupleRepository(db, logger)
+	if err != nil {
+		log.Fatalf("Error creating relation tuple repository instance: %v", err)
+	}
+	blobLinkSigningKeyRepo, err := repository.NewGormBlobLinkSigningKeyRepository(db, logger)
+	if err != nil {
+		log.Fatalf("Error creating blob link signing key repository instance: %v", err)
+	}
+	blobLinkRevocationRepo, err := repository.NewGormBlobLinkRevocationRepository(db, logger)
+	if err != nil {
+		log.Fatalf("Error creating blob link revocation repository instance: %v", err)
+	}
 
 	ctx := context.Background()
-	var blobConnector connector.BlobConnector
-	if config.BlobConnector.CloudProvider == "azure" {
-		blobConnector, err = connector.NewAzureBlobConnector(ctx, &config.BlobConnector, logger)
-		if err != nil {
-			log.Fatalf("%v", err)
-			return
-		}
+	blobConnector, err := connector.NewBlobConnector(ctx, &config.BlobConnector, logger)
+	if err != nil {
+		log.Fatalf("%v", err)
+		return
 	}
 
-	var vaultConnector connector.VaultConnector
-	if config.BlobConnector.CloudProvider == "azure" {
-		vaultConnector, err = connector.NewAzureVaultConnector(ctx, &config.KeyConnector, logger)
-		if err != nil {
-			log.Fatalf("%v", err)
-			return
-		}
+	vaultConnector, err := connector.NewVaultConnector(ctx, &config.KeyConnector, &config.PKCS11, logger)
+	if err != nil {
+		log.Fatalf("%v", err)
+		return
+	}
+
+	// A first master key version is only generated for vaults not holding any wrapped keys yet
+	initializeMasterKey, err := services.MasterKeyInitializable(ctx, cryptoKeyRepo, keyImportKeyRepo, blobLinkSigningKeyRepo)
+	if err != nil {
+		log.Fatalf("%v", err)
+		return
+	}
+
+	masterKeyProvider, err := cryptography.NewMasterKeyProvider(&config.MasterKey, &config.PKCS11, initializeMasterKey, logger)
+	if err != nil {
+		log.Fatalf("%v", err)
+		return
+	}
+
+	permissionManagement, err := authorization.NewLocalPermissionManagement(relationTupleRepo, logger)
+	if err != nil {
+		log.Fatalf("%v", err)
+		return
+	}
+
+	// Record the ownership of blobs and keys stored before permissions were managed by relationship tuples
+	if err := services.SyncOwnershipTuples(ctx, permissionManagement, blobRepo, cryptoKeyRepo, logger); err != nil {
+		log.Fatalf("%v", err)
+		return
 	}
 
-	blobUploadService, err := services.NewBlobUploadService(blobConnector, blobRepo, vaultConnector, cryptoKeyRepo, logger)
+	blobUploadService, err := services.NewBlobUploadService(blobConnector, blobRepo, vaultConnector, cryptoKeyRepo, logicalKeyRepo, masterKeyProvider, permissionManagement, logger)
+	if err != nil {
+		log.Fatalf("%v", err)
+		return
+	}
+	blobDownloadService, err := services.NewBlobDownloadService(blobConnector, blobRepo, vaultConnector, cryptoKeyRepo, masterKeyProvider, permissionManagement, logger)
+	if err != nil {
+		log.Fatalf("%v", err)
+		return
+	}
+	blobMetadataService, err := services.NewBlobMetadataService(blobRepo, blobConnector, permissionManagement, logger)
+	if err != nil {
+		log.Fatalf("%v", err)
+		return
+	}
+	blobGrantService, err := services.NewBlobGrantService(blobRepo, permissionManagement, logger)
+	if err != nil {
+		log.Fatalf("%v", err)
+		return
+	}
+	blobLinkService, err := services.NewBlobLinkService(blobRepo, blobLinkSigningKeyRepo, blobLinkRevocationRepo, vaultConnector, masterKeyProvider, permissionManagement, logger)
+	if err != nil {
+		log.Fatalf("%v", err)
+		return
+	}
+	cryptoKeyUploadService, err := services.NewCryptoKeyUploadService(vaultConnector, cryptoKeyRepo, logicalKeyRepo, masterKeyProvider, permissionManagement, logger)
+	if err != nil {
+		log.Fatalf("%v", err)
+		return
+	}
+	if err := config.KeyExport.Validate(); err != nil {
+		log.Fatalf("invalid key export settings: %v", err)
+	}
+	trustedWrappingKeys, err := cryptography.ReadRSAWrappingKeys(config.KeyExport.TrustedWrappingKeyFiles)
+	if err != nil {
+		log.Fatalf("%v", err)
+		return
+	}
+	cryptoKeyDownloadService, err := services.NewCryptoKeyDownloadService(vaultConnector, cryptoKeyRepo, logicalKeyRepo, masterKeyProvider, permissionManagement, trustedWrappingKeys, logger)
+	if err != nil {
+		log.Fatalf("%v", err)
+		return
+	}
+	if err := config.KeyDestruction.Validate(); err != nil {
+		log.Fatalf("invalid key destruction settings: %v", err)
+	}
+	cryptoKeyMetadataService, err := services.NewCryptoKeyMetadataService(vaultConnector, cryptoKeyRepo, blobRepo, permissionManagement, config.KeyDestruction.GracePeriod, logger)
+	if err != nil {
+		log.Fatalf("%v", err)
+		return
+	}
+	cryptoKeyDestructionService, err := services.NewCryptoKeyDestructionService(vaultConnector, cryptoKeyRepo, config.KeyDestruction.Interval, logger)
+	if err != nil {
+		log.Fatalf("%v", err)
+		return
+	}
+	if err := config.KeyRotation.Validate(); err != nil {
+		log.Fatalf("invalid key rotation settings: %v", err)
+	}
+	cryptoKeyRotationService, err := services.NewCryptoKeyRotationService(vaultConnector, cryptoKeyRepo, logicalKeyRepo, masterKeyProvider, permissionManagement, config.KeyRotation.Interval, logger)
 	if err != nil {
 		log.Fatalf("%v", err)
 		return
 	}
-	blobDownloadService, err := services.NewBlobDownloadService(blobConnector, blobRepo, vaultConnector, cryptoKeyRepo, logger)
+	cryptoKeyImportService, err := services.NewCryptoKeyImportService(vaultConnector, cryptoKeyRepo, logicalKeyRepo, keyImportKeyRepo, masterKeyProvider, permissionManagement, logger)
 	if err != nil {
 		log.Fatalf("%v", err)
 		return
 	}
-	blobMetadataService, err := services.NewBlobMetadataService(blobRepo, blobConnector, logger)
+	masterKeyRotationService, err := services.NewMasterKeyRotationService(vaultConnector, cryptoKeyRepo, keyImportKeyRepo, blobLinkSigningKeyRepo, masterKeyRotationRepo, masterKeyProvider, logger)
 	if err != nil {
 		log.Fatalf("%v", err)
 		return
 	}
-	cryptoKeyUploadService, err := services.NewCryptoKeyUploadService(vaultConnector, cryptoKeyRepo, logger)
+	cryptoOperationService, err := services.NewCryptoOperationService(vaultConnector, cryptoKeyRepo, logicalKeyRepo, masterKeyProvider, permissionManagement, logger)
 	if err != nil {
 		log.Fatalf("%v", err)
 		return
 	}
-	cryptoKeyDownloadService, err := services.NewCryptoKeyDownloadService(vaultConnector, cryptoKeyRepo, logger)
+	pkcs11Handler, err := cryptography.NewPKCS11Handler(&config.PKCS11, logger)
 	if err != nil {
 		log.Fatalf("%v", err)
 		return
 	}
-	cryptoKeyMetadataService, err := services.NewCryptoKeyMetadataService(vaultConnector, cryptoKeyRepo, logger)
+	// Tokens holding the keys of the vault or the master key are off limits to the admin routes
+	var vaultTokenLabels []string
+	if config.KeyConnector.CloudProvider == "pkcs11" {
+		vaultTokenLabels = append(vaultTokenLabels, config.KeyConnector.TokenLabel)
+	}
+	if config.MasterKey.Source == "pkcs11" {
+		vaultTokenLabels = append(vaultTokenLabels, config.MasterKey.TokenLabel)
+	}
+	pkcs11AdminService, err := services.NewPKCS11AdminService(pkcs11Handler, config.PKCS11.SlotID, vaultTokenLabels, logger)
 	if err != nil {
 		log.Fatalf("%v", err)
 		return
 	}
 
-	v1.SetupRoutes(r, blobUploadService, blobDownloadService, blobMetadataService, cryptoKeyUploadService, cryptoKeyDownloadService, cryptoKeyMetadataService)
+	// Continue re-wrapping stored keys if a previous master key rotation was interrupted
+	if err := masterKeyRotationService.Resume(ctx); err != nil {
+		log.Fatalf("%v", err)
+		return
+	}
+
+	// Delete the material of keys once their scheduled destruction is due
+	go cryptoKeyDestructionService.Run(ctx)
+
+	// Rotate logical keys once their rotation policy is due
+	go cryptoKeyRotationService.Run(ctx)
+
+	tokenValidator, err := authentication.NewJWTValidator(&config.JWT, logger)
+	if err != nil {
+		log.Fatalf("%v", err)
+		return
+	}
 
-	// r.Use(v1.AuthMiddleware())
+	v1.SetupRoutes(r, blobUploadService, blobDownloadService, blobMetadataService, blobGrantService, blobLinkService, cryptoKeyUploadService, cryptoKeyDownloadService, cryptoKeyMetadataService, cryptoKeyRotationService, cryptoKeyImportService, masterKeyRotationService, cryptoOperationService, pkcs11AdminService, tokenValidator)
 
 	docs.SwaggerInfo.Version = v1.Version
 	docs.SwaggerInfo.BasePath = v1.BasePath
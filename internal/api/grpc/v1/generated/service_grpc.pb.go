@@ -4,7 +4,7 @@
 // - protoc             v3.21.12
 // source: internal/service.proto
 
-package __
+package generated
 
 import (
 	context "context"
@@ -27,8 +27,8 @@ const (
 // For semantics around ctx use and closing/ending streaming RPCs, please refer to https://pkg.go.dev/google.golang.org/grpc/?tab=doc#ClientConn.NewStream.
 type BlobUploadClient interface {
 	// Upload a blob
-	// Multipart file uploads are not supported with grpc-gateway. For more details
-	// checkout: https://grpc-ecosystem.github.io/grpc-gateway/docs/mapping/binary_file_uploads/. As a result, no annotations are provided.
+	// Multipart file uploads are not supported with grpc-gateway. For more details,
+	// see: https://grpc-ecosystem.github.io/grpc-gateway/docs/mapping/binary_file_uploads/. As a result, no annotations are provided.
 	Upload(ctx context.Context, in *BlobUploadRequest, opts ...grpc.CallOption) (grpc.ServerStreamingClient[BlobMetaResponse], error)
 }
 
@@ -64,8 +64,8 @@ type BlobUpload_UploadClient = grpc.ServerStreamingClient[BlobMetaResponse]
 // for forward compatibility.
 type BlobUploadServer interface {
 	// Upload a blob
-	// Multipart file uploads are not supported with grpc-gateway. For more details
-	// checkout: https://grpc-ecosystem.github.io/grpc-gateway/docs/mapping/binary_file_uploads/. As a result, no annotations are provided.
+	// Multipart file uploads are not supported with grpc-gateway. For more details,
+	// see: https://grpc-ecosystem.github.io/grpc-gateway/docs/mapping/binary_file_uploads/. As a result, no annotations are provided.
 	Upload(*BlobUploadRequest, grpc.ServerStreamingServer[BlobMetaResponse]) error
 	mustEmbedUnimplementedBlobUploadServer()
 }
@@ -131,6 +131,7 @@ var BlobUpload_ServiceDesc = grpc.ServiceDesc{
 
 const (
 	BlobDownload_DownloadByID_FullMethodName = "/internal.BlobDownload/DownloadByID"
+	BlobDownload_VerifyByID_FullMethodName   = "/internal.BlobDownload/VerifyByID"
 )
 
 // BlobDownloadClient is the client API for BlobDownload service.
@@ -139,6 +140,8 @@ const (
 type BlobDownloadClient interface {
 	// Download a blob by ID
 	DownloadByID(ctx context.Context, in *BlobDownloadRequest, opts ...grpc.CallOption) (grpc.ServerStreamingClient[BlobContent], error)
+	// Verify the detached signature of a blob by ID
+	VerifyByID(ctx context.Context, in *BlobVerifyRequest, opts ...grpc.CallOption) (*BlobVerifyResponse, error)
 }
 
 type blobDownloadClient struct {
@@ -168,12 +171,24 @@ func (c *blobDownloadClient) DownloadByID(ctx context.Context, in *BlobDownloadR
 // This type alias is provided for backwards compatibility with existing code that references the prior non-generic stream type by name.
 type BlobDownload_DownloadByIDClient = grpc.ServerStreamingClient[BlobContent]
 
+func (c *blobDownloadClient) VerifyByID(ctx context.Context, in *BlobVerifyRequest, opts ...grpc.CallOption) (*BlobVerifyResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(BlobVerifyResponse)
+	err := c.cc.Invoke(ctx, BlobDownload_VerifyByID_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 // BlobDownloadServer is the server API for BlobDownload service.
 // All implementations must embed UnimplementedBlobDownloadServer
 // for forward compatibility.
 type BlobDownloadServer interface {
 	// Download a blob by ID
 	DownloadByID(*BlobDownloadRequest, grpc.ServerStreamingServer[BlobContent]) error
+	// Verify the detached signature of a blob by ID
+	VerifyByID(context.Context, *BlobVerifyRequest) (*BlobVerifyResponse, error)
 	mustEmbedUnimplementedBlobDownloadServer()
 }
 
@@ -187,6 +202,9 @@ type UnimplementedBlobDownloadServer struct{}
 func (UnimplementedBlobDownloadServer) DownloadByID(*BlobDownloadRequest, grpc.ServerStreamingServer[BlobContent]) error {
 	return status.Errorf(codes.Unimplemented, "method DownloadByID not implemented")
 }
+func (UnimplementedBlobDownloadServer) VerifyByID(context.Context, *BlobVerifyRequest) (*BlobVerifyResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method VerifyByID not implemented")
+}
 func (UnimplementedBlobDownloadServer) mustEmbedUnimplementedBlobDownloadServer() {}
 func (UnimplementedBlobDownloadServer) testEmbeddedByValue()                      {}
 
@@ -219,13 +237,36 @@ func _BlobDownload_DownloadByID_Handler(srv interface{}, stream grpc.ServerStrea
 // This type alias is provided for backwards compatibility with existing code that references the prior non-generic stream type by name.
 type BlobDownload_DownloadByIDServer = grpc.ServerStreamingServer[BlobContent]
 
+func _BlobDownload_VerifyByID_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(BlobVerifyRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(BlobDownloadServer).VerifyByID(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: BlobDownload_VerifyByID_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(BlobDownloadServer).VerifyByID(ctx, req.(*BlobVerifyRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 // BlobDownload_ServiceDesc is the grpc.ServiceDesc for BlobDownload service.
 // It's only intended for direct use with grpc.RegisterService,
 // and not to be introspected or modified (even as a copy)
 var BlobDownload_ServiceDesc = grpc.ServiceDesc{
 	ServiceName: "internal.BlobDownload",
 	HandlerType: (*BlobDownloadServer)(nil),
-	Methods:     []grpc.MethodDesc{},
+	Methods: []grpc.MethodDesc{
+		{
+			MethodName: "VerifyByID",
+			Handler:    _BlobDownload_VerifyByID_Handler,
+		},
+	},
 	Streams: []grpc.StreamDesc{
 		{
 			StreamName:    "DownloadByID",
@@ -424,6 +465,194 @@ var BlobMetadata_ServiceDesc = grpc.ServiceDesc{
 	Metadata: "internal/service.proto",
 }
 
+const (
+	BlobGrant_CreateGrant_FullMethodName = "/internal.BlobGrant/CreateGrant"
+	BlobGrant_ListGrants_FullMethodName  = "/internal.BlobGrant/ListGrants"
+	BlobGrant_DeleteGrant_FullMethodName = "/internal.BlobGrant/DeleteGrant"
+)
+
+// BlobGrantClient is the client API for BlobGrant service.
+//
+// For semantics around ctx use and closing/ending streaming RPCs, please refer to https://pkg.go.dev/google.golang.org/grpc/?tab=doc#ClientConn.NewStream.
+type BlobGrantClient interface {
+	// Share a blob with a user or group, replacing an existing grant of the grantee
+	CreateGrant(ctx context.Context, in *BlobGrantRequest, opts ...grpc.CallOption) (*BlobGrantResponse, error)
+	// List the grants of a blob
+	ListGrants(ctx context.Context, in *IdRequest, opts ...grpc.CallOption) (grpc.ServerStreamingClient[BlobGrantResponse], error)
+	// Revoke the grant of a user or group for a blob
+	DeleteGrant(ctx context.Context, in *BlobGrantDeleteRequest, opts ...grpc.CallOption) (*InfoResponse, error)
+}
+
+type blobGrantClient struct {
+	cc grpc.ClientConnInterface
+}
+
+func NewBlobGrantClient(cc grpc.ClientConnInterface) BlobGrantClient {
+	return &blobGrantClient{cc}
+}
+
+func (c *blobGrantClient) CreateGrant(ctx context.Context, in *BlobGrantRequest, opts ...grpc.CallOption) (*BlobGrantResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(BlobGrantResponse)
+	err := c.cc.Invoke(ctx, BlobGrant_CreateGrant_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *blobGrantClient) ListGrants(ctx context.Context, in *IdRequest, opts ...grpc.CallOption) (grpc.ServerStreamingClient[BlobGrantResponse], error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	stream, err := c.cc.NewStream(ctx, &BlobGrant_ServiceDesc.Streams[0], BlobGrant_ListGrants_FullMethodName, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	x := &grpc.GenericClientStream[IdRequest, BlobGrantResponse]{ClientStream: stream}
+	if err := x.ClientStream.SendMsg(in); err != nil {
+		return nil, err
+	}
+	if err := x.ClientStream.CloseSend(); err != nil {
+		return nil, err
+	}
+	return x, nil
+}
+
+// This type alias is provided for backwards compatibility with existing code that references the prior non-generic stream type by name.
+type BlobGrant_ListGrantsClient = grpc.ServerStreamingClient[BlobGrantResponse]
+
+func (c *blobGrantClient) DeleteGrant(ctx context.Context, in *BlobGrantDeleteRequest, opts ...grpc.CallOption) (*InfoResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(InfoResponse)
+	err := c.cc.Invoke(ctx, BlobGrant_DeleteGrant_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+// BlobGrantServer is the server API for BlobGrant service.
+// All implementations must embed UnimplementedBlobGrantServer
+// for forward compatibility.
+type BlobGrantServer interface {
+	// Share a blob with a user or group, replacing an existing grant of the grantee
+	CreateGrant(context.Context, *BlobGrantRequest) (*BlobGrantResponse, error)
+	// List the grants of a blob
+	ListGrants(*IdRequest, grpc.ServerStreamingServer[BlobGrantResponse]) error
+	// Revoke the grant of a user or group for a blob
+	DeleteGrant(context.Context, *BlobGrantDeleteRequest) (*InfoResponse, error)
+	mustEmbedUnimplementedBlobGrantServer()
+}
+
+// UnimplementedBlobGrantServer must be embedded to have
+// forward compatible implementations.
+//
+// NOTE: this should be embedded by value instead of pointer to avoid a nil
+// pointer dereference when methods are called.
+type UnimplementedBlobGrantServer struct{}
+
+func (UnimplementedBlobGrantServer) CreateGrant(context.Context, *BlobGrantRequest) (*BlobGrantResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CreateGrant not implemented")
+}
+func (UnimplementedBlobGrantServer) ListGrants(*IdRequest, grpc.ServerStreamingServer[BlobGrantResponse]) error {
+	return status.Errorf(codes.Unimplemented, "method ListGrants not implemented")
+}
+func (UnimplementedBlobGrantServer) DeleteGrant(context.Context, *BlobGrantDeleteRequest) (*InfoResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method DeleteGrant not implemented")
+}
+func (UnimplementedBlobGrantServer) mustEmbedUnimplementedBlobGrantServer() {}
+func (UnimplementedBlobGrantServer) testEmbeddedByValue()                   {}
+
+// UnsafeBlobGrantServer may be embedded to opt out of forward compatibility for this service.
+// Use of this interface is not recommended, as added methods to BlobGrantServer will
+// result in compilation errors.
+type UnsafeBlobGrantServer interface {
+	mustEmbedUnimplementedBlobGrantServer()
+}
+
+func RegisterBlobGrantServer(s grpc.ServiceRegistrar, srv BlobGrantServer) {
+	// If the following call pancis, it indicates UnimplementedBlobGrantServer was
+	// embedded by pointer and is nil.  This will cause panics if an
+	// unimplemented method is ever invoked, so we test this at initialization
+	// time to prevent it from happening at runtime later due to I/O.
+	if t, ok := srv.(interface{ testEmbeddedByValue() }); ok {
+		t.testEmbeddedByValue()
+	}
+	s.RegisterService(&BlobGrant_ServiceDesc, srv)
+}
+
+func _BlobGrant_CreateGrant_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(BlobGrantRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(BlobGrantServer).CreateGrant(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: BlobGrant_CreateGrant_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(BlobGrantServer).CreateGrant(ctx, req.(*BlobGrantRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _BlobGrant_ListGrants_Handler(srv interface{}, stream grpc.ServerStream) error {
+	m := new(IdRequest)
+	if err := stream.RecvMsg(m); err != nil {
+		return err
+	}
+	return srv.(BlobGrantServer).ListGrants(m, &grpc.GenericServerStream[IdRequest, BlobGrantResponse]{ServerStream: stream})
+}
+
+// This type alias is provided for backwards compatibility with existing code that references the prior non-generic stream type by name.
+type BlobGrant_ListGrantsServer = grpc.ServerStreamingServer[BlobGrantResponse]
+
+func _BlobGrant_DeleteGrant_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(BlobGrantDeleteRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(BlobGrantServer).DeleteGrant(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: BlobGrant_DeleteGrant_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(BlobGrantServer).DeleteGrant(ctx, req.(*BlobGrantDeleteRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+// BlobGrant_ServiceDesc is the grpc.ServiceDesc for BlobGrant service.
+// It's only intended for direct use with grpc.RegisterService,
+// and not to be introspected or modified (even as a copy)
+var BlobGrant_ServiceDesc = grpc.ServiceDesc{
+	ServiceName: "internal.BlobGrant",
+	HandlerType: (*BlobGrantServer)(nil),
+	Methods: []grpc.MethodDesc{
+		{
+			MethodName: "CreateGrant",
+			Handler:    _BlobGrant_CreateGrant_Handler,
+		},
+		{
+			MethodName: "DeleteGrant",
+			Handler:    _BlobGrant_DeleteGrant_Handler,
+		},
+	},
+	Streams: []grpc.StreamDesc{
+		{
+			StreamName:    "ListGrants",
+			Handler:       _BlobGrant_ListGrants_Handler,
+			ServerStreams: true,
+		},
+	},
+	Metadata: "internal/service.proto",
+}
+
 const (
 	CryptoKeyUpload_Upload_FullMethodName = "/internal.CryptoKeyUpload/Upload"
 )
@@ -532,7 +761,8 @@ var CryptoKeyUpload_ServiceDesc = grpc.ServiceDesc{
 }
 
 const (
-	CryptoKeyDownload_DownloadByID_FullMethodName = "/internal.CryptoKeyDownload/DownloadByID"
+	CryptoKeyDownload_DownloadByID_FullMethodName      = "/internal.CryptoKeyDownload/DownloadByID"
+	CryptoKeyDownload_ExportWrappedByID_FullMethodName = "/internal.CryptoKeyDownload/ExportWrappedByID"
 )
 
 // CryptoKeyDownloadClient is the client API for CryptoKeyDownload service.
@@ -541,6 +771,8 @@ const (
 type CryptoKeyDownloadClient interface {
 	// Download crypto key by ID
 	DownloadByID(ctx context.Context, in *KeyDownloadRequest, opts ...grpc.CallOption) (grpc.ServerStreamingClient[KeyContent], error)
+	// Export crypto key by ID wrapped with a vault AES key or an RSA public key
+	ExportWrappedByID(ctx context.Context, in *KeyExportRequest, opts ...grpc.CallOption) (*WrappedKeyContent, error)
 }
 
 type cryptoKeyDownloadClient struct {
@@ -570,12 +802,24 @@ func (c *cryptoKeyDownloadClient) DownloadByID(ctx context.Context, in *KeyDownl
 // This type alias is provided for backwards compatibility with existing code that references the prior non-generic stream type by name.
 type CryptoKeyDownload_DownloadByIDClient = grpc.ServerStreamingClient[KeyContent]
 
+func (c *cryptoKeyDownloadClient) ExportWrappedByID(ctx context.Context, in *KeyExportRequest, opts ...grpc.CallOption) (*WrappedKeyContent, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(WrappedKeyContent)
+	err := c.cc.Invoke(ctx, CryptoKeyDownload_ExportWrappedByID_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 // CryptoKeyDownloadServer is the server API for CryptoKeyDownload service.
 // All implementations must embed UnimplementedCryptoKeyDownloadServer
 // for forward compatibility.
 type CryptoKeyDownloadServer interface {
 	// Download crypto key by ID
 	DownloadByID(*KeyDownloadRequest, grpc.ServerStreamingServer[KeyContent]) error
+	// Export crypto key by ID wrapped with a vault AES key or an RSA public key
+	ExportWrappedByID(context.Context, *KeyExportRequest) (*WrappedKeyContent, error)
 	mustEmbedUnimplementedCryptoKeyDownloadServer()
 }
 
@@ -589,6 +833,9 @@ type UnimplementedCryptoKeyDownloadServer struct{}
 func (UnimplementedCryptoKeyDownloadServer) DownloadByID(*KeyDownloadRequest, grpc.ServerStreamingServer[KeyContent]) error {
 	return status.Errorf(codes.Unimplemented, "method DownloadByID not implemented")
 }
+func (UnimplementedCryptoKeyDownloadServer) ExportWrappedByID(context.Context, *KeyExportRequest) (*WrappedKeyContent, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ExportWrappedByID not implemented")
+}
 func (UnimplementedCryptoKeyDownloadServer) mustEmbedUnimplementedCryptoKeyDownloadServer() {}
 func (UnimplementedCryptoKeyDownloadServer) testEmbeddedByValue()                           {}
 
@@ -621,13 +868,36 @@ func _CryptoKeyDownload_DownloadByID_Handler(srv interface{}, stream grpc.Server
 // This type alias is provided for backwards compatibility with existing code that references the prior non-generic stream type by name.
 type CryptoKeyDownload_DownloadByIDServer = grpc.ServerStreamingServer[KeyContent]
 
+func _CryptoKeyDownload_ExportWrappedByID_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(KeyExportRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(CryptoKeyDownloadServer).ExportWrappedByID(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: CryptoKeyDownload_ExportWrappedByID_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(CryptoKeyDownloadServer).ExportWrappedByID(ctx, req.(*KeyExportRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 // CryptoKeyDownload_ServiceDesc is the grpc.ServiceDesc for CryptoKeyDownload service.
 // It's only intended for direct use with grpc.RegisterService,
 // and not to be introspected or modified (even as a copy)
 var CryptoKeyDownload_ServiceDesc = grpc.ServiceDesc{
 	ServiceName: "internal.CryptoKeyDownload",
 	HandlerType: (*CryptoKeyDownloadServer)(nil),
-	Methods:     []grpc.MethodDesc{},
+	Methods: []grpc.MethodDesc{
+		{
+			MethodName: "ExportWrappedByID",
+			Handler:    _CryptoKeyDownload_ExportWrappedByID_Handler,
+		},
+	},
 	Streams: []grpc.StreamDesc{
 		{
 			StreamName:    "DownloadByID",
@@ -639,9 +909,12 @@ var CryptoKeyDownload_ServiceDesc = grpc.ServiceDesc{
 }
 
 const (
-	CryptoKeyMetadata_ListMetadata_FullMethodName    = "/internal.CryptoKeyMetadata/ListMetadata"
-	CryptoKeyMetadata_GetMetadataByID_FullMethodName = "/internal.CryptoKeyMetadata/GetMetadataByID"
-	CryptoKeyMetadata_DeleteByID_FullMethodName      = "/internal.CryptoKeyMetadata/DeleteByID"
+	CryptoKeyMetadata_ListMetadata_FullMethodName       = "/internal.CryptoKeyMetadata/ListMetadata"
+	CryptoKeyMetadata_GetMetadataByID_FullMethodName    = "/internal.CryptoKeyMetadata/GetMetadataByID"
+	CryptoKeyMetadata_DeleteByID_FullMethodName         = "/internal.CryptoKeyMetadata/DeleteByID"
+	CryptoKeyMetadata_CancelDestruction_FullMethodName  = "/internal.CryptoKeyMetadata/CancelDestruction"
+	CryptoKeyMetadata_UpdateLifecycle_FullMethodName    = "/internal.CryptoKeyMetadata/UpdateLifecycle"
+	CryptoKeyMetadata_UpdateExportPolicy_FullMethodName = "/internal.CryptoKeyMetadata/UpdateExportPolicy"
 )
 
 // CryptoKeyMetadataClient is the client API for CryptoKeyMetadata service.
@@ -652,8 +925,14 @@ type CryptoKeyMetadataClient interface {
 	ListMetadata(ctx context.Context, in *KeyMetadataQuery, opts ...grpc.CallOption) (grpc.ServerStreamingClient[CryptoKeyMetaResponse], error)
 	// Get metadata by ID
 	GetMetadataByID(ctx context.Context, in *IdRequest, opts ...grpc.CallOption) (*CryptoKeyMetaResponse, error)
-	// Delete crypto key by ID
-	DeleteByID(ctx context.Context, in *IdRequest, opts ...grpc.CallOption) (*InfoResponse, error)
+	// Schedule the destruction of the key pair a crypto key belongs to once the grace period has passed
+	DeleteByID(ctx context.Context, in *DeleteKeyRequest, opts ...grpc.CallOption) (grpc.ServerStreamingClient[CryptoKeyMetaResponse], error)
+	// Cancel the scheduled destruction of the key pair a crypto key belongs to
+	CancelDestruction(ctx context.Context, in *IdRequest, opts ...grpc.CallOption) (grpc.ServerStreamingClient[CryptoKeyMetaResponse], error)
+	// Transition the lifecycle state of the key pair a crypto key belongs to and reschedule its activation or expiry
+	UpdateLifecycle(ctx context.Context, in *UpdateKeyLifecycleRequest, opts ...grpc.CallOption) (grpc.ServerStreamingClient[CryptoKeyMetaResponse], error)
+	// Forbid the plaintext export of the key pair a crypto key belongs to
+	UpdateExportPolicy(ctx context.Context, in *UpdateExportPolicyRequest, opts ...grpc.CallOption) (grpc.ServerStreamingClient[CryptoKeyMetaResponse], error)
 }
 
 type cryptoKeyMetadataClient struct {
@@ -693,16 +972,82 @@ func (c *cryptoKeyMetadataClient) GetMetadataByID(ctx context.Context, in *IdReq
 	return out, nil
 }
 
-func (c *cryptoKeyMetadataClient) DeleteByID(ctx context.Context, in *IdRequest, opts ...grpc.CallOption) (*InfoResponse, error) {
+func (c *cryptoKeyMetadataClient) DeleteByID(ctx context.Context, in *DeleteKeyRequest, opts ...grpc.CallOption) (grpc.ServerStreamingClient[CryptoKeyMetaResponse], error) {
 	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
-	out := new(InfoResponse)
-	err := c.cc.Invoke(ctx, CryptoKeyMetadata_DeleteByID_FullMethodName, in, out, cOpts...)
+	stream, err := c.cc.NewStream(ctx, &CryptoKeyMetadata_ServiceDesc.Streams[1], CryptoKeyMetadata_DeleteByID_FullMethodName, cOpts...)
 	if err != nil {
 		return nil, err
 	}
-	return out, nil
+	x := &grpc.GenericClientStream[DeleteKeyRequest, CryptoKeyMetaResponse]{ClientStream: stream}
+	if err := x.ClientStream.SendMsg(in); err != nil {
+		return nil, err
+	}
+	if err := x.ClientStream.CloseSend(); err != nil {
+		return nil, err
+	}
+	return x, nil
+}
+
+// This type alias is provided for backwards compatibility with existing code that references the prior non-generic stream type by name.
+type CryptoKeyMetadata_DeleteByIDClient = grpc.ServerStreamingClient[CryptoKeyMetaResponse]
+
+func (c *cryptoKeyMetadataClient) CancelDestruction(ctx context.Context, in *IdRequest, opts ...grpc.CallOption) (grpc.ServerStreamingClient[CryptoKeyMetaResponse], error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	stream, err := c.cc.NewStream(ctx, &CryptoKeyMetadata_ServiceDesc.Streams[2], CryptoKeyMetadata_CancelDestruction_FullMethodName, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	x := &grpc.GenericClientStream[IdRequest, CryptoKeyMetaResponse]{ClientStream: stream}
+	if err := x.ClientStream.SendMsg(in); err != nil {
+		return nil, err
+	}
+	if err := x.ClientStream.CloseSend(); err != nil {
+		return nil, err
+	}
+	return x, nil
+}
+
+// This type alias is provided for backwards compatibility with existing code that references the prior non-generic stream type by name.
+type CryptoKeyMetadata_CancelDestructionClient = grpc.ServerStreamingClient[CryptoKeyMetaResponse]
+
+func (c *cryptoKeyMetadataClient) UpdateLifecycle(ctx context.Context, in *UpdateKeyLifecycleRequest, opts ...grpc.CallOption) (grpc.ServerStreamingClient[CryptoKeyMetaResponse], error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	stream, err := c.cc.NewStream(ctx, &CryptoKeyMetadata_ServiceDesc.Streams[3], CryptoKeyMetadata_UpdateLifecycle_FullMethodName, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	x := &grpc.GenericClientStream[UpdateKeyLifecycleRequest, CryptoKeyMetaResponse]{ClientStream: stream}
+	if err := x.ClientStream.SendMsg(in); err != nil {
+		return nil, err
+	}
+	if err := x.ClientStream.CloseSend(); err != nil {
+		return nil, err
+	}
+	return x, nil
+}
+
+// This type alias is provided for backwards compatibility with existing code that references the prior non-generic stream type by name.
+type CryptoKeyMetadata_UpdateLifecycleClient = grpc.ServerStreamingClient[CryptoKeyMetaResponse]
+
+func (c *cryptoKeyMetadataClient) UpdateExportPolicy(ctx context.Context, in *UpdateExportPolicyRequest, opts ...grpc.CallOption) (grpc.ServerStreamingClient[CryptoKeyMetaResponse], error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	stream, err := c.cc.NewStream(ctx, &CryptoKeyMetadata_ServiceDesc.Streams[4], CryptoKeyMetadata_UpdateExportPolicy_FullMethodName, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	x := &grpc.GenericClientStream[UpdateExportPolicyRequest, CryptoKeyMetaResponse]{ClientStream: stream}
+	if err := x.ClientStream.SendMsg(in); err != nil {
+		return nil, err
+	}
+	if err := x.ClientStream.CloseSend(); err != nil {
+		return nil, err
+	}
+	return x, nil
 }
 
+// This type alias is provided for backwards compatibility with existing code that references the prior non-generic stream type by name.
+type CryptoKeyMetadata_UpdateExportPolicyClient = grpc.ServerStreamingClient[CryptoKeyMetaResponse]
+
 // CryptoKeyMetadataServer is the server API for CryptoKeyMetadata service.
 // All implementations must embed UnimplementedCryptoKeyMetadataServer
 // for forward compatibility.
@@ -711,8 +1056,14 @@ type CryptoKeyMetadataServer interface {
 	ListMetadata(*KeyMetadataQuery, grpc.ServerStreamingServer[CryptoKeyMetaResponse]) error
 	// Get metadata by ID
 	GetMetadataByID(context.Context, *IdRequest) (*CryptoKeyMetaResponse, error)
-	// Delete crypto key by ID
-	DeleteByID(context.Context, *IdRequest) (*InfoResponse, error)
+	// Schedule the destruction of the key pair a crypto key belongs to once the grace period has passed
+	DeleteByID(*DeleteKeyRequest, grpc.ServerStreamingServer[CryptoKeyMetaResponse]) error
+	// Cancel the scheduled destruction of the key pair a crypto key belongs to
+	CancelDestruction(*IdRequest, grpc.ServerStreamingServer[CryptoKeyMetaResponse]) error
+	// Transition the lifecycle state of the key pair a crypto key belongs to and reschedule its activation or expiry
+	UpdateLifecycle(*UpdateKeyLifecycleRequest, grpc.ServerStreamingServer[CryptoKeyMetaResponse]) error
+	// Forbid the plaintext export of the key pair a crypto key belongs to
+	UpdateExportPolicy(*UpdateExportPolicyRequest, grpc.ServerStreamingServer[CryptoKeyMetaResponse]) error
 	mustEmbedUnimplementedCryptoKeyMetadataServer()
 }
 
@@ -729,8 +1080,17 @@ func (UnimplementedCryptoKeyMetadataServer) ListMetadata(*KeyMetadataQuery, grpc
 func (UnimplementedCryptoKeyMetadataServer) GetMetadataByID(context.Context, *IdRequest) (*CryptoKeyMetaResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method GetMetadataByID not implemented")
 }
-func (UnimplementedCryptoKeyMetadataServer) DeleteByID(context.Context, *IdRequest) (*InfoResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method DeleteByID not implemented")
+func (UnimplementedCryptoKeyMetadataServer) DeleteByID(*DeleteKeyRequest, grpc.ServerStreamingServer[CryptoKeyMetaResponse]) error {
+	return status.Errorf(codes.Unimplemented, "method DeleteByID not implemented")
+}
+func (UnimplementedCryptoKeyMetadataServer) CancelDestruction(*IdRequest, grpc.ServerStreamingServer[CryptoKeyMetaResponse]) error {
+	return status.Errorf(codes.Unimplemented, "method CancelDestruction not implemented")
+}
+func (UnimplementedCryptoKeyMetadataServer) UpdateLifecycle(*UpdateKeyLifecycleRequest, grpc.ServerStreamingServer[CryptoKeyMetaResponse]) error {
+	return status.Errorf(codes.Unimplemented, "method UpdateLifecycle not implemented")
+}
+func (UnimplementedCryptoKeyMetadataServer) UpdateExportPolicy(*UpdateExportPolicyRequest, grpc.ServerStreamingServer[CryptoKeyMetaResponse]) error {
+	return status.Errorf(codes.Unimplemented, "method UpdateExportPolicy not implemented")
 }
 func (UnimplementedCryptoKeyMetadataServer) mustEmbedUnimplementedCryptoKeyMetadataServer() {}
 func (UnimplementedCryptoKeyMetadataServer) testEmbeddedByValue()                           {}
@@ -782,24 +1142,50 @@ func _CryptoKeyMetadata_GetMetadataByID_Handler(srv interface{}, ctx context.Con
 	return interceptor(ctx, in, info, handler)
 }
 
-func _CryptoKeyMetadata_DeleteByID_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(IdRequest)
-	if err := dec(in); err != nil {
-		return nil, err
+func _CryptoKeyMetadata_DeleteByID_Handler(srv interface{}, stream grpc.ServerStream) error {
+	m := new(DeleteKeyRequest)
+	if err := stream.RecvMsg(m); err != nil {
+		return err
 	}
-	if interceptor == nil {
-		return srv.(CryptoKeyMetadataServer).DeleteByID(ctx, in)
+	return srv.(CryptoKeyMetadataServer).DeleteByID(m, &grpc.GenericServerStream[DeleteKeyRequest, CryptoKeyMetaResponse]{ServerStream: stream})
+}
+
+// This type alias is provided for backwards compatibility with existing code that references the prior non-generic stream type by name.
+type CryptoKeyMetadata_DeleteByIDServer = grpc.ServerStreamingServer[CryptoKeyMetaResponse]
+
+func _CryptoKeyMetadata_CancelDestruction_Handler(srv interface{}, stream grpc.ServerStream) error {
+	m := new(IdRequest)
+	if err := stream.RecvMsg(m); err != nil {
+		return err
 	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: CryptoKeyMetadata_DeleteByID_FullMethodName,
+	return srv.(CryptoKeyMetadataServer).CancelDestruction(m, &grpc.GenericServerStream[IdRequest, CryptoKeyMetaResponse]{ServerStream: stream})
+}
+
+// This type alias is provided for backwards compatibility with existing code that references the prior non-generic stream type by name.
+type CryptoKeyMetadata_CancelDestructionServer = grpc.ServerStreamingServer[CryptoKeyMetaResponse]
+
+func _CryptoKeyMetadata_UpdateLifecycle_Handler(srv interface{}, stream grpc.ServerStream) error {
+	m := new(UpdateKeyLifecycleRequest)
+	if err := stream.RecvMsg(m); err != nil {
+		return err
 	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(CryptoKeyMetadataServer).DeleteByID(ctx, req.(*IdRequest))
+	return srv.(CryptoKeyMetadataServer).UpdateLifecycle(m, &grpc.GenericServerStream[UpdateKeyLifecycleRequest, CryptoKeyMetaResponse]{ServerStream: stream})
+}
+
+// This type alias is provided for backwards compatibility with existing code that references the prior non-generic stream type by name.
+type CryptoKeyMetadata_UpdateLifecycleServer = grpc.ServerStreamingServer[CryptoKeyMetaResponse]
+
+func _CryptoKeyMetadata_UpdateExportPolicy_Handler(srv interface{}, stream grpc.ServerStream) error {
+	m := new(UpdateExportPolicyRequest)
+	if err := stream.RecvMsg(m); err != nil {
+		return err
 	}
-	return interceptor(ctx, in, info, handler)
+	return srv.(CryptoKeyMetadataServer).UpdateExportPolicy(m, &grpc.GenericServerStream[UpdateExportPolicyRequest, CryptoKeyMetaResponse]{ServerStream: stream})
 }
 
+// This type alias is provided for backwards compatibility with existing code that references the prior non-generic stream type by name.
+type CryptoKeyMetadata_UpdateExportPolicyServer = grpc.ServerStreamingServer[CryptoKeyMetaResponse]
+
 // CryptoKeyMetadata_ServiceDesc is the grpc.ServiceDesc for CryptoKeyMetadata service.
 // It's only intended for direct use with grpc.RegisterService,
 // and not to be introspected or modified (even as a copy)
@@ -811,10 +1197,6 @@ var CryptoKeyMetadata_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "GetMetadataByID",
 			Handler:    _CryptoKeyMetadata_GetMetadataByID_Handler,
 		},
-		{
-			MethodName: "DeleteByID",
-			Handler:    _CryptoKeyMetadata_DeleteByID_Handler,
-		},
 	},
 	Streams: []grpc.StreamDesc{
 		{
@@ -822,6 +1204,1244 @@ var CryptoKeyMetadata_ServiceDesc = grpc.ServiceDesc{
 			Handler:       _CryptoKeyMetadata_ListMetadata_Handler,
 			ServerStreams: true,
 		},
+		{
+			StreamName:    "DeleteByID",
+			Handler:       _CryptoKeyMetadata_DeleteByID_Handler,
+			ServerStreams: true,
+		},
+		{
+			StreamName:    "CancelDestruction",
+			Handler:       _CryptoKeyMetadata_CancelDestruction_Handler,
+			ServerStreams: true,
+		},
+		{
+			StreamName:    "UpdateLifecycle",
+			Handler:       _CryptoKeyMetadata_UpdateLifecycle_Handler,
+			ServerStreams: true,
+		},
+		{
+			StreamName:    "UpdateExportPolicy",
+			Handler:       _CryptoKeyMetadata_UpdateExportPolicy_Handler,
+			ServerStreams: true,
+		},
+	},
+	Metadata: "internal/service.proto",
+}
+
+const (
+	CryptoKeyRotation_Rotate_FullMethodName               = "/internal.CryptoKeyRotation/Rotate"
+	CryptoKeyRotation_ListVersions_FullMethodName         = "/internal.CryptoKeyRotation/ListVersions"
+	CryptoKeyRotation_UpdateRotationPolicy_FullMethodName = "/internal.CryptoKeyRotation/UpdateRotationPolicy"
+)
+
+// CryptoKeyRotationClient is the client API for CryptoKeyRotation service.
+//
+// For semantics around ctx use and closing/ending streaming RPCs, please refer to https://pkg.go.dev/google.golang.org/grpc/?tab=doc#ClientConn.NewStream.
+type CryptoKeyRotationClient interface {
+	// Generate a new version of the logical key a crypto key belongs to and make it the primary version
+	Rotate(ctx context.Context, in *IdRequest, opts ...grpc.CallOption) (grpc.ServerStreamingClient[CryptoKeyMetaResponse], error)
+	// List the metadata of all versions of the logical key a crypto key belongs to
+	ListVersions(ctx context.Context, in *IdRequest, opts ...grpc.CallOption) (grpc.ServerStreamingClient[CryptoKeyMetaResponse], error)
+	// Set the period after which the logical key a crypto key belongs to is rotated automatically
+	UpdateRotationPolicy(ctx context.Context, in *UpdateRotationPolicyRequest, opts ...grpc.CallOption) (*LogicalKeyResponse, error)
+}
+
+type cryptoKeyRotationClient struct {
+	cc grpc.ClientConnInterface
+}
+
+func NewCryptoKeyRotationClient(cc grpc.ClientConnInterface) CryptoKeyRotationClient {
+	return &cryptoKeyRotationClient{cc}
+}
+
+func (c *cryptoKeyRotationClient) Rotate(ctx context.Context, in *IdRequest, opts ...grpc.CallOption) (grpc.ServerStreamingClient[CryptoKeyMetaResponse], error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	stream, err := c.cc.NewStream(ctx, &CryptoKeyRotation_ServiceDesc.Streams[0], CryptoKeyRotation_Rotate_FullMethodName, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	x := &grpc.GenericClientStream[IdRequest, CryptoKeyMetaResponse]{ClientStream: stream}
+	if err := x.ClientStream.SendMsg(in); err != nil {
+		return nil, err
+	}
+	if err := x.ClientStream.CloseSend(); err != nil {
+		return nil, err
+	}
+	return x, nil
+}
+
+// This type alias is provided for backwards compatibility with existing code that references the prior non-generic stream type by name.
+type CryptoKeyRotation_RotateClient = grpc.ServerStreamingClient[CryptoKeyMetaResponse]
+
+func (c *cryptoKeyRotationClient) ListVersions(ctx context.Context, in *IdRequest, opts ...grpc.CallOption) (grpc.ServerStreamingClient[CryptoKeyMetaResponse], error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	stream, err := c.cc.NewStream(ctx, &CryptoKeyRotation_ServiceDesc.Streams[1], CryptoKeyRotation_ListVersions_FullMethodName, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	x := &grpc.GenericClientStream[IdRequest, CryptoKeyMetaResponse]{ClientStream: stream}
+	if err := x.ClientStream.SendMsg(in); err != nil {
+		return nil, err
+	}
+	if err := x.ClientStream.CloseSend(); err != nil {
+		return nil, err
+	}
+	return x, nil
+}
+
+// This type alias is provided for backwards compatibility with existing code that references the prior non-generic stream type by name.
+type CryptoKeyRotation_ListVersionsClient = grpc.ServerStreamingClient[CryptoKeyMetaResponse]
+
+func (c *cryptoKeyRotationClient) UpdateRotationPolicy(ctx context.Context, in *UpdateRotationPolicyRequest, opts ...grpc.CallOption) (*LogicalKeyResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(LogicalKeyResponse)
+	err := c.cc.Invoke(ctx, CryptoKeyRotation_UpdateRotationPolicy_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+// CryptoKeyRotationServer is the server API for CryptoKeyRotation service.
+// All implementations must embed UnimplementedCryptoKeyRotationServer
+// for forward compatibility.
+type CryptoKeyRotationServer interface {
+	// Generate a new version of the logical key a crypto key belongs to and make it the primary version
+	Rotate(*IdRequest, grpc.ServerStreamingServer[CryptoKeyMetaResponse]) error
+	// List the metadata of all versions of the logical key a crypto key belongs to
+	ListVersions(*IdRequest, grpc.ServerStreamingServer[CryptoKeyMetaResponse]) error
+	// Set the period after which the logical key a crypto key belongs to is rotated automatically
+	UpdateRotationPolicy(context.Context, *UpdateRotationPolicyRequest) (*LogicalKeyResponse, error)
+	mustEmbedUnimplementedCryptoKeyRotationServer()
+}
+
+// UnimplementedCryptoKeyRotationServer must be embedded to have
+// forward compatible implementations.
+//
+// NOTE: this should be embedded by value instead of pointer to avoid a nil
+// pointer dereference when methods are called.
+type UnimplementedCryptoKeyRotationServer struct{}
+
+func (UnimplementedCryptoKeyRotationServer) Rotate(*IdRequest, grpc.ServerStreamingServer[CryptoKeyMetaResponse]) error {
+	return status.Errorf(codes.Unimplemented, "method Rotate not implemented")
+}
+func (UnimplementedCryptoKeyRotationServer) ListVersions(*IdRequest, grpc.ServerStreamingServer[CryptoKeyMetaResponse]) error {
+	return status.Errorf(codes.Unimplemented, "method ListVersions not implemented")
+}
+func (UnimplementedCryptoKeyRotationServer) UpdateRotationPolicy(context.Context, *UpdateRotationPolicyRequest) (*LogicalKeyResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method UpdateRotationPolicy not implemented")
+}
+func (UnimplementedCryptoKeyRotationServer) mustEmbedUnimplementedCryptoKeyRotationServer() {}
+func (UnimplementedCryptoKeyRotationServer) testEmbeddedByValue()                           {}
+
+// UnsafeCryptoKeyRotationServer may be embedded to opt out of forward compatibility for this service.
+// Use of this interface is not recommended, as added methods to CryptoKeyRotationServer will
+// result in compilation errors.
+type UnsafeCryptoKeyRotationServer interface {
+	mustEmbedUnimplementedCryptoKeyRotationServer()
+}
+
+func RegisterCryptoKeyRotationServer(s grpc.ServiceRegistrar, srv CryptoKeyRotationServer) {
+	// If the following call pancis, it indicates UnimplementedCryptoKeyRotationServer was
+	// embedded by pointer and is nil.  This will cause panics if an
+	// unimplemented method is ever invoked, so we test this at initialization
+	// time to prevent it from happening at runtime later due to I/O.
+	if t, ok := srv.(interface{ testEmbeddedByValue() }); ok {
+		t.testEmbeddedByValue()
+	}
+	s.RegisterService(&CryptoKeyRotation_ServiceDesc, srv)
+}
+
+func _CryptoKeyRotation_Rotate_Handler(srv interface{}, stream grpc.ServerStream) error {
+	m := new(IdRequest)
+	if err := stream.RecvMsg(m); err != nil {
+		return err
+	}
+	return srv.(CryptoKeyRotationServer).Rotate(m, &grpc.GenericServerStream[IdRequest, CryptoKeyMetaResponse]{ServerStream: stream})
+}
+
+// This type alias is provided for backwards compatibility with existing code that references the prior non-generic stream type by name.
+type CryptoKeyRotation_RotateServer = grpc.ServerStreamingServer[CryptoKeyMetaResponse]
+
+func _CryptoKeyRotation_ListVersions_Handler(srv interface{}, stream grpc.ServerStream) error {
+	m := new(IdRequest)
+	if err := stream.RecvMsg(m); err != nil {
+		return err
+	}
+	return srv.(CryptoKeyRotationServer).ListVersions(m, &grpc.GenericServerStream[IdRequest, CryptoKeyMetaResponse]{ServerStream: stream})
+}
+
+// This type alias is provided for backwards compatibility with existing code that references the prior non-generic stream type by name.
+type CryptoKeyRotation_ListVersionsServer = grpc.ServerStreamingServer[CryptoKeyMetaResponse]
+
+func _CryptoKeyRotation_UpdateRotationPolicy_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(UpdateRotationPolicyRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(CryptoKeyRotationServer).UpdateRotationPolicy(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: CryptoKeyRotation_UpdateRotationPolicy_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(CryptoKeyRotationServer).UpdateRotationPolicy(ctx, req.(*UpdateRotationPolicyRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+// CryptoKeyRotation_ServiceDesc is the grpc.ServiceDesc for CryptoKeyRotation service.
+// It's only intended for direct use with grpc.RegisterService,
+// and not to be introspected or modified (even as a copy)
+var CryptoKeyRotation_ServiceDesc = grpc.ServiceDesc{
+	ServiceName: "internal.CryptoKeyRotation",
+	HandlerType: (*CryptoKeyRotationServer)(nil),
+	Methods: []grpc.MethodDesc{
+		{
+			MethodName: "UpdateRotationPolicy",
+			Handler:    _CryptoKeyRotation_UpdateRotationPolicy_Handler,
+		},
+	},
+	Streams: []grpc.StreamDesc{
+		{
+			StreamName:    "Rotate",
+			Handler:       _CryptoKeyRotation_Rotate_Handler,
+			ServerStreams: true,
+		},
+		{
+			StreamName:    "ListVersions",
+			Handler:       _CryptoKeyRotation_ListVersions_Handler,
+			ServerStreams: true,
+		},
+	},
+	Metadata: "internal/service.proto",
+}
+
+const (
+	CryptoKeyImport_GetImportPublicKey_FullMethodName = "/internal.CryptoKeyImport/GetImportPublicKey"
+	CryptoKeyImport_Import_FullMethodName             = "/internal.CryptoKeyImport/Import"
+)
+
+// CryptoKeyImportClient is the client API for CryptoKeyImport service.
+//
+// For semantics around ctx use and closing/ending streaming RPCs, please refer to https://pkg.go.dev/google.golang.org/grpc/?tab=doc#ClientConn.NewStream.
+type CryptoKeyImportClient interface {
+	// Get the public key key material is wrapped with before importing it wrapped
+	GetImportPublicKey(ctx context.Context, in *GetImportPublicKeyRequest, opts ...grpc.CallOption) (*KeyImportPublicKeyResponse, error)
+	// Import key material generated outside the service as a new logical key
+	Import(ctx context.Context, in *ImportKeyRequest, opts ...grpc.CallOption) (grpc.ServerStreamingClient[CryptoKeyMetaResponse], error)
+}
+
+type cryptoKeyImportClient struct {
+	cc grpc.ClientConnInterface
+}
+
+func NewCryptoKeyImportClient(cc grpc.ClientConnInterface) CryptoKeyImportClient {
+	return &cryptoKeyImportClient{cc}
+}
+
+func (c *cryptoKeyImportClient) GetImportPublicKey(ctx context.Context, in *GetImportPublicKeyRequest, opts ...grpc.CallOption) (*KeyImportPublicKeyResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(KeyImportPublicKeyResponse)
+	err := c.cc.Invoke(ctx, CryptoKeyImport_GetImportPublicKey_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *cryptoKeyImportClient) Import(ctx context.Context, in *ImportKeyRequest, opts ...grpc.CallOption) (grpc.ServerStreamingClient[CryptoKeyMetaResponse], error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	stream, err := c.cc.NewStream(ctx, &CryptoKeyImport_ServiceDesc.Streams[0], CryptoKeyImport_Import_FullMethodName, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	x := &grpc.GenericClientStream[ImportKeyRequest, CryptoKeyMetaResponse]{ClientStream: stream}
+	if err := x.ClientStream.SendMsg(in); err != nil {
+		return nil, err
+	}
+	if err := x.ClientStream.CloseSend(); err != nil {
+		return nil, err
+	}
+	return x, nil
+}
+
+// This type alias is provided for backwards compatibility with existing code that references the prior non-generic stream type by name.
+type CryptoKeyImport_ImportClient = grpc.ServerStreamingClient[CryptoKeyMetaResponse]
+
+// CryptoKeyImportServer is the server API for CryptoKeyImport service.
+// All implementations must embed UnimplementedCryptoKeyImportServer
+// for forward compatibility.
+type CryptoKeyImportServer interface {
+	// Get the public key key material is wrapped with before importing it wrapped
+	GetImportPublicKey(context.Context, *GetImportPublicKeyRequest) (*KeyImportPublicKeyResponse, error)
+	// Import key material generated outside the service as a new logical key
+	Import(*ImportKeyRequest, grpc.ServerStreamingServer[CryptoKeyMetaResponse]) error
+	mustEmbedUnimplementedCryptoKeyImportServer()
+}
+
+// UnimplementedCryptoKeyImportServer must be embedded to have
+// forward compatible implementations.
+//
+// NOTE: this should be embedded by value instead of pointer to avoid a nil
+// pointer dereference when methods are called.
+type UnimplementedCryptoKeyImportServer struct{}
+
+func (UnimplementedCryptoKeyImportServer) GetImportPublicKey(context.Context, *GetImportPublicKeyRequest) (*KeyImportPublicKeyResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetImportPublicKey not implemented")
+}
+func (UnimplementedCryptoKeyImportServer) Import(*ImportKeyRequest, grpc.ServerStreamingServer[CryptoKeyMetaResponse]) error {
+	return status.Errorf(codes.Unimplemented, "method Import not implemented")
+}
+func (UnimplementedCryptoKeyImportServer) mustEmbedUnimplementedCryptoKeyImportServer() {}
+func (UnimplementedCryptoKeyImportServer) testEmbeddedByValue()                         {}
+
+// UnsafeCryptoKeyImportServer may be embedded to opt out of forward compatibility for this service.
+// Use of this interface is not recommended, as added methods to CryptoKeyImportServer will
+// result in compilation errors.
+type UnsafeCryptoKeyImportServer interface {
+	mustEmbedUnimplementedCryptoKeyImportServer()
+}
+
+func RegisterCryptoKeyImportServer(s grpc.ServiceRegistrar, srv CryptoKeyImportServer) {
+	// If the following call pancis, it indicates UnimplementedCryptoKeyImportServer was
+	// embedded by pointer and is nil.  This will cause panics if an
+	// unimplemented method is ever invoked, so we test this at initialization
+	// time to prevent it from happening at runtime later due to I/O.
+	if t, ok := srv.(interface{ testEmbeddedByValue() }); ok {
+		t.testEmbeddedByValue()
+	}
+	s.RegisterService(&CryptoKeyImport_ServiceDesc, srv)
+}
+
+func _CryptoKeyImport_GetImportPublicKey_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetImportPublicKeyRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(CryptoKeyImportServer).GetImportPublicKey(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: CryptoKeyImport_GetImportPublicKey_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(CryptoKeyImportServer).GetImportPublicKey(ctx, req.(*GetImportPublicKeyRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _CryptoKeyImport_Import_Handler(srv interface{}, stream grpc.ServerStream) error {
+	m := new(ImportKeyRequest)
+	if err := stream.RecvMsg(m); err != nil {
+		return err
+	}
+	return srv.(CryptoKeyImportServer).Import(m, &grpc.GenericServerStream[ImportKeyRequest, CryptoKeyMetaResponse]{ServerStream: stream})
+}
+
+// This type alias is provided for backwards compatibility with existing code that references the prior non-generic stream type by name.
+type CryptoKeyImport_ImportServer = grpc.ServerStreamingServer[CryptoKeyMetaResponse]
+
+// CryptoKeyImport_ServiceDesc is the grpc.ServiceDesc for CryptoKeyImport service.
+// It's only intended for direct use with grpc.RegisterService,
+// and not to be introspected or modified (even as a copy)
+var CryptoKeyImport_ServiceDesc = grpc.ServiceDesc{
+	ServiceName: "internal.CryptoKeyImport",
+	HandlerType: (*CryptoKeyImportServer)(nil),
+	Methods: []grpc.MethodDesc{
+		{
+			MethodName: "GetImportPublicKey",
+			Handler:    _CryptoKeyImport_GetImportPublicKey_Handler,
+		},
+	},
+	Streams: []grpc.StreamDesc{
+		{
+			StreamName:    "Import",
+			Handler:       _CryptoKeyImport_Import_Handler,
+			ServerStreams: true,
+		},
+	},
+	Metadata: "internal/service.proto",
+}
+
+const (
+	MasterKeyRotation_Rotate_FullMethodName          = "/internal.MasterKeyRotation/Rotate"
+	MasterKeyRotation_GetRotationByID_FullMethodName = "/internal.MasterKeyRotation/GetRotationByID"
+)
+
+// MasterKeyRotationClient is the client API for MasterKeyRotation service.
+//
+// For semantics around ctx use and closing/ending streaming RPCs, please refer to https://pkg.go.dev/google.golang.org/grpc/?tab=doc#ClientConn.NewStream.
+type MasterKeyRotationClient interface {
+	// Rotate the master key and re-wrap all stored crypto keys in the background
+	Rotate(ctx context.Context, in *RotateMasterKeyRequest, opts ...grpc.CallOption) (*MasterKeyRotationResponse, error)
+	// Get the progress of a master key rotation by ID
+	GetRotationByID(ctx context.Context, in *IdRequest, opts ...grpc.CallOption) (*MasterKeyRotationResponse, error)
+}
+
+type masterKeyRotationClient struct {
+	cc grpc.ClientConnInterface
+}
+
+func NewMasterKeyRotationClient(cc grpc.ClientConnInterface) MasterKeyRotationClient {
+	return &masterKeyRotationClient{cc}
+}
+
+func (c *masterKeyRotationClient) Rotate(ctx context.Context, in *RotateMasterKeyRequest, opts ...grpc.CallOption) (*MasterKeyRotationResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(MasterKeyRotationResponse)
+	err := c.cc.Invoke(ctx, MasterKeyRotation_Rotate_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *masterKeyRotationClient) GetRotationByID(ctx context.Context, in *IdRequest, opts ...grpc.CallOption) (*MasterKeyRotationResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(MasterKeyRotationResponse)
+	err := c.cc.Invoke(ctx, MasterKeyRotation_GetRotationByID_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+// MasterKeyRotationServer is the server API for MasterKeyRotation service.
+// All implementations must embed UnimplementedMasterKeyRotationServer
+// for forward compatibility.
+type MasterKeyRotationServer interface {
+	// Rotate the master key and re-wrap all stored crypto keys in the background
+	Rotate(context.Context, *RotateMasterKeyRequest) (*MasterKeyRotationResponse, error)
+	// Get the progress of a master key rotation by ID
+	GetRotationByID(context.Context, *IdRequest) (*MasterKeyRotationResponse, error)
+	mustEmbedUnimplementedMasterKeyRotationServer()
+}
+
+// UnimplementedMasterKeyRotationServer must be embedded to have
+// forward compatible implementations.
+//
+// NOTE: this should be embedded by value instead of pointer to avoid a nil
+// pointer dereference when methods are called.
+type UnimplementedMasterKeyRotationServer struct{}
+
+func (UnimplementedMasterKeyRotationServer) Rotate(context.Context, *RotateMasterKeyRequest) (*MasterKeyRotationResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method Rotate not implemented")
+}
+func (UnimplementedMasterKeyRotationServer) GetRotationByID(context.Context, *IdRequest) (*MasterKeyRotationResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetRotationByID not implemented")
+}
+func (UnimplementedMasterKeyRotationServer) mustEmbedUnimplementedMasterKeyRotationServer() {}
+func (UnimplementedMasterKeyRotationServer) testEmbeddedByValue()                           {}
+
+// UnsafeMasterKeyRotationServer may be embedded to opt out of forward compatibility for this service.
+// Use of this interface is not recommended, as added methods to MasterKeyRotationServer will
+// result in compilation errors.
+type UnsafeMasterKeyRotationServer interface {
+	mustEmbedUnimplementedMasterKeyRotationServer()
+}
+
+func RegisterMasterKeyRotationServer(s grpc.ServiceRegistrar, srv MasterKeyRotationServer) {
+	// If the following call pancis, it indicates UnimplementedMasterKeyRotationServer was
+	// embedded by pointer and is nil.  This will cause panics if an
+	// unimplemented method is ever invoked, so we test this at initialization
+	// time to prevent it from happening at runtime later due to I/O.
+	if t, ok := srv.(interface{ testEmbeddedByValue() }); ok {
+		t.testEmbeddedByValue()
+	}
+	s.RegisterService(&MasterKeyRotation_ServiceDesc, srv)
+}
+
+func _MasterKeyRotation_Rotate_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(RotateMasterKeyRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MasterKeyRotationServer).Rotate(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: MasterKeyRotation_Rotate_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MasterKeyRotationServer).Rotate(ctx, req.(*RotateMasterKeyRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _MasterKeyRotation_GetRotationByID_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(IdRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MasterKeyRotationServer).GetRotationByID(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: MasterKeyRotation_GetRotationByID_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MasterKeyRotationServer).GetRotationByID(ctx, req.(*IdRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+// MasterKeyRotation_ServiceDesc is the grpc.ServiceDesc for MasterKeyRotation service.
+// It's only intended for direct use with grpc.RegisterService,
+// and not to be introspected or modified (even as a copy)
+var MasterKeyRotation_ServiceDesc = grpc.ServiceDesc{
+	ServiceName: "internal.MasterKeyRotation",
+	HandlerType: (*MasterKeyRotationServer)(nil),
+	Methods: []grpc.MethodDesc{
+		{
+			MethodName: "Rotate",
+			Handler:    _MasterKeyRotation_Rotate_Handler,
+		},
+		{
+			MethodName: "GetRotationByID",
+			Handler:    _MasterKeyRotation_GetRotationByID_Handler,
+		},
+	},
+	Streams:  []grpc.StreamDesc{},
+	Metadata: "internal/service.proto",
+}
+
+const (
+	CryptoOperation_Encrypt_FullMethodName = "/internal.CryptoOperation/Encrypt"
+	CryptoOperation_Decrypt_FullMethodName = "/internal.CryptoOperation/Decrypt"
+	CryptoOperation_Sign_FullMethodName    = "/internal.CryptoOperation/Sign"
+	CryptoOperation_Verify_FullMethodName  = "/internal.CryptoOperation/Verify"
+	CryptoOperation_Wrap_FullMethodName    = "/internal.CryptoOperation/Wrap"
+	CryptoOperation_Unwrap_FullMethodName  = "/internal.CryptoOperation/Unwrap"
+)
+
+// CryptoOperationClient is the client API for CryptoOperation service.
+//
+// For semantics around ctx use and closing/ending streaming RPCs, please refer to https://pkg.go.dev/google.golang.org/grpc/?tab=doc#ClientConn.NewStream.
+type CryptoOperationClient interface {
+	// Encrypt a payload with an AES key or the public key of an RSA key pair
+	Encrypt(ctx context.Context, in *CryptoOperationRequest, opts ...grpc.CallOption) (*CryptoOperationResponse, error)
+	// Decrypt a ciphertext returned by Encrypt
+	Decrypt(ctx context.Context, in *CryptoOperationRequest, opts ...grpc.CallOption) (*CryptoOperationResponse, error)
+	// Sign a payload with the private key of an RSA or EC key pair
+	Sign(ctx context.Context, in *CryptoOperationRequest, opts ...grpc.CallOption) (*CryptoOperationResponse, error)
+	// Verify the signature of a payload with the public key of an RSA or EC key pair
+	Verify(ctx context.Context, in *CryptoOperationRequest, opts ...grpc.CallOption) (*CryptoOperationResponse, error)
+	// Wrap key material with an AES key or the public key of an RSA key pair
+	Wrap(ctx context.Context, in *CryptoOperationRequest, opts ...grpc.CallOption) (*CryptoOperationResponse, error)
+	// Unwrap key material returned by Wrap
+	Unwrap(ctx context.Context, in *CryptoOperationRequest, opts ...grpc.CallOption) (*CryptoOperationResponse, error)
+}
+
+type cryptoOperationClient struct {
+	cc grpc.ClientConnInterface
+}
+
+func NewCryptoOperationClient(cc grpc.ClientConnInterface) CryptoOperationClient {
+	return &cryptoOperationClient{cc}
+}
+
+func (c *cryptoOperationClient) Encrypt(ctx context.Context, in *CryptoOperationRequest, opts ...grpc.CallOption) (*CryptoOperationResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(CryptoOperationResponse)
+	err := c.cc.Invoke(ctx, CryptoOperation_Encrypt_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *cryptoOperationClient) Decrypt(ctx context.Context, in *CryptoOperationRequest, opts ...grpc.CallOption) (*CryptoOperationResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(CryptoOperationResponse)
+	err := c.cc.Invoke(ctx, CryptoOperation_Decrypt_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *cryptoOperationClient) Sign(ctx context.Context, in *CryptoOperationRequest, opts ...grpc.CallOption) (*CryptoOperationResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(CryptoOperationResponse)
+	err := c.cc.Invoke(ctx, CryptoOperation_Sign_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *cryptoOperationClient) Verify(ctx context.Context, in *CryptoOperationRequest, opts ...grpc.CallOption) (*CryptoOperationResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(CryptoOperationResponse)
+	err := c.cc.Invoke(ctx, CryptoOperation_Verify_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *cryptoOperationClient) Wrap(ctx context.Context, in *CryptoOperationRequest, opts ...grpc.CallOption) (*CryptoOperationResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(CryptoOperationResponse)
+	err := c.cc.Invoke(ctx, CryptoOperation_Wrap_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *cryptoOperationClient) Unwrap(ctx context.Context, in *CryptoOperationRequest, opts ...grpc.CallOption) (*CryptoOperationResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(CryptoOperationResponse)
+	err := c.cc.Invoke(ctx, CryptoOperation_Unwrap_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+// CryptoOperationServer is the server API for CryptoOperation service.
+// All implementations must embed UnimplementedCryptoOperationServer
+// for forward compatibility.
+type CryptoOperationServer interface {
+	// Encrypt a payload with an AES key or the public key of an RSA key pair
+	Encrypt(context.Context, *CryptoOperationRequest) (*CryptoOperationResponse, error)
+	// Decrypt a ciphertext returned by Encrypt
+	Decrypt(context.Context, *CryptoOperationRequest) (*CryptoOperationResponse, error)
+	// Sign a payload with the private key of an RSA or EC key pair
+	Sign(context.Context, *CryptoOperationRequest) (*CryptoOperationResponse, error)
+	// Verify the signature of a payload with the public key of an RSA or EC key pair
+	Verify(context.Context, *CryptoOperationRequest) (*CryptoOperationResponse, error)
+	// Wrap key material with an AES key or the public key of an RSA key pair
+	Wrap(context.Context, *CryptoOperationRequest) (*CryptoOperationResponse, error)
+	// Unwrap key material returned by Wrap
+	Unwrap(context.Context, *CryptoOperationRequest) (*CryptoOperationResponse, error)
+	mustEmbedUnimplementedCryptoOperationServer()
+}
+
+// UnimplementedCryptoOperationServer must be embedded to have
+// forward compatible implementations.
+//
+// NOTE: this should be embedded by value instead of pointer to avoid a nil
+// pointer dereference when methods are called.
+type UnimplementedCryptoOperationServer struct{}
+
+func (UnimplementedCryptoOperationServer) Encrypt(context.Context, *CryptoOperationRequest) (*CryptoOperationResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method Encrypt not implemented")
+}
+func (UnimplementedCryptoOperationServer) Decrypt(context.Context, *CryptoOperationRequest) (*CryptoOperationResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method Decrypt not implemented")
+}
+func (UnimplementedCryptoOperationServer) Sign(context.Context, *CryptoOperationRequest) (*CryptoOperationResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method Sign not implemented")
+}
+func (UnimplementedCryptoOperationServer) Verify(context.Context, *CryptoOperationRequest) (*CryptoOperationResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method Verify not implemented")
+}
+func (UnimplementedCryptoOperationServer) Wrap(context.Context, *CryptoOperationRequest) (*CryptoOperationResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method Wrap not implemented")
+}
+func (UnimplementedCryptoOperationServer) Unwrap(context.Context, *CryptoOperationRequest) (*CryptoOperationResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method Unwrap not implemented")
+}
+func (UnimplementedCryptoOperationServer) mustEmbedUnimplementedCryptoOperationServer() {}
+func (UnimplementedCryptoOperationServer) testEmbeddedByValue()                         {}
+
+// UnsafeCryptoOperationServer may be embedded to opt out of forward compatibility for this service.
+// Use of this interface is not recommended, as added methods to CryptoOperationServer will
+// result in compilation errors.
+type UnsafeCryptoOperationServer interface {
+	mustEmbedUnimplementedCryptoOperationServer()
+}
+
+func RegisterCryptoOperationServer(s grpc.ServiceRegistrar, srv CryptoOperationServer) {
+	// If the following call pancis, it indicates UnimplementedCryptoOperationServer was
+	// embedded by pointer and is nil.  This will cause panics if an
+	// unimplemented method is ever invoked, so we test this at initialization
+	// time to prevent it from happening at runtime later due to I/O.
+	if t, ok := srv.(interface{ testEmbeddedByValue() }); ok {
+		t.testEmbeddedByValue()
+	}
+	s.RegisterService(&CryptoOperation_ServiceDesc, srv)
+}
+
+func _CryptoOperation_Encrypt_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CryptoOperationRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(CryptoOperationServer).Encrypt(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: CryptoOperation_Encrypt_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(CryptoOperationServer).Encrypt(ctx, req.(*CryptoOperationRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _CryptoOperation_Decrypt_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CryptoOperationRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(CryptoOperationServer).Decrypt(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: CryptoOperation_Decrypt_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(CryptoOperationServer).Decrypt(ctx, req.(*CryptoOperationRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _CryptoOperation_Sign_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CryptoOperationRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(CryptoOperationServer).Sign(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: CryptoOperation_Sign_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(CryptoOperationServer).Sign(ctx, req.(*CryptoOperationRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _CryptoOperation_Verify_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CryptoOperationRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(CryptoOperationServer).Verify(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: CryptoOperation_Verify_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(CryptoOperationServer).Verify(ctx, req.(*CryptoOperationRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _CryptoOperation_Wrap_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CryptoOperationRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(CryptoOperationServer).Wrap(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: CryptoOperation_Wrap_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(CryptoOperationServer).Wrap(ctx, req.(*CryptoOperationRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _CryptoOperation_Unwrap_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CryptoOperationRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(CryptoOperationServer).Unwrap(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: CryptoOperation_Unwrap_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(CryptoOperationServer).Unwrap(ctx, req.(*CryptoOperationRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+// CryptoOperation_ServiceDesc is the grpc.ServiceDesc for CryptoOperation service.
+// It's only intended for direct use with grpc.RegisterService,
+// and not to be introspected or modified (even as a copy)
+var CryptoOperation_ServiceDesc = grpc.ServiceDesc{
+	ServiceName: "internal.CryptoOperation",
+	HandlerType: (*CryptoOperationServer)(nil),
+	Methods: []grpc.MethodDesc{
+		{
+			MethodName: "Encrypt",
+			Handler:    _CryptoOperation_Encrypt_Handler,
+		},
+		{
+			MethodName: "Decrypt",
+			Handler:    _CryptoOperation_Decrypt_Handler,
+		},
+		{
+			MethodName: "Sign",
+			Handler:    _CryptoOperation_Sign_Handler,
+		},
+		{
+			MethodName: "Verify",
+			Handler:    _CryptoOperation_Verify_Handler,
+		},
+		{
+			MethodName: "Wrap",
+			Handler:    _CryptoOperation_Wrap_Handler,
+		},
+		{
+			MethodName: "Unwrap",
+			Handler:    _CryptoOperation_Unwrap_Handler,
+		},
+	},
+	Streams:  []grpc.StreamDesc{},
+	Metadata: "internal/service.proto",
+}
+
+const (
+	Pkcs11Admin_ListTokenSlots_FullMethodName  = "/internal.Pkcs11Admin/ListTokenSlots"
+	Pkcs11Admin_InitializeToken_FullMethodName = "/internal.Pkcs11Admin/InitializeToken"
+	Pkcs11Admin_ListObjects_FullMethodName     = "/internal.Pkcs11Admin/ListObjects"
+	Pkcs11Admin_AddKey_FullMethodName          = "/internal.Pkcs11Admin/AddKey"
+	Pkcs11Admin_DeleteObject_FullMethodName    = "/internal.Pkcs11Admin/DeleteObject"
+	Pkcs11Admin_Encrypt_FullMethodName         = "/internal.Pkcs11Admin/Encrypt"
+	Pkcs11Admin_Decrypt_FullMethodName         = "/internal.Pkcs11Admin/Decrypt"
+	Pkcs11Admin_Sign_FullMethodName            = "/internal.Pkcs11Admin/Sign"
+	Pkcs11Admin_Verify_FullMethodName          = "/internal.Pkcs11Admin/Verify"
+)
+
+// Pkcs11AdminClient is the client API for Pkcs11Admin service.
+//
+// For semantics around ctx use and closing/ending streaming RPCs, please refer to https://pkg.go.dev/google.golang.org/grpc/?tab=doc#ClientConn.NewStream.
+type Pkcs11AdminClient interface {
+	// List the tokens present in the slots of the PKCS#11 module, restricted to admins
+	ListTokenSlots(ctx context.Context, in *ListPkcs11TokensRequest, opts ...grpc.CallOption) (grpc.ServerStreamingClient[Pkcs11TokenResponse], error)
+	// Initialize the token of the configured slot, restricted to admins
+	InitializeToken(ctx context.Context, in *Pkcs11TokenRequest, opts ...grpc.CallOption) (*InfoResponse, error)
+	// List the objects stored on a token, restricted to admins
+	ListObjects(ctx context.Context, in *Pkcs11TokenRequest, opts ...grpc.CallOption) (grpc.ServerStreamingClient[Pkcs11ObjectResponse], error)
+	// Generate an RSA or ECDSA key pair or an AES key on a token, restricted to admins
+	AddKey(ctx context.Context, in *AddPkcs11KeyRequest, opts ...grpc.CallOption) (*InfoResponse, error)
+	// Delete the objects of a type and label from a token, restricted to admins
+	DeleteObject(ctx context.Context, in *DeletePkcs11ObjectRequest, opts ...grpc.CallOption) (*InfoResponse, error)
+	// Encrypt a payload with the public key of an RSA key pair or an AES key on a token, restricted to admins
+	Encrypt(ctx context.Context, in *Pkcs11OperationRequest, opts ...grpc.CallOption) (*Pkcs11OperationResponse, error)
+	// Decrypt a ciphertext with the private key of an RSA key pair or an AES key on a token, restricted to admins
+	Decrypt(ctx context.Context, in *Pkcs11OperationRequest, opts ...grpc.CallOption) (*Pkcs11OperationResponse, error)
+	// Sign a payload with the private key of an RSA or ECDSA key pair on a token, restricted to admins
+	Sign(ctx context.Context, in *Pkcs11OperationRequest, opts ...grpc.CallOption) (*Pkcs11OperationResponse, error)
+	// Verify the signature of a payload with the public key of an RSA or ECDSA key pair on a token, restricted to admins
+	Verify(ctx context.Context, in *Pkcs11OperationRequest, opts ...grpc.CallOption) (*Pkcs11OperationResponse, error)
+}
+
+type pkcs11AdminClient struct {
+	cc grpc.ClientConnInterface
+}
+
+func NewPkcs11AdminClient(cc grpc.ClientConnInterface) Pkcs11AdminClient {
+	return &pkcs11AdminClient{cc}
+}
+
+func (c *pkcs11AdminClient) ListTokenSlots(ctx context.Context, in *ListPkcs11TokensRequest, opts ...grpc.CallOption) (grpc.ServerStreamingClient[Pkcs11TokenResponse], error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	stream, err := c.cc.NewStream(ctx, &Pkcs11Admin_ServiceDesc.Streams[0], Pkcs11Admin_ListTokenSlots_FullMethodName, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	x := &grpc.GenericClientStream[ListPkcs11TokensRequest, Pkcs11TokenResponse]{ClientStream: stream}
+	if err := x.ClientStream.SendMsg(in); err != nil {
+		return nil, err
+	}
+	if err := x.ClientStream.CloseSend(); err != nil {
+		return nil, err
+	}
+	return x, nil
+}
+
+// This type alias is provided for backwards compatibility with existing code that references the prior non-generic stream type by name.
+type Pkcs11Admin_ListTokenSlotsClient = grpc.ServerStreamingClient[Pkcs11TokenResponse]
+
+func (c *pkcs11AdminClient) InitializeToken(ctx context.Context, in *Pkcs11TokenRequest, opts ...grpc.CallOption) (*InfoResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(InfoResponse)
+	err := c.cc.Invoke(ctx, Pkcs11Admin_InitializeToken_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *pkcs11AdminClient) ListObjects(ctx context.Context, in *Pkcs11TokenRequest, opts ...grpc.CallOption) (grpc.ServerStreamingClient[Pkcs11ObjectResponse], error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	stream, err := c.cc.NewStream(ctx, &Pkcs11Admin_ServiceDesc.Streams[1], Pkcs11Admin_ListObjects_FullMethodName, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	x := &grpc.GenericClientStream[Pkcs11TokenRequest, Pkcs11ObjectResponse]{ClientStream: stream}
+	if err := x.ClientStream.SendMsg(in); err != nil {
+		return nil, err
+	}
+	if err := x.ClientStream.CloseSend(); err != nil {
+		return nil, err
+	}
+	return x, nil
+}
+
+// This type alias is provided for backwards compatibility with existing code that references the prior non-generic stream type by name.
+type Pkcs11Admin_ListObjectsClient = grpc.ServerStreamingClient[Pkcs11ObjectResponse]
+
+func (c *pkcs11AdminClient) AddKey(ctx context.Context, in *AddPkcs11KeyRequest, opts ...grpc.CallOption) (*InfoResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(InfoResponse)
+	err := c.cc.Invoke(ctx, Pkcs11Admin_AddKey_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *pkcs11AdminClient) DeleteObject(ctx context.Context, in *DeletePkcs11ObjectRequest, opts ...grpc.CallOption) (*InfoResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(InfoResponse)
+	err := c.cc.Invoke(ctx, Pkcs11Admin_DeleteObject_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *pkcs11AdminClient) Encrypt(ctx context.Context, in *Pkcs11OperationRequest, opts ...grpc.CallOption) (*Pkcs11OperationResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(Pkcs11OperationResponse)
+	err := c.cc.Invoke(ctx, Pkcs11Admin_Encrypt_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *pkcs11AdminClient) Decrypt(ctx context.Context, in *Pkcs11OperationRequest, opts ...grpc.CallOption) (*Pkcs11OperationResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(Pkcs11OperationResponse)
+	err := c.cc.Invoke(ctx, Pkcs11Admin_Decrypt_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *pkcs11AdminClient) Sign(ctx context.Context, in *Pkcs11OperationRequest, opts ...grpc.CallOption) (*Pkcs11OperationResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(Pkcs11OperationResponse)
+	err := c.cc.Invoke(ctx, Pkcs11Admin_Sign_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *pkcs11AdminClient) Verify(ctx context.Context, in *Pkcs11OperationRequest, opts ...grpc.CallOption) (*Pkcs11OperationResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(Pkcs11OperationResponse)
+	err := c.cc.Invoke(ctx, Pkcs11Admin_Verify_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+// Pkcs11AdminServer is the server API for Pkcs11Admin service.
+// All implementations must embed UnimplementedPkcs11AdminServer
+// for forward compatibility.
+type Pkcs11AdminServer interface {
+	// List the tokens present in the slots of the PKCS#11 module, restricted to admins
+	ListTokenSlots(*ListPkcs11TokensRequest, grpc.ServerStreamingServer[Pkcs11TokenResponse]) error
+	// Initialize the token of the configured slot, restricted to admins
+	InitializeToken(context.Context, *Pkcs11TokenRequest) (*InfoResponse, error)
+	// List the objects stored on a token, restricted to admins
+	ListObjects(*Pkcs11TokenRequest, grpc.ServerStreamingServer[Pkcs11ObjectResponse]) error
+	// Generate an RSA or ECDSA key pair or an AES key on a token, restricted to admins
+	AddKey(context.Context, *AddPkcs11KeyRequest) (*InfoResponse, error)
+	// Delete the objects of a type and label from a token, restricted to admins
+	DeleteObject(context.Context, *DeletePkcs11ObjectRequest) (*InfoResponse, error)
+	// Encrypt a payload with the public key of an RSA key pair or an AES key on a token, restricted to admins
+	Encrypt(context.Context, *Pkcs11OperationRequest) (*Pkcs11OperationResponse, error)
+	// Decrypt a ciphertext with the private key of an RSA key pair or an AES key on a token, restricted to admins
+	Decrypt(context.Context, *Pkcs11OperationRequest) (*Pkcs11OperationResponse, error)
+	// Sign a payload with the private key of an RSA or ECDSA key pair on a token, restricted to admins
+	Sign(context.Context, *Pkcs11OperationRequest) (*Pkcs11OperationResponse, error)
+	// Verify the signature of a payload with the public key of an RSA or ECDSA key pair on a token, restricted to admins
+	Verify(context.Context, *Pkcs11OperationRequest) (*Pkcs11OperationResponse, error)
+	mustEmbedUnimplementedPkcs11AdminServer()
+}
+
+// UnimplementedPkcs11AdminServer must be embedded to have
+// forward compatible implementations.
+//
+// NOTE: this should be embedded by value instead of pointer to avoid a nil
+// pointer dereference when methods are called.
+type UnimplementedPkcs11AdminServer struct{}
+
+func (UnimplementedPkcs11AdminServer) ListTokenSlots(*ListPkcs11TokensRequest, grpc.ServerStreamingServer[Pkcs11TokenResponse]) error {
+	return status.Errorf(codes.Unimplemented, "method ListTokenSlots not implemented")
+}
+func (UnimplementedPkcs11AdminServer) InitializeToken(context.Context, *Pkcs11TokenRequest) (*InfoResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method InitializeToken not implemented")
+}
+func (UnimplementedPkcs11AdminServer) ListObjects(*Pkcs11TokenRequest, grpc.ServerStreamingServer[Pkcs11ObjectResponse]) error {
+	return status.Errorf(codes.Unimplemented, "method ListObjects not implemented")
+}
+func (UnimplementedPkcs11AdminServer) AddKey(context.Context, *AddPkcs11KeyRequest) (*InfoResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method AddKey not implemented")
+}
+func (UnimplementedPkcs11AdminServer) DeleteObject(context.Context, *DeletePkcs11ObjectRequest) (*InfoResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method DeleteObject not implemented")
+}
+func (UnimplementedPkcs11AdminServer) Encrypt(context.Context, *Pkcs11OperationRequest) (*Pkcs11OperationResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method Encrypt not implemented")
+}
+func (UnimplementedPkcs11AdminServer) Decrypt(context.Context, *Pkcs11OperationRequest) (*Pkcs11OperationResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method Decrypt not implemented")
+}
+func (UnimplementedPkcs11AdminServer) Sign(context.Context, *Pkcs11OperationRequest) (*Pkcs11OperationResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method Sign not implemented")
+}
+func (UnimplementedPkcs11AdminServer) Verify(context.Context, *Pkcs11OperationRequest) (*Pkcs11OperationResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method Verify not implemented")
+}
+func (UnimplementedPkcs11AdminServer) mustEmbedUnimplementedPkcs11AdminServer() {}
+func (UnimplementedPkcs11AdminServer) testEmbeddedByValue()                     {}
+
+// UnsafePkcs11AdminServer may be embedded to opt out of forward compatibility for this service.
+// Use of this interface is not recommended, as added methods to Pkcs11AdminServer will
+// result in compilation errors.
+type UnsafePkcs11AdminServer interface {
+	mustEmbedUnimplementedPkcs11AdminServer()
+}
+
+func RegisterPkcs11AdminServer(s grpc.ServiceRegistrar, srv Pkcs11AdminServer) {
+	// If the following call pancis, it indicates UnimplementedPkcs11AdminServer was
+	// embedded by pointer and is nil.  This will cause panics if an
+	// unimplemented method is ever invoked, so we test this at initialization
+	// time to prevent it from happening at runtime later due to I/O.
+	if t, ok := srv.(interface{ testEmbeddedByValue() }); ok {
+		t.testEmbeddedByValue()
+	}
+	s.RegisterService(&Pkcs11Admin_ServiceDesc, srv)
+}
+
+func _Pkcs11Admin_ListTokenSlots_Handler(srv interface{}, stream grpc.ServerStream) error {
+	m := new(ListPkcs11TokensRequest)
+	if err := stream.RecvMsg(m); err != nil {
+		return err
+	}
+	return srv.(Pkcs11AdminServer).ListTokenSlots(m, &grpc.GenericServerStream[ListPkcs11TokensRequest, Pkcs11TokenResponse]{ServerStream: stream})
+}
+
+// This type alias is provided for backwards compatibility with existing code that references the prior non-generic stream type by name.
+type Pkcs11Admin_ListTokenSlotsServer = grpc.ServerStreamingServer[Pkcs11TokenResponse]
+
+func _Pkcs11Admin_InitializeToken_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(Pkcs11TokenRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(Pkcs11AdminServer).InitializeToken(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Pkcs11Admin_InitializeToken_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(Pkcs11AdminServer).InitializeToken(ctx, req.(*Pkcs11TokenRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Pkcs11Admin_ListObjects_Handler(srv interface{}, stream grpc.ServerStream) error {
+	m := new(Pkcs11TokenRequest)
+	if err := stream.RecvMsg(m); err != nil {
+		return err
+	}
+	return srv.(Pkcs11AdminServer).ListObjects(m, &grpc.GenericServerStream[Pkcs11TokenRequest, Pkcs11ObjectResponse]{ServerStream: stream})
+}
+
+// This type alias is provided for backwards compatibility with existing code that references the prior non-generic stream type by name.
+type Pkcs11Admin_ListObjectsServer = grpc.ServerStreamingServer[Pkcs11ObjectResponse]
+
+func _Pkcs11Admin_AddKey_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(AddPkcs11KeyRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(Pkcs11AdminServer).AddKey(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Pkcs11Admin_AddKey_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(Pkcs11AdminServer).AddKey(ctx, req.(*AddPkcs11KeyRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Pkcs11Admin_DeleteObject_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(DeletePkcs11ObjectRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(Pkcs11AdminServer).DeleteObject(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Pkcs11Admin_DeleteObject_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(Pkcs11AdminServer).DeleteObject(ctx, req.(*DeletePkcs11ObjectRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Pkcs11Admin_Encrypt_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(Pkcs11OperationRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(Pkcs11AdminServer).Encrypt(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Pkcs11Admin_Encrypt_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(Pkcs11AdminServer).Encrypt(ctx, req.(*Pkcs11OperationRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Pkcs11Admin_Decrypt_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(Pkcs11OperationRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(Pkcs11AdminServer).Decrypt(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Pkcs11Admin_Decrypt_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(Pkcs11AdminServer).Decrypt(ctx, req.(*Pkcs11OperationRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Pkcs11Admin_Sign_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(Pkcs11OperationRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(Pkcs11AdminServer).Sign(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Pkcs11Admin_Sign_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(Pkcs11AdminServer).Sign(ctx, req.(*Pkcs11OperationRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Pkcs11Admin_Verify_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(Pkcs11OperationRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(Pkcs11AdminServer).Verify(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Pkcs11Admin_Verify_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(Pkcs11AdminServer).Verify(ctx, req.(*Pkcs11OperationRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+// Pkcs11Admin_ServiceDesc is the grpc.ServiceDesc for Pkcs11Admin service.
+// It's only intended for direct use with grpc.RegisterService,
+// and not to be introspected or modified (even as a copy)
+var Pkcs11Admin_ServiceDesc = grpc.ServiceDesc{
+	ServiceName: "internal.Pkcs11Admin",
+	HandlerType: (*Pkcs11AdminServer)(nil),
+	Methods: []grpc.MethodDesc{
+		{
+			MethodName: "InitializeToken",
+			Handler:    _Pkcs11Admin_InitializeToken_Handler,
+		},
+		{
+			MethodName: "AddKey",
+			Handler:    _Pkcs11Admin_AddKey_Handler,
+		},
+		{
+			MethodName: "DeleteObject",
+			Handler:    _Pkcs11Admin_DeleteObject_Handler,
+		},
+		{
+			MethodName: "Encrypt",
+			Handler:    _Pkcs11Admin_Encrypt_Handler,
+		},
+		{
+			MethodName: "Decrypt",
+			Handler:    _Pkcs11Admin_Decrypt_Handler,
+		},
+		{
+			MethodName: "Sign",
+			Handler:    _Pkcs11Admin_Sign_Handler,
+		},
+		{
+			MethodName: "Verify",
+			Handler:    _Pkcs11Admin_Verify_Handler,
+		},
+	},
+	Streams: []grpc.StreamDesc{
+		{
+			StreamName:    "ListTokenSlots",
+			Handler:       _Pkcs11Admin_ListTokenSlots_Handler,
+			ServerStreams: true,
+		},
+		{
+			StreamName:    "ListObjects",
+			Handler:       _Pkcs11Admin_ListObjects_Handler,
+			ServerStreams: true,
+		},
 	},
 	Metadata: "internal/service.proto",
 }
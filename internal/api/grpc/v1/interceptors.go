@@ -0,0 +1,82 @@
+package v1
+
+import (
+	"context"
+	"crypto_vault_service/internal/domain/auth"
+	"crypto_vault_service/internal/domain/keys"
+	"errors"
+	"strings"
+
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/metadata"
+	"google.golang.org/grpc/status"
+)
+
+// bearerPrefix is the scheme prefix of the authorization metadata carrying a JWT.
+// The gRPC-Gateway forwards the Authorization header of HTTP requests as this metadata.
+const bearerPrefix = "Bearer "
+
+// UnaryAuthInterceptor authenticates unary calls by the JWT in the authorization metadata
+// and stores the identity of the token's subject in the context passed to the handler.
+// Handler errors caused by missing permissions or by the export policy of a key are returned with code PermissionDenied.
+func UnaryAuthInterceptor(tokenValidator auth.TokenValidator) grpc.UnaryServerInterceptor {
+	return func(ctx context.Context, req interface{}, info *grpc.UnaryServerInfo, handler grpc.UnaryHandler) (interface{}, error) {
+		authCtx, err := authenticate(ctx, tokenValidator)
+		if err != nil {
+			return nil, err
+		}
+		resp, err := handler(authCtx, req)
+		return resp, authorizationError(err)
+	}
+}
+
+// StreamAuthInterceptor authenticates streaming calls by the JWT in the authorization metadata
+// and stores the identity of the token's subject in the context of the stream passed to the handler.
+// Handler errors caused by missing permissions or by the export policy of a key are returned with code PermissionDenied.
+func StreamAuthInterceptor(tokenValidator auth.TokenValidator) grpc.StreamServerInterceptor {
+	return func(srv interface{}, stream grpc.ServerStream, info *grpc.StreamServerInfo, handler grpc.StreamHandler) error {
+		authCtx, err := authenticate(stream.Context(), tokenValidator)
+		if err != nil {
+			return err
+		}
+		return authorizationError(handler(srv, &authenticatedServerStream{ServerStream: stream, ctx: authCtx}))
+	}
+}
+
+// authorizationError converts errors caused by missing permissions or by the export policy of a key into status errors with code PermissionDenied.
+// Other errors are returned as is.
+func authorizationError(err error) error {
+	if errors.Is(err, auth.ErrForbidden) || errors.Is(err, keys.ErrExportForbidden) {
+		return status.Error(codes.PermissionDenied, err.Error())
+	}
+	return err
+}
+
+// authenticatedServerStream overrides the context of a server stream with the one carrying the caller identity
+type authenticatedServerStream struct {
+	grpc.ServerStream
+	ctx context.Context
+}
+
+// Context returns the context carrying the caller identity
+func (s *authenticatedServerStream) Context() context.Context {
+	return s.ctx
+}
+
+// authenticate validates the bearer token of the incoming metadata and returns a context carrying the identity of the token's subject.
+// Errors are gRPC status errors with code Unauthenticated, which must be returned to the client unwrapped.
+func authenticate(ctx context.Context, tokenValidator auth.TokenValidator) (context.Context, error) {
+	md, _ := metadata.FromIncomingContext(ctx)
+	values := md.Get("authorization")
+	if len(values) == 0 || len(values[0]) < len(bearerPrefix) || !strings.EqualFold(values[0][:len(bearerPrefix)], bearerPrefix) {
+		return nil, status.Error(codes.Unauthenticated, "authorization bearer token required")
+	}
+
+	identity, err := tokenValidator.Validate(ctx, strings.TrimSpace(values[0][len(bearerPrefix):]))
+	if err != nil {
+		return nil, status.Error(codes.Unauthenticated, "invalid authorization token")
+	}
+
+	return auth.WithIdentity(ctx, identity), nil
+}
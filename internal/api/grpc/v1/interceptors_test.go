@@ -0,0 +1,137 @@
+//go:build unit
+// +build unit
+
+package v1
+
+import (
+	"context"
+	"crypto_vault_service/internal/domain/auth"
+	"crypto_vault_service/internal/domain/keys"
+	"errors"
+	"fmt"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/metadata"
+	"google.golang.org/grpc/status"
+)
+
+// staticTokenValidator accepts a single token identifying a fixed user
+type staticTokenValidator struct {
+	token  string
+	userID string
+}
+
+// Validate returns the identity of the user if the token matches
+func (v *staticTokenValidator) Validate(ctx context.Context, token string) (*auth.Identity, error) {
+	if token != v.token {
+		return nil, errors.New("invalid token")
+	}
+	return &auth.Identity{UserID: v.userID}, nil
+}
+
+// fakeServerStream is a server stream carrying a context only
+type fakeServerStream struct {
+	grpc.ServerStream
+	ctx context.Context
+}
+
+// Context returns the stream context
+func (s *fakeServerStream) Context() context.Context {
+	return s.ctx
+}
+
+// incomingContext returns a context with the authorization metadata set if not empty
+func incomingContext(authorization string) context.Context {
+	if authorization == "" {
+		return context.Background()
+	}
+	return metadata.NewIncomingContext(context.Background(), metadata.Pairs("authorization", authorization))
+}
+
+var authInterceptorTests = []struct {
+	name          string
+	authorization string
+	expectedCode  codes.Code
+}{
+	{"Valid Token", "Bearer valid-token", codes.OK},
+	{"Missing Metadata", "", codes.Unauthenticated},
+	{"Other Scheme", "Basic dXNlcjpwYXNz", codes.Unauthenticated},
+	{"Invalid Token", "Bearer invalid-token", codes.Unauthenticated},
+}
+
+func TestUnaryAuthInterceptor(t *testing.T) {
+	interceptor := UnaryAuthInterceptor(&staticTokenValidator{token: "valid-token", userID: "user-1"})
+
+	for _, tt := range authInterceptorTests {
+		t.Run(tt.name, func(t *testing.T) {
+			handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+				return auth.UserIDFromContext(ctx)
+			}
+
+			resp, err := interceptor(incomingContext(tt.authorization), nil, &grpc.UnaryServerInfo{}, handler)
+
+			assert.Equal(t, tt.expectedCode, status.Code(err))
+			if tt.expectedCode == codes.OK {
+				require.NoError(t, err)
+				assert.Equal(t, "user-1", resp)
+			}
+		})
+	}
+}
+
+func TestStreamAuthInterceptor(t *testing.T) {
+	interceptor := StreamAuthInterceptor(&staticTokenValidator{token: "valid-token", userID: "user-1"})
+
+	for _, tt := range authInterceptorTests {
+		t.Run(tt.name, func(t *testing.T) {
+			var userID string
+			handler := func(srv interface{}, stream grpc.ServerStream) error {
+				var err error
+				userID, err = auth.UserIDFromContext(stream.Context())
+				return err
+			}
+
+			err := interceptor(nil, &fakeServerStream{ctx: incomingContext(tt.authorization)}, &grpc.StreamServerInfo{}, handler)
+
+			assert.Equal(t, tt.expectedCode, status.Code(err))
+			if tt.expectedCode == codes.OK {
+				require.NoError(t, err)
+				assert.Equal(t, "user-1", userID)
+			}
+		})
+	}
+}
+
+func TestAuthInterceptorsPermissionDenied(t *testing.T) {
+	tokenValidator := &staticTokenValidator{token: "valid-token", userID: "user-1"}
+	forbiddenErr := fmt.Errorf("failed to get metadata by ID: %w", auth.ErrForbidden)
+
+	unaryInterceptor := UnaryAuthInterceptor(tokenValidator)
+	_, err := unaryInterceptor(incomingContext("Bearer valid-token"), nil, &grpc.UnaryServerInfo{}, func(ctx context.Context, req interface{}) (interface{}, error) {
+		return nil, forbiddenErr
+	})
+	assert.Equal(t, codes.PermissionDenied, status.Code(err))
+
+	streamInterceptor := StreamAuthInterceptor(tokenValidator)
+	err = streamInterceptor(nil, &fakeServerStream{ctx: incomingContext("Bearer valid-token")}, &grpc.StreamServerInfo{}, func(srv interface{}, stream grpc.ServerStream) error {
+		return forbiddenErr
+	})
+	assert.Equal(t, codes.PermissionDenied, status.Code(err))
+
+	// Errors caused by the export policy of a key are denied as well
+	err = streamInterceptor(nil, &fakeServerStream{ctx: incomingContext("Bearer valid-token")}, &grpc.StreamServerInfo{}, func(srv interface{}, stream grpc.ServerStream) error {
+		return fmt.Errorf("failed to download crypto key: %w", keys.ErrExportForbidden)
+	})
+	assert.Equal(t, codes.PermissionDenied, status.Code(err))
+
+	// Other errors are passed through
+	otherErr := errors.New("not found")
+	_, err = unaryInterceptor(incomingContext("Bearer valid-token"), nil, &grpc.UnaryServerInfo{}, func(ctx context.Context, req interface{}) (interface{}, error) {
+		return nil, otherErr
+	})
+	assert.Equal(t, otherErr, err)
+}
@@ -3,13 +3,19 @@ package v1
 import (
 	"context"
 	"crypto_vault_service/internal/domain/blobs"
+	"crypto_vault_service/internal/domain/crypto"
 	"crypto_vault_service/internal/domain/keys"
+	"crypto_vault_service/internal/domain/pkcs11"
+	"crypto_vault_service/internal/infrastructure/cryptography"
 	"crypto_vault_service/internal/infrastructure/utils"
+	"errors"
 	"fmt"
+	"io"
+	"log"
+	"time"
 
 	pb "proto"
 
-	"github.com/google/uuid"
 	"github.com/grpc-ecosystem/grpc-gateway/v2/runtime"
 	"google.golang.org/grpc"
 	"google.golang.org/grpc/credentials"
@@ -34,6 +40,12 @@ type BlobMetadataServer struct {
 	blobMetadataService blobs.BlobMetadataService
 }
 
+// BlobGrantServer handles gRPC requests for sharing blobs
+type BlobGrantServer struct {
+	pb.UnimplementedBlobGrantServer
+	blobGrantService blobs.BlobGrantService
+}
+
 // CryptoKeyUploadServer handles gRPC requests for uploading cryptographic keys
 type CryptoKeyUploadServer struct {
 	pb.UnimplementedCryptoKeyUploadServer
@@ -52,6 +64,36 @@ type CryptoKeyMetadataServer struct {
 	cryptoKeyMetadataService keys.CryptoKeyMetadataService
 }
 
+// CryptoKeyRotationServer handles gRPC requests for rotating logical keys
+type CryptoKeyRotationServer struct {
+	pb.UnimplementedCryptoKeyRotationServer
+	cryptoKeyRotationService keys.CryptoKeyRotationService
+}
+
+// CryptoKeyImportServer handles gRPC requests for importing key material generated outside the service
+type CryptoKeyImportServer struct {
+	pb.UnimplementedCryptoKeyImportServer
+	cryptoKeyImportService keys.CryptoKeyImportService
+}
+
+// MasterKeyRotationServer handles gRPC requests for master key rotations
+type MasterKeyRotationServer struct {
+	pb.UnimplementedMasterKeyRotationServer
+	masterKeyRotationService keys.MasterKeyRotationService
+}
+
+// CryptoOperationServer handles gRPC requests for cryptographic operations with stored keys
+type CryptoOperationServer struct {
+	pb.UnimplementedCryptoOperationServer
+	cryptoOperationService crypto.CryptoOperationService
+}
+
+// Pkcs11AdminServer handles gRPC requests for administering the PKCS#11 tokens of the configured module
+type Pkcs11AdminServer struct {
+	pb.UnimplementedPkcs11AdminServer
+	pkcs11AdminService pkcs11.PKCS11AdminService
+}
+
 // NewBlobUploadServer creates a new instance of BlobUploadServer.
 func NewBlobUploadServer(blobUploadService blobs.BlobUploadService) (*BlobUploadServer, error) {
 	return &BlobUploadServer{
@@ -75,13 +117,12 @@ func (s BlobUploadServer) Upload(req *pb.BlobUploadRequest, stream pb.BlobUpload
 		signKeyID = &req.SignKeyId
 	}
 
-	userID := uuid.New().String() // TODO(MGTheTrain): extract user id from JWT
 	form, err := utils.CreateMultipleFilesForm(fileContent, fileNames)
 	if err != nil {
 		return fmt.Errorf("failed to create multiple files form for files %v: %w", fileNames, err)
 	}
 
-	blobMetas, err := s.blobUploadService.Upload(stream.Context(), form, userID, encryptionKeyID, signKeyID)
+	blobMetas, err := s.blobUploadService.Upload(stream.Context(), form, encryptionKeyID, signKeyID)
 	if err != nil {
 		return fmt.Errorf("failed to upload blob: %w", err)
 	}
@@ -104,6 +145,9 @@ func (s BlobUploadServer) Upload(req *pb.BlobUploadRequest, stream pb.BlobUpload
 		if blobMeta.SignKeyID != nil {
 			blobMetaResponse.SignKeyId = *blobMeta.SignKeyID
 		}
+		if blobMeta.SignatureName != nil {
+			blobMetaResponse.SignatureName = *blobMeta.SignatureName
+		}
 
 		// Send the metadata response to the client
 		if err := stream.Send(blobMetaResponse); err != nil {
@@ -129,30 +173,57 @@ func (s *BlobDownloadServer) DownloadByID(req *pb.BlobDownloadRequest, stream pb
 		decryptionKeyID = &req.DecryptionKeyId
 	}
 
-	bytes, err := s.blobDownloadService.DownloadByID(stream.Context(), id, decryptionKeyID)
+	reader, err := s.blobDownloadService.DownloadByID(stream.Context(), id, decryptionKeyID)
 	if err != nil {
 		return fmt.Errorf("could not download blob with id %s: %w", id, err)
 	}
+	defer func() {
+		if err := reader.Close(); err != nil {
+			log.Printf("warning: failed to close blob stream: %v\n", err)
+		}
+	}()
 
 	// If no error, stream the blob content back in chunks
 	chunkSize := 1024 * 1024 // 1MB chunk size, adjust as needed
-	for i := 0; i < len(bytes); i += chunkSize {
-		end := i + chunkSize
-		if end > len(bytes) {
-			end = len(bytes)
+	buffer := make([]byte, chunkSize)
+	for {
+		n, err := io.ReadFull(reader, buffer)
+		if n > 0 {
+			// Create the chunk of data to send
+			chunk := &pb.BlobContent{
+				Content: buffer[:n],
+			}
+
+			// Send the chunk
+			if err := stream.Send(chunk); err != nil {
+				return fmt.Errorf("failed to send chunk: %w", err)
+			}
 		}
-
-		// Create the chunk of data to send
-		chunk := &pb.BlobContent{
-			Content: bytes[i:end],
+		if errors.Is(err, io.EOF) || errors.Is(err, io.ErrUnexpectedEOF) {
+			return nil
 		}
-
-		// Send the chunk
-		if err := stream.Send(chunk); err != nil {
-			return fmt.Errorf("failed to send chunk: %w", err)
+		if err != nil {
+			return fmt.Errorf("failed to read blob with id %s: %w", id, err)
 		}
 	}
-	return nil
+}
+
+// VerifyByID checks the detached signature of a blob by its ID
+func (s *BlobDownloadServer) VerifyByID(ctx context.Context, req *pb.BlobVerifyRequest) (*pb.BlobVerifyResponse, error) {
+	var decryptionKeyID *string
+	if len(req.DecryptionKeyId) > 0 {
+		decryptionKeyID = &req.DecryptionKeyId
+	}
+
+	valid, err := s.blobDownloadService.VerifyByID(ctx, req.Id, decryptionKeyID)
+	if err != nil {
+		return nil, fmt.Errorf("could not verify blob with id %s: %w", req.Id, err)
+	}
+
+	return &pb.BlobVerifyResponse{
+		Id:    req.Id,
+		Valid: valid,
+	}, nil
 }
 
 // NewBlobMetadataServer creates a new instance of BlobMetadataServer.
@@ -207,6 +278,9 @@ func (s *BlobMetadataServer) ListMetadata(req *pb.BlobMetaQuery, stream pb.BlobM
 		if blobMeta.SignKeyID != nil {
 			blobMetaResponse.SignKeyId = *blobMeta.SignKeyID
 		}
+		if blobMeta.SignatureName != nil {
+			blobMetaResponse.SignatureName = *blobMeta.SignatureName
+		}
 
 		// Send the metadata response to the client
 		if err := stream.Send(blobMetaResponse); err != nil {
@@ -241,6 +315,9 @@ func (s *BlobMetadataServer) GetMetadataByID(ctx context.Context, req *pb.IdRequ
 	if blobMeta.SignKeyID != nil {
 		blobMetaResponse.SignKeyId = *blobMeta.SignKeyID
 	}
+	if blobMeta.SignatureName != nil {
+		blobMetaResponse.SignatureName = *blobMeta.SignatureName
+	}
 	return blobMetaResponse, nil
 }
 
@@ -256,6 +333,77 @@ func (s *BlobMetadataServer) DeleteByID(ctx context.Context, req *pb.IdRequest)
 	}, nil
 }
 
+// NewBlobGrantServer creates a new instance of BlobGrantServer.
+func NewBlobGrantServer(blobGrantService blobs.BlobGrantService) (*BlobGrantServer, error) {
+	return &BlobGrantServer{
+		blobGrantService: blobGrantService,
+	}, nil
+}
+
+// CreateGrant shares a blob with a user or group, replacing an existing grant of the grantee
+func (s *BlobGrantServer) CreateGrant(ctx context.Context, req *pb.BlobGrantRequest) (*pb.BlobGrantResponse, error) {
+	grant := &blobs.BlobGrant{
+		BlobID:      req.BlobId,
+		GranteeType: req.GranteeType,
+		GranteeID:   req.GranteeId,
+		Access:      req.Access,
+	}
+	if req.DateTimeExpires != nil {
+		dateTimeExpires := req.DateTimeExpires.AsTime()
+		grant.DateTimeExpires = &dateTimeExpires
+	}
+
+	grant, err := s.blobGrantService.Create(ctx, grant)
+	if err != nil {
+		return nil, fmt.Errorf("failed to share blob with id %s: %w", req.BlobId, err)
+	}
+
+	return newBlobGrantResponse(grant), nil
+}
+
+// ListGrants lists the grants of a blob that have not expired
+func (s *BlobGrantServer) ListGrants(req *pb.IdRequest, stream pb.BlobGrant_ListGrantsServer) error {
+	grants, err := s.blobGrantService.List(stream.Context(), req.Id)
+	if err != nil {
+		return fmt.Errorf("failed to list grants: %w", err)
+	}
+
+	for _, grant := range grants {
+		if err := stream.Send(newBlobGrantResponse(grant)); err != nil {
+			return fmt.Errorf("failed to send grant response: %w", err)
+		}
+	}
+
+	return nil
+}
+
+// DeleteGrant revokes the grant of a user or group for a blob
+func (s *BlobGrantServer) DeleteGrant(ctx context.Context, req *pb.BlobGrantDeleteRequest) (*pb.InfoResponse, error) {
+	err := s.blobGrantService.Delete(ctx, req.BlobId, req.GranteeType, req.GranteeId)
+	if err != nil {
+		return nil, fmt.Errorf("failed to revoke grant: %w", err)
+	}
+
+	return &pb.InfoResponse{
+		Message: fmt.Sprintf("grant of %s %s for blob with id %s revoked successfully", req.GranteeType, req.GranteeId, req.BlobId),
+	}, nil
+}
+
+// newBlobGrantResponse maps a BlobGrant to its gRPC response
+func newBlobGrantResponse(grant *blobs.BlobGrant) *pb.BlobGrantResponse {
+	response := &pb.BlobGrantResponse{
+		BlobId:          grant.BlobID,
+		GranteeType:     grant.GranteeType,
+		GranteeId:       grant.GranteeID,
+		Access:          grant.Access,
+		DateTimeCreated: timestamppb.New(grant.DateTimeCreated),
+	}
+	if grant.DateTimeExpires != nil {
+		response.DateTimeExpires = timestamppb.New(*grant.DateTimeExpires)
+	}
+	return response
+}
+
 // NewCryptoKeyUploadServer creates a new instance of CryptoKeyUploadServer.
 func NewCryptoKeyUploadServer(cryptoKeyUploadService keys.CryptoKeyUploadService) (*CryptoKeyUploadServer, error) {
 	return &CryptoKeyUploadServer{
@@ -265,25 +413,14 @@ func NewCryptoKeyUploadServer(cryptoKeyUploadService keys.CryptoKeyUploadService
 
 // Upload generates and uploads cryptographic keys
 func (s *CryptoKeyUploadServer) Upload(req *pb.UploadKeyRequest, stream pb.CryptoKeyUpload_UploadServer) error {
-	userID := uuid.New().String() // TODO(MGTheTrain): extract user id from JWT
-
-	cryptoKeyMetas, err := s.cryptoKeyUploadService.Upload(stream.Context(), userID, req.Algorithm, req.KeySize)
+	cryptoKeyMetas, err := s.cryptoKeyUploadService.Upload(stream.Context(), req.Algorithm, req.KeySize, newCryptoKeyLifecycle(req.State, req.DateTimeActivation, req.DateTimeExpires))
 	if err != nil {
 		return fmt.Errorf("failed to generate and upload crypto keys: %w", err)
 	}
 
 	for _, cryptoKeyMeta := range cryptoKeyMetas {
-		cryptoKeyMetaResponse := &pb.CryptoKeyMetaResponse{
-			Id:              cryptoKeyMeta.ID,
-			DateTimeCreated: timestamppb.New(cryptoKeyMeta.DateTimeCreated),
-			UserId:          cryptoKeyMeta.UserID,
-			Algorithm:       cryptoKeyMeta.Algorithm,
-			KeySize:         uint32(cryptoKeyMeta.KeySize),
-			Type:            cryptoKeyMeta.Type,
-		}
-
 		// Send the metadata response to the client
-		if err := stream.Send(cryptoKeyMetaResponse); err != nil {
+		if err := stream.Send(newCryptoKeyMetaResponse(cryptoKeyMeta)); err != nil {
 			return fmt.Errorf("failed to send metadata response: %w", err)
 		}
 	}
@@ -327,6 +464,23 @@ func (s *CryptoKeyDownloadServer) DownloadByID(req *pb.KeyDownloadRequest, strea
 	return nil
 }
 
+// ExportWrappedByID exports a key by its ID wrapped with a vault AES key or an RSA public key
+func (s *CryptoKeyDownloadServer) ExportWrappedByID(ctx context.Context, req *pb.KeyExportRequest) (*pb.WrappedKeyContent, error) {
+	export, err := s.cryptoKeyDownloadService.ExportWrappedByID(ctx, req.Id, &keys.KeyExportWrapping{
+		WrappingKeyID: req.WrappingKeyId,
+		PublicKey:     req.PublicKey,
+	})
+	if err != nil {
+		return nil, fmt.Errorf("failed to export crypto key: %w", err)
+	}
+
+	return &pb.WrappedKeyContent{
+		WrappedKey:        export.WrappedKey,
+		WrappingAlgorithm: export.WrappingAlgorithm,
+		WrappingKeyId:     export.WrappingKeyID,
+	}, nil
+}
+
 // NewCryptoKeyMetadataServer creates a new instance of CryptoKeyMetadataServer.
 func NewCryptoKeyMetadataServer(cryptoKeyMetadataService keys.CryptoKeyMetadataService) (*CryptoKeyMetadataServer, error) {
 	return &CryptoKeyMetadataServer{
@@ -359,18 +513,8 @@ func (s *CryptoKeyMetadataServer) ListMetadata(req *pb.KeyMetadataQuery, stream
 	}
 
 	for _, cryptoKeyMeta := range cryptoKeyMetas {
-		cryptoKeyMetaResponse := &pb.CryptoKeyMetaResponse{
-			Id:              cryptoKeyMeta.ID,
-			KeyPairId:       cryptoKeyMeta.KeyPairID,
-			Algorithm:       cryptoKeyMeta.Algorithm,
-			KeySize:         uint32(cryptoKeyMeta.KeySize),
-			Type:            cryptoKeyMeta.Type,
-			DateTimeCreated: timestamppb.New(cryptoKeyMeta.DateTimeCreated),
-			UserId:          cryptoKeyMeta.UserID,
-		}
-
 		// Send the metadata response to the client
-		if err := stream.Send(cryptoKeyMetaResponse); err != nil {
+		if err := stream.Send(newCryptoKeyMetaResponse(cryptoKeyMeta)); err != nil {
 			return fmt.Errorf("failed to send metadata response: %w", err)
 		}
 	}
@@ -385,29 +529,511 @@ func (s *CryptoKeyMetadataServer) GetMetadataByID(ctx context.Context, req *pb.I
 		return nil, fmt.Errorf("failed to get crypto key metadata by ID: %w", err)
 	}
 
-	return &pb.CryptoKeyMetaResponse{
-		Id:              cryptoKeyMeta.ID,
-		KeyPairId:       cryptoKeyMeta.KeyPairID,
-		Algorithm:       cryptoKeyMeta.Algorithm,
-		KeySize:         uint32(cryptoKeyMeta.KeySize),
-		Type:            cryptoKeyMeta.Type,
-		DateTimeCreated: timestamppb.New(cryptoKeyMeta.DateTimeCreated),
-		UserId:          cryptoKeyMeta.UserID,
+	return newCryptoKeyMetaResponse(cryptoKeyMeta), nil
+}
+
+// DeleteByID schedules the destruction of the key pair a key belongs to and streams the metadata of its keys
+func (s *CryptoKeyMetadataServer) DeleteByID(req *pb.DeleteKeyRequest, stream pb.CryptoKeyMetadata_DeleteByIDServer) error {
+	cryptoKeyMetas, err := s.cryptoKeyMetadataService.DeleteByID(stream.Context(), req.Id, req.Force)
+	if err != nil {
+		return fmt.Errorf("failed to delete crypto key: %w", err)
+	}
+
+	for _, cryptoKeyMeta := range cryptoKeyMetas {
+		// Send the metadata response to the client
+		if err := stream.Send(newCryptoKeyMetaResponse(cryptoKeyMeta)); err != nil {
+			return fmt.Errorf("failed to send metadata response: %w", err)
+		}
+	}
+
+	return nil
+}
+
+// CancelDestruction cancels the scheduled destruction of the key pair a key belongs to and streams the metadata of its keys
+func (s *CryptoKeyMetadataServer) CancelDestruction(req *pb.IdRequest, stream pb.CryptoKeyMetadata_CancelDestructionServer) error {
+	cryptoKeyMetas, err := s.cryptoKeyMetadataService.CancelDestruction(stream.Context(), req.Id)
+	if err != nil {
+		return fmt.Errorf("failed to cancel destruction of crypto key: %w", err)
+	}
+
+	for _, cryptoKeyMeta := range cryptoKeyMetas {
+		// Send the metadata response to the client
+		if err := stream.Send(newCryptoKeyMetaResponse(cryptoKeyMeta)); err != nil {
+			return fmt.Errorf("failed to send metadata response: %w", err)
+		}
+	}
+
+	return nil
+}
+
+// UpdateLifecycle transitions the lifecycle state of the key pair a key belongs to and reschedules its activation or expiry
+func (s *CryptoKeyMetadataServer) UpdateLifecycle(req *pb.UpdateKeyLifecycleRequest, stream pb.CryptoKeyMetadata_UpdateLifecycleServer) error {
+	lifecycle := newCryptoKeyLifecycle(req.State, req.DateTimeActivation, req.DateTimeExpires)
+	lifecycle.Force = req.Force
+	cryptoKeyMetas, err := s.cryptoKeyMetadataService.UpdateLifecycle(stream.Context(), req.Id, lifecycle)
+	if err != nil {
+		return fmt.Errorf("failed to update lifecycle of crypto key: %w", err)
+	}
+
+	for _, cryptoKeyMeta := range cryptoKeyMetas {
+		// Send the metadata response to the client
+		if err := stream.Send(newCryptoKeyMetaResponse(cryptoKeyMeta)); err != nil {
+			return fmt.Errorf("failed to send metadata response: %w", err)
+		}
+	}
+
+	return nil
+}
+
+// UpdateExportPolicy forbids the plaintext export of the key pair a key belongs to
+func (s *CryptoKeyMetadataServer) UpdateExportPolicy(req *pb.UpdateExportPolicyRequest, stream pb.CryptoKeyMetadata_UpdateExportPolicyServer) error {
+	cryptoKeyMetas, err := s.cryptoKeyMetadataService.UpdateExportPolicy(stream.Context(), req.Id, req.PlaintextExportForbidden)
+	if err != nil {
+		return fmt.Errorf("failed to update export policy of crypto key: %w", err)
+	}
+
+	for _, cryptoKeyMeta := range cryptoKeyMetas {
+		// Send the metadata response to the client
+		if err := stream.Send(newCryptoKeyMetaResponse(cryptoKeyMeta)); err != nil {
+			return fmt.Errorf("failed to send metadata response: %w", err)
+		}
+	}
+
+	return nil
+}
+
+// newCryptoKeyLifecycle maps the lifecycle fields of a gRPC request to a CryptoKeyLifecycle, leaving unset timestamps nil
+func newCryptoKeyLifecycle(state string, dateTimeActivation, dateTimeExpires *timestamppb.Timestamp) *keys.CryptoKeyLifecycle {
+	lifecycle := &keys.CryptoKeyLifecycle{State: state}
+	if dateTimeActivation != nil {
+		activation := dateTimeActivation.AsTime()
+		lifecycle.DateTimeActivation = &activation
+	}
+	if dateTimeExpires != nil {
+		expires := dateTimeExpires.AsTime()
+		lifecycle.DateTimeExpires = &expires
+	}
+	return lifecycle
+}
+
+// newCryptoKeyMetaResponse maps a CryptoKeyMeta to its gRPC response, reporting the lifecycle state the key is in now
+func newCryptoKeyMetaResponse(cryptoKeyMeta *keys.CryptoKeyMeta) *pb.CryptoKeyMetaResponse {
+	response := &pb.CryptoKeyMetaResponse{
+		Id:                       cryptoKeyMeta.ID,
+		KeyPairId:                cryptoKeyMeta.KeyPairID,
+		Algorithm:                cryptoKeyMeta.Algorithm,
+		KeySize:                  uint32(cryptoKeyMeta.KeySize),
+		Type:                     cryptoKeyMeta.Type,
+		DateTimeCreated:          timestamppb.New(cryptoKeyMeta.DateTimeCreated),
+		UserId:                   cryptoKeyMeta.UserID,
+		State:                    cryptoKeyMeta.EffectiveState(time.Now()),
+		LogicalKeyId:             cryptoKeyMeta.LogicalKeyID,
+		Version:                  cryptoKeyMeta.Version,
+		PlaintextExportForbidden: cryptoKeyMeta.PlaintextExportForbidden,
+		TokenLabel:               cryptoKeyMeta.TokenLabel,
+	}
+	if cryptoKeyMeta.DateTimeActivation != nil {
+		response.DateTimeActivation = timestamppb.New(*cryptoKeyMeta.DateTimeActivation)
+	}
+	if cryptoKeyMeta.DateTimeExpires != nil {
+		response.DateTimeExpires = timestamppb.New(*cryptoKeyMeta.DateTimeExpires)
+	}
+	if cryptoKeyMeta.DateTimeDestruction != nil {
+		response.DateTimeDestruction = timestamppb.New(*cryptoKeyMeta.DateTimeDestruction)
+	}
+	return response
+}
+
+// NewCryptoKeyRotationServer creates a new instance of CryptoKeyRotationServer.
+func NewCryptoKeyRotationServer(cryptoKeyRotationService keys.CryptoKeyRotationService) (*CryptoKeyRotationServer, error) {
+	return &CryptoKeyRotationServer{
+		cryptoKeyRotationService: cryptoKeyRotationService,
+	}, nil
+}
+
+// Rotate generates a new primary version of the logical key a key belongs to and streams the metadata of its keys
+func (s *CryptoKeyRotationServer) Rotate(req *pb.IdRequest, stream pb.CryptoKeyRotation_RotateServer) error {
+	cryptoKeyMetas, err := s.cryptoKeyRotationService.Rotate(stream.Context(), req.Id)
+	if err != nil {
+		return fmt.Errorf("failed to rotate crypto key: %w", err)
+	}
+
+	for _, cryptoKeyMeta := range cryptoKeyMetas {
+		// Send the metadata response to the client
+		if err := stream.Send(newCryptoKeyMetaResponse(cryptoKeyMeta)); err != nil {
+			return fmt.Errorf("failed to send metadata response: %w", err)
+		}
+	}
+
+	return nil
+}
+
+// ListVersions streams the metadata of all versions of the logical key a key belongs to
+func (s *CryptoKeyRotationServer) ListVersions(req *pb.IdRequest, stream pb.CryptoKeyRotation_ListVersionsServer) error {
+	cryptoKeyMetas, err := s.cryptoKeyRotationService.ListVersions(stream.Context(), req.Id)
+	if err != nil {
+		return fmt.Errorf("failed to list versions of crypto key: %w", err)
+	}
+
+	for _, cryptoKeyMeta := range cryptoKeyMetas {
+		// Send the metadata response to the client
+		if err := stream.Send(newCryptoKeyMetaResponse(cryptoKeyMeta)); err != nil {
+			return fmt.Errorf("failed to send metadata response: %w", err)
+		}
+	}
+
+	return nil
+}
+
+// UpdateRotationPolicy sets the period after which the logical key a key belongs to is rotated automatically
+func (s *CryptoKeyRotationServer) UpdateRotationPolicy(ctx context.Context, req *pb.UpdateRotationPolicyRequest) (*pb.LogicalKeyResponse, error) {
+	var rotationPeriod time.Duration
+	if req.RotationPeriod != "" {
+		var err error
+		rotationPeriod, err = time.ParseDuration(req.RotationPeriod)
+		if err != nil {
+			return nil, fmt.Errorf("invalid rotation period: %w", err)
+		}
+	}
+
+	logicalKey, err := s.cryptoKeyRotationService.UpdateRotationPolicy(ctx, req.Id, rotationPeriod)
+	if err != nil {
+		return nil, fmt.Errorf("failed to update rotation policy of crypto key: %w", err)
+	}
+
+	return newLogicalKeyResponse(logicalKey), nil
+}
+
+// newLogicalKeyResponse maps a LogicalKey to its gRPC response
+func newLogicalKeyResponse(logicalKey *keys.LogicalKey) *pb.LogicalKeyResponse {
+	response := &pb.LogicalKeyResponse{
+		Id:               logicalKey.ID,
+		UserId:           logicalKey.UserID,
+		Algorithm:        logicalKey.Algorithm,
+		KeySize:          logicalKey.KeySize,
+		PrimaryVersion:   logicalKey.PrimaryVersion,
+		PrimaryKeyPairId: logicalKey.PrimaryKeyPairID,
+		LatestVersion:    logicalKey.LatestVersion,
+		RotationPeriod:   logicalKey.RotationPeriod.String(),
+		DateTimeCreated:  timestamppb.New(logicalKey.DateTimeCreated),
+	}
+	if logicalKey.DateTimeNextRotation != nil {
+		response.DateTimeNextRotation = timestamppb.New(*logicalKey.DateTimeNextRotation)
+	}
+	if logicalKey.DateTimeRotated != nil {
+		response.DateTimeRotated = timestamppb.New(*logicalKey.DateTimeRotated)
+	}
+	return response
+}
+
+// NewCryptoKeyImportServer creates a new instance of CryptoKeyImportServer.
+func NewCryptoKeyImportServer(cryptoKeyImportService keys.CryptoKeyImportService) (*CryptoKeyImportServer, error) {
+	return &CryptoKeyImportServer{
+		cryptoKeyImportService: cryptoKeyImportService,
+	}, nil
+}
+
+// GetImportPublicKey returns the public key key material is wrapped with before importing it wrapped
+func (s *CryptoKeyImportServer) GetImportPublicKey(ctx context.Context, req *pb.GetImportPublicKeyRequest) (*pb.KeyImportPublicKeyResponse, error) {
+	publicKey, err := s.cryptoKeyImportService.GetImportPublicKey(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get key import public key: %w", err)
+	}
+
+	return &pb.KeyImportPublicKeyResponse{
+		PublicKey:         string(publicKey),
+		WrappingAlgorithm: cryptography.RSAAESKeyWrapAlgorithm,
+	}, nil
+}
+
+// Import imports key material as a new logical key and streams the metadata of its keys
+func (s *CryptoKeyImportServer) Import(req *pb.ImportKeyRequest, stream pb.CryptoKeyImport_ImportServer) error {
+	keyImport := &keys.CryptoKeyImport{
+		Algorithm:   req.Algorithm,
+		Format:      req.Format,
+		KeyMaterial: req.KeyMaterial,
+		Wrapped:     req.Wrapped,
+	}
+
+	cryptoKeyMetas, err := s.cryptoKeyImportService.Import(stream.Context(), keyImport, newCryptoKeyLifecycle(req.State, req.DateTimeActivation, req.DateTimeExpires))
+	if err != nil {
+		return fmt.Errorf("failed to import crypto keys: %w", err)
+	}
+
+	for _, cryptoKeyMeta := range cryptoKeyMetas {
+		// Send the metadata response to the client
+		if err := stream.Send(newCryptoKeyMetaResponse(cryptoKeyMeta)); err != nil {
+			return fmt.Errorf("failed to send metadata response: %w", err)
+		}
+	}
+
+	return nil
+}
+
+// NewMasterKeyRotationServer creates a new instance of MasterKeyRotationServer.
+func NewMasterKeyRotationServer(masterKeyRotationService keys.MasterKeyRotationService) (*MasterKeyRotationServer, error) {
+	return &MasterKeyRotationServer{
+		masterKeyRotationService: masterKeyRotationService,
+	}, nil
+}
+
+// Rotate rotates the master key and starts re-wrapping all stored keys in the background
+func (s *MasterKeyRotationServer) Rotate(ctx context.Context, req *pb.RotateMasterKeyRequest) (*pb.MasterKeyRotationResponse, error) {
+	rotation, err := s.masterKeyRotationService.Rotate(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("failed to rotate master key: %w", err)
+	}
+
+	return newMasterKeyRotationResponse(rotation), nil
+}
+
+// GetRotationByID retrieves the progress of a master key rotation by its ID
+func (s *MasterKeyRotationServer) GetRotationByID(ctx context.Context, req *pb.IdRequest) (*pb.MasterKeyRotationResponse, error) {
+	rotation, err := s.masterKeyRotationService.GetByID(ctx, req.Id)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get master key rotation by ID: %w", err)
+	}
+
+	return newMasterKeyRotationResponse(rotation), nil
+}
+
+// newMasterKeyRotationResponse maps a MasterKeyRotation to its gRPC response
+func newMasterKeyRotationResponse(rotation *keys.MasterKeyRotation) *pb.MasterKeyRotationResponse {
+	return &pb.MasterKeyRotationResponse{
+		Id:               rotation.ID,
+		TargetKekVersion: rotation.TargetKEKVersion,
+		Status:           rotation.Status,
+		ProcessedKeys:    int64(rotation.Offset),
+		RewrappedKeys:    int64(rotation.RewrappedKeys),
+		Error:            rotation.Error,
+		DateTimeStarted:  timestamppb.New(rotation.DateTimeStarted),
+		DateTimeUpdated:  timestamppb.New(rotation.DateTimeUpdated),
+	}
+}
+
+// NewCryptoOperationServer creates a new instance of CryptoOperationServer.
+func NewCryptoOperationServer(cryptoOperationService crypto.CryptoOperationService) (*CryptoOperationServer, error) {
+	return &CryptoOperationServer{
+		cryptoOperationService: cryptoOperationService,
+	}, nil
+}
+
+// Encrypt encrypts a payload with an AES key or the public key of an RSA key pair
+func (s *CryptoOperationServer) Encrypt(ctx context.Context, req *pb.CryptoOperationRequest) (*pb.CryptoOperationResponse, error) {
+	result, err := s.cryptoOperationService.Encrypt(ctx, req.KeyId, req.Payload, req.AssociatedData)
+	if err != nil {
+		return nil, fmt.Errorf("failed to encrypt with key: %w", err)
+	}
+
+	return newCryptoOperationResponse(result), nil
+}
+
+// Decrypt decrypts a ciphertext returned by Encrypt
+func (s *CryptoOperationServer) Decrypt(ctx context.Context, req *pb.CryptoOperationRequest) (*pb.CryptoOperationResponse, error) {
+	result, err := s.cryptoOperationService.Decrypt(ctx, req.KeyId, req.Payload, req.AssociatedData)
+	if err != nil {
+		return nil, fmt.Errorf("failed to decrypt with key: %w", err)
+	}
+
+	return newCryptoOperationResponse(result), nil
+}
+
+// Sign signs a payload with the private key of an RSA or EC key pair
+func (s *CryptoOperationServer) Sign(ctx context.Context, req *pb.CryptoOperationRequest) (*pb.CryptoOperationResponse, error) {
+	result, err := s.cryptoOperationService.Sign(ctx, req.KeyId, req.Payload)
+	if err != nil {
+		return nil, fmt.Errorf("failed to sign with key: %w", err)
+	}
+
+	return newCryptoOperationResponse(result), nil
+}
+
+// Verify verifies the signature of a payload with the public key of an RSA or EC key pair
+func (s *CryptoOperationServer) Verify(ctx context.Context, req *pb.CryptoOperationRequest) (*pb.CryptoOperationResponse, error) {
+	result, err := s.cryptoOperationService.Verify(ctx, req.KeyId, req.Payload, req.Signature)
+	if err != nil {
+		return nil, fmt.Errorf("failed to verify with key: %w", err)
+	}
+
+	return newCryptoOperationResponse(result), nil
+}
+
+// Wrap wraps key material with an AES key or the public key of an RSA key pair
+func (s *CryptoOperationServer) Wrap(ctx context.Context, req *pb.CryptoOperationRequest) (*pb.CryptoOperationResponse, error) {
+	result, err := s.cryptoOperationService.Wrap(ctx, req.KeyId, req.Payload)
+	if err != nil {
+		return nil, fmt.Errorf("failed to wrap with key: %w", err)
+	}
+
+	return newCryptoOperationResponse(result), nil
+}
+
+// Unwrap unwraps key material returned by Wrap
+func (s *CryptoOperationServer) Unwrap(ctx context.Context, req *pb.CryptoOperationRequest) (*pb.CryptoOperationResponse, error) {
+	result, err := s.cryptoOperationService.Unwrap(ctx, req.KeyId, req.Payload)
+	if err != nil {
+		return nil, fmt.Errorf("failed to unwrap with key: %w", err)
+	}
+
+	return newCryptoOperationResponse(result), nil
+}
+
+// newCryptoOperationResponse maps an OperationResult to its gRPC response
+func newCryptoOperationResponse(result *crypto.OperationResult) *pb.CryptoOperationResponse {
+	return &pb.CryptoOperationResponse{
+		Operation:  result.Operation,
+		KeyId:      result.KeyID,
+		KeyPairId:  result.KeyPairID,
+		KeyVersion: result.KeyVersion,
+		Algorithm:  result.Algorithm,
+		Ciphertext: result.Ciphertext,
+		Plaintext:  result.Plaintext,
+		Signature:  result.Signature,
+		WrappedKey: result.WrappedKey,
+		Key:        result.Key,
+		Valid:      result.Valid != nil && *result.Valid,
+	}
+}
+
+// NewPkcs11AdminServer creates a new instance of Pkcs11AdminServer.
+func NewPkcs11AdminServer(pkcs11AdminService pkcs11.PKCS11AdminService) (*Pkcs11AdminServer, error) {
+	return &Pkcs11AdminServer{
+		pkcs11AdminService: pkcs11AdminService,
+	}, nil
+}
+
+// ListTokenSlots lists the tokens present in the slots of the PKCS#11 module
+func (s *Pkcs11AdminServer) ListTokenSlots(req *pb.ListPkcs11TokensRequest, stream pb.Pkcs11Admin_ListTokenSlotsServer) error {
+	tokens, err := s.pkcs11AdminService.ListTokenSlots(stream.Context())
+	if err != nil {
+		return fmt.Errorf("failed to list tokens: %w", err)
+	}
+
+	for _, token := range tokens {
+		tokenResponse := &pb.Pkcs11TokenResponse{
+			SlotId:       token.SlotID,
+			Label:        token.Label,
+			Manufacturer: token.Manufacturer,
+			Model:        token.Model,
+			SerialNumber: token.SerialNumber,
+		}
+		if err := stream.Send(tokenResponse); err != nil {
+			return fmt.Errorf("failed to send token response: %w", err)
+		}
+	}
+
+	return nil
+}
+
+// InitializeToken initializes the token of the configured slot with the label
+func (s *Pkcs11AdminServer) InitializeToken(ctx context.Context, req *pb.Pkcs11TokenRequest) (*pb.InfoResponse, error) {
+	if err := s.pkcs11AdminService.InitializeToken(ctx, req.TokenLabel); err != nil {
+		return nil, fmt.Errorf("failed to initialize token: %w", err)
+	}
+
+	return &pb.InfoResponse{
+		Message: fmt.Sprintf("token %s initialized successfully", req.TokenLabel),
 	}, nil
 }
 
-// DeleteByID deletes a key by its ID
-func (s *CryptoKeyMetadataServer) DeleteByID(ctx context.Context, req *pb.IdRequest) (*pb.InfoResponse, error) {
-	err := s.cryptoKeyMetadataService.DeleteByID(ctx, req.Id)
+// ListObjects lists the objects stored on a token
+func (s *Pkcs11AdminServer) ListObjects(req *pb.Pkcs11TokenRequest, stream pb.Pkcs11Admin_ListObjectsServer) error {
+	objects, err := s.pkcs11AdminService.ListObjects(stream.Context(), req.TokenLabel)
 	if err != nil {
-		return nil, fmt.Errorf("failed to delete crypto key: %w", err)
+		return fmt.Errorf("failed to list objects: %w", err)
+	}
+
+	for _, object := range objects {
+		objectResponse := &pb.Pkcs11ObjectResponse{
+			Label:  object.Label,
+			Type:   object.Type,
+			Usage:  object.Usage,
+			Access: object.Access,
+		}
+		if err := stream.Send(objectResponse); err != nil {
+			return fmt.Errorf("failed to send object response: %w", err)
+		}
+	}
+
+	return nil
+}
+
+// AddKey generates an RSA or ECDSA key pair or an AES key on a token
+func (s *Pkcs11AdminServer) AddKey(ctx context.Context, req *pb.AddPkcs11KeyRequest) (*pb.InfoResponse, error) {
+	if err := s.pkcs11AdminService.AddKey(ctx, req.TokenLabel, req.ObjectLabel, req.KeyType, req.KeySize); err != nil {
+		return nil, fmt.Errorf("failed to add key: %w", err)
+	}
+
+	return &pb.InfoResponse{
+		Message: fmt.Sprintf("%s key %s added to token %s successfully", req.KeyType, req.ObjectLabel, req.TokenLabel),
+	}, nil
+}
+
+// DeleteObject deletes the objects of a type and label from a token
+func (s *Pkcs11AdminServer) DeleteObject(ctx context.Context, req *pb.DeletePkcs11ObjectRequest) (*pb.InfoResponse, error) {
+	if err := s.pkcs11AdminService.DeleteObject(ctx, req.TokenLabel, req.ObjectType, req.ObjectLabel); err != nil {
+		return nil, fmt.Errorf("failed to delete object: %w", err)
 	}
 
 	return &pb.InfoResponse{
-		Message: fmt.Sprintf("crypto key with id %s deleted successfully", req.Id),
+		Message: fmt.Sprintf("%s object %s deleted from token %s successfully", req.ObjectType, req.ObjectLabel, req.TokenLabel),
 	}, nil
 }
 
+// Encrypt encrypts a payload with the public key of an RSA key pair or an AES key on a token
+func (s *Pkcs11AdminServer) Encrypt(ctx context.Context, req *pb.Pkcs11OperationRequest) (*pb.Pkcs11OperationResponse, error) {
+	result, err := s.pkcs11AdminService.Encrypt(ctx, req.TokenLabel, req.ObjectLabel, req.KeyType, req.Payload)
+	if err != nil {
+		return nil, fmt.Errorf("failed to encrypt on token: %w", err)
+	}
+
+	return newPkcs11OperationResponse(result), nil
+}
+
+// Decrypt decrypts a ciphertext with the private key of an RSA key pair or an AES key on a token
+func (s *Pkcs11AdminServer) Decrypt(ctx context.Context, req *pb.Pkcs11OperationRequest) (*pb.Pkcs11OperationResponse, error) {
+	result, err := s.pkcs11AdminService.Decrypt(ctx, req.TokenLabel, req.ObjectLabel, req.KeyType, req.Payload)
+	if err != nil {
+		return nil, fmt.Errorf("failed to decrypt on token: %w", err)
+	}
+
+	return newPkcs11OperationResponse(result), nil
+}
+
+// Sign signs a payload with the private key of an RSA or ECDSA key pair on a token
+func (s *Pkcs11AdminServer) Sign(ctx context.Context, req *pb.Pkcs11OperationRequest) (*pb.Pkcs11OperationResponse, error) {
+	result, err := s.pkcs11AdminService.Sign(ctx, req.TokenLabel, req.ObjectLabel, req.KeyType, req.Payload)
+	if err != nil {
+		return nil, fmt.Errorf("failed to sign on token: %w", err)
+	}
+
+	return newPkcs11OperationResponse(result), nil
+}
+
+// Verify verifies the signature of a payload with the public key of an RSA or ECDSA key pair on a token
+func (s *Pkcs11AdminServer) Verify(ctx context.Context, req *pb.Pkcs11OperationRequest) (*pb.Pkcs11OperationResponse, error) {
+	result, err := s.pkcs11AdminService.Verify(ctx, req.TokenLabel, req.ObjectLabel, req.KeyType, req.Payload, req.Signature)
+	if err != nil {
+		return nil, fmt.Errorf("failed to verify on token: %w", err)
+	}
+
+	return newPkcs11OperationResponse(result), nil
+}
+
+// newPkcs11OperationResponse maps an OperationResult of a token to its gRPC response
+func newPkcs11OperationResponse(result *pkcs11.OperationResult) *pb.Pkcs11OperationResponse {
+	return &pb.Pkcs11OperationResponse{
+		Operation:   result.Operation,
+		TokenLabel:  result.TokenLabel,
+		ObjectLabel: result.ObjectLabel,
+		KeyType:     result.KeyType,
+		Ciphertext:  result.Ciphertext,
+		Plaintext:   result.Plaintext,
+		Signature:   result.Signature,
+		Valid:       result.Valid != nil && *result.Valid,
+	}
+}
+
 // Register the gRPC handlers for each service
 
 // RegisterBlobUploadServer registers the BlobUpload gRPC service with the server
@@ -425,6 +1051,11 @@ func RegisterBlobMetadataServer(server *grpc.Server, blobMetadataServer *BlobMet
 	pb.RegisterBlobMetadataServer(server, blobMetadataServer)
 }
 
+// RegisterBlobGrantServer registers the BlobGrant gRPC service with the server
+func RegisterBlobGrantServer(server *grpc.Server, blobGrantServer *BlobGrantServer) {
+	pb.RegisterBlobGrantServer(server, blobGrantServer)
+}
+
 // RegisterCryptoKeyUploadServer registers the CryptoKeyUpload gRPC service with the server
 func RegisterCryptoKeyUploadServer(server *grpc.Server, cryptoKeyUploadServer *CryptoKeyUploadServer) {
 	pb.RegisterCryptoKeyUploadServer(server, cryptoKeyUploadServer)
@@ -440,6 +1071,31 @@ func RegisterCryptoKeyMetadataServer(server *grpc.Server, cryptoKeyMetadataServe
 	pb.RegisterCryptoKeyMetadataServer(server, cryptoKeyMetadataServer)
 }
 
+// RegisterCryptoKeyRotationServer registers the CryptoKeyRotation gRPC service with the server
+func RegisterCryptoKeyRotationServer(server *grpc.Server, cryptoKeyRotationServer *CryptoKeyRotationServer) {
+	pb.RegisterCryptoKeyRotationServer(server, cryptoKeyRotationServer)
+}
+
+// RegisterCryptoKeyImportServer registers the CryptoKeyImport gRPC service with the server
+func RegisterCryptoKeyImportServer(server *grpc.Server, cryptoKeyImportServer *CryptoKeyImportServer) {
+	pb.RegisterCryptoKeyImportServer(server, cryptoKeyImportServer)
+}
+
+// RegisterMasterKeyRotationServer registers the MasterKeyRotation gRPC service with the server
+func RegisterMasterKeyRotationServer(server *grpc.Server, masterKeyRotationServer *MasterKeyRotationServer) {
+	pb.RegisterMasterKeyRotationServer(server, masterKeyRotationServer)
+}
+
+// RegisterCryptoOperationServer registers the CryptoOperation gRPC service with the server
+func RegisterCryptoOperationServer(server *grpc.Server, cryptoOperationServer *CryptoOperationServer) {
+	pb.RegisterCryptoOperationServer(server, cryptoOperationServer)
+}
+
+// RegisterPkcs11AdminServer registers the Pkcs11Admin gRPC service with the server
+func RegisterPkcs11AdminServer(server *grpc.Server, pkcs11AdminServer *Pkcs11AdminServer) {
+	pb.RegisterPkcs11AdminServer(server, pkcs11AdminServer)
+}
+
 // Register the gRPC-Gateway handlers for each service
 
 // Multipart file uploads are not supported with grpc-gateway. For more details,
@@ -470,6 +1126,15 @@ func RegisterBlobMetadataGateway(ctx context.Context, gatewayTarget string, gwmu
 	return nil
 }
 
+// RegisterBlobGrantGateway registers the BlobGrant HTTP gateway handler.
+func RegisterBlobGrantGateway(ctx context.Context, gatewayTarget string, gwmux *runtime.ServeMux, _ *grpc.ClientConn, creds credentials.TransportCredentials) error {
+	err := pb.RegisterBlobGrantHandlerFromEndpoint(ctx, gwmux, gatewayTarget, []grpc.DialOption{grpc.WithTransportCredentials(creds)})
+	if err != nil {
+		return fmt.Errorf("failed to register blob grant gateway: %w", err)
+	}
+	return nil
+}
+
 // RegisterCryptoKeyUploadGateway registers the CryptoKeyUpload HTTP gateway handler.
 func RegisterCryptoKeyUploadGateway(ctx context.Context, gatewayTarget string, gwmux *runtime.ServeMux, _ *grpc.ClientConn, creds credentials.TransportCredentials) error {
 	err := pb.RegisterCryptoKeyUploadHandlerFromEndpoint(ctx, gwmux, gatewayTarget, []grpc.DialOption{grpc.WithTransportCredentials(creds)})
@@ -496,3 +1161,48 @@ func RegisterCryptoKeyMetadataGateway(ctx context.Context, gatewayTarget string,
 	}
 	return nil
 }
+
+// RegisterCryptoKeyRotationGateway registers the CryptoKeyRotation HTTP gateway handler.
+func RegisterCryptoKeyRotationGateway(ctx context.Context, gatewayTarget string, gwmux *runtime.ServeMux, _ *grpc.ClientConn, creds credentials.TransportCredentials) error {
+	err := pb.RegisterCryptoKeyRotationHandlerFromEndpoint(ctx, gwmux, gatewayTarget, []grpc.DialOption{grpc.WithTransportCredentials(creds)})
+	if err != nil {
+		return fmt.Errorf("failed to register crypto key rotation gateway: %w", err)
+	}
+	return nil
+}
+
+// RegisterCryptoKeyImportGateway registers the CryptoKeyImport HTTP gateway handler.
+func RegisterCryptoKeyImportGateway(ctx context.Context, gatewayTarget string, gwmux *runtime.ServeMux, _ *grpc.ClientConn, creds credentials.TransportCredentials) error {
+	err := pb.RegisterCryptoKeyImportHandlerFromEndpoint(ctx, gwmux, gatewayTarget, []grpc.DialOption{grpc.WithTransportCredentials(creds)})
+	if err != nil {
+		return fmt.Errorf("failed to register crypto key import gateway: %w", err)
+	}
+	return nil
+}
+
+// RegisterMasterKeyRotationGateway registers the MasterKeyRotation HTTP gateway handler.
+func RegisterMasterKeyRotationGateway(ctx context.Context, gatewayTarget string, gwmux *runtime.ServeMux, _ *grpc.ClientConn, creds credentials.TransportCredentials) error {
+	err := pb.RegisterMasterKeyRotationHandlerFromEndpoint(ctx, gwmux, gatewayTarget, []grpc.DialOption{grpc.WithTransportCredentials(creds)})
+	if err != nil {
+		return fmt.Errorf("failed to register master key rotation gateway: %w", err)
+	}
+	return nil
+}
+
+// RegisterCryptoOperationGateway registers the CryptoOperation HTTP gateway handler.
+func RegisterCryptoOperationGateway(ctx context.Context, gatewayTarget string, gwmux *runtime.ServeMux, _ *grpc.ClientConn, creds credentials.TransportCredentials) error {
+	err := pb.RegisterCryptoOperationHandlerFromEndpoint(ctx, gwmux, gatewayTarget, []grpc.DialOption{grpc.WithTransportCredentials(creds)})
+	if err != nil {
+		return fmt.Errorf("failed to register crypto operation gateway: %w", err)
+	}
+	return nil
+}
+
+// RegisterPkcs11AdminGateway registers the Pkcs11Admin HTTP gateway handler.
+func RegisterPkcs11AdminGateway(ctx context.Context, gatewayTarget string, gwmux *runtime.ServeMux, _ *grpc.ClientConn, creds credentials.TransportCredentials) error {
+	err := pb.RegisterPkcs11AdminHandlerFromEndpoint(ctx, gwmux, gatewayTarget, []grpc.DialOption{grpc.WithTransportCredentials(creds)})
+	if err != nil {
+		return fmt.Errorf("failed to register pkcs11 admin gateway: %w", err)
+	}
+	return nil
+}
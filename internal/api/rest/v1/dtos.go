@@ -11,8 +11,11 @@ import (
 
 // UploadKeyRequest represents the request structure for uploading a cryptographic key
 type UploadKeyRequest struct {
-	Algorithm string `json:"algorithm" validate:"omitempty,oneof=AES RSA EC"`
-	KeySize   uint32 `json:"key_size" validate:"omitempty,keySizeValidation"`
+	Algorithm          string     `json:"algorithm" validate:"omitempty,oneof=AES RSA EC"`
+	KeySize            uint32     `json:"key_size" validate:"omitempty,keySizeValidation"`
+	State              string     `json:"state" validate:"omitempty,oneof=pre-active active"` // Optional initial state, keys are pre-active if their activation is scheduled and active otherwise
+	DateTimeActivation *time.Time `json:"date_time_activation" validate:"omitempty"`          // Optional time from which pre-active keys are active
+	DateTimeExpires    *time.Time `json:"date_time_expires" validate:"omitempty"`             // Optional time from which the keys are deactivated
 }
 
 // Validate method for UploadKeyRequest struct
@@ -39,6 +42,318 @@ func (k *UploadKeyRequest) Validate() error {
 	return nil
 }
 
+// ImportKeyRequest represents the request structure for importing cryptographic key material generated outside the service. Key material is base64 encoded.
+type ImportKeyRequest struct {
+	Algorithm          string     `json:"algorithm" validate:"required,oneof=AES RSA EC"`
+	Format             string     `json:"format" validate:"required,oneof=pem der jwk raw"`   // Encoding of the key material: PEM or DER encoded PKCS#1, PKCS#8, SEC1 or PKIX keys, JWK, or raw AES keys
+	KeyMaterial        []byte     `json:"key_material" validate:"required,max=65536"`         // Key material, wrapped with the key import public key if wrapped is set
+	Wrapped            bool       `json:"wrapped"`                                            // Whether the key material is wrapped with RSA_AES_KEY_WRAP_SHA_256 under the key import public key
+	State              string     `json:"state" validate:"omitempty,oneof=pre-active active"` // Optional initial state, keys are pre-active if their activation is scheduled and active otherwise
+	DateTimeActivation *time.Time `json:"date_time_activation" validate:"omitempty"`          // Optional time from which pre-active keys are active
+	DateTimeExpires    *time.Time `json:"date_time_expires" validate:"omitempty"`             // Optional time from which the keys are deactivated
+}
+
+// Validate method for ImportKeyRequest struct
+func (k *ImportKeyRequest) Validate() error {
+	validate := validator.New()
+
+	err := validate.Struct(k)
+	if err != nil {
+		var validationErrors validator.ValidationErrors
+		if errors.As(err, &validationErrors) {
+			var messages []string
+			for _, fieldErr := range validationErrors {
+				messages = append(messages, fmt.Sprintf("Field: %s, Tag: %s", fieldErr.Field(), fieldErr.Tag()))
+			}
+			return fmt.Errorf("validation failed: %v", messages)
+		}
+		return fmt.Errorf("validation error: %w", err)
+	}
+
+	return nil
+}
+
+// UpdateKeyLifecycleRequest represents the request structure for transitioning the lifecycle state of a cryptographic key and rescheduling its activation or expiry
+type UpdateKeyLifecycleRequest struct {
+	State              string     `json:"state" validate:"omitempty,oneof=pre-active active suspended deactivated destroyed"`
+	DateTimeActivation *time.Time `json:"date_time_activation" validate:"omitempty"`
+	DateTimeExpires    *time.Time `json:"date_time_expires" validate:"omitempty"`
+	Force              bool       `json:"force"` // Schedule the destruction even if blobs are still protected with the keys
+}
+
+// Validate method for UpdateKeyLifecycleRequest struct
+func (l *UpdateKeyLifecycleRequest) Validate() error {
+	validate := validator.New()
+
+	err := validate.Struct(l)
+	if err != nil {
+		var validationErrors validator.ValidationErrors
+		if errors.As(err, &validationErrors) {
+			var messages []string
+			for _, fieldErr := range validationErrors {
+				messages = append(messages, fmt.Sprintf("Field: %s, Tag: %s", fieldErr.Field(), fieldErr.Tag()))
+			}
+			return fmt.Errorf("validation failed: %v", messages)
+		}
+		return fmt.Errorf("validation error: %w", err)
+	}
+
+	if l.State == "" && l.DateTimeActivation == nil && l.DateTimeExpires == nil {
+		return fmt.Errorf("validation failed: at least one of state, date_time_activation and date_time_expires is required")
+	}
+
+	return nil
+}
+
+// UpdateRotationPolicyRequest represents the request structure for setting how often a logical key is rotated automatically
+type UpdateRotationPolicyRequest struct {
+	RotationPeriod string `json:"rotation_period" validate:"omitempty"` // e.g. "720h", empty or "0" disables automatic rotation
+}
+
+// Period parses the rotation period, an empty value disables automatic rotation
+func (r *UpdateRotationPolicyRequest) Period() (time.Duration, error) {
+	if r.RotationPeriod == "" {
+		return 0, nil
+	}
+
+	rotationPeriod, err := time.ParseDuration(r.RotationPeriod)
+	if err != nil {
+		return 0, fmt.Errorf("invalid rotation period: %w", err)
+	}
+
+	return rotationPeriod, nil
+}
+
+// Validate method for UpdateRotationPolicyRequest struct
+func (r *UpdateRotationPolicyRequest) Validate() error {
+	rotationPeriod, err := r.Period()
+	if err != nil {
+		return fmt.Errorf("validation failed: %w", err)
+	}
+
+	if rotationPeriod != 0 && rotationPeriod < 24*time.Hour {
+		return fmt.Errorf("validation failed: rotation_period must be 0 or at least 24h")
+	}
+
+	return nil
+}
+
+// ExportKeyRequest represents the request structure for exporting a cryptographic key wrapped with either a vault AES key or an RSA public key. The public key is base64 encoded.
+type ExportKeyRequest struct {
+	WrappingKeyID string `json:"wrapping_key_id" validate:"omitempty,uuid4,excluded_with=PublicKey"` // ID of an AES key of the vault, the key is wrapped with AES_KEY_WRAP_PAD (RFC 5649)
+	PublicKey     []byte `json:"public_key" validate:"required_without=WrappingKeyID,max=65536"`     // PEM or DER encoded PKIX or PKCS#1 RSA public key of at least 2048 bits, the key is wrapped with RSA-OAEP
+}
+
+// Validate method for ExportKeyRequest struct
+func (e *ExportKeyRequest) Validate() error {
+	validate := validator.New()
+
+	err := validate.Struct(e)
+	if err != nil {
+		var validationErrors validator.ValidationErrors
+		if errors.As(err, &validationErrors) {
+			var messages []string
+			for _, fieldErr := range validationErrors {
+				messages = append(messages, fmt.Sprintf("Field: %s, Tag: %s", fieldErr.Field(), fieldErr.Tag()))
+			}
+			return fmt.Errorf("validation failed: %v", messages)
+		}
+		return fmt.Errorf("validation error: %w", err)
+	}
+
+	return nil
+}
+
+// UpdateExportPolicyRequest represents the request structure for forbidding the plaintext export of a cryptographic key
+type UpdateExportPolicyRequest struct {
+	PlaintextExportForbidden *bool `json:"plaintext_export_forbidden" validate:"required"` // Whether the key may only be exported wrapped, once set it cannot be cleared
+}
+
+// Validate method for UpdateExportPolicyRequest struct
+func (p *UpdateExportPolicyRequest) Validate() error {
+	validate := validator.New()
+
+	err := validate.Struct(p)
+	if err != nil {
+		var validationErrors validator.ValidationErrors
+		if errors.As(err, &validationErrors) {
+			var messages []string
+			for _, fieldErr := range validationErrors {
+				messages = append(messages, fmt.Sprintf("Field: %s, Tag: %s", fieldErr.Field(), fieldErr.Tag()))
+			}
+			return fmt.Errorf("validation failed: %v", messages)
+		}
+		return fmt.Errorf("validation error: %w", err)
+	}
+
+	return nil
+}
+
+// CreateBlobGrantRequest represents the request structure for sharing a blob with another user or group
+type CreateBlobGrantRequest struct {
+	GranteeType     string     `json:"grantee_type" validate:"required,oneof=user group"`
+	GranteeID       string     `json:"grantee_id" validate:"required,min=1,max=255,excludesall=:#"`
+	Access          string     `json:"access" validate:"required,oneof=view download"`
+	DateTimeExpires *time.Time `json:"date_time_expires" validate:"omitempty"`
+}
+
+// Validate method for CreateBlobGrantRequest struct
+func (g *CreateBlobGrantRequest) Validate() error {
+	validate := validator.New()
+
+	err := validate.Struct(g)
+	if err != nil {
+		var validationErrors validator.ValidationErrors
+		if errors.As(err, &validationErrors) {
+			var messages []string
+			for _, fieldErr := range validationErrors {
+				messages = append(messages, fmt.Sprintf("Field: %s, Tag: %s", fieldErr.Field(), fieldErr.Tag()))
+			}
+			return fmt.Errorf("validation failed: %v", messages)
+		}
+		return fmt.Errorf("validation error: %w", err)
+	}
+
+	return nil
+}
+
+// CreateBlobLinkRequest represents the request structure for issuing a pre-signed download link for a blob
+type CreateBlobLinkRequest struct {
+	ExpiresInSeconds int64   `json:"expires_in_seconds" validate:"required,min=1,max=86400"`
+	DecryptionKeyID  *string `json:"decryption_key_id" validate:"omitempty,uuid4"`
+	SingleUse        bool    `json:"single_use"`
+}
+
+// Validate method for CreateBlobLinkRequest struct
+func (l *CreateBlobLinkRequest) Validate() error {
+	validate := validator.New()
+
+	err := validate.Struct(l)
+	if err != nil {
+		var validationErrors validator.ValidationErrors
+		if errors.As(err, &validationErrors) {
+			var messages []string
+			for _, fieldErr := range validationErrors {
+				messages = append(messages, fmt.Sprintf("Field: %s, Tag: %s", fieldErr.Field(), fieldErr.Tag()))
+			}
+			return fmt.Errorf("validation failed: %v", messages)
+		}
+		return fmt.Errorf("validation error: %w", err)
+	}
+
+	return nil
+}
+
+// CryptoOperationRequest represents the request structure for performing a cryptographic operation with a stored key.
+// Binary fields are base64 encoded. The payload holds the plaintext, ciphertext, data, key or wrapped key depending on the operation.
+type CryptoOperationRequest struct {
+	Payload        []byte `json:"payload" validate:"required,max=1048576"`
+	AssociatedData []byte `json:"associated_data" validate:"max=1048576"` // Optional data bound to ciphertexts by encrypt and decrypt
+	Signature      []byte `json:"signature" validate:"max=1048576"`       // Signature checked by verify
+}
+
+// Validate method for CryptoOperationRequest struct
+func (o *CryptoOperationRequest) Validate() error {
+	validate := validator.New()
+
+	err := validate.Struct(o)
+	if err != nil {
+		var validationErrors validator.ValidationErrors
+		if errors.As(err, &validationErrors) {
+			var messages []string
+			for _, fieldErr := range validationErrors {
+				messages = append(messages, fmt.Sprintf("Field: %s, Tag: %s", fieldErr.Field(), fieldErr.Tag()))
+			}
+			return fmt.Errorf("validation failed: %v", messages)
+		}
+		return fmt.Errorf("validation error: %w", err)
+	}
+
+	return nil
+}
+
+// InitializePKCS11TokenRequest represents the request structure for initializing a PKCS#11 token
+type InitializePKCS11TokenRequest struct {
+	Label string `json:"label" validate:"required,max=32"` // PKCS#11 token labels are limited to 32 characters
+}
+
+// Validate method for InitializePKCS11TokenRequest struct
+func (r *InitializePKCS11TokenRequest) Validate() error {
+	validate := validator.New()
+
+	err := validate.Struct(r)
+	if err != nil {
+		var validationErrors validator.ValidationErrors
+		if errors.As(err, &validationErrors) {
+			var messages []string
+			for _, fieldErr := range validationErrors {
+				messages = append(messages, fmt.Sprintf("Field: %s, Tag: %s", fieldErr.Field(), fieldErr.Tag()))
+			}
+			return fmt.Errorf("validation failed: %v", messages)
+		}
+		return fmt.Errorf("validation error: %w", err)
+	}
+
+	return nil
+}
+
+// AddPKCS11KeyRequest represents the request structure for generating a key pair or AES key on a PKCS#11 token.
+// RSA keys are 2048, 3072 or 4096 bits, ECDSA keys 256, 384 or 521 bits, AES keys 128, 192 or 256 bits.
+type AddPKCS11KeyRequest struct {
+	ObjectLabel string `json:"object_label" validate:"required"`
+	KeyType     string `json:"key_type" validate:"required,oneof=RSA ECDSA AES"`
+	KeySize     uint32 `json:"key_size" validate:"required"`
+}
+
+// Validate method for AddPKCS11KeyRequest struct
+func (r *AddPKCS11KeyRequest) Validate() error {
+	validate := validator.New()
+
+	err := validate.Struct(r)
+	if err != nil {
+		var validationErrors validator.ValidationErrors
+		if errors.As(err, &validationErrors) {
+			var messages []string
+			for _, fieldErr := range validationErrors {
+				messages = append(messages, fmt.Sprintf("Field: %s, Tag: %s", fieldErr.Field(), fieldErr.Tag()))
+			}
+			return fmt.Errorf("validation failed: %v", messages)
+		}
+		return fmt.Errorf("validation error: %w", err)
+	}
+
+	return nil
+}
+
+// PKCS11OperationRequest represents the request structure for performing a cryptographic operation with a key pair or AES key on a PKCS#11 token.
+// Binary fields are base64 encoded. The payload holds the plaintext, ciphertext or data depending on the operation.
+type PKCS11OperationRequest struct {
+	ObjectLabel string `json:"object_label" validate:"required"`
+	KeyType     string `json:"key_type" validate:"required,oneof=RSA ECDSA AES"`
+	Payload     []byte `json:"payload" validate:"required,max=1048576"`
+	Signature   []byte `json:"signature" validate:"max=1048576"` // Signature checked by verify
+}
+
+// Validate method for PKCS11OperationRequest struct
+func (o *PKCS11OperationRequest) Validate() error {
+	validate := validator.New()
+
+	err := validate.Struct(o)
+	if err != nil {
+		var validationErrors validator.ValidationErrors
+		if errors.As(err, &validationErrors) {
+			var messages []string
+			for _, fieldErr := range validationErrors {
+				messages = append(messages, fmt.Sprintf("Field: %s, Tag: %s", fieldErr.Field(), fieldErr.Tag()))
+			}
+			return fmt.Errorf("validation failed: %v", messages)
+		}
+		return fmt.Errorf("validation error: %w", err)
+	}
+
+	return nil
+}
+
 // ErrorResponse represents an error response with a message.
 type ErrorResponse struct {
 	Message string `json:"message"` // The error message
@@ -59,15 +374,128 @@ type BlobMetaResponse struct {
 	Type            string    `json:"type"`            // Type of the blob (e.g., file format)
 	EncryptionKeyID *string   `json:"encryptionKeyID"` // Optional encryption key ID for the blob
 	SignKeyID       *string   `json:"signKeyID"`       // Optional signature key ID for the blob
+	SignatureName   *string   `json:"signatureName"`   // Optional name of the detached signature stored next to the blob
+}
+
+// BlobVerificationResponse contains the result of checking the detached signature of a blob.
+type BlobVerificationResponse struct {
+	ID    string `json:"id"`    // Unique identifier for the blob
+	Valid bool   `json:"valid"` // Whether the signature matches the blob content
+}
+
+// BlobGrantResponse contains the access a blob was shared with to another user or group.
+type BlobGrantResponse struct {
+	BlobID          string     `json:"blobID"`          // Identifier of the shared blob
+	GranteeType     string     `json:"granteeType"`     // Type of the grantee (user, group)
+	GranteeID       string     `json:"granteeID"`       // Identifier of the user or group the blob is shared with
+	Access          string     `json:"access"`          // Access granted (view, download)
+	DateTimeExpires *time.Time `json:"dateTimeExpires"` // Optional timestamp when the grant expires
+	DateTimeCreated time.Time  `json:"dateTimeCreated"` // Timestamp when the grant was created
+}
+
+// BlobLinkResponse contains a pre-signed link for downloading a blob without a bearer token.
+type BlobLinkResponse struct {
+	ID              string    `json:"id"`              // Unique identifier for the link, which it is revoked by
+	BlobID          string    `json:"blobID"`          // Identifier of the blob the link downloads
+	Token           string    `json:"token"`           // Signed token to pass as token query parameter
+	URL             string    `json:"url"`             // Path of the download including the token
+	DecryptionKeyID *string   `json:"decryptionKeyID"` // Optional key the blob is decrypted with on download
+	SingleUse       bool      `json:"singleUse"`       // Whether the link is revoked when used
+	DateTimeExpires time.Time `json:"dateTimeExpires"` // Timestamp when the link expires
 }
 
 // CryptoKeyMetaResponse contains metadata about a cryptographic key.
 type CryptoKeyMetaResponse struct {
-	ID              string    `json:"id"`              // Unique identifier for the cryptographic key
-	KeyPairID       string    `json:"keyPairID"`       // Identifier for the key pair the key belongs to
-	Algorithm       string    `json:"algorithm"`       // Cryptographic algorithm (e.g., AES, RSA, EC)
-	KeySize         uint32    `json:"keySize"`         // Size of the cryptographic key
-	Type            string    `json:"type"`            // Type of the cryptographic key (e.g., public, private)
-	DateTimeCreated time.Time `json:"dateTimeCreated"` // Timestamp when the key was created
-	UserID          string    `json:"userID"`          // User who created the key
+	ID                       string     `json:"id"`                       // Unique identifier for the cryptographic key
+	KeyPairID                string     `json:"keyPairID"`                // Identifier for the key pair the key belongs to
+	Algorithm                string     `json:"algorithm"`                // Cryptographic algorithm (e.g., AES, RSA, EC)
+	KeySize                  uint32     `json:"keySize"`                  // Size of the cryptographic key
+	Type                     string     `json:"type"`                     // Type of the cryptographic key (e.g., public, private)
+	DateTimeCreated          time.Time  `json:"dateTimeCreated"`          // Timestamp when the key was created
+	UserID                   string     `json:"userID"`                   // User who created the key
+	State                    string     `json:"state"`                    // Lifecycle state of the key (pre-active, active, suspended, deactivated, destroyed)
+	DateTimeActivation       *time.Time `json:"dateTimeActivation"`       // Timestamp from which the key is active
+	DateTimeExpires          *time.Time `json:"dateTimeExpires"`          // Timestamp from which the key is deactivated
+	DateTimeDestruction      *time.Time `json:"dateTimeDestruction"`      // Timestamp from which the key is destroyed if its destruction is scheduled
+	LogicalKeyID             string     `json:"logicalKeyID"`             // Identifier of the logical key the key pair is a version of
+	Version                  uint32     `json:"version"`                  // Version of the logical key the key pair belongs to
+	PlaintextExportForbidden bool       `json:"plaintextExportForbidden"` // Whether the key may only be exported wrapped
+	TokenLabel               string     `json:"tokenLabel,omitempty"`     // Label of the PKCS#11 token the key was generated on, whose private key never leaves it
+}
+
+// LogicalKeyResponse contains the versions and rotation policy of a logical key.
+type LogicalKeyResponse struct {
+	ID                   string     `json:"id"`                   // Unique identifier for the logical key
+	UserID               string     `json:"userID"`               // User who created the logical key
+	Algorithm            string     `json:"algorithm"`            // Cryptographic algorithm of all versions (e.g., AES, RSA, EC)
+	KeySize              uint32     `json:"keySize"`              // Size of the keys of all versions
+	PrimaryVersion       uint32     `json:"primaryVersion"`       // Version used to encrypt and sign new data
+	PrimaryKeyPairID     string     `json:"primaryKeyPairID"`     // Identifier of the key pair of the primary version
+	LatestVersion        uint32     `json:"latestVersion"`        // Highest version created so far
+	RotationPeriod       string     `json:"rotationPeriod"`       // Interval of automatic rotation, "0s" if disabled
+	DateTimeNextRotation *time.Time `json:"dateTimeNextRotation"` // Timestamp of the next automatic rotation
+	DateTimeCreated      time.Time  `json:"dateTimeCreated"`      // Timestamp when the logical key was created
+	DateTimeRotated      *time.Time `json:"dateTimeRotated"`      // Timestamp of the last rotation
+}
+
+// KeyImportPublicKeyResponse contains the public key clients wrap key material with before importing it wrapped.
+type KeyImportPublicKeyResponse struct {
+	PublicKey         string `json:"publicKey"`         // PEM encoded PKIX RSA public key
+	WrappingAlgorithm string `json:"wrappingAlgorithm"` // Algorithm key material is wrapped with (RSA_AES_KEY_WRAP_SHA_256)
+}
+
+// MasterKeyRotationResponse contains the progress of re-wrapping stored keys with a new master key version.
+type MasterKeyRotationResponse struct {
+	ID               string    `json:"id"`               // Unique identifier for the rotation
+	TargetKEKVersion uint32    `json:"targetKEKVersion"` // Master key version the stored keys are re-wrapped with
+	Status           string    `json:"status"`           // Status of the rotation (running, completed, failed)
+	ProcessedKeys    int       `json:"processedKeys"`    // Number of key metadata entries processed so far
+	RewrappedKeys    int       `json:"rewrappedKeys"`    // Number of keys re-wrapped with the target version
+	Error            string    `json:"error,omitempty"`  // Reason why the rotation failed
+	DateTimeStarted  time.Time `json:"dateTimeStarted"`  // Timestamp when the rotation was started
+	DateTimeUpdated  time.Time `json:"dateTimeUpdated"`  // Timestamp of the last recorded progress
+}
+
+// CryptoOperationResponse contains the result of a cryptographic operation. Only the output of the performed operation is set, binary fields are base64 encoded.
+type CryptoOperationResponse struct {
+	Operation  string `json:"operation"`            // Performed operation (encrypt, decrypt, sign, verify, wrap, unwrap)
+	KeyID      string `json:"keyID"`                // Identifier of the key the operation was requested with
+	KeyPairID  string `json:"keyPairID"`            // Identifier of the key pair whose key performed the operation
+	KeyVersion uint32 `json:"keyVersion"`           // Version of the logical key that performed the operation
+	Algorithm  string `json:"algorithm"`            // Cryptographic algorithm of the key (e.g., AES, RSA, EC)
+	Ciphertext []byte `json:"ciphertext,omitempty"` // Envelope returned by encrypt
+	Plaintext  []byte `json:"plaintext,omitempty"`  // Plaintext returned by decrypt
+	Signature  []byte `json:"signature,omitempty"`  // Signature returned by sign
+	WrappedKey []byte `json:"wrappedKey,omitempty"` // Wrapped key returned by wrap
+	Key        []byte `json:"key,omitempty"`        // Key returned by unwrap
+	Valid      *bool  `json:"valid,omitempty"`      // Whether the signature checked by verify is valid
+}
+
+// PKCS11TokenResponse contains the details of a token present in a slot of the PKCS#11 module.
+type PKCS11TokenResponse struct {
+	SlotID       string `json:"slotID"`       // Identifier of the slot holding the token, e.g. 0x0
+	Label        string `json:"label"`        // Label of the token
+	Manufacturer string `json:"manufacturer"` // Manufacturer of the token
+	Model        string `json:"model"`        // Model of the token
+	SerialNumber string `json:"serialNumber"` // Serial number of the token
+}
+
+// PKCS11ObjectResponse contains the details of an object stored on a PKCS#11 token.
+type PKCS11ObjectResponse struct {
+	Label  string `json:"label"`  // Label of the object
+	Type   string `json:"type"`   // Type of the object (e.g. RSA private key, ECDSA public key, data)
+	Usage  string `json:"usage"`  // Operations the object may be used for (e.g. encrypt, sign, decrypt)
+	Access string `json:"access"` // Access controls of the object (e.g. sensitive, always sensitive)
+}
+
+// PKCS11OperationResponse contains the result of a cryptographic operation performed on a PKCS#11 token. Only the output of the performed operation is set, binary fields are base64 encoded.
+type PKCS11OperationResponse struct {
+	Operation   string `json:"operation"`            // Performed operation (encrypt, decrypt, sign, verify)
+	TokenLabel  string `json:"tokenLabel"`           // Label of the token that performed the operation
+	ObjectLabel string `json:"objectLabel"`          // Label of the key pair that performed the operation
+	KeyType     string `json:"keyType"`              // Type of the key pair (RSA, ECDSA)
+	Ciphertext  []byte `json:"ciphertext,omitempty"` // Ciphertext returned by encrypt
+	Plaintext   []byte `json:"plaintext,omitempty"`  // Plaintext returned by decrypt
+	Signature   []byte `json:"signature,omitempty"`  // Signature returned by sign
+	Valid       *bool  `json:"valid,omitempty"`      // Whether the signature checked by verify is valid
 }
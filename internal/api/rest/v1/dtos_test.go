@@ -5,7 +5,9 @@ package v1
 
 import (
 	"testing"
+	"time"
 
+	"github.com/google/uuid"
 	"github.com/stretchr/testify/require"
 )
 
@@ -33,6 +35,251 @@ func TestUploadKeyRequest_Validate(t *testing.T) {
 
 		// Invalid algorithm
 		{"Invalid algorithm", UploadKeyRequest{Algorithm: "Unknown", KeySize: 256}, true},
+
+		// Lifecycle
+		{"Valid pre-active", UploadKeyRequest{Algorithm: "AES", KeySize: 256, State: "pre-active"}, false},
+		{"Invalid initial state", UploadKeyRequest{Algorithm: "AES", KeySize: 256, State: "suspended"}, true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			err := tt.request.Validate()
+			if tt.shouldErr {
+				require.Error(t, err, "expected validation error")
+			} else {
+				require.NoError(t, err, "expected no validation error")
+			}
+		})
+	}
+}
+
+func TestImportKeyRequest_Validate(t *testing.T) {
+	tests := []struct {
+		name      string
+		request   ImportKeyRequest
+		shouldErr bool
+	}{
+		{"Valid raw AES", ImportKeyRequest{Algorithm: "AES", Format: "raw", KeyMaterial: make([]byte, 32)}, false},
+		{"Valid wrapped PEM RSA", ImportKeyRequest{Algorithm: "RSA", Format: "pem", KeyMaterial: []byte("wrapped"), Wrapped: true}, false},
+		{"Missing key material", ImportKeyRequest{Algorithm: "EC", Format: "jwk"}, true},
+		{"Invalid format", ImportKeyRequest{Algorithm: "EC", Format: "pkcs12", KeyMaterial: []byte("key")}, true},
+		{"Missing algorithm", ImportKeyRequest{Format: "der", KeyMaterial: []byte("key")}, true},
+		{"Invalid initial state", ImportKeyRequest{Algorithm: "AES", Format: "raw", KeyMaterial: make([]byte, 32), State: "suspended"}, true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			err := tt.request.Validate()
+			if tt.shouldErr {
+				require.Error(t, err, "expected validation error")
+			} else {
+				require.NoError(t, err, "expected no validation error")
+			}
+		})
+	}
+}
+
+func TestUpdateKeyLifecycleRequest_Validate(t *testing.T) {
+	expires := time.Now().Add(time.Hour)
+
+	tests := []struct {
+		name      string
+		request   UpdateKeyLifecycleRequest
+		shouldErr bool
+	}{
+		{"Valid state", UpdateKeyLifecycleRequest{State: "suspended"}, false},
+		{"Valid expiry", UpdateKeyLifecycleRequest{DateTimeExpires: &expires}, false},
+		{"Invalid state", UpdateKeyLifecycleRequest{State: "compromised"}, true},
+		{"Empty update", UpdateKeyLifecycleRequest{}, true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			err := tt.request.Validate()
+			if tt.shouldErr {
+				require.Error(t, err, "expected validation error")
+			} else {
+				require.NoError(t, err, "expected no validation error")
+			}
+		})
+	}
+}
+
+func TestExportKeyRequest_Validate(t *testing.T) {
+	wrappingKeyID := uuid.New().String()
+
+	tests := []struct {
+		name      string
+		request   ExportKeyRequest
+		shouldErr bool
+	}{
+		{"Valid wrapping key ID", ExportKeyRequest{WrappingKeyID: wrappingKeyID}, false},
+		{"Valid public key", ExportKeyRequest{PublicKey: []byte("public key")}, false},
+		{"Missing wrapping key", ExportKeyRequest{}, true},
+		{"Both wrapping keys", ExportKeyRequest{WrappingKeyID: wrappingKeyID, PublicKey: []byte("public key")}, true},
+		{"Invalid wrapping key ID", ExportKeyRequest{WrappingKeyID: "not-a-uuid"}, true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			err := tt.request.Validate()
+			if tt.shouldErr {
+				require.Error(t, err, "expected validation error")
+			} else {
+				require.NoError(t, err, "expected no validation error")
+			}
+		})
+	}
+}
+
+func TestUpdateExportPolicyRequest_Validate(t *testing.T) {
+	forbidden := true
+
+	require.NoError(t, (&UpdateExportPolicyRequest{PlaintextExportForbidden: &forbidden}).Validate())
+	require.Error(t, (&UpdateExportPolicyRequest{}).Validate())
+}
+
+func TestCreateBlobGrantRequest_Validate(t *testing.T) {
+	tests := []struct {
+		name      string
+		request   CreateBlobGrantRequest
+		shouldErr bool
+	}{
+		{"Valid user view", CreateBlobGrantRequest{GranteeType: "user", GranteeID: "user-2", Access: "view"}, false},
+		{"Valid group download", CreateBlobGrantRequest{GranteeType: "group", GranteeID: "auditors", Access: "download"}, false},
+		{"Missing grantee ID", CreateBlobGrantRequest{GranteeType: "user", Access: "view"}, true},
+		{"Invalid grantee type", CreateBlobGrantRequest{GranteeType: "team", GranteeID: "auditors", Access: "view"}, true},
+		{"Invalid grantee ID", CreateBlobGrantRequest{GranteeType: "group", GranteeID: "admins#admin", Access: "view"}, true},
+		{"Invalid access", CreateBlobGrantRequest{GranteeType: "user", GranteeID: "user-2", Access: "delete"}, true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			err := tt.request.Validate()
+			if tt.shouldErr {
+				require.Error(t, err, "expected validation error")
+			} else {
+				require.NoError(t, err, "expected no validation error")
+			}
+		})
+	}
+}
+
+func TestCreateBlobLinkRequest_Validate(t *testing.T) {
+	decryptionKeyID := "7f3d3b84-7b1f-4a55-9a43-1a8f0a5bde21"
+	invalidDecryptionKeyID := "key-1"
+
+	tests := []struct {
+		name      string
+		request   CreateBlobLinkRequest
+		shouldErr bool
+	}{
+		{"Valid", CreateBlobLinkRequest{ExpiresInSeconds: 300}, false},
+		{"Valid single use with decryption key", CreateBlobLinkRequest{ExpiresInSeconds: 86400, DecryptionKeyID: &decryptionKeyID, SingleUse: true}, false},
+		{"Missing expiry", CreateBlobLinkRequest{}, true},
+		{"Expiry too long", CreateBlobLinkRequest{ExpiresInSeconds: 86401}, true},
+		{"Negative expiry", CreateBlobLinkRequest{ExpiresInSeconds: -1}, true},
+		{"Invalid decryption key ID", CreateBlobLinkRequest{ExpiresInSeconds: 300, DecryptionKeyID: &invalidDecryptionKeyID}, true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			err := tt.request.Validate()
+			if tt.shouldErr {
+				require.Error(t, err, "expected validation error")
+			} else {
+				require.NoError(t, err, "expected no validation error")
+			}
+		})
+	}
+}
+
+func TestCryptoOperationRequest_Validate(t *testing.T) {
+	tests := []struct {
+		name      string
+		request   CryptoOperationRequest
+		shouldErr bool
+	}{
+		{"Valid", CryptoOperationRequest{Payload: []byte("payload")}, false},
+		{"Valid with associated data and signature", CryptoOperationRequest{Payload: []byte("payload"), AssociatedData: []byte("context"), Signature: []byte("signature")}, false},
+		{"Missing payload", CryptoOperationRequest{}, true},
+		{"Payload too large", CryptoOperationRequest{Payload: make([]byte, 1048577)}, true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			err := tt.request.Validate()
+			if tt.shouldErr {
+				require.Error(t, err, "expected validation error")
+			} else {
+				require.NoError(t, err, "expected no validation error")
+			}
+		})
+	}
+}
+
+func TestInitializePKCS11TokenRequest_Validate(t *testing.T) {
+	tests := []struct {
+		name      string
+		request   InitializePKCS11TokenRequest
+		shouldErr bool
+	}{
+		{"Valid", InitializePKCS11TokenRequest{Label: "VaultKeys"}, false},
+		{"Missing label", InitializePKCS11TokenRequest{}, true},
+		{"Label too long", InitializePKCS11TokenRequest{Label: "ThisTokenLabelIsLongerThan32Chars"}, true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			err := tt.request.Validate()
+			if tt.shouldErr {
+				require.Error(t, err, "expected validation error")
+			} else {
+				require.NoError(t, err, "expected no validation error")
+			}
+		})
+	}
+}
+
+func TestAddPKCS11KeyRequest_Validate(t *testing.T) {
+	tests := []struct {
+		name      string
+		request   AddPKCS11KeyRequest
+		shouldErr bool
+	}{
+		{"Valid RSA", AddPKCS11KeyRequest{ObjectLabel: "signing-key", KeyType: "RSA", KeySize: 2048}, false},
+		{"Valid ECDSA", AddPKCS11KeyRequest{ObjectLabel: "signing-key", KeyType: "ECDSA", KeySize: 384}, false},
+		{"Missing object label", AddPKCS11KeyRequest{KeyType: "RSA", KeySize: 2048}, true},
+		{"Valid AES", AddPKCS11KeyRequest{ObjectLabel: "wrapping-key", KeyType: "AES", KeySize: 256}, false},
+		{"Unsupported key type", AddPKCS11KeyRequest{ObjectLabel: "signing-key", KeyType: "DSA", KeySize: 2048}, true},
+		{"Missing key size", AddPKCS11KeyRequest{ObjectLabel: "signing-key", KeyType: "RSA"}, true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			err := tt.request.Validate()
+			if tt.shouldErr {
+				require.Error(t, err, "expected validation error")
+			} else {
+				require.NoError(t, err, "expected no validation error")
+			}
+		})
+	}
+}
+
+func TestPKCS11OperationRequest_Validate(t *testing.T) {
+	tests := []struct {
+		name      string
+		request   PKCS11OperationRequest
+		shouldErr bool
+	}{
+		{"Valid", PKCS11OperationRequest{ObjectLabel: "signing-key", KeyType: "RSA", Payload: []byte("payload")}, false},
+		{"Valid with signature", PKCS11OperationRequest{ObjectLabel: "signing-key", KeyType: "ECDSA", Payload: []byte("payload"), Signature: []byte("signature")}, false},
+		{"Valid AES", PKCS11OperationRequest{ObjectLabel: "wrapping-key", KeyType: "AES", Payload: []byte("payload")}, false},
+		{"Missing object label", PKCS11OperationRequest{KeyType: "RSA", Payload: []byte("payload")}, true},
+		{"Unsupported key type", PKCS11OperationRequest{ObjectLabel: "signing-key", KeyType: "EC", Payload: []byte("payload")}, true},
+		{"Missing payload", PKCS11OperationRequest{ObjectLabel: "signing-key", KeyType: "RSA"}, true},
+		{"Payload too large", PKCS11OperationRequest{ObjectLabel: "signing-key", KeyType: "RSA", Payload: make([]byte, 1048577)}, true},
 	}
 
 	for _, tt := range tests {
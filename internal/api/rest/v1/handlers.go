@@ -2,15 +2,22 @@ package v1
 
 import (
 	"crypto_vault_service/internal/domain/blobs"
+	"crypto_vault_service/internal/domain/crypto"
 	"crypto_vault_service/internal/domain/keys"
+	"crypto_vault_service/internal/domain/pkcs11"
+	"crypto_vault_service/internal/infrastructure/cryptography"
 	"crypto_vault_service/internal/infrastructure/utils"
+	"errors"
 	"fmt"
+	"log"
 	"mime/multipart"
 	"net/http"
+	"net/url"
+	"strconv"
+	"strings"
 	"time"
 
 	"github.com/gin-gonic/gin"
-	"github.com/google/uuid"
 )
 
 // BlobHandler defines the interface for handling blob-related operations
@@ -19,7 +26,13 @@ type BlobHandler interface {
 	ListMetadata(ctx *gin.Context)
 	GetMetadataByID(ctx *gin.Context)
 	DownloadByID(ctx *gin.Context)
+	VerifyByID(ctx *gin.Context)
 	DeleteByID(ctx *gin.Context)
+	CreateGrant(ctx *gin.Context)
+	ListGrants(ctx *gin.Context)
+	DeleteGrant(ctx *gin.Context)
+	CreateLink(ctx *gin.Context)
+	RevokeLink(ctx *gin.Context)
 }
 
 // BlobHandler struct holds the services
@@ -27,15 +40,19 @@ type blobHandler struct {
 	blobUploadService      blobs.BlobUploadService
 	blobMetadataService    blobs.BlobMetadataService
 	blobDownloadService    blobs.BlobDownloadService
+	blobGrantService       blobs.BlobGrantService
+	blobLinkService        blobs.BlobLinkService
 	cryptoKeyUploadService keys.CryptoKeyUploadService
 }
 
 // NewBlobHandler creates a new BlobHandler
-func NewBlobHandler(blobUploadService blobs.BlobUploadService, blobDownloadService blobs.BlobDownloadService, blobMetadataService blobs.BlobMetadataService, cryptoKeyUploadService keys.CryptoKeyUploadService) BlobHandler {
+func NewBlobHandler(blobUploadService blobs.BlobUploadService, blobDownloadService blobs.BlobDownloadService, blobMetadataService blobs.BlobMetadataService, blobGrantService blobs.BlobGrantService, blobLinkService blobs.BlobLinkService, cryptoKeyUploadService keys.CryptoKeyUploadService) BlobHandler {
 	return &blobHandler{
 		blobUploadService:      blobUploadService,
 		blobDownloadService:    blobDownloadService,
 		blobMetadataService:    blobMetadataService,
+		blobGrantService:       blobGrantService,
+		blobLinkService:        blobLinkService,
 		cryptoKeyUploadService: cryptoKeyUploadService,
 	}
 }
@@ -51,12 +68,14 @@ func NewBlobHandler(blobUploadService blobs.BlobUploadService, blobDownloadServi
 // @Param sign_key_id formData string false "Sign Key ID"
 // @Success 201 {array} BlobMetaResponse
 // @Failure 400 {object} ErrorResponse
+// @Failure 401 {object} ErrorResponse
+// @Failure 403 {object} ErrorResponse
+// @Security ApiKeyAuth
 // @Router /blobs [post]
 func (handler *blobHandler) Upload(ctx *gin.Context) {
 	var form *multipart.Form
 	var encryptionKeyID *string
 	var signKeyID *string
-	userID := uuid.New().String() // TODO(MGTheTrain): extract user id from JWT
 
 	form, err := ctx.MultipartForm()
 	if err != nil {
@@ -74,11 +93,11 @@ func (handler *blobHandler) Upload(ctx *gin.Context) {
 		signKeyID = &signKeys[0]
 	}
 
-	blobMetas, err := handler.blobUploadService.Upload(ctx, form, userID, encryptionKeyID, signKeyID)
+	blobMetas, err := handler.blobUploadService.Upload(ctx, form, encryptionKeyID, signKeyID)
 	if err != nil {
 		var errorResponse ErrorResponse
 		errorResponse.Message = fmt.Sprintf("error uploading blob: %v", err.Error())
-		ctx.JSON(http.StatusBadRequest, errorResponse)
+		ctx.JSON(authErrorStatus(err, http.StatusBadRequest), errorResponse)
 		return
 	}
 
@@ -93,6 +112,7 @@ func (handler *blobHandler) Upload(ctx *gin.Context) {
 			Type:            blobMeta.Type,
 			EncryptionKeyID: nil,
 			SignKeyID:       nil,
+			SignatureName:   blobMeta.SignatureName,
 		}
 		if blobMeta.EncryptionKeyID != nil {
 			blobMetadataResponse.EncryptionKeyID = blobMeta.EncryptionKeyID
@@ -120,7 +140,9 @@ func (handler *blobHandler) Upload(ctx *gin.Context) {
 // @Param offset query int false "Offset the results"
 // @Success 200 {array} BlobMetaResponse
 // @Failure 400 {object} ErrorResponse
+// @Failure 401 {object} ErrorResponse
 // @Failure 404 {object} ErrorResponse
+// @Security ApiKeyAuth
 // @Router /blobs [get]
 func (handler *blobHandler) ListMetadata(ctx *gin.Context) {
 	query := blobs.NewBlobMetaQuery()
@@ -171,7 +193,7 @@ func (handler *blobHandler) ListMetadata(ctx *gin.Context) {
 	if err != nil {
 		var errorResponse ErrorResponse
 		errorResponse.Message = fmt.Sprintf("list query failed: %v", err.Error())
-		ctx.JSON(http.StatusNotFound, errorResponse)
+		ctx.JSON(authErrorStatus(err, http.StatusNotFound), errorResponse)
 		return
 	}
 
@@ -186,6 +208,7 @@ func (handler *blobHandler) ListMetadata(ctx *gin.Context) {
 			Type:            blobMeta.Type,
 			EncryptionKeyID: nil,
 			SignKeyID:       nil,
+			SignatureName:   blobMeta.SignatureName,
 		}
 		if blobMeta.EncryptionKeyID != nil {
 			blobMetadataResponse.EncryptionKeyID = blobMeta.EncryptionKeyID
@@ -207,7 +230,10 @@ func (handler *blobHandler) ListMetadata(ctx *gin.Context) {
 // @Produce json
 // @Param id path string true "Blob ID"
 // @Success 200 {object} BlobMetaResponse
+// @Failure 401 {object} ErrorResponse
+// @Failure 403 {object} ErrorResponse
 // @Failure 404 {object} ErrorResponse
+// @Security ApiKeyAuth
 // @Router /blobs/{id} [get]
 func (handler *blobHandler) GetMetadataByID(ctx *gin.Context) {
 	blobID := ctx.Param("id")
@@ -215,8 +241,8 @@ func (handler *blobHandler) GetMetadataByID(ctx *gin.Context) {
 	blobMeta, err := handler.blobMetadataService.GetByID(ctx, blobID)
 	if err != nil {
 		var errorResponse ErrorResponse
-		errorResponse.Message = fmt.Sprintf("blob with id %s not found", blobID)
-		ctx.JSON(http.StatusNotFound, errorResponse)
+		errorResponse.Message = authErrorMessage(err, fmt.Sprintf("blob with id %s not found", blobID))
+		ctx.JSON(authErrorStatus(err, http.StatusNotFound), errorResponse)
 		return
 	}
 
@@ -229,6 +255,7 @@ func (handler *blobHandler) GetMetadataByID(ctx *gin.Context) {
 		Type:            blobMeta.Type,
 		EncryptionKeyID: nil,
 		SignKeyID:       nil,
+		SignatureName:   blobMeta.SignatureName,
 	}
 
 	if blobMeta.EncryptionKeyID != nil {
@@ -244,13 +271,18 @@ func (handler *blobHandler) GetMetadataByID(ctx *gin.Context) {
 // DownloadByID handles the GET request to download a blob by its ID
 // @Summary Download a blob by its ID
 // @Description Download the content of a specific blob by its ID, optionally decrypted with a provided decryption key ID.
+// @Description Instead of a bearer token the token of a pre-signed link may be passed, which determines the decryption key.
 // @Tags Blob
 // @Accept json
 // @Produce octet-stream
 // @Param id path string true "Blob ID"
 // @Param decryption_key_id query string false "Decryption Key ID"
+// @Param token query string false "Pre-signed Link Token"
 // @Success 200 {file} file "Blob content"
+// @Failure 401 {object} ErrorResponse
+// @Failure 403 {object} ErrorResponse
 // @Failure 404 {object} ErrorResponse
+// @Security ApiKeyAuth
 // @Router /blobs/{id}/file [get]
 func (handler *blobHandler) DownloadByID(ctx *gin.Context) {
 	blobID := ctx.Param("id")
@@ -259,34 +291,80 @@ func (handler *blobHandler) DownloadByID(ctx *gin.Context) {
 	if decryptionKeyQuery := ctx.Query("decryption_key_id"); len(decryptionKeyQuery) > 0 {
 		decryptionKeyID = &decryptionKeyQuery
 	}
+	// Pre-signed links are bound to the decryption key they were issued for, if any
+	if link, ok := blobLinkFromContext(ctx); ok {
+		decryptionKeyID = link.DecryptionKeyID
+	}
 
-	bytes, err := handler.blobDownloadService.DownloadByID(ctx, blobID, decryptionKeyID)
+	reader, err := handler.blobDownloadService.DownloadByID(ctx, blobID, decryptionKeyID)
 	if err != nil {
 		var errorResponse ErrorResponse
 		errorResponse.Message = fmt.Sprintf("could not download blob with id %s: %v", blobID, err.Error())
-		ctx.JSON(http.StatusBadRequest, errorResponse)
+		ctx.JSON(authErrorStatus(err, http.StatusBadRequest), errorResponse)
 		return
 	}
+	defer func() {
+		if err := reader.Close(); err != nil {
+			log.Printf("warning: failed to close blob stream: %v\n", err)
+		}
+	}()
 
 	blobMeta, err := handler.blobMetadataService.GetByID(ctx, blobID)
 	if err != nil {
 		var errorResponse ErrorResponse
-		errorResponse.Message = fmt.Sprintf("blob with id %s not found", blobID)
-		ctx.JSON(http.StatusNotFound, errorResponse)
+		errorResponse.Message = authErrorMessage(err, fmt.Sprintf("blob with id %s not found", blobID))
+		ctx.JSON(authErrorStatus(err, http.StatusNotFound), errorResponse)
 		return
 	}
 
-	ctx.Writer.WriteHeader(http.StatusOK)
-	ctx.Writer.Header().Set("Content-Type", "application/octet-stream; charset=utf-8")
-	ctx.Writer.Header().Set("Content-Disposition", "attachment; filename="+blobMeta.Name)
-	_, err = ctx.Writer.Write(bytes)
+	// The content is streamed, so errors surfacing while decrypting can only abort the response
+	ctx.DataFromReader(http.StatusOK, -1, "application/octet-stream; charset=utf-8", reader, map[string]string{
+		"Content-Disposition": "attachment; filename=" + blobMeta.Name,
+	})
+}
+
+// VerifyByID handles the POST request to verify the detached signature of a blob by its ID
+// @Summary Verify the signature of a blob by its ID
+// @Description Check the detached signature of a signed blob against the public key of its sign key pair. Encrypted blobs require a decryption key ID, since signatures cover the plaintext.
+// @Tags Blob
+// @Accept json
+// @Produce json
+// @Param id path string true "Blob ID"
+// @Param decryption_key_id query string false "Decryption Key ID"
+// @Success 200 {object} BlobVerificationResponse
+// @Failure 400 {object} ErrorResponse
+// @Failure 401 {object} ErrorResponse
+// @Failure 403 {object} ErrorResponse
+// @Failure 404 {object} ErrorResponse
+// @Security ApiKeyAuth
+// @Router /blobs/{id}/verify [post]
+func (handler *blobHandler) VerifyByID(ctx *gin.Context) {
+	blobID := ctx.Param("id")
+
+	var decryptionKeyID *string
+	if decryptionKeyQuery := ctx.Query("decryption_key_id"); len(decryptionKeyQuery) > 0 {
+		decryptionKeyID = &decryptionKeyQuery
+	}
+
+	if _, err := handler.blobMetadataService.GetByID(ctx, blobID); err != nil {
+		var errorResponse ErrorResponse
+		errorResponse.Message = authErrorMessage(err, fmt.Sprintf("blob with id %s not found", blobID))
+		ctx.JSON(authErrorStatus(err, http.StatusNotFound), errorResponse)
+		return
+	}
 
+	valid, err := handler.blobDownloadService.VerifyByID(ctx, blobID, decryptionKeyID)
 	if err != nil {
 		var errorResponse ErrorResponse
-		errorResponse.Message = fmt.Sprintf("could not write bytes: %v", err.Error())
-		ctx.JSON(http.StatusBadRequest, errorResponse)
+		errorResponse.Message = fmt.Sprintf("could not verify blob with id %s: %v", blobID, err.Error())
+		ctx.JSON(authErrorStatus(err, http.StatusBadRequest), errorResponse)
 		return
 	}
+
+	ctx.JSON(http.StatusOK, BlobVerificationResponse{
+		ID:    blobID,
+		Valid: valid,
+	})
 }
 
 // DeleteByID handles the DELETE request to delete a blob by its ID
@@ -297,15 +375,18 @@ func (handler *blobHandler) DownloadByID(ctx *gin.Context) {
 // @Produce json
 // @Param id path string true "Blob ID"
 // @Success 204 {object} InfoResponse
+// @Failure 401 {object} ErrorResponse
+// @Failure 403 {object} ErrorResponse
 // @Failure 404 {object} ErrorResponse
+// @Security ApiKeyAuth
 // @Router /blobs/{id} [delete]
 func (handler *blobHandler) DeleteByID(ctx *gin.Context) {
 	blobID := ctx.Param("id")
 
 	if err := handler.blobMetadataService.DeleteByID(ctx, blobID); err != nil {
 		var errorResponse ErrorResponse
-		errorResponse.Message = fmt.Sprintf("blob with id %s not found", blobID)
-		ctx.JSON(http.StatusNotFound, errorResponse)
+		errorResponse.Message = authErrorMessage(err, fmt.Sprintf("blob with id %s not found", blobID))
+		ctx.JSON(authErrorStatus(err, http.StatusNotFound), errorResponse)
 		return
 	}
 
@@ -314,13 +395,231 @@ func (handler *blobHandler) DeleteByID(ctx *gin.Context) {
 	ctx.JSON(http.StatusNoContent, infoResponse)
 }
 
+// CreateGrant handles the POST request to share a blob with another user or group
+// @Summary Share a blob with a user or group
+// @Description Grant a user or the members of a group view-only or download access to a blob, optionally until an expiry. An existing grant of the grantee is replaced. Download access to an encrypted blob includes decrypting it with the key pair it was encrypted with.
+// @Tags Blob
+// @Accept json
+// @Produce json
+// @Param id path string true "Blob ID"
+// @Param requestBody body CreateBlobGrantRequest true "Blob Grant Data"
+// @Success 201 {object} BlobGrantResponse
+// @Failure 400 {object} ErrorResponse
+// @Failure 401 {object} ErrorResponse
+// @Failure 403 {object} ErrorResponse
+// @Security ApiKeyAuth
+// @Router /blobs/{id}/grants [post]
+func (handler *blobHandler) CreateGrant(ctx *gin.Context) {
+	blobID := ctx.Param("id")
+
+	var request CreateBlobGrantRequest
+
+	if err := ctx.ShouldBindJSON(&request); err != nil {
+		var errorResponse ErrorResponse
+		errorResponse.Message = fmt.Sprintf("invalid grant data: %v", err.Error())
+		ctx.JSON(http.StatusBadRequest, errorResponse)
+		return
+	}
+
+	if err := request.Validate(); err != nil {
+		var errorResponse ErrorResponse
+		errorResponse.Message = fmt.Sprintf("validation failed: %v", err.Error())
+		ctx.JSON(http.StatusBadRequest, errorResponse)
+		return
+	}
+
+	grant, err := handler.blobGrantService.Create(ctx, &blobs.BlobGrant{
+		BlobID:          blobID,
+		GranteeType:     request.GranteeType,
+		GranteeID:       request.GranteeID,
+		Access:          request.Access,
+		DateTimeExpires: request.DateTimeExpires,
+	})
+	if err != nil {
+		var errorResponse ErrorResponse
+		errorResponse.Message = fmt.Sprintf("error sharing blob with id %s: %v", blobID, err.Error())
+		ctx.JSON(authErrorStatus(err, http.StatusBadRequest), errorResponse)
+		return
+	}
+
+	ctx.JSON(http.StatusCreated, newBlobGrantResponse(grant))
+}
+
+// ListGrants handles the GET request to list the grants of a blob
+// @Summary List the grants of a blob
+// @Description Fetch the users and groups a blob is shared with and the access they were granted. Expired grants are omitted.
+// @Tags Blob
+// @Accept json
+// @Produce json
+// @Param id path string true "Blob ID"
+// @Success 200 {array} BlobGrantResponse
+// @Failure 401 {object} ErrorResponse
+// @Failure 403 {object} ErrorResponse
+// @Failure 404 {object} ErrorResponse
+// @Security ApiKeyAuth
+// @Router /blobs/{id}/grants [get]
+func (handler *blobHandler) ListGrants(ctx *gin.Context) {
+	blobID := ctx.Param("id")
+
+	grants, err := handler.blobGrantService.List(ctx, blobID)
+	if err != nil {
+		var errorResponse ErrorResponse
+		errorResponse.Message = authErrorMessage(err, fmt.Sprintf("blob with id %s not found", blobID))
+		ctx.JSON(authErrorStatus(err, http.StatusNotFound), errorResponse)
+		return
+	}
+
+	var listResponse = []BlobGrantResponse{}
+	for _, grant := range grants {
+		listResponse = append(listResponse, newBlobGrantResponse(grant))
+	}
+
+	ctx.JSON(http.StatusOK, listResponse)
+}
+
+// DeleteGrant handles the DELETE request to revoke the grant of a user or group for a blob
+// @Summary Revoke the grant of a user or group for a blob
+// @Description Revoke the access a blob was shared with to a user or the members of a group.
+// @Tags Blob
+// @Accept json
+// @Produce json
+// @Param id path string true "Blob ID"
+// @Param granteeType path string true "Grantee Type (user, group)"
+// @Param granteeId path string true "Grantee ID"
+// @Success 204 {object} InfoResponse
+// @Failure 401 {object} ErrorResponse
+// @Failure 403 {object} ErrorResponse
+// @Failure 404 {object} ErrorResponse
+// @Security ApiKeyAuth
+// @Router /blobs/{id}/grants/{granteeType}/{granteeId} [delete]
+func (handler *blobHandler) DeleteGrant(ctx *gin.Context) {
+	blobID := ctx.Param("id")
+	granteeType := ctx.Param("granteeType")
+	granteeID := ctx.Param("granteeId")
+
+	if err := handler.blobGrantService.Delete(ctx, blobID, granteeType, granteeID); err != nil {
+		var errorResponse ErrorResponse
+		errorResponse.Message = authErrorMessage(err, fmt.Sprintf("grant of %s %s for blob with id %s not found", granteeType, granteeID, blobID))
+		ctx.JSON(authErrorStatus(err, http.StatusNotFound), errorResponse)
+		return
+	}
+
+	var infoResponse InfoResponse
+	infoResponse.Message = fmt.Sprintf("revoked grant of %s %s for blob with id %s", granteeType, granteeID, blobID)
+	ctx.JSON(http.StatusNoContent, infoResponse)
+}
+
+// CreateLink handles the POST request to issue a pre-signed download link for a blob
+// @Summary Issue a pre-signed download link for a blob
+// @Description Issue an HMAC-signed token permitting to download a blob without a bearer token until it expires, optionally bound to a decryption key and a single use.
+// @Description The download is authorized for the issuer, hence the link stops working once the issuer loses access to the blob. Access the issuer obtains through the admin role or groups is not delegated.
+// @Tags Blob
+// @Accept json
+// @Produce json
+// @Param id path string true "Blob ID"
+// @Param requestBody body CreateBlobLinkRequest true "Blob Link Data"
+// @Success 201 {object} BlobLinkResponse
+// @Failure 400 {object} ErrorResponse
+// @Failure 401 {object} ErrorResponse
+// @Failure 403 {object} ErrorResponse
+// @Security ApiKeyAuth
+// @Router /blobs/{id}/links [post]
+func (handler *blobHandler) CreateLink(ctx *gin.Context) {
+	blobID := ctx.Param("id")
+
+	var request CreateBlobLinkRequest
+
+	if err := ctx.ShouldBindJSON(&request); err != nil {
+		var errorResponse ErrorResponse
+		errorResponse.Message = fmt.Sprintf("invalid link data: %v", err.Error())
+		ctx.JSON(http.StatusBadRequest, errorResponse)
+		return
+	}
+
+	if err := request.Validate(); err != nil {
+		var errorResponse ErrorResponse
+		errorResponse.Message = fmt.Sprintf("validation failed: %v", err.Error())
+		ctx.JSON(http.StatusBadRequest, errorResponse)
+		return
+	}
+
+	link, token, err := handler.blobLinkService.Create(ctx, blobID, request.DecryptionKeyID, request.SingleUse, time.Duration(request.ExpiresInSeconds)*time.Second)
+	if err != nil {
+		var errorResponse ErrorResponse
+		errorResponse.Message = fmt.Sprintf("error issuing link for blob with id %s: %v", blobID, err.Error())
+		ctx.JSON(authErrorStatus(err, http.StatusBadRequest), errorResponse)
+		return
+	}
+
+	ctx.JSON(http.StatusCreated, BlobLinkResponse{
+		ID:              link.ID,
+		BlobID:          link.BlobID,
+		Token:           token,
+		URL:             fmt.Sprintf("%s/blobs/%s/file?token=%s", BasePath, url.PathEscape(link.BlobID), url.QueryEscape(token)),
+		DecryptionKeyID: link.DecryptionKeyID,
+		SingleUse:       link.SingleUse,
+		DateTimeExpires: link.DateTimeExpires,
+	})
+}
+
+// RevokeLink handles the DELETE request to revoke a pre-signed download link for a blob
+// @Summary Revoke a pre-signed download link for a blob
+// @Description Reject the token of a pre-signed link before it expires. Only callers who may share the blob revoke its links.
+// @Tags Blob
+// @Accept json
+// @Produce json
+// @Param id path string true "Blob ID"
+// @Param linkId path string true "Link ID"
+// @Success 204 {object} InfoResponse
+// @Failure 400 {object} ErrorResponse
+// @Failure 401 {object} ErrorResponse
+// @Failure 403 {object} ErrorResponse
+// @Security ApiKeyAuth
+// @Router /blobs/{id}/links/{linkId} [delete]
+func (handler *blobHandler) RevokeLink(ctx *gin.Context) {
+	blobID := ctx.Param("id")
+	linkID := ctx.Param("linkId")
+
+	if err := handler.blobLinkService.Revoke(ctx, blobID, linkID); err != nil {
+		var errorResponse ErrorResponse
+		errorResponse.Message = fmt.Sprintf("error revoking link %s for blob with id %s: %v", linkID, blobID, err.Error())
+		ctx.JSON(authErrorStatus(err, http.StatusBadRequest), errorResponse)
+		return
+	}
+
+	var infoResponse InfoResponse
+	infoResponse.Message = fmt.Sprintf("revoked link %s for blob with id %s", linkID, blobID)
+	ctx.JSON(http.StatusNoContent, infoResponse)
+}
+
+// newBlobGrantResponse maps a BlobGrant to its response representation
+func newBlobGrantResponse(grant *blobs.BlobGrant) BlobGrantResponse {
+	return BlobGrantResponse{
+		BlobID:          grant.BlobID,
+		GranteeType:     grant.GranteeType,
+		GranteeID:       grant.GranteeID,
+		Access:          grant.Access,
+		DateTimeExpires: grant.DateTimeExpires,
+		DateTimeCreated: grant.DateTimeCreated,
+	}
+}
+
 // KeyHandler defines the interface for handling key-related operations
 type KeyHandler interface {
 	UploadKeys(ctx *gin.Context)
 	ListMetadata(ctx *gin.Context)
 	GetMetadataByID(ctx *gin.Context)
+	UpdateLifecycle(ctx *gin.Context)
+	UpdateExportPolicy(ctx *gin.Context)
 	DownloadByID(ctx *gin.Context)
+	ExportWrappedByID(ctx *gin.Context)
 	DeleteByID(ctx *gin.Context)
+	CancelDestruction(ctx *gin.Context)
+	Rotate(ctx *gin.Context)
+	ListVersions(ctx *gin.Context)
+	UpdateRotationPolicy(ctx *gin.Context)
+	ImportKeys(ctx *gin.Context)
+	GetImportPublicKey(ctx *gin.Context)
 }
 
 // KeyHandler struct holds the services
@@ -328,14 +627,18 @@ type keyHandler struct {
 	cryptoKeyUploadService   keys.CryptoKeyUploadService
 	cryptoKeyDownloadService keys.CryptoKeyDownloadService
 	cryptoKeyMetadataService keys.CryptoKeyMetadataService
+	cryptoKeyRotationService keys.CryptoKeyRotationService
+	cryptoKeyImportService   keys.CryptoKeyImportService
 }
 
 // NewKeyHandler creates a new KeyHandler
-func NewKeyHandler(cryptoKeyUploadService keys.CryptoKeyUploadService, cryptoKeyDownloadService keys.CryptoKeyDownloadService, cryptoKeyMetadataService keys.CryptoKeyMetadataService) KeyHandler {
+func NewKeyHandler(cryptoKeyUploadService keys.CryptoKeyUploadService, cryptoKeyDownloadService keys.CryptoKeyDownloadService, cryptoKeyMetadataService keys.CryptoKeyMetadataService, cryptoKeyRotationService keys.CryptoKeyRotationService, cryptoKeyImportService keys.CryptoKeyImportService) KeyHandler {
 	return &keyHandler{
 		cryptoKeyUploadService:   cryptoKeyUploadService,
 		cryptoKeyDownloadService: cryptoKeyDownloadService,
 		cryptoKeyMetadataService: cryptoKeyMetadataService,
+		cryptoKeyRotationService: cryptoKeyRotationService,
+		cryptoKeyImportService:   cryptoKeyImportService,
 	}
 }
 
@@ -348,6 +651,8 @@ func NewKeyHandler(cryptoKeyUploadService keys.CryptoKeyUploadService, cryptoKey
 // @Param requestBody body UploadKeyRequest true "Cryptographic Key Data"
 // @Success 201 {array} CryptoKeyMetaResponse
 // @Failure 400 {object} ErrorResponse
+// @Failure 401 {object} ErrorResponse
+// @Security ApiKeyAuth
 // @Router /keys [post]
 func (handler *keyHandler) UploadKeys(ctx *gin.Context) {
 
@@ -367,9 +672,11 @@ func (handler *keyHandler) UploadKeys(ctx *gin.Context) {
 		return
 	}
 
-	userID := uuid.New().String() // TODO(MGTheTrain): extract user id from JWT
-
-	cryptoKeyMetas, err := handler.cryptoKeyUploadService.Upload(ctx, userID, request.Algorithm, request.KeySize)
+	cryptoKeyMetas, err := handler.cryptoKeyUploadService.Upload(ctx, request.Algorithm, request.KeySize, &keys.CryptoKeyLifecycle{
+		State:              request.State,
+		DateTimeActivation: request.DateTimeActivation,
+		DateTimeExpires:    request.DateTimeExpires,
+	})
 	if err != nil {
 		var errorResponse ErrorResponse
 		errorResponse.Message = fmt.Sprintf("error uploading key: %v", err.Error())
@@ -379,16 +686,7 @@ func (handler *keyHandler) UploadKeys(ctx *gin.Context) {
 
 	var listResponse = []CryptoKeyMetaResponse{}
 	for _, cryptoKeyMeta := range cryptoKeyMetas {
-		cryptoKeyMetadataResponse := CryptoKeyMetaResponse{
-			ID:              cryptoKeyMeta.ID,
-			KeyPairID:       cryptoKeyMeta.KeyPairID,
-			Algorithm:       cryptoKeyMeta.Algorithm,
-			KeySize:         cryptoKeyMeta.KeySize,
-			Type:            cryptoKeyMeta.Type,
-			DateTimeCreated: cryptoKeyMeta.DateTimeCreated,
-			UserID:          cryptoKeyMeta.UserID,
-		}
-		listResponse = append(listResponse, cryptoKeyMetadataResponse)
+		listResponse = append(listResponse, newCryptoKeyMetaResponse(cryptoKeyMeta))
 	}
 
 	ctx.JSON(http.StatusCreated, listResponse)
@@ -409,7 +707,9 @@ func (handler *keyHandler) UploadKeys(ctx *gin.Context) {
 // @Param sortOrder query string false "Sort order (asc/desc)"
 // @Success 200 {array} CryptoKeyMetaResponse
 // @Failure 400 {object} ErrorResponse
+// @Failure 401 {object} ErrorResponse
 // @Failure 404 {object} ErrorResponse
+// @Security ApiKeyAuth
 // @Router /keys [get]
 func (handler *keyHandler) ListMetadata(ctx *gin.Context) {
 	query := keys.NewCryptoKeyQuery()
@@ -456,22 +756,13 @@ func (handler *keyHandler) ListMetadata(ctx *gin.Context) {
 	if err != nil {
 		var errorResponse ErrorResponse
 		errorResponse.Message = fmt.Sprintf("list query failed: %v", err.Error())
-		ctx.JSON(http.StatusNotFound, errorResponse)
+		ctx.JSON(authErrorStatus(err, http.StatusNotFound), errorResponse)
 		return
 	}
 
 	var listResponse = []CryptoKeyMetaResponse{}
 	for _, cryptoKeyMeta := range cryptoKeyMetas {
-		cryptoKeyMetadataResponse := CryptoKeyMetaResponse{
-			ID:              cryptoKeyMeta.ID,
-			KeyPairID:       cryptoKeyMeta.KeyPairID,
-			Algorithm:       cryptoKeyMeta.Algorithm,
-			KeySize:         cryptoKeyMeta.KeySize,
-			Type:            cryptoKeyMeta.Type,
-			DateTimeCreated: cryptoKeyMeta.DateTimeCreated,
-			UserID:          cryptoKeyMeta.UserID,
-		}
-		listResponse = append(listResponse, cryptoKeyMetadataResponse)
+		listResponse = append(listResponse, newCryptoKeyMetaResponse(cryptoKeyMeta))
 	}
 
 	ctx.JSON(http.StatusOK, listResponse)
@@ -485,7 +776,10 @@ func (handler *keyHandler) ListMetadata(ctx *gin.Context) {
 // @Produce json
 // @Param id path string true "Key ID"
 // @Success 200 {object} CryptoKeyMetaResponse
+// @Failure 401 {object} ErrorResponse
+// @Failure 403 {object} ErrorResponse
 // @Failure 404 {object} ErrorResponse
+// @Security ApiKeyAuth
 // @Router /keys/{id} [get]
 func (handler *keyHandler) GetMetadataByID(ctx *gin.Context) {
 	keyID := ctx.Param("id")
@@ -493,33 +787,129 @@ func (handler *keyHandler) GetMetadataByID(ctx *gin.Context) {
 	cryptoKeyMeta, err := handler.cryptoKeyMetadataService.GetByID(ctx, keyID)
 	if err != nil {
 		var errorResponse ErrorResponse
-		errorResponse.Message = fmt.Sprintf("key with id %s not found", keyID)
-		ctx.JSON(http.StatusNotFound, errorResponse)
+		errorResponse.Message = authErrorMessage(err, fmt.Sprintf("key with id %s not found", keyID))
+		ctx.JSON(authErrorStatus(err, http.StatusNotFound), errorResponse)
+		return
+	}
+
+	ctx.JSON(http.StatusOK, newCryptoKeyMetaResponse(cryptoKeyMeta))
+}
+
+// UpdateLifecycle handles the PATCH request to transition the lifecycle state of a key pair and reschedule its activation or expiry
+// @Summary Update the lifecycle of a cryptographic key
+// @Description Transition all keys of the key pair the key belongs to into another lifecycle state (pre-active, active, suspended, deactivated, destroyed) and reschedule their activation or expiry. Pre-active keys can be activated or destroyed, active keys suspended or deactivated, suspended keys reactivated or deactivated, and deactivated keys destroyed. Destroying keys schedules their destruction once the grace period has passed and is refused while blobs are protected with the keys unless force is set.
+// @Tags Key
+// @Accept json
+// @Produce json
+// @Param id path string true "Key ID"
+// @Param requestBody body UpdateKeyLifecycleRequest true "Lifecycle Update"
+// @Success 200 {array} CryptoKeyMetaResponse
+// @Failure 400 {object} ErrorResponse
+// @Failure 401 {object} ErrorResponse
+// @Failure 403 {object} ErrorResponse
+// @Failure 409 {object} ErrorResponse
+// @Security ApiKeyAuth
+// @Router /keys/{id}/lifecycle [patch]
+func (handler *keyHandler) UpdateLifecycle(ctx *gin.Context) {
+	keyID := ctx.Param("id")
+
+	var request UpdateKeyLifecycleRequest
+	if err := ctx.ShouldBindJSON(&request); err != nil {
+		var errorResponse ErrorResponse
+		errorResponse.Message = fmt.Sprintf("invalid lifecycle data: %v", err.Error())
+		ctx.JSON(http.StatusBadRequest, errorResponse)
+		return
+	}
+
+	if err := request.Validate(); err != nil {
+		var errorResponse ErrorResponse
+		errorResponse.Message = fmt.Sprintf("validation failed: %v", err.Error())
+		ctx.JSON(http.StatusBadRequest, errorResponse)
+		return
+	}
+
+	cryptoKeyMetas, err := handler.cryptoKeyMetadataService.UpdateLifecycle(ctx, keyID, &keys.CryptoKeyLifecycle{
+		State:              request.State,
+		DateTimeActivation: request.DateTimeActivation,
+		DateTimeExpires:    request.DateTimeExpires,
+		Force:              request.Force,
+	})
+	if err != nil {
+		var errorResponse ErrorResponse
+		errorResponse.Message = authErrorMessage(err, fmt.Sprintf("error updating lifecycle of key with id %s: %v", keyID, err.Error()))
+		ctx.JSON(keyStateErrorStatus(err, authErrorStatus(err, http.StatusBadRequest)), errorResponse)
+		return
+	}
+
+	var listResponse = []CryptoKeyMetaResponse{}
+	for _, cryptoKeyMeta := range cryptoKeyMetas {
+		listResponse = append(listResponse, newCryptoKeyMetaResponse(cryptoKeyMeta))
+	}
+
+	ctx.JSON(http.StatusOK, listResponse)
+}
+
+// UpdateExportPolicy handles the PUT request to set the export policy of a key pair
+// @Summary Update the export policy of a cryptographic key by its ID
+// @Description Forbid the plaintext export of all keys of the key pair the key belongs to, so that their material can only be exported wrapped. Forbidding plaintext export cannot be undone, new versions of the logical key inherit it.
+// @Tags Key
+// @Accept json
+// @Produce json
+// @Param id path string true "Key ID"
+// @Param requestBody body UpdateExportPolicyRequest true "Export Policy"
+// @Success 200 {array} CryptoKeyMetaResponse
+// @Failure 400 {object} ErrorResponse
+// @Failure 401 {object} ErrorResponse
+// @Failure 403 {object} ErrorResponse
+// @Failure 404 {object} ErrorResponse
+// @Security ApiKeyAuth
+// @Router /keys/{id}/export-policy [put]
+func (handler *keyHandler) UpdateExportPolicy(ctx *gin.Context) {
+	keyID := ctx.Param("id")
+
+	var request UpdateExportPolicyRequest
+	if err := ctx.ShouldBindJSON(&request); err != nil {
+		var errorResponse ErrorResponse
+		errorResponse.Message = fmt.Sprintf("invalid export policy data: %v", err.Error())
+		ctx.JSON(http.StatusBadRequest, errorResponse)
+		return
+	}
+
+	if err := request.Validate(); err != nil {
+		var errorResponse ErrorResponse
+		errorResponse.Message = fmt.Sprintf("validation failed: %v", err.Error())
+		ctx.JSON(http.StatusBadRequest, errorResponse)
+		return
+	}
+
+	cryptoKeyMetas, err := handler.cryptoKeyMetadataService.UpdateExportPolicy(ctx, keyID, *request.PlaintextExportForbidden)
+	if err != nil {
+		var errorResponse ErrorResponse
+		errorResponse.Message = authErrorMessage(err, fmt.Sprintf("error updating export policy of key with id %s: %v", keyID, err.Error()))
+		ctx.JSON(exportErrorStatus(err, authErrorStatus(err, http.StatusNotFound)), errorResponse)
 		return
 	}
 
-	cryptoKeyMetadataResponse := CryptoKeyMetaResponse{
-		ID:              cryptoKeyMeta.ID,
-		KeyPairID:       cryptoKeyMeta.KeyPairID,
-		Algorithm:       cryptoKeyMeta.Algorithm,
-		KeySize:         cryptoKeyMeta.KeySize,
-		Type:            cryptoKeyMeta.Type,
-		DateTimeCreated: cryptoKeyMeta.DateTimeCreated,
-		UserID:          cryptoKeyMeta.UserID,
+	var listResponse = []CryptoKeyMetaResponse{}
+	for _, cryptoKeyMeta := range cryptoKeyMetas {
+		listResponse = append(listResponse, newCryptoKeyMetaResponse(cryptoKeyMeta))
 	}
 
-	ctx.JSON(http.StatusOK, cryptoKeyMetadataResponse)
+	ctx.JSON(http.StatusOK, listResponse)
 }
 
 // DownloadByID handles the GET request to download a key by its ID
 // @Summary Download a cryptographic key by its ID
-// @Description Download the content of a specific cryptographic key by its ID.
+// @Description Download the content of a specific cryptographic key by its ID. Private and symmetric keys whose export policy forbids plaintext export can only be exported wrapped.
 // @Tags Key
 // @Accept json
 // @Produce octet-stream
 // @Param id path string true "Key ID"
 // @Success 200 {file} file "Cryptographic key content"
+// @Failure 401 {object} ErrorResponse
+// @Failure 403 {object} ErrorResponse
 // @Failure 404 {object} ErrorResponse
+// @Security ApiKeyAuth
 // @Router /keys/{id}/file [get]
 func (handler *keyHandler) DownloadByID(ctx *gin.Context) {
 	keyID := ctx.Param("id")
@@ -528,7 +918,7 @@ func (handler *keyHandler) DownloadByID(ctx *gin.Context) {
 	if err != nil {
 		var errorResponse ErrorResponse
 		errorResponse.Message = fmt.Sprintf("could not download key with id %s: %v", keyID, err.Error())
-		ctx.JSON(http.StatusBadRequest, errorResponse)
+		ctx.JSON(exportErrorStatus(err, authErrorStatus(err, http.StatusBadRequest)), errorResponse)
 		return
 	}
 
@@ -545,27 +935,995 @@ func (handler *keyHandler) DownloadByID(ctx *gin.Context) {
 	}
 }
 
-// DeleteByID handles the DELETE request to delete a key by its ID
-// @Summary Delete a cryptographic key by its ID
-// @Description Delete a specific cryptographic key and its associated metadata by its ID.
+// ExportWrappedByID handles the POST request to export a key by its ID wrapped with another key
+// @Summary Export a cryptographic key wrapped with another key by its ID
+// @Description Export the content of a specific cryptographic key wrapped with either an AES key of the vault (AES_KEY_WRAP_PAD, RFC 5649) or an RSA public key supplied by the caller. Symmetric keys are wrapped with RSAES_OAEP_SHA_256, private and public keys with RSA_AES_KEY_WRAP_SHA_256. Only the wrapped key is returned, the wrapping algorithm is set in the X-Wrapping-Algorithm header.
+// @Tags Key
+// @Accept json
+// @Produce octet-stream
+// @Param id path string true "Key ID"
+// @Param requestBody body ExportKeyRequest true "Wrapping Key"
+// @Success 200 {file} file "Wrapped cryptographic key content"
+// @Failure 400 {object} ErrorResponse
+// @Failure 401 {object} ErrorResponse
+// @Failure 403 {object} ErrorResponse
+// @Failure 409 {object} ErrorResponse
+// @Security ApiKeyAuth
+// @Router /keys/{id}/export [post]
+func (handler *keyHandler) ExportWrappedByID(ctx *gin.Context) {
+	keyID := ctx.Param("id")
+
+	var request ExportKeyRequest
+	if err := ctx.ShouldBindJSON(&request); err != nil {
+		var errorResponse ErrorResponse
+		errorResponse.Message = fmt.Sprintf("invalid export data: %v", err.Error())
+		ctx.JSON(http.StatusBadRequest, errorResponse)
+		return
+	}
+
+	if err := request.Validate(); err != nil {
+		var errorResponse ErrorResponse
+		errorResponse.Message = fmt.Sprintf("validation failed: %v", err.Error())
+		ctx.JSON(http.StatusBadRequest, errorResponse)
+		return
+	}
+
+	export, err := handler.cryptoKeyDownloadService.ExportWrappedByID(ctx, keyID, &keys.KeyExportWrapping{
+		WrappingKeyID: request.WrappingKeyID,
+		PublicKey:     request.PublicKey,
+	})
+	if err != nil {
+		var errorResponse ErrorResponse
+		errorResponse.Message = authErrorMessage(err, fmt.Sprintf("could not export key with id %s: %v", keyID, err.Error()))
+		ctx.JSON(keyStateErrorStatus(err, authErrorStatus(err, http.StatusBadRequest)), errorResponse)
+		return
+	}
+
+	ctx.Header("X-Wrapping-Algorithm", export.WrappingAlgorithm)
+	if export.WrappingKeyID != "" {
+		ctx.Header("X-Wrapping-Key-ID", export.WrappingKeyID)
+	}
+	ctx.Header("Content-Disposition", "attachment; filename="+keyID+".wrapped")
+	ctx.Data(http.StatusOK, "application/octet-stream", export.WrappedKey)
+}
+
+// DeleteByID handles the DELETE request to schedule the destruction of a key by its ID
+// @Summary Schedule the destruction of a cryptographic key by its ID
+// @Description Schedule the destruction of all keys of the key pair a key belongs to once the configured grace period has passed. The destruction can be cancelled until then. Keys blobs are still protected with are only scheduled for destruction if force is set. The material of destroyed keys is deleted, their metadata is kept.
 // @Tags Key
 // @Accept json
 // @Produce json
 // @Param id path string true "Key ID"
-// @Success 204 {object} InfoResponse
+// @Param force query bool false "Schedule the destruction even if blobs are still protected with the keys"
+// @Success 202 {array} CryptoKeyMetaResponse
+// @Failure 400 {object} ErrorResponse
+// @Failure 401 {object} ErrorResponse
+// @Failure 403 {object} ErrorResponse
 // @Failure 404 {object} ErrorResponse
+// @Failure 409 {object} ErrorResponse
+// @Security ApiKeyAuth
 // @Router /keys/{id} [delete]
 func (handler *keyHandler) DeleteByID(ctx *gin.Context) {
 	keyID := ctx.Param("id")
 
-	if err := handler.cryptoKeyMetadataService.DeleteByID(ctx, keyID); err != nil {
+	force := false
+	if forceQuery := ctx.Query("force"); len(forceQuery) > 0 {
+		parsedForce, err := strconv.ParseBool(forceQuery)
+		if err != nil {
+			var errorResponse ErrorResponse
+			errorResponse.Message = fmt.Sprintf("invalid force parameter: %v", err.Error())
+			ctx.JSON(http.StatusBadRequest, errorResponse)
+			return
+		}
+		force = parsedForce
+	}
+
+	cryptoKeyMetas, err := handler.cryptoKeyMetadataService.DeleteByID(ctx, keyID, force)
+	if err != nil {
 		var errorResponse ErrorResponse
-		errorResponse.Message = fmt.Sprintf("error deleting key with id %s", keyID)
-		ctx.JSON(http.StatusNotFound, errorResponse)
+		errorResponse.Message = authErrorMessage(err, fmt.Sprintf("error deleting key with id %s: %v", keyID, err.Error()))
+		ctx.JSON(keyStateErrorStatus(err, authErrorStatus(err, http.StatusNotFound)), errorResponse)
 		return
 	}
 
-	var infoResponse InfoResponse
-	infoResponse.Message = fmt.Sprintf("deleted key with id %s", keyID)
-	ctx.JSON(http.StatusNoContent, infoResponse)
+	var listResponse = []CryptoKeyMetaResponse{}
+	for _, cryptoKeyMeta := range cryptoKeyMetas {
+		listResponse = append(listResponse, newCryptoKeyMetaResponse(cryptoKeyMeta))
+	}
+
+	ctx.JSON(http.StatusAccepted, listResponse)
+}
+
+// CancelDestruction handles the DELETE request to cancel the scheduled destruction of a key by its ID
+// @Summary Cancel the scheduled destruction of a cryptographic key by its ID
+// @Description Cancel the scheduled destruction of all keys of the key pair a key belongs to, provided the grace period has not passed yet.
+// @Tags Key
+// @Accept json
+// @Produce json
+// @Param id path string true "Key ID"
+// @Success 200 {array} CryptoKeyMetaResponse
+// @Failure 401 {object} ErrorResponse
+// @Failure 403 {object} ErrorResponse
+// @Failure 404 {object} ErrorResponse
+// @Failure 409 {object} ErrorResponse
+// @Security ApiKeyAuth
+// @Router /keys/{id}/destruction [delete]
+func (handler *keyHandler) CancelDestruction(ctx *gin.Context) {
+	keyID := ctx.Param("id")
+
+	cryptoKeyMetas, err := handler.cryptoKeyMetadataService.CancelDestruction(ctx, keyID)
+	if err != nil {
+		var errorResponse ErrorResponse
+		errorResponse.Message = authErrorMessage(err, fmt.Sprintf("error cancelling destruction of key with id %s: %v", keyID, err.Error()))
+		ctx.JSON(keyStateErrorStatus(err, authErrorStatus(err, http.StatusNotFound)), errorResponse)
+		return
+	}
+
+	var listResponse = []CryptoKeyMetaResponse{}
+	for _, cryptoKeyMeta := range cryptoKeyMetas {
+		listResponse = append(listResponse, newCryptoKeyMetaResponse(cryptoKeyMeta))
+	}
+
+	ctx.JSON(http.StatusOK, listResponse)
+}
+
+// Rotate handles the POST request to rotate the logical key a key belongs to
+// @Summary Rotate the logical key of a cryptographic key by its ID
+// @Description Generate a new version of the logical key the key belongs to and make it the primary version. Older versions remain available to decrypt and verify existing data.
+// @Tags Key
+// @Accept json
+// @Produce json
+// @Param id path string true "Key ID"
+// @Success 201 {array} CryptoKeyMetaResponse
+// @Failure 401 {object} ErrorResponse
+// @Failure 403 {object} ErrorResponse
+// @Failure 404 {object} ErrorResponse
+// @Security ApiKeyAuth
+// @Router /keys/{id}/rotate [post]
+func (handler *keyHandler) Rotate(ctx *gin.Context) {
+	keyID := ctx.Param("id")
+
+	cryptoKeyMetas, err := handler.cryptoKeyRotationService.Rotate(ctx, keyID)
+	if err != nil {
+		var errorResponse ErrorResponse
+		errorResponse.Message = authErrorMessage(err, fmt.Sprintf("error rotating key with id %s: %v", keyID, err.Error()))
+		ctx.JSON(authErrorStatus(err, http.StatusNotFound), errorResponse)
+		return
+	}
+
+	var listResponse = []CryptoKeyMetaResponse{}
+	for _, cryptoKeyMeta := range cryptoKeyMetas {
+		listResponse = append(listResponse, newCryptoKeyMetaResponse(cryptoKeyMeta))
+	}
+
+	ctx.JSON(http.StatusCreated, listResponse)
+}
+
+// ListVersions handles the GET request to list all versions of the logical key a key belongs to
+// @Summary List the versions of the logical key of a cryptographic key by its ID
+// @Description Fetch the key metadata of all versions of the logical key the key belongs to, newest version first.
+// @Tags Key
+// @Accept json
+// @Produce json
+// @Param id path string true "Key ID"
+// @Success 200 {array} CryptoKeyMetaResponse
+// @Failure 401 {object} ErrorResponse
+// @Failure 403 {object} ErrorResponse
+// @Failure 404 {object} ErrorResponse
+// @Security ApiKeyAuth
+// @Router /keys/{id}/versions [get]
+func (handler *keyHandler) ListVersions(ctx *gin.Context) {
+	keyID := ctx.Param("id")
+
+	cryptoKeyMetas, err := handler.cryptoKeyRotationService.ListVersions(ctx, keyID)
+	if err != nil {
+		var errorResponse ErrorResponse
+		errorResponse.Message = authErrorMessage(err, fmt.Sprintf("error listing versions of key with id %s: %v", keyID, err.Error()))
+		ctx.JSON(authErrorStatus(err, http.StatusNotFound), errorResponse)
+		return
+	}
+
+	var listResponse = []CryptoKeyMetaResponse{}
+	for _, cryptoKeyMeta := range cryptoKeyMetas {
+		listResponse = append(listResponse, newCryptoKeyMetaResponse(cryptoKeyMeta))
+	}
+
+	ctx.JSON(http.StatusOK, listResponse)
+}
+
+// UpdateRotationPolicy handles the PUT request to set the automatic rotation period of the logical key a key belongs to
+// @Summary Update the rotation policy of the logical key of a cryptographic key by its ID
+// @Description Set the period after which the logical key the key belongs to is rotated automatically. An empty period or "0" disables automatic rotation.
+// @Tags Key
+// @Accept json
+// @Produce json
+// @Param id path string true "Key ID"
+// @Param requestBody body UpdateRotationPolicyRequest true "Rotation Policy"
+// @Success 200 {object} LogicalKeyResponse
+// @Failure 400 {object} ErrorResponse
+// @Failure 401 {object} ErrorResponse
+// @Failure 403 {object} ErrorResponse
+// @Failure 404 {object} ErrorResponse
+// @Security ApiKeyAuth
+// @Router /keys/{id}/rotation-policy [put]
+func (handler *keyHandler) UpdateRotationPolicy(ctx *gin.Context) {
+	keyID := ctx.Param("id")
+
+	var request UpdateRotationPolicyRequest
+
+	if err := ctx.ShouldBindJSON(&request); err != nil {
+		var errorResponse ErrorResponse
+		errorResponse.Message = fmt.Sprintf("invalid rotation policy data: %v", err.Error())
+		ctx.JSON(http.StatusBadRequest, errorResponse)
+		return
+	}
+
+	if err := request.Validate(); err != nil {
+		var errorResponse ErrorResponse
+		errorResponse.Message = fmt.Sprintf("validation failed: %v", err.Error())
+		ctx.JSON(http.StatusBadRequest, errorResponse)
+		return
+	}
+
+	rotationPeriod, err := request.Period()
+	if err != nil {
+		var errorResponse ErrorResponse
+		errorResponse.Message = fmt.Sprintf("validation failed: %v", err.Error())
+		ctx.JSON(http.StatusBadRequest, errorResponse)
+		return
+	}
+
+	logicalKey, err := handler.cryptoKeyRotationService.UpdateRotationPolicy(ctx, keyID, rotationPeriod)
+	if err != nil {
+		var errorResponse ErrorResponse
+		errorResponse.Message = authErrorMessage(err, fmt.Sprintf("error updating rotation policy of key with id %s: %v", keyID, err.Error()))
+		ctx.JSON(authErrorStatus(err, http.StatusNotFound), errorResponse)
+		return
+	}
+
+	ctx.JSON(http.StatusOK, newLogicalKeyResponse(logicalKey))
+}
+
+// ImportKeys handles the POST request to import cryptographic key material generated outside the service
+// @Summary Import cryptographic key material
+// @Description Import AES, RSA or EC key material encoded as PEM, DER, JWK or raw AES key, optionally wrapped with the key import public key. The imported keys form version 1 of a new logical key.
+// @Tags Key
+// @Accept json
+// @Produce json
+// @Param requestBody body ImportKeyRequest true "Key Material"
+// @Success 201 {array} CryptoKeyMetaResponse
+// @Failure 400 {object} ErrorResponse
+// @Failure 401 {object} ErrorResponse
+// @Security ApiKeyAuth
+// @Router /keys/import [post]
+func (handler *keyHandler) ImportKeys(ctx *gin.Context) {
+
+	var request ImportKeyRequest
+
+	if err := ctx.ShouldBindJSON(&request); err != nil {
+		var errorResponse ErrorResponse
+		errorResponse.Message = fmt.Sprintf("invalid key import data: %v", err.Error())
+		ctx.JSON(http.StatusBadRequest, errorResponse)
+		return
+	}
+
+	if err := request.Validate(); err != nil {
+		var errorResponse ErrorResponse
+		errorResponse.Message = fmt.Sprintf("validation failed: %v", err.Error())
+		ctx.JSON(http.StatusBadRequest, errorResponse)
+		return
+	}
+
+	cryptoKeyMetas, err := handler.cryptoKeyImportService.Import(ctx, &keys.CryptoKeyImport{
+		Algorithm:   request.Algorithm,
+		Format:      request.Format,
+		KeyMaterial: request.KeyMaterial,
+		Wrapped:     request.Wrapped,
+	}, &keys.CryptoKeyLifecycle{
+		State:              request.State,
+		DateTimeActivation: request.DateTimeActivation,
+		DateTimeExpires:    request.DateTimeExpires,
+	})
+	if err != nil {
+		var errorResponse ErrorResponse
+		errorResponse.Message = authErrorMessage(err, fmt.Sprintf("error importing key: %v", err.Error()))
+		ctx.JSON(authErrorStatus(err, http.StatusBadRequest), errorResponse)
+		return
+	}
+
+	var listResponse = []CryptoKeyMetaResponse{}
+	for _, cryptoKeyMeta := range cryptoKeyMetas {
+		listResponse = append(listResponse, newCryptoKeyMetaResponse(cryptoKeyMeta))
+	}
+
+	ctx.JSON(http.StatusCreated, listResponse)
+}
+
+// GetImportPublicKey handles the GET request to fetch the public key key material is wrapped with before importing it wrapped
+// @Summary Get the key import public key
+// @Description Fetch the RSA public key to wrap key material with RSA_AES_KEY_WRAP_SHA_256 before importing it, so that it never travels in plaintext.
+// @Tags Key
+// @Accept json
+// @Produce json
+// @Success 200 {object} KeyImportPublicKeyResponse
+// @Failure 400 {object} ErrorResponse
+// @Failure 401 {object} ErrorResponse
+// @Security ApiKeyAuth
+// @Router /keys/import-key [get]
+func (handler *keyHandler) GetImportPublicKey(ctx *gin.Context) {
+	publicKey, err := handler.cryptoKeyImportService.GetImportPublicKey(ctx)
+	if err != nil {
+		var errorResponse ErrorResponse
+		errorResponse.Message = authErrorMessage(err, fmt.Sprintf("error fetching key import public key: %v", err.Error()))
+		ctx.JSON(authErrorStatus(err, http.StatusBadRequest), errorResponse)
+		return
+	}
+
+	ctx.JSON(http.StatusOK, KeyImportPublicKeyResponse{
+		PublicKey:         string(publicKey),
+		WrappingAlgorithm: cryptography.RSAAESKeyWrapAlgorithm,
+	})
+}
+
+// newLogicalKeyResponse maps a LogicalKey to its response representation
+func newLogicalKeyResponse(logicalKey *keys.LogicalKey) LogicalKeyResponse {
+	return LogicalKeyResponse{
+		ID:                   logicalKey.ID,
+		UserID:               logicalKey.UserID,
+		Algorithm:            logicalKey.Algorithm,
+		KeySize:              logicalKey.KeySize,
+		PrimaryVersion:       logicalKey.PrimaryVersion,
+		PrimaryKeyPairID:     logicalKey.PrimaryKeyPairID,
+		LatestVersion:        logicalKey.LatestVersion,
+		RotationPeriod:       logicalKey.RotationPeriod.String(),
+		DateTimeNextRotation: logicalKey.DateTimeNextRotation,
+		DateTimeCreated:      logicalKey.DateTimeCreated,
+		DateTimeRotated:      logicalKey.DateTimeRotated,
+	}
+}
+
+// newCryptoKeyMetaResponse maps a CryptoKeyMeta to its response representation, reporting the lifecycle state the key is in now
+func newCryptoKeyMetaResponse(cryptoKeyMeta *keys.CryptoKeyMeta) CryptoKeyMetaResponse {
+	return CryptoKeyMetaResponse{
+		ID:                       cryptoKeyMeta.ID,
+		KeyPairID:                cryptoKeyMeta.KeyPairID,
+		Algorithm:                cryptoKeyMeta.Algorithm,
+		KeySize:                  cryptoKeyMeta.KeySize,
+		Type:                     cryptoKeyMeta.Type,
+		DateTimeCreated:          cryptoKeyMeta.DateTimeCreated,
+		UserID:                   cryptoKeyMeta.UserID,
+		State:                    cryptoKeyMeta.EffectiveState(time.Now()),
+		DateTimeActivation:       cryptoKeyMeta.DateTimeActivation,
+		DateTimeExpires:          cryptoKeyMeta.DateTimeExpires,
+		DateTimeDestruction:      cryptoKeyMeta.DateTimeDestruction,
+		LogicalKeyID:             cryptoKeyMeta.LogicalKeyID,
+		Version:                  cryptoKeyMeta.Version,
+		PlaintextExportForbidden: cryptoKeyMeta.PlaintextExportForbidden,
+		TokenLabel:               cryptoKeyMeta.TokenLabel,
+	}
+}
+
+// keyStateErrorStatus returns http.StatusConflict for errors caused by the lifecycle state of a key or by blobs still protected with it, or status for any other error
+func keyStateErrorStatus(err error, status int) int {
+	if errors.Is(err, keys.ErrKeyState) || errors.Is(err, keys.ErrKeyInUse) {
+		return http.StatusConflict
+	}
+	return status
+}
+
+// exportErrorStatus returns http.StatusForbidden for errors caused by the export policy of a key, or status for any other error
+func exportErrorStatus(err error, status int) int {
+	if errors.Is(err, keys.ErrExportForbidden) {
+		return http.StatusForbidden
+	}
+	return status
+}
+
+// CryptoHandler defines the interface for handling cryptographic operations with stored keys
+type CryptoHandler interface {
+	Encrypt(ctx *gin.Context)
+	Decrypt(ctx *gin.Context)
+	Sign(ctx *gin.Context)
+	Verify(ctx *gin.Context)
+	Wrap(ctx *gin.Context)
+	Unwrap(ctx *gin.Context)
+}
+
+// cryptoHandler struct holds the services
+type cryptoHandler struct {
+	cryptoOperationService crypto.CryptoOperationService
+}
+
+// NewCryptoHandler creates a new CryptoHandler
+func NewCryptoHandler(cryptoOperationService crypto.CryptoOperationService) CryptoHandler {
+	return &cryptoHandler{
+		cryptoOperationService: cryptoOperationService,
+	}
+}
+
+// Encrypt handles the POST request to encrypt a payload with a key
+// @Summary Encrypt a payload with a key
+// @Description Encrypt a base64 payload with an AES key or the public key of an RSA key pair, optionally binding associated data. The returned ciphertext is an envelope recording the key pair.
+// @Tags Crypto
+// @Accept json
+// @Produce json
+// @Param id path string true "Key ID"
+// @Param requestBody body CryptoOperationRequest true "Operation Payload"
+// @Success 200 {object} CryptoOperationResponse
+// @Failure 400 {object} ErrorResponse
+// @Failure 401 {object} ErrorResponse
+// @Failure 403 {object} ErrorResponse
+// @Security ApiKeyAuth
+// @Router /keys/{id}/encrypt [post]
+func (handler *cryptoHandler) Encrypt(ctx *gin.Context) {
+	handler.perform(ctx, crypto.OperationEncrypt, func(keyID string, request *CryptoOperationRequest) (*crypto.OperationResult, error) {
+		return handler.cryptoOperationService.Encrypt(ctx, keyID, request.Payload, request.AssociatedData)
+	})
+}
+
+// Decrypt handles the POST request to decrypt a payload with a key
+// @Summary Decrypt a payload with a key
+// @Description Decrypt a base64 ciphertext returned by encrypt with an AES key or the private key of an RSA key pair. The associated data passed on encryption must be passed again.
+// @Tags Crypto
+// @Accept json
+// @Produce json
+// @Param id path string true "Key ID"
+// @Param requestBody body CryptoOperationRequest true "Operation Payload"
+// @Success 200 {object} CryptoOperationResponse
+// @Failure 400 {object} ErrorResponse
+// @Failure 401 {object} ErrorResponse
+// @Failure 403 {object} ErrorResponse
+// @Security ApiKeyAuth
+// @Router /keys/{id}/decrypt [post]
+func (handler *cryptoHandler) Decrypt(ctx *gin.Context) {
+	handler.perform(ctx, crypto.OperationDecrypt, func(keyID string, request *CryptoOperationRequest) (*crypto.OperationResult, error) {
+		return handler.cryptoOperationService.Decrypt(ctx, keyID, request.Payload, request.AssociatedData)
+	})
+}
+
+// Sign handles the POST request to sign a payload with a key
+// @Summary Sign a payload with a key
+// @Description Sign the SHA-256 digest of a base64 payload with the private key of an RSA or EC key pair.
+// @Tags Crypto
+// @Accept json
+// @Produce json
+// @Param id path string true "Key ID"
+// @Param requestBody body CryptoOperationRequest true "Operation Payload"
+// @Success 200 {object} CryptoOperationResponse
+// @Failure 400 {object} ErrorResponse
+// @Failure 401 {object} ErrorResponse
+// @Failure 403 {object} ErrorResponse
+// @Security ApiKeyAuth
+// @Router /keys/{id}/sign [post]
+func (handler *cryptoHandler) Sign(ctx *gin.Context) {
+	handler.perform(ctx, crypto.OperationSign, func(keyID string, request *CryptoOperationRequest) (*crypto.OperationResult, error) {
+		return handler.cryptoOperationService.Sign(ctx, keyID, request.Payload)
+	})
+}
+
+// Verify handles the POST request to verify a payload with a key
+// @Summary Verify the signature of a payload with a key
+// @Description Verify the base64 signature of a base64 payload with the public key of an RSA or EC key pair. A signature not matching the payload is reported as invalid.
+// @Tags Crypto
+// @Accept json
+// @Produce json
+// @Param id path string true "Key ID"
+// @Param requestBody body CryptoOperationRequest true "Operation Payload"
+// @Success 200 {object} CryptoOperationResponse
+// @Failure 400 {object} ErrorResponse
+// @Failure 401 {object} ErrorResponse
+// @Failure 403 {object} ErrorResponse
+// @Security ApiKeyAuth
+// @Router /keys/{id}/verify [post]
+func (handler *cryptoHandler) Verify(ctx *gin.Context) {
+	handler.perform(ctx, crypto.OperationVerify, func(keyID string, request *CryptoOperationRequest) (*crypto.OperationResult, error) {
+		return handler.cryptoOperationService.Verify(ctx, keyID, request.Payload, request.Signature)
+	})
+}
+
+// Wrap handles the POST request to wrap a payload with a key
+// @Summary Wrap key material with a key
+// @Description Wrap base64 key material with an AES key (AES Key Wrap with Padding, RFC 5649) or the public key of an RSA key pair (RSA-OAEP with SHA-256).
+// @Tags Crypto
+// @Accept json
+// @Produce json
+// @Param id path string true "Key ID"
+// @Param requestBody body CryptoOperationRequest true "Operation Payload"
+// @Success 200 {object} CryptoOperationResponse
+// @Failure 400 {object} ErrorResponse
+// @Failure 401 {object} ErrorResponse
+// @Failure 403 {object} ErrorResponse
+// @Security ApiKeyAuth
+// @Router /keys/{id}/wrap [post]
+func (handler *cryptoHandler) Wrap(ctx *gin.Context) {
+	handler.perform(ctx, crypto.OperationWrap, func(keyID string, request *CryptoOperationRequest) (*crypto.OperationResult, error) {
+		return handler.cryptoOperationService.Wrap(ctx, keyID, request.Payload)
+	})
+}
+
+// Unwrap handles the POST request to unwrap a payload with a key
+// @Summary Unwrap key material with a key
+// @Description Unwrap base64 key material returned by wrap with an AES key or the private key of an RSA key pair.
+// @Tags Crypto
+// @Accept json
+// @Produce json
+// @Param id path string true "Key ID"
+// @Param requestBody body CryptoOperationRequest true "Operation Payload"
+// @Success 200 {object} CryptoOperationResponse
+// @Failure 400 {object} ErrorResponse
+// @Failure 401 {object} ErrorResponse
+// @Failure 403 {object} ErrorResponse
+// @Security ApiKeyAuth
+// @Router /keys/{id}/unwrap [post]
+func (handler *cryptoHandler) Unwrap(ctx *gin.Context) {
+	handler.perform(ctx, crypto.OperationUnwrap, func(keyID string, request *CryptoOperationRequest) (*crypto.OperationResult, error) {
+		return handler.cryptoOperationService.Unwrap(ctx, keyID, request.Payload)
+	})
+}
+
+// perform binds and validates the request of an operation, runs the operation with the key given by the path and responds with its result
+func (handler *cryptoHandler) perform(ctx *gin.Context, operation string, run func(keyID string, request *CryptoOperationRequest) (*crypto.OperationResult, error)) {
+	keyID := ctx.Param("id")
+
+	var request CryptoOperationRequest
+
+	if err := ctx.ShouldBindJSON(&request); err != nil {
+		var errorResponse ErrorResponse
+		errorResponse.Message = fmt.Sprintf("invalid %s data: %v", operation, err.Error())
+		ctx.JSON(http.StatusBadRequest, errorResponse)
+		return
+	}
+
+	if err := request.Validate(); err != nil {
+		var errorResponse ErrorResponse
+		errorResponse.Message = fmt.Sprintf("validation failed: %v", err.Error())
+		ctx.JSON(http.StatusBadRequest, errorResponse)
+		return
+	}
+
+	result, err := run(keyID, &request)
+	if err != nil {
+		var errorResponse ErrorResponse
+		errorResponse.Message = fmt.Sprintf("error performing %s with key with id %s: %v", operation, keyID, err.Error())
+		ctx.JSON(exportErrorStatus(err, keyStateErrorStatus(err, authErrorStatus(err, http.StatusBadRequest))), errorResponse)
+		return
+	}
+
+	ctx.JSON(http.StatusOK, CryptoOperationResponse{
+		Operation:  result.Operation,
+		KeyID:      result.KeyID,
+		KeyPairID:  result.KeyPairID,
+		KeyVersion: result.KeyVersion,
+		Algorithm:  result.Algorithm,
+		Ciphertext: result.Ciphertext,
+		Plaintext:  result.Plaintext,
+		Signature:  result.Signature,
+		WrappedKey: result.WrappedKey,
+		Key:        result.Key,
+		Valid:      result.Valid,
+	})
+}
+
+// MasterKeyHandler defines the interface for handling master key related operations
+type MasterKeyHandler interface {
+	Rotate(ctx *gin.Context)
+	GetRotationByID(ctx *gin.Context)
+}
+
+// masterKeyHandler struct holds the services
+type masterKeyHandler struct {
+	masterKeyRotationService keys.MasterKeyRotationService
+}
+
+// NewMasterKeyHandler creates a new MasterKeyHandler
+func NewMasterKeyHandler(masterKeyRotationService keys.MasterKeyRotationService) MasterKeyHandler {
+	return &masterKeyHandler{
+		masterKeyRotationService: masterKeyRotationService,
+	}
+}
+
+// Rotate handles the POST request to rotate the master key
+// @Summary Rotate the master key
+// @Description Introduce a new master key version and re-wrap all stored cryptographic keys in the background. A failed rotation is resumed with the keys it left to re-wrap instead.
+// @Tags MasterKey
+// @Accept json
+// @Produce json
+// @Success 202 {object} MasterKeyRotationResponse
+// @Failure 401 {object} ErrorResponse
+// @Failure 403 {object} ErrorResponse
+// @Failure 409 {object} ErrorResponse
+// @Security ApiKeyAuth
+// @Router /master-key/rotations [post]
+func (handler *masterKeyHandler) Rotate(ctx *gin.Context) {
+	rotation, err := handler.masterKeyRotationService.Rotate(ctx)
+	if err != nil {
+		var errorResponse ErrorResponse
+		errorResponse.Message = fmt.Sprintf("error rotating master key: %v", err.Error())
+		ctx.JSON(authErrorStatus(err, http.StatusConflict), errorResponse)
+		return
+	}
+
+	ctx.JSON(http.StatusAccepted, newMasterKeyRotationResponse(rotation))
+}
+
+// GetRotationByID handles the GET request to retrieve the progress of a master key rotation by its ID
+// @Summary Retrieve a master key rotation by its ID
+// @Description Fetch the status and progress of a master key rotation by its unique ID.
+// @Tags MasterKey
+// @Accept json
+// @Produce json
+// @Param id path string true "Rotation ID"
+// @Success 200 {object} MasterKeyRotationResponse
+// @Failure 401 {object} ErrorResponse
+// @Failure 403 {object} ErrorResponse
+// @Failure 404 {object} ErrorResponse
+// @Security ApiKeyAuth
+// @Router /master-key/rotations/{id} [get]
+func (handler *masterKeyHandler) GetRotationByID(ctx *gin.Context) {
+	rotationID := ctx.Param("id")
+
+	rotation, err := handler.masterKeyRotationService.GetByID(ctx, rotationID)
+	if err != nil {
+		var errorResponse ErrorResponse
+		errorResponse.Message = authErrorMessage(err, fmt.Sprintf("master key rotation with id %s not found", rotationID))
+		ctx.JSON(authErrorStatus(err, http.StatusNotFound), errorResponse)
+		return
+	}
+
+	ctx.JSON(http.StatusOK, newMasterKeyRotationResponse(rotation))
+}
+
+// newMasterKeyRotationResponse maps a MasterKeyRotation to its response representation
+func newMasterKeyRotationResponse(rotation *keys.MasterKeyRotation) MasterKeyRotationResponse {
+	return MasterKeyRotationResponse{
+		ID:               rotation.ID,
+		TargetKEKVersion: rotation.TargetKEKVersion,
+		Status:           rotation.Status,
+		ProcessedKeys:    rotation.Offset,
+		RewrappedKeys:    rotation.RewrappedKeys,
+		Error:            rotation.Error,
+		DateTimeStarted:  rotation.DateTimeStarted,
+		DateTimeUpdated:  rotation.DateTimeUpdated,
+	}
+}
+
+// PKCS11Handler defines the interface for handling the administration of PKCS#11 tokens
+type PKCS11Handler interface {
+	ListTokenSlots(ctx *gin.Context)
+	InitializeToken(ctx *gin.Context)
+	ListObjects(ctx *gin.Context)
+	AddKey(ctx *gin.Context)
+	DeleteObject(ctx *gin.Context)
+	Encrypt(ctx *gin.Context)
+	Decrypt(ctx *gin.Context)
+	Sign(ctx *gin.Context)
+	Verify(ctx *gin.Context)
+}
+
+// pkcs11Handler struct holds the services
+type pkcs11Handler struct {
+	pkcs11AdminService pkcs11.PKCS11AdminService
+}
+
+// NewPKCS11Handler creates a new PKCS11Handler
+func NewPKCS11Handler(pkcs11AdminService pkcs11.PKCS11AdminService) PKCS11Handler {
+	return &pkcs11Handler{
+		pkcs11AdminService: pkcs11AdminService,
+	}
+}
+
+// ListTokenSlots handles the GET request to list the tokens of the PKCS#11 module
+// @Summary List the PKCS#11 tokens
+// @Description Fetch the tokens present in the slots of the configured PKCS#11 module. Restricted to admins.
+// @Tags PKCS11
+// @Accept json
+// @Produce json
+// @Success 200 {array} PKCS11TokenResponse
+// @Failure 401 {object} ErrorResponse
+// @Failure 403 {object} ErrorResponse
+// @Failure 500 {object} ErrorResponse
+// @Security ApiKeyAuth
+// @Router /pkcs11/slots [get]
+func (handler *pkcs11Handler) ListTokenSlots(ctx *gin.Context) {
+	tokens, err := handler.pkcs11AdminService.ListTokenSlots(ctx)
+	if err != nil {
+		var errorResponse ErrorResponse
+		errorResponse.Message = fmt.Sprintf("error listing tokens: %v", err.Error())
+		ctx.JSON(authErrorStatus(err, http.StatusInternalServerError), errorResponse)
+		return
+	}
+
+	var listResponse = []PKCS11TokenResponse{}
+	for _, token := range tokens {
+		listResponse = append(listResponse, PKCS11TokenResponse{
+			SlotID:       token.SlotID,
+			Label:        token.Label,
+			Manufacturer: token.Manufacturer,
+			Model:        token.Model,
+			SerialNumber: token.SerialNumber,
+		})
+	}
+
+	ctx.JSON(http.StatusOK, listResponse)
+}
+
+// InitializeToken handles the POST request to initialize a PKCS#11 token
+// @Summary Initialize a PKCS#11 token
+// @Description Initialize the token of the configured slot with a label and the configured pins. Tokens already carrying the label are left untouched. Restricted to admins.
+// @Tags PKCS11
+// @Accept json
+// @Produce json
+// @Param requestBody body InitializePKCS11TokenRequest true "Token Data"
+// @Success 201 {object} InfoResponse
+// @Failure 400 {object} ErrorResponse
+// @Failure 401 {object} ErrorResponse
+// @Failure 403 {object} ErrorResponse
+// @Security ApiKeyAuth
+// @Router /pkcs11/tokens [post]
+func (handler *pkcs11Handler) InitializeToken(ctx *gin.Context) {
+	var request InitializePKCS11TokenRequest
+
+	if err := ctx.ShouldBindJSON(&request); err != nil {
+		var errorResponse ErrorResponse
+		errorResponse.Message = fmt.Sprintf("invalid token data: %v", err.Error())
+		ctx.JSON(http.StatusBadRequest, errorResponse)
+		return
+	}
+
+	if err := request.Validate(); err != nil {
+		var errorResponse ErrorResponse
+		errorResponse.Message = fmt.Sprintf("validation failed: %v", err.Error())
+		ctx.JSON(http.StatusBadRequest, errorResponse)
+		return
+	}
+
+	if err := handler.pkcs11AdminService.InitializeToken(ctx, request.Label); err != nil {
+		var errorResponse ErrorResponse
+		errorResponse.Message = fmt.Sprintf("error initializing token %s: %v", request.Label, err.Error())
+		ctx.JSON(authErrorStatus(err, http.StatusBadRequest), errorResponse)
+		return
+	}
+
+	var infoResponse InfoResponse
+	infoResponse.Message = fmt.Sprintf("initialized token %s", request.Label)
+	ctx.JSON(http.StatusCreated, infoResponse)
+}
+
+// ListObjects handles the GET request to list the objects stored on a PKCS#11 token
+// @Summary List the objects of a PKCS#11 token
+// @Description Fetch the keys and data objects stored on a token. Restricted to admins.
+// @Tags PKCS11
+// @Accept json
+// @Produce json
+// @Param label path string true "Token Label"
+// @Success 200 {array} PKCS11ObjectResponse
+// @Failure 401 {object} ErrorResponse
+// @Failure 403 {object} ErrorResponse
+// @Failure 404 {object} ErrorResponse
+// @Security ApiKeyAuth
+// @Router /pkcs11/tokens/{label}/objects [get]
+func (handler *pkcs11Handler) ListObjects(ctx *gin.Context) {
+	tokenLabel := ctx.Param("label")
+
+	objects, err := handler.pkcs11AdminService.ListObjects(ctx, tokenLabel)
+	if err != nil {
+		var errorResponse ErrorResponse
+		errorResponse.Message = fmt.Sprintf("error listing objects of token %s: %v", tokenLabel, err.Error())
+		ctx.JSON(authErrorStatus(err, http.StatusNotFound), errorResponse)
+		return
+	}
+
+	var listResponse = []PKCS11ObjectResponse{}
+	for _, object := range objects {
+		listResponse = append(listResponse, PKCS11ObjectResponse{
+			Label:  object.Label,
+			Type:   object.Type,
+			Usage:  object.Usage,
+			Access: object.Access,
+		})
+	}
+
+	ctx.JSON(http.StatusOK, listResponse)
+}
+
+// AddKey handles the POST request to generate a key pair on a PKCS#11 token
+// @Summary Generate a key pair on a PKCS#11 token
+// @Description Generate an RSA or ECDSA key pair or an AES key on a token, whose private or secret key never leaves the token. Restricted to admins.
+// @Tags PKCS11
+// @Accept json
+// @Produce json
+// @Param label path string true "Token Label"
+// @Param requestBody body AddPKCS11KeyRequest true "Key Data"
+// @Success 201 {object} InfoResponse
+// @Failure 400 {object} ErrorResponse
+// @Failure 401 {object} ErrorResponse
+// @Failure 403 {object} ErrorResponse
+// @Security ApiKeyAuth
+// @Router /pkcs11/tokens/{label}/keys [post]
+func (handler *pkcs11Handler) AddKey(ctx *gin.Context) {
+	tokenLabel := ctx.Param("label")
+
+	var request AddPKCS11KeyRequest
+
+	if err := ctx.ShouldBindJSON(&request); err != nil {
+		var errorResponse ErrorResponse
+		errorResponse.Message = fmt.Sprintf("invalid key data: %v", err.Error())
+		ctx.JSON(http.StatusBadRequest, errorResponse)
+		return
+	}
+
+	if err := request.Validate(); err != nil {
+		var errorResponse ErrorResponse
+		errorResponse.Message = fmt.Sprintf("validation failed: %v", err.Error())
+		ctx.JSON(http.StatusBadRequest, errorResponse)
+		return
+	}
+
+	if err := handler.pkcs11AdminService.AddKey(ctx, tokenLabel, request.ObjectLabel, request.KeyType, request.KeySize); err != nil {
+		var errorResponse ErrorResponse
+		errorResponse.Message = fmt.Sprintf("error adding key to token %s: %v", tokenLabel, err.Error())
+		ctx.JSON(authErrorStatus(err, http.StatusBadRequest), errorResponse)
+		return
+	}
+
+	var infoResponse InfoResponse
+	infoResponse.Message = fmt.Sprintf("added %s key %s to token %s", request.KeyType, request.ObjectLabel, tokenLabel)
+	ctx.JSON(http.StatusCreated, infoResponse)
+}
+
+// DeleteObject handles the DELETE request to delete objects from a PKCS#11 token
+// @Summary Delete objects from a PKCS#11 token
+// @Description Delete the objects of a type and label from a token. Object labels may contain slashes, e.g. those of keys stored by the pkcs11 vault connector. Restricted to admins.
+// @Tags PKCS11
+// @Accept json
+// @Produce json
+// @Param label path string true "Token Label"
+// @Param type path string true "Object Type (privkey, pubkey, secrkey, cert, data)"
+// @Param objectLabel path string true "Object Label"
+// @Success 204 {object} InfoResponse
+// @Failure 400 {object} ErrorResponse
+// @Failure 401 {object} ErrorResponse
+// @Failure 403 {object} ErrorResponse
+// @Failure 404 {object} ErrorResponse
+// @Security ApiKeyAuth
+// @Router /pkcs11/tokens/{label}/objects/{type}/{objectLabel} [delete]
+func (handler *pkcs11Handler) DeleteObject(ctx *gin.Context) {
+	tokenLabel := ctx.Param("label")
+	objectType := ctx.Param("type")
+	objectLabel := strings.TrimPrefix(ctx.Param("objectLabel"), "/")
+
+	if err := handler.pkcs11AdminService.DeleteObject(ctx, tokenLabel, objectType, objectLabel); err != nil {
+		status := http.StatusBadRequest
+		if errors.Is(err, cryptography.ErrObjectNotFound) {
+			status = http.StatusNotFound
+		}
+		var errorResponse ErrorResponse
+		errorResponse.Message = fmt.Sprintf("error deleting %s object %s from token %s: %v", objectType, objectLabel, tokenLabel, err.Error())
+		ctx.JSON(authErrorStatus(err, status), errorResponse)
+		return
+	}
+
+	var infoResponse InfoResponse
+	infoResponse.Message = fmt.Sprintf("deleted %s object %s from token %s", objectType, objectLabel, tokenLabel)
+	ctx.JSON(http.StatusNoContent, infoResponse)
+}
+
+// Encrypt handles the POST request to encrypt a payload on a PKCS#11 token
+// @Summary Encrypt a payload on a PKCS#11 token
+// @Description Encrypt a base64 payload with the public key of an RSA key pair (PKCS#1 v1.5) or with an AES key (AES-GCM, the IV prefixes the ciphertext) on a token. Restricted to admins.
+// @Tags PKCS11
+// @Accept json
+// @Produce json
+// @Param label path string true "Token Label"
+// @Param requestBody body PKCS11OperationRequest true "Operation Payload"
+// @Success 200 {object} PKCS11OperationResponse
+// @Failure 400 {object} ErrorResponse
+// @Failure 401 {object} ErrorResponse
+// @Failure 403 {object} ErrorResponse
+// @Security ApiKeyAuth
+// @Router /pkcs11/tokens/{label}/encrypt [post]
+func (handler *pkcs11Handler) Encrypt(ctx *gin.Context) {
+	handler.perform(ctx, crypto.OperationEncrypt, func(tokenLabel string, request *PKCS11OperationRequest) (*pkcs11.OperationResult, error) {
+		return handler.pkcs11AdminService.Encrypt(ctx, tokenLabel, request.ObjectLabel, request.KeyType, request.Payload)
+	})
+}
+
+// Decrypt handles the POST request to decrypt a payload on a PKCS#11 token
+// @Summary Decrypt a payload on a PKCS#11 token
+// @Description Decrypt a base64 ciphertext returned by encrypt with the private key of the RSA key pair or the AES key on a token. Restricted to admins.
+// @Tags PKCS11
+// @Accept json
+// @Produce json
+// @Param label path string true "Token Label"
+// @Param requestBody body PKCS11OperationRequest true "Operation Payload"
+// @Success 200 {object} PKCS11OperationResponse
+// @Failure 400 {object} ErrorResponse
+// @Failure 401 {object} ErrorResponse
+// @Failure 403 {object} ErrorResponse
+// @Security ApiKeyAuth
+// @Router /pkcs11/tokens/{label}/decrypt [post]
+func (handler *pkcs11Handler) Decrypt(ctx *gin.Context) {
+	handler.perform(ctx, crypto.OperationDecrypt, func(tokenLabel string, request *PKCS11OperationRequest) (*pkcs11.OperationResult, error) {
+		return handler.pkcs11AdminService.Decrypt(ctx, tokenLabel, request.ObjectLabel, request.KeyType, request.Payload)
+	})
+}
+
+// Sign handles the POST request to sign a payload on a PKCS#11 token
+// @Summary Sign a payload on a PKCS#11 token
+// @Description Sign a base64 payload with the private key of an RSA (RSA-PSS over SHA-384) or ECDSA (over SHA-384, ASN.1 DER encoded) key pair on a token. Restricted to admins.
+// @Tags PKCS11
+// @Accept json
+// @Produce json
+// @Param label path string true "Token Label"
+// @Param requestBody body PKCS11OperationRequest true "Operation Payload"
+// @Success 200 {object} PKCS11OperationResponse
+// @Failure 400 {object} ErrorResponse
+// @Failure 401 {object} ErrorResponse
+// @Failure 403 {object} ErrorResponse
+// @Security ApiKeyAuth
+// @Router /pkcs11/tokens/{label}/sign [post]
+func (handler *pkcs11Handler) Sign(ctx *gin.Context) {
+	handler.perform(ctx, crypto.OperationSign, func(tokenLabel string, request *PKCS11OperationRequest) (*pkcs11.OperationResult, error) {
+		return handler.pkcs11AdminService.Sign(ctx, tokenLabel, request.ObjectLabel, request.KeyType, request.Payload)
+	})
+}
+
+// Verify handles the POST request to verify the signature of a payload on a PKCS#11 token
+// @Summary Verify the signature of a payload on a PKCS#11 token
+// @Description Verify the base64 signature returned by sign of a base64 payload with the public key of the key pair on a token. A signature not matching the payload is reported as invalid. Restricted to admins.
+// @Tags PKCS11
+// @Accept json
+// @Produce json
+// @Param label path string true "Token Label"
+// @Param requestBody body PKCS11OperationRequest true "Operation Payload"
+// @Success 200 {object} PKCS11OperationResponse
+// @Failure 400 {object} ErrorResponse
+// @Failure 401 {object} ErrorResponse
+// @Failure 403 {object} ErrorResponse
+// @Security ApiKeyAuth
+// @Router /pkcs11/tokens/{label}/verify [post]
+func (handler *pkcs11Handler) Verify(ctx *gin.Context) {
+	handler.perform(ctx, crypto.OperationVerify, func(tokenLabel string, request *PKCS11OperationRequest) (*pkcs11.OperationResult, error) {
+		return handler.pkcs11AdminService.Verify(ctx, tokenLabel, request.ObjectLabel, request.KeyType, request.Payload, request.Signature)
+	})
+}
+
+// perform binds and validates the request of an operation, runs the operation on the token given by the path and responds with its result
+func (handler *pkcs11Handler) perform(ctx *gin.Context, operation string, run func(tokenLabel string, request *PKCS11OperationRequest) (*pkcs11.OperationResult, error)) {
+	tokenLabel := ctx.Param("label")
+
+	var request PKCS11OperationRequest
+
+	if err := ctx.ShouldBindJSON(&request); err != nil {
+		var errorResponse ErrorResponse
+		errorResponse.Message = fmt.Sprintf("invalid %s data: %v", operation, err.Error())
+		ctx.JSON(http.StatusBadRequest, errorResponse)
+		return
+	}
+
+	if err := request.Validate(); err != nil {
+		var errorResponse ErrorResponse
+		errorResponse.Message = fmt.Sprintf("validation failed: %v", err.Error())
+		ctx.JSON(http.StatusBadRequest, errorResponse)
+		return
+	}
+
+	result, err := run(tokenLabel, &request)
+	if err != nil {
+		var errorResponse ErrorResponse
+		errorResponse.Message = fmt.Sprintf("error performing %s with key %s on token %s: %v", operation, request.ObjectLabel, tokenLabel, err.Error())
+		ctx.JSON(authErrorStatus(err, http.StatusBadRequest), errorResponse)
+		return
+	}
+
+	ctx.JSON(http.StatusOK, PKCS11OperationResponse{
+		Operation:   result.Operation,
+		TokenLabel:  result.TokenLabel,
+		ObjectLabel: result.ObjectLabel,
+		KeyType:     result.KeyType,
+		Ciphertext:  result.Ciphertext,
+		Plaintext:   result.Plaintext,
+		Signature:   result.Signature,
+		Valid:       result.Valid,
+	})
 }
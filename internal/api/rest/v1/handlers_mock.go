@@ -2,10 +2,15 @@ package v1
 
 import (
 	"context"
+	"crypto_vault_service/internal/domain/auth"
 	"crypto_vault_service/internal/domain/blobs"
+	"crypto_vault_service/internal/domain/crypto"
 	"crypto_vault_service/internal/domain/keys"
+	"crypto_vault_service/internal/domain/pkcs11"
 	"fmt"
+	"io"
 	"mime/multipart"
+	"time"
 
 	"github.com/stretchr/testify/mock"
 )
@@ -17,8 +22,8 @@ type MockBlobUploadService struct {
 }
 
 // Upload simulates uploading a blob and returns mocked blob metadata or an error.
-func (m *MockBlobUploadService) Upload(ctx context.Context, form *multipart.Form, userID string, encryptionKeyID, signKeyID *string) ([]*blobs.BlobMeta, error) {
-	args := m.Called(ctx, form, userID, encryptionKeyID, signKeyID)
+func (m *MockBlobUploadService) Upload(ctx context.Context, form *multipart.Form, encryptionKeyID, signKeyID *string) ([]*blobs.BlobMeta, error) {
+	args := m.Called(ctx, form, encryptionKeyID, signKeyID)
 
 	err := args.Error(1)
 	if err != nil {
@@ -71,13 +76,105 @@ type MockBlobDownloadService struct {
 }
 
 // DownloadByID simulates downloading a blob by its ID, possibly using a decryption key.
-func (m *MockBlobDownloadService) DownloadByID(ctx context.Context, blobID string, decryptionKeyID *string) ([]byte, error) {
+func (m *MockBlobDownloadService) DownloadByID(ctx context.Context, blobID string, decryptionKeyID *string) (io.ReadCloser, error) {
 	args := m.Called(ctx, blobID, decryptionKeyID)
 	err := args.Error(1)
 	if err != nil {
 		return nil, fmt.Errorf("mock DownloadByID error: %w", err)
 	}
-	return args.Get(0).([]byte), nil
+	return args.Get(0).(io.ReadCloser), nil
+}
+
+// VerifyByID simulates verifying the signature of a blob by its ID, possibly using a decryption key.
+func (m *MockBlobDownloadService) VerifyByID(ctx context.Context, blobID string, decryptionKeyID *string) (bool, error) {
+	args := m.Called(ctx, blobID, decryptionKeyID)
+	err := args.Error(1)
+	if err != nil {
+		return false, fmt.Errorf("mock VerifyByID error: %w", err)
+	}
+	return args.Bool(0), nil
+}
+
+// MockBlobGrantService is a mock implementation of the BlobGrantService used for testing.
+// It simulates creating, listing and revoking the grants of a blob.
+type MockBlobGrantService struct {
+	mock.Mock
+}
+
+// Create simulates sharing a blob and returns the mocked grant or an error.
+func (m *MockBlobGrantService) Create(ctx context.Context, grant *blobs.BlobGrant) (*blobs.BlobGrant, error) {
+	args := m.Called(ctx, grant)
+	err := args.Error(1)
+	if err != nil {
+		return nil, fmt.Errorf("mock Create error: %w", err)
+	}
+	return args.Get(0).(*blobs.BlobGrant), nil
+}
+
+// List simulates listing the grants of a blob.
+func (m *MockBlobGrantService) List(ctx context.Context, blobID string) ([]*blobs.BlobGrant, error) {
+	args := m.Called(ctx, blobID)
+	err := args.Error(1)
+	if err != nil {
+		return nil, fmt.Errorf("mock List error: %w", err)
+	}
+	return args.Get(0).([]*blobs.BlobGrant), nil
+}
+
+// Delete simulates revoking the grant of a grantee for a blob.
+func (m *MockBlobGrantService) Delete(ctx context.Context, blobID, granteeType, granteeID string) error {
+	args := m.Called(ctx, blobID, granteeType, granteeID)
+	err := args.Error(0)
+	if err != nil {
+		return fmt.Errorf("mock Delete error: %w", err)
+	}
+	return nil
+}
+
+// MockBlobLinkService is a mock implementation of the BlobLinkService used for testing.
+// It simulates issuing, redeeming, consuming and revoking pre-signed blob links.
+type MockBlobLinkService struct {
+	mock.Mock
+}
+
+// Create simulates issuing a link and returns the mocked link and token or an error.
+func (m *MockBlobLinkService) Create(ctx context.Context, blobID string, decryptionKeyID *string, singleUse bool, validity time.Duration) (*blobs.BlobLink, string, error) {
+	args := m.Called(ctx, blobID, decryptionKeyID, singleUse, validity)
+	err := args.Error(2)
+	if err != nil {
+		return nil, "", fmt.Errorf("mock Create error: %w", err)
+	}
+	return args.Get(0).(*blobs.BlobLink), args.String(1), nil
+}
+
+// Redeem simulates verifying the token of a link and returns the mocked link or an error.
+func (m *MockBlobLinkService) Redeem(ctx context.Context, blobID, token string) (*blobs.BlobLink, error) {
+	args := m.Called(ctx, blobID, token)
+	err := args.Error(1)
+	if err != nil {
+		return nil, fmt.Errorf("mock Redeem error: %w", err)
+	}
+	return args.Get(0).(*blobs.BlobLink), nil
+}
+
+// Release simulates lifting the claim of a redeemed single-use link.
+func (m *MockBlobLinkService) Release(ctx context.Context, link *blobs.BlobLink) error {
+	args := m.Called(ctx, link)
+	err := args.Error(0)
+	if err != nil {
+		return fmt.Errorf("mock Release error: %w", err)
+	}
+	return nil
+}
+
+// Revoke simulates revoking a link for a blob.
+func (m *MockBlobLinkService) Revoke(ctx context.Context, blobID, linkID string) error {
+	args := m.Called(ctx, blobID, linkID)
+	err := args.Error(0)
+	if err != nil {
+		return fmt.Errorf("mock Revoke error: %w", err)
+	}
+	return nil
 }
 
 // MockCryptoKeyUploadService is a mock implementation of the CryptoKeyUploadService used for testing.
@@ -87,8 +184,8 @@ type MockCryptoKeyUploadService struct {
 }
 
 // Upload simulates uploading a cryptographic key and returns mocked key metadata or an error.
-func (m *MockCryptoKeyUploadService) Upload(ctx context.Context, userID, keyAlgorithm string, keySize uint32) ([]*keys.CryptoKeyMeta, error) {
-	args := m.Called(ctx, userID, keyAlgorithm, keySize)
+func (m *MockCryptoKeyUploadService) Upload(ctx context.Context, keyAlgorithm string, keySize uint32, lifecycle *keys.CryptoKeyLifecycle) ([]*keys.CryptoKeyMeta, error) {
+	args := m.Called(ctx, keyAlgorithm, keySize, lifecycle)
 	err := args.Error(1)
 	if err != nil {
 		return nil, fmt.Errorf("mock Upload error: %w", err)
@@ -97,7 +194,7 @@ func (m *MockCryptoKeyUploadService) Upload(ctx context.Context, userID, keyAlgo
 }
 
 // MockCryptoKeyMetadataService is a mock implementation of the CryptoKeyMetadataService used for testing.
-// It simulates operations related to listing, fetching by ID, and deleting cryptographic key metadata.
+// It simulates operations related to listing, fetching by ID, deleting and updating the lifecycle of cryptographic key metadata.
 type MockCryptoKeyMetadataService struct {
 	mock.Mock
 }
@@ -122,18 +219,48 @@ func (m *MockCryptoKeyMetadataService) GetByID(ctx context.Context, keyID string
 	return args.Get(0).(*keys.CryptoKeyMeta), nil
 }
 
-// DeleteByID simulates deleting a cryptographic key's metadata by its ID.
-func (m *MockCryptoKeyMetadataService) DeleteByID(ctx context.Context, keyID string) error {
+// DeleteByID simulates scheduling the destruction of a cryptographic key pair.
+func (m *MockCryptoKeyMetadataService) DeleteByID(ctx context.Context, keyID string, force bool) ([]*keys.CryptoKeyMeta, error) {
+	args := m.Called(ctx, keyID, force)
+	err := args.Error(1)
+	if err != nil {
+		return nil, fmt.Errorf("mock DeleteByID error: %w", err)
+	}
+	return args.Get(0).([]*keys.CryptoKeyMeta), nil
+}
+
+// CancelDestruction simulates cancelling the scheduled destruction of a cryptographic key pair.
+func (m *MockCryptoKeyMetadataService) CancelDestruction(ctx context.Context, keyID string) ([]*keys.CryptoKeyMeta, error) {
 	args := m.Called(ctx, keyID)
-	err := args.Error(0)
+	err := args.Error(1)
 	if err != nil {
-		return fmt.Errorf("mock DeleteByID error: %w", err)
+		return nil, fmt.Errorf("mock CancelDestruction error: %w", err)
 	}
-	return nil
+	return args.Get(0).([]*keys.CryptoKeyMeta), nil
+}
+
+// UpdateLifecycle simulates transitioning the lifecycle of a cryptographic key pair.
+func (m *MockCryptoKeyMetadataService) UpdateLifecycle(ctx context.Context, keyID string, lifecycle *keys.CryptoKeyLifecycle) ([]*keys.CryptoKeyMeta, error) {
+	args := m.Called(ctx, keyID, lifecycle)
+	err := args.Error(1)
+	if err != nil {
+		return nil, fmt.Errorf("mock UpdateLifecycle error: %w", err)
+	}
+	return args.Get(0).([]*keys.CryptoKeyMeta), nil
+}
+
+// UpdateExportPolicy simulates setting the export policy of a cryptographic key pair.
+func (m *MockCryptoKeyMetadataService) UpdateExportPolicy(ctx context.Context, keyID string, plaintextExportForbidden bool) ([]*keys.CryptoKeyMeta, error) {
+	args := m.Called(ctx, keyID, plaintextExportForbidden)
+	err := args.Error(1)
+	if err != nil {
+		return nil, fmt.Errorf("mock UpdateExportPolicy error: %w", err)
+	}
+	return args.Get(0).([]*keys.CryptoKeyMeta), nil
 }
 
 // MockCryptoKeyDownloadService is a mock implementation of the CryptoKeyDownloadService used for testing.
-// It simulates downloading a cryptographic key by ID.
+// It simulates downloading a cryptographic key by ID, in plaintext or wrapped.
 type MockCryptoKeyDownloadService struct {
 	mock.Mock
 }
@@ -147,3 +274,304 @@ func (m *MockCryptoKeyDownloadService) DownloadByID(ctx context.Context, keyID s
 	}
 	return args.Get(0).([]byte), nil
 }
+
+// ExportWrappedByID simulates exporting a cryptographic key by its ID wrapped with another key.
+func (m *MockCryptoKeyDownloadService) ExportWrappedByID(ctx context.Context, keyID string, wrapping *keys.KeyExportWrapping) (*keys.WrappedKeyExport, error) {
+	args := m.Called(ctx, keyID, wrapping)
+	err := args.Error(1)
+	if err != nil {
+		return nil, fmt.Errorf("mock ExportWrappedByID error: %w", err)
+	}
+	return args.Get(0).(*keys.WrappedKeyExport), nil
+}
+
+// MockCryptoKeyRotationService is a mock implementation of the CryptoKeyRotationService used for testing.
+// It simulates rotating logical keys, listing their versions and updating their rotation policy.
+type MockCryptoKeyRotationService struct {
+	mock.Mock
+}
+
+// Rotate simulates rotating the logical key a cryptographic key belongs to.
+func (m *MockCryptoKeyRotationService) Rotate(ctx context.Context, keyID string) ([]*keys.CryptoKeyMeta, error) {
+	args := m.Called(ctx, keyID)
+	err := args.Error(1)
+	if err != nil {
+		return nil, fmt.Errorf("mock Rotate error: %w", err)
+	}
+	return args.Get(0).([]*keys.CryptoKeyMeta), nil
+}
+
+// ListVersions simulates listing the versions of the logical key a cryptographic key belongs to.
+func (m *MockCryptoKeyRotationService) ListVersions(ctx context.Context, keyID string) ([]*keys.CryptoKeyMeta, error) {
+	args := m.Called(ctx, keyID)
+	err := args.Error(1)
+	if err != nil {
+		return nil, fmt.Errorf("mock ListVersions error: %w", err)
+	}
+	return args.Get(0).([]*keys.CryptoKeyMeta), nil
+}
+
+// UpdateRotationPolicy simulates setting the rotation period of the logical key a cryptographic key belongs to.
+func (m *MockCryptoKeyRotationService) UpdateRotationPolicy(ctx context.Context, keyID string, rotationPeriod time.Duration) (*keys.LogicalKey, error) {
+	args := m.Called(ctx, keyID, rotationPeriod)
+	err := args.Error(1)
+	if err != nil {
+		return nil, fmt.Errorf("mock UpdateRotationPolicy error: %w", err)
+	}
+	return args.Get(0).(*keys.LogicalKey), nil
+}
+
+// RotateDue simulates rotating all logical keys whose rotation is due.
+func (m *MockCryptoKeyRotationService) RotateDue(ctx context.Context) (int, error) {
+	args := m.Called(ctx)
+	err := args.Error(1)
+	if err != nil {
+		return 0, fmt.Errorf("mock RotateDue error: %w", err)
+	}
+	return args.Int(0), nil
+}
+
+// Run simulates the background loop rotating due logical keys.
+func (m *MockCryptoKeyRotationService) Run(ctx context.Context) {
+	m.Called(ctx)
+}
+
+// MockCryptoKeyImportService is a mock implementation of the CryptoKeyImportService used for testing.
+// It simulates importing key material and providing the key import public key.
+type MockCryptoKeyImportService struct {
+	mock.Mock
+}
+
+// GetImportPublicKey simulates fetching the public key key material is wrapped with before import.
+func (m *MockCryptoKeyImportService) GetImportPublicKey(ctx context.Context) ([]byte, error) {
+	args := m.Called(ctx)
+	err := args.Error(1)
+	if err != nil {
+		return nil, fmt.Errorf("mock GetImportPublicKey error: %w", err)
+	}
+	return args.Get(0).([]byte), nil
+}
+
+// Import simulates importing key material as a new logical key.
+func (m *MockCryptoKeyImportService) Import(ctx context.Context, keyImport *keys.CryptoKeyImport, lifecycle *keys.CryptoKeyLifecycle) ([]*keys.CryptoKeyMeta, error) {
+	args := m.Called(ctx, keyImport, lifecycle)
+	err := args.Error(1)
+	if err != nil {
+		return nil, fmt.Errorf("mock Import error: %w", err)
+	}
+	return args.Get(0).([]*keys.CryptoKeyMeta), nil
+}
+
+// MockMasterKeyRotationService is a mock implementation of the MasterKeyRotationService used for testing.
+// It simulates starting, fetching and resuming master key rotations.
+type MockMasterKeyRotationService struct {
+	mock.Mock
+}
+
+// Rotate simulates starting a master key rotation.
+func (m *MockMasterKeyRotationService) Rotate(ctx context.Context) (*keys.MasterKeyRotation, error) {
+	args := m.Called(ctx)
+	err := args.Error(1)
+	if err != nil {
+		return nil, fmt.Errorf("mock Rotate error: %w", err)
+	}
+	return args.Get(0).(*keys.MasterKeyRotation), nil
+}
+
+// GetByID simulates fetching a master key rotation by its ID.
+func (m *MockMasterKeyRotationService) GetByID(ctx context.Context, rotationID string) (*keys.MasterKeyRotation, error) {
+	args := m.Called(ctx, rotationID)
+	err := args.Error(1)
+	if err != nil {
+		return nil, fmt.Errorf("mock GetByID error: %w", err)
+	}
+	return args.Get(0).(*keys.MasterKeyRotation), nil
+}
+
+// Resume simulates resuming an unfinished master key rotation.
+func (m *MockMasterKeyRotationService) Resume(ctx context.Context) error {
+	args := m.Called(ctx)
+	err := args.Error(0)
+	if err != nil {
+		return fmt.Errorf("mock Resume error: %w", err)
+	}
+	return nil
+}
+
+// MockCryptoOperationService is a mock implementation of the CryptoOperationService used for testing.
+// It simulates performing cryptographic operations with stored keys.
+type MockCryptoOperationService struct {
+	mock.Mock
+}
+
+// Encrypt simulates encrypting a payload with a key.
+func (m *MockCryptoOperationService) Encrypt(ctx context.Context, keyID string, plaintext, associatedData []byte) (*crypto.OperationResult, error) {
+	args := m.Called(ctx, keyID, plaintext, associatedData)
+	err := args.Error(1)
+	if err != nil {
+		return nil, fmt.Errorf("mock Encrypt error: %w", err)
+	}
+	return args.Get(0).(*crypto.OperationResult), nil
+}
+
+// Decrypt simulates decrypting a payload with a key.
+func (m *MockCryptoOperationService) Decrypt(ctx context.Context, keyID string, ciphertext, associatedData []byte) (*crypto.OperationResult, error) {
+	args := m.Called(ctx, keyID, ciphertext, associatedData)
+	err := args.Error(1)
+	if err != nil {
+		return nil, fmt.Errorf("mock Decrypt error: %w", err)
+	}
+	return args.Get(0).(*crypto.OperationResult), nil
+}
+
+// Sign simulates signing a payload with a key.
+func (m *MockCryptoOperationService) Sign(ctx context.Context, keyID string, data []byte) (*crypto.OperationResult, error) {
+	args := m.Called(ctx, keyID, data)
+	err := args.Error(1)
+	if err != nil {
+		return nil, fmt.Errorf("mock Sign error: %w", err)
+	}
+	return args.Get(0).(*crypto.OperationResult), nil
+}
+
+// Verify simulates verifying the signature of a payload with a key.
+func (m *MockCryptoOperationService) Verify(ctx context.Context, keyID string, data, signature []byte) (*crypto.OperationResult, error) {
+	args := m.Called(ctx, keyID, data, signature)
+	err := args.Error(1)
+	if err != nil {
+		return nil, fmt.Errorf("mock Verify error: %w", err)
+	}
+	return args.Get(0).(*crypto.OperationResult), nil
+}
+
+// Wrap simulates wrapping key material with a key.
+func (m *MockCryptoOperationService) Wrap(ctx context.Context, keyID string, key []byte) (*crypto.OperationResult, error) {
+	args := m.Called(ctx, keyID, key)
+	err := args.Error(1)
+	if err != nil {
+		return nil, fmt.Errorf("mock Wrap error: %w", err)
+	}
+	return args.Get(0).(*crypto.OperationResult), nil
+}
+
+// Unwrap simulates unwrapping key material with a key.
+func (m *MockCryptoOperationService) Unwrap(ctx context.Context, keyID string, wrappedKey []byte) (*crypto.OperationResult, error) {
+	args := m.Called(ctx, keyID, wrappedKey)
+	err := args.Error(1)
+	if err != nil {
+		return nil, fmt.Errorf("mock Unwrap error: %w", err)
+	}
+	return args.Get(0).(*crypto.OperationResult), nil
+}
+
+// MockPKCS11AdminService is a mock implementation of the PKCS11AdminService used for testing.
+// It simulates administering PKCS#11 tokens.
+type MockPKCS11AdminService struct {
+	mock.Mock
+}
+
+// ListTokenSlots simulates listing the tokens of the PKCS#11 module.
+func (m *MockPKCS11AdminService) ListTokenSlots(ctx context.Context) ([]*pkcs11.Token, error) {
+	args := m.Called(ctx)
+	err := args.Error(1)
+	if err != nil {
+		return nil, fmt.Errorf("mock ListTokenSlots error: %w", err)
+	}
+	return args.Get(0).([]*pkcs11.Token), nil
+}
+
+// ListObjects simulates listing the objects of a token.
+func (m *MockPKCS11AdminService) ListObjects(ctx context.Context, tokenLabel string) ([]*pkcs11.TokenObject, error) {
+	args := m.Called(ctx, tokenLabel)
+	err := args.Error(1)
+	if err != nil {
+		return nil, fmt.Errorf("mock ListObjects error: %w", err)
+	}
+	return args.Get(0).([]*pkcs11.TokenObject), nil
+}
+
+// InitializeToken simulates initializing a token.
+func (m *MockPKCS11AdminService) InitializeToken(ctx context.Context, tokenLabel string) error {
+	args := m.Called(ctx, tokenLabel)
+	err := args.Error(0)
+	if err != nil {
+		return fmt.Errorf("mock InitializeToken error: %w", err)
+	}
+	return nil
+}
+
+// AddKey simulates generating a key pair on a token.
+func (m *MockPKCS11AdminService) AddKey(ctx context.Context, tokenLabel, objectLabel, keyType string, keySize uint32) error {
+	args := m.Called(ctx, tokenLabel, objectLabel, keyType, keySize)
+	err := args.Error(0)
+	if err != nil {
+		return fmt.Errorf("mock AddKey error: %w", err)
+	}
+	return nil
+}
+
+// DeleteObject simulates deleting objects from a token.
+func (m *MockPKCS11AdminService) DeleteObject(ctx context.Context, tokenLabel, objectType, objectLabel string) error {
+	args := m.Called(ctx, tokenLabel, objectType, objectLabel)
+	err := args.Error(0)
+	if err != nil {
+		return fmt.Errorf("mock DeleteObject error: %w", err)
+	}
+	return nil
+}
+
+// Encrypt simulates encrypting a payload on a token.
+func (m *MockPKCS11AdminService) Encrypt(ctx context.Context, tokenLabel, objectLabel, keyType string, plaintext []byte) (*pkcs11.OperationResult, error) {
+	args := m.Called(ctx, tokenLabel, objectLabel, keyType, plaintext)
+	err := args.Error(1)
+	if err != nil {
+		return nil, fmt.Errorf("mock Encrypt error: %w", err)
+	}
+	return args.Get(0).(*pkcs11.OperationResult), nil
+}
+
+// Decrypt simulates decrypting a payload on a token.
+func (m *MockPKCS11AdminService) Decrypt(ctx context.Context, tokenLabel, objectLabel, keyType string, ciphertext []byte) (*pkcs11.OperationResult, error) {
+	args := m.Called(ctx, tokenLabel, objectLabel, keyType, ciphertext)
+	err := args.Error(1)
+	if err != nil {
+		return nil, fmt.Errorf("mock Decrypt error: %w", err)
+	}
+	return args.Get(0).(*pkcs11.OperationResult), nil
+}
+
+// Sign simulates signing a payload on a token.
+func (m *MockPKCS11AdminService) Sign(ctx context.Context, tokenLabel, objectLabel, keyType string, data []byte) (*pkcs11.OperationResult, error) {
+	args := m.Called(ctx, tokenLabel, objectLabel, keyType, data)
+	err := args.Error(1)
+	if err != nil {
+		return nil, fmt.Errorf("mock Sign error: %w", err)
+	}
+	return args.Get(0).(*pkcs11.OperationResult), nil
+}
+
+// Verify simulates verifying the signature of a payload on a token.
+func (m *MockPKCS11AdminService) Verify(ctx context.Context, tokenLabel, objectLabel, keyType string, data, signature []byte) (*pkcs11.OperationResult, error) {
+	args := m.Called(ctx, tokenLabel, objectLabel, keyType, data, signature)
+	err := args.Error(1)
+	if err != nil {
+		return nil, fmt.Errorf("mock Verify error: %w", err)
+	}
+	return args.Get(0).(*pkcs11.OperationResult), nil
+}
+
+// MockTokenValidator is a mock implementation of the TokenValidator used for testing.
+// It simulates validating the bearer tokens of requests.
+type MockTokenValidator struct {
+	mock.Mock
+}
+
+// Validate simulates validating a token and returns the mocked identity or an error.
+func (m *MockTokenValidator) Validate(ctx context.Context, token string) (*auth.Identity, error) {
+	args := m.Called(ctx, token)
+	err := args.Error(1)
+	if err != nil {
+		return nil, fmt.Errorf("mock Validate error: %w", err)
+	}
+	return args.Get(0).(*auth.Identity), nil
+}
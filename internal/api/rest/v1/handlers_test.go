@@ -5,11 +5,18 @@ package v1
 
 import (
 	"bytes"
+	"crypto_vault_service/internal/domain/auth"
 	"crypto_vault_service/internal/domain/blobs"
+	"crypto_vault_service/internal/domain/crypto"
 	"crypto_vault_service/internal/domain/keys"
+	"crypto_vault_service/internal/domain/pkcs11"
+	"crypto_vault_service/internal/infrastructure/cryptography"
 	"crypto_vault_service/test/testutils"
+	"encoding/base64"
+	"encoding/json"
 	"errors"
 	"fmt"
+	"io"
 	"mime/multipart"
 	"net/http"
 	"net/http/httptest"
@@ -17,6 +24,7 @@ import (
 	"time"
 
 	"github.com/gin-gonic/gin"
+	"github.com/google/uuid"
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/mock"
 	"github.com/stretchr/testify/require"
@@ -29,7 +37,7 @@ func TestBlobHandler_Upload(t *testing.T) {
 	mockMetadataService := new(MockBlobMetadataService)
 	mockCryptoKeyUploadService := new(MockCryptoKeyUploadService)
 
-	handler := NewBlobHandler(mockUploadService, mockDownloadService, mockMetadataService, mockCryptoKeyUploadService)
+	handler := NewBlobHandler(mockUploadService, mockDownloadService, mockMetadataService, new(MockBlobGrantService), new(MockBlobLinkService), mockCryptoKeyUploadService)
 
 	// Create mock blob metadata
 	blobMeta := blobs.BlobMeta{
@@ -37,7 +45,7 @@ func TestBlobHandler_Upload(t *testing.T) {
 	}
 
 	// Mock the Upload service call
-	mockUploadService.On("Upload", mock.Anything, mock.Anything, mock.Anything, mock.Anything, mock.Anything).
+	mockUploadService.On("Upload", mock.Anything, mock.Anything, mock.Anything, mock.Anything).
 		Return([]*blobs.BlobMeta{&blobMeta}, nil)
 
 	// Create test file and form data
@@ -88,7 +96,7 @@ func TestBlobHandler_ListMetadata(t *testing.T) {
 	mockMetadataService := new(MockBlobMetadataService)
 	mockCryptoKeyUploadService := new(MockCryptoKeyUploadService)
 
-	handler := NewBlobHandler(mockUploadService, mockDownloadService, mockMetadataService, mockCryptoKeyUploadService)
+	handler := NewBlobHandler(mockUploadService, mockDownloadService, mockMetadataService, new(MockBlobGrantService), new(MockBlobLinkService), mockCryptoKeyUploadService)
 
 	// Create mock blob metadata
 	blobMeta := blobs.BlobMeta{
@@ -122,7 +130,7 @@ func TestBlobHandler_GetMetadataByID(t *testing.T) {
 	mockMetadataService := new(MockBlobMetadataService)
 	mockCryptoKeyUploadService := new(MockCryptoKeyUploadService)
 
-	handler := NewBlobHandler(mockUploadService, mockDownloadService, mockMetadataService, mockCryptoKeyUploadService)
+	handler := NewBlobHandler(mockUploadService, mockDownloadService, mockMetadataService, new(MockBlobGrantService), new(MockBlobLinkService), mockCryptoKeyUploadService)
 
 	// Create mock blob metadata
 	blobMeta := blobs.BlobMeta{
@@ -157,7 +165,7 @@ func TestBlobHandler_DownloadByID(t *testing.T) {
 	mockMetadataService := new(MockBlobMetadataService)
 	mockCryptoKeyUploadService := new(MockCryptoKeyUploadService)
 
-	handler := NewBlobHandler(mockUploadService, mockDownloadService, mockMetadataService, mockCryptoKeyUploadService)
+	handler := NewBlobHandler(mockUploadService, mockDownloadService, mockMetadataService, new(MockBlobGrantService), new(MockBlobLinkService), mockCryptoKeyUploadService)
 
 	// Prepare mock data
 	blobID := "123"
@@ -168,7 +176,7 @@ func TestBlobHandler_DownloadByID(t *testing.T) {
 	}
 
 	// Mock the Download and GetByID service calls
-	mockDownloadService.On("DownloadByID", mock.Anything, blobID, (*string)(nil)).Return(blobContent, nil)
+	mockDownloadService.On("DownloadByID", mock.Anything, blobID, (*string)(nil)).Return(io.NopCloser(bytes.NewReader(blobContent)), nil)
 	mockMetadataService.On("GetByID", mock.Anything, blobID).Return(blobMeta, nil)
 
 	// Create a test HTTP request
@@ -193,6 +201,88 @@ func TestBlobHandler_DownloadByID(t *testing.T) {
 	mockMetadataService.AssertExpectations(t)
 }
 
+func TestBlobHandler_VerifyByID(t *testing.T) {
+	// Set up mock services
+	mockUploadService := new(MockBlobUploadService)
+	mockDownloadService := new(MockBlobDownloadService)
+	mockMetadataService := new(MockBlobMetadataService)
+	mockCryptoKeyUploadService := new(MockCryptoKeyUploadService)
+
+	handler := NewBlobHandler(mockUploadService, mockDownloadService, mockMetadataService, new(MockBlobGrantService), new(MockBlobLinkService), mockCryptoKeyUploadService)
+
+	// Prepare mock data
+	blobID := "123"
+	decryptionKeyID := "456"
+	blobMeta := &blobs.BlobMeta{
+		ID:   blobID,
+		Name: "testfile.txt",
+	}
+
+	// Mock the GetByID and VerifyByID service calls
+	mockMetadataService.On("GetByID", mock.Anything, blobID).Return(blobMeta, nil)
+	mockDownloadService.On("VerifyByID", mock.Anything, blobID, &decryptionKeyID).Return(true, nil)
+
+	// Create a test HTTP request
+	w := httptest.NewRecorder()
+	req, _ := http.NewRequest("POST", "/blobs/123/verify?decryption_key_id=456", nil)
+
+	// Set up Gin context
+	c, _ := gin.CreateTestContext(w)
+	c.Request = req
+	c.Params = gin.Params{gin.Param{Key: "id", Value: blobID}}
+
+	// Call the handler
+	handler.VerifyByID(c)
+
+	// Assert the response
+	assert.Equal(t, http.StatusOK, w.Code)
+	var response BlobVerificationResponse
+	err := json.Unmarshal(w.Body.Bytes(), &response)
+	assert.NoError(t, err)
+	assert.Equal(t, blobID, response.ID)
+	assert.True(t, response.Valid)
+
+	mockDownloadService.AssertExpectations(t)
+	mockMetadataService.AssertExpectations(t)
+}
+
+// Test error case in VerifyByID
+func TestBlobHandler_VerifyByID_Error(t *testing.T) {
+	// Set up mock services
+	mockUploadService := new(MockBlobUploadService)
+	mockDownloadService := new(MockBlobDownloadService)
+	mockMetadataService := new(MockBlobMetadataService)
+	mockCryptoKeyUploadService := new(MockCryptoKeyUploadService)
+
+	handler := NewBlobHandler(mockUploadService, mockDownloadService, mockMetadataService, new(MockBlobGrantService), new(MockBlobLinkService), mockCryptoKeyUploadService)
+
+	blobID := "123"
+	blobMeta := &blobs.BlobMeta{
+		ID:   blobID,
+		Name: "testfile.txt",
+	}
+
+	// Mock an error in VerifyByID service call, e.g. for blobs without signature
+	mockMetadataService.On("GetByID", mock.Anything, blobID).Return(blobMeta, nil)
+	mockDownloadService.On("VerifyByID", mock.Anything, blobID, (*string)(nil)).Return(false, fmt.Errorf("blob %s has no signature", blobID))
+
+	// Create a test HTTP request
+	w := httptest.NewRecorder()
+	req, _ := http.NewRequest("POST", "/blobs/123/verify", nil)
+
+	// Set up Gin context
+	c, _ := gin.CreateTestContext(w)
+	c.Request = req
+	c.Params = gin.Params{gin.Param{Key: "id", Value: blobID}}
+
+	handler.VerifyByID(c)
+
+	// Assert the response
+	assert.Equal(t, http.StatusBadRequest, w.Code)
+	mockDownloadService.AssertExpectations(t)
+	mockMetadataService.AssertExpectations(t)
+}
+
 func TestBlobHandler_DeleteByID(t *testing.T) {
 	// Set up mock services
 	mockUploadService := new(MockBlobUploadService)
@@ -200,7 +290,7 @@ func TestBlobHandler_DeleteByID(t *testing.T) {
 	mockMetadataService := new(MockBlobMetadataService)
 	mockCryptoKeyUploadService := new(MockCryptoKeyUploadService)
 
-	handler := NewBlobHandler(mockUploadService, mockDownloadService, mockMetadataService, mockCryptoKeyUploadService)
+	handler := NewBlobHandler(mockUploadService, mockDownloadService, mockMetadataService, new(MockBlobGrantService), new(MockBlobLinkService), mockCryptoKeyUploadService)
 
 	// Mock the DeleteByID service call
 	mockMetadataService.On("DeleteByID", mock.Anything, "123").Return(nil, nil)
@@ -229,7 +319,7 @@ func TestBlobHandler_GetMetadataByID_Error(t *testing.T) {
 	mockMetadataService := new(MockBlobMetadataService)
 	mockCryptoKeyUploadService := new(MockCryptoKeyUploadService)
 
-	handler := NewBlobHandler(mockUploadService, mockDownloadService, mockMetadataService, mockCryptoKeyUploadService)
+	handler := NewBlobHandler(mockUploadService, mockDownloadService, mockMetadataService, new(MockBlobGrantService), new(MockBlobLinkService), mockCryptoKeyUploadService)
 
 	// Mock an error in GetByID service call
 	mockMetadataService.On("GetByID", mock.Anything, "123").Return(&blobs.BlobMeta{}, errors.New("not found"))
@@ -252,6 +342,31 @@ func TestBlobHandler_GetMetadataByID_Error(t *testing.T) {
 	mockMetadataService.AssertExpectations(t)
 }
 
+// Test GetMetadataByID denying access to blobs of other users
+func TestBlobHandler_GetMetadataByID_Forbidden(t *testing.T) {
+	mockUploadService := new(MockBlobUploadService)
+	mockDownloadService := new(MockBlobDownloadService)
+	mockMetadataService := new(MockBlobMetadataService)
+	mockCryptoKeyUploadService := new(MockCryptoKeyUploadService)
+
+	handler := NewBlobHandler(mockUploadService, mockDownloadService, mockMetadataService, new(MockBlobGrantService), new(MockBlobLinkService), mockCryptoKeyUploadService)
+
+	mockMetadataService.On("GetByID", mock.Anything, "123").Return(&blobs.BlobMeta{}, fmt.Errorf("blob 123: %w", auth.ErrForbidden))
+
+	w := httptest.NewRecorder()
+	req, _ := http.NewRequest("GET", "/blobs/123", nil)
+
+	c, _ := gin.CreateTestContext(w)
+	c.Request = req
+	c.Params = gin.Params{gin.Param{Key: "id", Value: "123"}}
+
+	handler.GetMetadataByID(c)
+
+	assert.Equal(t, http.StatusForbidden, w.Code)
+	assert.Contains(t, w.Body.String(), "forbidden")
+	mockMetadataService.AssertExpectations(t)
+}
+
 func TestBlobHandler_Upload_InvalidData_Error(t *testing.T) {
 	// Set up mock services
 	mockBlobUploadService := new(MockBlobUploadService)
@@ -259,10 +374,10 @@ func TestBlobHandler_Upload_InvalidData_Error(t *testing.T) {
 	mockBlobDownloadService := new(MockBlobDownloadService)
 	mockCryptoKeyUploadService := new(MockCryptoKeyUploadService)
 
-	handler := NewBlobHandler(mockBlobUploadService, mockBlobDownloadService, mockBlobMetadataService, mockCryptoKeyUploadService)
+	handler := NewBlobHandler(mockBlobUploadService, mockBlobDownloadService, mockBlobMetadataService, new(MockBlobGrantService), new(MockBlobLinkService), mockCryptoKeyUploadService)
 
 	// Mock an error in the Upload service call
-	mockBlobUploadService.On("Upload", mock.Anything, mock.Anything, mock.Anything, mock.Anything, mock.Anything).Return(nil, fmt.Errorf("invalid form data"))
+	mockBlobUploadService.On("Upload", mock.Anything, mock.Anything, mock.Anything, mock.Anything).Return(nil, fmt.Errorf("invalid form data"))
 
 	// Create a test HTTP request
 	w := httptest.NewRecorder()
@@ -281,12 +396,289 @@ func TestBlobHandler_Upload_InvalidData_Error(t *testing.T) {
 	// mockBlobUploadService.AssertExpectations(t)
 }
 
+func TestBlobHandler_CreateGrant(t *testing.T) {
+	mockGrantService := new(MockBlobGrantService)
+	handler := NewBlobHandler(new(MockBlobUploadService), new(MockBlobDownloadService), new(MockBlobMetadataService), mockGrantService, new(MockBlobLinkService), new(MockCryptoKeyUploadService))
+
+	grant := &blobs.BlobGrant{
+		BlobID:          "123",
+		GranteeType:     blobs.GranteeTypeGroup,
+		GranteeID:       "auditors",
+		Access:          blobs.AccessView,
+		DateTimeCreated: time.Now(),
+	}
+
+	mockGrantService.On("Create", mock.Anything, mock.MatchedBy(func(g *blobs.BlobGrant) bool {
+		return g.BlobID == "123" && g.GranteeType == blobs.GranteeTypeGroup && g.GranteeID == "auditors" && g.Access == blobs.AccessView
+	})).Return(grant, nil)
+
+	requestBody := `{"grantee_type": "group", "grantee_id": "auditors", "access": "view"}`
+
+	w := httptest.NewRecorder()
+	req, _ := http.NewRequest("POST", "/blobs/123/grants", bytes.NewBufferString(requestBody))
+	req.Header.Set("Content-Type", "application/json")
+
+	c, _ := gin.CreateTestContext(w)
+	c.Request = req
+	c.Params = gin.Params{gin.Param{Key: "id", Value: "123"}}
+
+	handler.CreateGrant(c)
+
+	assert.Equal(t, http.StatusCreated, w.Code)
+
+	var response BlobGrantResponse
+	require.NoError(t, json.Unmarshal(w.Body.Bytes(), &response))
+	assert.Equal(t, "auditors", response.GranteeID)
+	assert.Equal(t, blobs.AccessView, response.Access)
+	mockGrantService.AssertExpectations(t)
+}
+
+// Test CreateGrant rejecting invalid grant data and callers not permitted to share the blob
+func TestBlobHandler_CreateGrant_Error(t *testing.T) {
+	mockGrantService := new(MockBlobGrantService)
+	handler := NewBlobHandler(new(MockBlobUploadService), new(MockBlobDownloadService), new(MockBlobMetadataService), mockGrantService, new(MockBlobLinkService), new(MockCryptoKeyUploadService))
+
+	mockGrantService.On("Create", mock.Anything, mock.Anything).Return(nil, fmt.Errorf("can_share_blob on blob:123: %w", auth.ErrForbidden))
+
+	tests := []struct {
+		name           string
+		requestBody    string
+		expectedStatus int
+	}{
+		{"Invalid JSON", `{"grantee_type":`, http.StatusBadRequest},
+		{"Invalid Access", `{"grantee_type": "user", "grantee_id": "user-2", "access": "delete"}`, http.StatusBadRequest},
+		{"Invalid Grantee ID", `{"grantee_type": "group", "grantee_id": "admins#admin", "access": "view"}`, http.StatusBadRequest},
+		{"Forbidden", `{"grantee_type": "user", "grantee_id": "user-2", "access": "download"}`, http.StatusForbidden},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			w := httptest.NewRecorder()
+			req, _ := http.NewRequest("POST", "/blobs/123/grants", bytes.NewBufferString(tt.requestBody))
+			req.Header.Set("Content-Type", "application/json")
+
+			c, _ := gin.CreateTestContext(w)
+			c.Request = req
+			c.Params = gin.Params{gin.Param{Key: "id", Value: "123"}}
+
+			handler.CreateGrant(c)
+
+			assert.Equal(t, tt.expectedStatus, w.Code)
+		})
+	}
+}
+
+func TestBlobHandler_ListGrants(t *testing.T) {
+	mockGrantService := new(MockBlobGrantService)
+	handler := NewBlobHandler(new(MockBlobUploadService), new(MockBlobDownloadService), new(MockBlobMetadataService), mockGrantService, new(MockBlobLinkService), new(MockCryptoKeyUploadService))
+
+	expires := time.Now().Add(time.Hour)
+	grants := []*blobs.BlobGrant{
+		{BlobID: "123", GranteeType: blobs.GranteeTypeUser, GranteeID: "user-2", Access: blobs.AccessDownload, DateTimeExpires: &expires, DateTimeCreated: time.Now()},
+	}
+	mockGrantService.On("List", mock.Anything, "123").Return(grants, nil)
+
+	w := httptest.NewRecorder()
+	req, _ := http.NewRequest("GET", "/blobs/123/grants", nil)
+
+	c, _ := gin.CreateTestContext(w)
+	c.Request = req
+	c.Params = gin.Params{gin.Param{Key: "id", Value: "123"}}
+
+	handler.ListGrants(c)
+
+	assert.Equal(t, http.StatusOK, w.Code)
+
+	var response []BlobGrantResponse
+	require.NoError(t, json.Unmarshal(w.Body.Bytes(), &response))
+	require.Len(t, response, 1)
+	assert.Equal(t, "user-2", response[0].GranteeID)
+	assert.NotNil(t, response[0].DateTimeExpires)
+	mockGrantService.AssertExpectations(t)
+}
+
+func TestBlobHandler_DeleteGrant(t *testing.T) {
+	mockGrantService := new(MockBlobGrantService)
+	handler := NewBlobHandler(new(MockBlobUploadService), new(MockBlobDownloadService), new(MockBlobMetadataService), mockGrantService, new(MockBlobLinkService), new(MockCryptoKeyUploadService))
+
+	mockGrantService.On("Delete", mock.Anything, "123", blobs.GranteeTypeUser, "user-2").Return(nil)
+	mockGrantService.On("Delete", mock.Anything, "123", blobs.GranteeTypeGroup, "auditors").Return(errors.New("no grant"))
+
+	tests := []struct {
+		name           string
+		granteeType    string
+		granteeID      string
+		expectedStatus int
+	}{
+		{"Revoked", blobs.GranteeTypeUser, "user-2", http.StatusNoContent},
+		{"Not Found", blobs.GranteeTypeGroup, "auditors", http.StatusNotFound},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			w := httptest.NewRecorder()
+			req, _ := http.NewRequest("DELETE", "/blobs/123/grants/"+tt.granteeType+"/"+tt.granteeID, nil)
+
+			c, _ := gin.CreateTestContext(w)
+			c.Request = req
+			c.Params = gin.Params{
+				gin.Param{Key: "id", Value: "123"},
+				gin.Param{Key: "granteeType", Value: tt.granteeType},
+				gin.Param{Key: "granteeId", Value: tt.granteeID},
+			}
+
+			handler.DeleteGrant(c)
+
+			assert.Equal(t, tt.expectedStatus, w.Code)
+		})
+	}
+	mockGrantService.AssertExpectations(t)
+}
+
+func TestBlobHandler_CreateLink(t *testing.T) {
+	mockLinkService := new(MockBlobLinkService)
+	handler := NewBlobHandler(new(MockBlobUploadService), new(MockBlobDownloadService), new(MockBlobMetadataService), new(MockBlobGrantService), mockLinkService, new(MockCryptoKeyUploadService))
+
+	decryptionKeyID := "7f3d3b84-7b1f-4a55-9a43-1a8f0a5bde21"
+	link := &blobs.BlobLink{
+		ID:              "link-1",
+		BlobID:          "123",
+		UserID:          "user-1",
+		DecryptionKeyID: &decryptionKeyID,
+		SingleUse:       true,
+		DateTimeExpires: time.Now().Add(5 * time.Minute),
+	}
+
+	mockLinkService.On("Create", mock.Anything, "123", mock.MatchedBy(func(keyID *string) bool {
+		return keyID != nil && *keyID == decryptionKeyID
+	}), true, 5*time.Minute).Return(link, "payload.signature", nil)
+
+	requestBody := `{"expires_in_seconds": 300, "decryption_key_id": "` + decryptionKeyID + `", "single_use": true}`
+
+	w := httptest.NewRecorder()
+	req, _ := http.NewRequest("POST", "/blobs/123/links", bytes.NewBufferString(requestBody))
+	req.Header.Set("Content-Type", "application/json")
+
+	c, _ := gin.CreateTestContext(w)
+	c.Request = req
+	c.Params = gin.Params{gin.Param{Key: "id", Value: "123"}}
+
+	handler.CreateLink(c)
+
+	assert.Equal(t, http.StatusCreated, w.Code)
+
+	var response BlobLinkResponse
+	require.NoError(t, json.Unmarshal(w.Body.Bytes(), &response))
+	assert.Equal(t, "link-1", response.ID)
+	assert.Equal(t, "payload.signature", response.Token)
+	assert.Equal(t, BasePath+"/blobs/123/file?token=payload.signature", response.URL)
+	assert.True(t, response.SingleUse)
+	mockLinkService.AssertExpectations(t)
+}
+
+// Test CreateLink rejecting invalid link data and callers not permitted to download the blob
+func TestBlobHandler_CreateLink_Error(t *testing.T) {
+	mockLinkService := new(MockBlobLinkService)
+	handler := NewBlobHandler(new(MockBlobUploadService), new(MockBlobDownloadService), new(MockBlobMetadataService), new(MockBlobGrantService), mockLinkService, new(MockCryptoKeyUploadService))
+
+	mockLinkService.On("Create", mock.Anything, "123", mock.Anything, mock.Anything, mock.Anything).Return(nil, "", fmt.Errorf("can_download_blob on blob:123: %w", auth.ErrForbidden))
+
+	tests := []struct {
+		name           string
+		requestBody    string
+		expectedStatus int
+	}{
+		{"Invalid JSON", `{"expires_in_seconds":`, http.StatusBadRequest},
+		{"Missing Expiry", `{"single_use": true}`, http.StatusBadRequest},
+		{"Expiry Too Long", `{"expires_in_seconds": 86401}`, http.StatusBadRequest},
+		{"Invalid Decryption Key ID", `{"expires_in_seconds": 300, "decryption_key_id": "key-1"}`, http.StatusBadRequest},
+		{"Forbidden", `{"expires_in_seconds": 300}`, http.StatusForbidden},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			w := httptest.NewRecorder()
+			req, _ := http.NewRequest("POST", "/blobs/123/links", bytes.NewBufferString(tt.requestBody))
+			req.Header.Set("Content-Type", "application/json")
+
+			c, _ := gin.CreateTestContext(w)
+			c.Request = req
+			c.Params = gin.Params{gin.Param{Key: "id", Value: "123"}}
+
+			handler.CreateLink(c)
+
+			assert.Equal(t, tt.expectedStatus, w.Code)
+		})
+	}
+}
+
+func TestBlobHandler_RevokeLink(t *testing.T) {
+	mockLinkService := new(MockBlobLinkService)
+	handler := NewBlobHandler(new(MockBlobUploadService), new(MockBlobDownloadService), new(MockBlobMetadataService), new(MockBlobGrantService), mockLinkService, new(MockCryptoKeyUploadService))
+
+	mockLinkService.On("Revoke", mock.Anything, "123", "link-1").Return(nil)
+	mockLinkService.On("Revoke", mock.Anything, "456", "link-1").Return(fmt.Errorf("can_share_blob on blob:456: %w", auth.ErrForbidden))
+
+	tests := []struct {
+		name           string
+		blobID         string
+		expectedStatus int
+	}{
+		{"Revoked", "123", http.StatusNoContent},
+		{"Forbidden", "456", http.StatusForbidden},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			w := httptest.NewRecorder()
+			req, _ := http.NewRequest("DELETE", "/blobs/"+tt.blobID+"/links/link-1", nil)
+
+			c, _ := gin.CreateTestContext(w)
+			c.Request = req
+			c.Params = gin.Params{gin.Param{Key: "id", Value: tt.blobID}, gin.Param{Key: "linkId", Value: "link-1"}}
+
+			handler.RevokeLink(c)
+
+			assert.Equal(t, tt.expectedStatus, c.Writer.Status())
+		})
+	}
+	mockLinkService.AssertExpectations(t)
+}
+
+// Test DownloadByID decrypting with the key a pre-signed link is bound to rather than the query parameter
+func TestBlobHandler_DownloadByID_WithLink(t *testing.T) {
+	mockDownloadService := new(MockBlobDownloadService)
+	mockMetadataService := new(MockBlobMetadataService)
+	handler := NewBlobHandler(new(MockBlobUploadService), mockDownloadService, mockMetadataService, new(MockBlobGrantService), new(MockBlobLinkService), new(MockCryptoKeyUploadService))
+
+	blobID := "123"
+	decryptionKeyID := "7f3d3b84-7b1f-4a55-9a43-1a8f0a5bde21"
+	blobContent := []byte("file content")
+
+	mockDownloadService.On("DownloadByID", mock.Anything, blobID, &decryptionKeyID).Return(io.NopCloser(bytes.NewReader(blobContent)), nil)
+	mockMetadataService.On("GetByID", mock.Anything, blobID).Return(&blobs.BlobMeta{ID: blobID, Name: "testfile.txt"}, nil)
+
+	w := httptest.NewRecorder()
+	req, _ := http.NewRequest("GET", "/blobs/123/file?token=payload.signature&decryption_key_id=other-key", nil)
+
+	c, _ := gin.CreateTestContext(w)
+	c.Request = req
+	c.Params = gin.Params{gin.Param{Key: "id", Value: blobID}}
+	c.Set(blobLinkKey, &blobs.BlobLink{ID: "link-1", BlobID: blobID, UserID: "user-1", DecryptionKeyID: &decryptionKeyID})
+
+	handler.DownloadByID(c)
+
+	assert.Equal(t, http.StatusOK, w.Code)
+	assert.Equal(t, string(blobContent), w.Body.String())
+	mockDownloadService.AssertExpectations(t)
+}
+
 func TestKeyHandler_UploadKeys(t *testing.T) {
 	mockUploadService := new(MockCryptoKeyUploadService)
 	mockDownloadService := new(MockCryptoKeyDownloadService)
 	mockMetadataService := new(MockCryptoKeyMetadataService)
 
-	handler := NewKeyHandler(mockUploadService, mockDownloadService, mockMetadataService)
+	handler := NewKeyHandler(mockUploadService, mockDownloadService, mockMetadataService, new(MockCryptoKeyRotationService), new(MockCryptoKeyImportService))
 
 	keyMeta := &keys.CryptoKeyMeta{
 		ID:              "abc-123",
@@ -301,7 +693,7 @@ func TestKeyHandler_UploadKeys(t *testing.T) {
 	requestBody := `{"algorithm": "RSA", "key_size": 2048}`
 
 	mockUploadService.
-		On("Upload", mock.Anything, mock.AnythingOfType("string"), "RSA", uint32(2048)).
+		On("Upload", mock.Anything, "RSA", uint32(2048), mock.Anything).
 		Return([]*keys.CryptoKeyMeta{keyMeta}, nil)
 
 	w := httptest.NewRecorder()
@@ -323,7 +715,7 @@ func TestKeyHandler_ListMetadata(t *testing.T) {
 	mockDownloadService := new(MockCryptoKeyDownloadService)
 	mockMetadataService := new(MockCryptoKeyMetadataService)
 
-	handler := NewKeyHandler(mockUploadService, mockDownloadService, mockMetadataService)
+	handler := NewKeyHandler(mockUploadService, mockDownloadService, mockMetadataService, new(MockCryptoKeyRotationService), new(MockCryptoKeyImportService))
 
 	keyMeta := &keys.CryptoKeyMeta{
 		ID:              "abc-123",
@@ -357,7 +749,7 @@ func TestKeyHandler_GetMetadataByID(t *testing.T) {
 	mockDownloadService := new(MockCryptoKeyDownloadService)
 	mockMetadataService := new(MockCryptoKeyMetadataService)
 
-	handler := NewKeyHandler(mockUploadService, mockDownloadService, mockMetadataService)
+	handler := NewKeyHandler(mockUploadService, mockDownloadService, mockMetadataService, new(MockCryptoKeyRotationService), new(MockCryptoKeyImportService))
 
 	keyMeta := &keys.CryptoKeyMeta{
 		ID:              "abc-123",
@@ -387,59 +779,1045 @@ func TestKeyHandler_GetMetadataByID(t *testing.T) {
 	mockMetadataService.AssertExpectations(t)
 }
 
-func TestKeyHandler_DownloadByID(t *testing.T) {
+func TestKeyHandler_UpdateLifecycle(t *testing.T) {
 	mockUploadService := new(MockCryptoKeyUploadService)
 	mockDownloadService := new(MockCryptoKeyDownloadService)
 	mockMetadataService := new(MockCryptoKeyMetadataService)
 
-	handler := NewKeyHandler(mockUploadService, mockDownloadService, mockMetadataService)
+	handler := NewKeyHandler(mockUploadService, mockDownloadService, mockMetadataService, new(MockCryptoKeyRotationService), new(MockCryptoKeyImportService))
 
-	keyID := "abc-123"
-	keyContent := []byte("secret key content")
+	keyMeta := &keys.CryptoKeyMeta{
+		ID:              "abc-123",
+		KeyPairID:       "pair-123",
+		Algorithm:       "RSA",
+		KeySize:         2048,
+		Type:            "private",
+		DateTimeCreated: time.Now(),
+		UserID:          "user-1",
+		State:           keys.KeyStateSuspended,
+	}
 
-	mockDownloadService.
-		On("DownloadByID", mock.Anything, keyID).
-		Return(keyContent, nil)
+	mockMetadataService.
+		On("UpdateLifecycle", mock.Anything, "abc-123", &keys.CryptoKeyLifecycle{State: keys.KeyStateSuspended}).
+		Return([]*keys.CryptoKeyMeta{keyMeta}, nil)
 
 	w := httptest.NewRecorder()
-	req, _ := http.NewRequest("GET", "/keys/abc-123/file", nil)
+	req, _ := http.NewRequest("PATCH", "/keys/abc-123/lifecycle", bytes.NewBufferString(`{"state": "suspended"}`))
+	req.Header.Set("Content-Type", "application/json")
 
 	c, _ := gin.CreateTestContext(w)
 	c.Request = req
-	c.Params = gin.Params{gin.Param{Key: "id", Value: keyID}}
+	c.Params = gin.Params{gin.Param{Key: "id", Value: "abc-123"}}
 
-	handler.DownloadByID(c)
+	handler.UpdateLifecycle(c)
 
 	assert.Equal(t, http.StatusOK, w.Code)
-	assert.Equal(t, "application/octet-stream; charset=utf-8", w.Header().Get("Content-Type"))
-	assert.Equal(t, "attachment; filename="+keyID, w.Header().Get("Content-Disposition"))
-	assert.Equal(t, string(keyContent), w.Body.String())
+	assert.Contains(t, w.Body.String(), `"state":"suspended"`)
+	mockMetadataService.AssertExpectations(t)
+}
 
-	mockDownloadService.AssertExpectations(t)
+func TestKeyHandler_UpdateLifecycle_DestroyForce(t *testing.T) {
+	mockMetadataService := new(MockCryptoKeyMetadataService)
+
+	handler := NewKeyHandler(new(MockCryptoKeyUploadService), new(MockCryptoKeyDownloadService), mockMetadataService, new(MockCryptoKeyRotationService), new(MockCryptoKeyImportService))
+
+	destruction := time.Now().Add(7 * 24 * time.Hour)
+	keyMeta := &keys.CryptoKeyMeta{
+		ID:                  "abc-123",
+		KeyPairID:           "pair-123",
+		Algorithm:           "AES",
+		KeySize:             256,
+		Type:                "private",
+		DateTimeCreated:     time.Now(),
+		UserID:              "user-1",
+		State:               keys.KeyStateDeactivated,
+		DateTimeDestruction: &destruction,
+	}
+
+	mockMetadataService.
+		On("UpdateLifecycle", mock.Anything, "abc-123", &keys.CryptoKeyLifecycle{State: keys.KeyStateDestroyed, Force: true}).
+		Return([]*keys.CryptoKeyMeta{keyMeta}, nil)
+
+	w := httptest.NewRecorder()
+	req, _ := http.NewRequest("PATCH", "/keys/abc-123/lifecycle", bytes.NewBufferString(`{"state": "destroyed", "force": true}`))
+	req.Header.Set("Content-Type", "application/json")
+
+	c, _ := gin.CreateTestContext(w)
+	c.Request = req
+	c.Params = gin.Params{gin.Param{Key: "id", Value: "abc-123"}}
+
+	handler.UpdateLifecycle(c)
+
+	assert.Equal(t, http.StatusOK, w.Code)
+	assert.NotContains(t, w.Body.String(), `"dateTimeDestruction":null`)
+	mockMetadataService.AssertExpectations(t)
 }
 
-func TestKeyHandler_DeleteByID(t *testing.T) {
+func TestKeyHandler_UpdateLifecycle_Error(t *testing.T) {
 	mockUploadService := new(MockCryptoKeyUploadService)
 	mockDownloadService := new(MockCryptoKeyDownloadService)
 	mockMetadataService := new(MockCryptoKeyMetadataService)
 
-	handler := NewKeyHandler(mockUploadService, mockDownloadService, mockMetadataService)
+	handler := NewKeyHandler(mockUploadService, mockDownloadService, mockMetadataService, new(MockCryptoKeyRotationService), new(MockCryptoKeyImportService))
 
-	keyID := "abc-123"
+	mockMetadataService.
+		On("UpdateLifecycle", mock.Anything, "abc-123", mock.Anything).
+		Return(nil, fmt.Errorf("%w: key abc-123 cannot transition from active to destroyed", keys.ErrKeyState))
+
+	tests := []struct {
+		name           string
+		body           string
+		expectedStatus int
+	}{
+		{"Invalid transition", `{"state": "destroyed"}`, http.StatusConflict},
+		{"Empty update", `{}`, http.StatusBadRequest},
+		{"Invalid state", `{"state": "compromised"}`, http.StatusBadRequest},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			w := httptest.NewRecorder()
+			req, _ := http.NewRequest("PATCH", "/keys/abc-123/lifecycle", bytes.NewBufferString(tt.body))
+			req.Header.Set("Content-Type", "application/json")
+
+			c, _ := gin.CreateTestContext(w)
+			c.Request = req
+			c.Params = gin.Params{gin.Param{Key: "id", Value: "abc-123"}}
+
+			handler.UpdateLifecycle(c)
+
+			assert.Equal(t, tt.expectedStatus, w.Code)
+		})
+	}
+}
+
+func TestKeyHandler_UpdateExportPolicy(t *testing.T) {
+	mockMetadataService := new(MockCryptoKeyMetadataService)
+
+	handler := NewKeyHandler(new(MockCryptoKeyUploadService), new(MockCryptoKeyDownloadService), mockMetadataService, new(MockCryptoKeyRotationService), new(MockCryptoKeyImportService))
+
+	keyMeta := &keys.CryptoKeyMeta{
+		ID:                       "abc-123",
+		KeyPairID:                "pair-123",
+		Algorithm:                "AES",
+		KeySize:                  256,
+		Type:                     "symmetric",
+		DateTimeCreated:          time.Now(),
+		UserID:                   "user-1",
+		PlaintextExportForbidden: true,
+	}
 
 	mockMetadataService.
-		On("DeleteByID", mock.Anything, keyID).
-		Return(nil)
+		On("UpdateExportPolicy", mock.Anything, "abc-123", true).
+		Return([]*keys.CryptoKeyMeta{keyMeta}, nil)
 
 	w := httptest.NewRecorder()
-	req, _ := http.NewRequest("DELETE", "/keys/abc-123", nil)
+	req, _ := http.NewRequest("PUT", "/keys/abc-123/export-policy", bytes.NewBufferString(`{"plaintext_export_forbidden": true}`))
+	req.Header.Set("Content-Type", "application/json")
 
 	c, _ := gin.CreateTestContext(w)
 	c.Request = req
-	c.Params = gin.Params{gin.Param{Key: "id", Value: keyID}}
+	c.Params = gin.Params{gin.Param{Key: "id", Value: "abc-123"}}
 
-	handler.DeleteByID(c)
+	handler.UpdateExportPolicy(c)
 
-	assert.Equal(t, http.StatusNoContent, w.Code)
+	assert.Equal(t, http.StatusOK, w.Code)
+	assert.Contains(t, w.Body.String(), `"plaintextExportForbidden":true`)
 	mockMetadataService.AssertExpectations(t)
 }
+
+func TestKeyHandler_UpdateExportPolicy_Error(t *testing.T) {
+	mockMetadataService := new(MockCryptoKeyMetadataService)
+
+	handler := NewKeyHandler(new(MockCryptoKeyUploadService), new(MockCryptoKeyDownloadService), mockMetadataService, new(MockCryptoKeyRotationService), new(MockCryptoKeyImportService))
+
+	mockMetadataService.
+		On("UpdateExportPolicy", mock.Anything, "abc-123", false).
+		Return(nil, fmt.Errorf("%w: plaintext export of key abc-123 cannot be permitted again", keys.ErrExportForbidden))
+
+	tests := []struct {
+		name           string
+		body           string
+		expectedStatus int
+	}{
+		{"Permitting plaintext export again", `{"plaintext_export_forbidden": false}`, http.StatusForbidden},
+		{"Missing policy", `{}`, http.StatusBadRequest},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			w := httptest.NewRecorder()
+			req, _ := http.NewRequest("PUT", "/keys/abc-123/export-policy", bytes.NewBufferString(tt.body))
+			req.Header.Set("Content-Type", "application/json")
+
+			c, _ := gin.CreateTestContext(w)
+			c.Request = req
+			c.Params = gin.Params{gin.Param{Key: "id", Value: "abc-123"}}
+
+			handler.UpdateExportPolicy(c)
+
+			assert.Equal(t, tt.expectedStatus, w.Code)
+		})
+	}
+}
+
+func TestKeyHandler_DownloadByID(t *testing.T) {
+	mockUploadService := new(MockCryptoKeyUploadService)
+	mockDownloadService := new(MockCryptoKeyDownloadService)
+	mockMetadataService := new(MockCryptoKeyMetadataService)
+
+	handler := NewKeyHandler(mockUploadService, mockDownloadService, mockMetadataService, new(MockCryptoKeyRotationService), new(MockCryptoKeyImportService))
+
+	keyID := "abc-123"
+	keyContent := []byte("secret key content")
+
+	mockDownloadService.
+		On("DownloadByID", mock.Anything, keyID).
+		Return(keyContent, nil)
+
+	w := httptest.NewRecorder()
+	req, _ := http.NewRequest("GET", "/keys/abc-123/file", nil)
+
+	c, _ := gin.CreateTestContext(w)
+	c.Request = req
+	c.Params = gin.Params{gin.Param{Key: "id", Value: keyID}}
+
+	handler.DownloadByID(c)
+
+	assert.Equal(t, http.StatusOK, w.Code)
+	assert.Equal(t, "application/octet-stream; charset=utf-8", w.Header().Get("Content-Type"))
+	assert.Equal(t, "attachment; filename="+keyID, w.Header().Get("Content-Disposition"))
+	assert.Equal(t, string(keyContent), w.Body.String())
+
+	mockDownloadService.AssertExpectations(t)
+}
+
+func TestKeyHandler_DownloadByID_ExportForbidden(t *testing.T) {
+	mockDownloadService := new(MockCryptoKeyDownloadService)
+
+	handler := NewKeyHandler(new(MockCryptoKeyUploadService), mockDownloadService, new(MockCryptoKeyMetadataService), new(MockCryptoKeyRotationService), new(MockCryptoKeyImportService))
+
+	mockDownloadService.
+		On("DownloadByID", mock.Anything, "abc-123").
+		Return(nil, fmt.Errorf("%w: key abc-123 may only be exported wrapped", keys.ErrExportForbidden))
+
+	w := httptest.NewRecorder()
+	req, _ := http.NewRequest("GET", "/keys/abc-123/file", nil)
+
+	c, _ := gin.CreateTestContext(w)
+	c.Request = req
+	c.Params = gin.Params{gin.Param{Key: "id", Value: "abc-123"}}
+
+	handler.DownloadByID(c)
+
+	assert.Equal(t, http.StatusForbidden, w.Code)
+	assert.Contains(t, w.Body.String(), "exported wrapped")
+	mockDownloadService.AssertExpectations(t)
+}
+
+func TestKeyHandler_ExportWrappedByID(t *testing.T) {
+	mockDownloadService := new(MockCryptoKeyDownloadService)
+
+	handler := NewKeyHandler(new(MockCryptoKeyUploadService), mockDownloadService, new(MockCryptoKeyMetadataService), new(MockCryptoKeyRotationService), new(MockCryptoKeyImportService))
+
+	wrappingKeyID := uuid.New().String()
+	wrappedKey := []byte("wrapped key content")
+
+	mockDownloadService.
+		On("ExportWrappedByID", mock.Anything, "abc-123", &keys.KeyExportWrapping{WrappingKeyID: wrappingKeyID}).
+		Return(&keys.WrappedKeyExport{KeyID: "abc-123", WrappingAlgorithm: "AES_KEY_WRAP_PAD", WrappingKeyID: wrappingKeyID, WrappedKey: wrappedKey}, nil)
+
+	w := httptest.NewRecorder()
+	req, _ := http.NewRequest("POST", "/keys/abc-123/export", bytes.NewBufferString(fmt.Sprintf(`{"wrapping_key_id": "%s"}`, wrappingKeyID)))
+	req.Header.Set("Content-Type", "application/json")
+
+	c, _ := gin.CreateTestContext(w)
+	c.Request = req
+	c.Params = gin.Params{gin.Param{Key: "id", Value: "abc-123"}}
+
+	handler.ExportWrappedByID(c)
+
+	assert.Equal(t, http.StatusOK, w.Code)
+	assert.Equal(t, "application/octet-stream", w.Header().Get("Content-Type"))
+	assert.Equal(t, "AES_KEY_WRAP_PAD", w.Header().Get("X-Wrapping-Algorithm"))
+	assert.Equal(t, wrappingKeyID, w.Header().Get("X-Wrapping-Key-ID"))
+	assert.Equal(t, string(wrappedKey), w.Body.String())
+	mockDownloadService.AssertExpectations(t)
+}
+
+func TestKeyHandler_ExportWrappedByID_Error(t *testing.T) {
+	mockDownloadService := new(MockCryptoKeyDownloadService)
+
+	handler := NewKeyHandler(new(MockCryptoKeyUploadService), mockDownloadService, new(MockCryptoKeyMetadataService), new(MockCryptoKeyRotationService), new(MockCryptoKeyImportService))
+
+	destroyedKeyID := uuid.New().String()
+	mockDownloadService.
+		On("ExportWrappedByID", mock.Anything, "abc-123", &keys.KeyExportWrapping{WrappingKeyID: destroyedKeyID}).
+		Return(nil, fmt.Errorf("%w: key %s is destroyed", keys.ErrKeyState, destroyedKeyID))
+
+	tests := []struct {
+		name           string
+		body           string
+		expectedStatus int
+	}{
+		{"Wrapping key destroyed", fmt.Sprintf(`{"wrapping_key_id": "%s"}`, destroyedKeyID), http.StatusConflict},
+		{"No wrapping key", `{}`, http.StatusBadRequest},
+		{"Both wrapping keys", fmt.Sprintf(`{"wrapping_key_id": "%s", "public_key": "cHVibGlj"}`, destroyedKeyID), http.StatusBadRequest},
+		{"Invalid wrapping key ID", `{"wrapping_key_id": "not-a-uuid"}`, http.StatusBadRequest},
+		{"Public key not base64", `{"public_key": "%%%"}`, http.StatusBadRequest},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			w := httptest.NewRecorder()
+			req, _ := http.NewRequest("POST", "/keys/abc-123/export", bytes.NewBufferString(tt.body))
+			req.Header.Set("Content-Type", "application/json")
+
+			c, _ := gin.CreateTestContext(w)
+			c.Request = req
+			c.Params = gin.Params{gin.Param{Key: "id", Value: "abc-123"}}
+
+			handler.ExportWrappedByID(c)
+
+			assert.Equal(t, tt.expectedStatus, w.Code)
+		})
+	}
+}
+
+func TestKeyHandler_DeleteByID(t *testing.T) {
+	mockUploadService := new(MockCryptoKeyUploadService)
+	mockDownloadService := new(MockCryptoKeyDownloadService)
+	mockMetadataService := new(MockCryptoKeyMetadataService)
+
+	handler := NewKeyHandler(mockUploadService, mockDownloadService, mockMetadataService, new(MockCryptoKeyRotationService), new(MockCryptoKeyImportService))
+
+	keyID := "abc-123"
+	destruction := time.Now().Add(7 * 24 * time.Hour)
+
+	mockMetadataService.
+		On("DeleteByID", mock.Anything, keyID, true).
+		Return([]*keys.CryptoKeyMeta{{ID: keyID, State: keys.KeyStateActive, DateTimeDestruction: &destruction}}, nil)
+
+	w := httptest.NewRecorder()
+	req, _ := http.NewRequest("DELETE", "/keys/abc-123?force=true", nil)
+
+	c, _ := gin.CreateTestContext(w)
+	c.Request = req
+	c.Params = gin.Params{gin.Param{Key: "id", Value: keyID}}
+
+	handler.DeleteByID(c)
+
+	assert.Equal(t, http.StatusAccepted, w.Code)
+
+	var response []CryptoKeyMetaResponse
+	require.NoError(t, json.Unmarshal(w.Body.Bytes(), &response))
+	require.Len(t, response, 1)
+	require.NotNil(t, response[0].DateTimeDestruction)
+	assert.True(t, destruction.Equal(*response[0].DateTimeDestruction))
+	mockMetadataService.AssertExpectations(t)
+}
+
+// Test the key handler refusing to destroy keys blobs are still protected with and rejecting invalid force parameters
+func TestKeyHandler_DeleteByID_Error(t *testing.T) {
+	mockUploadService := new(MockCryptoKeyUploadService)
+	mockDownloadService := new(MockCryptoKeyDownloadService)
+	mockMetadataService := new(MockCryptoKeyMetadataService)
+
+	handler := NewKeyHandler(mockUploadService, mockDownloadService, mockMetadataService, new(MockCryptoKeyRotationService), new(MockCryptoKeyImportService))
+
+	mockMetadataService.
+		On("DeleteByID", mock.Anything, "abc-123", false).
+		Return(nil, fmt.Errorf("%w: 2 blobs are protected with key pair pair-1", keys.ErrKeyInUse))
+
+	tests := []struct {
+		name           string
+		url            string
+		expectedStatus int
+	}{
+		{"Key In Use", "/keys/abc-123", http.StatusConflict},
+		{"Invalid Force", "/keys/abc-123?force=maybe", http.StatusBadRequest},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			w := httptest.NewRecorder()
+			req, _ := http.NewRequest("DELETE", tt.url, nil)
+
+			c, _ := gin.CreateTestContext(w)
+			c.Request = req
+			c.Params = gin.Params{gin.Param{Key: "id", Value: "abc-123"}}
+
+			handler.DeleteByID(c)
+
+			assert.Equal(t, tt.expectedStatus, w.Code)
+		})
+	}
+
+	mockMetadataService.AssertExpectations(t)
+}
+
+func TestKeyHandler_CancelDestruction(t *testing.T) {
+	mockUploadService := new(MockCryptoKeyUploadService)
+	mockDownloadService := new(MockCryptoKeyDownloadService)
+	mockMetadataService := new(MockCryptoKeyMetadataService)
+
+	handler := NewKeyHandler(mockUploadService, mockDownloadService, mockMetadataService, new(MockCryptoKeyRotationService), new(MockCryptoKeyImportService))
+
+	mockMetadataService.
+		On("CancelDestruction", mock.Anything, "abc-123").
+		Return([]*keys.CryptoKeyMeta{{ID: "abc-123", State: keys.KeyStateActive}}, nil)
+	mockMetadataService.
+		On("CancelDestruction", mock.Anything, "def-456").
+		Return(nil, fmt.Errorf("%w: key def-456 is not scheduled for destruction", keys.ErrKeyState))
+
+	tests := []struct {
+		keyID          string
+		expectedStatus int
+	}{
+		{"abc-123", http.StatusOK},
+		{"def-456", http.StatusConflict},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.keyID, func(t *testing.T) {
+			w := httptest.NewRecorder()
+			req, _ := http.NewRequest("DELETE", "/keys/"+tt.keyID+"/destruction", nil)
+
+			c, _ := gin.CreateTestContext(w)
+			c.Request = req
+			c.Params = gin.Params{gin.Param{Key: "id", Value: tt.keyID}}
+
+			handler.CancelDestruction(c)
+
+			assert.Equal(t, tt.expectedStatus, w.Code)
+		})
+	}
+
+	mockMetadataService.AssertExpectations(t)
+}
+
+func TestKeyHandler_Rotate(t *testing.T) {
+	mockRotationService := new(MockCryptoKeyRotationService)
+
+	handler := NewKeyHandler(new(MockCryptoKeyUploadService), new(MockCryptoKeyDownloadService), new(MockCryptoKeyMetadataService), mockRotationService, new(MockCryptoKeyImportService))
+
+	mockRotationService.
+		On("Rotate", mock.Anything, "abc-123").
+		Return([]*keys.CryptoKeyMeta{{ID: "def-456", KeyPairID: "pair-2", LogicalKeyID: "pair-1", Version: 2, State: keys.KeyStateActive}}, nil)
+	mockRotationService.
+		On("Rotate", mock.Anything, "def-456").
+		Return(nil, auth.ErrForbidden)
+
+	tests := []struct {
+		keyID          string
+		expectedStatus int
+	}{
+		{"abc-123", http.StatusCreated},
+		{"def-456", http.StatusForbidden},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.keyID, func(t *testing.T) {
+			w := httptest.NewRecorder()
+			req, _ := http.NewRequest("POST", "/keys/"+tt.keyID+"/rotate", nil)
+
+			c, _ := gin.CreateTestContext(w)
+			c.Request = req
+			c.Params = gin.Params{gin.Param{Key: "id", Value: tt.keyID}}
+
+			handler.Rotate(c)
+
+			assert.Equal(t, tt.expectedStatus, w.Code)
+			if tt.expectedStatus == http.StatusCreated {
+				var response []CryptoKeyMetaResponse
+				require.NoError(t, json.Unmarshal(w.Body.Bytes(), &response))
+				require.Len(t, response, 1)
+				assert.Equal(t, "pair-1", response[0].LogicalKeyID)
+				assert.Equal(t, uint32(2), response[0].Version)
+			}
+		})
+	}
+
+	mockRotationService.AssertExpectations(t)
+}
+
+func TestKeyHandler_ListVersions(t *testing.T) {
+	mockRotationService := new(MockCryptoKeyRotationService)
+
+	handler := NewKeyHandler(new(MockCryptoKeyUploadService), new(MockCryptoKeyDownloadService), new(MockCryptoKeyMetadataService), mockRotationService, new(MockCryptoKeyImportService))
+
+	mockRotationService.
+		On("ListVersions", mock.Anything, "abc-123").
+		Return([]*keys.CryptoKeyMeta{
+			{ID: "def-456", KeyPairID: "pair-2", LogicalKeyID: "pair-1", Version: 2},
+			{ID: "abc-123", KeyPairID: "pair-1", LogicalKeyID: "pair-1", Version: 1},
+		}, nil)
+
+	w := httptest.NewRecorder()
+	req, _ := http.NewRequest("GET", "/keys/abc-123/versions", nil)
+
+	c, _ := gin.CreateTestContext(w)
+	c.Request = req
+	c.Params = gin.Params{gin.Param{Key: "id", Value: "abc-123"}}
+
+	handler.ListVersions(c)
+
+	assert.Equal(t, http.StatusOK, w.Code)
+
+	var response []CryptoKeyMetaResponse
+	require.NoError(t, json.Unmarshal(w.Body.Bytes(), &response))
+	require.Len(t, response, 2)
+	assert.Equal(t, uint32(2), response[0].Version)
+	assert.Equal(t, uint32(1), response[1].Version)
+	mockRotationService.AssertExpectations(t)
+}
+
+func TestKeyHandler_UpdateRotationPolicy(t *testing.T) {
+	mockRotationService := new(MockCryptoKeyRotationService)
+
+	handler := NewKeyHandler(new(MockCryptoKeyUploadService), new(MockCryptoKeyDownloadService), new(MockCryptoKeyMetadataService), mockRotationService, new(MockCryptoKeyImportService))
+
+	mockRotationService.
+		On("UpdateRotationPolicy", mock.Anything, "abc-123", 720*time.Hour).
+		Return(&keys.LogicalKey{ID: "pair-1", PrimaryVersion: 1, LatestVersion: 1, RotationPeriod: 720 * time.Hour}, nil)
+	mockRotationService.
+		On("UpdateRotationPolicy", mock.Anything, "abc-123", time.Duration(0)).
+		Return(&keys.LogicalKey{ID: "pair-1", PrimaryVersion: 1, LatestVersion: 1}, nil)
+
+	tests := []struct {
+		name           string
+		body           string
+		expectedStatus int
+	}{
+		{"Enable", `{"rotation_period": "720h"}`, http.StatusOK},
+		{"Disable", `{"rotation_period": "0"}`, http.StatusOK},
+		{"Too Short", `{"rotation_period": "1h"}`, http.StatusBadRequest},
+		{"Invalid Period", `{"rotation_period": "monthly"}`, http.StatusBadRequest},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			w := httptest.NewRecorder()
+			req, _ := http.NewRequest("PUT", "/keys/abc-123/rotation-policy", bytes.NewBufferString(tt.body))
+			req.Header.Set("Content-Type", "application/json")
+
+			c, _ := gin.CreateTestContext(w)
+			c.Request = req
+			c.Params = gin.Params{gin.Param{Key: "id", Value: "abc-123"}}
+
+			handler.UpdateRotationPolicy(c)
+
+			assert.Equal(t, tt.expectedStatus, w.Code)
+		})
+	}
+
+	mockRotationService.AssertExpectations(t)
+}
+
+func TestKeyHandler_ImportKeys(t *testing.T) {
+	mockImportService := new(MockCryptoKeyImportService)
+
+	handler := NewKeyHandler(new(MockCryptoKeyUploadService), new(MockCryptoKeyDownloadService), new(MockCryptoKeyMetadataService), new(MockCryptoKeyRotationService), mockImportService)
+
+	keyMeta := &keys.CryptoKeyMeta{
+		ID:              "abc-123",
+		KeyPairID:       "pair-123",
+		Algorithm:       "AES",
+		KeySize:         256,
+		Type:            "symmetric",
+		DateTimeCreated: time.Now(),
+		UserID:          "user-1",
+	}
+
+	keyMaterial := make([]byte, 32)
+	mockImportService.
+		On("Import", mock.Anything, &keys.CryptoKeyImport{Algorithm: "AES", Format: "raw", KeyMaterial: keyMaterial}, mock.Anything).
+		Return([]*keys.CryptoKeyMeta{keyMeta}, nil)
+	mockImportService.
+		On("Import", mock.Anything, mock.Anything, mock.Anything).
+		Return(nil, errors.New("key size 3072 not supported for RSA"))
+
+	tests := []struct {
+		name           string
+		body           string
+		expectedStatus int
+	}{
+		{"Success", `{"algorithm": "AES", "format": "raw", "key_material": "` + base64.StdEncoding.EncodeToString(keyMaterial) + `"}`, http.StatusCreated},
+		{"Rejected Key Material", `{"algorithm": "RSA", "format": "pem", "key_material": "a2V5"}`, http.StatusBadRequest},
+		{"Missing Key Material", `{"algorithm": "AES", "format": "raw"}`, http.StatusBadRequest},
+		{"Invalid Format", `{"algorithm": "AES", "format": "pkcs12", "key_material": "a2V5"}`, http.StatusBadRequest},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			w := httptest.NewRecorder()
+			req, _ := http.NewRequest("POST", "/keys/import", bytes.NewBufferString(tt.body))
+			req.Header.Set("Content-Type", "application/json")
+
+			c, _ := gin.CreateTestContext(w)
+			c.Request = req
+
+			handler.ImportKeys(c)
+
+			assert.Equal(t, tt.expectedStatus, w.Code)
+		})
+	}
+
+	mockImportService.AssertExpectations(t)
+}
+
+func TestKeyHandler_GetImportPublicKey(t *testing.T) {
+	mockImportService := new(MockCryptoKeyImportService)
+
+	handler := NewKeyHandler(new(MockCryptoKeyUploadService), new(MockCryptoKeyDownloadService), new(MockCryptoKeyMetadataService), new(MockCryptoKeyRotationService), mockImportService)
+
+	mockImportService.
+		On("GetImportPublicKey", mock.Anything).
+		Return([]byte("-----BEGIN PUBLIC KEY-----"), nil)
+
+	w := httptest.NewRecorder()
+	req, _ := http.NewRequest("GET", "/keys/import-key", nil)
+
+	c, _ := gin.CreateTestContext(w)
+	c.Request = req
+
+	handler.GetImportPublicKey(c)
+
+	assert.Equal(t, http.StatusOK, w.Code)
+	assert.Contains(t, w.Body.String(), "BEGIN PUBLIC KEY")
+	assert.Contains(t, w.Body.String(), "RSA_AES_KEY_WRAP_SHA_256")
+	mockImportService.AssertExpectations(t)
+}
+
+func TestCryptoHandler_Operations(t *testing.T) {
+	mockOperationService := new(MockCryptoOperationService)
+
+	handler := NewCryptoHandler(mockOperationService)
+
+	valid := true
+	mockOperationService.
+		On("Encrypt", mock.Anything, "key-1", []byte("plaintext"), []byte("context")).
+		Return(&crypto.OperationResult{Operation: crypto.OperationEncrypt, KeyID: "key-1", KeyPairID: "pair-1", Algorithm: "AES", Ciphertext: []byte("ciphertext")}, nil)
+	mockOperationService.
+		On("Decrypt", mock.Anything, "key-1", []byte("ciphertext"), []byte(nil)).
+		Return(&crypto.OperationResult{Operation: crypto.OperationDecrypt, KeyID: "key-1", KeyPairID: "pair-1", Algorithm: "AES", Plaintext: []byte("plaintext")}, nil)
+	mockOperationService.
+		On("Sign", mock.Anything, "key-1", []byte("data")).
+		Return(&crypto.OperationResult{Operation: crypto.OperationSign, KeyID: "key-1", KeyPairID: "pair-1", Algorithm: "EC", Signature: []byte("signature")}, nil)
+	mockOperationService.
+		On("Verify", mock.Anything, "key-1", []byte("data"), []byte("signature")).
+		Return(&crypto.OperationResult{Operation: crypto.OperationVerify, KeyID: "key-1", KeyPairID: "pair-1", Algorithm: "EC", Valid: &valid}, nil)
+	mockOperationService.
+		On("Wrap", mock.Anything, "key-1", []byte("key")).
+		Return(&crypto.OperationResult{Operation: crypto.OperationWrap, KeyID: "key-1", KeyPairID: "pair-1", Algorithm: "RSA", WrappedKey: []byte("wrapped")}, nil)
+	mockOperationService.
+		On("Unwrap", mock.Anything, "key-1", []byte("wrapped")).
+		Return(&crypto.OperationResult{Operation: crypto.OperationUnwrap, KeyID: "key-1", KeyPairID: "pair-1", Algorithm: "RSA", Key: []byte("key")}, nil)
+
+	tests := []struct {
+		name     string
+		handle   func(ctx *gin.Context)
+		request  CryptoOperationRequest
+		expected CryptoOperationResponse
+	}{
+		{"Encrypt", handler.Encrypt, CryptoOperationRequest{Payload: []byte("plaintext"), AssociatedData: []byte("context")}, CryptoOperationResponse{Operation: "encrypt", Ciphertext: []byte("ciphertext")}},
+		{"Decrypt", handler.Decrypt, CryptoOperationRequest{Payload: []byte("ciphertext")}, CryptoOperationResponse{Operation: "decrypt", Plaintext: []byte("plaintext")}},
+		{"Sign", handler.Sign, CryptoOperationRequest{Payload: []byte("data")}, CryptoOperationResponse{Operation: "sign", Signature: []byte("signature")}},
+		{"Verify", handler.Verify, CryptoOperationRequest{Payload: []byte("data"), Signature: []byte("signature")}, CryptoOperationResponse{Operation: "verify", Valid: &valid}},
+		{"Wrap", handler.Wrap, CryptoOperationRequest{Payload: []byte("key")}, CryptoOperationResponse{Operation: "wrap", WrappedKey: []byte("wrapped")}},
+		{"Unwrap", handler.Unwrap, CryptoOperationRequest{Payload: []byte("wrapped")}, CryptoOperationResponse{Operation: "unwrap", Key: []byte("key")}},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			requestBody, err := json.Marshal(tt.request)
+			require.NoError(t, err)
+
+			w := httptest.NewRecorder()
+			req, _ := http.NewRequest("POST", "/keys/key-1/"+tt.expected.Operation, bytes.NewBuffer(requestBody))
+			req.Header.Set("Content-Type", "application/json")
+
+			c, _ := gin.CreateTestContext(w)
+			c.Request = req
+			c.Params = gin.Params{gin.Param{Key: "id", Value: "key-1"}}
+
+			tt.handle(c)
+
+			assert.Equal(t, http.StatusOK, w.Code)
+
+			var response CryptoOperationResponse
+			require.NoError(t, json.Unmarshal(w.Body.Bytes(), &response))
+			assert.Equal(t, "key-1", response.KeyID)
+			assert.Equal(t, "pair-1", response.KeyPairID)
+			assert.Equal(t, tt.expected.Operation, response.Operation)
+			assert.Equal(t, tt.expected.Ciphertext, response.Ciphertext)
+			assert.Equal(t, tt.expected.Plaintext, response.Plaintext)
+			assert.Equal(t, tt.expected.Signature, response.Signature)
+			assert.Equal(t, tt.expected.Valid, response.Valid)
+			assert.Equal(t, tt.expected.WrappedKey, response.WrappedKey)
+			assert.Equal(t, tt.expected.Key, response.Key)
+		})
+	}
+
+	mockOperationService.AssertExpectations(t)
+}
+
+// Test the crypto handler rejecting invalid payloads and callers not permitted to use the key
+func TestCryptoHandler_Encrypt_Error(t *testing.T) {
+	mockOperationService := new(MockCryptoOperationService)
+
+	handler := NewCryptoHandler(mockOperationService)
+
+	mockOperationService.
+		On("Encrypt", mock.Anything, "key-1", []byte("plaintext"), []byte(nil)).
+		Return(nil, fmt.Errorf("can_use_key on key:key-1: %w", auth.ErrForbidden))
+	mockOperationService.
+		On("Encrypt", mock.Anything, "key-1", []byte("data"), []byte(nil)).
+		Return(nil, errors.New("unsupported algorithm for encrypt: EC"))
+
+	tests := []struct {
+		name           string
+		requestBody    string
+		expectedStatus int
+	}{
+		{"Invalid JSON", `{"payload":`, http.StatusBadRequest},
+		{"Invalid Base64", `{"payload": "not base64!"}`, http.StatusBadRequest},
+		{"Missing Payload", `{}`, http.StatusBadRequest},
+		{"Forbidden", `{"payload": "cGxhaW50ZXh0"}`, http.StatusForbidden},
+		{"Unsupported Algorithm", `{"payload": "ZGF0YQ=="}`, http.StatusBadRequest},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			w := httptest.NewRecorder()
+			req, _ := http.NewRequest("POST", "/keys/key-1/encrypt", bytes.NewBufferString(tt.requestBody))
+			req.Header.Set("Content-Type", "application/json")
+
+			c, _ := gin.CreateTestContext(w)
+			c.Request = req
+			c.Params = gin.Params{gin.Param{Key: "id", Value: "key-1"}}
+
+			handler.Encrypt(c)
+
+			assert.Equal(t, tt.expectedStatus, w.Code)
+		})
+	}
+}
+
+func TestCryptoHandler_Unwrap_ExportForbidden(t *testing.T) {
+	mockOperationService := new(MockCryptoOperationService)
+
+	handler := NewCryptoHandler(mockOperationService)
+
+	mockOperationService.
+		On("Unwrap", mock.Anything, "key-1", []byte("wrapped")).
+		Return(nil, fmt.Errorf("%w: key material was exported wrapped with key key-1 and may not be unwrapped in plaintext", keys.ErrExportForbidden))
+
+	w := httptest.NewRecorder()
+	req, _ := http.NewRequest("POST", "/keys/key-1/unwrap", bytes.NewBufferString(`{"payload": "d3JhcHBlZA=="}`))
+	req.Header.Set("Content-Type", "application/json")
+
+	c, _ := gin.CreateTestContext(w)
+	c.Request = req
+	c.Params = gin.Params{gin.Param{Key: "id", Value: "key-1"}}
+
+	handler.Unwrap(c)
+
+	assert.Equal(t, http.StatusForbidden, w.Code)
+}
+
+func TestMasterKeyHandler_Rotate(t *testing.T) {
+	mockRotationService := new(MockMasterKeyRotationService)
+
+	handler := NewMasterKeyHandler(mockRotationService)
+
+	rotation := &keys.MasterKeyRotation{
+		ID:               "rotation-123",
+		TargetKEKVersion: 2,
+		Status:           "running",
+		DateTimeStarted:  time.Now(),
+		DateTimeUpdated:  time.Now(),
+	}
+
+	mockRotationService.
+		On("Rotate", mock.Anything).
+		Return(rotation, nil)
+
+	w := httptest.NewRecorder()
+	req, _ := http.NewRequest("POST", "/master-key/rotations", nil)
+
+	c, _ := gin.CreateTestContext(w)
+	c.Request = req
+
+	handler.Rotate(c)
+
+	assert.Equal(t, http.StatusAccepted, w.Code)
+	assert.Contains(t, w.Body.String(), "rotation-123")
+	assert.Contains(t, w.Body.String(), `"targetKEKVersion":2`)
+	mockRotationService.AssertExpectations(t)
+}
+
+func TestMasterKeyHandler_Rotate_AlreadyRunning_Error(t *testing.T) {
+	mockRotationService := new(MockMasterKeyRotationService)
+
+	handler := NewMasterKeyHandler(mockRotationService)
+
+	mockRotationService.
+		On("Rotate", mock.Anything).
+		Return(nil, errors.New("master key rotation is still running"))
+
+	w := httptest.NewRecorder()
+	req, _ := http.NewRequest("POST", "/master-key/rotations", nil)
+
+	c, _ := gin.CreateTestContext(w)
+	c.Request = req
+
+	handler.Rotate(c)
+
+	assert.Equal(t, http.StatusConflict, w.Code)
+	mockRotationService.AssertExpectations(t)
+}
+
+func TestMasterKeyHandler_Rotate_Forbidden(t *testing.T) {
+	mockRotationService := new(MockMasterKeyRotationService)
+
+	handler := NewMasterKeyHandler(mockRotationService)
+
+	mockRotationService.
+		On("Rotate", mock.Anything).
+		Return(nil, auth.ErrForbidden)
+
+	w := httptest.NewRecorder()
+	req, _ := http.NewRequest("POST", "/master-key/rotations", nil)
+
+	c, _ := gin.CreateTestContext(w)
+	c.Request = req
+
+	handler.Rotate(c)
+
+	assert.Equal(t, http.StatusForbidden, w.Code)
+	mockRotationService.AssertExpectations(t)
+}
+
+func TestMasterKeyHandler_GetRotationByID(t *testing.T) {
+	mockRotationService := new(MockMasterKeyRotationService)
+
+	handler := NewMasterKeyHandler(mockRotationService)
+
+	rotation := &keys.MasterKeyRotation{
+		ID:               "rotation-123",
+		TargetKEKVersion: 2,
+		Status:           "completed",
+		Offset:           4,
+		RewrappedKeys:    4,
+		DateTimeStarted:  time.Now(),
+		DateTimeUpdated:  time.Now(),
+	}
+
+	mockRotationService.
+		On("GetByID", mock.Anything, "rotation-123").
+		Return(rotation, nil)
+
+	w := httptest.NewRecorder()
+	req, _ := http.NewRequest("GET", "/master-key/rotations/rotation-123", nil)
+
+	c, _ := gin.CreateTestContext(w)
+	c.Request = req
+	c.Params = gin.Params{gin.Param{Key: "id", Value: "rotation-123"}}
+
+	handler.GetRotationByID(c)
+
+	assert.Equal(t, http.StatusOK, w.Code)
+	assert.Contains(t, w.Body.String(), `"status":"completed"`)
+	assert.Contains(t, w.Body.String(), `"rewrappedKeys":4`)
+	mockRotationService.AssertExpectations(t)
+}
+
+func TestPKCS11Handler_ListTokenSlots(t *testing.T) {
+	mockPKCS11AdminService := new(MockPKCS11AdminService)
+
+	handler := NewPKCS11Handler(mockPKCS11AdminService)
+
+	mockPKCS11AdminService.
+		On("ListTokenSlots", mock.Anything).
+		Return([]*pkcs11.Token{{SlotID: "0x1", Label: "VaultKeys", Manufacturer: "SoftHSM project", Model: "SoftHSM v2", SerialNumber: "1234"}}, nil)
+
+	w := httptest.NewRecorder()
+	req, _ := http.NewRequest("GET", "/pkcs11/slots", nil)
+
+	c, _ := gin.CreateTestContext(w)
+	c.Request = req
+
+	handler.ListTokenSlots(c)
+
+	assert.Equal(t, http.StatusOK, w.Code)
+	assert.Contains(t, w.Body.String(), `"slotID":"0x1"`)
+	assert.Contains(t, w.Body.String(), `"label":"VaultKeys"`)
+	mockPKCS11AdminService.AssertExpectations(t)
+}
+
+func TestPKCS11Handler_ListTokenSlots_Forbidden(t *testing.T) {
+	mockPKCS11AdminService := new(MockPKCS11AdminService)
+
+	handler := NewPKCS11Handler(mockPKCS11AdminService)
+
+	mockPKCS11AdminService.
+		On("ListTokenSlots", mock.Anything).
+		Return(nil, auth.ErrForbidden)
+
+	w := httptest.NewRecorder()
+	req, _ := http.NewRequest("GET", "/pkcs11/slots", nil)
+
+	c, _ := gin.CreateTestContext(w)
+	c.Request = req
+
+	handler.ListTokenSlots(c)
+
+	assert.Equal(t, http.StatusForbidden, w.Code)
+	mockPKCS11AdminService.AssertExpectations(t)
+}
+
+func TestPKCS11Handler_AddKey(t *testing.T) {
+	mockPKCS11AdminService := new(MockPKCS11AdminService)
+
+	handler := NewPKCS11Handler(mockPKCS11AdminService)
+
+	mockPKCS11AdminService.
+		On("AddKey", mock.Anything, "VaultKeys", "signing-key", "ECDSA", uint32(256)).
+		Return(nil)
+
+	tests := []struct {
+		name           string
+		requestBody    string
+		expectedStatus int
+	}{
+		{"Valid Key", `{"object_label": "signing-key", "key_type": "ECDSA", "key_size": 256}`, http.StatusCreated},
+		{"Invalid JSON", `{"object_label":`, http.StatusBadRequest},
+		{"Unsupported Key Type", `{"object_label": "signing-key", "key_type": "DSA", "key_size": 2048}`, http.StatusBadRequest},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			w := httptest.NewRecorder()
+			req, _ := http.NewRequest("POST", "/pkcs11/tokens/VaultKeys/keys", bytes.NewBufferString(tt.requestBody))
+			req.Header.Set("Content-Type", "application/json")
+
+			c, _ := gin.CreateTestContext(w)
+			c.Request = req
+			c.Params = gin.Params{gin.Param{Key: "label", Value: "VaultKeys"}}
+
+			handler.AddKey(c)
+
+			assert.Equal(t, tt.expectedStatus, w.Code)
+		})
+	}
+
+	mockPKCS11AdminService.AssertExpectations(t)
+}
+
+func TestPKCS11Handler_DeleteObject(t *testing.T) {
+	mockPKCS11AdminService := new(MockPKCS11AdminService)
+
+	handler := NewPKCS11Handler(mockPKCS11AdminService)
+
+	mockPKCS11AdminService.
+		On("DeleteObject", mock.Anything, "VaultKeys", "data", "pair-1/key-1-symmetric").
+		Return(nil)
+	mockPKCS11AdminService.
+		On("DeleteObject", mock.Anything, "VaultKeys", "privkey", "missing-key").
+		Return(cryptography.ErrObjectNotFound)
+
+	tests := []struct {
+		name           string
+		objectType     string
+		objectLabel    string
+		expectedStatus int
+	}{
+		{"Label With Slash", "data", "/pair-1/key-1-symmetric", http.StatusNoContent},
+		{"Object Not Found", "privkey", "/missing-key", http.StatusNotFound},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			w := httptest.NewRecorder()
+			req, _ := http.NewRequest("DELETE", "/pkcs11/tokens/VaultKeys/objects/"+tt.objectType+tt.objectLabel, nil)
+
+			c, _ := gin.CreateTestContext(w)
+			c.Request = req
+			c.Params = gin.Params{
+				gin.Param{Key: "label", Value: "VaultKeys"},
+				gin.Param{Key: "type", Value: tt.objectType},
+				gin.Param{Key: "objectLabel", Value: tt.objectLabel},
+			}
+
+			handler.DeleteObject(c)
+
+			assert.Equal(t, tt.expectedStatus, w.Code)
+		})
+	}
+
+	mockPKCS11AdminService.AssertExpectations(t)
+}
+
+func TestPKCS11Handler_Operations(t *testing.T) {
+	mockPKCS11AdminService := new(MockPKCS11AdminService)
+
+	handler := NewPKCS11Handler(mockPKCS11AdminService)
+
+	valid := true
+	mockPKCS11AdminService.
+		On("Encrypt", mock.Anything, "VaultKeys", "rsa-key", "RSA", []byte("plaintext")).
+		Return(&pkcs11.OperationResult{Operation: crypto.OperationEncrypt, TokenLabel: "VaultKeys", ObjectLabel: "rsa-key", KeyType: "RSA", Ciphertext: []byte("ciphertext")}, nil)
+	mockPKCS11AdminService.
+		On("Decrypt", mock.Anything, "VaultKeys", "rsa-key", "RSA", []byte("ciphertext")).
+		Return(&pkcs11.OperationResult{Operation: crypto.OperationDecrypt, TokenLabel: "VaultKeys", ObjectLabel: "rsa-key", KeyType: "RSA", Plaintext: []byte("plaintext")}, nil)
+	mockPKCS11AdminService.
+		On("Sign", mock.Anything, "VaultKeys", "rsa-key", "RSA", []byte("data")).
+		Return(&pkcs11.OperationResult{Operation: crypto.OperationSign, TokenLabel: "VaultKeys", ObjectLabel: "rsa-key", KeyType: "RSA", Signature: []byte("signature")}, nil)
+	mockPKCS11AdminService.
+		On("Verify", mock.Anything, "VaultKeys", "rsa-key", "RSA", []byte("data"), []byte("signature")).
+		Return(&pkcs11.OperationResult{Operation: crypto.OperationVerify, TokenLabel: "VaultKeys", ObjectLabel: "rsa-key", KeyType: "RSA", Valid: &valid}, nil)
+
+	tests := []struct {
+		name     string
+		handle   func(ctx *gin.Context)
+		request  PKCS11OperationRequest
+		expected PKCS11OperationResponse
+	}{
+		{"Encrypt", handler.Encrypt, PKCS11OperationRequest{ObjectLabel: "rsa-key", KeyType: "RSA", Payload: []byte("plaintext")}, PKCS11OperationResponse{Operation: "encrypt", Ciphertext: []byte("ciphertext")}},
+		{"Decrypt", handler.Decrypt, PKCS11OperationRequest{ObjectLabel: "rsa-key", KeyType: "RSA", Payload: []byte("ciphertext")}, PKCS11OperationResponse{Operation: "decrypt", Plaintext: []byte("plaintext")}},
+		{"Sign", handler.Sign, PKCS11OperationRequest{ObjectLabel: "rsa-key", KeyType: "RSA", Payload: []byte("data")}, PKCS11OperationResponse{Operation: "sign", Signature: []byte("signature")}},
+		{"Verify", handler.Verify, PKCS11OperationRequest{ObjectLabel: "rsa-key", KeyType: "RSA", Payload: []byte("data"), Signature: []byte("signature")}, PKCS11OperationResponse{Operation: "verify", Valid: &valid}},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			requestBody, err := json.Marshal(tt.request)
+			require.NoError(t, err)
+
+			w := httptest.NewRecorder()
+			req, _ := http.NewRequest("POST", "/pkcs11/tokens/VaultKeys/"+tt.expected.Operation, bytes.NewBuffer(requestBody))
+			req.Header.Set("Content-Type", "application/json")
+
+			c, _ := gin.CreateTestContext(w)
+			c.Request = req
+			c.Params = gin.Params{gin.Param{Key: "label", Value: "VaultKeys"}}
+
+			tt.handle(c)
+
+			assert.Equal(t, http.StatusOK, w.Code)
+
+			var response PKCS11OperationResponse
+			require.NoError(t, json.Unmarshal(w.Body.Bytes(), &response))
+			assert.Equal(t, "VaultKeys", response.TokenLabel)
+			assert.Equal(t, "rsa-key", response.ObjectLabel)
+			assert.Equal(t, tt.expected.Operation, response.Operation)
+			assert.Equal(t, tt.expected.Ciphertext, response.Ciphertext)
+			assert.Equal(t, tt.expected.Plaintext, response.Plaintext)
+			assert.Equal(t, tt.expected.Signature, response.Signature)
+			assert.Equal(t, tt.expected.Valid, response.Valid)
+		})
+	}
+
+	mockPKCS11AdminService.AssertExpectations(t)
+}
@@ -1,25 +1,112 @@
 package v1
 
-// TBD
-// import (
-// 	"net/http"
-
-// 	"github.com/gin-gonic/gin"
-// )
-
-// // AuthMiddleware is a simple authentication middleware
-// func AuthMiddleware() gin.HandlerFunc {
-// 	return func(c *gin.Context) {
-// 		// Example authentication logic
-// 		token := c.GetHeader("Authorization")
-// 		if token == "" {
-// 			c.JSON(http.StatusUnauthorized, gin.H{"error": "Authorization token required"})
-// 			c.Abort()
-// 			return
-// 		}
-
-// 		// Optionally, validate the token here
-
-// 		c.Next()
-// 	}
-// }
+import (
+	"crypto_vault_service/internal/domain/auth"
+	"crypto_vault_service/internal/domain/blobs"
+	"errors"
+	"log"
+	"net/http"
+	"strings"
+
+	"github.com/gin-gonic/gin"
+)
+
+// bearerPrefix is the scheme prefix of the Authorization header carrying a JWT
+const bearerPrefix = "Bearer "
+
+// AuthMiddleware authenticates requests by the JWT in the Authorization header.
+// The identity of the token's subject is stored in the request context, which the services read the caller identity from.
+func AuthMiddleware(tokenValidator auth.TokenValidator) gin.HandlerFunc {
+	return func(ctx *gin.Context) {
+		header := ctx.GetHeader("Authorization")
+		if len(header) < len(bearerPrefix) || !strings.EqualFold(header[:len(bearerPrefix)], bearerPrefix) {
+			abortUnauthorized(ctx, "authorization bearer token required")
+			return
+		}
+
+		identity, err := tokenValidator.Validate(ctx.Request.Context(), strings.TrimSpace(header[len(bearerPrefix):]))
+		if err != nil {
+			abortUnauthorized(ctx, "invalid authorization token")
+			return
+		}
+
+		ctx.Request = ctx.Request.WithContext(auth.WithIdentity(ctx.Request.Context(), identity))
+		ctx.Next()
+	}
+}
+
+// blobLinkKey is the gin context key of the pre-signed link a download was authenticated by
+const blobLinkKey = "blobLink"
+
+// BlobLinkMiddleware authenticates blob downloads by the token of a pre-signed link passed as token query parameter,
+// which permits handing download URLs to browsers without exposing bearer tokens. Requests without a token fall back to the AuthMiddleware.
+// Downloads by link are performed with the user ID of the link's issuer alone, so the issuer's own access to the blob is still enforced.
+// Single-use links are claimed when redeemed and released again unless the download has been written completely.
+func BlobLinkMiddleware(blobLinkService blobs.BlobLinkService, tokenValidator auth.TokenValidator) gin.HandlerFunc {
+	authMiddleware := AuthMiddleware(tokenValidator)
+
+	return func(ctx *gin.Context) {
+		token := ctx.Query("token")
+		if token == "" {
+			authMiddleware(ctx)
+			return
+		}
+
+		link, err := blobLinkService.Redeem(ctx.Request.Context(), ctx.Param("id"), token)
+		if err != nil {
+			var errorResponse ErrorResponse
+			errorResponse.Message = "invalid, expired or revoked link token"
+			ctx.AbortWithStatusJSON(http.StatusUnauthorized, errorResponse)
+			return
+		}
+
+		ctx.Set(blobLinkKey, link)
+		ctx.Request = ctx.Request.WithContext(auth.WithUserID(ctx.Request.Context(), link.UserID))
+		ctx.Next()
+
+		// Streaming errors are recorded in ctx.Errors, as the status has already been sent
+		if ctx.Writer.Status() != http.StatusOK || len(ctx.Errors) > 0 {
+			if err := blobLinkService.Release(ctx.Request.Context(), link); err != nil {
+				log.Printf("warning: failed to release link %s: %v\n", link.ID, err)
+			}
+		}
+	}
+}
+
+// blobLinkFromContext returns the pre-signed link the request was authenticated by, if any
+func blobLinkFromContext(ctx *gin.Context) (*blobs.BlobLink, bool) {
+	value, ok := ctx.Get(blobLinkKey)
+	if !ok {
+		return nil, false
+	}
+	link, ok := value.(*blobs.BlobLink)
+	return link, ok
+}
+
+// abortUnauthorized stops the request with a 401 response challenging the client for a bearer token
+func abortUnauthorized(ctx *gin.Context, message string) {
+	var errorResponse ErrorResponse
+	errorResponse.Message = message
+	ctx.Header("WWW-Authenticate", `Bearer realm="crypto-vault-service"`)
+	ctx.AbortWithStatusJSON(http.StatusUnauthorized, errorResponse)
+}
+
+// authErrorStatus returns the status code of errors caused by the caller's identity, or status for any other error
+func authErrorStatus(err error, status int) int {
+	switch {
+	case errors.Is(err, auth.ErrUnauthenticated):
+		return http.StatusUnauthorized
+	case errors.Is(err, auth.ErrForbidden):
+		return http.StatusForbidden
+	default:
+		return status
+	}
+}
+
+// authErrorMessage returns the message of errors caused by the caller's identity, or message for any other error
+func authErrorMessage(err error, message string) string {
+	if errors.Is(err, auth.ErrUnauthenticated) || errors.Is(err, auth.ErrForbidden) {
+		return err.Error()
+	}
+	return message
+}
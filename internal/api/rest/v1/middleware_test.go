@@ -0,0 +1,136 @@
+//go:build unit
+// +build unit
+
+package v1
+
+import (
+	"crypto_vault_service/internal/domain/auth"
+	"crypto_vault_service/internal/domain/blobs"
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/gin-gonic/gin"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+)
+
+func TestAuthMiddleware(t *testing.T) {
+	mockTokenValidator := new(MockTokenValidator)
+	mockTokenValidator.On("Validate", mock.Anything, "valid-token").Return(&auth.Identity{UserID: "user-1"}, nil)
+	mockTokenValidator.On("Validate", mock.Anything, "admin-token").Return(&auth.Identity{UserID: "admin-1", Admin: true}, nil)
+	mockTokenValidator.On("Validate", mock.Anything, mock.Anything).Return(nil, errors.New("invalid token"))
+
+	r := gin.New()
+	r.ContextWithFallback = true
+	r.Use(AuthMiddleware(mockTokenValidator))
+	r.GET("/whoami", func(ctx *gin.Context) {
+		identity, err := auth.IdentityFromContext(ctx)
+		if err != nil {
+			ctx.Status(http.StatusInternalServerError)
+			return
+		}
+		if identity.Admin {
+			ctx.String(http.StatusOK, identity.UserID+" (admin)")
+			return
+		}
+		ctx.String(http.StatusOK, identity.UserID)
+	})
+
+	tests := []struct {
+		name           string
+		authorization  string
+		expectedStatus int
+		expectedBody   string
+	}{
+		{"Valid Token", "Bearer valid-token", http.StatusOK, "user-1"},
+		{"Case Insensitive Scheme", "bearer valid-token", http.StatusOK, "user-1"},
+		{"Admin Token", "Bearer admin-token", http.StatusOK, "admin-1 (admin)"},
+		{"Missing Header", "", http.StatusUnauthorized, ""},
+		{"Other Scheme", "Basic dXNlcjpwYXNz", http.StatusUnauthorized, ""},
+		{"Invalid Token", "Bearer invalid-token", http.StatusUnauthorized, ""},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			req, _ := http.NewRequest(http.MethodGet, "/whoami", nil)
+			if tt.authorization != "" {
+				req.Header.Set("Authorization", tt.authorization)
+			}
+			w := httptest.NewRecorder()
+
+			r.ServeHTTP(w, req)
+
+			assert.Equal(t, tt.expectedStatus, w.Code)
+			if tt.expectedStatus == http.StatusOK {
+				assert.Equal(t, tt.expectedBody, w.Body.String())
+			} else {
+				assert.Contains(t, w.Header().Get("WWW-Authenticate"), "Bearer")
+			}
+		})
+	}
+}
+
+func TestBlobLinkMiddleware(t *testing.T) {
+	mockTokenValidator := new(MockTokenValidator)
+	mockTokenValidator.On("Validate", mock.Anything, "valid-token").Return(&auth.Identity{UserID: "user-1"}, nil)
+	mockTokenValidator.On("Validate", mock.Anything, mock.Anything).Return(nil, errors.New("invalid token"))
+
+	mockLinkService := new(MockBlobLinkService)
+	mockLinkService.On("Redeem", mock.Anything, "123", "valid-link").Return(&blobs.BlobLink{ID: "link-1", BlobID: "123", UserID: "issuer-1"}, nil)
+	failingLink := &blobs.BlobLink{ID: "link-2", BlobID: "789", UserID: "issuer-1", SingleUse: true}
+	mockLinkService.On("Redeem", mock.Anything, "789", "single-use-link").Return(failingLink, nil)
+	mockLinkService.On("Release", mock.Anything, mock.Anything).Return(nil)
+	mockLinkService.On("Redeem", mock.Anything, mock.Anything, mock.Anything).Return(nil, errors.New("invalid link token signature"))
+
+	r := gin.New()
+	r.ContextWithFallback = true
+	r.GET("/blobs/:id/file", BlobLinkMiddleware(mockLinkService, mockTokenValidator), func(ctx *gin.Context) {
+		identity, err := auth.IdentityFromContext(ctx)
+		if err != nil || ctx.Param("id") == "789" {
+			ctx.Status(http.StatusInternalServerError)
+			return
+		}
+		if link, ok := blobLinkFromContext(ctx); ok {
+			ctx.String(http.StatusOK, identity.UserID+" via "+link.ID)
+			return
+		}
+		ctx.String(http.StatusOK, identity.UserID)
+	})
+
+	tests := []struct {
+		name           string
+		url            string
+		authorization  string
+		expectedStatus int
+		expectedBody   string
+	}{
+		{"Valid Link", "/blobs/123/file?token=valid-link", "", http.StatusOK, "issuer-1 via link-1"},
+		{"Link Of Other Blob", "/blobs/456/file?token=valid-link", "", http.StatusUnauthorized, ""},
+		{"Failed Download", "/blobs/789/file?token=single-use-link", "", http.StatusInternalServerError, ""},
+		{"Invalid Link", "/blobs/123/file?token=forged-link", "Bearer valid-token", http.StatusUnauthorized, ""},
+		{"Bearer Token", "/blobs/123/file", "Bearer valid-token", http.StatusOK, "user-1"},
+		{"Missing Credentials", "/blobs/123/file", "", http.StatusUnauthorized, ""},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			req, _ := http.NewRequest(http.MethodGet, tt.url, nil)
+			if tt.authorization != "" {
+				req.Header.Set("Authorization", tt.authorization)
+			}
+			w := httptest.NewRecorder()
+
+			r.ServeHTTP(w, req)
+
+			assert.Equal(t, tt.expectedStatus, w.Code)
+			if tt.expectedStatus == http.StatusOK {
+				assert.Equal(t, tt.expectedBody, w.Body.String())
+			}
+		})
+	}
+	// Links are released only after failed downloads
+	mockLinkService.AssertCalled(t, "Release", mock.Anything, failingLink)
+	mockLinkService.AssertNotCalled(t, "Release", mock.Anything, mock.MatchedBy(func(link *blobs.BlobLink) bool { return link.ID == "link-1" }))
+}
@@ -1,36 +1,98 @@
 package v1
 
 import (
+	"crypto_vault_service/internal/domain/auth"
 	"crypto_vault_service/internal/domain/blobs"
+	"crypto_vault_service/internal/domain/crypto"
 	"crypto_vault_service/internal/domain/keys"
+	"crypto_vault_service/internal/domain/pkcs11"
 
 	"github.com/gin-gonic/gin"
 )
 
 // SetupRoutes sets up all the API routes for version 1.
+// All routes require a JWT validated by the token validator, which identifies the caller.
+// Blob downloads alternatively accept the token of a pre-signed link verified by the blob link service.
 func SetupRoutes(r *gin.Engine,
 	blobUploadService blobs.BlobUploadService,
 	blobDownloadService blobs.BlobDownloadService,
 	blobMetadataService blobs.BlobMetadataService,
+	blobGrantService blobs.BlobGrantService,
+	blobLinkService blobs.BlobLinkService,
 	cryptoKeyUploadService keys.CryptoKeyUploadService,
 	cryptoKeyDownloadService keys.CryptoKeyDownloadService,
-	cryptoKeyMetadataService keys.CryptoKeyMetadataService) {
+	cryptoKeyMetadataService keys.CryptoKeyMetadataService,
+	cryptoKeyRotationService keys.CryptoKeyRotationService,
+	cryptoKeyImportService keys.CryptoKeyImportService,
+	masterKeyRotationService keys.MasterKeyRotationService,
+	cryptoOperationService crypto.CryptoOperationService,
+	pkcs11AdminService pkcs11.PKCS11AdminService,
+	tokenValidator auth.TokenValidator) {
 
-	v1 := r.Group(BasePath) // lookup in version file
+	// Handlers pass the gin context to the services, which must resolve the caller identity stored in the request context
+	r.ContextWithFallback = true
+
+	blobHandler := NewBlobHandler(blobUploadService, blobDownloadService, blobMetadataService, blobGrantService, blobLinkService, cryptoKeyUploadService)
+
+	// Downloads authenticated by a pre-signed link or a JWT
+	linkable := r.Group(BasePath) // lookup in version file
+	linkable.GET("/blobs/:id/file", BlobLinkMiddleware(blobLinkService, tokenValidator), blobHandler.DownloadByID)
+
+	v1 := r.Group(BasePath)
+	v1.Use(AuthMiddleware(tokenValidator))
 
 	// Blobs Routes
-	blobHandler := NewBlobHandler(blobUploadService, blobDownloadService, blobMetadataService, cryptoKeyUploadService)
 	v1.POST("/blobs", blobHandler.Upload)
 	v1.GET("/blobs", blobHandler.ListMetadata)
 	v1.GET("/blobs/:id", blobHandler.GetMetadataByID)
-	v1.GET("/blobs/:id/file", blobHandler.DownloadByID)
+	v1.POST("/blobs/:id/verify", blobHandler.VerifyByID)
 	v1.DELETE("/blobs/:id", blobHandler.DeleteByID)
+	v1.POST("/blobs/:id/grants", blobHandler.CreateGrant)
+	v1.GET("/blobs/:id/grants", blobHandler.ListGrants)
+	v1.DELETE("/blobs/:id/grants/:granteeType/:granteeId", blobHandler.DeleteGrant)
+	v1.POST("/blobs/:id/links", blobHandler.CreateLink)
+	v1.DELETE("/blobs/:id/links/:linkId", blobHandler.RevokeLink)
 
 	// Keys Routes
-	keyHandler := NewKeyHandler(cryptoKeyUploadService, cryptoKeyDownloadService, cryptoKeyMetadataService)
+	keyHandler := NewKeyHandler(cryptoKeyUploadService, cryptoKeyDownloadService, cryptoKeyMetadataService, cryptoKeyRotationService, cryptoKeyImportService)
 	v1.POST("/keys", keyHandler.UploadKeys)
+	v1.POST("/keys/import", keyHandler.ImportKeys)
+	v1.GET("/keys/import-key", keyHandler.GetImportPublicKey)
 	v1.GET("/keys", keyHandler.ListMetadata)
 	v1.GET("/keys/:id", keyHandler.GetMetadataByID)
 	v1.GET("/keys/:id/file", keyHandler.DownloadByID)
+	v1.POST("/keys/:id/export", keyHandler.ExportWrappedByID)
+	v1.PATCH("/keys/:id/lifecycle", keyHandler.UpdateLifecycle)
+	v1.PUT("/keys/:id/export-policy", keyHandler.UpdateExportPolicy)
 	v1.DELETE("/keys/:id", keyHandler.DeleteByID)
+	v1.DELETE("/keys/:id/destruction", keyHandler.CancelDestruction)
+	v1.POST("/keys/:id/rotate", keyHandler.Rotate)
+	v1.GET("/keys/:id/versions", keyHandler.ListVersions)
+	v1.PUT("/keys/:id/rotation-policy", keyHandler.UpdateRotationPolicy)
+
+	// Crypto Routes, performing operations with keys that never leave the service
+	cryptoHandler := NewCryptoHandler(cryptoOperationService)
+	v1.POST("/keys/:id/encrypt", cryptoHandler.Encrypt)
+	v1.POST("/keys/:id/decrypt", cryptoHandler.Decrypt)
+	v1.POST("/keys/:id/sign", cryptoHandler.Sign)
+	v1.POST("/keys/:id/verify", cryptoHandler.Verify)
+	v1.POST("/keys/:id/wrap", cryptoHandler.Wrap)
+	v1.POST("/keys/:id/unwrap", cryptoHandler.Unwrap)
+
+	// Master Key Routes
+	masterKeyHandler := NewMasterKeyHandler(masterKeyRotationService)
+	v1.POST("/master-key/rotations", masterKeyHandler.Rotate)
+	v1.GET("/master-key/rotations/:id", masterKeyHandler.GetRotationByID)
+
+	// PKCS#11 Routes, administering the tokens of the configured module. Restricted to admins
+	pkcs11Handler := NewPKCS11Handler(pkcs11AdminService)
+	v1.GET("/pkcs11/slots", pkcs11Handler.ListTokenSlots)
+	v1.POST("/pkcs11/tokens", pkcs11Handler.InitializeToken)
+	v1.GET("/pkcs11/tokens/:label/objects", pkcs11Handler.ListObjects)
+	v1.POST("/pkcs11/tokens/:label/keys", pkcs11Handler.AddKey)
+	v1.DELETE("/pkcs11/tokens/:label/objects/:type/*objectLabel", pkcs11Handler.DeleteObject)
+	v1.POST("/pkcs11/tokens/:label/encrypt", pkcs11Handler.Encrypt)
+	v1.POST("/pkcs11/tokens/:label/decrypt", pkcs11Handler.Decrypt)
+	v1.POST("/pkcs11/tokens/:label/sign", pkcs11Handler.Sign)
+	v1.POST("/pkcs11/tokens/:label/verify", pkcs11Handler.Verify)
 }
@@ -4,6 +4,8 @@
 package v1
 
 import (
+	"crypto_vault_service/internal/domain/auth"
+	"errors"
 	"net/http"
 	"net/http/httptest"
 	"testing"
@@ -18,19 +20,29 @@ func TestSetupRoutes(t *testing.T) {
 	mockBlobUploadService := new(MockBlobUploadService)
 	mockBlobDownloadService := new(MockBlobDownloadService)
 	mockBlobMetadataService := new(MockBlobMetadataService)
+	mockBlobGrantService := new(MockBlobGrantService)
+	mockBlobLinkService := new(MockBlobLinkService)
 	mockCryptoKeyUploadService := new(MockCryptoKeyUploadService)
 	mockCryptoKeyDownloadService := new(MockCryptoKeyDownloadService)
 	mockCryptoKeyMetadataService := new(MockCryptoKeyMetadataService)
+	mockCryptoKeyRotationService := new(MockCryptoKeyRotationService)
+	mockCryptoKeyImportService := new(MockCryptoKeyImportService)
+	mockMasterKeyRotationService := new(MockMasterKeyRotationService)
+	mockCryptoOperationService := new(MockCryptoOperationService)
+	mockPKCS11AdminService := new(MockPKCS11AdminService)
+	mockTokenValidator := new(MockTokenValidator)
 
 	// Create Gin engine
 	r := gin.Default()
 
-	mockBlobUploadService.On("Upload", mock.Anything, mock.Anything, mock.Anything, mock.Anything, mock.Anything).
+	mockBlobUploadService.On("Upload", mock.Anything, mock.Anything, mock.Anything, mock.Anything).
 		Return(nil, nil)
 	mockBlobMetadataService.On("List", mock.Anything, mock.Anything).Return(nil, nil)
 	mockBlobMetadataService.On("GetByID", mock.Anything, mock.Anything).Return(nil, nil)
 	mockBlobMetadataService.On("DeleteByID", mock.Anything, mock.Anything).Return(nil, nil)
 	mockBlobDownloadService.On("DownloadByID", mock.Anything, mock.Anything, mock.Anything).Return(nil, nil)
+	mockBlobGrantService.On("List", mock.Anything, mock.Anything).Return(nil, errors.New("not found"))
+	mockBlobLinkService.On("Redeem", mock.Anything, mock.Anything, mock.Anything).Return(nil, errors.New("invalid link token signature"))
 
 	mockCryptoKeyUploadService.
 		On("Upload", mock.Anything, mock.Anything, mock.Anything, mock.Anything).
@@ -45,34 +57,96 @@ func TestSetupRoutes(t *testing.T) {
 		On("DownloadByID", mock.Anything, mock.Anything).
 		Return(nil, nil)
 	mockCryptoKeyMetadataService.
-		On("DeleteByID", mock.Anything, mock.Anything).
-		Return(nil)
+		On("DeleteByID", mock.Anything, mock.Anything, mock.Anything).
+		Return(nil, errors.New("not found"))
+	mockCryptoKeyMetadataService.
+		On("CancelDestruction", mock.Anything, mock.Anything).
+		Return(nil, errors.New("not found"))
+	mockCryptoKeyRotationService.
+		On("ListVersions", mock.Anything, mock.Anything).
+		Return(nil, errors.New("not found"))
+	mockCryptoKeyImportService.
+		On("GetImportPublicKey", mock.Anything).
+		Return([]byte("-----BEGIN PUBLIC KEY-----"), nil)
+	mockMasterKeyRotationService.
+		On("GetByID", mock.Anything, mock.Anything).
+		Return(nil, errors.New("not found"))
+	mockPKCS11AdminService.
+		On("ListTokenSlots", mock.Anything).
+		Return(nil, auth.ErrForbidden)
+	mockTokenValidator.
+		On("Validate", mock.Anything, "valid-token").
+		Return(&auth.Identity{UserID: "user-1"}, nil)
+	mockTokenValidator.
+		On("Validate", mock.Anything, mock.Anything).
+		Return(nil, errors.New("invalid token"))
 
 	// Call SetupRoutes to register routes
-	SetupRoutes(r, mockBlobUploadService, mockBlobDownloadService, mockBlobMetadataService, mockCryptoKeyUploadService, mockCryptoKeyDownloadService, mockCryptoKeyMetadataService)
+	SetupRoutes(r, mockBlobUploadService, mockBlobDownloadService, mockBlobMetadataService, mockBlobGrantService, mockBlobLinkService, mockCryptoKeyUploadService, mockCryptoKeyDownloadService, mockCryptoKeyMetadataService, mockCryptoKeyRotationService, mockCryptoKeyImportService, mockMasterKeyRotationService, mockCryptoOperationService, mockPKCS11AdminService, mockTokenValidator)
 
 	// Define test cases for different routes
 	tests := []struct {
 		method         string
 		url            string
+		token          string
 		expectedStatus int
 	}{
-		{"POST", "/api/v1/cvs/blobs", http.StatusBadRequest},
+		{"POST", "/api/v1/cvs/blobs", "", http.StatusUnauthorized},
+		{"POST", "/api/v1/cvs/blobs", "invalid-token", http.StatusUnauthorized},
+		{"POST", "/api/v1/cvs/blobs", "valid-token", http.StatusBadRequest},
 		// {"GET", "/api/v1/cvs/blobs", http.StatusBadRequest},
 		// {"GET", "/api/v1/cvs/blobs/123", http.StatusBadRequest},
 		// {"GET", "/api/v1/cvs/blobs/123/file", http.StatusBadRequest},
 		// {"DELETE", "/api/v1/cvs/blobs/123", http.StatusNoContent},
-		{"POST", "/api/v1/cvs/keys", http.StatusBadRequest},
+		{"POST", "/api/v1/cvs/blobs/123/grants", "valid-token", http.StatusBadRequest},
+		{"GET", "/api/v1/cvs/blobs/123/grants", "", http.StatusUnauthorized},
+		{"GET", "/api/v1/cvs/blobs/123/grants", "valid-token", http.StatusNotFound},
+		{"POST", "/api/v1/cvs/blobs/123/links", "", http.StatusUnauthorized},
+		{"POST", "/api/v1/cvs/blobs/123/links", "valid-token", http.StatusBadRequest},
+		{"GET", "/api/v1/cvs/blobs/123/file", "", http.StatusUnauthorized},
+		{"GET", "/api/v1/cvs/blobs/123/file?token=forged-link", "", http.StatusUnauthorized},
+		{"POST", "/api/v1/cvs/keys", "valid-token", http.StatusBadRequest},
+		{"POST", "/api/v1/cvs/keys/import", "", http.StatusUnauthorized},
+		{"POST", "/api/v1/cvs/keys/import", "valid-token", http.StatusBadRequest},
+		{"GET", "/api/v1/cvs/keys/import-key", "valid-token", http.StatusOK},
 		// {"GET", "/api/v1/cvs/keys", http.StatusOK},
 		// {"GET", "/api/v1/cvs/keys/123", http.StatusOK},
 		// {"GET", "/api/v1/cvs/keys/123/file", http.StatusOK},
 		// {"DELETE", "/api/v1/cvs/keys/123", http.StatusNoContent},
+		{"DELETE", "/api/v1/cvs/keys/123?force=maybe", "valid-token", http.StatusBadRequest},
+		{"DELETE", "/api/v1/cvs/keys/123/destruction", "", http.StatusUnauthorized},
+		{"DELETE", "/api/v1/cvs/keys/123/destruction", "valid-token", http.StatusNotFound},
+		{"PATCH", "/api/v1/cvs/keys/123/lifecycle", "", http.StatusUnauthorized},
+		{"PATCH", "/api/v1/cvs/keys/123/lifecycle", "valid-token", http.StatusBadRequest},
+		{"POST", "/api/v1/cvs/keys/123/rotate", "", http.StatusUnauthorized},
+		{"GET", "/api/v1/cvs/keys/123/versions", "valid-token", http.StatusNotFound},
+		{"PUT", "/api/v1/cvs/keys/123/rotation-policy", "valid-token", http.StatusBadRequest},
+		{"POST", "/api/v1/cvs/keys/123/export", "", http.StatusUnauthorized},
+		{"POST", "/api/v1/cvs/keys/123/export", "valid-token", http.StatusBadRequest},
+		{"PUT", "/api/v1/cvs/keys/123/export-policy", "valid-token", http.StatusBadRequest},
+		{"POST", "/api/v1/cvs/keys/123/encrypt", "", http.StatusUnauthorized},
+		{"POST", "/api/v1/cvs/keys/123/decrypt", "valid-token", http.StatusBadRequest},
+		{"POST", "/api/v1/cvs/keys/123/sign", "valid-token", http.StatusBadRequest},
+		{"POST", "/api/v1/cvs/keys/123/verify", "valid-token", http.StatusBadRequest},
+		{"POST", "/api/v1/cvs/keys/123/wrap", "valid-token", http.StatusBadRequest},
+		{"POST", "/api/v1/cvs/keys/123/unwrap", "invalid-token", http.StatusUnauthorized},
+		{"GET", "/api/v1/cvs/master-key/rotations/123", "", http.StatusUnauthorized},
+		{"GET", "/api/v1/cvs/master-key/rotations/123", "valid-token", http.StatusNotFound},
+		{"GET", "/api/v1/cvs/pkcs11/slots", "", http.StatusUnauthorized},
+		{"GET", "/api/v1/cvs/pkcs11/slots", "valid-token", http.StatusForbidden},
+		{"POST", "/api/v1/cvs/pkcs11/tokens", "valid-token", http.StatusBadRequest},
+		{"POST", "/api/v1/cvs/pkcs11/tokens/VaultKeys/keys", "valid-token", http.StatusBadRequest},
+		{"POST", "/api/v1/cvs/pkcs11/tokens/VaultKeys/sign", "", http.StatusUnauthorized},
+		{"POST", "/api/v1/cvs/pkcs11/tokens/VaultKeys/verify", "valid-token", http.StatusBadRequest},
 	}
 
 	for _, tt := range tests {
-		t.Run(tt.method+" "+tt.url, func(t *testing.T) {
+		t.Run(tt.method+" "+tt.url+" "+tt.token, func(t *testing.T) {
 			// Create a request
 			req, _ := http.NewRequest(tt.method, tt.url, nil)
+			if tt.token != "" {
+				req.Header.Set("Authorization", "Bearer "+tt.token)
+			}
 			w := httptest.NewRecorder()
 
 			// Simulate the request
@@ -0,0 +1,190 @@
+package services
+
+import (
+	"context"
+	"crypto_vault_service/internal/domain/blobs"
+	"crypto_vault_service/internal/domain/permissions"
+	"crypto_vault_service/internal/infrastructure/logger"
+	"fmt"
+	"time"
+)
+
+// blobGrantService implements the BlobGrantService interface for sharing blobs by relationship tuples.
+// View grants relate the grantee as viewer and download grants as grantee to the blob.
+// Groups are related by the userset of their members, which callers join through the groups claim of their token.
+type blobGrantService struct {
+	blobRepository       blobs.BlobRepository
+	permissionManagement permissions.PermissionManagement
+	logger               logger.Logger
+}
+
+// NewBlobGrantService creates a new instance of BlobGrantService
+func NewBlobGrantService(blobRepository blobs.BlobRepository, permissionManagement permissions.PermissionManagement, logger logger.Logger) (blobs.BlobGrantService, error) {
+	return &blobGrantService{
+		blobRepository:       blobRepository,
+		permissionManagement: permissionManagement,
+		logger:               logger,
+	}, nil
+}
+
+// Create shares a blob with the grantee, provided the caller may share the blob.
+// Any grant the grantee already holds for the blob is replaced, so changing the access or expiry of a grant is done by creating it again.
+// The new grant is written before the replaced one is deleted, so the grantee never loses access in between.
+// Download grants of encrypted blobs permit decrypting the blob with the key pair it was encrypted with, but no other use of the key.
+func (s *blobGrantService) Create(ctx context.Context, grant *blobs.BlobGrant) (*blobs.BlobGrant, error) {
+	grant.DateTimeCreated = time.Now()
+	if err := grant.Validate(); err != nil {
+		return nil, fmt.Errorf("%w", err)
+	}
+	if grant.DateTimeExpires != nil && !grant.DateTimeExpires.After(grant.DateTimeCreated) {
+		return nil, fmt.Errorf("expiry %s of grant is not in the future", grant.DateTimeExpires.Format(time.RFC3339))
+	}
+
+	if _, err := getAuthorizedBlob(ctx, s.permissionManagement, s.blobRepository, grant.BlobID, permissions.PermissionShareBlob); err != nil {
+		return nil, fmt.Errorf("%w", err)
+	}
+
+	object := permissions.Blob(grant.BlobID)
+	subject := granteeSubject(grant.GranteeType, grant.GranteeID)
+
+	existingTuples, err := s.grantTuples(ctx, object, subject)
+	if err != nil {
+		return nil, fmt.Errorf("%w", err)
+	}
+
+	relation := permissions.RelationViewer
+	if grant.Access == blobs.AccessDownload {
+		relation = permissions.RelationGrantee
+	}
+	tuple := permissions.NewRelationTuple(subject, relation, object)
+	tuple.DateTimeCreated = grant.DateTimeCreated
+	tuple.DateTimeExpires = grant.DateTimeExpires
+
+	if err := s.permissionManagement.WriteTuples(ctx, []*permissions.RelationTuple{tuple}); err != nil {
+		return nil, fmt.Errorf("failed to create grant of %s %s: %w", grant.GranteeType, grant.GranteeID, err)
+	}
+
+	// A tuple of the same relation was updated in place, only tuples of the other relation are replaced
+	var replacedTuples []*permissions.RelationTuple
+	for _, existingTuple := range existingTuples {
+		if existingTuple.Relation != relation {
+			replacedTuples = append(replacedTuples, existingTuple)
+		}
+	}
+	if err := s.permissionManagement.DeleteTuples(ctx, replacedTuples); err != nil {
+		return nil, fmt.Errorf("failed to replace grant of %s %s: %w", grant.GranteeType, grant.GranteeID, err)
+	}
+
+	s.logger.Info(fmt.Sprintf("Shared blob %s with %s %s for %s access", grant.BlobID, grant.GranteeType, grant.GranteeID, grant.Access))
+	return grant, nil
+}
+
+// List retrieves all grants of a blob that have not expired, provided the caller may share the blob
+func (s *blobGrantService) List(ctx context.Context, blobID string) ([]*blobs.BlobGrant, error) {
+	if _, err := getAuthorizedBlob(ctx, s.permissionManagement, s.blobRepository, blobID, permissions.PermissionShareBlob); err != nil {
+		return nil, fmt.Errorf("%w", err)
+	}
+
+	tuples, err := s.permissionManagement.ReadTuples(ctx, &permissions.RelationTupleQuery{Object: permissions.Blob(blobID)})
+	if err != nil {
+		return nil, fmt.Errorf("%w", err)
+	}
+
+	now := time.Now()
+	grants := []*blobs.BlobGrant{}
+	for _, tuple := range tuples {
+		if tuple.Expired(now) {
+			continue
+		}
+
+		grant := newBlobGrant(blobID, tuple)
+		if grant != nil {
+			grants = append(grants, grant)
+		}
+	}
+
+	return grants, nil
+}
+
+// Delete revokes the grant the grantee holds for a blob, provided the caller may share the blob
+func (s *blobGrantService) Delete(ctx context.Context, blobID, granteeType, granteeID string) error {
+	if granteeType != blobs.GranteeTypeUser && granteeType != blobs.GranteeTypeGroup {
+		return fmt.Errorf("invalid grantee type '%s', must be either '%s' or '%s'", granteeType, blobs.GranteeTypeUser, blobs.GranteeTypeGroup)
+	}
+
+	if _, err := getAuthorizedBlob(ctx, s.permissionManagement, s.blobRepository, blobID, permissions.PermissionShareBlob); err != nil {
+		return fmt.Errorf("%w", err)
+	}
+
+	tuples, err := s.grantTuples(ctx, permissions.Blob(blobID), granteeSubject(granteeType, granteeID))
+	if err != nil {
+		return fmt.Errorf("%w", err)
+	}
+	if len(tuples) == 0 {
+		return fmt.Errorf("no grant of %s %s for blob %s", granteeType, granteeID, blobID)
+	}
+
+	if err := s.permissionManagement.DeleteTuples(ctx, tuples); err != nil {
+		return fmt.Errorf("failed to delete grant of %s %s: %w", granteeType, granteeID, err)
+	}
+
+	s.logger.Info(fmt.Sprintf("Revoked grant of %s %s for blob %s", granteeType, granteeID, blobID))
+	return nil
+}
+
+// grantTuples retrieves the tuples granting the subject access to the blob, including expired ones
+func (s *blobGrantService) grantTuples(ctx context.Context, object, subject string) ([]*permissions.RelationTuple, error) {
+	tuples, err := s.permissionManagement.ReadTuples(ctx, &permissions.RelationTupleQuery{Object: object, User: subject})
+	if err != nil {
+		return nil, fmt.Errorf("%w", err)
+	}
+
+	var grantTuples []*permissions.RelationTuple
+	for _, tuple := range tuples {
+		if tuple.Relation == permissions.RelationViewer || tuple.Relation == permissions.RelationGrantee {
+			grantTuples = append(grantTuples, tuple)
+		}
+	}
+	return grantTuples, nil
+}
+
+// granteeSubject returns the user or the userset of the group's members a grant relates to the blob
+func granteeSubject(granteeType, granteeID string) string {
+	if granteeType == blobs.GranteeTypeGroup {
+		return permissions.Userset(permissions.UserGroup(granteeID), permissions.RelationMember)
+	}
+	return permissions.User(granteeID)
+}
+
+// newBlobGrant maps a tuple relating a grantee to a blob back to the grant, or returns nil if the tuple is no grant
+func newBlobGrant(blobID string, tuple *permissions.RelationTuple) *blobs.BlobGrant {
+	var access string
+	switch tuple.Relation {
+	case permissions.RelationViewer:
+		access = blobs.AccessView
+	case permissions.RelationGrantee:
+		access = blobs.AccessDownload
+	default:
+		return nil
+	}
+
+	var granteeType string
+	switch tuple.UserType() {
+	case permissions.TypeUser:
+		granteeType = blobs.GranteeTypeUser
+	case permissions.TypeUserGroup + "#" + permissions.RelationMember:
+		granteeType = blobs.GranteeTypeGroup
+	default:
+		return nil
+	}
+	_, granteeID := permissions.SplitObject(tuple.User)
+
+	return &blobs.BlobGrant{
+		BlobID:          blobID,
+		GranteeType:     granteeType,
+		GranteeID:       granteeID,
+		Access:          access,
+		DateTimeExpires: tuple.DateTimeExpires,
+		DateTimeCreated: tuple.DateTimeCreated,
+	}
+}
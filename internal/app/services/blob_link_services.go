@@ -0,0 +1,330 @@
+package services
+
+import (
+	"context"
+	"crypto/hmac"
+	"crypto/rand"
+	"crypto/sha256"
+	"crypto_vault_service/internal/domain/auth"
+	"crypto_vault_service/internal/domain/blobs"
+	"crypto_vault_service/internal/domain/permissions"
+	"crypto_vault_service/internal/infrastructure/connector"
+	"crypto_vault_service/internal/infrastructure/cryptography"
+	"crypto_vault_service/internal/infrastructure/logger"
+	"encoding/base64"
+	"encoding/json"
+	"fmt"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/google/uuid"
+)
+
+// Parameters of the secret signing blob links, which is stored in the vault like the material of cryptographic keys
+const (
+	blobLinkSigningKeyType      = "hmac"
+	blobLinkSigningKeyAlgorithm = "HMAC-SHA256"
+	blobLinkSigningKeySize      = 256
+)
+
+// blobLinkService implements the BlobLinkService interface for issuing and redeeming pre-signed blob links.
+// Tokens carry the link encoded as JSON followed by its HMAC-SHA256, both base64url encoded and separated by a dot.
+// The signing secret is generated on first use, wrapped with the master key and stored in the vault.
+// Links carry only the user ID of the issuer, whose permissions are checked again on every redemption, so links stop working once the issuer loses access to the blob.
+// Access obtained through the admin role or groups of the issuer's token is not delegated, as it cannot be re-checked without the token.
+type blobLinkService struct {
+	blobRepository       blobs.BlobRepository
+	signingKeyRepository blobs.BlobLinkSigningKeyRepository
+	revocationRepository blobs.BlobLinkRevocationRepository
+	vaultConnector       connector.VaultConnector
+	masterKeyProvider    cryptography.MasterKeyProvider
+	permissionManagement permissions.PermissionManagement
+	signingKey           []byte
+	signingKeyMu         sync.Mutex
+	logger               logger.Logger
+}
+
+// NewBlobLinkService creates a new instance of BlobLinkService
+func NewBlobLinkService(blobRepository blobs.BlobRepository, signingKeyRepository blobs.BlobLinkSigningKeyRepository, revocationRepository blobs.BlobLinkRevocationRepository, vaultConnector connector.VaultConnector, masterKeyProvider cryptography.MasterKeyProvider, permissionManagement permissions.PermissionManagement, logger logger.Logger) (blobs.BlobLinkService, error) {
+	return &blobLinkService{
+		blobRepository:       blobRepository,
+		signingKeyRepository: signingKeyRepository,
+		revocationRepository: revocationRepository,
+		vaultConnector:       vaultConnector,
+		masterKeyProvider:    masterKeyProvider,
+		permissionManagement: permissionManagement,
+		logger:               logger,
+	}, nil
+}
+
+// Create issues a link for downloading a blob, provided the caller may download the blob and use the decryption key if set
+// without relying on its admin role or groups
+func (s *blobLinkService) Create(ctx context.Context, blobID string, decryptionKeyID *string, singleUse bool, validity time.Duration) (*blobs.BlobLink, string, error) {
+	if validity <= 0 || validity > blobs.MaxBlobLinkValidity {
+		return nil, "", fmt.Errorf("validity %s of link must be positive and at most %s", validity, blobs.MaxBlobLinkValidity)
+	}
+
+	identity, err := auth.IdentityFromContext(ctx)
+	if err != nil {
+		return nil, "", fmt.Errorf("%w", err)
+	}
+
+	if err := s.checkIssuerPermissions(ctx, identity.UserID, blobID, decryptionKeyID); err != nil {
+		return nil, "", fmt.Errorf("%w", err)
+	}
+
+	link := &blobs.BlobLink{
+		ID:              uuid.New().String(),
+		BlobID:          blobID,
+		UserID:          identity.UserID,
+		DecryptionKeyID: decryptionKeyID,
+		SingleUse:       singleUse,
+		DateTimeExpires: time.Now().Add(validity).UTC(),
+	}
+	if err := link.Validate(); err != nil {
+		return nil, "", fmt.Errorf("%w", err)
+	}
+
+	token, err := s.sign(ctx, link)
+	if err != nil {
+		return nil, "", fmt.Errorf("%w", err)
+	}
+
+	s.logger.Info(fmt.Sprintf("Issued link %s for blob %s expiring at %s", link.ID, blobID, link.DateTimeExpires.Format(time.RFC3339)))
+	return link, token, nil
+}
+
+// Redeem verifies the token of a link and the current permissions of its issuer and returns the link.
+// Single-use links are claimed by recording a revocation, which fails for all but one of concurrent redemptions.
+// Release lifts the claim again once the download failed, so failed downloads do not use them up.
+func (s *blobLinkService) Redeem(ctx context.Context, blobID, token string) (*blobs.BlobLink, error) {
+	link, err := s.verify(ctx, token)
+	if err != nil {
+		return nil, fmt.Errorf("%w", err)
+	}
+
+	now := time.Now()
+	if !link.DateTimeExpires.After(now) {
+		return nil, fmt.Errorf("link %s expired at %s", link.ID, link.DateTimeExpires.Format(time.RFC3339))
+	}
+	if link.BlobID != blobID {
+		return nil, fmt.Errorf("link %s was not issued for blob %s", link.ID, blobID)
+	}
+	if err := s.checkIssuerPermissions(ctx, link.UserID, link.BlobID, link.DecryptionKeyID); err != nil {
+		return nil, fmt.Errorf("%w", err)
+	}
+
+	if link.SingleUse {
+		claimed, err := s.revoke(ctx, link.ID, link.BlobID, link.DateTimeExpires, true)
+		if err != nil {
+			return nil, fmt.Errorf("%w", err)
+		}
+		if !claimed {
+			return nil, fmt.Errorf("link %s was already used or revoked", link.ID)
+		}
+		return link, nil
+	}
+
+	revoked, err := s.revocationRepository.Exists(ctx, link.ID)
+	if err != nil {
+		return nil, fmt.Errorf("%w", err)
+	}
+	if revoked {
+		return nil, fmt.Errorf("link %s was already used or revoked", link.ID)
+	}
+
+	return link, nil
+}
+
+// Release lifts the claim of a redeemed single-use link after the download it authorized failed
+func (s *blobLinkService) Release(ctx context.Context, link *blobs.BlobLink) error {
+	if !link.SingleUse {
+		return nil
+	}
+
+	if err := s.revocationRepository.DeleteClaim(ctx, link.ID); err != nil {
+		return fmt.Errorf("%w", err)
+	}
+	return nil
+}
+
+// Revoke adds a link to the revocation list, provided the caller may share the blob.
+// Links are not stored, hence the revocation is kept until any link issued now would have expired.
+func (s *blobLinkService) Revoke(ctx context.Context, blobID, linkID string) error {
+	if _, err := getAuthorizedBlob(ctx, s.permissionManagement, s.blobRepository, blobID, permissions.PermissionShareBlob); err != nil {
+		return fmt.Errorf("%w", err)
+	}
+
+	if _, err := s.revoke(ctx, linkID, blobID, time.Now().Add(blobs.MaxBlobLinkValidity), false); err != nil {
+		return fmt.Errorf("%w", err)
+	}
+
+	return nil
+}
+
+// checkIssuerPermissions returns auth.ErrForbidden unless the issuer of a link, identified by its user ID alone,
+// may download the blob and use the decryption key if set
+func (s *blobLinkService) checkIssuerPermissions(ctx context.Context, issuerID, blobID string, decryptionKeyID *string) error {
+	issuerCtx := auth.WithUserID(ctx, issuerID)
+	if err := checkPermission(issuerCtx, s.permissionManagement, permissions.PermissionDownloadBlob, permissions.Blob(blobID)); err != nil {
+		return fmt.Errorf("%w", err)
+	}
+	if decryptionKeyID != nil {
+		if err := checkPermission(issuerCtx, s.permissionManagement, permissions.PermissionUseKey, permissions.Key(*decryptionKeyID)); err != nil {
+			return fmt.Errorf("%w", err)
+		}
+	}
+	return nil
+}
+
+// revoke records the revocation, or the claim of a single-use link, and reports whether the link was neither revoked nor claimed before.
+// Revocations of expired links are pruned on the way, since expired links are rejected anyway.
+func (s *blobLinkService) revoke(ctx context.Context, linkID, blobID string, expires time.Time, claim bool) (bool, error) {
+	now := time.Now()
+	if err := s.revocationRepository.DeleteExpired(ctx, now); err != nil {
+		return false, fmt.Errorf("%w", err)
+	}
+
+	revoked, err := s.revocationRepository.Create(ctx, &blobs.BlobLinkRevocation{
+		LinkID:          linkID,
+		BlobID:          blobID,
+		DateTimeExpires: expires,
+		DateTimeRevoked: now,
+		Claimed:         claim,
+	})
+	if err != nil {
+		return false, fmt.Errorf("%w", err)
+	}
+
+	return revoked, nil
+}
+
+// sign encodes the link and appends its HMAC
+func (s *blobLinkService) sign(ctx context.Context, link *blobs.BlobLink) (string, error) {
+	signingKey, err := s.getSigningKey(ctx)
+	if err != nil {
+		return "", fmt.Errorf("%w", err)
+	}
+
+	payload, err := json.Marshal(link)
+	if err != nil {
+		return "", fmt.Errorf("failed to encode link: %w", err)
+	}
+
+	encodedPayload := base64.RawURLEncoding.EncodeToString(payload)
+	return encodedPayload + "." + base64.RawURLEncoding.EncodeToString(blobLinkMAC(signingKey, encodedPayload)), nil
+}
+
+// verify checks the HMAC of a token and decodes the link it carries
+func (s *blobLinkService) verify(ctx context.Context, token string) (*blobs.BlobLink, error) {
+	encodedPayload, encodedMAC, ok := strings.Cut(token, ".")
+	if !ok {
+		return nil, fmt.Errorf("malformed link token")
+	}
+
+	mac, err := base64.RawURLEncoding.DecodeString(encodedMAC)
+	if err != nil {
+		return nil, fmt.Errorf("malformed link token signature: %w", err)
+	}
+
+	signingKey, err := s.getSigningKey(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("%w", err)
+	}
+	if !hmac.Equal(mac, blobLinkMAC(signingKey, encodedPayload)) {
+		return nil, fmt.Errorf("invalid link token signature")
+	}
+
+	payload, err := base64.RawURLEncoding.DecodeString(encodedPayload)
+	if err != nil {
+		return nil, fmt.Errorf("malformed link token payload: %w", err)
+	}
+
+	var link blobs.BlobLink
+	if err := json.Unmarshal(payload, &link); err != nil {
+		return nil, fmt.Errorf("malformed link token payload: %w", err)
+	}
+	if err := link.Validate(); err != nil {
+		return nil, fmt.Errorf("%w", err)
+	}
+
+	return &link, nil
+}
+
+// getSigningKey returns the secret signing links, loading it from the vault or generating it on first use.
+// Instances generating a secret concurrently agree on the earliest created one.
+func (s *blobLinkService) getSigningKey(ctx context.Context) ([]byte, error) {
+	s.signingKeyMu.Lock()
+	defer s.signingKeyMu.Unlock()
+
+	if s.signingKey != nil {
+		return s.signingKey, nil
+	}
+
+	signingKeyMeta, err := s.signingKeyRepository.GetFirst(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("%w", err)
+	}
+	if signingKeyMeta == nil {
+		if err := s.createSigningKey(ctx); err != nil {
+			return nil, fmt.Errorf("%w", err)
+		}
+
+		signingKeyMeta, err = s.signingKeyRepository.GetFirst(ctx)
+		if err != nil {
+			return nil, fmt.Errorf("%w", err)
+		}
+	}
+
+	wrappedSigningKey, err := s.vaultConnector.Download(ctx, signingKeyMeta.ID, signingKeyMeta.KeyPairID, blobLinkSigningKeyType)
+	if err != nil {
+		return nil, fmt.Errorf("%w", err)
+	}
+
+	signingKey, err := unwrapStoredKey(s.masterKeyProvider, wrappedSigningKey, signingKeyMeta.KEKVersion, signingKeyMeta.ID, signingKeyMeta.KeyPairID, blobLinkSigningKeyType)
+	if err != nil {
+		return nil, fmt.Errorf("failed to unwrap link signing key with master key: %w", err)
+	}
+
+	s.signingKey = signingKey
+	return signingKey, nil
+}
+
+// createSigningKey generates a random secret, wraps it with the master key, uploads it to the vault and records its location
+func (s *blobLinkService) createSigningKey(ctx context.Context) error {
+	signingKey := make([]byte, blobLinkSigningKeySize/8)
+	if _, err := rand.Read(signingKey); err != nil {
+		return fmt.Errorf("failed to generate link signing key: %w", err)
+	}
+
+	keyID, keyPairID := uuid.New().String(), uuid.New().String()
+	wrappedSigningKey, kekVersion, err := s.masterKeyProvider.Wrap(signingKey, keyID, keyPairID, blobLinkSigningKeyType)
+	if err != nil {
+		return fmt.Errorf("failed to wrap link signing key with master key: %w", err)
+	}
+
+	keyMeta, err := s.vaultConnector.Upload(ctx, wrappedSigningKey, keyID, "", keyPairID, blobLinkSigningKeyType, blobLinkSigningKeyAlgorithm, blobLinkSigningKeySize)
+	if err != nil {
+		return fmt.Errorf("%w", err)
+	}
+
+	if err := s.signingKeyRepository.Create(ctx, &blobs.BlobLinkSigningKey{
+		ID:              keyMeta.ID,
+		KeyPairID:       keyMeta.KeyPairID,
+		KEKVersion:      kekVersion,
+		DateTimeCreated: keyMeta.DateTimeCreated,
+	}); err != nil {
+		return fmt.Errorf("%w", err)
+	}
+
+	s.logger.Info(fmt.Sprintf("Created link signing key %s", keyMeta.ID))
+	return nil
+}
+
+// blobLinkMAC computes the HMAC-SHA256 of the encoded payload of a link token
+func blobLinkMAC(signingKey []byte, encodedPayload string) []byte {
+	mac := hmac.New(sha256.New, signingKey)
+	mac.Write([]byte(encodedPayload))
+	return mac.Sum(nil)
+}
@@ -3,127 +3,313 @@ package services
 import (
 	"bytes"
 	"context"
-	crypto_ec "crypto/ecdsa"
-	"crypto/elliptic"
 	crypto_rsa "crypto/rsa"
+	"crypto/sha256"
 	"crypto/x509"
+	"crypto_vault_service/internal/domain/auth"
 	"crypto_vault_service/internal/domain/blobs"
 	"crypto_vault_service/internal/domain/keys"
+	"crypto_vault_service/internal/domain/permissions"
 	"crypto_vault_service/internal/infrastructure/connector"
 	"crypto_vault_service/internal/infrastructure/cryptography"
 	"crypto_vault_service/internal/infrastructure/logger"
-	"crypto_vault_service/internal/infrastructure/utils"
+	"errors"
 	"fmt"
+	"hash"
 	"io"
 	"log"
-	"math/big"
 	"mime/multipart"
+	"path/filepath"
+	"time"
+
+	"github.com/google/uuid"
 )
 
 // blobUploadService implements the BlobUploadService interface for handling blob uploads
 type blobUploadService struct {
-	blobConnector  connector.BlobConnector
-	blobRepository blobs.BlobRepository
-	vaultConnector connector.VaultConnector
-	cryptoKeyRepo  keys.CryptoKeyRepository
-	logger         logger.Logger
+	blobConnector        connector.BlobConnector
+	blobRepository       blobs.BlobRepository
+	vaultConnector       connector.VaultConnector
+	cryptoKeyRepo        keys.CryptoKeyRepository
+	logicalKeyRepo       keys.LogicalKeyRepository
+	masterKeyProvider    cryptography.MasterKeyProvider
+	permissionManagement permissions.PermissionManagement
+	logger               logger.Logger
 }
 
 // NewBlobUploadService creates a new instance of BlobUploadService
-func NewBlobUploadService(blobConnector connector.BlobConnector, blobRepository blobs.BlobRepository, vaultConnector connector.VaultConnector, cryptoKeyRepo keys.CryptoKeyRepository, logger logger.Logger) (blobs.BlobUploadService, error) {
+func NewBlobUploadService(blobConnector connector.BlobConnector, blobRepository blobs.BlobRepository, vaultConnector connector.VaultConnector, cryptoKeyRepo keys.CryptoKeyRepository, logicalKeyRepo keys.LogicalKeyRepository, masterKeyProvider cryptography.MasterKeyProvider, permissionManagement permissions.PermissionManagement, logger logger.Logger) (blobs.BlobUploadService, error) {
 	return &blobUploadService{
-		blobConnector:  blobConnector,
-		blobRepository: blobRepository,
-		cryptoKeyRepo:  cryptoKeyRepo,
-		vaultConnector: vaultConnector,
-		logger:         logger,
+		blobConnector:        blobConnector,
+		blobRepository:       blobRepository,
+		cryptoKeyRepo:        cryptoKeyRepo,
+		logicalKeyRepo:       logicalKeyRepo,
+		vaultConnector:       vaultConnector,
+		masterKeyProvider:    masterKeyProvider,
+		permissionManagement: permissionManagement,
+		logger:               logger,
 	}, nil
 }
 
 // Upload transfers blobs with the option to encrypt them using an encryption key or sign them with a signing key.
+// File contents are streamed to the blob storage, encrypted ones segment by segment, so blobs are never held in memory as a whole.
+// Signed blobs get a detached signature of their plaintext stored next to them, before encryption is applied.
+// The blobs are owned by the authenticated caller carried by ctx, who must be permitted to use the keys.
+// Blobs are encrypted and signed with the primary version of the logical keys, whose keys are recorded in the blob metadata.
 // It returns a slice of Blob for the uploaded blobs and any error encountered during the upload process.
-func (s *blobUploadService) Upload(ctx context.Context, form *multipart.Form, userID string, encryptionKeyID, signKeyID *string) ([]*blobs.BlobMeta, error) {
-	var newForm *multipart.Form
+func (s *blobUploadService) Upload(ctx context.Context, form *multipart.Form, encryptionKeyID, signKeyID *string) ([]*blobs.BlobMeta, error) {
+	var signKeyBytes, encryptionKeyBytes []byte
+	var signKeyMeta, encryptionKeyMeta *keys.CryptoKeyMeta
+
+	userID, err := auth.UserIDFromContext(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("%w", err)
+	}
 
 	// Process signKeyID if provided
 	if signKeyID != nil {
-		keyBytes, cryptoKeyMeta, err := s.getCryptoKeyAndData(ctx, *signKeyID)
+		signKeyBytes, signKeyMeta, err = s.getCryptoKeyAndData(ctx, *signKeyID)
 		if err != nil {
 			return nil, fmt.Errorf("%w", err)
 		}
+	}
 
-		cryptoOperation := "signing"
-		contents, fileNames, err := s.applyCryptographicOperation(form, cryptoKeyMeta.Algorithm, cryptoOperation, keyBytes, cryptoKeyMeta.KeySize)
+	// Process encryptionKeyID if provided
+	if encryptionKeyID != nil {
+		encryptionKeyBytes, encryptionKeyMeta, err = s.getCryptoKeyAndData(ctx, *encryptionKeyID)
 		if err != nil {
 			return nil, fmt.Errorf("%w", err)
 		}
+	}
 
-		newForm, err = utils.CreateMultipleFilesForm(contents, fileNames)
-		if err != nil {
-			return nil, fmt.Errorf("%w", err)
+	var blobMetas []*blobs.BlobMeta
+	for _, fileHeader := range form.File["files"] {
+		// Blob IDs are assigned upfront, since authenticated encryption binds them to the ciphertexts
+		blobMeta := &blobs.BlobMeta{
+			ID:              uuid.New().String(),
+			Name:            fileHeader.Filename,
+			Type:            filepath.Ext(fileHeader.Filename),
+			DateTimeCreated: time.Now(),
+			UserID:          userID,
+		}
+		if encryptionKeyMeta != nil {
+			blobMeta.EncryptionKeyID = &encryptionKeyMeta.ID
+		}
+		if signKeyMeta != nil {
+			blobMeta.SignKeyID = &signKeyMeta.ID
+		}
+
+		// Signing hashes the plaintext while it is streamed, so the signature covers the content before encryption
+		var digest hash.Hash
+		if signKeyMeta != nil {
+			digest = sha256.New()
+		}
+
+		var uploadErr error
+		if encryptionKeyMeta != nil {
+			uploadErr = s.uploadEncrypted(ctx, fileHeader, blobMeta, digest, encryptionKeyBytes, encryptionKeyMeta)
+		} else {
+			uploadErr = s.upload(ctx, fileHeader, blobMeta, digest, nil)
+		}
+		if uploadErr != nil {
+			s.rollbackUploadedBlobs(ctx, blobMetas)
+			return nil, fmt.Errorf("%w", uploadErr)
+		}
+
+		blobMetas = append(blobMetas, blobMeta)
+
+		if digest != nil {
+			if err := s.uploadSignature(ctx, blobMeta, digest.Sum(nil), signKeyBytes, signKeyMeta); err != nil {
+				s.rollbackUploadedBlobs(ctx, blobMetas)
+				return nil, fmt.Errorf("%w", err)
+			}
 		}
 	}
 
-	// Process encryptionKeyID if provided
-	if encryptionKeyID != nil {
-		keyBytes, cryptoKeyMeta, err := s.getCryptoKeyAndData(ctx, *encryptionKeyID)
+	for i, blobMeta := range blobMetas {
+		// Ownership is recorded first, so stored metadata never lacks the tuples granting access to it
+		err := s.permissionManagement.WriteTuples(ctx, ownershipTuples(blobMeta.UserID, permissions.Blob(blobMeta.ID)))
 		if err != nil {
+			s.rollbackStoredBlobs(ctx, blobMetas[:i])
+			s.rollbackUploadedBlobs(ctx, blobMetas[i:])
 			return nil, fmt.Errorf("%w", err)
 		}
 
-		cryptoOperation := "encryption"
-		contents, fileNames, err := s.applyCryptographicOperation(form, cryptoKeyMeta.Algorithm, cryptoOperation, keyBytes, cryptoKeyMeta.KeySize)
+		err = s.blobRepository.Create(ctx, blobMeta)
 		if err != nil {
+			s.rollbackStoredBlobs(ctx, blobMetas[:i])
+			s.rollbackOwnership(ctx, blobMeta)
+			s.rollbackUploadedBlobs(ctx, blobMetas[i:])
 			return nil, fmt.Errorf("%w", err)
 		}
+	}
+
+	return blobMetas, nil
+}
+
+// upload streams a file to the blob storage, passing its content through the transform if set.
+// The file content is written to the digest if set, before being transformed.
+// The size of the blob meta is set to the number of bytes stored.
+func (s *blobUploadService) upload(ctx context.Context, fileHeader *multipart.FileHeader, blobMeta *blobs.BlobMeta, digest hash.Hash, transform func(io.Reader) (io.ReadCloser, error)) error {
+	file, err := fileHeader.Open()
+	if err != nil {
+		return fmt.Errorf("failed to open file '%s': %w", fileHeader.Filename, err)
+	}
+	defer func() {
+		if err := file.Close(); err != nil {
+			log.Printf("warning: failed to close file: %v\n", err)
+		}
+	}()
 
-		newForm, err = utils.CreateMultipleFilesForm(contents, fileNames)
+	var content io.Reader = file
+	if digest != nil {
+		content = io.TeeReader(content, digest)
+	}
+	if transform != nil {
+		transformed, err := transform(content)
 		if err != nil {
-			return nil, fmt.Errorf("%w", err)
+			return fmt.Errorf("%w", err)
 		}
+		defer func() {
+			if err := transformed.Close(); err != nil {
+				log.Printf("warning: failed to close stream: %v\n", err)
+			}
+		}()
+		content = transformed
+	}
+
+	counter := &countingReader{reader: content}
+	err = s.blobConnector.Upload(ctx, counter, blobMeta.ID, blobMeta.Name)
+	if err != nil {
+		return fmt.Errorf("%w", err)
 	}
+	blobMeta.Size = counter.count
 
-	if signKeyID != nil || encryptionKeyID != nil {
-		blobMetas, err := s.blobConnector.Upload(ctx, newForm, userID, encryptionKeyID, signKeyID)
+	return nil
+}
+
+// uploadEncrypted streams a file to the blob storage while encrypting it as envelope referencing the key pair ID and version.
+// The blob ID and user ID are bound to the ciphertext as associated data.
+func (s *blobUploadService) uploadEncrypted(ctx context.Context, fileHeader *multipart.FileHeader, blobMeta *blobs.BlobMeta, digest hash.Hash, keyBytes []byte, cryptoKeyMeta *keys.CryptoKeyMeta) error {
+	associatedData := blobAssociatedData(blobMeta.ID, blobMeta.UserID)
+
+	var newEncrypter func(dst io.Writer) (io.WriteCloser, error)
+	switch cryptoKeyMeta.Algorithm {
+	case "AES":
+		aesProcessor, err := cryptography.NewAESProcessor(s.logger)
 		if err != nil {
-			return nil, fmt.Errorf("%w", err)
+			return fmt.Errorf("%w", err)
 		}
-
-		for _, blobMeta := range blobMetas {
-			err := s.blobRepository.Create(ctx, blobMeta)
-			if err != nil {
-				return nil, fmt.Errorf("%w", err)
-			}
+		newEncrypter = func(dst io.Writer) (io.WriteCloser, error) {
+			return aesProcessor.EncryptStream(dst, keyBytes, cryptoKeyMeta.KeyPairID, cryptoKeyMeta.Version, associatedData)
+		}
+	case "RSA":
+		rsaProcessor, err := cryptography.NewRSAProcessor(s.logger)
+		if err != nil {
+			return fmt.Errorf("%w", err)
+		}
+		publicKeyInterface, err := x509.ParsePKIXPublicKey(keyBytes)
+		if err != nil {
+			return fmt.Errorf("error parsing public key: %w", err)
+		}
+		publicKey, ok := publicKeyInterface.(*crypto_rsa.PublicKey)
+		if !ok {
+			return fmt.Errorf("public key is not of type RSA")
 		}
-		return blobMetas, nil
+		newEncrypter = func(dst io.Writer) (io.WriteCloser, error) {
+			return rsaProcessor.EncryptStream(dst, publicKey, cryptoKeyMeta.KeyPairID, cryptoKeyMeta.Version, associatedData)
+		}
+	default:
+		return fmt.Errorf("unsupported algorithm: %s", cryptoKeyMeta.Algorithm)
 	}
 
-	blobMetas, err := s.blobConnector.Upload(ctx, form, userID, encryptionKeyID, signKeyID)
+	return s.upload(ctx, fileHeader, blobMeta, digest, func(plainText io.Reader) (io.ReadCloser, error) {
+		return encryptingReader(plainText, newEncrypter), nil
+	})
+}
+
+// uploadSignature signs the SHA-256 digest of a blob's content and stores the detached signature next to the blob
+func (s *blobUploadService) uploadSignature(ctx context.Context, blobMeta *blobs.BlobMeta, digest []byte, keyBytes []byte, cryptoKeyMeta *keys.CryptoKeyMeta) error {
+	signature, err := s.signDigest(ctx, digest, keyBytes, cryptoKeyMeta)
 	if err != nil {
-		return nil, fmt.Errorf("%w", err)
+		return fmt.Errorf("%w", err)
 	}
 
+	signatureName := blobMeta.Name + blobSignatureSuffix
+	err = s.blobConnector.Upload(ctx, bytes.NewReader(signature), blobMeta.ID, signatureName)
+	if err != nil {
+		return fmt.Errorf("failed to upload signature of blob '%s': %w", blobMeta.Name, err)
+	}
+	blobMeta.SignatureName = &signatureName
+
+	return nil
+}
+
+// rollbackStoredBlobs deletes the metadata and ownership tuples of blobs stored before the error occurred along with their uploaded content
+func (s *blobUploadService) rollbackStoredBlobs(ctx context.Context, blobMetas []*blobs.BlobMeta) {
 	for _, blobMeta := range blobMetas {
-		err := s.blobRepository.Create(ctx, blobMeta)
-		if err != nil {
-			return nil, fmt.Errorf("%w", err)
+		if err := s.blobRepository.DeleteByID(ctx, blobMeta.ID); err != nil {
+			s.logger.Info(fmt.Sprintf("Failed to delete metadata of blob '%s' during rollback: %v", blobMeta.Name, err))
 		}
+		s.rollbackOwnership(ctx, blobMeta)
 	}
+	s.rollbackUploadedBlobs(ctx, blobMetas)
+}
 
-	return blobMetas, nil
+// rollbackOwnership deletes the ownership tuples written for a blob before the error occurred
+func (s *blobUploadService) rollbackOwnership(ctx context.Context, blobMeta *blobs.BlobMeta) {
+	if err := s.permissionManagement.DeleteTuples(ctx, ownershipTuples(blobMeta.UserID, permissions.Blob(blobMeta.ID))); err != nil {
+		s.logger.Info(fmt.Sprintf("Failed to delete ownership of blob '%s' during rollback: %v", blobMeta.Name, err))
+	}
 }
 
-// getCryptoKeyAndData retrieves the encryption or signing key along with its metadata by ID.
-// It downloads the key from the vault and returns the key bytes and associated metadata.
+// rollbackUploadedBlobs deletes the blobs that were uploaded successfully before the error occurred
+func (s *blobUploadService) rollbackUploadedBlobs(ctx context.Context, blobMetas []*blobs.BlobMeta) {
+	for _, blobMeta := range blobMetas {
+		err := s.blobConnector.Delete(ctx, blobMeta.ID, blobMeta.Name)
+		if err != nil {
+			s.logger.Info(fmt.Sprintf("Failed to delete blob '%s' during rollback: %v", blobMeta.Name, err))
+		} else {
+			s.logger.Info(fmt.Sprintf("Blob '%s' deleted during rollback", blobMeta.Name))
+		}
+
+		if blobMeta.SignatureName != nil {
+			err := s.blobConnector.Delete(ctx, blobMeta.ID, *blobMeta.SignatureName)
+			if err != nil {
+				s.logger.Info(fmt.Sprintf("Failed to delete signature '%s' during rollback: %v", *blobMeta.SignatureName, err))
+			}
+		}
+	}
+}
+
+// getCryptoKeyAndData retrieves the encryption or signing key of the primary version of the logical key the given key is a version of, along with its metadata.
+// It downloads the key from the vault, unwraps it with the master key and returns the key bytes and associated metadata.
+// The caller must be permitted to use the requested and the resolved key, and the resolved key must be active.
 func (s *blobUploadService) getCryptoKeyAndData(ctx context.Context, cryptoKeyID string) ([]byte, *keys.CryptoKeyMeta, error) {
 	// Get meta info
-	cryptoKeyMeta, err := s.cryptoKeyRepo.GetByID(ctx, cryptoKeyID)
+	requestedKeyMeta, err := getAuthorizedCryptoKey(ctx, s.permissionManagement, s.cryptoKeyRepo, cryptoKeyID, permissions.PermissionUseKey)
 	if err != nil {
 		return nil, nil, fmt.Errorf("%w", err)
 	}
+	keyPairID, err := primaryKeyPairID(ctx, s.logicalKeyRepo, requestedKeyMeta)
+	if err != nil {
+		return nil, nil, fmt.Errorf("%w", err)
+	}
+	cryptoKeyMeta, err := resolveKeyVersion(ctx, s.cryptoKeyRepo, requestedKeyMeta, keyPairID, requestedKeyMeta.Type)
+	if err != nil {
+		return nil, nil, fmt.Errorf("%w", err)
+	}
+	if cryptoKeyMeta.ID != requestedKeyMeta.ID {
+		if err := checkPermission(ctx, s.permissionManagement, permissions.PermissionUseKey, permissions.Key(cryptoKeyMeta.ID)); err != nil {
+			return nil, nil, fmt.Errorf("%w", err)
+		}
+	}
+	if err := cryptoKeyMeta.CheckUsage(keys.KeyUsageProtect, time.Now()); err != nil {
+		return nil, nil, fmt.Errorf("%w", err)
+	}
 
 	// Download key
-	keyBytes, err := s.vaultConnector.Download(ctx, cryptoKeyMeta.ID, cryptoKeyMeta.KeyPairID, cryptoKeyMeta.Type)
+	keyBytes, err := loadCryptoKey(ctx, s.vaultConnector, s.masterKeyProvider, cryptoKeyMeta)
 	if err != nil {
 		return nil, nil, fmt.Errorf("%w", err)
 	}
@@ -131,149 +317,127 @@ func (s *blobUploadService) getCryptoKeyAndData(ctx context.Context, cryptoKeyID
 	return keyBytes, cryptoKeyMeta, nil
 }
 
-// applyCryptographicOperation performs cryptographic operations (encryption or signing)
-// on files within a multipart form using the specified algorithm and key.
-func (s *blobUploadService) applyCryptographicOperation(form *multipart.Form, algorithm, operation string, keyBytes []byte, keySize uint32) ([][]byte, []string, error) {
-	var contents [][]byte
-	var fileNames []string
+// signDigest signs the SHA-256 digest using the algorithm and private key of the key metadata, on the PKCS#11 token for keys generated on it
+func (s *blobUploadService) signDigest(ctx context.Context, digest []byte, keyBytes []byte, cryptoKeyMeta *keys.CryptoKeyMeta) ([]byte, error) {
+	privateKey, err := newSigner(ctx, s.vaultConnector, keyBytes, cryptoKeyMeta)
+	if err != nil {
+		return nil, fmt.Errorf("%w", err)
+	}
 
-	fileHeaders := form.File["files"]
-	for _, fileHeader := range fileHeaders {
-		file, err := fileHeader.Open()
+	switch cryptoKeyMeta.Algorithm {
+	case "RSA":
+		rsaProcessor, err := cryptography.NewRSAProcessor(s.logger)
 		if err != nil {
-			return nil, nil, fmt.Errorf("%w", err)
+			return nil, fmt.Errorf("%w", err)
 		}
-		defer func() {
-			if err := file.Close(); err != nil {
-				log.Printf("warning: failed to close file: %v\n", err)
-			}
-		}()
 
-		buffer := bytes.NewBuffer(make([]byte, 0))
-		_, err = io.Copy(buffer, file)
+		signature, err := rsaProcessor.SignDigest(digest, privateKey)
 		if err != nil {
-			return nil, nil, fmt.Errorf("%w", err)
+			return nil, fmt.Errorf("signing error: %w", err)
+		}
+		return signature, nil
+	case "EC":
+		ecProcessor, err := cryptography.NewECProcessor(s.logger)
+		if err != nil {
+			return nil, fmt.Errorf("%w", err)
 		}
-		data := buffer.Bytes()
-
-		var processedBytes []byte
-
-		switch algorithm {
-		case "AES":
-			if operation == "encryption" {
-				aesProcessor, err := cryptography.NewAESProcessor(s.logger)
-				if err != nil {
-					return nil, nil, fmt.Errorf("%w", err)
-				}
-				processedBytes, err = aesProcessor.Encrypt(data, keyBytes)
-				if err != nil {
-					return nil, nil, fmt.Errorf("%w", err)
-				}
-			}
-		case "RSA":
-			rsaProcessor, err := cryptography.NewRSAProcessor(s.logger)
-			if err != nil {
-				return nil, nil, fmt.Errorf("%w", err)
-			}
 
-			switch operation {
-			case "encryption":
-				publicKeyInterface, err := x509.ParsePKIXPublicKey(keyBytes)
-				if err != nil {
-					return nil, nil, fmt.Errorf("error parsing public key: %w", err)
-				}
-				publicKey, ok := publicKeyInterface.(*crypto_rsa.PublicKey)
-				if !ok {
-					return nil, nil, fmt.Errorf("public key is not of type RSA")
-				}
-				processedBytes, err = rsaProcessor.Encrypt(data, publicKey)
-				if err != nil {
-					return nil, nil, fmt.Errorf("encryption error: %w", err)
-				}
-
-			case "signing":
-				privateKey, err := x509.ParsePKCS1PrivateKey(keyBytes)
-				if err != nil {
-					return nil, nil, fmt.Errorf("error parsing private key: %w", err)
-				}
-				processedBytes, err = rsaProcessor.Sign(data, privateKey)
-				if err != nil {
-					return nil, nil, fmt.Errorf("signing error: %w", err)
-				}
-
-			default:
-				return nil, nil, fmt.Errorf("unsupported operation: %s", operation)
-			}
-		case "EC":
-			if operation == "signing" {
-				ecProcessor, err := cryptography.NewECProcessor(s.logger)
-				if err != nil {
-					return nil, nil, fmt.Errorf("%w", err)
-				}
-
-				privateKeyD := new(big.Int).SetBytes(keyBytes[:32])
-				pubKeyX := new(big.Int).SetBytes(keyBytes[32:64])
-				pubKeyY := new(big.Int).SetBytes(keyBytes[64:96])
-
-				var curve elliptic.Curve
-				switch keySize {
-				case 224:
-					curve = elliptic.P224()
-				case 256:
-					curve = elliptic.P256()
-				case 384:
-					curve = elliptic.P384()
-				case 521:
-					curve = elliptic.P521()
-				default:
-					return nil, nil, fmt.Errorf("key size %v not supported for EC", keySize)
-				}
-
-				publicKey := &crypto_ec.PublicKey{
-					Curve: curve,
-					X:     pubKeyX,
-					Y:     pubKeyY,
-				}
-
-				privateKey := &crypto_ec.PrivateKey{
-					D:         privateKeyD,
-					PublicKey: *publicKey,
-				}
-				processedBytes, err = ecProcessor.Sign(data, privateKey)
-				if err != nil {
-					return nil, nil, fmt.Errorf("%w", err)
-				}
-			}
-		default:
-			return nil, nil, fmt.Errorf("unsupported algorithm: %s", algorithm)
+		signature, err := ecProcessor.SignDigest(digest, privateKey)
+		if err != nil {
+			return nil, fmt.Errorf("%w", err)
 		}
+		return signature, nil
+	default:
+		return nil, fmt.Errorf("unsupported algorithm: %s", cryptoKeyMeta.Algorithm)
+	}
+}
 
-		contents = append(contents, processedBytes)
-		fileNames = append(fileNames, fileHeader.Filename)
+// getAuthorizedBlob retrieves a blob's metadata by ID and returns auth.ErrForbidden unless the caller has the permission on the blob
+func getAuthorizedBlob(ctx context.Context, permissionManagement permissions.PermissionManagement, blobRepository blobs.BlobRepository, blobID, permission string) (*blobs.BlobMeta, error) {
+	if err := checkPermission(ctx, permissionManagement, permission, permissions.Blob(blobID)); err != nil {
+		return nil, fmt.Errorf("%w", err)
+	}
+
+	blobMeta, err := blobRepository.GetByID(ctx, blobID)
+	if err != nil {
+		return nil, fmt.Errorf("%w", err)
 	}
 
-	return contents, fileNames, nil
+	return blobMeta, nil
+}
+
+// blobSignatureSuffix is appended to a blob's name to name its detached signature stored next to it
+const blobSignatureSuffix = ".sig"
+
+// blobAssociatedData returns the associated data binding an encrypted blob to its ID and owner
+func blobAssociatedData(blobID, userID string) []byte {
+	return []byte(blobID + "|" + userID)
+}
+
+// encryptingReader returns a reader of the ciphertext of plainText, which is encrypted concurrently while the reader is consumed.
+// Closing the reader before it is fully consumed aborts the encryption.
+func encryptingReader(plainText io.Reader, newEncrypter func(dst io.Writer) (io.WriteCloser, error)) io.ReadCloser {
+	pipeReader, pipeWriter := io.Pipe()
+
+	go func() {
+		encrypter, err := newEncrypter(pipeWriter)
+		if err == nil {
+			_, err = io.Copy(encrypter, plainText)
+		}
+		if err == nil {
+			err = encrypter.Close()
+		}
+		pipeWriter.CloseWithError(err)
+	}()
+
+	return pipeReader
+}
+
+// countingReader counts the bytes read through it
+type countingReader struct {
+	reader io.Reader
+	count  int64
+}
+
+// Read reads from the underlying reader and counts the bytes read
+func (r *countingReader) Read(p []byte) (int, error) {
+	n, err := r.reader.Read(p)
+	r.count += int64(n)
+	return n, err
 }
 
 // blobMetadataService implements the BlobMetadataService interface for retrieving and deleting blob metadata
 type blobMetadataService struct {
-	blobConnector  connector.BlobConnector
-	blobRepository blobs.BlobRepository
-	logger         logger.Logger
+	blobConnector        connector.BlobConnector
+	blobRepository       blobs.BlobRepository
+	permissionManagement permissions.PermissionManagement
+	logger               logger.Logger
 }
 
 // NewBlobMetadataService creates a new instance of blobMetadataService
-func NewBlobMetadataService(blobRepository blobs.BlobRepository, blobConnector connector.BlobConnector, logger logger.Logger) (blobs.BlobMetadataService, error) {
+func NewBlobMetadataService(blobRepository blobs.BlobRepository, blobConnector connector.BlobConnector, permissionManagement permissions.PermissionManagement, logger logger.Logger) (blobs.BlobMetadataService, error) {
 	return &blobMetadataService{
-		blobConnector:  blobConnector,
-		blobRepository: blobRepository,
-		logger:         logger,
+		blobConnector:        blobConnector,
+		blobRepository:       blobRepository,
+		permissionManagement: permissionManagement,
+		logger:               logger,
 	}, nil
 }
 
-// List retrieves all blobs' metadata considering a query filter
+// List retrieves all blobs' metadata considering a query filter.
+// Callers other than admins only list the blobs they own.
 func (s *blobMetadataService) List(ctx context.Context, query *blobs.BlobMetaQuery) ([]*blobs.BlobMeta, error) {
-	blobMetas, err := s.blobRepository.List(ctx, query)
+	ownerID, err := auth.OwnerScope(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("%w", err)
+	}
+
+	scopedQuery := *query
+	if ownerID != "" {
+		scopedQuery.UserID = ownerID
+	}
+
+	blobMetas, err := s.blobRepository.List(ctx, &scopedQuery)
 	if err != nil {
 		return nil, fmt.Errorf("%w", err)
 	}
@@ -281,19 +445,19 @@ func (s *blobMetadataService) List(ctx context.Context, query *blobs.BlobMetaQue
 	return blobMetas, nil
 }
 
-// GetByID retrieves a blob's metadata by its unique ID
+// GetByID retrieves a blob's metadata by its unique ID, provided the caller may view the blob
 func (s *blobMetadataService) GetByID(ctx context.Context, blobID string) (*blobs.BlobMeta, error) {
-	blobMeta, err := s.blobRepository.GetByID(ctx, blobID)
+	blobMeta, err := getAuthorizedBlob(ctx, s.permissionManagement, s.blobRepository, blobID, permissions.PermissionViewBlob)
 	if err != nil {
 		return nil, fmt.Errorf("%w", err)
 	}
 	return blobMeta, nil
 }
 
-// DeleteByID deletes a blob and its associated metadata by ID
+// DeleteByID deletes a blob, its associated metadata and relationship tuples by ID, provided the caller may delete the blob
 func (s *blobMetadataService) DeleteByID(ctx context.Context, blobID string) error {
 
-	blobMeta, err := s.blobRepository.GetByID(ctx, blobID)
+	blobMeta, err := getAuthorizedBlob(ctx, s.permissionManagement, s.blobRepository, blobID, permissions.PermissionDeleteBlob)
 	if err != nil {
 		return fmt.Errorf("%w", err)
 	}
@@ -308,96 +472,344 @@ func (s *blobMetadataService) DeleteByID(ctx context.Context, blobID string) err
 		return fmt.Errorf("%w", err)
 	}
 
+	if blobMeta.SignatureName != nil {
+		err = s.blobConnector.Delete(ctx, blobMeta.ID, *blobMeta.SignatureName)
+		if err != nil {
+			return fmt.Errorf("%w", err)
+		}
+	}
+
+	err = deleteObjectTuples(ctx, s.permissionManagement, permissions.Blob(blobMeta.ID))
+	if err != nil {
+		return fmt.Errorf("%w", err)
+	}
+
 	return nil
 }
 
 // blobDownloadService implements the BlobDownloadService interface for downloading blobs
 type blobDownloadService struct {
-	blobConnector  connector.BlobConnector
-	blobRepository blobs.BlobRepository
-	vaultConnector connector.VaultConnector
-	cryptoKeyRepo  keys.CryptoKeyRepository
-	logger         logger.Logger
+	blobConnector        connector.BlobConnector
+	blobRepository       blobs.BlobRepository
+	vaultConnector       connector.VaultConnector
+	cryptoKeyRepo        keys.CryptoKeyRepository
+	masterKeyProvider    cryptography.MasterKeyProvider
+	permissionManagement permissions.PermissionManagement
+	logger               logger.Logger
 }
 
 // NewBlobDownloadService creates a new instance of BlobDownloadService
-func NewBlobDownloadService(blobConnector connector.BlobConnector, blobRepository blobs.BlobRepository, vaultConnector connector.VaultConnector, cryptoKeyRepo keys.CryptoKeyRepository, logger logger.Logger) (blobs.BlobDownloadService, error) {
+func NewBlobDownloadService(blobConnector connector.BlobConnector, blobRepository blobs.BlobRepository, vaultConnector connector.VaultConnector, cryptoKeyRepo keys.CryptoKeyRepository, masterKeyProvider cryptography.MasterKeyProvider, permissionManagement permissions.PermissionManagement, logger logger.Logger) (blobs.BlobDownloadService, error) {
 	return &blobDownloadService{
-		blobConnector:  blobConnector,
-		blobRepository: blobRepository,
-		cryptoKeyRepo:  cryptoKeyRepo,
-		vaultConnector: vaultConnector,
-		logger:         logger,
+		blobConnector:        blobConnector,
+		blobRepository:       blobRepository,
+		cryptoKeyRepo:        cryptoKeyRepo,
+		vaultConnector:       vaultConnector,
+		masterKeyProvider:    masterKeyProvider,
+		permissionManagement: permissionManagement,
+		logger:               logger,
 	}, nil
 }
 
 // The download function retrieves a blob's content using its ID and also enables data decryption.
-// NOTE: Signing should be performed locally by first downloading the associated key, followed by verification.
-// Optionally, a verify endpoint will be available soon for optional use.
-func (s *blobDownloadService) DownloadByID(ctx context.Context, blobID string, decryptionKeyID *string) ([]byte, error) {
+// The content is streamed from the blob storage and decrypted while being read. The returned reader must be closed by the caller.
+// Only callers permitted to download the blob may download it, and only with a decryption key they may use.
+// NOTE: Detached signatures of signed blobs are checked with VerifyByID, or locally by downloading the signature and the sign key's public half.
+func (s *blobDownloadService) DownloadByID(ctx context.Context, blobID string, decryptionKeyID *string) (io.ReadCloser, error) {
 
-	blobMeta, err := s.blobRepository.GetByID(ctx, blobID)
+	blobMeta, err := getAuthorizedBlob(ctx, s.permissionManagement, s.blobRepository, blobID, permissions.PermissionDownloadBlob)
 	if err != nil {
 		return nil, fmt.Errorf("%w", err)
 	}
 
-	blobBytes, err := s.blobConnector.Download(ctx, blobID, blobMeta.Name)
+	if decryptionKeyID == nil {
+		blobReader, err := s.blobConnector.Download(ctx, blobID, blobMeta.Name)
+		if err != nil {
+			return nil, fmt.Errorf("%w", err)
+		}
+		return blobReader, nil
+	}
+
+	keyBytes, cryptoKeyMeta, err := s.getCryptoKeyAndData(ctx, *decryptionKeyID, blobMeta.EncryptionKeyID)
 	if err != nil {
 		return nil, fmt.Errorf("%w", err)
 	}
 
-	if decryptionKeyID != nil {
-		var processedBytes []byte
-		keyBytes, cryptoKeyMeta, err := s.getCryptoKeyAndData(ctx, *decryptionKeyID)
+	blobReader, err := s.blobConnector.Download(ctx, blobID, blobMeta.Name)
+	if err != nil {
+		return nil, fmt.Errorf("%w", err)
+	}
+
+	plainTextReader, err := s.decryptStream(ctx, blobReader, blobMeta, keyBytes, cryptoKeyMeta)
+	if err != nil {
+		if closeErr := blobReader.Close(); closeErr != nil {
+			log.Printf("warning: failed to close blob stream: %v\n", closeErr)
+		}
+		return nil, fmt.Errorf("%w", err)
+	}
+
+	return &blobReadCloser{Reader: plainTextReader, Closer: blobReader}, nil
+}
+
+// VerifyByID checks the detached signature of a blob against the public key of the key pair the blob was signed with.
+// Signatures cover the plaintext, so encrypted blobs require a decryption key. The content is streamed and hashed, never held in memory.
+// Only callers permitted to verify the blob may verify it, which does not require access to the sign key.
+// It returns false if the signature does not match the content.
+func (s *blobDownloadService) VerifyByID(ctx context.Context, blobID string, decryptionKeyID *string) (bool, error) {
+	blobMeta, err := getAuthorizedBlob(ctx, s.permissionManagement, s.blobRepository, blobID, permissions.PermissionVerifyBlob)
+	if err != nil {
+		return false, fmt.Errorf("%w", err)
+	}
+
+	if blobMeta.SignKeyID == nil || blobMeta.SignatureName == nil {
+		return false, fmt.Errorf("blob %s has no signature", blobID)
+	}
+	if blobMeta.EncryptionKeyID != nil && decryptionKeyID == nil {
+		return false, fmt.Errorf("blob %s is encrypted, a decryption key is required to verify its signature", blobID)
+	}
+
+	publicKeyBytes, publicKeyMeta, err := s.getPublicKeyAndData(ctx, *blobMeta.SignKeyID)
+	if err != nil {
+		return false, fmt.Errorf("%w", err)
+	}
+
+	signatureReader, err := s.blobConnector.Download(ctx, blobMeta.ID, *blobMeta.SignatureName)
+	if err != nil {
+		return false, fmt.Errorf("%w", err)
+	}
+	defer func() {
+		if err := signatureReader.Close(); err != nil {
+			log.Printf("warning: failed to close signature stream: %v\n", err)
+		}
+	}()
+
+	signature, err := io.ReadAll(signatureReader)
+	if err != nil {
+		return false, fmt.Errorf("failed to read signature of blob '%s': %w", blobMeta.Name, err)
+	}
+
+	contentReader, err := s.DownloadByID(ctx, blobID, decryptionKeyID)
+	if err != nil {
+		return false, fmt.Errorf("%w", err)
+	}
+	defer func() {
+		if err := contentReader.Close(); err != nil {
+			log.Printf("warning: failed to close blob stream: %v\n", err)
+		}
+	}()
+
+	digest := sha256.New()
+	if _, err := io.Copy(digest, contentReader); err != nil {
+		return false, fmt.Errorf("failed to read blob '%s': %w", blobMeta.Name, err)
+	}
+
+	return s.verifyDigest(digest.Sum(nil), signature, publicKeyMeta.Algorithm, publicKeyBytes)
+}
+
+// verifyDigest verifies the signature of the SHA-256 digest using the specified algorithm and public key
+func (s *blobDownloadService) verifyDigest(digest, signature []byte, algorithm string, keyBytes []byte) (bool, error) {
+	switch algorithm {
+	case "RSA":
+		rsaProcessor, err := cryptography.NewRSAProcessor(s.logger)
 		if err != nil {
-			return nil, fmt.Errorf("%w", err)
+			return false, fmt.Errorf("%w", err)
 		}
 
-		switch cryptoKeyMeta.Algorithm {
-		case "AES":
-			aesProcessor, err := cryptography.NewAESProcessor(s.logger)
-			if err != nil {
-				return nil, fmt.Errorf("%w", err)
-			}
-			processedBytes, err = aesProcessor.Decrypt(blobBytes, keyBytes)
-			if err != nil {
-				return nil, fmt.Errorf("%w", err)
-			}
-		case "RSA":
-			rsaProcessor, err := cryptography.NewRSAProcessor(s.logger)
-			if err != nil {
-				return nil, fmt.Errorf("%w", err)
-			}
-			privateKey, err := x509.ParsePKCS1PrivateKey(keyBytes)
-			if err != nil {
-				return nil, fmt.Errorf("error parsing private key: %w", err)
-			}
-			processedBytes, err = rsaProcessor.Decrypt(blobBytes, privateKey)
-			if err != nil {
-				return nil, fmt.Errorf("%w", err)
-			}
-		default:
-			return nil, fmt.Errorf("unsupported algorithm: %s", cryptoKeyMeta.Algorithm)
+		publicKeyInterface, err := x509.ParsePKIXPublicKey(keyBytes)
+		if err != nil {
+			return false, fmt.Errorf("error parsing public key: %w", err)
+		}
+		publicKey, ok := publicKeyInterface.(*crypto_rsa.PublicKey)
+		if !ok {
+			return false, fmt.Errorf("public key is not of type RSA")
+		}
+		valid, err := rsaProcessor.VerifyDigest(digest, signature, publicKey)
+		if err != nil {
+			return false, fmt.Errorf("%w", err)
+		}
+		return valid, nil
+	case "EC":
+		ecProcessor, err := cryptography.NewECProcessor(s.logger)
+		if err != nil {
+			return false, fmt.Errorf("%w", err)
+		}
+
+		publicKey, err := cryptography.ParseECPublicKey(keyBytes)
+		if err != nil {
+			return false, fmt.Errorf("%w", err)
+		}
+		valid, err := ecProcessor.VerifyDigest(digest, signature, publicKey)
+		if err != nil {
+			return false, fmt.Errorf("%w", err)
 		}
-		return processedBytes, nil
+		return valid, nil
+	default:
+		return false, fmt.Errorf("unsupported algorithm: %s", algorithm)
 	}
-	return blobBytes, nil
 }
 
-// getCryptoKeyAndData retrieves the encryption or signing key along with its metadata by ID.
-// It downloads the key from the vault and returns the key bytes and associated metadata.
-func (s *blobDownloadService) getCryptoKeyAndData(ctx context.Context, cryptoKeyID string) ([]byte, *keys.CryptoKeyMeta, error) {
+// decryptStream returns a reader decrypting the blob stream with the given key, on the PKCS#11 token for keys generated on it
+func (s *blobDownloadService) decryptStream(ctx context.Context, blobReader io.Reader, blobMeta *blobs.BlobMeta, keyBytes []byte, cryptoKeyMeta *keys.CryptoKeyMeta) (io.Reader, error) {
+	envelopeReader := cryptography.NewEnvelopeReader(blobReader)
+
+	envelope, err := cryptography.PeekEnvelope(envelopeReader)
+	if err != nil {
+		return nil, fmt.Errorf("%w", err)
+	}
+
+	if err := checkEnvelopeKey(envelope, cryptoKeyMeta); err != nil {
+		return nil, fmt.Errorf("%w", err)
+	}
+
+	associatedData := blobAssociatedData(blobMeta.ID, blobMeta.UserID)
+
+	switch cryptoKeyMeta.Algorithm {
+	case "AES":
+		aesProcessor, err := cryptography.NewAESProcessor(s.logger)
+		if err != nil {
+			return nil, fmt.Errorf("%w", err)
+		}
+		plainTextReader, err := aesProcessor.DecryptStream(envelopeReader, keyBytes, associatedData)
+		if err != nil {
+			return nil, fmt.Errorf("%w", err)
+		}
+		return plainTextReader, nil
+	case "RSA":
+		rsaProcessor, err := cryptography.NewRSAProcessor(s.logger)
+		if err != nil {
+			return nil, fmt.Errorf("%w", err)
+		}
+		privateKey, err := newDecrypter(ctx, s.vaultConnector, keyBytes, cryptoKeyMeta)
+		if err != nil {
+			return nil, fmt.Errorf("%w", err)
+		}
+		plainTextReader, err := rsaProcessor.DecryptStream(envelopeReader, privateKey, associatedData)
+		if err != nil {
+			return nil, fmt.Errorf("%w", err)
+		}
+		return plainTextReader, nil
+	default:
+		return nil, fmt.Errorf("unsupported algorithm: %s", cryptoKeyMeta.Algorithm)
+	}
+}
+
+// checkEnvelopeKey rejects decryption keys which do not match the algorithm and key pair recorded in the envelope of a ciphertext.
+// Blobs encrypted before envelopes were introduced carry no envelope and are not checked.
+func checkEnvelopeKey(envelope *cryptography.Envelope, cryptoKeyMeta *keys.CryptoKeyMeta) error {
+	if envelope == nil {
+		return nil
+	}
+
+	if envelope.Algorithm != cryptoKeyMeta.Algorithm {
+		return fmt.Errorf("ciphertext is encrypted with %s, but key %s is a %s key", envelope.Algorithm, cryptoKeyMeta.ID, cryptoKeyMeta.Algorithm)
+	}
+	if envelope.KeyID != cryptoKeyMeta.KeyPairID {
+		return fmt.Errorf("ciphertext is encrypted with key %s, but key %s belongs to %s", envelope.KeyID, cryptoKeyMeta.ID, cryptoKeyMeta.KeyPairID)
+	}
+
+	return nil
+}
+
+// blobReadCloser couples a reader processing a blob stream with the blob stream to be closed
+type blobReadCloser struct {
+	io.Reader
+	io.Closer
+}
+
+// getCryptoKeyAndData retrieves the decryption key along with its metadata by ID.
+// If the blob records its encryption key, the decryption key is resolved from the version of the logical key that encrypted the blob.
+// It downloads the key from the vault, unwraps it with the master key and returns the key bytes and associated metadata.
+// The caller must be permitted to use the requested and the resolved key, which may also be suspended or deactivated.
+func (s *blobDownloadService) getCryptoKeyAndData(ctx context.Context, cryptoKeyID string, encryptionKeyID *string) ([]byte, *keys.CryptoKeyMeta, error) {
+	var encryptionKeyMeta *keys.CryptoKeyMeta
+	if encryptionKeyID != nil {
+		var err error
+		encryptionKeyMeta, err = s.cryptoKeyRepo.GetByID(ctx, *encryptionKeyID)
+		if err != nil {
+			return nil, nil, fmt.Errorf("%w", err)
+		}
+	}
+
 	// Get meta info
-	cryptoKeyMeta, err := s.cryptoKeyRepo.GetByID(ctx, cryptoKeyID)
+	requestedKeyMeta, err := s.getAuthorizedDecryptionKey(ctx, cryptoKeyID, encryptionKeyMeta)
 	if err != nil {
 		return nil, nil, fmt.Errorf("%w", err)
 	}
 
+	cryptoKeyMeta := requestedKeyMeta
+	if encryptionKeyMeta != nil {
+		// Blobs are decrypted with the private key of the key pair, even if the ID of its public key is requested
+		cryptoKeyMeta, err = resolveKeyVersion(ctx, s.cryptoKeyRepo, requestedKeyMeta, encryptionKeyMeta.KeyPairID, "private")
+		if err != nil {
+			return nil, nil, fmt.Errorf("%w", err)
+		}
+		if cryptoKeyMeta.ID != requestedKeyMeta.ID {
+			if _, err := s.getAuthorizedDecryptionKey(ctx, cryptoKeyMeta.ID, encryptionKeyMeta); err != nil {
+				return nil, nil, fmt.Errorf("%w", err)
+			}
+		}
+	}
+	if err := cryptoKeyMeta.CheckUsage(keys.KeyUsageProcess, time.Now()); err != nil {
+		return nil, nil, fmt.Errorf("%w", err)
+	}
+
+	return s.downloadCryptoKey(ctx, cryptoKeyMeta)
+}
+
+// getAuthorizedDecryptionKey retrieves a key's metadata by ID and returns auth.ErrForbidden unless the caller may use the key.
+// Callers permitted to download a blob, including grantees of download grants, may decrypt it with keys of the key pair that encrypted it without being permitted to use them otherwise.
+func (s *blobDownloadService) getAuthorizedDecryptionKey(ctx context.Context, keyID string, encryptionKeyMeta *keys.CryptoKeyMeta) (*keys.CryptoKeyMeta, error) {
+	permissionErr := checkPermission(ctx, s.permissionManagement, permissions.PermissionUseKey, permissions.Key(keyID))
+	if permissionErr == nil {
+		keyMeta, err := s.cryptoKeyRepo.GetByID(ctx, keyID)
+		if err != nil {
+			return nil, fmt.Errorf("%w", err)
+		}
+		return keyMeta, nil
+	}
+	if !errors.Is(permissionErr, auth.ErrForbidden) || encryptionKeyMeta == nil {
+		return nil, fmt.Errorf("%w", permissionErr)
+	}
+
+	keyMeta, err := s.cryptoKeyRepo.GetByID(ctx, keyID)
+	if err != nil || keyMeta.KeyPairID != encryptionKeyMeta.KeyPairID {
+		return nil, fmt.Errorf("%w", permissionErr)
+	}
+	return keyMeta, nil
+}
+
+// downloadCryptoKey downloads a key from the vault and unwraps it with the master key, private keys generated on a PKCS#11 token stay on it
+func (s *blobDownloadService) downloadCryptoKey(ctx context.Context, cryptoKeyMeta *keys.CryptoKeyMeta) ([]byte, *keys.CryptoKeyMeta, error) {
 	// Download key
-	keyBytes, err := s.vaultConnector.Download(ctx, cryptoKeyMeta.ID, cryptoKeyMeta.KeyPairID, cryptoKeyMeta.Type)
+	keyBytes, err := loadCryptoKey(ctx, s.vaultConnector, s.masterKeyProvider, cryptoKeyMeta)
 	if err != nil {
 		return nil, nil, fmt.Errorf("%w", err)
 	}
 
 	return keyBytes, cryptoKeyMeta, nil
 }
+
+// getPublicKeyAndData retrieves the public key of the key pair the given key belongs to, along with its metadata.
+// Public keys are not confidential, hence callers permitted to verify a blob need no permission on its sign key.
+func (s *blobDownloadService) getPublicKeyAndData(ctx context.Context, cryptoKeyID string) ([]byte, *keys.CryptoKeyMeta, error) {
+	cryptoKeyMeta, err := s.cryptoKeyRepo.GetByID(ctx, cryptoKeyID)
+	if err != nil {
+		return nil, nil, fmt.Errorf("%w", err)
+	}
+
+	query := keys.NewCryptoKeyQuery()
+	query.KeyPairID = cryptoKeyMeta.KeyPairID
+	query.Type = "public"
+	publicKeyMetas, err := s.cryptoKeyRepo.List(ctx, query)
+	if err != nil {
+		return nil, nil, fmt.Errorf("%w", err)
+	}
+	if len(publicKeyMetas) == 0 {
+		return nil, nil, fmt.Errorf("no public key found for key pair %s", cryptoKeyMeta.KeyPairID)
+	}
+	if err := publicKeyMetas[0].CheckUsage(keys.KeyUsageProcess, time.Now()); err != nil {
+		return nil, nil, fmt.Errorf("%w", err)
+	}
+
+	return s.downloadCryptoKey(ctx, publicKeyMetas[0])
+}
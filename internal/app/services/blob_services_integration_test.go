@@ -4,17 +4,27 @@
 package services
 
 import (
+	"bytes"
 	"context"
+	"crypto_vault_service/internal/domain/auth"
 	"crypto_vault_service/internal/domain/blobs"
 	"crypto_vault_service/internal/domain/keys"
+	"crypto_vault_service/internal/domain/permissions"
+	"crypto_vault_service/internal/infrastructure/authorization"
 	"crypto_vault_service/internal/infrastructure/connector"
+	"crypto_vault_service/internal/infrastructure/cryptography"
 	"crypto_vault_service/internal/infrastructure/logger"
 	"crypto_vault_service/internal/infrastructure/settings"
 	"crypto_vault_service/internal/infrastructure/utils"
 	"crypto_vault_service/internal/persistence/repository"
 	"crypto_vault_service/test/testutils"
+	"fmt"
+	"io"
 	"os"
+	"sync"
+	"sync/atomic"
 	"testing"
+	"time"
 
 	"github.com/google/uuid"
 	"github.com/stretchr/testify/require"
@@ -22,11 +32,15 @@ import (
 )
 
 type BlobServicesTest struct {
-	blobUploadService      blobs.BlobUploadService
-	blobDownloadService    blobs.BlobDownloadService
-	blobMetadataService    blobs.BlobMetadataService
-	cryptoKeyUploadService keys.CryptoKeyUploadService
-	dbContext              *repository.TestDBContext
+	blobUploadService        blobs.BlobUploadService
+	blobDownloadService      blobs.BlobDownloadService
+	blobMetadataService      blobs.BlobMetadataService
+	blobGrantService         blobs.BlobGrantService
+	blobLinkService          blobs.BlobLinkService
+	cryptoKeyUploadService   keys.CryptoKeyUploadService
+	cryptoKeyRotationService keys.CryptoKeyRotationService
+	permissionManagement     permissions.PermissionManagement
+	dbContext                *repository.TestDBContext
 }
 
 func NewBlobServicesTest(t *testing.T, dbType string) *BlobServicesTest {
@@ -59,24 +73,43 @@ func NewBlobServicesTest(t *testing.T, dbType string) *BlobServicesTest {
 	vaultConnector, err := connector.NewAzureVaultConnector(ctx, keyConnectorSettings, logger)
 	require.NoError(t, err, "Error creating vault connector")
 
-	blobUploadService, err := NewBlobUploadService(blobConnector, dbContext.BlobRepo, vaultConnector, dbContext.CryptoKeyRepo, logger)
+	masterKeyProvider, err := cryptography.NewFileMasterKeyProvider(t.TempDir(), true, logger)
+	require.NoError(t, err, "Error creating master key provider")
+
+	permissionManagement, err := authorization.NewLocalPermissionManagement(dbContext.RelationTupleRepo, logger)
+	require.NoError(t, err, "Error creating permission management")
+
+	blobUploadService, err := NewBlobUploadService(blobConnector, dbContext.BlobRepo, vaultConnector, dbContext.CryptoKeyRepo, dbContext.LogicalKeyRepo, masterKeyProvider, permissionManagement, logger)
 	require.NoError(t, err, "Error creating BlobUploadService")
 
-	blobDownloadService, err := NewBlobDownloadService(blobConnector, dbContext.BlobRepo, vaultConnector, dbContext.CryptoKeyRepo, logger)
+	blobDownloadService, err := NewBlobDownloadService(blobConnector, dbContext.BlobRepo, vaultConnector, dbContext.CryptoKeyRepo, masterKeyProvider, permissionManagement, logger)
 	require.NoError(t, err, "Error creating BlobDownloadService")
 
-	blobMetadataService, err := NewBlobMetadataService(dbContext.BlobRepo, blobConnector, logger)
+	blobMetadataService, err := NewBlobMetadataService(dbContext.BlobRepo, blobConnector, permissionManagement, logger)
 	require.NoError(t, err, "Error creating BlobMetadataService")
 
-	cryptoKeyUploadService, err := NewCryptoKeyUploadService(vaultConnector, dbContext.CryptoKeyRepo, logger)
+	blobGrantService, err := NewBlobGrantService(dbContext.BlobRepo, permissionManagement, logger)
+	require.NoError(t, err, "Error creating BlobGrantService")
+
+	blobLinkService, err := NewBlobLinkService(dbContext.BlobRepo, dbContext.BlobLinkSigningKeyRepo, dbContext.BlobLinkRevocationRepo, vaultConnector, masterKeyProvider, permissionManagement, logger)
+	require.NoError(t, err, "Error creating BlobLinkService")
+
+	cryptoKeyUploadService, err := NewCryptoKeyUploadService(vaultConnector, dbContext.CryptoKeyRepo, dbContext.LogicalKeyRepo, masterKeyProvider, permissionManagement, logger)
 	require.NoError(t, err, "Error creating CryptoKeyUploadService")
 
+	cryptoKeyRotationService, err := NewCryptoKeyRotationService(vaultConnector, dbContext.CryptoKeyRepo, dbContext.LogicalKeyRepo, masterKeyProvider, permissionManagement, time.Hour, logger)
+	require.NoError(t, err, "Error creating CryptoKeyRotationService")
+
 	return &BlobServicesTest{
-		blobUploadService:      blobUploadService,
-		blobDownloadService:    blobDownloadService,
-		blobMetadataService:    blobMetadataService,
-		cryptoKeyUploadService: cryptoKeyUploadService,
-		dbContext:              dbContext,
+		blobUploadService:        blobUploadService,
+		blobDownloadService:      blobDownloadService,
+		blobMetadataService:      blobMetadataService,
+		blobGrantService:         blobGrantService,
+		blobLinkService:          blobLinkService,
+		cryptoKeyUploadService:   cryptoKeyUploadService,
+		cryptoKeyRotationService: cryptoKeyRotationService,
+		permissionManagement:     permissionManagement,
+		dbContext:                dbContext,
 	}
 }
 
@@ -96,16 +129,16 @@ func TestBlobUploadService_Upload_With_RSA_Encryption_And_Signing_Success(t *tes
 
 	keyAlgorithm := "RSA"
 	var keySize uint32 = 2048
-	ctx := context.Background()
+	ctx := auth.WithUserID(context.Background(), userID)
 
-	cryptoKeyMetas, err := blobServices.cryptoKeyUploadService.Upload(ctx, userID, keyAlgorithm, keySize)
+	cryptoKeyMetas, err := blobServices.cryptoKeyUploadService.Upload(ctx, keyAlgorithm, keySize, nil)
 	require.NoError(t, err)
 	require.Equal(t, len(cryptoKeyMetas), 2)
 
 	signKeyID := cryptoKeyMetas[0].ID       // private key
 	encryptionKeyID := cryptoKeyMetas[1].ID // public key
 
-	blobMetas, err := blobServices.blobUploadService.Upload(ctx, form, userID, &encryptionKeyID, &signKeyID)
+	blobMetas, err := blobServices.blobUploadService.Upload(ctx, form, &encryptionKeyID, &signKeyID)
 	require.NoError(t, err)
 	require.NotNil(t, blobMetas)
 	require.NotEmpty(t, blobMetas[0].ID)
@@ -129,9 +162,9 @@ func TestBlobUploadService_Upload_With_AES_Encryption_And_ECDSA_Signing_Success(
 	// generate signing private EC key
 	signKeyAlgorithm := "EC"
 	var signKeySize uint32 = 256
-	ctx := context.Background()
+	ctx := auth.WithUserID(context.Background(), userID)
 
-	cryptoKeyMetas, err := blobServices.cryptoKeyUploadService.Upload(ctx, userID, signKeyAlgorithm, signKeySize)
+	cryptoKeyMetas, err := blobServices.cryptoKeyUploadService.Upload(ctx, signKeyAlgorithm, signKeySize, nil)
 	require.NoError(t, err)
 	require.Equal(t, len(cryptoKeyMetas), 2)
 
@@ -139,14 +172,14 @@ func TestBlobUploadService_Upload_With_AES_Encryption_And_ECDSA_Signing_Success(
 	encryptionKeyAlgorithm := "AES"
 	var encryptionKeySize uint32 = 256
 
-	cryptoKeyMetas2, err := blobServices.cryptoKeyUploadService.Upload(ctx, userID, encryptionKeyAlgorithm, encryptionKeySize)
+	cryptoKeyMetas2, err := blobServices.cryptoKeyUploadService.Upload(ctx, encryptionKeyAlgorithm, encryptionKeySize, nil)
 	require.NoError(t, err)
 	require.Equal(t, len(cryptoKeyMetas2), 1)
 
 	signKeyID := cryptoKeyMetas[0].ID        // private key
 	encryptionKeyID := cryptoKeyMetas2[0].ID // symmetric key
 
-	blobMetas, err := blobServices.blobUploadService.Upload(ctx, form, userID, &encryptionKeyID, &signKeyID)
+	blobMetas, err := blobServices.blobUploadService.Upload(ctx, form, &encryptionKeyID, &signKeyID)
 	require.NoError(t, err)
 	require.NotNil(t, blobMetas)
 	require.NotEmpty(t, blobMetas[0].ID)
@@ -168,9 +201,9 @@ func TestBlobUploadService_Upload_Without_Encryption_And_Signing_Success(t *test
 	userID := uuid.New().String()
 	var encryptionKeyID *string = nil
 	var signKeyID *string = nil
-	ctx := context.Background()
+	ctx := auth.WithUserID(context.Background(), userID)
 
-	blobMetas, err := blobServices.blobUploadService.Upload(ctx, form, userID, encryptionKeyID, signKeyID)
+	blobMetas, err := blobServices.blobUploadService.Upload(ctx, form, encryptionKeyID, signKeyID)
 	require.NoError(t, err)
 	require.NotNil(t, blobMetas)
 	require.NotEmpty(t, blobMetas[0].ID)
@@ -192,13 +225,109 @@ func TestBlobUploadService_Upload_Fail_InvalidEncryptionKey(t *testing.T) {
 	userID := uuid.New().String()
 	invalidEncryptionKeyId := "invalid-encryption-key-id"
 	signKeyID := uuid.New().String()
-	ctx := context.Background()
+	ctx := auth.WithUserID(context.Background(), userID)
 
-	blobMetas, err := blobServices.blobUploadService.Upload(ctx, form, userID, &invalidEncryptionKeyId, &signKeyID)
+	blobMetas, err := blobServices.blobUploadService.Upload(ctx, form, &invalidEncryptionKeyId, &signKeyID)
 	require.Error(t, err)
 	require.Nil(t, blobMetas)
 }
 
+// Test case for failed blob upload due to a missing caller identity
+func TestBlobUploadService_Upload_Fail_Unauthenticated(t *testing.T) {
+	dbType := "sqlite"
+	blobServices := NewBlobServicesTest(t, dbType)
+	defer repository.TeardownTestDB(t, blobServices.dbContext, dbType)
+
+	form, err := testutils.CreateTestFileAndForm(t, "testfile.txt", []byte("This is test file content"))
+	require.NoError(t, err)
+
+	blobMetas, err := blobServices.blobUploadService.Upload(context.Background(), form, nil, nil)
+	require.ErrorIs(t, err, auth.ErrUnauthenticated)
+	require.Nil(t, blobMetas)
+}
+
+// failingCreateBlobRepository fails storing the metadata of blobs named failName and records the metadata stored before
+type failingCreateBlobRepository struct {
+	blobs.BlobRepository
+	failName string
+	created  []*blobs.BlobMeta
+}
+
+func (r *failingCreateBlobRepository) Create(ctx context.Context, blob *blobs.BlobMeta) error {
+	if blob.Name == r.failName {
+		return fmt.Errorf("database unavailable")
+	}
+	r.created = append(r.created, blob)
+	return r.BlobRepository.Create(ctx, blob)
+}
+
+// Test case for removing uploaded content, metadata and ownership of all blobs of an upload once storing the metadata of one fails
+func TestBlobUploadService_Upload_Fail_Rollback(t *testing.T) {
+	dbType := "sqlite"
+	blobServices := NewBlobServicesTest(t, dbType)
+	defer repository.TeardownTestDB(t, blobServices.dbContext, dbType)
+
+	form, err := testutils.CreateTestFileAndForm(t, "first.txt", []byte("first file content"))
+	require.NoError(t, err)
+	secondForm, err := testutils.CreateTestFileAndForm(t, "second.txt", []byte("second file content"))
+	require.NoError(t, err)
+	form.File["files"] = append(form.File["files"], secondForm.File["files"]...)
+
+	blobRepo := &failingCreateBlobRepository{BlobRepository: blobServices.dbContext.BlobRepo, failName: "second.txt"}
+	uploadService := *blobServices.blobUploadService.(*blobUploadService)
+	uploadService.blobRepository = blobRepo
+
+	ctx := auth.WithUserID(context.Background(), uuid.New().String())
+	blobMetas, err := uploadService.Upload(ctx, form, nil, nil)
+	require.Error(t, err)
+	require.Nil(t, blobMetas)
+	require.Len(t, blobRepo.created, 1)
+
+	storedBlobMeta := blobRepo.created[0]
+	_, err = blobServices.dbContext.BlobRepo.GetByID(ctx, storedBlobMeta.ID)
+	require.Error(t, err)
+	_, err = uploadService.blobConnector.Download(ctx, storedBlobMeta.ID, storedBlobMeta.Name)
+	require.Error(t, err)
+
+	tuples, err := blobServices.permissionManagement.ReadTuples(context.Background(), &permissions.RelationTupleQuery{})
+	require.NoError(t, err)
+	require.Empty(t, tuples)
+}
+
+// Test case for successful blob upload and download for a caller whose JWT subject is no UUID
+func TestBlobServices_NonUUIDSubject_Success(t *testing.T) {
+	dbType := "sqlite"
+	blobServices := NewBlobServicesTest(t, dbType)
+	defer repository.TeardownTestDB(t, blobServices.dbContext, dbType)
+
+	testFileContent := []byte("This is test file content")
+	form, err := testutils.CreateTestFileAndForm(t, "testfile.txt", testFileContent)
+	require.NoError(t, err)
+
+	userID := "auth0|user-1"
+	ctx := auth.WithUserID(context.Background(), userID)
+
+	cryptoKeyMetas, err := blobServices.cryptoKeyUploadService.Upload(ctx, "AES", 256, nil)
+	require.NoError(t, err)
+	encryptionKeyID := cryptoKeyMetas[0].ID
+
+	blobMetas, err := blobServices.blobUploadService.Upload(ctx, form, &encryptionKeyID, nil)
+	require.NoError(t, err)
+	require.Equal(t, userID, blobMetas[0].UserID)
+
+	listedBlobMetas, err := blobServices.blobMetadataService.List(ctx, blobs.NewBlobMetaQuery())
+	require.NoError(t, err)
+	require.Len(t, listedBlobMetas, 1)
+
+	blobReader, err := blobServices.blobDownloadService.DownloadByID(ctx, blobMetas[0].ID, &encryptionKeyID)
+	require.NoError(t, err)
+	defer blobReader.Close()
+
+	blobData, err := io.ReadAll(blobReader)
+	require.NoError(t, err)
+	require.Equal(t, testFileContent, blobData)
+}
+
 // Test case for successful blob download
 func TestBlobDownloadService_Download_Success(t *testing.T) {
 	dbType := "sqlite"
@@ -214,19 +343,160 @@ func TestBlobDownloadService_Download_Success(t *testing.T) {
 	userID := uuid.New().String()
 	var encryptionKeyID *string = nil
 	var signKeyID *string = nil
-	ctx := context.Background()
+	ctx := auth.WithUserID(context.Background(), userID)
 
-	blobMetas, err := blobServices.blobUploadService.Upload(ctx, form, userID, encryptionKeyID, signKeyID)
+	blobMetas, err := blobServices.blobUploadService.Upload(ctx, form, encryptionKeyID, signKeyID)
 	require.NoError(t, err)
 	require.NotNil(t, blobMetas)
 
 	// decryptionKeyID := uuid.New().String()
 
 	// blobData, err := blobServices.blobDownloadService.DownloadByID(blobID, &decryptionKeyID)
-	blobData, err := blobServices.blobDownloadService.DownloadByID(ctx, blobMetas[0].ID, nil)
+	blobReader, err := blobServices.blobDownloadService.DownloadByID(ctx, blobMetas[0].ID, nil)
+	require.NoError(t, err)
+	defer blobReader.Close()
+
+	blobData, err := io.ReadAll(blobReader)
+	require.NoError(t, err)
+	require.Equal(t, testFileContent, blobData)
+}
+
+// Test case for successful blob download of an AES-GCM encrypted blob
+func TestBlobDownloadService_Download_With_AES_Decryption_Success(t *testing.T) {
+	dbType := "sqlite"
+	blobServices := NewBlobServicesTest(t, dbType)
+	defer repository.TeardownTestDB(t, blobServices.dbContext, dbType)
+
+	testFileContent := []byte("This is test file content")
+	testFileName := "testfile.txt"
+
+	form, err := testutils.CreateTestFileAndForm(t, testFileName, testFileContent)
+	require.NoError(t, err)
+
+	userID := uuid.New().String()
+	ctx := auth.WithUserID(context.Background(), userID)
+
+	cryptoKeyMetas, err := blobServices.cryptoKeyUploadService.Upload(ctx, "AES", 256, nil)
+	require.NoError(t, err)
+	require.Equal(t, len(cryptoKeyMetas), 1)
+
+	encryptionKeyID := cryptoKeyMetas[0].ID
+
+	blobMetas, err := blobServices.blobUploadService.Upload(ctx, form, &encryptionKeyID, nil)
+	require.NoError(t, err)
+	require.NotNil(t, blobMetas)
+
+	blobReader, err := blobServices.blobDownloadService.DownloadByID(ctx, blobMetas[0].ID, &encryptionKeyID)
+	require.NoError(t, err)
+	defer blobReader.Close()
+
+	blobData, err := io.ReadAll(blobReader)
+	require.NoError(t, err)
+	require.Equal(t, testFileContent, blobData)
+}
+
+// Test case for blobs encrypted before and after rotating the encryption key, which are encrypted with the primary version and decrypted with the version they were encrypted with
+func TestBlobServices_Rotated_Encryption_Key(t *testing.T) {
+	dbType := "sqlite"
+	blobServices := NewBlobServicesTest(t, dbType)
+	defer repository.TeardownTestDB(t, blobServices.dbContext, dbType)
+
+	testFileContent := []byte("This is test file content")
+	ctx := auth.WithUserID(context.Background(), uuid.New().String())
+
+	cryptoKeyMetas, err := blobServices.cryptoKeyUploadService.Upload(ctx, "AES", 256, nil)
+	require.NoError(t, err)
+	encryptionKeyID := cryptoKeyMetas[0].ID
+
+	form, err := testutils.CreateTestFileAndForm(t, "before.txt", testFileContent)
+	require.NoError(t, err)
+	blobMetasBefore, err := blobServices.blobUploadService.Upload(ctx, form, &encryptionKeyID, nil)
+	require.NoError(t, err)
+
+	rotatedKeyMetas, err := blobServices.cryptoKeyRotationService.Rotate(ctx, encryptionKeyID)
+	require.NoError(t, err)
+
+	form, err = testutils.CreateTestFileAndForm(t, "after.txt", testFileContent)
+	require.NoError(t, err)
+	blobMetasAfter, err := blobServices.blobUploadService.Upload(ctx, form, &encryptionKeyID, nil)
+	require.NoError(t, err)
+	require.Equal(t, rotatedKeyMetas[0].ID, *blobMetasAfter[0].EncryptionKeyID)
+
+	for _, blobMeta := range []*blobs.BlobMeta{blobMetasBefore[0], blobMetasAfter[0]} {
+		blobReader, err := blobServices.blobDownloadService.DownloadByID(ctx, blobMeta.ID, &encryptionKeyID)
+		require.NoError(t, err)
+
+		blobData, err := io.ReadAll(blobReader)
+		require.NoError(t, err)
+		require.NoError(t, blobReader.Close())
+		require.Equal(t, testFileContent, blobData)
+	}
+}
+
+// Test case for successful streamed round trip of an AES encrypted blob spanning multiple segments
+func TestBlobDownloadService_Download_With_AES_Decryption_LargeBlob_Success(t *testing.T) {
+	dbType := "sqlite"
+	blobServices := NewBlobServicesTest(t, dbType)
+	defer repository.TeardownTestDB(t, blobServices.dbContext, dbType)
+
+	testFileContent := bytes.Repeat([]byte("0123456789abcdef"), 64*1024)
+	testFileName := "largetestfile.bin"
+
+	form, err := testutils.CreateTestFileAndForm(t, testFileName, testFileContent)
+	require.NoError(t, err)
+
+	userID := uuid.New().String()
+	ctx := auth.WithUserID(context.Background(), userID)
+
+	cryptoKeyMetas, err := blobServices.cryptoKeyUploadService.Upload(ctx, "AES", 256, nil)
+	require.NoError(t, err)
+	require.Equal(t, len(cryptoKeyMetas), 1)
+
+	encryptionKeyID := cryptoKeyMetas[0].ID
+
+	blobMetas, err := blobServices.blobUploadService.Upload(ctx, form, &encryptionKeyID, nil)
 	require.NoError(t, err)
-	require.NotNil(t, blobData)
-	require.NotEmpty(t, blobData)
+	require.Greater(t, blobMetas[0].Size, int64(len(testFileContent)))
+
+	blobReader, err := blobServices.blobDownloadService.DownloadByID(ctx, blobMetas[0].ID, &encryptionKeyID)
+	require.NoError(t, err)
+	defer blobReader.Close()
+
+	blobData, err := io.ReadAll(blobReader)
+	require.NoError(t, err)
+	require.Equal(t, testFileContent, blobData)
+}
+
+// Test case for failed blob download with a decryption key not matching the blob envelope
+func TestBlobDownloadService_Download_Fail_MismatchedDecryptionKey(t *testing.T) {
+	dbType := "sqlite"
+	blobServices := NewBlobServicesTest(t, dbType)
+	defer repository.TeardownTestDB(t, blobServices.dbContext, dbType)
+
+	testFileContent := []byte("This is test file content")
+	testFileName := "testfile.txt"
+
+	form, err := testutils.CreateTestFileAndForm(t, testFileName, testFileContent)
+	require.NoError(t, err)
+
+	userID := uuid.New().String()
+	ctx := auth.WithUserID(context.Background(), userID)
+
+	cryptoKeyMetas, err := blobServices.cryptoKeyUploadService.Upload(ctx, "AES", 256, nil)
+	require.NoError(t, err)
+	otherCryptoKeyMetas, err := blobServices.cryptoKeyUploadService.Upload(ctx, "AES", 256, nil)
+	require.NoError(t, err)
+
+	encryptionKeyID := cryptoKeyMetas[0].ID
+	otherKeyID := otherCryptoKeyMetas[0].ID
+
+	blobMetas, err := blobServices.blobUploadService.Upload(ctx, form, &encryptionKeyID, nil)
+	require.NoError(t, err)
+
+	blobData, err := blobServices.blobDownloadService.DownloadByID(ctx, blobMetas[0].ID, &otherKeyID)
+	require.Error(t, err)
+	require.Contains(t, err.Error(), cryptoKeyMetas[0].KeyPairID)
+	require.Nil(t, blobData)
 }
 
 // Test case for failed blob download with invalid decryption key
@@ -244,6 +514,155 @@ func TestBlobDownloadService_Download_Fail_InvalidDecryptionKey(t *testing.T) {
 	require.Nil(t, blobData)
 }
 
+// Test case for successful signature verification of a blob signed with RSA and encrypted afterwards
+func TestBlobDownloadService_Verify_With_RSA_Signing_And_Encryption_Success(t *testing.T) {
+	dbType := "sqlite"
+	blobServices := NewBlobServicesTest(t, dbType)
+	defer repository.TeardownTestDB(t, blobServices.dbContext, dbType)
+
+	testFileContent := []byte("This is test file content")
+	testFileName := "testfile.txt"
+
+	form, err := testutils.CreateTestFileAndForm(t, testFileName, testFileContent)
+	require.NoError(t, err)
+
+	userID := uuid.New().String()
+	ctx := auth.WithUserID(context.Background(), userID)
+
+	cryptoKeyMetas, err := blobServices.cryptoKeyUploadService.Upload(ctx, "RSA", 2048, nil)
+	require.NoError(t, err)
+	require.Equal(t, len(cryptoKeyMetas), 2)
+
+	signKeyID := cryptoKeyMetas[0].ID       // private key
+	encryptionKeyID := cryptoKeyMetas[1].ID // public key
+	decryptionKeyID := cryptoKeyMetas[0].ID // private key
+
+	blobMetas, err := blobServices.blobUploadService.Upload(ctx, form, &encryptionKeyID, &signKeyID)
+	require.NoError(t, err)
+	require.NotNil(t, blobMetas[0].SignatureName)
+	require.Equal(t, testFileName+".sig", *blobMetas[0].SignatureName)
+
+	// The original content is stored next to the signature
+	blobReader, err := blobServices.blobDownloadService.DownloadByID(ctx, blobMetas[0].ID, &decryptionKeyID)
+	require.NoError(t, err)
+	defer blobReader.Close()
+
+	blobData, err := io.ReadAll(blobReader)
+	require.NoError(t, err)
+	require.Equal(t, testFileContent, blobData)
+
+	valid, err := blobServices.blobDownloadService.VerifyByID(ctx, blobMetas[0].ID, &decryptionKeyID)
+	require.NoError(t, err)
+	require.True(t, valid)
+
+	// Signatures cover the plaintext, so encrypted blobs can not be verified without decryption key
+	_, err = blobServices.blobDownloadService.VerifyByID(ctx, blobMetas[0].ID, nil)
+	require.Error(t, err)
+}
+
+// Test case for blobs protected with keys that are deactivated later on, which still decrypt and verify them but protect no new blobs
+func TestBlobServices_Deactivated_Keys(t *testing.T) {
+	dbType := "sqlite"
+	blobServices := NewBlobServicesTest(t, dbType)
+	defer repository.TeardownTestDB(t, blobServices.dbContext, dbType)
+
+	testFileContent := []byte("This is test file content")
+	testFileName := "testfile.txt"
+
+	form, err := testutils.CreateTestFileAndForm(t, testFileName, testFileContent)
+	require.NoError(t, err)
+
+	ctx := auth.WithUserID(context.Background(), uuid.New().String())
+
+	cryptoKeyMetas, err := blobServices.cryptoKeyUploadService.Upload(ctx, "RSA", 2048, nil)
+	require.NoError(t, err)
+	require.Equal(t, keys.KeyStateActive, cryptoKeyMetas[0].State)
+
+	signKeyID := cryptoKeyMetas[0].ID       // private key
+	encryptionKeyID := cryptoKeyMetas[1].ID // public key
+	decryptionKeyID := cryptoKeyMetas[0].ID // private key
+
+	blobMetas, err := blobServices.blobUploadService.Upload(ctx, form, &encryptionKeyID, &signKeyID)
+	require.NoError(t, err)
+
+	// Expire both keys of the pair
+	expired := time.Now().Add(-time.Minute)
+	for _, cryptoKeyMeta := range cryptoKeyMetas {
+		cryptoKeyMeta.DateTimeExpires = &expired
+		require.NoError(t, blobServices.dbContext.CryptoKeyRepo.UpdateByID(ctx, cryptoKeyMeta))
+	}
+
+	_, err = blobServices.blobUploadService.Upload(ctx, form, &encryptionKeyID, nil)
+	require.ErrorIs(t, err, keys.ErrKeyState)
+	_, err = blobServices.blobUploadService.Upload(ctx, form, nil, &signKeyID)
+	require.ErrorIs(t, err, keys.ErrKeyState)
+
+	blobReader, err := blobServices.blobDownloadService.DownloadByID(ctx, blobMetas[0].ID, &decryptionKeyID)
+	require.NoError(t, err)
+	defer blobReader.Close()
+
+	blobData, err := io.ReadAll(blobReader)
+	require.NoError(t, err)
+	require.Equal(t, testFileContent, blobData)
+
+	valid, err := blobServices.blobDownloadService.VerifyByID(ctx, blobMetas[0].ID, &decryptionKeyID)
+	require.NoError(t, err)
+	require.True(t, valid)
+}
+
+// Test case for successful signature verification of blobs signed with ECDSA on every supported curve
+func TestBlobDownloadService_Verify_With_ECDSA_Signing_Success(t *testing.T) {
+	dbType := "sqlite"
+	blobServices := NewBlobServicesTest(t, dbType)
+	defer repository.TeardownTestDB(t, blobServices.dbContext, dbType)
+
+	testFileContent := []byte("This is test file content")
+	testFileName := "testfile.txt"
+
+	userID := uuid.New().String()
+	ctx := auth.WithUserID(context.Background(), userID)
+
+	for _, keySize := range []uint32{256, 384, 521} {
+		form, err := testutils.CreateTestFileAndForm(t, testFileName, testFileContent)
+		require.NoError(t, err)
+
+		cryptoKeyMetas, err := blobServices.cryptoKeyUploadService.Upload(ctx, "EC", keySize, nil)
+		require.NoError(t, err)
+		require.Equal(t, len(cryptoKeyMetas), 2)
+
+		signKeyID := cryptoKeyMetas[0].ID // private key
+
+		blobMetas, err := blobServices.blobUploadService.Upload(ctx, form, nil, &signKeyID)
+		require.NoError(t, err)
+		require.Equal(t, int64(len(testFileContent)), blobMetas[0].Size)
+
+		valid, err := blobServices.blobDownloadService.VerifyByID(ctx, blobMetas[0].ID, nil)
+		require.NoError(t, err)
+		require.True(t, valid, "key size %d", keySize)
+	}
+}
+
+// Test case for failed signature verification of a blob uploaded without signing
+func TestBlobDownloadService_Verify_Fail_NoSignature(t *testing.T) {
+	dbType := "sqlite"
+	blobServices := NewBlobServicesTest(t, dbType)
+	defer repository.TeardownTestDB(t, blobServices.dbContext, dbType)
+
+	form, err := testutils.CreateTestFileAndForm(t, "testfile.txt", []byte("This is test file content"))
+	require.NoError(t, err)
+
+	userID := uuid.New().String()
+	ctx := auth.WithUserID(context.Background(), userID)
+
+	blobMetas, err := blobServices.blobUploadService.Upload(ctx, form, nil, nil)
+	require.NoError(t, err)
+	require.Nil(t, blobMetas[0].SignatureName)
+
+	valid, err := blobServices.blobDownloadService.VerifyByID(ctx, blobMetas[0].ID, nil)
+	require.Error(t, err)
+	require.False(t, valid)
+}
+
 // Test case for successful listing of blob metadata
 func TestBlobMetadataService_List_Success(t *testing.T) {
 	dbType := "sqlite"
@@ -262,10 +681,10 @@ func TestBlobMetadataService_List_Success(t *testing.T) {
 	userID := uuid.New().String()
 	// encryptionKeyID := uuid.New().String()
 	// signKeyID := uuid.New().String()
-	ctx := context.Background()
+	ctx := auth.WithUserID(context.Background(), userID)
 
-	// blobMetas, err := blobServices.blobUploadService.Upload(form, userID, &encryptionKeyID, &signKeyID)
-	blobMetas, err := blobServices.blobUploadService.Upload(ctx, form, userID, nil, nil)
+	// blobMetas, err := blobServices.blobUploadService.Upload(form, &encryptionKeyID, &signKeyID)
+	blobMetas, err := blobServices.blobUploadService.Upload(ctx, form, nil, nil)
 	require.NoError(t, err)
 	require.NotNil(t, blobMetas)
 
@@ -291,9 +710,9 @@ func TestBlobMetadataService_GetByID_Success(t *testing.T) {
 	userID := uuid.New().String()
 	var encryptionKeyID *string = nil
 	var signKeyID *string = nil
-	ctx := context.Background()
+	ctx := auth.WithUserID(context.Background(), userID)
 
-	blobMetas, err := blobServices.blobUploadService.Upload(ctx, form, userID, encryptionKeyID, signKeyID)
+	blobMetas, err := blobServices.blobUploadService.Upload(ctx, form, encryptionKeyID, signKeyID)
 	require.NoError(t, err)
 	require.NotNil(t, blobMetas)
 
@@ -318,9 +737,9 @@ func TestBlobMetadataService_DeleteByID_Success(t *testing.T) {
 	userID := uuid.New().String()
 	var encryptionKeyID *string = nil
 	var signKeyID *string = nil
-	ctx := context.Background()
+	ctx := auth.WithUserID(context.Background(), userID)
 
-	blobMetas, err := blobServices.blobUploadService.Upload(ctx, form, userID, encryptionKeyID, signKeyID)
+	blobMetas, err := blobServices.blobUploadService.Upload(ctx, form, encryptionKeyID, signKeyID)
 	require.NoError(t, err)
 	require.NotNil(t, blobMetas)
 
@@ -333,3 +752,469 @@ func TestBlobMetadataService_DeleteByID_Success(t *testing.T) {
 	require.Error(t, err)
 	require.Equal(t, gorm.ErrRecordNotFound, err)
 }
+
+// Test case for denying other users access to a blob while admins may access it
+func TestBlobServices_Ownership(t *testing.T) {
+	dbType := "sqlite"
+	blobServices := NewBlobServicesTest(t, dbType)
+	defer repository.TeardownTestDB(t, blobServices.dbContext, dbType)
+
+	testFileContent := []byte("This is test file content")
+	testFileName := "testfile.txt"
+
+	form, err := testutils.CreateTestFileAndForm(t, testFileName, testFileContent)
+	require.NoError(t, err)
+
+	ownerCtx := auth.WithUserID(context.Background(), uuid.New().String())
+	otherCtx := auth.WithUserID(context.Background(), uuid.New().String())
+	adminCtx := auth.WithIdentity(context.Background(), &auth.Identity{UserID: uuid.New().String(), Admin: true})
+
+	cryptoKeyMetas, err := blobServices.cryptoKeyUploadService.Upload(ownerCtx, "AES", 256, nil)
+	require.NoError(t, err)
+	encryptionKeyID := cryptoKeyMetas[0].ID
+
+	blobMetas, err := blobServices.blobUploadService.Upload(ownerCtx, form, &encryptionKeyID, nil)
+	require.NoError(t, err)
+	blobID := blobMetas[0].ID
+
+	// Other users neither see, download nor delete the blob
+	otherBlobMetas, err := blobServices.blobMetadataService.List(otherCtx, &blobs.BlobMetaQuery{})
+	require.NoError(t, err)
+	require.Empty(t, otherBlobMetas)
+
+	_, err = blobServices.blobMetadataService.GetByID(otherCtx, blobID)
+	require.ErrorIs(t, err, auth.ErrForbidden)
+
+	_, err = blobServices.blobDownloadService.DownloadByID(otherCtx, blobID, nil)
+	require.ErrorIs(t, err, auth.ErrForbidden)
+
+	err = blobServices.blobMetadataService.DeleteByID(otherCtx, blobID)
+	require.ErrorIs(t, err, auth.ErrForbidden)
+
+	// Other users may not use the owner's key
+	otherForm, err := testutils.CreateTestFileAndForm(t, testFileName, testFileContent)
+	require.NoError(t, err)
+	_, err = blobServices.blobUploadService.Upload(otherCtx, otherForm, &encryptionKeyID, nil)
+	require.ErrorIs(t, err, auth.ErrForbidden)
+
+	// Admins list, download and delete the blobs of all users
+	adminBlobMetas, err := blobServices.blobMetadataService.List(adminCtx, &blobs.BlobMetaQuery{})
+	require.NoError(t, err)
+	require.Len(t, adminBlobMetas, 1)
+
+	reader, err := blobServices.blobDownloadService.DownloadByID(adminCtx, blobID, &encryptionKeyID)
+	require.NoError(t, err)
+	content, err := io.ReadAll(reader)
+	require.NoError(t, err)
+	require.NoError(t, reader.Close())
+	require.Equal(t, testFileContent, content)
+
+	err = blobServices.blobMetadataService.DeleteByID(adminCtx, blobID)
+	require.NoError(t, err)
+}
+
+// Test case for granting another user access to view, download and verify but not to delete a blob
+func TestBlobServices_Grantee(t *testing.T) {
+	dbType := "sqlite"
+	blobServices := NewBlobServicesTest(t, dbType)
+	defer repository.TeardownTestDB(t, blobServices.dbContext, dbType)
+
+	testFileContent := []byte("This is test file content")
+	testFileName := "testfile.txt"
+
+	form, err := testutils.CreateTestFileAndForm(t, testFileName, testFileContent)
+	require.NoError(t, err)
+
+	ownerCtx := auth.WithUserID(context.Background(), uuid.New().String())
+	granteeID := uuid.New().String()
+	granteeCtx := auth.WithUserID(context.Background(), granteeID)
+
+	cryptoKeyMetas, err := blobServices.cryptoKeyUploadService.Upload(ownerCtx, "EC", 256, nil)
+	require.NoError(t, err)
+	signKeyID := cryptoKeyMetas[0].ID
+
+	blobMetas, err := blobServices.blobUploadService.Upload(ownerCtx, form, nil, &signKeyID)
+	require.NoError(t, err)
+	blobID := blobMetas[0].ID
+
+	_, err = blobServices.blobMetadataService.GetByID(granteeCtx, blobID)
+	require.ErrorIs(t, err, auth.ErrForbidden)
+
+	err = blobServices.permissionManagement.WriteTuples(context.Background(), []*permissions.RelationTuple{
+		permissions.NewRelationTuple(permissions.User(granteeID), permissions.RelationGrantee, permissions.Blob(blobID)),
+	})
+	require.NoError(t, err)
+
+	// Grantees view, download and verify the blob without access to the sign key
+	_, err = blobServices.blobMetadataService.GetByID(granteeCtx, blobID)
+	require.NoError(t, err)
+
+	reader, err := blobServices.blobDownloadService.DownloadByID(granteeCtx, blobID, nil)
+	require.NoError(t, err)
+	content, err := io.ReadAll(reader)
+	require.NoError(t, err)
+	require.NoError(t, reader.Close())
+	require.Equal(t, testFileContent, content)
+
+	valid, err := blobServices.blobDownloadService.VerifyByID(granteeCtx, blobID, nil)
+	require.NoError(t, err)
+	require.True(t, valid)
+
+	err = blobServices.blobMetadataService.DeleteByID(granteeCtx, blobID)
+	require.ErrorIs(t, err, auth.ErrForbidden)
+
+	// Deleting the blob removes its tuples
+	err = blobServices.blobMetadataService.DeleteByID(ownerCtx, blobID)
+	require.NoError(t, err)
+
+	tuples, err := blobServices.permissionManagement.ReadTuples(context.Background(), &permissions.RelationTupleQuery{Object: permissions.Blob(blobID)})
+	require.NoError(t, err)
+	require.Empty(t, tuples)
+}
+
+// Test case for sharing a blob with a user for view access and with a group for download access, and revoking the grants
+func TestBlobGrantService_Create_List_Delete_Success(t *testing.T) {
+	dbType := "sqlite"
+	blobServices := NewBlobServicesTest(t, dbType)
+	defer repository.TeardownTestDB(t, blobServices.dbContext, dbType)
+
+	testFileContent := []byte("This is test file content")
+	testFileName := "testfile.txt"
+
+	form, err := testutils.CreateTestFileAndForm(t, testFileName, testFileContent)
+	require.NoError(t, err)
+
+	ownerCtx := auth.WithUserID(context.Background(), uuid.New().String())
+	viewerID := uuid.New().String()
+	viewerCtx := auth.WithUserID(context.Background(), viewerID)
+	memberCtx := auth.WithIdentity(context.Background(), &auth.Identity{UserID: uuid.New().String(), Groups: []string{"auditors"}})
+
+	blobMetas, err := blobServices.blobUploadService.Upload(ownerCtx, form, nil, nil)
+	require.NoError(t, err)
+	blobID := blobMetas[0].ID
+
+	// Viewers see the metadata but may not download the blob
+	_, err = blobServices.blobGrantService.Create(ownerCtx, &blobs.BlobGrant{BlobID: blobID, GranteeType: blobs.GranteeTypeUser, GranteeID: viewerID, Access: blobs.AccessView})
+	require.NoError(t, err)
+
+	_, err = blobServices.blobMetadataService.GetByID(viewerCtx, blobID)
+	require.NoError(t, err)
+
+	_, err = blobServices.blobDownloadService.DownloadByID(viewerCtx, blobID, nil)
+	require.ErrorIs(t, err, auth.ErrForbidden)
+
+	// Members of a group download the blob shared with the group
+	_, err = blobServices.blobDownloadService.DownloadByID(memberCtx, blobID, nil)
+	require.ErrorIs(t, err, auth.ErrForbidden)
+
+	expires := time.Now().Add(time.Hour)
+	_, err = blobServices.blobGrantService.Create(ownerCtx, &blobs.BlobGrant{BlobID: blobID, GranteeType: blobs.GranteeTypeGroup, GranteeID: "auditors", Access: blobs.AccessDownload, DateTimeExpires: &expires})
+	require.NoError(t, err)
+
+	reader, err := blobServices.blobDownloadService.DownloadByID(memberCtx, blobID, nil)
+	require.NoError(t, err)
+	content, err := io.ReadAll(reader)
+	require.NoError(t, err)
+	require.NoError(t, reader.Close())
+	require.Equal(t, testFileContent, content)
+
+	grants, err := blobServices.blobGrantService.List(ownerCtx, blobID)
+	require.NoError(t, err)
+	require.Len(t, grants, 2)
+
+	// Creating a grant again replaces the existing one
+	_, err = blobServices.blobGrantService.Create(ownerCtx, &blobs.BlobGrant{BlobID: blobID, GranteeType: blobs.GranteeTypeUser, GranteeID: viewerID, Access: blobs.AccessDownload})
+	require.NoError(t, err)
+
+	grants, err = blobServices.blobGrantService.List(ownerCtx, blobID)
+	require.NoError(t, err)
+	require.Len(t, grants, 2)
+	for _, grant := range grants {
+		require.Equal(t, blobs.AccessDownload, grant.Access)
+	}
+
+	_, err = blobServices.blobDownloadService.DownloadByID(viewerCtx, blobID, nil)
+	require.NoError(t, err)
+
+	// Revoked grants no longer permit access
+	err = blobServices.blobGrantService.Delete(ownerCtx, blobID, blobs.GranteeTypeGroup, "auditors")
+	require.NoError(t, err)
+
+	_, err = blobServices.blobMetadataService.GetByID(memberCtx, blobID)
+	require.ErrorIs(t, err, auth.ErrForbidden)
+
+	err = blobServices.blobGrantService.Delete(ownerCtx, blobID, blobs.GranteeTypeGroup, "auditors")
+	require.Error(t, err)
+}
+
+// Test case for grantees decrypting an encrypted blob shared for download with the key pair it was encrypted with, but with no other key
+func TestBlobGrantService_Download_Encrypted(t *testing.T) {
+	dbType := "sqlite"
+	blobServices := NewBlobServicesTest(t, dbType)
+	defer repository.TeardownTestDB(t, blobServices.dbContext, dbType)
+
+	testFileContent := []byte("This is test file content")
+	form, err := testutils.CreateTestFileAndForm(t, "testfile.txt", testFileContent)
+	require.NoError(t, err)
+
+	ownerCtx := auth.WithUserID(context.Background(), uuid.New().String())
+	granteeID := uuid.New().String()
+	granteeCtx := auth.WithUserID(context.Background(), granteeID)
+
+	rsaKeyMetas, err := blobServices.cryptoKeyUploadService.Upload(ownerCtx, "RSA", 2048, nil)
+	require.NoError(t, err)
+	otherKeyMetas, err := blobServices.cryptoKeyUploadService.Upload(ownerCtx, "AES", 256, nil)
+	require.NoError(t, err)
+
+	var encryptionKeyID string
+	for _, keyMeta := range rsaKeyMetas {
+		if keyMeta.Type == "public" {
+			encryptionKeyID = keyMeta.ID
+		}
+	}
+
+	blobMetas, err := blobServices.blobUploadService.Upload(ownerCtx, form, &encryptionKeyID, nil)
+	require.NoError(t, err)
+	blobID := blobMetas[0].ID
+
+	// View grants do not permit decrypting the blob
+	_, err = blobServices.blobGrantService.Create(ownerCtx, &blobs.BlobGrant{BlobID: blobID, GranteeType: blobs.GranteeTypeUser, GranteeID: granteeID, Access: blobs.AccessView})
+	require.NoError(t, err)
+	_, err = blobServices.blobDownloadService.DownloadByID(granteeCtx, blobID, &encryptionKeyID)
+	require.ErrorIs(t, err, auth.ErrForbidden)
+
+	expires := time.Now().Add(time.Hour)
+	_, err = blobServices.blobGrantService.Create(ownerCtx, &blobs.BlobGrant{BlobID: blobID, GranteeType: blobs.GranteeTypeUser, GranteeID: granteeID, Access: blobs.AccessDownload, DateTimeExpires: &expires})
+	require.NoError(t, err)
+
+	for _, keyMeta := range rsaKeyMetas {
+		reader, err := blobServices.blobDownloadService.DownloadByID(granteeCtx, blobID, &keyMeta.ID)
+		require.NoError(t, err)
+		content, err := io.ReadAll(reader)
+		require.NoError(t, err)
+		require.NoError(t, reader.Close())
+		require.Equal(t, testFileContent, content)
+	}
+
+	// The grant does not extend to other keys of the owner
+	_, err = blobServices.blobDownloadService.DownloadByID(granteeCtx, blobID, &otherKeyMetas[0].ID)
+	require.ErrorIs(t, err, auth.ErrForbidden)
+
+	// Creating the grant again updates its expiry in place
+	extendedExpires := expires.Add(time.Hour)
+	_, err = blobServices.blobGrantService.Create(ownerCtx, &blobs.BlobGrant{BlobID: blobID, GranteeType: blobs.GranteeTypeUser, GranteeID: granteeID, Access: blobs.AccessDownload, DateTimeExpires: &extendedExpires})
+	require.NoError(t, err)
+
+	grants, err := blobServices.blobGrantService.List(ownerCtx, blobID)
+	require.NoError(t, err)
+	require.Len(t, grants, 1)
+	require.Equal(t, blobs.AccessDownload, grants[0].Access)
+	require.True(t, extendedExpires.Equal(*grants[0].DateTimeExpires))
+}
+
+// Test case for denying grantees and other users to manage the grants of a blob and rejecting expired grants
+func TestBlobGrantService_Fail(t *testing.T) {
+	dbType := "sqlite"
+	blobServices := NewBlobServicesTest(t, dbType)
+	defer repository.TeardownTestDB(t, blobServices.dbContext, dbType)
+
+	form, err := testutils.CreateTestFileAndForm(t, "testfile.txt", []byte("This is test file content"))
+	require.NoError(t, err)
+
+	ownerCtx := auth.WithUserID(context.Background(), uuid.New().String())
+	granteeID := uuid.New().String()
+	granteeCtx := auth.WithUserID(context.Background(), granteeID)
+
+	blobMetas, err := blobServices.blobUploadService.Upload(ownerCtx, form, nil, nil)
+	require.NoError(t, err)
+	blobID := blobMetas[0].ID
+
+	_, err = blobServices.blobGrantService.Create(ownerCtx, &blobs.BlobGrant{BlobID: blobID, GranteeType: blobs.GranteeTypeUser, GranteeID: granteeID, Access: blobs.AccessDownload})
+	require.NoError(t, err)
+
+	// Grantees may not share the blob any further
+	_, err = blobServices.blobGrantService.Create(granteeCtx, &blobs.BlobGrant{BlobID: blobID, GranteeType: blobs.GranteeTypeUser, GranteeID: uuid.New().String(), Access: blobs.AccessView})
+	require.ErrorIs(t, err, auth.ErrForbidden)
+
+	_, err = blobServices.blobGrantService.List(granteeCtx, blobID)
+	require.ErrorIs(t, err, auth.ErrForbidden)
+
+	err = blobServices.blobGrantService.Delete(granteeCtx, blobID, blobs.GranteeTypeUser, granteeID)
+	require.ErrorIs(t, err, auth.ErrForbidden)
+
+	// Expiries must lie in the future
+	expired := time.Now().Add(-time.Minute)
+	_, err = blobServices.blobGrantService.Create(ownerCtx, &blobs.BlobGrant{BlobID: blobID, GranteeType: blobs.GranteeTypeUser, GranteeID: granteeID, Access: blobs.AccessView, DateTimeExpires: &expired})
+	require.Error(t, err)
+
+	// Grants of unknown grantee types are rejected
+	_, err = blobServices.blobGrantService.Create(ownerCtx, &blobs.BlobGrant{BlobID: blobID, GranteeType: "team", GranteeID: granteeID, Access: blobs.AccessView})
+	require.Error(t, err)
+}
+
+// Test case for issuing and redeeming pre-signed links, which delegate the issuer's access to download a blob
+func TestBlobLinkService_Create_Redeem_Success(t *testing.T) {
+	dbType := "sqlite"
+	blobServices := NewBlobServicesTest(t, dbType)
+	defer repository.TeardownTestDB(t, blobServices.dbContext, dbType)
+
+	testFileContent := []byte("This is test file content")
+	form, err := testutils.CreateTestFileAndForm(t, "testfile.txt", testFileContent)
+	require.NoError(t, err)
+
+	ownerCtx := auth.WithUserID(context.Background(), uuid.New().String())
+
+	cryptoKeyMetas, err := blobServices.cryptoKeyUploadService.Upload(ownerCtx, "AES", 256, nil)
+	require.NoError(t, err)
+	encryptionKeyID := cryptoKeyMetas[0].ID
+
+	blobMetas, err := blobServices.blobUploadService.Upload(ownerCtx, form, &encryptionKeyID, nil)
+	require.NoError(t, err)
+	blobID := blobMetas[0].ID
+
+	link, token, err := blobServices.blobLinkService.Create(ownerCtx, blobID, &encryptionKeyID, false, time.Hour)
+	require.NoError(t, err)
+	require.Equal(t, blobID, link.BlobID)
+
+	// Links are redeemed without the caller's identity and authorize the download for the issuer
+	for i := 0; i < 2; i++ {
+		redeemedLink, err := blobServices.blobLinkService.Redeem(context.Background(), blobID, token)
+		require.NoError(t, err)
+		require.Equal(t, link.ID, redeemedLink.ID)
+
+		reader, err := blobServices.blobDownloadService.DownloadByID(auth.WithUserID(context.Background(), redeemedLink.UserID), blobID, redeemedLink.DecryptionKeyID)
+		require.NoError(t, err)
+		content, err := io.ReadAll(reader)
+		require.NoError(t, err)
+		require.NoError(t, reader.Close())
+		require.Equal(t, testFileContent, content)
+	}
+
+	// Revoked links are rejected
+	require.NoError(t, blobServices.blobLinkService.Revoke(ownerCtx, blobID, link.ID))
+	_, err = blobServices.blobLinkService.Redeem(context.Background(), blobID, token)
+	require.Error(t, err)
+
+	// Single-use links are redeemed once, unless the download they authorized failed and released them
+	singleUseLink, singleUseToken, err := blobServices.blobLinkService.Create(ownerCtx, blobID, nil, true, time.Minute)
+	require.NoError(t, err)
+
+	_, err = blobServices.blobLinkService.Redeem(context.Background(), blobID, singleUseToken)
+	require.NoError(t, err)
+	_, err = blobServices.blobLinkService.Redeem(context.Background(), blobID, singleUseToken)
+	require.Error(t, err)
+
+	require.NoError(t, blobServices.blobLinkService.Release(context.Background(), singleUseLink))
+	_, err = blobServices.blobLinkService.Redeem(context.Background(), blobID, singleUseToken)
+	require.NoError(t, err)
+
+	// Single-use links revoked while being redeemed stay revoked once released
+	require.NoError(t, blobServices.blobLinkService.Revoke(ownerCtx, blobID, singleUseLink.ID))
+	require.NoError(t, blobServices.blobLinkService.Release(context.Background(), singleUseLink))
+	_, err = blobServices.blobLinkService.Redeem(context.Background(), blobID, singleUseToken)
+	require.Error(t, err)
+}
+
+// Test case for concurrent redemptions of a single-use link, of which only one succeeds
+func TestBlobLinkService_Redeem_SingleUse_Concurrent(t *testing.T) {
+	dbType := "sqlite"
+	blobServices := NewBlobServicesTest(t, dbType)
+	defer repository.TeardownTestDB(t, blobServices.dbContext, dbType)
+
+	form, err := testutils.CreateTestFileAndForm(t, "testfile.txt", []byte("This is test file content"))
+	require.NoError(t, err)
+
+	ownerCtx := auth.WithUserID(context.Background(), uuid.New().String())
+	blobMetas, err := blobServices.blobUploadService.Upload(ownerCtx, form, nil, nil)
+	require.NoError(t, err)
+	blobID := blobMetas[0].ID
+
+	_, token, err := blobServices.blobLinkService.Create(ownerCtx, blobID, nil, true, time.Minute)
+	require.NoError(t, err)
+
+	const redemptions = 8
+	var redeemed atomic.Int32
+	var wg sync.WaitGroup
+	for i := 0; i < redemptions; i++ {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			if _, err := blobServices.blobLinkService.Redeem(context.Background(), blobID, token); err == nil {
+				redeemed.Add(1)
+			}
+		}()
+	}
+	wg.Wait()
+
+	require.Equal(t, int32(1), redeemed.Load())
+}
+
+// Test case for links the caller may not issue and tokens that must not be redeemed
+func TestBlobLinkService_Fail(t *testing.T) {
+	dbType := "sqlite"
+	blobServices := NewBlobServicesTest(t, dbType)
+	defer repository.TeardownTestDB(t, blobServices.dbContext, dbType)
+
+	form, err := testutils.CreateTestFileAndForm(t, "testfile.txt", []byte("This is test file content"))
+	require.NoError(t, err)
+
+	ownerCtx := auth.WithUserID(context.Background(), uuid.New().String())
+	otherCtx := auth.WithUserID(context.Background(), uuid.New().String())
+
+	blobMetas, err := blobServices.blobUploadService.Upload(ownerCtx, form, nil, nil)
+	require.NoError(t, err)
+	blobID := blobMetas[0].ID
+
+	// Only callers who may download the blob and use the decryption key issue links
+	_, _, err = blobServices.blobLinkService.Create(otherCtx, blobID, nil, false, time.Hour)
+	require.ErrorIs(t, err, auth.ErrForbidden)
+
+	otherKeyMetas, err := blobServices.cryptoKeyUploadService.Upload(otherCtx, "AES", 256, nil)
+	require.NoError(t, err)
+	_, _, err = blobServices.blobLinkService.Create(ownerCtx, blobID, &otherKeyMetas[0].ID, false, time.Hour)
+	require.ErrorIs(t, err, auth.ErrForbidden)
+
+	_, _, err = blobServices.blobLinkService.Create(context.Background(), blobID, nil, false, time.Hour)
+	require.ErrorIs(t, err, auth.ErrUnauthenticated)
+
+	// Validities are bounded
+	_, _, err = blobServices.blobLinkService.Create(ownerCtx, blobID, nil, false, blobs.MaxBlobLinkValidity+time.Second)
+	require.Error(t, err)
+
+	// Tokens are bound to the blob and their signature
+	_, token, err := blobServices.blobLinkService.Create(ownerCtx, blobID, nil, false, time.Hour)
+	require.NoError(t, err)
+
+	_, err = blobServices.blobLinkService.Redeem(context.Background(), uuid.New().String(), token)
+	require.Error(t, err)
+
+	_, err = blobServices.blobLinkService.Redeem(context.Background(), blobID, token[:len(token)-2]+"AA")
+	require.Error(t, err)
+
+	_, err = blobServices.blobLinkService.Redeem(context.Background(), blobID, "malformed")
+	require.Error(t, err)
+
+	// Only callers who may share the blob revoke its links
+	link, _, err := blobServices.blobLinkService.Create(ownerCtx, blobID, nil, false, time.Hour)
+	require.NoError(t, err)
+	err = blobServices.blobLinkService.Revoke(otherCtx, blobID, link.ID)
+	require.ErrorIs(t, err, auth.ErrForbidden)
+
+	// Links do not delegate the admin role of the issuer
+	adminCtx := auth.WithIdentity(context.Background(), &auth.Identity{UserID: uuid.New().String(), Admin: true})
+	_, _, err = blobServices.blobLinkService.Create(adminCtx, blobID, nil, false, time.Hour)
+	require.ErrorIs(t, err, auth.ErrForbidden)
+
+	// Links stop working once the issuer loses access to the blob
+	granteeID := uuid.New().String()
+	_, err = blobServices.blobGrantService.Create(ownerCtx, &blobs.BlobGrant{BlobID: blobID, GranteeType: blobs.GranteeTypeUser, GranteeID: granteeID, Access: blobs.AccessDownload})
+	require.NoError(t, err)
+	_, granteeToken, err := blobServices.blobLinkService.Create(auth.WithUserID(context.Background(), granteeID), blobID, nil, false, time.Hour)
+	require.NoError(t, err)
+	_, err = blobServices.blobLinkService.Redeem(context.Background(), blobID, granteeToken)
+	require.NoError(t, err)
+
+	require.NoError(t, blobServices.blobGrantService.Delete(ownerCtx, blobID, blobs.GranteeTypeUser, granteeID))
+	_, err = blobServices.blobLinkService.Redeem(context.Background(), blobID, granteeToken)
+	require.ErrorIs(t, err, auth.ErrForbidden)
+}
@@ -1,6 +1,485 @@
 package services
 
-// cryptoKeyOperationService implements the CryptoKeyOperationService interface for local cryptographic key management, encryption, signing, and PKCS#11 operations.
-// TODO(MGTheTrain): setup interface signatures for CryptoKeyOperationService and implement those for cryptoKeyOperationService
-// type cryptoKeyOperationService struct {
-// }
+import (
+	"context"
+	crypto_rsa "crypto/rsa"
+	"crypto/sha256"
+	"crypto/x509"
+	"crypto_vault_service/internal/domain/crypto"
+	"crypto_vault_service/internal/domain/keys"
+	"crypto_vault_service/internal/domain/permissions"
+	"crypto_vault_service/internal/infrastructure/connector"
+	"crypto_vault_service/internal/infrastructure/cryptography"
+	"crypto_vault_service/internal/infrastructure/logger"
+	"fmt"
+	"time"
+)
+
+// cryptoOperationService implements the CryptoOperationService interface for performing cryptographic operations with stored keys.
+// Operations may be requested with either key of an RSA or EC key pair, the key suited for the operation is resolved from the pair.
+// Any version of a logical key may be requested: data is protected with the primary version and processed with the version that protected it.
+type cryptoOperationService struct {
+	vaultConnector       connector.VaultConnector
+	cryptoKeyRepo        keys.CryptoKeyRepository
+	logicalKeyRepo       keys.LogicalKeyRepository
+	masterKeyProvider    cryptography.MasterKeyProvider
+	permissionManagement permissions.PermissionManagement
+	logger               logger.Logger
+}
+
+// NewCryptoOperationService creates a new instance of CryptoOperationService
+func NewCryptoOperationService(vaultConnector connector.VaultConnector, cryptoKeyRepo keys.CryptoKeyRepository, logicalKeyRepo keys.LogicalKeyRepository, masterKeyProvider cryptography.MasterKeyProvider, permissionManagement permissions.PermissionManagement, logger logger.Logger) (crypto.CryptoOperationService, error) {
+	return &cryptoOperationService{
+		vaultConnector:       vaultConnector,
+		cryptoKeyRepo:        cryptoKeyRepo,
+		logicalKeyRepo:       logicalKeyRepo,
+		masterKeyProvider:    masterKeyProvider,
+		permissionManagement: permissionManagement,
+		logger:               logger,
+	}, nil
+}
+
+// Encrypt encrypts the plaintext with the primary version into an envelope recording its key pair and version, provided the caller may use the key
+func (s *cryptoOperationService) Encrypt(ctx context.Context, keyID string, plaintext, associatedData []byte) (*crypto.OperationResult, error) {
+	if err := checkPayload(crypto.OperationEncrypt, plaintext); err != nil {
+		return nil, fmt.Errorf("%w", err)
+	}
+
+	keyBytes, cryptoKeyMeta, err := s.getOperationKey(ctx, keyID, "public", keys.KeyUsageProtect)
+	if err != nil {
+		return nil, fmt.Errorf("%w", err)
+	}
+
+	var ciphertext []byte
+	switch cryptoKeyMeta.Algorithm {
+	case "AES":
+		aesProcessor, err := cryptography.NewAESProcessor(s.logger)
+		if err != nil {
+			return nil, fmt.Errorf("%w", err)
+		}
+		ciphertext, err = aesProcessor.Encrypt(plaintext, keyBytes, cryptoKeyMeta.KeyPairID, cryptoKeyMeta.Version, associatedData)
+		if err != nil {
+			return nil, fmt.Errorf("%w", err)
+		}
+	case "RSA":
+		rsaProcessor, err := cryptography.NewRSAProcessor(s.logger)
+		if err != nil {
+			return nil, fmt.Errorf("%w", err)
+		}
+		publicKey, err := parseRSAPublicKey(keyBytes)
+		if err != nil {
+			return nil, fmt.Errorf("%w", err)
+		}
+		ciphertext, err = rsaProcessor.Encrypt(plaintext, publicKey, cryptoKeyMeta.KeyPairID, cryptoKeyMeta.Version, associatedData)
+		if err != nil {
+			return nil, fmt.Errorf("%w", err)
+		}
+	default:
+		return nil, fmt.Errorf("unsupported algorithm for %s: %s", crypto.OperationEncrypt, cryptoKeyMeta.Algorithm)
+	}
+
+	s.logger.Info(fmt.Sprintf("Encrypted %d bytes with key %s", len(plaintext), cryptoKeyMeta.ID))
+	result := newOperationResult(crypto.OperationEncrypt, keyID, cryptoKeyMeta)
+	result.Ciphertext = ciphertext
+	return result, nil
+}
+
+// Decrypt decrypts an envelope produced by Encrypt with the key pair it records, which may be any version of the logical key, provided the caller may use the key
+func (s *cryptoOperationService) Decrypt(ctx context.Context, keyID string, ciphertext, associatedData []byte) (*crypto.OperationResult, error) {
+	if err := checkPayload(crypto.OperationDecrypt, ciphertext); err != nil {
+		return nil, fmt.Errorf("%w", err)
+	}
+	if !cryptography.IsEnvelope(ciphertext) {
+		return nil, fmt.Errorf("ciphertext is no envelope produced by %s", crypto.OperationEncrypt)
+	}
+	envelope, err := cryptography.ParseEnvelope(ciphertext)
+	if err != nil {
+		return nil, fmt.Errorf("%w", err)
+	}
+	if err := checkEnvelopeMode(envelope); err != nil {
+		return nil, fmt.Errorf("%w", err)
+	}
+
+	requestedKeyMeta, err := getAuthorizedCryptoKey(ctx, s.permissionManagement, s.cryptoKeyRepo, keyID, permissions.PermissionUseKey)
+	if err != nil {
+		return nil, fmt.Errorf("%w", err)
+	}
+	keyBytes, cryptoKeyMeta, err := s.getVersionKey(ctx, requestedKeyMeta, envelope.KeyID, "private", keys.KeyUsageProcess)
+	if err != nil {
+		return nil, fmt.Errorf("%w", err)
+	}
+	if err := checkEnvelopeKey(envelope, cryptoKeyMeta); err != nil {
+		return nil, fmt.Errorf("%w", err)
+	}
+
+	var plaintext []byte
+	switch cryptoKeyMeta.Algorithm {
+	case "AES":
+		aesProcessor, err := cryptography.NewAESProcessor(s.logger)
+		if err != nil {
+			return nil, fmt.Errorf("%w", err)
+		}
+		plaintext, err = aesProcessor.Decrypt(ciphertext, keyBytes, associatedData)
+		if err != nil {
+			return nil, fmt.Errorf("%w", err)
+		}
+	case "RSA":
+		rsaProcessor, err := cryptography.NewRSAProcessor(s.logger)
+		if err != nil {
+			return nil, fmt.Errorf("%w", err)
+		}
+		privateKey, err := newDecrypter(ctx, s.vaultConnector, keyBytes, cryptoKeyMeta)
+		if err != nil {
+			return nil, fmt.Errorf("%w", err)
+		}
+		plaintext, err = rsaProcessor.Decrypt(ciphertext, privateKey, associatedData)
+		if err != nil {
+			return nil, fmt.Errorf("%w", err)
+		}
+	default:
+		return nil, fmt.Errorf("unsupported algorithm for %s: %s", crypto.OperationDecrypt, cryptoKeyMeta.Algorithm)
+	}
+
+	s.logger.Info(fmt.Sprintf("Decrypted %d bytes with key %s", len(ciphertext), cryptoKeyMeta.ID))
+	result := newOperationResult(crypto.OperationDecrypt, keyID, cryptoKeyMeta)
+	result.Plaintext = plaintext
+	return result, nil
+}
+
+// Sign signs the SHA-256 digest of the data with the private key of the primary version, provided the caller may use the key
+func (s *cryptoOperationService) Sign(ctx context.Context, keyID string, data []byte) (*crypto.OperationResult, error) {
+	if err := checkPayload(crypto.OperationSign, data); err != nil {
+		return nil, fmt.Errorf("%w", err)
+	}
+
+	keyBytes, cryptoKeyMeta, err := s.getOperationKey(ctx, keyID, "private", keys.KeyUsageProtect)
+	if err != nil {
+		return nil, fmt.Errorf("%w", err)
+	}
+
+	if cryptoKeyMeta.Algorithm != "RSA" && cryptoKeyMeta.Algorithm != "EC" {
+		return nil, fmt.Errorf("unsupported algorithm for %s: %s", crypto.OperationSign, cryptoKeyMeta.Algorithm)
+	}
+	privateKey, err := newSigner(ctx, s.vaultConnector, keyBytes, cryptoKeyMeta)
+	if err != nil {
+		return nil, fmt.Errorf("%w", err)
+	}
+
+	digest := sha256.Sum256(data)
+	var signature []byte
+	switch cryptoKeyMeta.Algorithm {
+	case "RSA":
+		rsaProcessor, err := cryptography.NewRSAProcessor(s.logger)
+		if err != nil {
+			return nil, fmt.Errorf("%w", err)
+		}
+		signature, err = rsaProcessor.SignDigest(digest[:], privateKey)
+		if err != nil {
+			return nil, fmt.Errorf("signing error: %w", err)
+		}
+	case "EC":
+		ecProcessor, err := cryptography.NewECProcessor(s.logger)
+		if err != nil {
+			return nil, fmt.Errorf("%w", err)
+		}
+		signature, err = ecProcessor.SignDigest(digest[:], privateKey)
+		if err != nil {
+			return nil, fmt.Errorf("%w", err)
+		}
+	default:
+		return nil, fmt.Errorf("unsupported algorithm for %s: %s", crypto.OperationSign, cryptoKeyMeta.Algorithm)
+	}
+
+	s.logger.Info(fmt.Sprintf("Signed %d bytes with key %s", len(data), cryptoKeyMeta.ID))
+	result := newOperationResult(crypto.OperationSign, keyID, cryptoKeyMeta)
+	result.Signature = signature
+	return result, nil
+}
+
+// Verify verifies the signature of the data with the public key of the key pair, provided the caller may use the key.
+// Signatures made by other versions of the logical key are verified as well, trying the requested version first and the others newest first.
+// A signature not matching the data is reported as invalid result rather than as error.
+func (s *cryptoOperationService) Verify(ctx context.Context, keyID string, data, signature []byte) (*crypto.OperationResult, error) {
+	if err := checkPayload(crypto.OperationVerify, data); err != nil {
+		return nil, fmt.Errorf("%w", err)
+	}
+	if len(signature) == 0 {
+		return nil, fmt.Errorf("signature cannot be empty")
+	}
+
+	requestedKeyMeta, err := getAuthorizedCryptoKey(ctx, s.permissionManagement, s.cryptoKeyRepo, keyID, permissions.PermissionUseKey)
+	if err != nil {
+		return nil, fmt.Errorf("%w", err)
+	}
+	keyPairIDs, err := versionKeyPairIDs(ctx, s.cryptoKeyRepo, requestedKeyMeta)
+	if err != nil {
+		return nil, fmt.Errorf("%w", err)
+	}
+
+	digest := sha256.Sum256(data)
+	var verifiedKeyMeta *keys.CryptoKeyMeta
+	var firstErr error
+	for _, keyPairID := range keyPairIDs {
+		keyBytes, cryptoKeyMeta, err := s.getVersionKey(ctx, requestedKeyMeta, keyPairID, "public", keys.KeyUsageProcess)
+		var valid bool
+		if err == nil {
+			valid, err = s.verifyDigest(digest[:], signature, keyBytes, cryptoKeyMeta)
+		}
+		if err != nil {
+			if firstErr == nil {
+				firstErr = err
+			}
+			continue
+		}
+
+		if valid {
+			s.logger.Info(fmt.Sprintf("Verified signature of %d bytes with key %s: valid=%t", len(data), cryptoKeyMeta.ID, valid))
+			result := newOperationResult(crypto.OperationVerify, keyID, cryptoKeyMeta)
+			result.Valid = &valid
+			return result, nil
+		}
+		if verifiedKeyMeta == nil {
+			verifiedKeyMeta = cryptoKeyMeta
+		}
+	}
+	if verifiedKeyMeta == nil {
+		return nil, fmt.Errorf("%w", firstErr)
+	}
+
+	valid := false
+	s.logger.Info(fmt.Sprintf("Verified signature of %d bytes with key %s: valid=%t", len(data), verifiedKeyMeta.ID, valid))
+	result := newOperationResult(crypto.OperationVerify, keyID, verifiedKeyMeta)
+	result.Valid = &valid
+	return result, nil
+}
+
+// verifyDigest verifies the signature of the SHA-256 digest with the given public key
+func (s *cryptoOperationService) verifyDigest(digest, signature, keyBytes []byte, cryptoKeyMeta *keys.CryptoKeyMeta) (bool, error) {
+	switch cryptoKeyMeta.Algorithm {
+	case "RSA":
+		rsaProcessor, err := cryptography.NewRSAProcessor(s.logger)
+		if err != nil {
+			return false, fmt.Errorf("%w", err)
+		}
+		publicKey, err := parseRSAPublicKey(keyBytes)
+		if err != nil {
+			return false, fmt.Errorf("%w", err)
+		}
+		valid, err := rsaProcessor.VerifyDigest(digest, signature, publicKey)
+		if err != nil {
+			return false, fmt.Errorf("%w", err)
+		}
+		return valid, nil
+	case "EC":
+		ecProcessor, err := cryptography.NewECProcessor(s.logger)
+		if err != nil {
+			return false, fmt.Errorf("%w", err)
+		}
+		publicKey, err := cryptography.ParseECPublicKey(keyBytes)
+		if err != nil {
+			return false, fmt.Errorf("%w", err)
+		}
+		valid, err := ecProcessor.VerifyDigest(digest, signature, publicKey)
+		if err != nil {
+			return false, fmt.Errorf("%w", err)
+		}
+		return valid, nil
+	default:
+		return false, fmt.Errorf("unsupported algorithm for %s: %s", crypto.OperationVerify, cryptoKeyMeta.Algorithm)
+	}
+}
+
+// Wrap wraps key material with AES Key Wrap with Padding or RSA-OAEP using the primary version, provided the caller may use the key
+func (s *cryptoOperationService) Wrap(ctx context.Context, keyID string, key []byte) (*crypto.OperationResult, error) {
+	if err := checkPayload(crypto.OperationWrap, key); err != nil {
+		return nil, fmt.Errorf("%w", err)
+	}
+
+	keyBytes, cryptoKeyMeta, err := s.getOperationKey(ctx, keyID, "public", keys.KeyUsageProtect)
+	if err != nil {
+		return nil, fmt.Errorf("%w", err)
+	}
+
+	var wrappedKey []byte
+	switch cryptoKeyMeta.Algorithm {
+	case "AES":
+		wrappedKey, err = cryptography.AESKeyWrapPad(keyBytes, key)
+		if err != nil {
+			return nil, fmt.Errorf("%w", err)
+		}
+	case "RSA":
+		publicKey, err := parseRSAPublicKey(keyBytes)
+		if err != nil {
+			return nil, fmt.Errorf("%w", err)
+		}
+		wrappedKey, err = cryptography.RSAOAEPWrapKey(publicKey, key)
+		if err != nil {
+			return nil, fmt.Errorf("%w", err)
+		}
+	default:
+		return nil, fmt.Errorf("unsupported algorithm for %s: %s", crypto.OperationWrap, cryptoKeyMeta.Algorithm)
+	}
+
+	s.logger.Info(fmt.Sprintf("Wrapped %d bytes of key material with key %s", len(key), cryptoKeyMeta.ID))
+	result := newOperationResult(crypto.OperationWrap, keyID, cryptoKeyMeta)
+	result.WrappedKey = wrappedKey
+	return result, nil
+}
+
+// Unwrap unwraps key material wrapped by Wrap, provided the caller may use the key.
+// Key material wrapped by other versions of the logical key is unwrapped as well, trying the requested version first and the others newest first.
+// Material of keys whose plaintext export is forbidden, exported wrapped with the key, is refused with keys.ErrExportForbidden.
+func (s *cryptoOperationService) Unwrap(ctx context.Context, keyID string, wrappedKey []byte) (*crypto.OperationResult, error) {
+	if err := checkPayload(crypto.OperationUnwrap, wrappedKey); err != nil {
+		return nil, fmt.Errorf("%w", err)
+	}
+
+	requestedKeyMeta, err := getAuthorizedCryptoKey(ctx, s.permissionManagement, s.cryptoKeyRepo, keyID, permissions.PermissionUseKey)
+	if err != nil {
+		return nil, fmt.Errorf("%w", err)
+	}
+	keyPairIDs, err := versionKeyPairIDs(ctx, s.cryptoKeyRepo, requestedKeyMeta)
+	if err != nil {
+		return nil, fmt.Errorf("%w", err)
+	}
+
+	var firstErr error
+	for _, keyPairID := range keyPairIDs {
+		keyBytes, cryptoKeyMeta, err := s.getVersionKey(ctx, requestedKeyMeta, keyPairID, "private", keys.KeyUsageProcess)
+		var key []byte
+		if err == nil {
+			key, err = s.unwrapKeyMaterial(ctx, keyBytes, wrappedKey, cryptoKeyMeta)
+		}
+		if err != nil {
+			if firstErr == nil {
+				firstErr = err
+			}
+			continue
+		}
+		if cryptography.IsNonExportableKey(key) {
+			return nil, fmt.Errorf("%w: key material was exported wrapped with key %s and may not be unwrapped in plaintext", keys.ErrExportForbidden, cryptoKeyMeta.ID)
+		}
+
+		s.logger.Info(fmt.Sprintf("Unwrapped %d bytes of key material with key %s", len(key), cryptoKeyMeta.ID))
+		result := newOperationResult(crypto.OperationUnwrap, keyID, cryptoKeyMeta)
+		result.Key = key
+		return result, nil
+	}
+
+	return nil, fmt.Errorf("%w", firstErr)
+}
+
+// unwrapKeyMaterial unwraps key material wrapped with AES Key Wrap with Padding or RSA-OAEP using the given key, on the PKCS#11 token for keys generated on it
+func (s *cryptoOperationService) unwrapKeyMaterial(ctx context.Context, keyBytes, wrappedKey []byte, cryptoKeyMeta *keys.CryptoKeyMeta) ([]byte, error) {
+	switch cryptoKeyMeta.Algorithm {
+	case "AES":
+		key, err := cryptography.AESKeyUnwrapPad(keyBytes, wrappedKey)
+		if err != nil {
+			return nil, fmt.Errorf("%w", err)
+		}
+		return key, nil
+	case "RSA":
+		privateKey, err := newDecrypter(ctx, s.vaultConnector, keyBytes, cryptoKeyMeta)
+		if err != nil {
+			return nil, fmt.Errorf("%w", err)
+		}
+		key, err := cryptography.RSAOAEPUnwrapKey(privateKey, wrappedKey)
+		if err != nil {
+			return nil, fmt.Errorf("%w", err)
+		}
+		return key, nil
+	default:
+		return nil, fmt.Errorf("unsupported algorithm for %s: %s", crypto.OperationUnwrap, cryptoKeyMeta.Algorithm)
+	}
+}
+
+// getOperationKey retrieves the key performing an operation requested with the given key ID along with its metadata.
+// Data is protected with the primary version of the logical key the requested key is a version of, and processed with the requested version.
+func (s *cryptoOperationService) getOperationKey(ctx context.Context, keyID, keyType, usage string) ([]byte, *keys.CryptoKeyMeta, error) {
+	cryptoKeyMeta, err := getAuthorizedCryptoKey(ctx, s.permissionManagement, s.cryptoKeyRepo, keyID, permissions.PermissionUseKey)
+	if err != nil {
+		return nil, nil, fmt.Errorf("%w", err)
+	}
+
+	keyPairID := cryptoKeyMeta.KeyPairID
+	if usage == keys.KeyUsageProtect {
+		keyPairID, err = primaryKeyPairID(ctx, s.logicalKeyRepo, cryptoKeyMeta)
+		if err != nil {
+			return nil, nil, fmt.Errorf("%w", err)
+		}
+	}
+
+	return s.getVersionKey(ctx, cryptoKeyMeta, keyPairID, keyType, usage)
+}
+
+// getVersionKey retrieves the key of the version with the given key pair of the logical key the requested key is a version of, along with its metadata.
+// Symmetric keys perform all operations themselves, whereas for key pairs the private or public key of the pair is resolved by keyType.
+// Private keys generated on a PKCS#11 token are returned without key bytes, since they never leave the token.
+// The caller must be permitted to use both the requested and the resolved key, and the lifecycle state of the resolved key must permit the usage.
+func (s *cryptoOperationService) getVersionKey(ctx context.Context, requestedKeyMeta *keys.CryptoKeyMeta, keyPairID, keyType, usage string) ([]byte, *keys.CryptoKeyMeta, error) {
+	cryptoKeyMeta, err := resolveKeyVersion(ctx, s.cryptoKeyRepo, requestedKeyMeta, keyPairID, keyType)
+	if err != nil {
+		return nil, nil, fmt.Errorf("%w", err)
+	}
+	if cryptoKeyMeta.ID != requestedKeyMeta.ID {
+		if err := checkPermission(ctx, s.permissionManagement, permissions.PermissionUseKey, permissions.Key(cryptoKeyMeta.ID)); err != nil {
+			return nil, nil, fmt.Errorf("%w", err)
+		}
+	}
+	if err := cryptoKeyMeta.CheckUsage(usage, time.Now()); err != nil {
+		return nil, nil, fmt.Errorf("%w", err)
+	}
+
+	keyBytes, err := loadCryptoKey(ctx, s.vaultConnector, s.masterKeyProvider, cryptoKeyMeta)
+	if err != nil {
+		return nil, nil, fmt.Errorf("%w", err)
+	}
+
+	return keyBytes, cryptoKeyMeta, nil
+}
+
+// checkPayload rejects empty payloads and payloads exceeding crypto.MaxPayloadSize
+func checkPayload(operation string, payload []byte) error {
+	if len(payload) == 0 {
+		return fmt.Errorf("payload to %s cannot be empty", operation)
+	}
+	if len(payload) > crypto.MaxPayloadSize {
+		return fmt.Errorf("payload to %s of %d bytes exceeds the limit of %d bytes", operation, len(payload), crypto.MaxPayloadSize)
+	}
+	return nil
+}
+
+// checkEnvelopeMode rejects envelopes in modes Encrypt does not produce.
+// Decrypting caller-built AES-CBC or RSA PKCS#1 v1.5 envelopes would turn the service into a padding oracle on the key.
+func checkEnvelopeMode(envelope *cryptography.Envelope) error {
+	switch envelope.Mode {
+	case cryptography.AESModeGCM, cryptography.AESModeGCMStream, cryptography.RSAModeOAEPHybrid, cryptography.RSAModeOAEPHybridStream:
+		return nil
+	default:
+		return fmt.Errorf("ciphertext is encrypted in mode %s, which %s does not produce", envelope.Mode, crypto.OperationEncrypt)
+	}
+}
+
+// parseRSAPublicKey parses an RSA public key encoded as PKIX
+func parseRSAPublicKey(keyBytes []byte) (*crypto_rsa.PublicKey, error) {
+	publicKeyInterface, err := x509.ParsePKIXPublicKey(keyBytes)
+	if err != nil {
+		return nil, fmt.Errorf("error parsing public key: %w", err)
+	}
+	publicKey, ok := publicKeyInterface.(*crypto_rsa.PublicKey)
+	if !ok {
+		return nil, fmt.Errorf("public key is not of type RSA")
+	}
+	return publicKey, nil
+}
+
+// newOperationResult returns the result of an operation requested with keyID and performed by the given key
+func newOperationResult(operation, keyID string, cryptoKeyMeta *keys.CryptoKeyMeta) *crypto.OperationResult {
+	return &crypto.OperationResult{
+		Operation:  operation,
+		KeyID:      keyID,
+		KeyPairID:  cryptoKeyMeta.KeyPairID,
+		KeyVersion: cryptoKeyMeta.Version,
+		Algorithm:  cryptoKeyMeta.Algorithm,
+	}
+}
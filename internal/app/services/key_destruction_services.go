@@ -0,0 +1,98 @@
+package services
+
+import (
+	"context"
+	"crypto_vault_service/internal/domain/keys"
+	"crypto_vault_service/internal/infrastructure/connector"
+	"crypto_vault_service/internal/infrastructure/logger"
+	"errors"
+	"fmt"
+	"time"
+)
+
+// keyDestructionPageSize is the number of keys due for destruction fetched at once
+const keyDestructionPageSize = 100
+
+// cryptoKeyDestructionService implements the CryptoKeyDestructionService interface to destroy keys whose scheduled destruction is due
+type cryptoKeyDestructionService struct {
+	vaultConnector connector.VaultConnector
+	cryptoKeyRepo  keys.CryptoKeyRepository
+	interval       time.Duration
+	logger         logger.Logger
+}
+
+// NewCryptoKeyDestructionService creates a new cryptoKeyDestructionService instance destroying due keys in the given interval when running
+func NewCryptoKeyDestructionService(vaultConnector connector.VaultConnector, cryptoKeyRepo keys.CryptoKeyRepository, interval time.Duration, logger logger.Logger) (keys.CryptoKeyDestructionService, error) {
+	if interval <= 0 {
+		return nil, fmt.Errorf("key destruction interval must be positive, got %s", interval)
+	}
+
+	return &cryptoKeyDestructionService{
+		vaultConnector: vaultConnector,
+		cryptoKeyRepo:  cryptoKeyRepo,
+		interval:       interval,
+		logger:         logger,
+	}, nil
+}
+
+// DestroyDue deletes the material of all keys whose scheduled destruction is due from the vault and records them as destroyed.
+// Keys that fail to be destroyed are skipped and retried on the next call.
+func (s *cryptoKeyDestructionService) DestroyDue(ctx context.Context) (int, error) {
+	destroyedKeys := 0
+	var destructionErrs []error
+
+	for {
+		dueKeyMetas, err := s.cryptoKeyRepo.ListDueForDestruction(ctx, time.Now(), keyDestructionPageSize)
+		if err != nil {
+			return destroyedKeys, fmt.Errorf("%w", err)
+		}
+
+		pageDestroyedKeys := 0
+		for _, keyMeta := range dueKeyMetas {
+			if err := s.destroy(ctx, keyMeta); err != nil {
+				destructionErrs = append(destructionErrs, err)
+				continue
+			}
+			pageDestroyedKeys++
+		}
+		destroyedKeys += pageDestroyedKeys
+
+		// Stop once no keys are left or the remaining ones keep failing
+		if len(dueKeyMetas) < keyDestructionPageSize || pageDestroyedKeys == 0 {
+			return destroyedKeys, errors.Join(destructionErrs...)
+		}
+	}
+}
+
+// destroy deletes the material of a key from the vault and records it as destroyed
+func (s *cryptoKeyDestructionService) destroy(ctx context.Context, keyMeta *keys.CryptoKeyMeta) error {
+	if err := s.vaultConnector.Delete(ctx, keyMeta.ID, keyMeta.KeyPairID, keyMeta.Type); err != nil {
+		return fmt.Errorf("failed to destroy key %s: %w", keyMeta.ID, err)
+	}
+
+	keyMeta.State = keys.KeyStateDestroyed
+	if err := s.cryptoKeyRepo.UpdateByID(ctx, keyMeta); err != nil {
+		return fmt.Errorf("failed to record destruction of key %s: %w", keyMeta.ID, err)
+	}
+
+	s.logger.Info(fmt.Sprintf("Destroyed key %s of key pair %s", keyMeta.ID, keyMeta.KeyPairID))
+	return nil
+}
+
+// Run destroys due keys right away and then once per interval until ctx is done
+func (s *cryptoKeyDestructionService) Run(ctx context.Context) {
+	ticker := time.NewTicker(s.interval)
+	defer ticker.Stop()
+
+	for {
+		if _, err := s.DestroyDue(ctx); err != nil {
+			s.logger.Error(fmt.Sprintf("Failed to destroy keys due for destruction: %v", err))
+		}
+
+		select {
+		case <-ctx.Done():
+			return
+		case <-ticker.C:
+		}
+	}
+}
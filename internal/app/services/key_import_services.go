@@ -0,0 +1,251 @@
+package services
+
+import (
+	"context"
+	"crypto/ecdsa"
+	"crypto/rsa"
+	"crypto/x509"
+	"crypto_vault_service/internal/domain/auth"
+	"crypto_vault_service/internal/domain/keys"
+	"crypto_vault_service/internal/domain/permissions"
+	"crypto_vault_service/internal/domain/validators"
+	"crypto_vault_service/internal/infrastructure/connector"
+	"crypto_vault_service/internal/infrastructure/cryptography"
+	"crypto_vault_service/internal/infrastructure/logger"
+	"encoding/pem"
+	"fmt"
+	"sync"
+	"time"
+
+	"github.com/google/uuid"
+)
+
+const (
+	keyImportKeyType      = "private"
+	keyImportKeyAlgorithm = "RSA"
+	keyImportKeySize      = 3072
+)
+
+// cryptoKeyImportService implements the CryptoKeyImportService interface for importing key material generated outside the service.
+// Imported keys are stored like generated keys: wrapped with the master key in the vault, forming version 1 of a new logical key.
+// The RSA key unwrapping key material imported wrapped is generated on first use, wrapped with the master key and stored in the vault.
+type cryptoKeyImportService struct {
+	keyUploadService *cryptoKeyUploadService
+	keyImportKeyRepo keys.KeyImportKeyRepository
+	importKey        *rsa.PrivateKey
+	importKeyMu      sync.Mutex
+	logger           logger.Logger
+}
+
+// NewCryptoKeyImportService creates a new cryptoKeyImportService instance
+func NewCryptoKeyImportService(vaultConnector connector.VaultConnector, cryptoKeyRepo keys.CryptoKeyRepository, logicalKeyRepo keys.LogicalKeyRepository, keyImportKeyRepo keys.KeyImportKeyRepository, masterKeyProvider cryptography.MasterKeyProvider, permissionManagement permissions.PermissionManagement, logger logger.Logger) (keys.CryptoKeyImportService, error) {
+	return &cryptoKeyImportService{
+		keyUploadService: &cryptoKeyUploadService{
+			vaultConnector:       vaultConnector,
+			cryptoKeyRepo:        cryptoKeyRepo,
+			logicalKeyRepo:       logicalKeyRepo,
+			masterKeyProvider:    masterKeyProvider,
+			permissionManagement: permissionManagement,
+			logger:               logger,
+		},
+		keyImportKeyRepo: keyImportKeyRepo,
+		logger:           logger,
+	}, nil
+}
+
+// GetImportPublicKey returns the PEM encoded PKIX public key of the import key, clients wrap key material with as specified by cryptography.RSAAESKeyWrap
+func (s *cryptoKeyImportService) GetImportPublicKey(ctx context.Context) ([]byte, error) {
+	importKey, err := s.getImportKey(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("%w", err)
+	}
+
+	publicKeyBytes, err := x509.MarshalPKIXPublicKey(&importKey.PublicKey)
+	if err != nil {
+		return nil, fmt.Errorf("failed to marshal import public key: %w", err)
+	}
+
+	return pem.EncodeToMemory(&pem.Block{Type: "PUBLIC KEY", Bytes: publicKeyBytes}), nil
+}
+
+// Import imports key material owned by the authenticated caller carried by ctx, forming version 1 of a new logical key whose ID equals the key pair ID.
+// Key material imported wrapped is unwrapped with the import key first. Its key size must be one the service generates keys of.
+func (s *cryptoKeyImportService) Import(ctx context.Context, keyImport *keys.CryptoKeyImport, lifecycle *keys.CryptoKeyLifecycle) ([]*keys.CryptoKeyMeta, error) {
+	userID, err := auth.UserIDFromContext(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("%w", err)
+	}
+
+	if err := keyImport.Validate(); err != nil {
+		return nil, fmt.Errorf("%w", err)
+	}
+
+	keyMaterial := keyImport.KeyMaterial
+	if keyImport.Wrapped {
+		importKey, err := s.getImportKey(ctx)
+		if err != nil {
+			return nil, fmt.Errorf("%w", err)
+		}
+
+		keyMaterial, err = cryptography.RSAAESKeyUnwrap(importKey, keyImport.KeyMaterial)
+		if err != nil {
+			return nil, fmt.Errorf("failed to unwrap imported key material: %w", err)
+		}
+	}
+
+	importedKey, err := cryptography.ParseImportedKey(keyImport.Algorithm, keyImport.Format, keyMaterial)
+	if err != nil {
+		return nil, fmt.Errorf("invalid key material: %w", err)
+	}
+	if !validators.ValidKeySize(importedKey.Algorithm, uint64(importedKey.KeySize)) {
+		return nil, fmt.Errorf("key size %d not supported for %s", importedKey.KeySize, importedKey.Algorithm)
+	}
+
+	initialLifecycle, err := newInitialLifecycle(lifecycle, time.Now())
+	if err != nil {
+		return nil, fmt.Errorf("%w", err)
+	}
+
+	keyPairID := uuid.New().String()
+	keyMetas, err := s.storeImportedKey(ctx, importedKey, userID, keyPairID, initialLifecycle)
+	if err != nil {
+		return nil, fmt.Errorf("%w", err)
+	}
+
+	if err := s.keyUploadService.createLogicalKey(ctx, userID, keyPairID, importedKey.Algorithm, importedKey.KeySize); err != nil {
+		s.keyUploadService.rollbackStoredKeys(ctx, keyMetas, userID)
+		return nil, fmt.Errorf("%w", err)
+	}
+
+	s.logger.Info(fmt.Sprintf("Imported %s key of size %d with key pair %s", importedKey.Algorithm, importedKey.KeySize, keyPairID))
+	return keyMetas, nil
+}
+
+// storeImportedKey encodes the imported keys the way generated keys are stored and stores them wrapped with the master key.
+// RSA private keys are stored as PKCS#1, EC private keys as PKCS#8 and public keys as PKIX.
+func (s *cryptoKeyImportService) storeImportedKey(ctx context.Context, importedKey *cryptography.ImportedKey, userID, keyPairID string, lifecycle *keys.CryptoKeyLifecycle) ([]*keys.CryptoKeyMeta, error) {
+	if importedKey.SymmetricKey != nil {
+		keyMeta, err := s.keyUploadService.storeWrappedKey(ctx, importedKey.SymmetricKey, userID, keyPairID, keyPairID, 1, "symmetric", importedKey.Algorithm, importedKey.KeySize, lifecycle)
+		if err != nil {
+			return nil, fmt.Errorf("%w", err)
+		}
+		return []*keys.CryptoKeyMeta{keyMeta}, nil
+	}
+
+	var keyMetas []*keys.CryptoKeyMeta
+
+	if importedKey.PrivateKey != nil {
+		var privateKeyBytes []byte
+		var err error
+		switch privateKey := importedKey.PrivateKey.(type) {
+		case *rsa.PrivateKey:
+			privateKeyBytes = x509.MarshalPKCS1PrivateKey(privateKey)
+		case *ecdsa.PrivateKey:
+			privateKeyBytes, err = cryptography.MarshalECPrivateKey(privateKey)
+		default:
+			err = fmt.Errorf("private key of type %T not supported", privateKey)
+		}
+		if err != nil {
+			return nil, fmt.Errorf("%w", err)
+		}
+
+		keyMeta, err := s.keyUploadService.storeWrappedKey(ctx, privateKeyBytes, userID, keyPairID, keyPairID, 1, "private", importedKey.Algorithm, importedKey.KeySize, lifecycle)
+		if err != nil {
+			return nil, fmt.Errorf("%w", err)
+		}
+		keyMetas = append(keyMetas, keyMeta)
+	}
+
+	publicKeyBytes, err := x509.MarshalPKIXPublicKey(importedKey.PublicKey)
+	if err != nil {
+		return nil, fmt.Errorf("failed to marshal public key: %w", err)
+	}
+
+	keyMeta, err := s.keyUploadService.storeWrappedKey(ctx, publicKeyBytes, userID, keyPairID, keyPairID, 1, "public", importedKey.Algorithm, importedKey.KeySize, lifecycle)
+	if err != nil {
+		return nil, fmt.Errorf("%w", err)
+	}
+	keyMetas = append(keyMetas, keyMeta)
+
+	return keyMetas, nil
+}
+
+// getImportKey returns the import key, loading it from the vault or generating it on first use.
+// Instances generating an import key concurrently agree on the earliest created one.
+func (s *cryptoKeyImportService) getImportKey(ctx context.Context) (*rsa.PrivateKey, error) {
+	s.importKeyMu.Lock()
+	defer s.importKeyMu.Unlock()
+
+	if s.importKey != nil {
+		return s.importKey, nil
+	}
+
+	importKeyMeta, err := s.keyImportKeyRepo.GetFirst(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("%w", err)
+	}
+	if importKeyMeta == nil {
+		if err := s.createImportKey(ctx); err != nil {
+			return nil, fmt.Errorf("%w", err)
+		}
+
+		importKeyMeta, err = s.keyImportKeyRepo.GetFirst(ctx)
+		if err != nil {
+			return nil, fmt.Errorf("%w", err)
+		}
+	}
+
+	wrappedImportKey, err := s.keyUploadService.vaultConnector.Download(ctx, importKeyMeta.ID, importKeyMeta.KeyPairID, keyImportKeyType)
+	if err != nil {
+		return nil, fmt.Errorf("%w", err)
+	}
+
+	importKeyBytes, err := unwrapStoredKey(s.keyUploadService.masterKeyProvider, wrappedImportKey, importKeyMeta.KEKVersion, importKeyMeta.ID, importKeyMeta.KeyPairID, keyImportKeyType)
+	if err != nil {
+		return nil, fmt.Errorf("failed to unwrap import key with master key: %w", err)
+	}
+
+	importKey, err := x509.ParsePKCS1PrivateKey(importKeyBytes)
+	if err != nil {
+		return nil, fmt.Errorf("failed to parse import key: %w", err)
+	}
+
+	s.importKey = importKey
+	return importKey, nil
+}
+
+// createImportKey generates an RSA key pair, wraps its private key with the master key, uploads it to the vault and records its location
+func (s *cryptoKeyImportService) createImportKey(ctx context.Context) error {
+	rsaProcessor, err := cryptography.NewRSAProcessor(s.logger)
+	if err != nil {
+		return fmt.Errorf("%w", err)
+	}
+
+	privateKey, _, err := rsaProcessor.GenerateKeys(keyImportKeySize)
+	if err != nil {
+		return fmt.Errorf("failed to generate import key: %w", err)
+	}
+
+	keyID, keyPairID := uuid.New().String(), uuid.New().String()
+	wrappedImportKey, kekVersion, err := s.keyUploadService.masterKeyProvider.Wrap(x509.MarshalPKCS1PrivateKey(privateKey), keyID, keyPairID, keyImportKeyType)
+	if err != nil {
+		return fmt.Errorf("failed to wrap import key with master key: %w", err)
+	}
+
+	keyMeta, err := s.keyUploadService.vaultConnector.Upload(ctx, wrappedImportKey, keyID, "", keyPairID, keyImportKeyType, keyImportKeyAlgorithm, keyImportKeySize)
+	if err != nil {
+		return fmt.Errorf("%w", err)
+	}
+
+	if err := s.keyImportKeyRepo.Create(ctx, &keys.KeyImportKey{
+		ID:              keyMeta.ID,
+		KeyPairID:       keyMeta.KeyPairID,
+		KEKVersion:      kekVersion,
+		DateTimeCreated: keyMeta.DateTimeCreated,
+	}); err != nil {
+		return fmt.Errorf("%w", err)
+	}
+
+	s.logger.Info(fmt.Sprintf("Created key import key %s", keyMeta.ID))
+	return nil
+}
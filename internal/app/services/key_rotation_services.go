@@ -0,0 +1,383 @@
+package services
+
+import (
+	"context"
+	"crypto_vault_service/internal/domain/keys"
+	"crypto_vault_service/internal/domain/permissions"
+	"crypto_vault_service/internal/infrastructure/connector"
+	"crypto_vault_service/internal/infrastructure/cryptography"
+	"crypto_vault_service/internal/infrastructure/logger"
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/google/uuid"
+)
+
+// keyRotationPageSize is the number of logical keys due for rotation fetched at once
+const keyRotationPageSize = 100
+
+// keyRotationAttempts is the number of times a requested rotation is attempted while concurrent rotations keep taking the next version
+const keyRotationAttempts = 3
+
+// cryptoKeyRotationService implements the CryptoKeyRotationService interface to rotate the versions of logical keys
+type cryptoKeyRotationService struct {
+	keyUploadService     *cryptoKeyUploadService
+	cryptoKeyRepo        keys.CryptoKeyRepository
+	logicalKeyRepo       keys.LogicalKeyRepository
+	permissionManagement permissions.PermissionManagement
+	interval             time.Duration
+	logger               logger.Logger
+}
+
+// NewCryptoKeyRotationService creates a new cryptoKeyRotationService instance rotating due logical keys in the given interval when running
+func NewCryptoKeyRotationService(vaultConnector connector.VaultConnector, cryptoKeyRepo keys.CryptoKeyRepository, logicalKeyRepo keys.LogicalKeyRepository, masterKeyProvider cryptography.MasterKeyProvider, permissionManagement permissions.PermissionManagement, interval time.Duration, logger logger.Logger) (keys.CryptoKeyRotationService, error) {
+	if interval <= 0 {
+		return nil, fmt.Errorf("key rotation interval must be positive, got %s", interval)
+	}
+
+	return &cryptoKeyRotationService{
+		keyUploadService: &cryptoKeyUploadService{
+			vaultConnector:       vaultConnector,
+			cryptoKeyRepo:        cryptoKeyRepo,
+			logicalKeyRepo:       logicalKeyRepo,
+			masterKeyProvider:    masterKeyProvider,
+			permissionManagement: permissionManagement,
+			logger:               logger,
+		},
+		cryptoKeyRepo:        cryptoKeyRepo,
+		logicalKeyRepo:       logicalKeyRepo,
+		permissionManagement: permissionManagement,
+		interval:             interval,
+		logger:               logger,
+	}, nil
+}
+
+// Rotate generates a new version of the logical key the given key is a version of and makes it the primary version, provided the caller may manage the key.
+// The keys of the new version are active from now on and owned by the owner of the logical key.
+func (s *cryptoKeyRotationService) Rotate(ctx context.Context, keyID string) ([]*keys.CryptoKeyMeta, error) {
+	cryptoKeyMeta, err := getAuthorizedCryptoKey(ctx, s.permissionManagement, s.cryptoKeyRepo, keyID, permissions.PermissionManageKey)
+	if err != nil {
+		return nil, fmt.Errorf("%w", err)
+	}
+
+	logicalKey, err := s.getLogicalKey(ctx, cryptoKeyMeta)
+	if err != nil {
+		return nil, fmt.Errorf("%w", err)
+	}
+
+	for attempt := 1; ; attempt++ {
+		keyMetas, err := s.rotate(ctx, logicalKey, time.Now())
+		if err == nil {
+			return keyMetas, nil
+		}
+		if !errors.Is(err, keys.ErrConcurrentRotation) || attempt == keyRotationAttempts {
+			return nil, fmt.Errorf("%w", err)
+		}
+
+		logicalKey, err = s.logicalKeyRepo.GetByID(ctx, logicalKey.ID)
+		if err != nil {
+			return nil, fmt.Errorf("%w", err)
+		}
+	}
+}
+
+// ListVersions retrieves the metadata of the keys of all versions of the logical key the given key is a version of, provided the caller may manage the key.
+// Keys predating versioning which have not been rotated yet only list the keys of their key pair.
+func (s *cryptoKeyRotationService) ListVersions(ctx context.Context, keyID string) ([]*keys.CryptoKeyMeta, error) {
+	cryptoKeyMeta, err := getAuthorizedCryptoKey(ctx, s.permissionManagement, s.cryptoKeyRepo, keyID, permissions.PermissionManageKey)
+	if err != nil {
+		return nil, fmt.Errorf("%w", err)
+	}
+
+	query := keys.NewCryptoKeyQuery()
+	query.Limit = 0
+	if cryptoKeyMeta.LogicalKeyID != "" {
+		query.LogicalKeyID = cryptoKeyMeta.LogicalKeyID
+		query.SortBy = "version"
+		query.SortOrder = "desc"
+	} else {
+		query.KeyPairID = cryptoKeyMeta.KeyPairID
+	}
+
+	keyMetas, err := s.cryptoKeyRepo.List(ctx, query)
+	if err != nil {
+		return nil, fmt.Errorf("%w", err)
+	}
+
+	return keyMetas, nil
+}
+
+// UpdateRotationPolicy sets the period after which the logical key the given key is a version of is rotated automatically, provided the caller may manage the key.
+// The next rotation is scheduled one period from now, a period of 0 disables automatic rotation.
+func (s *cryptoKeyRotationService) UpdateRotationPolicy(ctx context.Context, keyID string, rotationPeriod time.Duration) (*keys.LogicalKey, error) {
+	if rotationPeriod < 0 {
+		return nil, fmt.Errorf("rotation period must not be negative, got %s", rotationPeriod)
+	}
+
+	cryptoKeyMeta, err := getAuthorizedCryptoKey(ctx, s.permissionManagement, s.cryptoKeyRepo, keyID, permissions.PermissionManageKey)
+	if err != nil {
+		return nil, fmt.Errorf("%w", err)
+	}
+
+	logicalKey, err := s.getLogicalKey(ctx, cryptoKeyMeta)
+	if err != nil {
+		return nil, fmt.Errorf("%w", err)
+	}
+
+	logicalKey.RotationPeriod = rotationPeriod
+	logicalKey.DateTimeNextRotation = nil
+	if rotationPeriod > 0 {
+		nextRotation := time.Now().Add(rotationPeriod)
+		logicalKey.DateTimeNextRotation = &nextRotation
+	}
+	if err := logicalKey.Validate(); err != nil {
+		return nil, fmt.Errorf("%w", err)
+	}
+
+	// Only the policy is written, so the versions of rotations running meanwhile are kept
+	if err := s.logicalKeyRepo.UpdateRotationPolicy(ctx, logicalKey.ID, logicalKey.RotationPeriod, logicalKey.DateTimeNextRotation); err != nil {
+		return nil, fmt.Errorf("%w", err)
+	}
+
+	s.logger.Info(fmt.Sprintf("Updated rotation period of logical key %s to %s", logicalKey.ID, rotationPeriod))
+	return logicalKey, nil
+}
+
+// RotateDue rotates all logical keys whose automatic rotation is due.
+// Logical keys that fail to be rotated are skipped and retried on the next call, those rotated concurrently meanwhile are skipped for good.
+func (s *cryptoKeyRotationService) RotateDue(ctx context.Context) (int, error) {
+	rotatedKeys := 0
+	var rotationErrs []error
+
+	for {
+		dueLogicalKeys, err := s.logicalKeyRepo.ListDueForRotation(ctx, time.Now(), keyRotationPageSize)
+		if err != nil {
+			return rotatedKeys, fmt.Errorf("%w", err)
+		}
+
+		pageRotatedKeys := 0
+		pageSettledKeys := 0
+		for _, logicalKey := range dueLogicalKeys {
+			if _, err := s.rotate(ctx, logicalKey, time.Now()); err != nil {
+				// Another instance or a requested rotation took the version, which rotated the logical key already
+				if errors.Is(err, keys.ErrConcurrentRotation) {
+					pageSettledKeys++
+					continue
+				}
+				rotationErrs = append(rotationErrs, fmt.Errorf("failed to rotate logical key %s: %w", logicalKey.ID, err))
+				continue
+			}
+			pageRotatedKeys++
+			pageSettledKeys++
+		}
+		rotatedKeys += pageRotatedKeys
+
+		// Stop once no logical keys are left or the remaining ones keep failing
+		if len(dueLogicalKeys) < keyRotationPageSize || pageSettledKeys == 0 {
+			return rotatedKeys, errors.Join(rotationErrs...)
+		}
+	}
+}
+
+// Run rotates due logical keys right away and then once per interval until ctx is done
+func (s *cryptoKeyRotationService) Run(ctx context.Context) {
+	ticker := time.NewTicker(s.interval)
+	defer ticker.Stop()
+
+	for {
+		if _, err := s.RotateDue(ctx); err != nil {
+			s.logger.Error(fmt.Sprintf("Failed to rotate logical keys due for rotation: %v", err))
+		}
+
+		select {
+		case <-ctx.Done():
+			return
+		case <-ticker.C:
+		}
+	}
+}
+
+// rotate generates the next version of the logical key, makes it the primary version and schedules the next automatic rotation if a rotation period is set.
+// The version is reserved before its keys are generated and fails with keys.ErrConcurrentRotation if the logical key was rotated since it was read,
+// so concurrent rotations neither generate the same version twice nor leave key pairs of a version they lost behind.
+func (s *cryptoKeyRotationService) rotate(ctx context.Context, logicalKey *keys.LogicalKey, now time.Time) ([]*keys.CryptoKeyMeta, error) {
+	version := logicalKey.LatestVersion + 1
+	keyPairID := uuid.New().String()
+	lifecycle := &keys.CryptoKeyLifecycle{State: keys.KeyStateActive, DateTimeActivation: &now}
+
+	plaintextExportForbidden, err := s.plaintextExportForbidden(ctx, logicalKey.PrimaryKeyPairID)
+	if err != nil {
+		return nil, fmt.Errorf("%w", err)
+	}
+
+	var nextRotation *time.Time
+	if logicalKey.RotationPeriod > 0 {
+		nextRotationTime := now.Add(logicalKey.RotationPeriod)
+		nextRotation = &nextRotationTime
+	}
+	if err := s.logicalKeyRepo.ReserveVersion(ctx, logicalKey.ID, logicalKey.LatestVersion, nextRotation); err != nil {
+		return nil, fmt.Errorf("%w", err)
+	}
+
+	keyMetas, err := s.keyUploadService.uploadKeyVersion(ctx, logicalKey.UserID, keyPairID, logicalKey.ID, version, logicalKey.Algorithm, logicalKey.KeySize, lifecycle)
+	if err != nil {
+		// The reserved version stays unused, restoring the schedule retries a due rotation on the next call
+		if scheduleErr := s.logicalKeyRepo.UpdateRotationPolicy(ctx, logicalKey.ID, logicalKey.RotationPeriod, logicalKey.DateTimeNextRotation); scheduleErr != nil {
+			s.logger.Error(fmt.Sprintf("Failed to restore rotation schedule of logical key %s: %v", logicalKey.ID, scheduleErr))
+		}
+		return nil, fmt.Errorf("%w", err)
+	}
+
+	// The new version inherits the export policy of the primary version, so rotating never permits plaintext export again
+	if plaintextExportForbidden {
+		for _, keyMeta := range keyMetas {
+			keyMeta.PlaintextExportForbidden = true
+			if err := s.cryptoKeyRepo.UpdateByID(ctx, keyMeta); err != nil {
+				return nil, fmt.Errorf("%w", err)
+			}
+		}
+	}
+
+	if err := s.logicalKeyRepo.PromoteVersion(ctx, logicalKey.ID, version, keyPairID, now); err != nil {
+		return nil, fmt.Errorf("%w", err)
+	}
+
+	s.logger.Info(fmt.Sprintf("Rotated logical key %s to version %d with key pair %s", logicalKey.ID, version, keyPairID))
+	return keyMetas, nil
+}
+
+// plaintextExportForbidden reports whether the export policy of any key of the given key pair forbids plaintext export
+func (s *cryptoKeyRotationService) plaintextExportForbidden(ctx context.Context, keyPairID string) (bool, error) {
+	query := keys.NewCryptoKeyQuery()
+	query.KeyPairID = keyPairID
+	keyMetas, err := s.cryptoKeyRepo.List(ctx, query)
+	if err != nil {
+		return false, fmt.Errorf("%w", err)
+	}
+
+	for _, keyMeta := range keyMetas {
+		if keyMeta.PlaintextExportForbidden {
+			return true, nil
+		}
+	}
+	return false, nil
+}
+
+// getLogicalKey retrieves the logical key the given key is a version of.
+// Keys predating versioning become version 1 of a new logical key whose ID equals their key pair ID.
+func (s *cryptoKeyRotationService) getLogicalKey(ctx context.Context, cryptoKeyMeta *keys.CryptoKeyMeta) (*keys.LogicalKey, error) {
+	if cryptoKeyMeta.LogicalKeyID != "" {
+		logicalKey, err := s.logicalKeyRepo.GetByID(ctx, cryptoKeyMeta.LogicalKeyID)
+		if err != nil {
+			return nil, fmt.Errorf("%w", err)
+		}
+		return logicalKey, nil
+	}
+
+	query := keys.NewCryptoKeyQuery()
+	query.KeyPairID = cryptoKeyMeta.KeyPairID
+	keyMetas, err := s.cryptoKeyRepo.List(ctx, query)
+	if err != nil {
+		return nil, fmt.Errorf("%w", err)
+	}
+
+	logicalKey := &keys.LogicalKey{
+		ID:               cryptoKeyMeta.KeyPairID,
+		UserID:           cryptoKeyMeta.UserID,
+		Algorithm:        cryptoKeyMeta.Algorithm,
+		KeySize:          cryptoKeyMeta.KeySize,
+		PrimaryVersion:   1,
+		PrimaryKeyPairID: cryptoKeyMeta.KeyPairID,
+		LatestVersion:    1,
+		DateTimeCreated:  cryptoKeyMeta.DateTimeCreated,
+	}
+	if err := s.logicalKeyRepo.Create(ctx, logicalKey); err != nil {
+		// A concurrent call may have created the logical key meanwhile, its keys are assigned to it again below
+		existingLogicalKey, getErr := s.logicalKeyRepo.GetByID(ctx, logicalKey.ID)
+		if getErr != nil {
+			return nil, fmt.Errorf("%w", err)
+		}
+		logicalKey = existingLogicalKey
+	}
+
+	for _, pairedKeyMeta := range keyMetas {
+		pairedKeyMeta.LogicalKeyID = logicalKey.ID
+		pairedKeyMeta.Version = 1
+		if err := s.cryptoKeyRepo.UpdateByID(ctx, pairedKeyMeta); err != nil {
+			return nil, fmt.Errorf("%w", err)
+		}
+	}
+
+	return logicalKey, nil
+}
+
+// primaryKeyPairID returns the key pair of the primary version of the logical key the given key is a version of, or the key's own key pair for keys predating versioning
+func primaryKeyPairID(ctx context.Context, logicalKeyRepo keys.LogicalKeyRepository, cryptoKeyMeta *keys.CryptoKeyMeta) (string, error) {
+	if cryptoKeyMeta.LogicalKeyID == "" {
+		return cryptoKeyMeta.KeyPairID, nil
+	}
+
+	logicalKey, err := logicalKeyRepo.GetByID(ctx, cryptoKeyMeta.LogicalKeyID)
+	if err != nil {
+		return "", fmt.Errorf("%w", err)
+	}
+
+	return logicalKey.PrimaryKeyPairID, nil
+}
+
+// versionKeyPairIDs returns the key pair of the given key followed by the key pairs of the other versions of its logical key, newest version first
+func versionKeyPairIDs(ctx context.Context, cryptoKeyRepo keys.CryptoKeyRepository, cryptoKeyMeta *keys.CryptoKeyMeta) ([]string, error) {
+	keyPairIDs := []string{cryptoKeyMeta.KeyPairID}
+	if cryptoKeyMeta.LogicalKeyID == "" {
+		return keyPairIDs, nil
+	}
+
+	query := keys.NewCryptoKeyQuery()
+	query.Limit = 0
+	query.LogicalKeyID = cryptoKeyMeta.LogicalKeyID
+	query.Type = cryptoKeyMeta.Type
+	query.SortBy = "version"
+	query.SortOrder = "desc"
+	versionKeyMetas, err := cryptoKeyRepo.List(ctx, query)
+	if err != nil {
+		return nil, fmt.Errorf("%w", err)
+	}
+
+	for _, versionKeyMeta := range versionKeyMetas {
+		if versionKeyMeta.KeyPairID != cryptoKeyMeta.KeyPairID {
+			keyPairIDs = append(keyPairIDs, versionKeyMeta.KeyPairID)
+		}
+	}
+	return keyPairIDs, nil
+}
+
+// resolveKeyVersion resolves the key of the given type in the version with the given key pair of the logical key the given key is a version of.
+// Symmetric keys resolve symmetric keys regardless of the type. Keys predating versioning, or an empty keyPairID, resolve within the key's own key pair.
+func resolveKeyVersion(ctx context.Context, cryptoKeyRepo keys.CryptoKeyRepository, cryptoKeyMeta *keys.CryptoKeyMeta, keyPairID, keyType string) (*keys.CryptoKeyMeta, error) {
+	if cryptoKeyMeta.Type == "symmetric" {
+		keyType = cryptoKeyMeta.Type
+	}
+	if keyPairID == "" || cryptoKeyMeta.LogicalKeyID == "" {
+		keyPairID = cryptoKeyMeta.KeyPairID
+	}
+	if keyPairID == cryptoKeyMeta.KeyPairID && keyType == cryptoKeyMeta.Type {
+		return cryptoKeyMeta, nil
+	}
+
+	query := keys.NewCryptoKeyQuery()
+	query.KeyPairID = keyPairID
+	query.Type = keyType
+	keyMetas, err := cryptoKeyRepo.List(ctx, query)
+	if err != nil {
+		return nil, fmt.Errorf("%w", err)
+	}
+	if len(keyMetas) == 0 {
+		return nil, fmt.Errorf("no %s key found for key pair %s", keyType, keyPairID)
+	}
+	if keyMetas[0].LogicalKeyID != cryptoKeyMeta.LogicalKeyID {
+		return nil, fmt.Errorf("key pair %s is no version of logical key %s", keyPairID, cryptoKeyMeta.LogicalKeyID)
+	}
+
+	return keyMetas[0], nil
+}
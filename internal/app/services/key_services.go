@@ -2,47 +2,110 @@ package services
 
 import (
 	"context"
+	"crypto"
 	"crypto/elliptic"
+	"crypto/rsa"
 	"crypto/x509"
+	"crypto_vault_service/internal/domain/auth"
+	"crypto_vault_service/internal/domain/blobs"
 	"crypto_vault_service/internal/domain/keys"
+	"crypto_vault_service/internal/domain/permissions"
 	"crypto_vault_service/internal/infrastructure/connector"
 	"crypto_vault_service/internal/infrastructure/cryptography"
 	"crypto_vault_service/internal/infrastructure/logger"
 	"fmt"
+	"time"
 
 	"github.com/google/uuid"
 )
 
 // cryptoKeyUploadService implements the CryptoKeyUploadService interface for handling blob uploads
 type cryptoKeyUploadService struct {
-	vaultConnector connector.VaultConnector
-	cryptoKeyRepo  keys.CryptoKeyRepository
-	logger         logger.Logger
+	vaultConnector       connector.VaultConnector
+	cryptoKeyRepo        keys.CryptoKeyRepository
+	logicalKeyRepo       keys.LogicalKeyRepository
+	masterKeyProvider    cryptography.MasterKeyProvider
+	permissionManagement permissions.PermissionManagement
+	logger               logger.Logger
 }
 
 // NewCryptoKeyUploadService creates a new cryptoKeyUploadService instance
-func NewCryptoKeyUploadService(vaultConnector connector.VaultConnector, cryptoKeyRepo keys.CryptoKeyRepository, logger logger.Logger) (keys.CryptoKeyUploadService, error) {
+func NewCryptoKeyUploadService(vaultConnector connector.VaultConnector, cryptoKeyRepo keys.CryptoKeyRepository, logicalKeyRepo keys.LogicalKeyRepository, masterKeyProvider cryptography.MasterKeyProvider, permissionManagement permissions.PermissionManagement, logger logger.Logger) (keys.CryptoKeyUploadService, error) {
 	return &cryptoKeyUploadService{
-		vaultConnector: vaultConnector,
-		cryptoKeyRepo:  cryptoKeyRepo,
-		logger:         logger,
+		vaultConnector:       vaultConnector,
+		cryptoKeyRepo:        cryptoKeyRepo,
+		logicalKeyRepo:       logicalKeyRepo,
+		masterKeyProvider:    masterKeyProvider,
+		permissionManagement: permissionManagement,
+		logger:               logger,
 	}, nil
 }
 
-// Upload uploads cryptographic keys
+// Upload uploads cryptographic keys owned by the authenticated caller carried by ctx
+// The keys are active from now on unless lifecycle sets them pre-active or schedules their activation, and expire as scheduled by lifecycle if set.
+// The keys form version 1 of a new logical key, whose ID equals their key pair ID.
 // It returns a slice of CryptoKeyMeta and any error encountered during the upload process.
-func (s *cryptoKeyUploadService) Upload(ctx context.Context, userID, keyAlgorithm string, keySize uint32) ([]*keys.CryptoKeyMeta, error) {
-	var cryptKeyMetas []*keys.CryptoKeyMeta
+func (s *cryptoKeyUploadService) Upload(ctx context.Context, keyAlgorithm string, keySize uint32, lifecycle *keys.CryptoKeyLifecycle) ([]*keys.CryptoKeyMeta, error) {
+	userID, err := auth.UserIDFromContext(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("%w", err)
+	}
+
+	initialLifecycle, err := newInitialLifecycle(lifecycle, time.Now())
+	if err != nil {
+		return nil, fmt.Errorf("%w", err)
+	}
 
 	keyPairID := uuid.New().String()
+	cryptKeyMetas, err := s.uploadKeyVersion(ctx, userID, keyPairID, keyPairID, 1, keyAlgorithm, keySize, initialLifecycle)
+	if err != nil {
+		return nil, err
+	}
+
+	if err := s.createLogicalKey(ctx, userID, keyPairID, keyAlgorithm, keySize); err != nil {
+		s.rollbackStoredKeys(ctx, cryptKeyMetas, userID)
+		return nil, fmt.Errorf("%w", err)
+	}
+
+	return cryptKeyMetas, nil
+}
+
+// createLogicalKey creates the logical key whose version 1 is formed by the keys of the given key pair, sharing the ID of the key pair
+func (s *cryptoKeyUploadService) createLogicalKey(ctx context.Context, userID, keyPairID, keyAlgorithm string, keySize uint32) error {
+	logicalKey := &keys.LogicalKey{
+		ID:               keyPairID,
+		UserID:           userID,
+		Algorithm:        keyAlgorithm,
+		KeySize:          keySize,
+		PrimaryVersion:   1,
+		PrimaryKeyPairID: keyPairID,
+		LatestVersion:    1,
+		DateTimeCreated:  time.Now(),
+	}
+	if err := s.logicalKeyRepo.Create(ctx, logicalKey); err != nil {
+		return fmt.Errorf("%w", err)
+	}
+
+	return nil
+}
+
+// uploadKeyVersion generates and uploads the keys of the given algorithm forming the given version of a logical key.
+// Vaults backed by a PKCS#11 token generate RSA and EC key pairs on the token instead.
+func (s *cryptoKeyUploadService) uploadKeyVersion(ctx context.Context, userID, keyPairID, logicalKeyID string, version uint32, keyAlgorithm string, keySize uint32, lifecycle *keys.CryptoKeyLifecycle) ([]*keys.CryptoKeyMeta, error) {
+	var cryptKeyMetas []*keys.CryptoKeyMeta
 	var err error
+
+	if tokenVaultConnector, ok := s.vaultConnector.(connector.TokenVaultConnector); ok && keyAlgorithm != "AES" {
+		return s.generateTokenKey(ctx, tokenVaultConnector, userID, keyPairID, logicalKeyID, version, keyAlgorithm, keySize, lifecycle)
+	}
+
 	switch keyAlgorithm {
 	case "AES":
-		cryptKeyMetas, err = s.uploadAESKey(ctx, userID, keyPairID, keyAlgorithm, keySize)
+		cryptKeyMetas, err = s.uploadAESKey(ctx, userID, keyPairID, logicalKeyID, version, keyAlgorithm, keySize, lifecycle)
 	case "EC":
-		cryptKeyMetas, err = s.uploadECKey(ctx, userID, keyPairID, keyAlgorithm, keySize)
+		cryptKeyMetas, err = s.uploadECKey(ctx, userID, keyPairID, logicalKeyID, version, keyAlgorithm, keySize, lifecycle)
 	case "RSA":
-		cryptKeyMetas, err = s.uploadRSAKey(ctx, userID, keyPairID, keyAlgorithm, keySize)
+		cryptKeyMetas, err = s.uploadRSAKey(ctx, userID, keyPairID, logicalKeyID, version, keyAlgorithm, keySize, lifecycle)
 	default:
 		return nil, fmt.Errorf("unsupported algorithm: %s", keyAlgorithm)
 	}
@@ -54,8 +117,131 @@ func (s *cryptoKeyUploadService) Upload(ctx context.Context, userID, keyAlgorith
 	return cryptKeyMetas, nil
 }
 
+// newInitialLifecycle resolves the lifecycle of keys created at the given time.
+// Keys are created pre-active if requested or if their activation is scheduled, otherwise they are active from now on.
+func newInitialLifecycle(lifecycle *keys.CryptoKeyLifecycle, now time.Time) (*keys.CryptoKeyLifecycle, error) {
+	initialLifecycle := &keys.CryptoKeyLifecycle{}
+	if lifecycle != nil {
+		if err := lifecycle.Validate(); err != nil {
+			return nil, fmt.Errorf("%w", err)
+		}
+		*initialLifecycle = *lifecycle
+	}
+
+	scheduled := initialLifecycle.DateTimeActivation != nil && initialLifecycle.DateTimeActivation.After(now)
+	switch initialLifecycle.State {
+	case "":
+		initialLifecycle.State = keys.KeyStateActive
+		if scheduled {
+			initialLifecycle.State = keys.KeyStatePreActive
+		}
+	case keys.KeyStateActive:
+		if scheduled {
+			return nil, fmt.Errorf("keys created active cannot be scheduled for activation")
+		}
+	case keys.KeyStatePreActive:
+	default:
+		return nil, fmt.Errorf("keys can only be created %s or %s, not %s", keys.KeyStatePreActive, keys.KeyStateActive, initialLifecycle.State)
+	}
+
+	if initialLifecycle.State == keys.KeyStateActive {
+		initialLifecycle.DateTimeActivation = &now
+	}
+	if initialLifecycle.DateTimeExpires != nil && !initialLifecycle.DateTimeExpires.After(now) {
+		return nil, fmt.Errorf("expiry %s of keys is not in the future", initialLifecycle.DateTimeExpires.Format(time.RFC3339))
+	}
+
+	return initialLifecycle, nil
+}
+
+// storeWrappedKey wraps the key material with the master key, uploads it to the vault and persists its metadata along with its lifecycle, logical key version and ownership tuples
+func (s *cryptoKeyUploadService) storeWrappedKey(ctx context.Context, keyBytes []byte, userID, keyPairID, logicalKeyID string, version uint32, keyType, keyAlgorithm string, keySize uint32, lifecycle *keys.CryptoKeyLifecycle) (*keys.CryptoKeyMeta, error) {
+	keyID := uuid.New().String()
+	wrappedKeyBytes, kekVersion, err := s.masterKeyProvider.Wrap(keyBytes, keyID, keyPairID, keyType)
+	if err != nil {
+		return nil, fmt.Errorf("failed to wrap key with master key: %w", err)
+	}
+
+	cryptoKeyMeta, err := s.vaultConnector.Upload(ctx, wrappedKeyBytes, keyID, userID, keyPairID, keyType, keyAlgorithm, keySize)
+	if err != nil {
+		return nil, fmt.Errorf("%w", err)
+	}
+	cryptoKeyMeta.KEKVersion = kekVersion
+
+	if err := s.storeKeyMeta(ctx, cryptoKeyMeta, userID, logicalKeyID, version, lifecycle); err != nil {
+		s.rollbackUploadedKey(ctx, cryptoKeyMeta)
+		return nil, fmt.Errorf("%w", err)
+	}
+
+	return cryptoKeyMeta, nil
+}
+
+// storeKeyMeta persists the metadata of a stored key along with its lifecycle, logical key version and ownership tuples
+func (s *cryptoKeyUploadService) storeKeyMeta(ctx context.Context, cryptoKeyMeta *keys.CryptoKeyMeta, userID, logicalKeyID string, version uint32, lifecycle *keys.CryptoKeyLifecycle) error {
+	cryptoKeyMeta.State = lifecycle.State
+	cryptoKeyMeta.DateTimeActivation = lifecycle.DateTimeActivation
+	cryptoKeyMeta.DateTimeExpires = lifecycle.DateTimeExpires
+	cryptoKeyMeta.LogicalKeyID = logicalKeyID
+	cryptoKeyMeta.Version = version
+
+	if err := s.permissionManagement.WriteTuples(ctx, ownershipTuples(userID, permissions.Key(cryptoKeyMeta.ID))); err != nil {
+		return fmt.Errorf("%w", err)
+	}
+
+	if err := s.cryptoKeyRepo.Create(ctx, cryptoKeyMeta); err != nil {
+		s.rollbackKeyOwnership(ctx, cryptoKeyMeta, userID)
+		return fmt.Errorf("%w", err)
+	}
+
+	return nil
+}
+
+// rollbackStoredKeys deletes the metadata, ownership and material of keys that were stored before the error occurred
+func (s *cryptoKeyUploadService) rollbackStoredKeys(ctx context.Context, cryptoKeyMetas []*keys.CryptoKeyMeta, userID string) {
+	for _, cryptoKeyMeta := range cryptoKeyMetas {
+		if err := s.cryptoKeyRepo.DeleteByID(ctx, cryptoKeyMeta.ID); err != nil {
+			s.logger.Info(fmt.Sprintf("Failed to delete metadata of key '%s' during rollback: %v", cryptoKeyMeta.ID, err))
+		}
+		s.rollbackKeyOwnership(ctx, cryptoKeyMeta, userID)
+		s.rollbackUploadedKey(ctx, cryptoKeyMeta)
+	}
+}
+
+// rollbackKeyOwnership deletes the ownership tuples written for a key before the error occurred
+func (s *cryptoKeyUploadService) rollbackKeyOwnership(ctx context.Context, cryptoKeyMeta *keys.CryptoKeyMeta, userID string) {
+	if err := s.permissionManagement.DeleteTuples(ctx, ownershipTuples(userID, permissions.Key(cryptoKeyMeta.ID))); err != nil {
+		s.logger.Info(fmt.Sprintf("Failed to delete ownership of key '%s' during rollback: %v", cryptoKeyMeta.ID, err))
+	}
+}
+
+// rollbackUploadedKey deletes the material of a key that was uploaded to the vault before the error occurred
+func (s *cryptoKeyUploadService) rollbackUploadedKey(ctx context.Context, cryptoKeyMeta *keys.CryptoKeyMeta) {
+	if err := s.vaultConnector.Delete(ctx, cryptoKeyMeta.ID, cryptoKeyMeta.KeyPairID, cryptoKeyMeta.Type); err != nil {
+		s.logger.Info(fmt.Sprintf("Failed to delete key '%s' during rollback: %v", cryptoKeyMeta.ID, err))
+	} else {
+		s.logger.Info(fmt.Sprintf("Key '%s' deleted during rollback", cryptoKeyMeta.ID))
+	}
+}
+
+// generateTokenKey generates an RSA or EC key pair on the PKCS#11 token backing the vault and persists the metadata of its keys.
+// The key material stays on the token, so it is not wrapped with the master key.
+func (s *cryptoKeyUploadService) generateTokenKey(ctx context.Context, tokenVaultConnector connector.TokenVaultConnector, userID, keyPairID, logicalKeyID string, version uint32, keyAlgorithm string, keySize uint32, lifecycle *keys.CryptoKeyLifecycle) ([]*keys.CryptoKeyMeta, error) {
+	keyMetas, err := tokenVaultConnector.Generate(ctx, userID, keyPairID, keyAlgorithm, keySize)
+	if err != nil {
+		return nil, fmt.Errorf("%w", err)
+	}
+
+	for _, cryptoKeyMeta := range keyMetas {
+		if err := s.storeKeyMeta(ctx, cryptoKeyMeta, userID, logicalKeyID, version, lifecycle); err != nil {
+			return nil, fmt.Errorf("%w", err)
+		}
+	}
+
+	return keyMetas, nil
+}
+
 // Helper function for uploading AES key
-func (s *cryptoKeyUploadService) uploadAESKey(ctx context.Context, userID, keyPairID, keyAlgorithm string, keySize uint32) ([]*keys.CryptoKeyMeta, error) {
+func (s *cryptoKeyUploadService) uploadAESKey(ctx context.Context, userID, keyPairID, logicalKeyID string, version uint32, keyAlgorithm string, keySize uint32, lifecycle *keys.CryptoKeyLifecycle) ([]*keys.CryptoKeyMeta, error) {
 	var keyMetas []*keys.CryptoKeyMeta
 
 	aesProcessor, err := cryptography.NewAESProcessor(s.logger)
@@ -81,35 +267,22 @@ func (s *cryptoKeyUploadService) uploadAESKey(ctx context.Context, userID, keyPa
 	}
 
 	keyType := "symmetric"
-	cryptoKeyMeta, err := s.vaultConnector.Upload(ctx, symmetricKeyBytes, userID, keyPairID, keyType, keyAlgorithm, keySize)
+	cryptoKeyMeta, err := s.storeWrappedKey(ctx, symmetricKeyBytes, userID, keyPairID, logicalKeyID, version, keyType, keyAlgorithm, keySize, lifecycle)
 	if err != nil {
 		return nil, fmt.Errorf("%w", err)
 	}
 
-	if err := s.cryptoKeyRepo.Create(ctx, cryptoKeyMeta); err != nil {
-		return nil, fmt.Errorf("%w", err)
-	}
-
 	keyMetas = append(keyMetas, cryptoKeyMeta)
 	return keyMetas, nil
 }
 
 // Helper function for uploading EC key pair (private and public)
-func (s *cryptoKeyUploadService) uploadECKey(ctx context.Context, userID, keyPairID, keyAlgorithm string, keySize uint32) ([]*keys.CryptoKeyMeta, error) {
+func (s *cryptoKeyUploadService) uploadECKey(ctx context.Context, userID, keyPairID, logicalKeyID string, version uint32, keyAlgorithm string, keySize uint32, lifecycle *keys.CryptoKeyLifecycle) ([]*keys.CryptoKeyMeta, error) {
 	var keyMetas []*keys.CryptoKeyMeta
 
-	var curve elliptic.Curve
-	switch keySize {
-	case 224:
-		curve = elliptic.P224()
-	case 256:
-		curve = elliptic.P256()
-	case 384:
-		curve = elliptic.P384()
-	case 521:
-		curve = elliptic.P521()
-	default:
-		return nil, fmt.Errorf("key size %v not supported for EC", keySize)
+	curve, err := ecCurve(keySize)
+	if err != nil {
+		return nil, fmt.Errorf("%w", err)
 	}
 
 	ecProcessor, err := cryptography.NewECProcessor(s.logger)
@@ -122,30 +295,27 @@ func (s *cryptoKeyUploadService) uploadECKey(ctx context.Context, userID, keyPai
 		return nil, fmt.Errorf("%w", err)
 	}
 
-	// Upload Private Key
-	privateKeyBytes := append(privateKey.D.Bytes(), privateKey.X.Bytes()...)
-	privateKeyBytes = append(privateKeyBytes, privateKey.Y.Bytes()...)
-	keyType := "private"
-	cryptoKeyMeta, err := s.vaultConnector.Upload(ctx, privateKeyBytes, userID, keyPairID, keyType, keyAlgorithm, keySize)
+	// Upload Private Key as PKCS#8, which records the curve alongside the key
+	privateKeyBytes, err := cryptography.MarshalECPrivateKey(privateKey)
 	if err != nil {
 		return nil, fmt.Errorf("%w", err)
 	}
-
-	if err := s.cryptoKeyRepo.Create(ctx, cryptoKeyMeta); err != nil {
+	keyType := "private"
+	cryptoKeyMeta, err := s.storeWrappedKey(ctx, privateKeyBytes, userID, keyPairID, logicalKeyID, version, keyType, keyAlgorithm, keySize, lifecycle)
+	if err != nil {
 		return nil, fmt.Errorf("%w", err)
 	}
 
 	keyMetas = append(keyMetas, cryptoKeyMeta)
 
-	// Upload Public Key
-	publicKeyBytes := append(publicKey.X.Bytes(), publicKey.Y.Bytes()...)
-	keyType = "public"
-	cryptoKeyMeta, err = s.vaultConnector.Upload(ctx, publicKeyBytes, userID, keyPairID, keyType, keyAlgorithm, keySize)
+	// Upload Public Key as PKIX
+	publicKeyBytes, err := cryptography.MarshalECPublicKey(publicKey)
 	if err != nil {
 		return nil, fmt.Errorf("%w", err)
 	}
-
-	if err := s.cryptoKeyRepo.Create(ctx, cryptoKeyMeta); err != nil {
+	keyType = "public"
+	cryptoKeyMeta, err = s.storeWrappedKey(ctx, publicKeyBytes, userID, keyPairID, logicalKeyID, version, keyType, keyAlgorithm, keySize, lifecycle)
+	if err != nil {
 		return nil, fmt.Errorf("%w", err)
 	}
 
@@ -153,8 +323,24 @@ func (s *cryptoKeyUploadService) uploadECKey(ctx context.Context, userID, keyPai
 	return keyMetas, nil
 }
 
+// ecCurve returns the elliptic curve of the given key size
+func ecCurve(keySize uint32) (elliptic.Curve, error) {
+	switch keySize {
+	case 224:
+		return elliptic.P224(), nil
+	case 256:
+		return elliptic.P256(), nil
+	case 384:
+		return elliptic.P384(), nil
+	case 521:
+		return elliptic.P521(), nil
+	default:
+		return nil, fmt.Errorf("key size %v not supported for EC", keySize)
+	}
+}
+
 // Helper function for uploading RSA key pair (private and public)
-func (s *cryptoKeyUploadService) uploadRSAKey(ctx context.Context, userID, keyPairID, keyAlgorithm string, keySize uint32) ([]*keys.CryptoKeyMeta, error) {
+func (s *cryptoKeyUploadService) uploadRSAKey(ctx context.Context, userID, keyPairID, logicalKeyID string, version uint32, keyAlgorithm string, keySize uint32, lifecycle *keys.CryptoKeyLifecycle) ([]*keys.CryptoKeyMeta, error) {
 	var keyMetas []*keys.CryptoKeyMeta
 
 	rsaProcessor, err := cryptography.NewRSAProcessor(s.logger)
@@ -170,15 +356,11 @@ func (s *cryptoKeyUploadService) uploadRSAKey(ctx context.Context, userID, keyPa
 	// Upload Private Key
 	privateKeyBytes := x509.MarshalPKCS1PrivateKey(privateKey)
 	keyType := "private"
-	cryptoKeyMeta, err := s.vaultConnector.Upload(ctx, privateKeyBytes, userID, keyPairID, keyType, keyAlgorithm, keySize)
+	cryptoKeyMeta, err := s.storeWrappedKey(ctx, privateKeyBytes, userID, keyPairID, logicalKeyID, version, keyType, keyAlgorithm, keySize, lifecycle)
 	if err != nil {
 		return nil, fmt.Errorf("%w", err)
 	}
 
-	if err := s.cryptoKeyRepo.Create(ctx, cryptoKeyMeta); err != nil {
-		return nil, fmt.Errorf("%w", err)
-	}
-
 	keyMetas = append(keyMetas, cryptoKeyMeta)
 
 	// Upload Public Key
@@ -187,38 +369,55 @@ func (s *cryptoKeyUploadService) uploadRSAKey(ctx context.Context, userID, keyPa
 		return nil, fmt.Errorf("failed to marshal public key: %w", err)
 	}
 	keyType = "public"
-	cryptoKeyMeta, err = s.vaultConnector.Upload(ctx, publicKeyBytes, userID, keyPairID, keyType, keyAlgorithm, keySize)
+	cryptoKeyMeta, err = s.storeWrappedKey(ctx, publicKeyBytes, userID, keyPairID, logicalKeyID, version, keyType, keyAlgorithm, keySize, lifecycle)
 	if err != nil {
 		return nil, fmt.Errorf("%w", err)
 	}
 
-	if err := s.cryptoKeyRepo.Create(ctx, cryptoKeyMeta); err != nil {
-		return nil, fmt.Errorf("%w", err)
-	}
-
 	keyMetas = append(keyMetas, cryptoKeyMeta)
 	return keyMetas, nil
 }
 
 // cryptoKeyMetadataService implements the CryptoKeyMetadataService interface to manages cryptographic key metadata.
 type cryptoKeyMetadataService struct {
-	vaultConnector connector.VaultConnector
-	cryptoKeyRepo  keys.CryptoKeyRepository
-	logger         logger.Logger
+	vaultConnector         connector.VaultConnector
+	cryptoKeyRepo          keys.CryptoKeyRepository
+	blobRepo               blobs.BlobRepository
+	permissionManagement   permissions.PermissionManagement
+	destructionGracePeriod time.Duration
+	logger                 logger.Logger
 }
 
-// NewCryptoKeyMetadataService creates a new cryptoKeyMetadataService instance
-func NewCryptoKeyMetadataService(vaultConnector connector.VaultConnector, cryptoKeyRepo keys.CryptoKeyRepository, logger logger.Logger) (keys.CryptoKeyMetadataService, error) {
+// NewCryptoKeyMetadataService creates a new cryptoKeyMetadataService instance scheduling the destruction of keys once the given grace period has passed
+func NewCryptoKeyMetadataService(vaultConnector connector.VaultConnector, cryptoKeyRepo keys.CryptoKeyRepository, blobRepo blobs.BlobRepository, permissionManagement permissions.PermissionManagement, destructionGracePeriod time.Duration, logger logger.Logger) (keys.CryptoKeyMetadataService, error) {
+	if destructionGracePeriod <= 0 {
+		return nil, fmt.Errorf("key destruction grace period must be positive, got %s", destructionGracePeriod)
+	}
+
 	return &cryptoKeyMetadataService{
-		vaultConnector: vaultConnector,
-		cryptoKeyRepo:  cryptoKeyRepo,
-		logger:         logger,
+		vaultConnector:         vaultConnector,
+		cryptoKeyRepo:          cryptoKeyRepo,
+		blobRepo:               blobRepo,
+		permissionManagement:   permissionManagement,
+		destructionGracePeriod: destructionGracePeriod,
+		logger:                 logger,
 	}, nil
 }
 
 // List retrieves all cryptographic key metadata based on a query.
+// Callers other than admins only list the keys they own.
 func (s *cryptoKeyMetadataService) List(ctx context.Context, query *keys.CryptoKeyQuery) ([]*keys.CryptoKeyMeta, error) {
-	crypoKeyMetas, err := s.cryptoKeyRepo.List(ctx, query)
+	ownerID, err := auth.OwnerScope(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("%w", err)
+	}
+
+	scopedQuery := *query
+	if ownerID != "" {
+		scopedQuery.UserID = ownerID
+	}
+
+	crypoKeyMetas, err := s.cryptoKeyRepo.List(ctx, &scopedQuery)
 	if err != nil {
 		return nil, fmt.Errorf("%w", err)
 	}
@@ -226,9 +425,9 @@ func (s *cryptoKeyMetadataService) List(ctx context.Context, query *keys.CryptoK
 	return crypoKeyMetas, nil
 }
 
-// GetByID retrieves the metadata of a cryptographic key by its ID.
+// GetByID retrieves the metadata of a cryptographic key by its ID, provided the caller may manage the key.
 func (s *cryptoKeyMetadataService) GetByID(ctx context.Context, keyID string) (*keys.CryptoKeyMeta, error) {
-	keyMeta, err := s.cryptoKeyRepo.GetByID(ctx, keyID)
+	keyMeta, err := getAuthorizedCryptoKey(ctx, s.permissionManagement, s.cryptoKeyRepo, keyID, permissions.PermissionManageKey)
 	if err != nil {
 		return nil, fmt.Errorf("%w", err)
 	}
@@ -236,52 +435,532 @@ func (s *cryptoKeyMetadataService) GetByID(ctx context.Context, keyID string) (*
 	return keyMeta, nil
 }
 
-// DeleteByID deletes a cryptographic key's metadata by its ID.
-func (s *cryptoKeyMetadataService) DeleteByID(ctx context.Context, keyID string) error {
-	keyMeta, err := s.GetByID(ctx, keyID)
+// DeleteByID schedules the destruction of the keys of the key pair the given key belongs to once the grace period has passed, provided the caller may manage all of them.
+// Destruction is refused with keys.ErrKeyInUse while blobs are encrypted or signed with any key of the pair unless force is set.
+// The material of the keys is deleted by the CryptoKeyDestructionService once their destruction is due, whereas their metadata is kept so blobs referencing them can still be attributed.
+func (s *cryptoKeyMetadataService) DeleteByID(ctx context.Context, keyID string, force bool) ([]*keys.CryptoKeyMeta, error) {
+	keyMetas, err := s.getAuthorizedKeyPair(ctx, keyID)
 	if err != nil {
-		return fmt.Errorf("failed to%w", err)
+		return nil, fmt.Errorf("%w", err)
 	}
 
-	err = s.vaultConnector.Delete(ctx, keyID, keyMeta.KeyPairID, keyMeta.Type)
+	return s.scheduleDestruction(ctx, keyMetas, force, time.Now())
+}
+
+// CancelDestruction cancels the scheduled destruction of the keys of the key pair the given key belongs to, provided the caller may manage all of them and the grace period has not passed yet.
+func (s *cryptoKeyMetadataService) CancelDestruction(ctx context.Context, keyID string) ([]*keys.CryptoKeyMeta, error) {
+	keyMetas, err := s.getAuthorizedKeyPair(ctx, keyID)
 	if err != nil {
-		return fmt.Errorf("failed to%w", err)
+		return nil, fmt.Errorf("%w", err)
+	}
+
+	now := time.Now()
+	for _, pairedKeyMeta := range keyMetas {
+		if pairedKeyMeta.EffectiveState(now) == keys.KeyStateDestroyed {
+			return nil, fmt.Errorf("%w: key %s is already destroyed", keys.ErrKeyState, pairedKeyMeta.ID)
+		}
+		if pairedKeyMeta.DateTimeDestruction == nil {
+			return nil, fmt.Errorf("%w: key %s is not scheduled for destruction", keys.ErrKeyState, pairedKeyMeta.ID)
+		}
+	}
+
+	for _, pairedKeyMeta := range keyMetas {
+		pairedKeyMeta.DateTimeDestruction = nil
+		if err := s.cryptoKeyRepo.UpdateByID(ctx, pairedKeyMeta); err != nil {
+			return nil, fmt.Errorf("%w", err)
+		}
 	}
 
-	err = s.cryptoKeyRepo.DeleteByID(ctx, keyID)
+	s.logger.Info(fmt.Sprintf("Cancelled destruction of key pair %s", keyMetas[0].KeyPairID))
+	return keyMetas, nil
+}
+
+// UpdateLifecycle transitions the keys of the key pair the given key belongs to, provided the caller may manage all of them.
+// Activating a pre-active key records the activation date and deactivating a key records the expiry date.
+// Destroying keys schedules their destruction like DeleteByID, so their material is only deleted once the grace period has passed.
+func (s *cryptoKeyMetadataService) UpdateLifecycle(ctx context.Context, keyID string, lifecycle *keys.CryptoKeyLifecycle) ([]*keys.CryptoKeyMeta, error) {
+	if err := lifecycle.Validate(); err != nil {
+		return nil, fmt.Errorf("%w", err)
+	}
+	if lifecycle.State == "" && lifecycle.DateTimeActivation == nil && lifecycle.DateTimeExpires == nil {
+		return nil, fmt.Errorf("lifecycle update must set a state, an activation or an expiry")
+	}
+
+	keyMetas, err := s.getAuthorizedKeyPair(ctx, keyID)
+	if err != nil {
+		return nil, fmt.Errorf("%w", err)
+	}
+
+	now := time.Now()
+	if lifecycle.State == keys.KeyStateDestroyed {
+		if lifecycle.DateTimeActivation != nil || lifecycle.DateTimeExpires != nil {
+			return nil, fmt.Errorf("%w: activation and expiry of keys cannot be rescheduled when destroying them", keys.ErrKeyState)
+		}
+		for _, pairedKeyMeta := range keyMetas {
+			if err := pairedKeyMeta.CheckTransition(keys.KeyStateDestroyed, now); err != nil {
+				return nil, fmt.Errorf("%w", err)
+			}
+		}
+
+		return s.scheduleDestruction(ctx, keyMetas, lifecycle.Force, now)
+	}
+
+	for _, pairedKeyMeta := range keyMetas {
+		if err := applyLifecycle(pairedKeyMeta, lifecycle, now); err != nil {
+			return nil, fmt.Errorf("%w", err)
+		}
+	}
+
+	for _, pairedKeyMeta := range keyMetas {
+		if err := s.cryptoKeyRepo.UpdateByID(ctx, pairedKeyMeta); err != nil {
+			return nil, fmt.Errorf("%w", err)
+		}
+	}
+
+	s.logger.Info(fmt.Sprintf("Updated lifecycle of key pair %s, keys are %s", keyMetas[0].KeyPairID, keyMetas[0].State))
+	return keyMetas, nil
+}
+
+// UpdateExportPolicy sets whether the material of the keys of the key pair the given key belongs to may only be exported wrapped, provided the caller may manage all of them.
+// Once plaintext export is forbidden it cannot be permitted again, as the key material might have been handed out on that premise.
+func (s *cryptoKeyMetadataService) UpdateExportPolicy(ctx context.Context, keyID string, plaintextExportForbidden bool) ([]*keys.CryptoKeyMeta, error) {
+	keyMetas, err := s.getAuthorizedKeyPair(ctx, keyID)
+	if err != nil {
+		return nil, fmt.Errorf("%w", err)
+	}
+
+	for _, pairedKeyMeta := range keyMetas {
+		if pairedKeyMeta.PlaintextExportForbidden && !plaintextExportForbidden {
+			return nil, fmt.Errorf("%w: plaintext export of key %s cannot be permitted again", keys.ErrExportForbidden, pairedKeyMeta.ID)
+		}
+	}
+
+	for _, pairedKeyMeta := range keyMetas {
+		pairedKeyMeta.PlaintextExportForbidden = plaintextExportForbidden
+		if err := s.cryptoKeyRepo.UpdateByID(ctx, pairedKeyMeta); err != nil {
+			return nil, fmt.Errorf("%w", err)
+		}
+	}
+
+	s.logger.Info(fmt.Sprintf("Updated export policy of key pair %s, plaintext export forbidden: %t", keyMetas[0].KeyPairID, plaintextExportForbidden))
+	return keyMetas, nil
+}
+
+// scheduleDestruction schedules the destruction of the given keys of a key pair once the grace period has passed.
+// It returns keys.ErrKeyInUse while blobs are encrypted or signed with any of the keys unless force is set.
+func (s *cryptoKeyMetadataService) scheduleDestruction(ctx context.Context, keyMetas []*keys.CryptoKeyMeta, force bool, now time.Time) ([]*keys.CryptoKeyMeta, error) {
+	keyIDs := make([]string, 0, len(keyMetas))
+	for _, pairedKeyMeta := range keyMetas {
+		if pairedKeyMeta.EffectiveState(now) == keys.KeyStateDestroyed {
+			return nil, fmt.Errorf("%w: key %s is already destroyed", keys.ErrKeyState, pairedKeyMeta.ID)
+		}
+		keyIDs = append(keyIDs, pairedKeyMeta.ID)
+	}
+
+	if !force {
+		blobCount, err := s.blobRepo.CountByKeyIDs(ctx, keyIDs)
+		if err != nil {
+			return nil, fmt.Errorf("%w", err)
+		}
+		if blobCount > 0 {
+			return nil, fmt.Errorf("%w: %d blobs are protected with key pair %s, destroying it requires force", keys.ErrKeyInUse, blobCount, keyMetas[0].KeyPairID)
+		}
+	}
+
+	// Keys already scheduled for destruction keep their earlier destruction date
+	destruction := now.Add(s.destructionGracePeriod)
+	for _, pairedKeyMeta := range keyMetas {
+		if pairedKeyMeta.DateTimeDestruction != nil {
+			continue
+		}
+
+		pairedKeyMeta.DateTimeDestruction = &destruction
+		if err := s.cryptoKeyRepo.UpdateByID(ctx, pairedKeyMeta); err != nil {
+			return nil, fmt.Errorf("%w", err)
+		}
+	}
+
+	s.logger.Info(fmt.Sprintf("Scheduled destruction of key pair %s at %s", keyMetas[0].KeyPairID, keyMetas[0].DateTimeDestruction.Format(time.RFC3339)))
+	return keyMetas, nil
+}
+
+// getAuthorizedKeyPair retrieves the metadata of all keys of the key pair the given key belongs to and returns auth.ErrForbidden unless the caller may manage all of them
+func (s *cryptoKeyMetadataService) getAuthorizedKeyPair(ctx context.Context, keyID string) ([]*keys.CryptoKeyMeta, error) {
+	keyMeta, err := s.GetByID(ctx, keyID)
 	if err != nil {
-		return fmt.Errorf("failed to%w", err)
+		return nil, fmt.Errorf("%w", err)
+	}
+
+	query := keys.NewCryptoKeyQuery()
+	query.KeyPairID = keyMeta.KeyPairID
+	keyMetas, err := s.cryptoKeyRepo.List(ctx, query)
+	if err != nil {
+		return nil, fmt.Errorf("%w", err)
+	}
+	for _, pairedKeyMeta := range keyMetas {
+		if err := checkPermission(ctx, s.permissionManagement, permissions.PermissionManageKey, permissions.Key(pairedKeyMeta.ID)); err != nil {
+			return nil, fmt.Errorf("%w", err)
+		}
+	}
+
+	return keyMetas, nil
+}
+
+// applyLifecycle records the effective state of a key at the given time, transitions it into the requested state if set and reschedules its activation and expiry if set.
+// Activation can only be rescheduled for pre-active keys, expiry only for keys that are not deactivated or destroyed.
+func applyLifecycle(keyMeta *keys.CryptoKeyMeta, lifecycle *keys.CryptoKeyLifecycle, now time.Time) error {
+	state := keyMeta.EffectiveState(now)
+	if lifecycle.State != "" && lifecycle.State != state {
+		if err := keyMeta.CheckTransition(lifecycle.State, now); err != nil {
+			return fmt.Errorf("%w", err)
+		}
+		state = lifecycle.State
+
+		switch state {
+		case keys.KeyStateActive:
+			if keyMeta.DateTimeActivation == nil || keyMeta.DateTimeActivation.After(now) {
+				keyMeta.DateTimeActivation = &now
+			}
+		case keys.KeyStateDeactivated:
+			if keyMeta.DateTimeExpires == nil || keyMeta.DateTimeExpires.After(now) {
+				keyMeta.DateTimeExpires = &now
+			}
+		}
+	}
+
+	if lifecycle.DateTimeActivation != nil {
+		if state != keys.KeyStatePreActive {
+			return fmt.Errorf("%w: activation of key %s cannot be rescheduled while it is %s", keys.ErrKeyState, keyMeta.ID, state)
+		}
+		if !lifecycle.DateTimeActivation.After(now) {
+			return fmt.Errorf("activation %s of key %s is not in the future", lifecycle.DateTimeActivation.Format(time.RFC3339), keyMeta.ID)
+		}
+		keyMeta.DateTimeActivation = lifecycle.DateTimeActivation
+	}
+
+	if lifecycle.DateTimeExpires != nil {
+		if state == keys.KeyStateDeactivated || state == keys.KeyStateDestroyed {
+			return fmt.Errorf("%w: expiry of key %s cannot be rescheduled while it is %s", keys.ErrKeyState, keyMeta.ID, state)
+		}
+		if !lifecycle.DateTimeExpires.After(now) {
+			return fmt.Errorf("expiry %s of key %s is not in the future", lifecycle.DateTimeExpires.Format(time.RFC3339), keyMeta.ID)
+		}
+		if keyMeta.DateTimeActivation != nil && !lifecycle.DateTimeExpires.After(*keyMeta.DateTimeActivation) {
+			return fmt.Errorf("expiry %s of key %s must be after its activation %s", lifecycle.DateTimeExpires.Format(time.RFC3339), keyMeta.ID, keyMeta.DateTimeActivation.Format(time.RFC3339))
+		}
+		keyMeta.DateTimeExpires = lifecycle.DateTimeExpires
 	}
+
+	keyMeta.State = state
 	return nil
 }
 
 // cryptoKeyDownloadService implements the CryptoKeyDownloadService interface to handle the download of cryptographic keys.
+// Vault keys wrapping exported keys are retrieved like by the CryptoOperationService.
 type cryptoKeyDownloadService struct {
-	vaultConnector connector.VaultConnector
-	cryptoKeyRepo  keys.CryptoKeyRepository
-	logger         logger.Logger
+	vaultConnector       connector.VaultConnector
+	cryptoKeyRepo        keys.CryptoKeyRepository
+	masterKeyProvider    cryptography.MasterKeyProvider
+	permissionManagement permissions.PermissionManagement
+	trustedWrappingKeys  []*rsa.PublicKey
+	operationService     *cryptoOperationService
+	logger               logger.Logger
 }
 
-// NewCryptoKeyDownloadService creates a new cryptoKeyDownloadService instance
-func NewCryptoKeyDownloadService(vaultConnector connector.VaultConnector, cryptoKeyRepo keys.CryptoKeyRepository, logger logger.Logger) (keys.CryptoKeyDownloadService, error) {
+// NewCryptoKeyDownloadService creates a new cryptoKeyDownloadService instance.
+// trustedWrappingKeys are the RSA public keys registered by administrators, which alone may wrap keys whose plaintext export is forbidden.
+func NewCryptoKeyDownloadService(vaultConnector connector.VaultConnector, cryptoKeyRepo keys.CryptoKeyRepository, logicalKeyRepo keys.LogicalKeyRepository, masterKeyProvider cryptography.MasterKeyProvider, permissionManagement permissions.PermissionManagement, trustedWrappingKeys []*rsa.PublicKey, logger logger.Logger) (keys.CryptoKeyDownloadService, error) {
 	return &cryptoKeyDownloadService{
-		vaultConnector: vaultConnector,
-		cryptoKeyRepo:  cryptoKeyRepo,
-		logger:         logger,
+		vaultConnector:       vaultConnector,
+		cryptoKeyRepo:        cryptoKeyRepo,
+		masterKeyProvider:    masterKeyProvider,
+		permissionManagement: permissionManagement,
+		trustedWrappingKeys:  trustedWrappingKeys,
+		operationService: &cryptoOperationService{
+			vaultConnector:       vaultConnector,
+			cryptoKeyRepo:        cryptoKeyRepo,
+			logicalKeyRepo:       logicalKeyRepo,
+			masterKeyProvider:    masterKeyProvider,
+			permissionManagement: permissionManagement,
+			logger:               logger,
+		},
+		logger: logger,
 	}, nil
 }
 
-// Download retrieves a cryptographic key by its ID.
+// Download retrieves a cryptographic key by its ID, provided the caller may manage the key and the key is not destroyed.
+// Private and symmetric keys whose export policy forbids plaintext export are refused with keys.ErrExportForbidden, public keys are not secret.
 func (s *cryptoKeyDownloadService) DownloadByID(ctx context.Context, keyID string) ([]byte, error) {
-	keyMeta, err := s.cryptoKeyRepo.GetByID(ctx, keyID)
+	keyMeta, err := getAuthorizedCryptoKey(ctx, s.permissionManagement, s.cryptoKeyRepo, keyID, permissions.PermissionManageKey)
 	if err != nil {
 		return nil, fmt.Errorf("%w", err)
 	}
+	if keyMeta.PlaintextExportForbidden && keyMeta.Type != "public" {
+		return nil, fmt.Errorf("%w: key %s may only be exported wrapped", keys.ErrExportForbidden, keyID)
+	}
 
-	blobData, err := s.vaultConnector.Download(ctx, keyMeta.ID, keyMeta.KeyPairID, keyMeta.Type)
+	keyBytes, err := s.downloadKey(ctx, keyMeta)
 	if err != nil {
 		return nil, fmt.Errorf("%w", err)
 	}
 
-	return blobData, nil
+	return keyBytes, nil
+}
+
+// ExportWrappedByID retrieves a cryptographic key by its ID wrapped with a vault AES key or an RSA public key supplied by the caller, provided the caller may manage the key and the key is not destroyed.
+// Vault keys wrap like Wrap does: the caller must be permitted to use them and the primary version of their logical key wraps the key material with AES Key Wrap with Padding.
+// Public keys wrap symmetric key material with RSA-OAEP and the larger material of private and public keys with cryptography.RSAAESKeyWrap.
+// Private and symmetric keys whose plaintext export is forbidden are only wrapped with vault keys whose plaintext export is forbidden as well,
+// marked so that Unwrap refuses them, or with the RSA public keys registered by administrators. Other wrapping keys are refused with keys.ErrExportForbidden.
+func (s *cryptoKeyDownloadService) ExportWrappedByID(ctx context.Context, keyID string, wrapping *keys.KeyExportWrapping) (*keys.WrappedKeyExport, error) {
+	if err := wrapping.Validate(); err != nil {
+		return nil, fmt.Errorf("%w", err)
+	}
+
+	keyMeta, err := getAuthorizedCryptoKey(ctx, s.permissionManagement, s.cryptoKeyRepo, keyID, permissions.PermissionManageKey)
+	if err != nil {
+		return nil, fmt.Errorf("%w", err)
+	}
+	exportForbidden := keyMeta.PlaintextExportForbidden && keyMeta.Type != "public"
+
+	keyBytes, err := s.downloadKey(ctx, keyMeta)
+	if err != nil {
+		return nil, fmt.Errorf("%w", err)
+	}
+
+	export := &keys.WrappedKeyExport{KeyID: keyMeta.ID}
+	if wrapping.WrappingKeyID != "" {
+		wrappingKeyBytes, wrappingKeyMeta, err := s.operationService.getOperationKey(ctx, wrapping.WrappingKeyID, "public", keys.KeyUsageProtect)
+		if err != nil {
+			return nil, fmt.Errorf("%w", err)
+		}
+		if wrappingKeyMeta.Algorithm != "AES" {
+			return nil, fmt.Errorf("wrapping key %s must be an AES key, got %s", wrapping.WrappingKeyID, wrappingKeyMeta.Algorithm)
+		}
+		if wrappingKeyMeta.ID == keyMeta.ID {
+			return nil, fmt.Errorf("key %s cannot be wrapped with itself", keyID)
+		}
+		if exportForbidden {
+			if !wrappingKeyMeta.PlaintextExportForbidden {
+				return nil, fmt.Errorf("%w: key %s may only be wrapped with a vault key whose plaintext export is forbidden as well", keys.ErrExportForbidden, keyID)
+			}
+			keyBytes = cryptography.MarkNonExportableKey(keyBytes)
+		}
+
+		export.WrappedKey, err = cryptography.AESKeyWrapPad(wrappingKeyBytes, keyBytes)
+		if err != nil {
+			return nil, fmt.Errorf("%w", err)
+		}
+		export.WrappingAlgorithm = cryptography.AESKeyWrapPadAlgorithm
+		export.WrappingKeyID = wrappingKeyMeta.ID
+	} else {
+		publicKey, err := cryptography.ParseRSAWrappingKey(wrapping.PublicKey)
+		if err != nil {
+			return nil, fmt.Errorf("%w", err)
+		}
+		if exportForbidden && !s.isTrustedWrappingKey(publicKey) {
+			return nil, fmt.Errorf("%w: key %s may only be wrapped with an RSA public key registered by an administrator", keys.ErrExportForbidden, keyID)
+		}
+
+		if keyMeta.Type == "symmetric" {
+			export.WrappedKey, err = cryptography.RSAOAEPWrapKey(publicKey, keyBytes)
+			export.WrappingAlgorithm = cryptography.RSAOAEPAlgorithm
+		} else {
+			export.WrappedKey, err = cryptography.RSAAESKeyWrap(publicKey, keyBytes)
+			export.WrappingAlgorithm = cryptography.RSAAESKeyWrapAlgorithm
+		}
+		if err != nil {
+			return nil, fmt.Errorf("%w", err)
+		}
+	}
+
+	s.logger.Info(fmt.Sprintf("Exported key %s wrapped with %s", keyMeta.ID, export.WrappingAlgorithm))
+	return export, nil
+}
+
+// isTrustedWrappingKey reports whether the RSA public key is one of the wrapping keys registered by administrators
+func (s *cryptoKeyDownloadService) isTrustedWrappingKey(publicKey *rsa.PublicKey) bool {
+	for _, trustedWrappingKey := range s.trustedWrappingKeys {
+		if trustedWrappingKey.Equal(publicKey) {
+			return true
+		}
+	}
+	return false
+}
+
+// downloadKey downloads the material of a key that is not destroyed from the vault, unwraps it with the master key and encodes EC keys as PKCS#8 or PKIX
+func (s *cryptoKeyDownloadService) downloadKey(ctx context.Context, keyMeta *keys.CryptoKeyMeta) ([]byte, error) {
+	if keyMeta.EffectiveState(time.Now()) == keys.KeyStateDestroyed {
+		return nil, fmt.Errorf("%w: key %s is destroyed", keys.ErrKeyState, keyMeta.ID)
+	}
+	if keyMeta.TokenResident() && keyMeta.Type != "public" {
+		return nil, fmt.Errorf("%w: key %s is generated on token %s and cannot be exported", keys.ErrExportForbidden, keyMeta.ID, keyMeta.TokenLabel)
+	}
+
+	keyBytes, err := loadCryptoKey(ctx, s.vaultConnector, s.masterKeyProvider, keyMeta)
+	if err != nil {
+		return nil, fmt.Errorf("%w", err)
+	}
+
+	keyBytes, err = encodeECKey(keyBytes, keyMeta)
+	if err != nil {
+		return nil, fmt.Errorf("%w", err)
+	}
+
+	return keyBytes, nil
+}
+
+// getAuthorizedCryptoKey retrieves a key's metadata by ID and returns auth.ErrForbidden unless the caller has the permission on the key
+func getAuthorizedCryptoKey(ctx context.Context, permissionManagement permissions.PermissionManagement, cryptoKeyRepo keys.CryptoKeyRepository, keyID, permission string) (*keys.CryptoKeyMeta, error) {
+	if err := checkPermission(ctx, permissionManagement, permission, permissions.Key(keyID)); err != nil {
+		return nil, fmt.Errorf("%w", err)
+	}
+
+	keyMeta, err := cryptoKeyRepo.GetByID(ctx, keyID)
+	if err != nil {
+		return nil, fmt.Errorf("%w", err)
+	}
+
+	return keyMeta, nil
+}
+
+// encodeECKey encodes EC key material as PKCS#8 private or PKIX public key.
+// It migrates keys stored in the legacy raw format (D||X||Y and X||Y), keys of other algorithms are returned as is.
+func encodeECKey(keyBytes []byte, cryptoKeyMeta *keys.CryptoKeyMeta) ([]byte, error) {
+	if cryptoKeyMeta.Algorithm != "EC" {
+		return keyBytes, nil
+	}
+
+	switch cryptoKeyMeta.Type {
+	case "private":
+		privateKey, err := cryptography.ParseECPrivateKey(keyBytes)
+		if err != nil {
+			return nil, fmt.Errorf("failed to parse key %s: %w", cryptoKeyMeta.ID, err)
+		}
+		privateKeyBytes, err := cryptography.MarshalECPrivateKey(privateKey)
+		if err != nil {
+			return nil, fmt.Errorf("%w", err)
+		}
+		return privateKeyBytes, nil
+	case "public":
+		publicKey, err := cryptography.ParseECPublicKey(keyBytes)
+		if err != nil {
+			return nil, fmt.Errorf("failed to parse key %s: %w", cryptoKeyMeta.ID, err)
+		}
+		publicKeyBytes, err := cryptography.MarshalECPublicKey(publicKey)
+		if err != nil {
+			return nil, fmt.Errorf("%w", err)
+		}
+		return publicKeyBytes, nil
+	default:
+		return nil, fmt.Errorf("unsupported EC key type: %s", cryptoKeyMeta.Type)
+	}
+}
+
+// loadCryptoKey downloads the material of a key from the vault and unwraps it with the master key.
+// Private keys generated on a PKCS#11 token never leave it, so no material is returned for them and operations go through newSigner and newDecrypter.
+func loadCryptoKey(ctx context.Context, vaultConnector connector.VaultConnector, masterKeyProvider cryptography.MasterKeyProvider, cryptoKeyMeta *keys.CryptoKeyMeta) ([]byte, error) {
+	if cryptoKeyMeta.TokenResident() && cryptoKeyMeta.Type != "public" {
+		return nil, nil
+	}
+
+	keyBytes, err := vaultConnector.Download(ctx, cryptoKeyMeta.ID, cryptoKeyMeta.KeyPairID, cryptoKeyMeta.Type)
+	if err != nil {
+		return nil, fmt.Errorf("%w", err)
+	}
+
+	keyBytes, err = unwrapCryptoKey(masterKeyProvider, keyBytes, cryptoKeyMeta)
+	if err != nil {
+		return nil, fmt.Errorf("%w", err)
+	}
+
+	return keyBytes, nil
+}
+
+// newSigner returns a crypto.Signer for an RSA or EC private key, delegating to the PKCS#11 token for keys generated on it
+func newSigner(ctx context.Context, vaultConnector connector.VaultConnector, keyBytes []byte, cryptoKeyMeta *keys.CryptoKeyMeta) (crypto.Signer, error) {
+	if cryptoKeyMeta.TokenResident() {
+		tokenVaultConnector, ok := vaultConnector.(connector.TokenVaultConnector)
+		if !ok {
+			return nil, fmt.Errorf("key %s is generated on token %s, which the vault is not backed by", cryptoKeyMeta.ID, cryptoKeyMeta.TokenLabel)
+		}
+		signer, err := tokenVaultConnector.Signer(ctx, cryptoKeyMeta)
+		if err != nil {
+			return nil, fmt.Errorf("%w", err)
+		}
+		return signer, nil
+	}
+
+	switch cryptoKeyMeta.Algorithm {
+	case "RSA":
+		privateKey, err := x509.ParsePKCS1PrivateKey(keyBytes)
+		if err != nil {
+			return nil, fmt.Errorf("failed to parse RSA private key: %w", err)
+		}
+		return privateKey, nil
+	case "EC":
+		privateKey, err := cryptography.ParseECPrivateKey(keyBytes)
+		if err != nil {
+			return nil, fmt.Errorf("%w", err)
+		}
+		return privateKey, nil
+	default:
+		return nil, fmt.Errorf("unsupported algorithm for signing: %s", cryptoKeyMeta.Algorithm)
+	}
+}
+
+// newDecrypter returns a crypto.Decrypter for an RSA private key, delegating to the PKCS#11 token for keys generated on it
+func newDecrypter(ctx context.Context, vaultConnector connector.VaultConnector, keyBytes []byte, cryptoKeyMeta *keys.CryptoKeyMeta) (crypto.Decrypter, error) {
+	if cryptoKeyMeta.Algorithm != "RSA" {
+		return nil, fmt.Errorf("unsupported algorithm for decryption: %s", cryptoKeyMeta.Algorithm)
+	}
+
+	if cryptoKeyMeta.TokenResident() {
+		tokenVaultConnector, ok := vaultConnector.(connector.TokenVaultConnector)
+		if !ok {
+			return nil, fmt.Errorf("key %s is generated on token %s, which the vault is not backed by", cryptoKeyMeta.ID, cryptoKeyMeta.TokenLabel)
+		}
+		decrypter, err := tokenVaultConnector.Decrypter(ctx, cryptoKeyMeta)
+		if err != nil {
+			return nil, fmt.Errorf("%w", err)
+		}
+		return decrypter, nil
+	}
+
+	privateKey, err := x509.ParsePKCS1PrivateKey(keyBytes)
+	if err != nil {
+		return nil, fmt.Errorf("failed to parse RSA private key: %w", err)
+	}
+	return privateKey, nil
+}
+
+// unwrapCryptoKey unwraps key material downloaded from the vault with the master key version recorded in its metadata.
+// Keys stored before envelope encryption was introduced (KEK version 0) are returned as is.
+// While a master key rotation is running the vault may already hold the key re-wrapped with the current version
+// before its metadata is updated, so the current version is tried as a fallback.
+func unwrapCryptoKey(masterKeyProvider cryptography.MasterKeyProvider, keyBytes []byte, cryptoKeyMeta *keys.CryptoKeyMeta) ([]byte, error) {
+	if cryptoKeyMeta.KEKVersion == 0 {
+		return keyBytes, nil
+	}
+
+	return unwrapStoredKey(masterKeyProvider, keyBytes, cryptoKeyMeta.KEKVersion, cryptoKeyMeta.ID, cryptoKeyMeta.KeyPairID, cryptoKeyMeta.Type)
+}
+
+// unwrapStoredKey unwraps key material wrapped with the given master key version, falling back to the current version like unwrapCryptoKey
+func unwrapStoredKey(masterKeyProvider cryptography.MasterKeyProvider, keyBytes []byte, kekVersion uint32, keyID, keyPairID, keyType string) ([]byte, error) {
+	plainKeyBytes, err := masterKeyProvider.Unwrap(keyBytes, kekVersion, keyID, keyPairID, keyType)
+	if err != nil {
+		currentVersion := masterKeyProvider.CurrentVersion()
+		if currentVersion == kekVersion {
+			return nil, fmt.Errorf("failed to unwrap key %s: %w", keyID, err)
+		}
+
+		plainKeyBytes, fallbackErr := masterKeyProvider.Unwrap(keyBytes, currentVersion, keyID, keyPairID, keyType)
+		if fallbackErr != nil {
+			return nil, fmt.Errorf("failed to unwrap key %s: %w", keyID, err)
+		}
+		return plainKeyBytes, nil
+	}
+
+	return plainKeyBytes, nil
 }
@@ -5,24 +5,51 @@ package services
 
 import (
 	"context"
+	cryptoHash "crypto"
+	"crypto/ecdsa"
+	"crypto/elliptic"
+	"crypto/rand"
+	"crypto/rsa"
+	"crypto/sha256"
+	"crypto/x509"
+	"encoding/pem"
+	"fmt"
+	"math/big"
+	"sync/atomic"
 	"testing"
+	"time"
 
 	"github.com/google/uuid"
 	"github.com/stretchr/testify/require"
-	"gorm.io/gorm"
 
+	"crypto_vault_service/internal/domain/auth"
+	"crypto_vault_service/internal/domain/blobs"
+	"crypto_vault_service/internal/domain/crypto"
 	"crypto_vault_service/internal/domain/keys"
+	"crypto_vault_service/internal/domain/permissions"
+	"crypto_vault_service/internal/infrastructure/authorization"
 	"crypto_vault_service/internal/infrastructure/connector"
+	"crypto_vault_service/internal/infrastructure/cryptography"
 	"crypto_vault_service/internal/infrastructure/logger"
 	"crypto_vault_service/internal/infrastructure/settings"
 	"crypto_vault_service/internal/persistence/repository"
 )
 
 type KeyServicesTest struct {
-	cryptoKeyUploadService   keys.CryptoKeyUploadService
-	cryptoKeyMetadataService keys.CryptoKeyMetadataService
-	cryptoKeyDownloadService keys.CryptoKeyDownloadService
-	dbContext                *repository.TestDBContext
+	cryptoKeyUploadService      keys.CryptoKeyUploadService
+	cryptoKeyMetadataService    keys.CryptoKeyMetadataService
+	cryptoKeyDownloadService    keys.CryptoKeyDownloadService
+	cryptoKeyDestructionService keys.CryptoKeyDestructionService
+	cryptoKeyRotationService    keys.CryptoKeyRotationService
+	cryptoKeyImportService      keys.CryptoKeyImportService
+	masterKeyRotationService    keys.MasterKeyRotationService
+	cryptoOperationService      crypto.CryptoOperationService
+	vaultConnector              connector.VaultConnector
+	masterKeyProvider           cryptography.MasterKeyProvider
+	permissionManagement        permissions.PermissionManagement
+	trustedWrappingKey          *rsa.PrivateKey
+	logger                      logger.Logger
+	dbContext                   *repository.TestDBContext
 }
 
 func NewKeyServicesTest(t *testing.T, dbType string) *KeyServicesTest {
@@ -49,22 +76,57 @@ func NewKeyServicesTest(t *testing.T, dbType string) *KeyServicesTest {
 	vaultConnector, err := connector.NewAzureVaultConnector(ctx, keyConnectorSettings, logger)
 	require.NoError(t, err, "Error creating vault connector")
 
+	masterKeyProvider, err := cryptography.NewFileMasterKeyProvider(t.TempDir(), true, logger)
+	require.NoError(t, err, "Error creating master key provider")
+
+	permissionManagement, err := authorization.NewLocalPermissionManagement(dbContext.RelationTupleRepo, logger)
+	require.NoError(t, err, "Error creating permission management")
+
+	// RSA key registered by an administrator to wrap keys whose plaintext export is forbidden
+	trustedWrappingKey, err := rsa.GenerateKey(rand.Reader, 2048)
+	require.NoError(t, err, "Error generating trusted wrapping key")
+
 	// Initialize services
-	cryptoKeyUploadService, err := NewCryptoKeyUploadService(vaultConnector, dbContext.CryptoKeyRepo, logger)
+	cryptoKeyUploadService, err := NewCryptoKeyUploadService(vaultConnector, dbContext.CryptoKeyRepo, dbContext.LogicalKeyRepo, masterKeyProvider, permissionManagement, logger)
 	require.NoError(t, err, "Error creating CryptoKeyUploadService")
 
-	cryptoKeyMetadataService, err := NewCryptoKeyMetadataService(vaultConnector, dbContext.CryptoKeyRepo, logger)
+	cryptoKeyMetadataService, err := NewCryptoKeyMetadataService(vaultConnector, dbContext.CryptoKeyRepo, dbContext.BlobRepo, permissionManagement, 7*24*time.Hour, logger)
 	require.NoError(t, err, "Error creating CryptoKeyMetadataService")
 
-	cryptoKeyDownloadService, err := NewCryptoKeyDownloadService(vaultConnector, dbContext.CryptoKeyRepo, logger)
+	cryptoKeyDestructionService, err := NewCryptoKeyDestructionService(vaultConnector, dbContext.CryptoKeyRepo, time.Hour, logger)
+	require.NoError(t, err, "Error creating CryptoKeyDestructionService")
+
+	cryptoKeyDownloadService, err := NewCryptoKeyDownloadService(vaultConnector, dbContext.CryptoKeyRepo, dbContext.LogicalKeyRepo, masterKeyProvider, permissionManagement, []*rsa.PublicKey{&trustedWrappingKey.PublicKey}, logger)
 	require.NoError(t, err, "Error creating CryptoKeyDownloadService")
 
+	cryptoKeyRotationService, err := NewCryptoKeyRotationService(vaultConnector, dbContext.CryptoKeyRepo, dbContext.LogicalKeyRepo, masterKeyProvider, permissionManagement, time.Hour, logger)
+	require.NoError(t, err, "Error creating CryptoKeyRotationService")
+
+	cryptoKeyImportService, err := NewCryptoKeyImportService(vaultConnector, dbContext.CryptoKeyRepo, dbContext.LogicalKeyRepo, dbContext.KeyImportKeyRepo, masterKeyProvider, permissionManagement, logger)
+	require.NoError(t, err, "Error creating CryptoKeyImportService")
+
+	masterKeyRotationService, err := NewMasterKeyRotationService(vaultConnector, dbContext.CryptoKeyRepo, dbContext.KeyImportKeyRepo, dbContext.BlobLinkSigningKeyRepo, dbContext.MasterKeyRotationRepo, masterKeyProvider, logger)
+	require.NoError(t, err, "Error creating MasterKeyRotationService")
+
+	cryptoOperationService, err := NewCryptoOperationService(vaultConnector, dbContext.CryptoKeyRepo, dbContext.LogicalKeyRepo, masterKeyProvider, permissionManagement, logger)
+	require.NoError(t, err, "Error creating CryptoOperationService")
+
 	// Return struct with services and context
 	return &KeyServicesTest{
-		cryptoKeyUploadService:   cryptoKeyUploadService,
-		cryptoKeyMetadataService: cryptoKeyMetadataService,
-		cryptoKeyDownloadService: cryptoKeyDownloadService,
-		dbContext:                dbContext,
+		cryptoKeyUploadService:      cryptoKeyUploadService,
+		cryptoKeyMetadataService:    cryptoKeyMetadataService,
+		cryptoKeyDownloadService:    cryptoKeyDownloadService,
+		cryptoKeyDestructionService: cryptoKeyDestructionService,
+		cryptoKeyRotationService:    cryptoKeyRotationService,
+		cryptoKeyImportService:      cryptoKeyImportService,
+		masterKeyRotationService:    masterKeyRotationService,
+		cryptoOperationService:      cryptoOperationService,
+		vaultConnector:              vaultConnector,
+		masterKeyProvider:           masterKeyProvider,
+		permissionManagement:        permissionManagement,
+		trustedWrappingKey:          trustedWrappingKey,
+		logger:                      logger,
+		dbContext:                   dbContext,
 	}
 }
 
@@ -77,9 +139,9 @@ func TestCryptoKeyUploadService_Upload_Success(t *testing.T) {
 	userID := uuid.New().String()
 	keyAlgorithm := "EC"
 	var keySize uint32 = 256
-	ctx := context.Background()
+	ctx := auth.WithUserID(context.Background(), userID)
 
-	cryptoKeyMetas, err := keyServices.cryptoKeyUploadService.Upload(ctx, userID, keyAlgorithm, keySize)
+	cryptoKeyMetas, err := keyServices.cryptoKeyUploadService.Upload(ctx, keyAlgorithm, keySize, nil)
 	require.NoError(t, err)
 	require.Equal(t, len(cryptoKeyMetas), 2)
 	require.NotNil(t, cryptoKeyMetas)
@@ -87,6 +149,130 @@ func TestCryptoKeyUploadService_Upload_Success(t *testing.T) {
 	require.Equal(t, userID, cryptoKeyMetas[0].UserID)
 	require.NotEmpty(t, cryptoKeyMetas[1].ID)
 	require.Equal(t, userID, cryptoKeyMetas[1].UserID)
+	require.Equal(t, uint32(1), cryptoKeyMetas[0].KEKVersion)
+	require.Equal(t, uint32(1), cryptoKeyMetas[1].KEKVersion)
+}
+
+// Test case for failed key upload due to a missing caller identity
+func TestCryptoKeyUploadService_Upload_Fail_Unauthenticated(t *testing.T) {
+	dbType := "sqlite"
+	keyServices := NewKeyServicesTest(t, dbType)
+	defer repository.TeardownTestDB(t, keyServices.dbContext, dbType)
+
+	cryptoKeyMetas, err := keyServices.cryptoKeyUploadService.Upload(context.Background(), "EC", 256, nil)
+	require.ErrorIs(t, err, auth.ErrUnauthenticated)
+	require.Nil(t, cryptoKeyMetas)
+}
+
+// failingCreateCryptoKeyRepository fails storing the metadata of keys of type failType and records the metadata stored and failed to store
+type failingCreateCryptoKeyRepository struct {
+	keys.CryptoKeyRepository
+	failType string
+	created  []*keys.CryptoKeyMeta
+	failed   []*keys.CryptoKeyMeta
+}
+
+func (r *failingCreateCryptoKeyRepository) Create(ctx context.Context, cryptoKeyMeta *keys.CryptoKeyMeta) error {
+	if cryptoKeyMeta.Type == r.failType {
+		r.failed = append(r.failed, cryptoKeyMeta)
+		return fmt.Errorf("database unavailable")
+	}
+	r.created = append(r.created, cryptoKeyMeta)
+	return r.CryptoKeyRepository.Create(ctx, cryptoKeyMeta)
+}
+
+// Test case for removing the material and ownership of a key once storing its metadata fails
+func TestCryptoKeyUploadService_Upload_Fail_Rollback(t *testing.T) {
+	dbType := "sqlite"
+	keyServices := NewKeyServicesTest(t, dbType)
+	defer repository.TeardownTestDB(t, keyServices.dbContext, dbType)
+
+	cryptoKeyRepo := &failingCreateCryptoKeyRepository{CryptoKeyRepository: keyServices.dbContext.CryptoKeyRepo, failType: "symmetric"}
+	uploadService := *keyServices.cryptoKeyUploadService.(*cryptoKeyUploadService)
+	uploadService.cryptoKeyRepo = cryptoKeyRepo
+
+	ctx := auth.WithUserID(context.Background(), uuid.New().String())
+	cryptoKeyMetas, err := uploadService.Upload(ctx, "AES", 256, nil)
+	require.Error(t, err)
+	require.Nil(t, cryptoKeyMetas)
+	require.Len(t, cryptoKeyRepo.failed, 1)
+
+	failedKeyMeta := cryptoKeyRepo.failed[0]
+	_, err = keyServices.vaultConnector.Download(ctx, failedKeyMeta.ID, failedKeyMeta.KeyPairID, failedKeyMeta.Type)
+	require.Error(t, err)
+
+	tuples, err := keyServices.permissionManagement.ReadTuples(context.Background(), &permissions.RelationTupleQuery{Object: permissions.Key(failedKeyMeta.ID)})
+	require.NoError(t, err)
+	require.Empty(t, tuples)
+}
+
+// failingCreateLogicalKeyRepository fails creating logical keys
+type failingCreateLogicalKeyRepository struct {
+	keys.LogicalKeyRepository
+}
+
+func (r *failingCreateLogicalKeyRepository) Create(ctx context.Context, logicalKey *keys.LogicalKey) error {
+	return fmt.Errorf("database unavailable")
+}
+
+// Test case for removing the stored keys of an upload once creating their logical key fails
+func TestCryptoKeyUploadService_Upload_Fail_LogicalKey_Rollback(t *testing.T) {
+	dbType := "sqlite"
+	keyServices := NewKeyServicesTest(t, dbType)
+	defer repository.TeardownTestDB(t, keyServices.dbContext, dbType)
+
+	cryptoKeyRepo := &failingCreateCryptoKeyRepository{CryptoKeyRepository: keyServices.dbContext.CryptoKeyRepo}
+	uploadService := *keyServices.cryptoKeyUploadService.(*cryptoKeyUploadService)
+	uploadService.cryptoKeyRepo = cryptoKeyRepo
+	uploadService.logicalKeyRepo = &failingCreateLogicalKeyRepository{LogicalKeyRepository: keyServices.dbContext.LogicalKeyRepo}
+
+	ctx := auth.WithUserID(context.Background(), uuid.New().String())
+	cryptoKeyMetas, err := uploadService.Upload(ctx, "RSA", 2048, nil)
+	require.Error(t, err)
+	require.Nil(t, cryptoKeyMetas)
+	require.Len(t, cryptoKeyRepo.created, 2)
+
+	for _, storedKeyMeta := range cryptoKeyRepo.created {
+		_, err = keyServices.dbContext.CryptoKeyRepo.GetByID(ctx, storedKeyMeta.ID)
+		require.Error(t, err)
+
+		_, err = keyServices.vaultConnector.Download(ctx, storedKeyMeta.ID, storedKeyMeta.KeyPairID, storedKeyMeta.Type)
+		require.Error(t, err)
+
+		tuples, err := keyServices.permissionManagement.ReadTuples(context.Background(), &permissions.RelationTupleQuery{Object: permissions.Key(storedKeyMeta.ID)})
+		require.NoError(t, err)
+		require.Empty(t, tuples)
+	}
+}
+
+// Test case for successful key generation, import and rotation for a caller whose JWT subject is no UUID
+func TestCryptoKeyServices_NonUUIDSubject_Success(t *testing.T) {
+	dbType := "sqlite"
+	keyServices := NewKeyServicesTest(t, dbType)
+	defer repository.TeardownTestDB(t, keyServices.dbContext, dbType)
+
+	userID := "auth0|user-1"
+	ctx := auth.WithUserID(context.Background(), userID)
+
+	cryptoKeyMetas, err := keyServices.cryptoKeyUploadService.Upload(ctx, "AES", 256, nil)
+	require.NoError(t, err)
+	require.Len(t, cryptoKeyMetas, 1)
+	require.Equal(t, userID, cryptoKeyMetas[0].UserID)
+
+	importedKeyMetas, err := keyServices.cryptoKeyImportService.Import(ctx, &keys.CryptoKeyImport{Algorithm: "AES", Format: cryptography.KeyFormatRaw, KeyMaterial: make([]byte, 32)}, nil)
+	require.NoError(t, err)
+	require.Len(t, importedKeyMetas, 1)
+	require.Equal(t, userID, importedKeyMetas[0].UserID)
+
+	rotatedKeyMetas, err := keyServices.cryptoKeyRotationService.Rotate(ctx, cryptoKeyMetas[0].ID)
+	require.NoError(t, err)
+	require.Len(t, rotatedKeyMetas, 1)
+	require.Equal(t, userID, rotatedKeyMetas[0].UserID)
+	require.Equal(t, uint32(2), rotatedKeyMetas[0].Version)
+
+	listedKeyMetas, err := keyServices.cryptoKeyMetadataService.List(ctx, keys.NewCryptoKeyQuery())
+	require.NoError(t, err)
+	require.Len(t, listedKeyMetas, 3)
 }
 
 // Test case for successful retrieval of cryptographic key metadata by ID
@@ -99,9 +285,9 @@ func TestCryptoKeyMetadataService_GetByID_Success(t *testing.T) {
 	userID := uuid.New().String()
 	keyAlgorithm := "EC"
 	var keySize uint32 = 256
-	ctx := context.Background()
+	ctx := auth.WithUserID(context.Background(), userID)
 
-	cryptoKeyMetas, err := keyServices.cryptoKeyUploadService.Upload(ctx, userID, keyAlgorithm, keySize)
+	cryptoKeyMetas, err := keyServices.cryptoKeyUploadService.Upload(ctx, keyAlgorithm, keySize, nil)
 	require.NoError(t, err)
 
 	fetchedCryptoKeyMeta, err := keyServices.cryptoKeyMetadataService.GetByID(ctx, cryptoKeyMetas[0].ID)
@@ -110,7 +296,7 @@ func TestCryptoKeyMetadataService_GetByID_Success(t *testing.T) {
 	require.Equal(t, cryptoKeyMetas[0].ID, fetchedCryptoKeyMeta.ID)
 }
 
-// Test case for successful deletion of cryptographic key metadata by ID
+// Test case for scheduling the destruction of a key pair and destroying it once the grace period has passed
 func TestCryptoKeyMetadataService_DeleteByID_Success(t *testing.T) {
 	dbType := "sqlite"
 	keyServices := NewKeyServicesTest(t, dbType)
@@ -119,18 +305,129 @@ func TestCryptoKeyMetadataService_DeleteByID_Success(t *testing.T) {
 	userID := uuid.New().String()
 	keyAlgorithm := "EC"
 	var keySize uint32 = 521
-	ctx := context.Background()
+	ctx := auth.WithUserID(context.Background(), userID)
 
-	cryptoKeyMetas, err := keyServices.cryptoKeyUploadService.Upload(ctx, userID, keyAlgorithm, keySize)
+	cryptoKeyMetas, err := keyServices.cryptoKeyUploadService.Upload(ctx, keyAlgorithm, keySize, nil)
 	require.NoError(t, err)
+	privateKeyID := keyOfType(t, cryptoKeyMetas, "private")
+	publicKeyID := keyOfType(t, cryptoKeyMetas, "public")
 
-	err = keyServices.cryptoKeyMetadataService.DeleteByID(ctx, cryptoKeyMetas[0].ID)
+	signed, err := keyServices.cryptoOperationService.Sign(ctx, privateKeyID, []byte("payload"))
 	require.NoError(t, err)
 
-	var deletedCryptoKeyMeta keys.CryptoKeyMeta
-	err = keyServices.dbContext.DB.First(&deletedCryptoKeyMeta, "id = ?", cryptoKeyMetas[0].ID).Error
-	require.Error(t, err)
-	require.Equal(t, gorm.ErrRecordNotFound, err)
+	scheduledKeyMetas, err := keyServices.cryptoKeyMetadataService.DeleteByID(ctx, privateKeyID, false)
+	require.NoError(t, err)
+	require.Len(t, scheduledKeyMetas, 2)
+	for _, scheduledKeyMeta := range scheduledKeyMetas {
+		require.NotNil(t, scheduledKeyMeta.DateTimeDestruction)
+		require.WithinDuration(t, time.Now().Add(7*24*time.Hour), *scheduledKeyMeta.DateTimeDestruction, time.Minute)
+	}
+
+	// Keys scheduled for destruction protect no data but still process it during the grace period
+	_, err = keyServices.cryptoOperationService.Sign(ctx, privateKeyID, []byte("payload"))
+	require.ErrorIs(t, err, keys.ErrKeyState)
+	verified, err := keyServices.cryptoOperationService.Verify(ctx, publicKeyID, []byte("payload"), signed.Signature)
+	require.NoError(t, err)
+	require.True(t, *verified.Valid)
+
+	destroyedKeys, err := keyServices.cryptoKeyDestructionService.DestroyDue(context.Background())
+	require.NoError(t, err)
+	require.Equal(t, 0, destroyedKeys)
+
+	// Pass the grace period
+	past := time.Now().Add(-time.Minute)
+	err = keyServices.dbContext.DB.Model(&keys.CryptoKeyMeta{}).Where("key_pair_id = ?", scheduledKeyMetas[0].KeyPairID).Update("date_time_destruction", past).Error
+	require.NoError(t, err)
+
+	destroyedKeys, err = keyServices.cryptoKeyDestructionService.DestroyDue(context.Background())
+	require.NoError(t, err)
+	require.Equal(t, 2, destroyedKeys)
+
+	// Destroyed keys keep their metadata, but their material is gone
+	for _, keyID := range []string{privateKeyID, publicKeyID} {
+		keyMeta, err := keyServices.cryptoKeyMetadataService.GetByID(ctx, keyID)
+		require.NoError(t, err)
+		require.Equal(t, keys.KeyStateDestroyed, keyMeta.State)
+
+		_, err = keyServices.vaultConnector.Download(context.Background(), keyMeta.ID, keyMeta.KeyPairID, keyMeta.Type)
+		require.Error(t, err)
+	}
+
+	_, err = keyServices.cryptoKeyMetadataService.DeleteByID(ctx, privateKeyID, false)
+	require.ErrorIs(t, err, keys.ErrKeyState)
+}
+
+// Test case for cancelling the scheduled destruction of a key pair
+func TestCryptoKeyMetadataService_CancelDestruction_Success(t *testing.T) {
+	dbType := "sqlite"
+	keyServices := NewKeyServicesTest(t, dbType)
+	defer repository.TeardownTestDB(t, keyServices.dbContext, dbType)
+
+	ctx := auth.WithUserID(context.Background(), uuid.New().String())
+
+	cryptoKeyMetas, err := keyServices.cryptoKeyUploadService.Upload(ctx, "AES", 256, nil)
+	require.NoError(t, err)
+	keyID := cryptoKeyMetas[0].ID
+
+	// Keys not scheduled for destruction have nothing to cancel
+	_, err = keyServices.cryptoKeyMetadataService.CancelDestruction(ctx, keyID)
+	require.ErrorIs(t, err, keys.ErrKeyState)
+
+	_, err = keyServices.cryptoKeyMetadataService.DeleteByID(ctx, keyID, false)
+	require.NoError(t, err)
+
+	cancelledKeyMetas, err := keyServices.cryptoKeyMetadataService.CancelDestruction(ctx, keyID)
+	require.NoError(t, err)
+	require.Len(t, cancelledKeyMetas, 1)
+	require.Nil(t, cancelledKeyMetas[0].DateTimeDestruction)
+
+	_, err = keyServices.cryptoOperationService.Encrypt(ctx, keyID, []byte("payload"), nil)
+	require.NoError(t, err)
+
+	// Destruction can no longer be cancelled once the grace period has passed
+	_, err = keyServices.cryptoKeyMetadataService.DeleteByID(ctx, keyID, false)
+	require.NoError(t, err)
+	err = keyServices.dbContext.DB.Model(&keys.CryptoKeyMeta{}).Where("id = ?", keyID).Update("date_time_destruction", time.Now().Add(-time.Minute)).Error
+	require.NoError(t, err)
+
+	_, err = keyServices.cryptoKeyMetadataService.CancelDestruction(ctx, keyID)
+	require.ErrorIs(t, err, keys.ErrKeyState)
+}
+
+// Test case for refusing to destroy keys blobs are still protected with unless forced
+func TestCryptoKeyMetadataService_DeleteByID_Fail_InUse(t *testing.T) {
+	dbType := "sqlite"
+	keyServices := NewKeyServicesTest(t, dbType)
+	defer repository.TeardownTestDB(t, keyServices.dbContext, dbType)
+
+	userID := uuid.New().String()
+	ctx := auth.WithUserID(context.Background(), userID)
+
+	cryptoKeyMetas, err := keyServices.cryptoKeyUploadService.Upload(ctx, "AES", 256, nil)
+	require.NoError(t, err)
+	keyID := cryptoKeyMetas[0].ID
+
+	blob := &blobs.BlobMeta{
+		ID:              uuid.New().String(),
+		DateTimeCreated: time.Now(),
+		UserID:          userID,
+		Name:            "blob",
+		Size:            1024,
+		Type:            "text",
+		EncryptionKeyID: &keyID,
+	}
+	require.NoError(t, keyServices.dbContext.BlobRepo.Create(context.Background(), blob))
+
+	_, err = keyServices.cryptoKeyMetadataService.DeleteByID(ctx, keyID, false)
+	require.ErrorIs(t, err, keys.ErrKeyInUse)
+
+	keyMeta, err := keyServices.cryptoKeyMetadataService.GetByID(ctx, keyID)
+	require.NoError(t, err)
+	require.Nil(t, keyMeta.DateTimeDestruction)
+
+	scheduledKeyMetas, err := keyServices.cryptoKeyMetadataService.DeleteByID(ctx, keyID, true)
+	require.NoError(t, err)
+	require.NotNil(t, scheduledKeyMetas[0].DateTimeDestruction)
 }
 
 // Test case for successful download of cryptographic key
@@ -142,9 +439,9 @@ func TestCryptoKeyDownloadService_Download_Success(t *testing.T) {
 	userID := uuid.New().String()
 	keyAlgorithm := "EC"
 	var keySize uint32 = 256
-	ctx := context.Background()
+	ctx := auth.WithUserID(context.Background(), userID)
 
-	cryptoKeyMetas, err := keyServices.cryptoKeyUploadService.Upload(ctx, userID, keyAlgorithm, keySize)
+	cryptoKeyMetas, err := keyServices.cryptoKeyUploadService.Upload(ctx, keyAlgorithm, keySize, nil)
 	require.NoError(t, err)
 
 	blobData, err := keyServices.cryptoKeyDownloadService.DownloadByID(ctx, cryptoKeyMetas[0].ID)
@@ -152,3 +449,1517 @@ func TestCryptoKeyDownloadService_Download_Success(t *testing.T) {
 	require.NotNil(t, blobData)
 	require.NotEmpty(t, blobData)
 }
+
+// Test case for exporting keys wrapped with a vault AES key and with RSA public keys supplied by the caller
+func TestCryptoKeyDownloadService_ExportWrapped_Success(t *testing.T) {
+	dbType := "sqlite"
+	keyServices := NewKeyServicesTest(t, dbType)
+	defer repository.TeardownTestDB(t, keyServices.dbContext, dbType)
+
+	ctx := auth.WithUserID(context.Background(), uuid.New().String())
+
+	aesKeyMetas, err := keyServices.cryptoKeyUploadService.Upload(ctx, "AES", 256, nil)
+	require.NoError(t, err)
+	wrappingKeyMetas, err := keyServices.cryptoKeyUploadService.Upload(ctx, "AES", 256, nil)
+	require.NoError(t, err)
+	rsaKeyMetas, err := keyServices.cryptoKeyUploadService.Upload(ctx, "RSA", 2048, nil)
+	require.NoError(t, err)
+	privateKeyID := keyOfType(t, rsaKeyMetas, "private")
+
+	aesKey, err := keyServices.cryptoKeyDownloadService.DownloadByID(ctx, aesKeyMetas[0].ID)
+	require.NoError(t, err)
+	privateKeyBytes, err := keyServices.cryptoKeyDownloadService.DownloadByID(ctx, privateKeyID)
+	require.NoError(t, err)
+
+	// Wrapped with a vault AES key, the holder of which unwraps it
+	export, err := keyServices.cryptoKeyDownloadService.ExportWrappedByID(ctx, aesKeyMetas[0].ID, &keys.KeyExportWrapping{WrappingKeyID: wrappingKeyMetas[0].ID})
+	require.NoError(t, err)
+	require.Equal(t, cryptography.AESKeyWrapPadAlgorithm, export.WrappingAlgorithm)
+	require.Equal(t, wrappingKeyMetas[0].ID, export.WrappingKeyID)
+	unwrapped, err := keyServices.cryptoOperationService.Unwrap(ctx, wrappingKeyMetas[0].ID, export.WrappedKey)
+	require.NoError(t, err)
+	require.Equal(t, aesKey, unwrapped.Key)
+
+	// Wrapped with an external RSA public key
+	externalKey, err := rsa.GenerateKey(rand.Reader, 2048)
+	require.NoError(t, err)
+	externalPublicKey, err := x509.MarshalPKIXPublicKey(&externalKey.PublicKey)
+	require.NoError(t, err)
+	wrapping := &keys.KeyExportWrapping{PublicKey: pem.EncodeToMemory(&pem.Block{Type: "PUBLIC KEY", Bytes: externalPublicKey})}
+
+	export, err = keyServices.cryptoKeyDownloadService.ExportWrappedByID(ctx, aesKeyMetas[0].ID, wrapping)
+	require.NoError(t, err)
+	require.Equal(t, cryptography.RSAOAEPAlgorithm, export.WrappingAlgorithm)
+	require.Empty(t, export.WrappingKeyID)
+	unwrappedKey, err := cryptography.RSAOAEPUnwrapKey(externalKey, export.WrappedKey)
+	require.NoError(t, err)
+	require.Equal(t, aesKey, unwrappedKey)
+
+	export, err = keyServices.cryptoKeyDownloadService.ExportWrappedByID(ctx, privateKeyID, wrapping)
+	require.NoError(t, err)
+	require.Equal(t, cryptography.RSAAESKeyWrapAlgorithm, export.WrappingAlgorithm)
+	unwrappedKey, err = cryptography.RSAAESKeyUnwrap(externalKey, export.WrappedKey)
+	require.NoError(t, err)
+	require.Equal(t, privateKeyBytes, unwrappedKey)
+}
+
+// Test case for exports refused because of the wrapping key or the caller's permissions
+func TestCryptoKeyDownloadService_ExportWrapped_Fail(t *testing.T) {
+	dbType := "sqlite"
+	keyServices := NewKeyServicesTest(t, dbType)
+	defer repository.TeardownTestDB(t, keyServices.dbContext, dbType)
+
+	ctx := auth.WithUserID(context.Background(), uuid.New().String())
+	otherCtx := auth.WithUserID(context.Background(), uuid.New().String())
+
+	aesKeyMetas, err := keyServices.cryptoKeyUploadService.Upload(ctx, "AES", 256, nil)
+	require.NoError(t, err)
+	rsaKeyMetas, err := keyServices.cryptoKeyUploadService.Upload(ctx, "RSA", 2048, nil)
+	require.NoError(t, err)
+	otherKeyMetas, err := keyServices.cryptoKeyUploadService.Upload(otherCtx, "AES", 256, nil)
+	require.NoError(t, err)
+
+	smallKey, err := rsa.GenerateKey(rand.Reader, 1024)
+	require.NoError(t, err)
+
+	tests := []struct {
+		name     string
+		wrapping *keys.KeyExportWrapping
+	}{
+		{"No wrapping key", &keys.KeyExportWrapping{}},
+		{"RSA vault key", &keys.KeyExportWrapping{WrappingKeyID: keyOfType(t, rsaKeyMetas, "public")}},
+		{"Key itself", &keys.KeyExportWrapping{WrappingKeyID: aesKeyMetas[0].ID}},
+		{"Small public key", &keys.KeyExportWrapping{PublicKey: x509.MarshalPKCS1PublicKey(&smallKey.PublicKey)}},
+		{"Invalid public key", &keys.KeyExportWrapping{PublicKey: []byte("garbage")}},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			_, err := keyServices.cryptoKeyDownloadService.ExportWrappedByID(ctx, aesKeyMetas[0].ID, tt.wrapping)
+			require.Error(t, err)
+		})
+	}
+
+	// Keys of other users can neither be exported nor wrap exported keys
+	_, err = keyServices.cryptoKeyDownloadService.ExportWrappedByID(ctx, aesKeyMetas[0].ID, &keys.KeyExportWrapping{WrappingKeyID: otherKeyMetas[0].ID})
+	require.ErrorIs(t, err, auth.ErrForbidden)
+	_, err = keyServices.cryptoKeyDownloadService.ExportWrappedByID(otherCtx, aesKeyMetas[0].ID, &keys.KeyExportWrapping{WrappingKeyID: otherKeyMetas[0].ID})
+	require.ErrorIs(t, err, auth.ErrForbidden)
+}
+
+// Test case for forbidding plaintext export, which is kept by new versions and cannot be undone
+func TestCryptoKeyMetadataService_UpdateExportPolicy_Success(t *testing.T) {
+	dbType := "sqlite"
+	keyServices := NewKeyServicesTest(t, dbType)
+	defer repository.TeardownTestDB(t, keyServices.dbContext, dbType)
+
+	ctx := auth.WithUserID(context.Background(), uuid.New().String())
+
+	ecKeyMetas, err := keyServices.cryptoKeyUploadService.Upload(ctx, "EC", 256, nil)
+	require.NoError(t, err)
+	privateKeyID := keyOfType(t, ecKeyMetas, "private")
+	publicKeyID := keyOfType(t, ecKeyMetas, "public")
+	wrappingKeyMetas, err := keyServices.cryptoKeyUploadService.Upload(ctx, "AES", 256, nil)
+	require.NoError(t, err)
+
+	updatedKeyMetas, err := keyServices.cryptoKeyMetadataService.UpdateExportPolicy(ctx, publicKeyID, true)
+	require.NoError(t, err)
+	require.Len(t, updatedKeyMetas, 2)
+	for _, updatedKeyMeta := range updatedKeyMetas {
+		require.True(t, updatedKeyMeta.PlaintextExportForbidden)
+	}
+
+	// The private key is only exported wrapped, whereas the public key is not secret
+	_, err = keyServices.cryptoKeyDownloadService.DownloadByID(ctx, privateKeyID)
+	require.ErrorIs(t, err, keys.ErrExportForbidden)
+	_, err = keyServices.cryptoKeyDownloadService.DownloadByID(ctx, publicKeyID)
+	require.NoError(t, err)
+
+	// Wrapping keys that are exportable in plaintext or not registered by an administrator would reveal the private key
+	_, err = keyServices.cryptoKeyDownloadService.ExportWrappedByID(ctx, privateKeyID, &keys.KeyExportWrapping{WrappingKeyID: wrappingKeyMetas[0].ID})
+	require.ErrorIs(t, err, keys.ErrExportForbidden)
+	externalKey, err := rsa.GenerateKey(rand.Reader, 2048)
+	require.NoError(t, err)
+	_, err = keyServices.cryptoKeyDownloadService.ExportWrappedByID(ctx, privateKeyID, &keys.KeyExportWrapping{PublicKey: x509.MarshalPKCS1PublicKey(&externalKey.PublicKey)})
+	require.ErrorIs(t, err, keys.ErrExportForbidden)
+
+	// Exports wrapped with a vault key whose plaintext export is forbidden as well cannot be unwrapped by the service
+	_, err = keyServices.cryptoKeyMetadataService.UpdateExportPolicy(ctx, wrappingKeyMetas[0].ID, true)
+	require.NoError(t, err)
+	export, err := keyServices.cryptoKeyDownloadService.ExportWrappedByID(ctx, privateKeyID, &keys.KeyExportWrapping{WrappingKeyID: wrappingKeyMetas[0].ID})
+	require.NoError(t, err)
+	_, err = keyServices.cryptoOperationService.Unwrap(ctx, wrappingKeyMetas[0].ID, export.WrappedKey)
+	require.ErrorIs(t, err, keys.ErrExportForbidden)
+
+	// Exports wrapped with a registered RSA public key are unwrapped by the holder of its private key
+	export, err = keyServices.cryptoKeyDownloadService.ExportWrappedByID(ctx, privateKeyID, &keys.KeyExportWrapping{PublicKey: x509.MarshalPKCS1PublicKey(&keyServices.trustedWrappingKey.PublicKey)})
+	require.NoError(t, err)
+	unwrappedKey, err := cryptography.RSAAESKeyUnwrap(keyServices.trustedWrappingKey, export.WrappedKey)
+	require.NoError(t, err)
+	_, err = x509.ParsePKCS8PrivateKey(unwrappedKey)
+	require.NoError(t, err)
+
+	_, err = keyServices.cryptoKeyMetadataService.UpdateExportPolicy(ctx, privateKeyID, false)
+	require.ErrorIs(t, err, keys.ErrExportForbidden)
+
+	rotatedKeyMetas, err := keyServices.cryptoKeyRotationService.Rotate(ctx, privateKeyID)
+	require.NoError(t, err)
+	for _, rotatedKeyMeta := range rotatedKeyMetas {
+		require.True(t, rotatedKeyMeta.PlaintextExportForbidden)
+	}
+	_, err = keyServices.cryptoKeyDownloadService.DownloadByID(ctx, keyOfType(t, rotatedKeyMetas, "private"))
+	require.ErrorIs(t, err, keys.ErrExportForbidden)
+}
+
+// Test case for downloading EC keys of every supported curve encoded as PKCS#8 and PKIX
+func TestCryptoKeyDownloadService_Download_EC_Keys_Standard_Encoding(t *testing.T) {
+	dbType := "sqlite"
+	keyServices := NewKeyServicesTest(t, dbType)
+	defer repository.TeardownTestDB(t, keyServices.dbContext, dbType)
+
+	userID := uuid.New().String()
+	ctx := auth.WithUserID(context.Background(), userID)
+
+	for _, keySize := range []uint32{256, 384, 521} {
+		cryptoKeyMetas, err := keyServices.cryptoKeyUploadService.Upload(ctx, "EC", keySize, nil)
+		require.NoError(t, err)
+		require.Len(t, cryptoKeyMetas, 2)
+
+		privateKeyBytes, err := keyServices.cryptoKeyDownloadService.DownloadByID(ctx, cryptoKeyMetas[0].ID)
+		require.NoError(t, err)
+		privateKey, err := x509.ParsePKCS8PrivateKey(privateKeyBytes)
+		require.NoError(t, err)
+		require.Equal(t, int(keySize), privateKey.(*ecdsa.PrivateKey).Curve.Params().BitSize)
+
+		publicKeyBytes, err := keyServices.cryptoKeyDownloadService.DownloadByID(ctx, cryptoKeyMetas[1].ID)
+		require.NoError(t, err)
+		publicKey, err := x509.ParsePKIXPublicKey(publicKeyBytes)
+		require.NoError(t, err)
+		require.True(t, privateKey.(*ecdsa.PrivateKey).PublicKey.Equal(publicKey))
+	}
+}
+
+// Test case for migrating an EC key stored in the legacy raw format D||X||Y
+func TestMasterKeyRotationService_Rotate_Migrates_Legacy_EC_Keys(t *testing.T) {
+	dbType := "sqlite"
+	keyServices := NewKeyServicesTest(t, dbType)
+	defer repository.TeardownTestDB(t, keyServices.dbContext, dbType)
+
+	userID := uuid.New().String()
+	ctx := auth.WithUserID(context.Background(), userID)
+
+	cryptoKeyMetas, err := keyServices.cryptoKeyUploadService.Upload(ctx, "EC", 384, nil)
+	require.NoError(t, err)
+
+	privateKeyBytes, err := keyServices.cryptoKeyDownloadService.DownloadByID(ctx, cryptoKeyMetas[0].ID)
+	require.NoError(t, err)
+	privateKey, err := cryptography.ParseECPrivateKey(privateKeyBytes)
+	require.NoError(t, err)
+
+	// Store the private key unwrapped in the legacy raw format
+	legacyKeyBytes := append(privateKey.D.Bytes(), privateKey.X.Bytes()...)
+	legacyKeyBytes = append(legacyKeyBytes, privateKey.Y.Bytes()...)
+	err = keyServices.vaultConnector.Replace(ctx, legacyKeyBytes, cryptoKeyMetas[0].ID, cryptoKeyMetas[0].KeyPairID, cryptoKeyMetas[0].Type)
+	require.NoError(t, err)
+	cryptoKeyMetas[0].KEKVersion = 0
+	require.NoError(t, keyServices.dbContext.CryptoKeyRepo.UpdateByID(ctx, cryptoKeyMetas[0]))
+
+	// Legacy keys are downloaded in the standard encoding
+	downloadedKeyBytes, err := keyServices.cryptoKeyDownloadService.DownloadByID(ctx, cryptoKeyMetas[0].ID)
+	require.NoError(t, err)
+	require.Equal(t, privateKeyBytes, downloadedKeyBytes)
+
+	adminCtx := auth.WithIdentity(context.Background(), &auth.Identity{UserID: uuid.New().String(), Admin: true})
+	rotation, err := keyServices.masterKeyRotationService.Rotate(adminCtx)
+	require.NoError(t, err)
+
+	require.Eventually(t, func() bool {
+		fetchedRotation, err := keyServices.masterKeyRotationService.GetByID(adminCtx, rotation.ID)
+		return err == nil && fetchedRotation.Status == "completed"
+	}, 10*time.Second, 100*time.Millisecond)
+
+	// The stored key has been migrated while being re-wrapped
+	storedKeyBytes, err := keyServices.vaultConnector.Download(ctx, cryptoKeyMetas[0].ID, cryptoKeyMetas[0].KeyPairID, cryptoKeyMetas[0].Type)
+	require.NoError(t, err)
+	plainKeyBytes, err := keyServices.masterKeyProvider.Unwrap(storedKeyBytes, rotation.TargetKEKVersion, cryptoKeyMetas[0].ID, cryptoKeyMetas[0].KeyPairID, cryptoKeyMetas[0].Type)
+	require.NoError(t, err)
+	require.Equal(t, privateKeyBytes, plainKeyBytes)
+}
+
+// Test case for permitting a first master key version only while no stored key is wrapped with the master key
+func TestMasterKeyInitializable(t *testing.T) {
+	dbType := "sqlite"
+	keyServices := NewKeyServicesTest(t, dbType)
+	defer repository.TeardownTestDB(t, keyServices.dbContext, dbType)
+
+	ctx := auth.WithUserID(context.Background(), uuid.New().String())
+
+	initializable, err := MasterKeyInitializable(ctx, keyServices.dbContext.CryptoKeyRepo, keyServices.dbContext.KeyImportKeyRepo, keyServices.dbContext.BlobLinkSigningKeyRepo)
+	require.NoError(t, err)
+	require.True(t, initializable)
+
+	_, err = keyServices.cryptoKeyUploadService.Upload(ctx, "AES", 256, nil)
+	require.NoError(t, err)
+
+	initializable, err = MasterKeyInitializable(ctx, keyServices.dbContext.CryptoKeyRepo, keyServices.dbContext.KeyImportKeyRepo, keyServices.dbContext.BlobLinkSigningKeyRepo)
+	require.NoError(t, err)
+	require.False(t, initializable)
+}
+
+// Test case for downloading a symmetric key which is transparently unwrapped with the master key
+func TestCryptoKeyDownloadService_Download_Unwraps_Key(t *testing.T) {
+	dbType := "sqlite"
+	keyServices := NewKeyServicesTest(t, dbType)
+	defer repository.TeardownTestDB(t, keyServices.dbContext, dbType)
+
+	userID := uuid.New().String()
+	keyAlgorithm := "AES"
+	var keySize uint32 = 256
+	ctx := auth.WithUserID(context.Background(), userID)
+
+	cryptoKeyMetas, err := keyServices.cryptoKeyUploadService.Upload(ctx, keyAlgorithm, keySize, nil)
+	require.NoError(t, err)
+
+	keyBytes, err := keyServices.cryptoKeyDownloadService.DownloadByID(ctx, cryptoKeyMetas[0].ID)
+	require.NoError(t, err)
+	require.Len(t, keyBytes, 32)
+}
+
+// Test case for rotating the master key and re-wrapping stored keys
+func TestMasterKeyRotationService_Rotate_Rewraps_Keys(t *testing.T) {
+	dbType := "sqlite"
+	keyServices := NewKeyServicesTest(t, dbType)
+	defer repository.TeardownTestDB(t, keyServices.dbContext, dbType)
+
+	userID := uuid.New().String()
+	ctx := auth.WithUserID(context.Background(), userID)
+
+	cryptoKeyMetas, err := keyServices.cryptoKeyUploadService.Upload(ctx, "AES", 256, nil)
+	require.NoError(t, err)
+
+	keyBytes, err := keyServices.cryptoKeyDownloadService.DownloadByID(ctx, cryptoKeyMetas[0].ID)
+	require.NoError(t, err)
+
+	adminCtx := auth.WithIdentity(context.Background(), &auth.Identity{UserID: uuid.New().String(), Admin: true})
+	rotation, err := keyServices.masterKeyRotationService.Rotate(adminCtx)
+	require.NoError(t, err)
+	require.Equal(t, uint32(2), rotation.TargetKEKVersion)
+	require.Equal(t, "running", rotation.Status)
+
+	require.Eventually(t, func() bool {
+		fetchedRotation, err := keyServices.masterKeyRotationService.GetByID(adminCtx, rotation.ID)
+		return err == nil && fetchedRotation.Status == "completed"
+	}, 10*time.Second, 100*time.Millisecond)
+
+	fetchedCryptoKeyMeta, err := keyServices.cryptoKeyMetadataService.GetByID(ctx, cryptoKeyMetas[0].ID)
+	require.NoError(t, err)
+	require.Equal(t, uint32(2), fetchedCryptoKeyMeta.KEKVersion)
+
+	rewrappedKeyBytes, err := keyServices.cryptoKeyDownloadService.DownloadByID(ctx, cryptoKeyMetas[0].ID)
+	require.NoError(t, err)
+	require.Equal(t, keyBytes, rewrappedKeyBytes)
+}
+
+// Test case for re-wrapping the key import key when rotating the master key
+func TestMasterKeyRotationService_Rotate_Rewraps_Import_Key(t *testing.T) {
+	dbType := "sqlite"
+	keyServices := NewKeyServicesTest(t, dbType)
+	defer repository.TeardownTestDB(t, keyServices.dbContext, dbType)
+
+	ctx := auth.WithUserID(context.Background(), uuid.New().String())
+
+	publicKeyPEM, err := keyServices.cryptoKeyImportService.GetImportPublicKey(ctx)
+	require.NoError(t, err)
+
+	adminCtx := auth.WithIdentity(context.Background(), &auth.Identity{UserID: uuid.New().String(), Admin: true})
+	rotation, err := keyServices.masterKeyRotationService.Rotate(adminCtx)
+	require.NoError(t, err)
+
+	require.Eventually(t, func() bool {
+		fetchedRotation, err := keyServices.masterKeyRotationService.GetByID(adminCtx, rotation.ID)
+		return err == nil && fetchedRotation.Status == "completed"
+	}, 10*time.Second, 100*time.Millisecond)
+
+	importKeyMeta, err := keyServices.dbContext.KeyImportKeyRepo.GetFirst(context.Background())
+	require.NoError(t, err)
+	require.Equal(t, rotation.TargetKEKVersion, importKeyMeta.KEKVersion)
+
+	// A new instance loads the re-wrapped import key from the vault instead of generating another one
+	cryptoKeyImportService, err := NewCryptoKeyImportService(keyServices.vaultConnector, keyServices.dbContext.CryptoKeyRepo, keyServices.dbContext.LogicalKeyRepo, keyServices.dbContext.KeyImportKeyRepo, keyServices.masterKeyProvider, keyServices.permissionManagement, keyServices.logger)
+	require.NoError(t, err)
+
+	rewrappedPublicKeyPEM, err := cryptoKeyImportService.GetImportPublicKey(ctx)
+	require.NoError(t, err)
+	require.Equal(t, publicKeyPEM, rewrappedPublicKeyPEM)
+}
+
+// Test case for re-wrapping the link signing key when rotating the master key
+func TestMasterKeyRotationService_Rotate_Rewraps_Link_Signing_Key(t *testing.T) {
+	dbType := "sqlite"
+	keyServices := NewKeyServicesTest(t, dbType)
+	defer repository.TeardownTestDB(t, keyServices.dbContext, dbType)
+
+	newBlobLinkService := func() *blobLinkService {
+		linkService, err := NewBlobLinkService(keyServices.dbContext.BlobRepo, keyServices.dbContext.BlobLinkSigningKeyRepo, keyServices.dbContext.BlobLinkRevocationRepo, keyServices.vaultConnector, keyServices.masterKeyProvider, keyServices.permissionManagement, keyServices.logger)
+		require.NoError(t, err)
+		return linkService.(*blobLinkService)
+	}
+
+	signingKey, err := newBlobLinkService().getSigningKey(context.Background())
+	require.NoError(t, err)
+
+	adminCtx := auth.WithIdentity(context.Background(), &auth.Identity{UserID: uuid.New().String(), Admin: true})
+	rotation, err := keyServices.masterKeyRotationService.Rotate(adminCtx)
+	require.NoError(t, err)
+
+	require.Eventually(t, func() bool {
+		fetchedRotation, err := keyServices.masterKeyRotationService.GetByID(adminCtx, rotation.ID)
+		return err == nil && fetchedRotation.Status == "completed"
+	}, 10*time.Second, 100*time.Millisecond)
+
+	signingKeyMeta, err := keyServices.dbContext.BlobLinkSigningKeyRepo.GetFirst(context.Background())
+	require.NoError(t, err)
+	require.Equal(t, rotation.TargetKEKVersion, signingKeyMeta.KEKVersion)
+
+	// A new instance loads the re-wrapped signing key from the vault, so links issued before the rotation stay valid
+	rewrappedSigningKey, err := newBlobLinkService().getSigningKey(context.Background())
+	require.NoError(t, err)
+	require.Equal(t, signingKey, rewrappedSigningKey)
+}
+
+// downloadHookVaultConnector runs onDownload before the first download of a key, standing in for updates racing a master key rotation
+type downloadHookVaultConnector struct {
+	connector.VaultConnector
+	onDownload func(keyID string)
+	replaced   []string
+}
+
+func (vc *downloadHookVaultConnector) Download(ctx context.Context, keyID, keyPairID, keyType string) ([]byte, error) {
+	if vc.onDownload != nil {
+		onDownload := vc.onDownload
+		vc.onDownload = nil
+		onDownload(keyID)
+	}
+	return vc.VaultConnector.Download(ctx, keyID, keyPairID, keyType)
+}
+
+func (vc *downloadHookVaultConnector) Replace(ctx context.Context, bytes []byte, keyID, keyPairID, keyType string) error {
+	vc.replaced = append(vc.replaced, keyID)
+	return vc.VaultConnector.Replace(ctx, bytes, keyID, keyPairID, keyType)
+}
+
+// Test case for keeping updates made to keys while a master key rotation re-wraps them and skipping keys destroyed meanwhile
+func TestMasterKeyRotationService_Rotate_Concurrent_Updates(t *testing.T) {
+	dbType := "sqlite"
+	keyServices := NewKeyServicesTest(t, dbType)
+	defer repository.TeardownTestDB(t, keyServices.dbContext, dbType)
+
+	ctx := auth.WithUserID(context.Background(), uuid.New().String())
+	updatedKeyMetas, err := keyServices.cryptoKeyUploadService.Upload(ctx, "AES", 256, nil)
+	require.NoError(t, err)
+	destroyedKeyMetas, err := keyServices.cryptoKeyUploadService.Upload(ctx, "AES", 256, nil)
+	require.NoError(t, err)
+
+	// While the first key is re-wrapped its export policy is updated and the second key, already listed, is destroyed
+	vaultConnector := &downloadHookVaultConnector{VaultConnector: keyServices.vaultConnector}
+	vaultConnector.onDownload = func(keyID string) {
+		updatedKeyMeta, err := keyServices.dbContext.CryptoKeyRepo.GetByID(ctx, updatedKeyMetas[0].ID)
+		require.NoError(t, err)
+		updatedKeyMeta.PlaintextExportForbidden = true
+		require.NoError(t, keyServices.dbContext.CryptoKeyRepo.UpdateByID(ctx, updatedKeyMeta))
+
+		destroyedKeyMeta := destroyedKeyMetas[0]
+		require.NoError(t, keyServices.vaultConnector.Delete(ctx, destroyedKeyMeta.ID, destroyedKeyMeta.KeyPairID, destroyedKeyMeta.Type))
+		destroyedKeyMeta.State = keys.KeyStateDestroyed
+		require.NoError(t, keyServices.dbContext.CryptoKeyRepo.UpdateByID(ctx, destroyedKeyMeta))
+	}
+
+	masterKeyRotationService, err := NewMasterKeyRotationService(vaultConnector, keyServices.dbContext.CryptoKeyRepo, keyServices.dbContext.KeyImportKeyRepo, keyServices.dbContext.BlobLinkSigningKeyRepo, keyServices.dbContext.MasterKeyRotationRepo, keyServices.masterKeyProvider, keyServices.logger)
+	require.NoError(t, err)
+
+	adminCtx := auth.WithIdentity(context.Background(), &auth.Identity{UserID: uuid.New().String(), Admin: true})
+	rotation, err := masterKeyRotationService.Rotate(adminCtx)
+	require.NoError(t, err)
+
+	require.Eventually(t, func() bool {
+		fetchedRotation, err := masterKeyRotationService.GetByID(adminCtx, rotation.ID)
+		return err == nil && fetchedRotation.Status == "completed"
+	}, 10*time.Second, 100*time.Millisecond)
+
+	updatedKeyMeta, err := keyServices.dbContext.CryptoKeyRepo.GetByID(ctx, updatedKeyMetas[0].ID)
+	require.NoError(t, err)
+	require.Equal(t, rotation.TargetKEKVersion, updatedKeyMeta.KEKVersion)
+	require.True(t, updatedKeyMeta.PlaintextExportForbidden)
+
+	destroyedKeyMeta, err := keyServices.dbContext.CryptoKeyRepo.GetByID(ctx, destroyedKeyMetas[0].ID)
+	require.NoError(t, err)
+	require.Equal(t, keys.KeyStateDestroyed, destroyedKeyMeta.State)
+	require.Equal(t, []string{updatedKeyMetas[0].ID}, vaultConnector.replaced)
+}
+
+// failingProgressRotationRepository fails recording the progress of running rotations while fail is set
+type failingProgressRotationRepository struct {
+	keys.MasterKeyRotationRepository
+	fail atomic.Bool
+}
+
+func (r *failingProgressRotationRepository) UpdateByID(ctx context.Context, rotation *keys.MasterKeyRotation) error {
+	if r.fail.Load() && rotation.Status == "running" && rotation.Offset > 0 {
+		return fmt.Errorf("database unavailable")
+	}
+	return r.MasterKeyRotationRepository.UpdateByID(ctx, rotation)
+}
+
+// Test case for marking a rotation failed once its progress cannot be recorded and resuming it with the keys it left
+func TestMasterKeyRotationService_Rotate_Resumes_Failed(t *testing.T) {
+	dbType := "sqlite"
+	keyServices := NewKeyServicesTest(t, dbType)
+	defer repository.TeardownTestDB(t, keyServices.dbContext, dbType)
+
+	rotationRepo := &failingProgressRotationRepository{MasterKeyRotationRepository: keyServices.dbContext.MasterKeyRotationRepo}
+	rotationRepo.fail.Store(true)
+	masterKeyRotationService, err := NewMasterKeyRotationService(keyServices.vaultConnector, keyServices.dbContext.CryptoKeyRepo, keyServices.dbContext.KeyImportKeyRepo, keyServices.dbContext.BlobLinkSigningKeyRepo, rotationRepo, keyServices.masterKeyProvider, keyServices.logger)
+	require.NoError(t, err)
+
+	ctx := auth.WithUserID(context.Background(), uuid.New().String())
+	cryptoKeyMetas, err := keyServices.cryptoKeyUploadService.Upload(ctx, "AES", 256, nil)
+	require.NoError(t, err)
+
+	adminCtx := auth.WithIdentity(context.Background(), &auth.Identity{UserID: uuid.New().String(), Admin: true})
+	rotation, err := masterKeyRotationService.Rotate(adminCtx)
+	require.NoError(t, err)
+
+	require.Eventually(t, func() bool {
+		fetchedRotation, err := masterKeyRotationService.GetByID(adminCtx, rotation.ID)
+		return err == nil && fetchedRotation.Status == "failed"
+	}, 10*time.Second, 100*time.Millisecond)
+
+	// Failed rotations are resumed instead of introducing another master key version
+	rotationRepo.fail.Store(false)
+	resumedRotation, err := masterKeyRotationService.Rotate(adminCtx)
+	require.NoError(t, err)
+	require.Equal(t, rotation.ID, resumedRotation.ID)
+	require.Equal(t, rotation.TargetKEKVersion, resumedRotation.TargetKEKVersion)
+	require.Equal(t, "running", resumedRotation.Status)
+	require.Empty(t, resumedRotation.Error)
+
+	require.Eventually(t, func() bool {
+		fetchedRotation, err := masterKeyRotationService.GetByID(adminCtx, rotation.ID)
+		return err == nil && fetchedRotation.Status == "completed"
+	}, 10*time.Second, 100*time.Millisecond)
+
+	fetchedCryptoKeyMeta, err := keyServices.cryptoKeyMetadataService.GetByID(ctx, cryptoKeyMetas[0].ID)
+	require.NoError(t, err)
+	require.Equal(t, rotation.TargetKEKVersion, fetchedCryptoKeyMeta.KEKVersion)
+
+	// Completed rotations are not resumed
+	require.NoError(t, masterKeyRotationService.Resume(context.Background()))
+	nextRotation, err := masterKeyRotationService.Rotate(adminCtx)
+	require.NoError(t, err)
+	require.NotEqual(t, rotation.ID, nextRotation.ID)
+	require.Equal(t, rotation.TargetKEKVersion+1, nextRotation.TargetKEKVersion)
+}
+
+// Test case for denying other users access to a key while admins may access it
+func TestCryptoKeyServices_Ownership(t *testing.T) {
+	dbType := "sqlite"
+	keyServices := NewKeyServicesTest(t, dbType)
+	defer repository.TeardownTestDB(t, keyServices.dbContext, dbType)
+
+	ownerCtx := auth.WithUserID(context.Background(), uuid.New().String())
+	otherCtx := auth.WithUserID(context.Background(), uuid.New().String())
+	adminCtx := auth.WithIdentity(context.Background(), &auth.Identity{UserID: uuid.New().String(), Admin: true})
+
+	cryptoKeyMetas, err := keyServices.cryptoKeyUploadService.Upload(ownerCtx, "AES", 256, nil)
+	require.NoError(t, err)
+	keyID := cryptoKeyMetas[0].ID
+
+	_, err = keyServices.cryptoKeyUploadService.Upload(otherCtx, "AES", 256, nil)
+	require.NoError(t, err)
+
+	// Users only list their own keys
+	ownerKeyMetas, err := keyServices.cryptoKeyMetadataService.List(ownerCtx, keys.NewCryptoKeyQuery())
+	require.NoError(t, err)
+	require.Len(t, ownerKeyMetas, 1)
+	require.Equal(t, keyID, ownerKeyMetas[0].ID)
+
+	// Other users neither get, download nor delete the key
+	_, err = keyServices.cryptoKeyMetadataService.GetByID(otherCtx, keyID)
+	require.ErrorIs(t, err, auth.ErrForbidden)
+
+	_, err = keyServices.cryptoKeyDownloadService.DownloadByID(otherCtx, keyID)
+	require.ErrorIs(t, err, auth.ErrForbidden)
+
+	_, err = keyServices.cryptoKeyMetadataService.DeleteByID(otherCtx, keyID, false)
+	require.ErrorIs(t, err, auth.ErrForbidden)
+
+	// Admins list, download and delete the keys of all users
+	adminKeyMetas, err := keyServices.cryptoKeyMetadataService.List(adminCtx, keys.NewCryptoKeyQuery())
+	require.NoError(t, err)
+	require.Len(t, adminKeyMetas, 2)
+
+	keyBytes, err := keyServices.cryptoKeyDownloadService.DownloadByID(adminCtx, keyID)
+	require.NoError(t, err)
+	require.Len(t, keyBytes, 32)
+
+	_, err = keyServices.cryptoKeyMetadataService.DeleteByID(adminCtx, keyID, false)
+	require.NoError(t, err)
+}
+
+// Test case for denying master key rotations to callers other than admins
+func TestMasterKeyRotationService_Rotate_Fail_Forbidden(t *testing.T) {
+	dbType := "sqlite"
+	keyServices := NewKeyServicesTest(t, dbType)
+	defer repository.TeardownTestDB(t, keyServices.dbContext, dbType)
+
+	ctx := auth.WithUserID(context.Background(), uuid.New().String())
+
+	_, err := keyServices.masterKeyRotationService.Rotate(ctx)
+	require.ErrorIs(t, err, auth.ErrForbidden)
+
+	_, err = keyServices.masterKeyRotationService.GetByID(ctx, uuid.New().String())
+	require.ErrorIs(t, err, auth.ErrForbidden)
+}
+
+// Test case for restoring the ownership tuples of keys stored before permissions were managed by relationship tuples
+func TestSyncOwnershipTuples_Success(t *testing.T) {
+	dbType := "sqlite"
+	keyServices := NewKeyServicesTest(t, dbType)
+	defer repository.TeardownTestDB(t, keyServices.dbContext, dbType)
+
+	ctx := auth.WithUserID(context.Background(), uuid.New().String())
+
+	cryptoKeyMetas, err := keyServices.cryptoKeyUploadService.Upload(ctx, "AES", 256, nil)
+	require.NoError(t, err)
+	keyID := cryptoKeyMetas[0].ID
+
+	tuples, err := keyServices.permissionManagement.ReadTuples(ctx, &permissions.RelationTupleQuery{Object: permissions.Key(keyID)})
+	require.NoError(t, err)
+	require.Len(t, tuples, 2)
+	require.NoError(t, keyServices.permissionManagement.DeleteTuples(ctx, tuples))
+
+	_, err = keyServices.cryptoKeyMetadataService.GetByID(ctx, keyID)
+	require.ErrorIs(t, err, auth.ErrForbidden)
+
+	err = SyncOwnershipTuples(context.Background(), keyServices.permissionManagement, keyServices.dbContext.BlobRepo, keyServices.dbContext.CryptoKeyRepo, keyServices.logger)
+	require.NoError(t, err)
+
+	_, err = keyServices.cryptoKeyMetadataService.GetByID(ctx, keyID)
+	require.NoError(t, err)
+
+	// Synchronizing again keeps the existing tuples
+	err = SyncOwnershipTuples(context.Background(), keyServices.permissionManagement, keyServices.dbContext.BlobRepo, keyServices.dbContext.CryptoKeyRepo, keyServices.logger)
+	require.NoError(t, err)
+}
+
+// keyOfType returns the ID of the key of the given type among the uploaded keys
+func keyOfType(t *testing.T, cryptoKeyMetas []*keys.CryptoKeyMeta, keyType string) string {
+	for _, cryptoKeyMeta := range cryptoKeyMetas {
+		if cryptoKeyMeta.Type == keyType {
+			return cryptoKeyMeta.ID
+		}
+	}
+	require.FailNow(t, "no key of type "+keyType)
+	return ""
+}
+
+// Test case for encrypting, decrypting, wrapping and unwrapping payloads with AES and RSA keys
+func TestCryptoOperationService_Encrypt_Decrypt_Wrap_Unwrap_Success(t *testing.T) {
+	dbType := "sqlite"
+	keyServices := NewKeyServicesTest(t, dbType)
+	defer repository.TeardownTestDB(t, keyServices.dbContext, dbType)
+
+	ctx := auth.WithUserID(context.Background(), uuid.New().String())
+
+	aesKeyMetas, err := keyServices.cryptoKeyUploadService.Upload(ctx, "AES", 256, nil)
+	require.NoError(t, err)
+	rsaKeyMetas, err := keyServices.cryptoKeyUploadService.Upload(ctx, "RSA", 2048, nil)
+	require.NoError(t, err)
+
+	// RSA key pairs are addressed by either key, the public key encrypts and the private key decrypts
+	tests := []struct {
+		name         string
+		encryptKeyID string
+		decryptKeyID string
+	}{
+		{name: "AES", encryptKeyID: aesKeyMetas[0].ID, decryptKeyID: aesKeyMetas[0].ID},
+		{name: "RSA", encryptKeyID: keyOfType(t, rsaKeyMetas, "private"), decryptKeyID: keyOfType(t, rsaKeyMetas, "public")},
+	}
+
+	plaintext := []byte("transit payload")
+	associatedData := []byte("context")
+	dataKey := []byte("0123456789abcdef0123456789abcdef")
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			encrypted, err := keyServices.cryptoOperationService.Encrypt(ctx, tt.encryptKeyID, plaintext, associatedData)
+			require.NoError(t, err)
+			require.Equal(t, crypto.OperationEncrypt, encrypted.Operation)
+			require.Equal(t, tt.encryptKeyID, encrypted.KeyID)
+			require.Equal(t, tt.name, encrypted.Algorithm)
+			require.True(t, cryptography.IsEnvelope(encrypted.Ciphertext))
+
+			decrypted, err := keyServices.cryptoOperationService.Decrypt(ctx, tt.decryptKeyID, encrypted.Ciphertext, associatedData)
+			require.NoError(t, err)
+			require.Equal(t, plaintext, decrypted.Plaintext)
+
+			// The associated data must match
+			_, err = keyServices.cryptoOperationService.Decrypt(ctx, tt.decryptKeyID, encrypted.Ciphertext, []byte("other"))
+			require.Error(t, err)
+
+			wrapped, err := keyServices.cryptoOperationService.Wrap(ctx, tt.encryptKeyID, dataKey)
+			require.NoError(t, err)
+			require.NotEqual(t, dataKey, wrapped.WrappedKey)
+
+			unwrapped, err := keyServices.cryptoOperationService.Unwrap(ctx, tt.decryptKeyID, wrapped.WrappedKey)
+			require.NoError(t, err)
+			require.Equal(t, dataKey, unwrapped.Key)
+		})
+	}
+
+	// Ciphertexts are only decrypted with the key pair recorded in their envelope
+	otherKeyMetas, err := keyServices.cryptoKeyUploadService.Upload(ctx, "AES", 256, nil)
+	require.NoError(t, err)
+	encrypted, err := keyServices.cryptoOperationService.Encrypt(ctx, aesKeyMetas[0].ID, plaintext, nil)
+	require.NoError(t, err)
+	_, err = keyServices.cryptoOperationService.Decrypt(ctx, otherKeyMetas[0].ID, encrypted.Ciphertext, nil)
+	require.Error(t, err)
+}
+
+// Test case for signing and verifying payloads with RSA and EC keys
+func TestCryptoOperationService_Sign_Verify_Success(t *testing.T) {
+	dbType := "sqlite"
+	keyServices := NewKeyServicesTest(t, dbType)
+	defer repository.TeardownTestDB(t, keyServices.dbContext, dbType)
+
+	ctx := auth.WithUserID(context.Background(), uuid.New().String())
+	data := []byte("signed payload")
+
+	for _, algorithm := range []struct {
+		name    string
+		keySize uint32
+	}{{name: "RSA", keySize: 2048}, {name: "EC", keySize: 256}} {
+		t.Run(algorithm.name, func(t *testing.T) {
+			cryptoKeyMetas, err := keyServices.cryptoKeyUploadService.Upload(ctx, algorithm.name, algorithm.keySize, nil)
+			require.NoError(t, err)
+
+			signed, err := keyServices.cryptoOperationService.Sign(ctx, keyOfType(t, cryptoKeyMetas, "public"), data)
+			require.NoError(t, err)
+			require.NotEmpty(t, signed.Signature)
+
+			verified, err := keyServices.cryptoOperationService.Verify(ctx, keyOfType(t, cryptoKeyMetas, "public"), data, signed.Signature)
+			require.NoError(t, err)
+			require.NotNil(t, verified.Valid)
+			require.True(t, *verified.Valid)
+
+			// Mismatching signatures are reported as invalid rather than as error
+			verified, err = keyServices.cryptoOperationService.Verify(ctx, keyOfType(t, cryptoKeyMetas, "private"), []byte("tampered payload"), signed.Signature)
+			require.NoError(t, err)
+			require.False(t, *verified.Valid)
+		})
+	}
+}
+
+// Test case for rejected operations
+func TestCryptoOperationService_Fail(t *testing.T) {
+	dbType := "sqlite"
+	keyServices := NewKeyServicesTest(t, dbType)
+	defer repository.TeardownTestDB(t, keyServices.dbContext, dbType)
+
+	ownerCtx := auth.WithUserID(context.Background(), uuid.New().String())
+	otherCtx := auth.WithUserID(context.Background(), uuid.New().String())
+
+	aesKeyMetas, err := keyServices.cryptoKeyUploadService.Upload(ownerCtx, "AES", 256, nil)
+	require.NoError(t, err)
+	aesKeyID := aesKeyMetas[0].ID
+	ecKeyMetas, err := keyServices.cryptoKeyUploadService.Upload(ownerCtx, "EC", 256, nil)
+	require.NoError(t, err)
+
+	// Other users may not use the key
+	_, err = keyServices.cryptoOperationService.Encrypt(otherCtx, aesKeyID, []byte("payload"), nil)
+	require.ErrorIs(t, err, auth.ErrForbidden)
+
+	// AES keys do not sign and EC keys do not encrypt
+	_, err = keyServices.cryptoOperationService.Sign(ownerCtx, aesKeyID, []byte("payload"))
+	require.Error(t, err)
+	_, err = keyServices.cryptoOperationService.Encrypt(ownerCtx, ecKeyMetas[0].ID, []byte("payload"), nil)
+	require.Error(t, err)
+
+	// Payloads must neither be empty nor exceed the limit
+	_, err = keyServices.cryptoOperationService.Encrypt(ownerCtx, aesKeyID, nil, nil)
+	require.Error(t, err)
+	_, err = keyServices.cryptoOperationService.Encrypt(ownerCtx, aesKeyID, make([]byte, crypto.MaxPayloadSize+1), nil)
+	require.Error(t, err)
+
+	// Only envelopes produced by encrypt are decrypted
+	_, err = keyServices.cryptoOperationService.Decrypt(ownerCtx, aesKeyID, []byte("no envelope"), nil)
+	require.Error(t, err)
+}
+
+// Test case for rejecting caller-built envelopes in unauthenticated modes, which would act as padding oracles on the key
+func TestCryptoOperationService_Decrypt_Fail_Unauthenticated_Modes(t *testing.T) {
+	dbType := "sqlite"
+	keyServices := NewKeyServicesTest(t, dbType)
+	defer repository.TeardownTestDB(t, keyServices.dbContext, dbType)
+
+	ctx := auth.WithUserID(context.Background(), uuid.New().String())
+
+	aesKeyMetas, err := keyServices.cryptoKeyUploadService.Upload(ctx, "AES", 256, nil)
+	require.NoError(t, err)
+	aesKey, err := keyServices.cryptoKeyDownloadService.DownloadByID(ctx, aesKeyMetas[0].ID)
+	require.NoError(t, err)
+
+	aesProcessor, err := cryptography.NewAESProcessor(keyServices.logger)
+	require.NoError(t, err)
+	cbcCiphertext, err := aesProcessor.EncryptCBC([]byte("payload"), aesKey, aesKeyMetas[0].KeyPairID, aesKeyMetas[0].Version)
+	require.NoError(t, err)
+	require.True(t, cryptography.IsEnvelope(cbcCiphertext))
+
+	_, err = keyServices.cryptoOperationService.Decrypt(ctx, aesKeyMetas[0].ID, cbcCiphertext, nil)
+	require.Error(t, err)
+
+	rsaKeyMetas, err := keyServices.cryptoKeyUploadService.Upload(ctx, "RSA", 2048, nil)
+	require.NoError(t, err)
+	publicKeyBytes, err := keyServices.cryptoKeyDownloadService.DownloadByID(ctx, keyOfType(t, rsaKeyMetas, "public"))
+	require.NoError(t, err)
+	publicKey, err := parseRSAPublicKey(publicKeyBytes)
+	require.NoError(t, err)
+
+	pkcs1v15Ciphertext, err := rsa.EncryptPKCS1v15(rand.Reader, publicKey, []byte("payload"))
+	require.NoError(t, err)
+	envelope := &cryptography.Envelope{
+		Algorithm:  cryptography.EnvelopeAlgorithmRSA,
+		Mode:       cryptography.RSAModePKCS1v15,
+		KeyID:      rsaKeyMetas[0].KeyPairID,
+		KeyVersion: rsaKeyMetas[0].Version,
+		Ciphertext: pkcs1v15Ciphertext,
+	}
+	pkcs1v15Envelope, err := envelope.Marshal()
+	require.NoError(t, err)
+
+	_, err = keyServices.cryptoOperationService.Decrypt(ctx, keyOfType(t, rsaKeyMetas, "private"), pkcs1v15Envelope, nil)
+	require.Error(t, err)
+}
+
+// Test case for keys created pre-active, which are unusable until activated
+func TestCryptoKeyMetadataService_UpdateLifecycle_Activate_Success(t *testing.T) {
+	dbType := "sqlite"
+	keyServices := NewKeyServicesTest(t, dbType)
+	defer repository.TeardownTestDB(t, keyServices.dbContext, dbType)
+
+	ctx := auth.WithUserID(context.Background(), uuid.New().String())
+
+	activation := time.Now().Add(time.Hour)
+	expires := activation.Add(24 * time.Hour)
+	cryptoKeyMetas, err := keyServices.cryptoKeyUploadService.Upload(ctx, "AES", 256, &keys.CryptoKeyLifecycle{DateTimeActivation: &activation, DateTimeExpires: &expires})
+	require.NoError(t, err)
+	keyID := cryptoKeyMetas[0].ID
+	require.Equal(t, keys.KeyStatePreActive, cryptoKeyMetas[0].State)
+
+	_, err = keyServices.cryptoOperationService.Encrypt(ctx, keyID, []byte("payload"), nil)
+	require.ErrorIs(t, err, keys.ErrKeyState)
+
+	updatedKeyMetas, err := keyServices.cryptoKeyMetadataService.UpdateLifecycle(ctx, keyID, &keys.CryptoKeyLifecycle{State: keys.KeyStateActive})
+	require.NoError(t, err)
+	require.Len(t, updatedKeyMetas, 1)
+	require.Equal(t, keys.KeyStateActive, updatedKeyMetas[0].State)
+	require.False(t, updatedKeyMetas[0].DateTimeActivation.After(time.Now()))
+
+	keyMeta, err := keyServices.cryptoKeyMetadataService.GetByID(ctx, keyID)
+	require.NoError(t, err)
+	require.Equal(t, keys.KeyStateActive, keyMeta.State)
+	require.WithinDuration(t, expires, *keyMeta.DateTimeExpires, time.Second)
+
+	_, err = keyServices.cryptoOperationService.Encrypt(ctx, keyID, []byte("payload"), nil)
+	require.NoError(t, err)
+}
+
+// Test case for deactivating and destroying a key pair, which keeps processing data until its material is destroyed
+func TestCryptoKeyMetadataService_UpdateLifecycle_Deactivate_Destroy_Success(t *testing.T) {
+	dbType := "sqlite"
+	keyServices := NewKeyServicesTest(t, dbType)
+	defer repository.TeardownTestDB(t, keyServices.dbContext, dbType)
+
+	ctx := auth.WithUserID(context.Background(), uuid.New().String())
+
+	cryptoKeyMetas, err := keyServices.cryptoKeyUploadService.Upload(ctx, "RSA", 2048, nil)
+	require.NoError(t, err)
+	privateKeyID := keyOfType(t, cryptoKeyMetas, "private")
+	publicKeyID := keyOfType(t, cryptoKeyMetas, "public")
+
+	encrypted, err := keyServices.cryptoOperationService.Encrypt(ctx, publicKeyID, []byte("payload"), nil)
+	require.NoError(t, err)
+	signed, err := keyServices.cryptoOperationService.Sign(ctx, privateKeyID, []byte("payload"))
+	require.NoError(t, err)
+
+	// Suspended and deactivated keys protect no data but still process it
+	for _, state := range []string{keys.KeyStateSuspended, keys.KeyStateDeactivated} {
+		updatedKeyMetas, err := keyServices.cryptoKeyMetadataService.UpdateLifecycle(ctx, publicKeyID, &keys.CryptoKeyLifecycle{State: state})
+		require.NoError(t, err)
+		require.Len(t, updatedKeyMetas, 2)
+		for _, updatedKeyMeta := range updatedKeyMetas {
+			require.Equal(t, state, updatedKeyMeta.State)
+		}
+
+		_, err = keyServices.cryptoOperationService.Encrypt(ctx, publicKeyID, []byte("payload"), nil)
+		require.ErrorIs(t, err, keys.ErrKeyState)
+		_, err = keyServices.cryptoOperationService.Sign(ctx, privateKeyID, []byte("payload"))
+		require.ErrorIs(t, err, keys.ErrKeyState)
+
+		decrypted, err := keyServices.cryptoOperationService.Decrypt(ctx, privateKeyID, encrypted.Ciphertext, nil)
+		require.NoError(t, err)
+		require.Equal(t, []byte("payload"), decrypted.Plaintext)
+		verified, err := keyServices.cryptoOperationService.Verify(ctx, publicKeyID, []byte("payload"), signed.Signature)
+		require.NoError(t, err)
+		require.True(t, *verified.Valid)
+	}
+
+	// Destroying keys schedules their destruction once the grace period has passed
+	scheduledKeyMetas, err := keyServices.cryptoKeyMetadataService.UpdateLifecycle(ctx, privateKeyID, &keys.CryptoKeyLifecycle{State: keys.KeyStateDestroyed})
+	require.NoError(t, err)
+	require.Len(t, scheduledKeyMetas, 2)
+	for _, scheduledKeyMeta := range scheduledKeyMetas {
+		require.Equal(t, keys.KeyStateDeactivated, scheduledKeyMeta.State)
+		require.NotNil(t, scheduledKeyMeta.DateTimeDestruction)
+		require.WithinDuration(t, time.Now().Add(7*24*time.Hour), *scheduledKeyMeta.DateTimeDestruction, time.Minute)
+	}
+
+	decrypted, err := keyServices.cryptoOperationService.Decrypt(ctx, privateKeyID, encrypted.Ciphertext, nil)
+	require.NoError(t, err)
+	require.Equal(t, []byte("payload"), decrypted.Plaintext)
+
+	// Pass the grace period
+	err = keyServices.dbContext.DB.Model(&keys.CryptoKeyMeta{}).Where("key_pair_id = ?", scheduledKeyMetas[0].KeyPairID).Update("date_time_destruction", time.Now().Add(-time.Minute)).Error
+	require.NoError(t, err)
+	destroyedKeys, err := keyServices.cryptoKeyDestructionService.DestroyDue(context.Background())
+	require.NoError(t, err)
+	require.Equal(t, 2, destroyedKeys)
+
+	// Destroyed keys keep their metadata, but their material is gone
+	keyMeta, err := keyServices.cryptoKeyMetadataService.GetByID(ctx, privateKeyID)
+	require.NoError(t, err)
+	require.Equal(t, keys.KeyStateDestroyed, keyMeta.State)
+
+	_, err = keyServices.cryptoOperationService.Decrypt(ctx, privateKeyID, encrypted.Ciphertext, nil)
+	require.ErrorIs(t, err, keys.ErrKeyState)
+	_, err = keyServices.cryptoKeyDownloadService.DownloadByID(ctx, privateKeyID)
+	require.ErrorIs(t, err, keys.ErrKeyState)
+
+	// Destroyed keys cannot be scheduled for destruction again
+	_, err = keyServices.cryptoKeyMetadataService.DeleteByID(ctx, privateKeyID, false)
+	require.ErrorIs(t, err, keys.ErrKeyState)
+}
+
+// Test case for refusing to destroy keys via their lifecycle while blobs are still protected with them unless forced
+func TestCryptoKeyMetadataService_UpdateLifecycle_Destroy_Fail_InUse(t *testing.T) {
+	dbType := "sqlite"
+	keyServices := NewKeyServicesTest(t, dbType)
+	defer repository.TeardownTestDB(t, keyServices.dbContext, dbType)
+
+	userID := uuid.New().String()
+	ctx := auth.WithUserID(context.Background(), userID)
+
+	cryptoKeyMetas, err := keyServices.cryptoKeyUploadService.Upload(ctx, "AES", 256, nil)
+	require.NoError(t, err)
+	keyID := cryptoKeyMetas[0].ID
+
+	blob := &blobs.BlobMeta{
+		ID:              uuid.New().String(),
+		DateTimeCreated: time.Now(),
+		UserID:          userID,
+		Name:            "blob",
+		Size:            1024,
+		Type:            "text",
+		EncryptionKeyID: &keyID,
+	}
+	require.NoError(t, keyServices.dbContext.BlobRepo.Create(context.Background(), blob))
+
+	_, err = keyServices.cryptoKeyMetadataService.UpdateLifecycle(ctx, keyID, &keys.CryptoKeyLifecycle{State: keys.KeyStateDeactivated})
+	require.NoError(t, err)
+
+	_, err = keyServices.cryptoKeyMetadataService.UpdateLifecycle(ctx, keyID, &keys.CryptoKeyLifecycle{State: keys.KeyStateDestroyed})
+	require.ErrorIs(t, err, keys.ErrKeyInUse)
+
+	keyMeta, err := keyServices.cryptoKeyMetadataService.GetByID(ctx, keyID)
+	require.NoError(t, err)
+	require.Nil(t, keyMeta.DateTimeDestruction)
+
+	// The material is kept until the grace period has passed
+	scheduledKeyMetas, err := keyServices.cryptoKeyMetadataService.UpdateLifecycle(ctx, keyID, &keys.CryptoKeyLifecycle{State: keys.KeyStateDestroyed, Force: true})
+	require.NoError(t, err)
+	require.Len(t, scheduledKeyMetas, 1)
+	require.NotNil(t, scheduledKeyMetas[0].DateTimeDestruction)
+
+	_, err = keyServices.vaultConnector.Download(context.Background(), keyID, scheduledKeyMetas[0].KeyPairID, scheduledKeyMetas[0].Type)
+	require.NoError(t, err)
+}
+
+// Test case for rejected lifecycle changes
+func TestCryptoKeyMetadataService_UpdateLifecycle_Fail(t *testing.T) {
+	dbType := "sqlite"
+	keyServices := NewKeyServicesTest(t, dbType)
+	defer repository.TeardownTestDB(t, keyServices.dbContext, dbType)
+
+	ownerCtx := auth.WithUserID(context.Background(), uuid.New().String())
+	otherCtx := auth.WithUserID(context.Background(), uuid.New().String())
+
+	past := time.Now().Add(-time.Hour)
+
+	// Keys are created pre-active or active only, and not already expired
+	_, err := keyServices.cryptoKeyUploadService.Upload(ownerCtx, "AES", 256, &keys.CryptoKeyLifecycle{State: keys.KeyStateSuspended})
+	require.Error(t, err)
+	_, err = keyServices.cryptoKeyUploadService.Upload(ownerCtx, "AES", 256, &keys.CryptoKeyLifecycle{DateTimeExpires: &past})
+	require.Error(t, err)
+
+	cryptoKeyMetas, err := keyServices.cryptoKeyUploadService.Upload(ownerCtx, "AES", 256, nil)
+	require.NoError(t, err)
+	keyID := cryptoKeyMetas[0].ID
+
+	// Other users may not manage the key
+	_, err = keyServices.cryptoKeyMetadataService.UpdateLifecycle(otherCtx, keyID, &keys.CryptoKeyLifecycle{State: keys.KeyStateSuspended})
+	require.ErrorIs(t, err, auth.ErrForbidden)
+
+	// Active keys must be deactivated before being destroyed
+	_, err = keyServices.cryptoKeyMetadataService.UpdateLifecycle(ownerCtx, keyID, &keys.CryptoKeyLifecycle{State: keys.KeyStateDestroyed})
+	require.ErrorIs(t, err, keys.ErrKeyState)
+
+	// Activation can only be rescheduled for pre-active keys, expiry only into the future
+	future := time.Now().Add(time.Hour)
+	_, err = keyServices.cryptoKeyMetadataService.UpdateLifecycle(ownerCtx, keyID, &keys.CryptoKeyLifecycle{DateTimeActivation: &future})
+	require.ErrorIs(t, err, keys.ErrKeyState)
+	_, err = keyServices.cryptoKeyMetadataService.UpdateLifecycle(ownerCtx, keyID, &keys.CryptoKeyLifecycle{DateTimeExpires: &past})
+	require.Error(t, err)
+
+	// Deactivated keys cannot be reactivated
+	_, err = keyServices.cryptoKeyMetadataService.UpdateLifecycle(ownerCtx, keyID, &keys.CryptoKeyLifecycle{State: keys.KeyStateDeactivated})
+	require.NoError(t, err)
+	_, err = keyServices.cryptoKeyMetadataService.UpdateLifecycle(ownerCtx, keyID, &keys.CryptoKeyLifecycle{State: keys.KeyStateActive})
+	require.ErrorIs(t, err, keys.ErrKeyState)
+
+	keyMeta, err := keyServices.cryptoKeyMetadataService.GetByID(ownerCtx, keyID)
+	require.NoError(t, err)
+	require.Equal(t, keys.KeyStateDeactivated, keyMeta.State)
+}
+
+// Test case for rotating a logical key, after which new data is protected with the new version while data protected with older versions remains readable
+func TestCryptoKeyRotationService_Rotate_Success(t *testing.T) {
+	dbType := "sqlite"
+	keyServices := NewKeyServicesTest(t, dbType)
+	defer repository.TeardownTestDB(t, keyServices.dbContext, dbType)
+
+	ctx := auth.WithUserID(context.Background(), uuid.New().String())
+	plaintext := []byte("transit payload")
+	dataKey := []byte("0123456789abcdef0123456789abcdef")
+
+	aesKeyMetas, err := keyServices.cryptoKeyUploadService.Upload(ctx, "AES", 256, nil)
+	require.NoError(t, err)
+	require.Equal(t, aesKeyMetas[0].KeyPairID, aesKeyMetas[0].LogicalKeyID)
+	require.Equal(t, uint32(1), aesKeyMetas[0].Version)
+	keyID := aesKeyMetas[0].ID
+
+	encryptedV1, err := keyServices.cryptoOperationService.Encrypt(ctx, keyID, plaintext, nil)
+	require.NoError(t, err)
+	require.Equal(t, uint32(1), encryptedV1.KeyVersion)
+	wrappedV1, err := keyServices.cryptoOperationService.Wrap(ctx, keyID, dataKey)
+	require.NoError(t, err)
+
+	rotatedKeyMetas, err := keyServices.cryptoKeyRotationService.Rotate(ctx, keyID)
+	require.NoError(t, err)
+	require.Len(t, rotatedKeyMetas, 1)
+	require.Equal(t, aesKeyMetas[0].LogicalKeyID, rotatedKeyMetas[0].LogicalKeyID)
+	require.Equal(t, uint32(2), rotatedKeyMetas[0].Version)
+	require.NotEqual(t, aesKeyMetas[0].KeyPairID, rotatedKeyMetas[0].KeyPairID)
+	require.Equal(t, keys.KeyStateActive, rotatedKeyMetas[0].State)
+
+	// Encrypting with the ID of version 1 uses the primary version 2
+	encryptedV2, err := keyServices.cryptoOperationService.Encrypt(ctx, keyID, plaintext, nil)
+	require.NoError(t, err)
+	require.Equal(t, uint32(2), encryptedV2.KeyVersion)
+	require.Equal(t, rotatedKeyMetas[0].KeyPairID, encryptedV2.KeyPairID)
+
+	// Ciphertexts of both versions are decrypted with the ID of either version
+	for _, decryptKeyID := range []string{keyID, rotatedKeyMetas[0].ID} {
+		for _, encrypted := range []*crypto.OperationResult{encryptedV1, encryptedV2} {
+			decrypted, err := keyServices.cryptoOperationService.Decrypt(ctx, decryptKeyID, encrypted.Ciphertext, nil)
+			require.NoError(t, err)
+			require.Equal(t, plaintext, decrypted.Plaintext)
+			require.Equal(t, encrypted.KeyVersion, decrypted.KeyVersion)
+		}
+	}
+
+	unwrapped, err := keyServices.cryptoOperationService.Unwrap(ctx, rotatedKeyMetas[0].ID, wrappedV1.WrappedKey)
+	require.NoError(t, err)
+	require.Equal(t, dataKey, unwrapped.Key)
+
+	versions, err := keyServices.cryptoKeyRotationService.ListVersions(ctx, keyID)
+	require.NoError(t, err)
+	require.Len(t, versions, 2)
+	require.Equal(t, uint32(2), versions[0].Version)
+	require.Equal(t, uint32(1), versions[1].Version)
+}
+
+// Test case for verifying signatures created with an older version of a rotated logical key
+func TestCryptoKeyRotationService_Rotate_Verify_Old_Signature(t *testing.T) {
+	dbType := "sqlite"
+	keyServices := NewKeyServicesTest(t, dbType)
+	defer repository.TeardownTestDB(t, keyServices.dbContext, dbType)
+
+	ctx := auth.WithUserID(context.Background(), uuid.New().String())
+	data := []byte("signed payload")
+
+	ecKeyMetas, err := keyServices.cryptoKeyUploadService.Upload(ctx, "EC", 256, nil)
+	require.NoError(t, err)
+	privateKeyID := keyOfType(t, ecKeyMetas, "private")
+
+	signedV1, err := keyServices.cryptoOperationService.Sign(ctx, privateKeyID, data)
+	require.NoError(t, err)
+
+	rotatedKeyMetas, err := keyServices.cryptoKeyRotationService.Rotate(ctx, privateKeyID)
+	require.NoError(t, err)
+	require.Len(t, rotatedKeyMetas, 2)
+
+	signedV2, err := keyServices.cryptoOperationService.Sign(ctx, privateKeyID, data)
+	require.NoError(t, err)
+	require.Equal(t, uint32(2), signedV2.KeyVersion)
+
+	for _, signed := range []*crypto.OperationResult{signedV1, signedV2} {
+		verified, err := keyServices.cryptoOperationService.Verify(ctx, keyOfType(t, rotatedKeyMetas, "public"), data, signed.Signature)
+		require.NoError(t, err)
+		require.True(t, *verified.Valid)
+	}
+}
+
+// Test case for rotating logical keys automatically once their rotation policy is due
+func TestCryptoKeyRotationService_RotateDue_Success(t *testing.T) {
+	dbType := "sqlite"
+	keyServices := NewKeyServicesTest(t, dbType)
+	defer repository.TeardownTestDB(t, keyServices.dbContext, dbType)
+
+	ctx := auth.WithUserID(context.Background(), uuid.New().String())
+
+	aesKeyMetas, err := keyServices.cryptoKeyUploadService.Upload(ctx, "AES", 256, nil)
+	require.NoError(t, err)
+
+	// Rotation periods below a day are rejected
+	_, err = keyServices.cryptoKeyRotationService.UpdateRotationPolicy(ctx, aesKeyMetas[0].ID, time.Hour)
+	require.Error(t, err)
+
+	logicalKey, err := keyServices.cryptoKeyRotationService.UpdateRotationPolicy(ctx, aesKeyMetas[0].ID, 30*24*time.Hour)
+	require.NoError(t, err)
+	require.NotNil(t, logicalKey.DateTimeNextRotation)
+
+	rotatedKeys, err := keyServices.cryptoKeyRotationService.RotateDue(ctx)
+	require.NoError(t, err)
+	require.Equal(t, 0, rotatedKeys)
+
+	// Move the next rotation into the past
+	past := time.Now().Add(-time.Minute)
+	logicalKey.DateTimeNextRotation = &past
+	require.NoError(t, keyServices.dbContext.LogicalKeyRepo.UpdateByID(ctx, logicalKey))
+
+	rotatedKeys, err = keyServices.cryptoKeyRotationService.RotateDue(ctx)
+	require.NoError(t, err)
+	require.Equal(t, 1, rotatedKeys)
+
+	rotatedLogicalKey, err := keyServices.dbContext.LogicalKeyRepo.GetByID(ctx, logicalKey.ID)
+	require.NoError(t, err)
+	require.Equal(t, uint32(2), rotatedLogicalKey.PrimaryVersion)
+	require.True(t, rotatedLogicalKey.DateTimeNextRotation.After(time.Now()))
+
+	// Disabling the policy stops automatic rotation
+	logicalKey, err = keyServices.cryptoKeyRotationService.UpdateRotationPolicy(ctx, aesKeyMetas[0].ID, 0)
+	require.NoError(t, err)
+	require.Nil(t, logicalKey.DateTimeNextRotation)
+}
+
+// Test case for rotating keys predating versioning, which become version 1 of a new logical key
+func TestCryptoKeyRotationService_Rotate_Legacy_Key(t *testing.T) {
+	dbType := "sqlite"
+	keyServices := NewKeyServicesTest(t, dbType)
+	defer repository.TeardownTestDB(t, keyServices.dbContext, dbType)
+
+	ctx := auth.WithUserID(context.Background(), uuid.New().String())
+	plaintext := []byte("transit payload")
+
+	rsaKeyMetas, err := keyServices.cryptoKeyUploadService.Upload(ctx, "RSA", 2048, nil)
+	require.NoError(t, err)
+	publicKeyID := keyOfType(t, rsaKeyMetas, "public")
+
+	encrypted, err := keyServices.cryptoOperationService.Encrypt(ctx, publicKeyID, plaintext, nil)
+	require.NoError(t, err)
+
+	// Drop the versioning of the key pair as if it had been created before logical keys existed
+	require.NoError(t, keyServices.dbContext.DB.Delete(&keys.LogicalKey{}, "id = ?", rsaKeyMetas[0].LogicalKeyID).Error)
+	for _, cryptoKeyMeta := range rsaKeyMetas {
+		cryptoKeyMeta.LogicalKeyID = ""
+		cryptoKeyMeta.Version = 0
+		require.NoError(t, keyServices.dbContext.CryptoKeyRepo.UpdateByID(ctx, cryptoKeyMeta))
+	}
+
+	rotatedKeyMetas, err := keyServices.cryptoKeyRotationService.Rotate(ctx, publicKeyID)
+	require.NoError(t, err)
+	require.Len(t, rotatedKeyMetas, 2)
+	require.Equal(t, rsaKeyMetas[0].KeyPairID, rotatedKeyMetas[0].LogicalKeyID)
+	require.Equal(t, uint32(2), rotatedKeyMetas[0].Version)
+
+	versions, err := keyServices.cryptoKeyRotationService.ListVersions(ctx, publicKeyID)
+	require.NoError(t, err)
+	require.Len(t, versions, 4)
+
+	decrypted, err := keyServices.cryptoOperationService.Decrypt(ctx, keyOfType(t, rotatedKeyMetas, "private"), encrypted.Ciphertext, nil)
+	require.NoError(t, err)
+	require.Equal(t, plaintext, decrypted.Plaintext)
+}
+
+// Test case for rotations racing each other, which must neither generate a version twice nor leave key pairs behind
+func TestCryptoKeyRotationService_Rotate_Concurrent(t *testing.T) {
+	dbType := "sqlite"
+	keyServices := NewKeyServicesTest(t, dbType)
+	defer repository.TeardownTestDB(t, keyServices.dbContext, dbType)
+
+	ctx := auth.WithUserID(context.Background(), uuid.New().String())
+	rotationService := keyServices.cryptoKeyRotationService.(*cryptoKeyRotationService)
+
+	aesKeyMetas, err := keyServices.cryptoKeyUploadService.Upload(ctx, "AES", 256, nil)
+	require.NoError(t, err)
+	staleLogicalKey, err := keyServices.dbContext.LogicalKeyRepo.GetByID(ctx, aesKeyMetas[0].LogicalKeyID)
+	require.NoError(t, err)
+
+	rotatedKeyMetas, err := keyServices.cryptoKeyRotationService.Rotate(ctx, aesKeyMetas[0].ID)
+	require.NoError(t, err)
+	require.Equal(t, uint32(2), rotatedKeyMetas[0].Version)
+
+	// A rotation reading the logical key before the other one finished loses the version without generating keys
+	_, err = rotationService.rotate(ctx, staleLogicalKey, time.Now())
+	require.ErrorIs(t, err, keys.ErrConcurrentRotation)
+
+	versions, err := keyServices.cryptoKeyRotationService.ListVersions(ctx, aesKeyMetas[0].ID)
+	require.NoError(t, err)
+	require.Len(t, versions, 2)
+
+	logicalKey, err := keyServices.dbContext.LogicalKeyRepo.GetByID(ctx, staleLogicalKey.ID)
+	require.NoError(t, err)
+	require.Equal(t, uint32(2), logicalKey.LatestVersion)
+	require.Equal(t, rotatedKeyMetas[0].KeyPairID, logicalKey.PrimaryKeyPairID)
+
+	// Keys predating versioning whose logical key was created by a concurrent rotation are assigned to it
+	legacyKeyMetas, err := keyServices.cryptoKeyUploadService.Upload(ctx, "AES", 256, nil)
+	require.NoError(t, err)
+	legacyKeyMetas[0].LogicalKeyID = ""
+	legacyKeyMetas[0].Version = 0
+	require.NoError(t, keyServices.dbContext.CryptoKeyRepo.UpdateByID(ctx, legacyKeyMetas[0]))
+
+	rotatedKeyMetas, err = keyServices.cryptoKeyRotationService.Rotate(ctx, legacyKeyMetas[0].ID)
+	require.NoError(t, err)
+	require.Equal(t, legacyKeyMetas[0].KeyPairID, rotatedKeyMetas[0].LogicalKeyID)
+	require.Equal(t, uint32(2), rotatedKeyMetas[0].Version)
+
+	versions, err = keyServices.cryptoKeyRotationService.ListVersions(ctx, legacyKeyMetas[0].ID)
+	require.NoError(t, err)
+	require.Len(t, versions, 2)
+}
+
+// Test case for rotations by users who may not manage the key
+func TestCryptoKeyRotationService_Rotate_Fail_Forbidden(t *testing.T) {
+	dbType := "sqlite"
+	keyServices := NewKeyServicesTest(t, dbType)
+	defer repository.TeardownTestDB(t, keyServices.dbContext, dbType)
+
+	ownerCtx := auth.WithUserID(context.Background(), uuid.New().String())
+	otherCtx := auth.WithUserID(context.Background(), uuid.New().String())
+
+	aesKeyMetas, err := keyServices.cryptoKeyUploadService.Upload(ownerCtx, "AES", 256, nil)
+	require.NoError(t, err)
+
+	_, err = keyServices.cryptoKeyRotationService.Rotate(otherCtx, aesKeyMetas[0].ID)
+	require.ErrorIs(t, err, auth.ErrForbidden)
+	_, err = keyServices.cryptoKeyRotationService.ListVersions(otherCtx, aesKeyMetas[0].ID)
+	require.ErrorIs(t, err, auth.ErrForbidden)
+	_, err = keyServices.cryptoKeyRotationService.UpdateRotationPolicy(otherCtx, aesKeyMetas[0].ID, 30*24*time.Hour)
+	require.ErrorIs(t, err, auth.ErrForbidden)
+}
+
+// Test case for importing AES, RSA and EC key material and using the imported keys
+func TestCryptoKeyImportService_Import_Success(t *testing.T) {
+	dbType := "sqlite"
+	keyServices := NewKeyServicesTest(t, dbType)
+	defer repository.TeardownTestDB(t, keyServices.dbContext, dbType)
+
+	userID := uuid.New().String()
+	ctx := auth.WithUserID(context.Background(), userID)
+
+	aesKey := make([]byte, 32)
+	_, err := rand.Read(aesKey)
+	require.NoError(t, err)
+	rsaKey, err := rsa.GenerateKey(rand.Reader, 2048)
+	require.NoError(t, err)
+	ecKey, err := ecdsa.GenerateKey(elliptic.P256(), rand.Reader)
+	require.NoError(t, err)
+	ecPKCS8Key, err := x509.MarshalPKCS8PrivateKey(ecKey)
+	require.NoError(t, err)
+
+	// Data encrypted outside the service with the AES key is decrypted by the imported key and vice versa
+	aesKeyMetas, err := keyServices.cryptoKeyImportService.Import(ctx, &keys.CryptoKeyImport{Algorithm: "AES", Format: cryptography.KeyFormatRaw, KeyMaterial: aesKey}, nil)
+	require.NoError(t, err)
+	require.Len(t, aesKeyMetas, 1)
+	require.Equal(t, userID, aesKeyMetas[0].UserID)
+	require.Equal(t, uint32(256), aesKeyMetas[0].KeySize)
+	require.Equal(t, keys.KeyStateActive, aesKeyMetas[0].State)
+	require.Equal(t, aesKeyMetas[0].KeyPairID, aesKeyMetas[0].LogicalKeyID)
+	require.Equal(t, uint32(1), aesKeyMetas[0].Version)
+
+	downloadedKey, err := keyServices.cryptoKeyDownloadService.DownloadByID(ctx, aesKeyMetas[0].ID)
+	require.NoError(t, err)
+	require.Equal(t, aesKey, downloadedKey)
+
+	encrypted, err := keyServices.cryptoOperationService.Encrypt(ctx, aesKeyMetas[0].ID, []byte("payload"), nil)
+	require.NoError(t, err)
+	decrypted, err := keyServices.cryptoOperationService.Decrypt(ctx, aesKeyMetas[0].ID, encrypted.Ciphertext, nil)
+	require.NoError(t, err)
+	require.Equal(t, []byte("payload"), decrypted.Plaintext)
+
+	// Signatures of imported private keys verify with the public key kept outside the service
+	rsaKeyMetas, err := keyServices.cryptoKeyImportService.Import(ctx, &keys.CryptoKeyImport{
+		Algorithm:   "RSA",
+		Format:      cryptography.KeyFormatPEM,
+		KeyMaterial: pem.EncodeToMemory(&pem.Block{Type: "RSA PRIVATE KEY", Bytes: x509.MarshalPKCS1PrivateKey(rsaKey)}),
+	}, nil)
+	require.NoError(t, err)
+	require.Len(t, rsaKeyMetas, 2)
+
+	data := []byte("signed payload")
+	digest := sha256.Sum256(data)
+	signed, err := keyServices.cryptoOperationService.Sign(ctx, keyOfType(t, rsaKeyMetas, "private"), data)
+	require.NoError(t, err)
+	require.NoError(t, rsa.VerifyPKCS1v15(&rsaKey.PublicKey, cryptoHash.SHA256, digest[:], signed.Signature))
+
+	ecKeyMetas, err := keyServices.cryptoKeyImportService.Import(ctx, &keys.CryptoKeyImport{Algorithm: "EC", Format: cryptography.KeyFormatDER, KeyMaterial: ecPKCS8Key}, nil)
+	require.NoError(t, err)
+	require.Len(t, ecKeyMetas, 2)
+
+	signed, err = keyServices.cryptoOperationService.Sign(ctx, keyOfType(t, ecKeyMetas, "public"), data)
+	require.NoError(t, err)
+	r, sigS := new(big.Int).SetBytes(signed.Signature[:32]), new(big.Int).SetBytes(signed.Signature[32:])
+	require.True(t, ecdsa.Verify(&ecKey.PublicKey, digest[:], r, sigS))
+
+	// Public keys imported alone verify signatures created outside the service
+	ecPublicKey, err := x509.MarshalPKIXPublicKey(&ecKey.PublicKey)
+	require.NoError(t, err)
+	publicKeyMetas, err := keyServices.cryptoKeyImportService.Import(ctx, &keys.CryptoKeyImport{Algorithm: "EC", Format: cryptography.KeyFormatDER, KeyMaterial: ecPublicKey}, nil)
+	require.NoError(t, err)
+	require.Len(t, publicKeyMetas, 1)
+	require.Equal(t, "public", publicKeyMetas[0].Type)
+
+	// Signatures are encoded as r and s with a fixed size
+	r, sigS, err = ecdsa.Sign(rand.Reader, ecKey, digest[:])
+	require.NoError(t, err)
+	externalSignature := make([]byte, 64)
+	r.FillBytes(externalSignature[:32])
+	sigS.FillBytes(externalSignature[32:])
+	verified, err := keyServices.cryptoOperationService.Verify(ctx, publicKeyMetas[0].ID, data, externalSignature)
+	require.NoError(t, err)
+	require.True(t, *verified.Valid)
+
+	_, err = keyServices.cryptoOperationService.Sign(ctx, publicKeyMetas[0].ID, data)
+	require.Error(t, err)
+}
+
+// Test case for importing key material wrapped with the key import public key
+func TestCryptoKeyImportService_Import_Wrapped_Success(t *testing.T) {
+	dbType := "sqlite"
+	keyServices := NewKeyServicesTest(t, dbType)
+	defer repository.TeardownTestDB(t, keyServices.dbContext, dbType)
+
+	ctx := auth.WithUserID(context.Background(), uuid.New().String())
+
+	publicKeyPEM, err := keyServices.cryptoKeyImportService.GetImportPublicKey(ctx)
+	require.NoError(t, err)
+	block, _ := pem.Decode(publicKeyPEM)
+	require.NotNil(t, block)
+	publicKey, err := x509.ParsePKIXPublicKey(block.Bytes)
+	require.NoError(t, err)
+	importPublicKey, ok := publicKey.(*rsa.PublicKey)
+	require.True(t, ok)
+
+	// The import key is generated once and reused
+	samePublicKeyPEM, err := keyServices.cryptoKeyImportService.GetImportPublicKey(ctx)
+	require.NoError(t, err)
+	require.Equal(t, publicKeyPEM, samePublicKeyPEM)
+
+	aesKey := make([]byte, 16)
+	_, err = rand.Read(aesKey)
+	require.NoError(t, err)
+	wrappedKey, err := cryptography.RSAAESKeyWrap(importPublicKey, aesKey)
+	require.NoError(t, err)
+
+	cryptoKeyMetas, err := keyServices.cryptoKeyImportService.Import(ctx, &keys.CryptoKeyImport{Algorithm: "AES", Format: cryptography.KeyFormatRaw, KeyMaterial: wrappedKey, Wrapped: true}, nil)
+	require.NoError(t, err)
+	require.Len(t, cryptoKeyMetas, 1)
+	require.Equal(t, uint32(128), cryptoKeyMetas[0].KeySize)
+
+	downloadedKey, err := keyServices.cryptoKeyDownloadService.DownloadByID(ctx, cryptoKeyMetas[0].ID)
+	require.NoError(t, err)
+	require.Equal(t, aesKey, downloadedKey)
+
+	// Key material wrapped with another key is rejected
+	otherKey, err := rsa.GenerateKey(rand.Reader, 2048)
+	require.NoError(t, err)
+	wrappedKey, err = cryptography.RSAAESKeyWrap(&otherKey.PublicKey, aesKey)
+	require.NoError(t, err)
+	_, err = keyServices.cryptoKeyImportService.Import(ctx, &keys.CryptoKeyImport{Algorithm: "AES", Format: cryptography.KeyFormatRaw, KeyMaterial: wrappedKey, Wrapped: true}, nil)
+	require.Error(t, err)
+}
+
+// Test case for rejected key imports
+func TestCryptoKeyImportService_Import_Fail(t *testing.T) {
+	dbType := "sqlite"
+	keyServices := NewKeyServicesTest(t, dbType)
+	defer repository.TeardownTestDB(t, keyServices.dbContext, dbType)
+
+	ctx := auth.WithUserID(context.Background(), uuid.New().String())
+
+	rsaKey, err := rsa.GenerateKey(rand.Reader, 3072)
+	require.NoError(t, err)
+
+	tests := []struct {
+		name      string
+		ctx       context.Context
+		keyImport *keys.CryptoKeyImport
+	}{
+		{"Unauthenticated", context.Background(), &keys.CryptoKeyImport{Algorithm: "AES", Format: cryptography.KeyFormatRaw, KeyMaterial: make([]byte, 32)}},
+		{"Unsupported AES key size", ctx, &keys.CryptoKeyImport{Algorithm: "AES", Format: cryptography.KeyFormatRaw, KeyMaterial: make([]byte, 20)}},
+		{"Unsupported RSA key size", ctx, &keys.CryptoKeyImport{Algorithm: "RSA", Format: cryptography.KeyFormatDER, KeyMaterial: x509.MarshalPKCS1PrivateKey(rsaKey)}},
+		{"Key of other algorithm", ctx, &keys.CryptoKeyImport{Algorithm: "EC", Format: cryptography.KeyFormatDER, KeyMaterial: x509.MarshalPKCS1PrivateKey(rsaKey)}},
+		{"Invalid format", ctx, &keys.CryptoKeyImport{Algorithm: "RSA", Format: "pkcs12", KeyMaterial: []byte("key")}},
+		{"Garbage wrapped key material", ctx, &keys.CryptoKeyImport{Algorithm: "AES", Format: cryptography.KeyFormatRaw, KeyMaterial: make([]byte, 32), Wrapped: true}},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			_, err := keyServices.cryptoKeyImportService.Import(tt.ctx, tt.keyImport, nil)
+			require.Error(t, err)
+		})
+	}
+
+	_, err = keyServices.cryptoKeyImportService.Import(context.Background(), &keys.CryptoKeyImport{Algorithm: "AES", Format: cryptography.KeyFormatRaw, KeyMaterial: make([]byte, 32)}, nil)
+	require.ErrorIs(t, err, auth.ErrUnauthenticated)
+
+	keyMetas, err := keyServices.dbContext.CryptoKeyRepo.List(ctx, keys.NewCryptoKeyQuery())
+	require.NoError(t, err)
+	require.Empty(t, keyMetas)
+}
+
+// tokenVaultConnector stands in for a vault backed by a PKCS#11 token, generating key pairs in memory and storing other keys in the wrapped vault
+type tokenVaultConnector struct {
+	connector.VaultConnector
+	keyPairs map[string]cryptoHash.Signer // by key pair ID
+}
+
+func (vc *tokenVaultConnector) Generate(ctx context.Context, userID, keyPairID, keyAlgorithm string, keySize uint32) ([]*keys.CryptoKeyMeta, error) {
+	var signer cryptoHash.Signer
+	var err error
+	switch keyAlgorithm {
+	case "RSA":
+		signer, err = rsa.GenerateKey(rand.Reader, int(keySize))
+	case "EC":
+		signer, err = ecdsa.GenerateKey(elliptic.P256(), rand.Reader)
+	}
+	if err != nil {
+		return nil, err
+	}
+	vc.keyPairs[keyPairID] = signer
+
+	var cryptoKeyMetas []*keys.CryptoKeyMeta
+	for _, keyType := range []string{"private", "public"} {
+		cryptoKeyMetas = append(cryptoKeyMetas, &keys.CryptoKeyMeta{
+			ID: uuid.New().String(), KeyPairID: keyPairID, Type: keyType, Algorithm: keyAlgorithm, KeySize: keySize,
+			DateTimeCreated: time.Now(), UserID: userID, TokenLabel: "vault-keys", TokenObjectLabel: keyPairID,
+		})
+	}
+	return cryptoKeyMetas, nil
+}
+
+func (vc *tokenVaultConnector) Download(ctx context.Context, keyID, keyPairID, keyType string) ([]byte, error) {
+	signer, generated := vc.keyPairs[keyPairID]
+	if !generated {
+		return vc.VaultConnector.Download(ctx, keyID, keyPairID, keyType)
+	}
+	if keyType != "public" {
+		return nil, keys.ErrExportForbidden
+	}
+	return x509.MarshalPKIXPublicKey(signer.Public())
+}
+
+func (vc *tokenVaultConnector) Signer(ctx context.Context, cryptoKeyMeta *keys.CryptoKeyMeta) (cryptoHash.Signer, error) {
+	return vc.keyPairs[cryptoKeyMeta.TokenObjectLabel], nil
+}
+
+func (vc *tokenVaultConnector) Decrypter(ctx context.Context, cryptoKeyMeta *keys.CryptoKeyMeta) (cryptoHash.Decrypter, error) {
+	return vc.keyPairs[cryptoKeyMeta.TokenObjectLabel].(cryptoHash.Decrypter), nil
+}
+
+// Test case for key pairs generated on the token backing the vault, whose private keys are used but never exported
+func TestCryptoKeyServices_Token_Generated_Keys(t *testing.T) {
+	dbType := "sqlite"
+	keyServices := NewKeyServicesTest(t, dbType)
+	defer repository.TeardownTestDB(t, keyServices.dbContext, dbType)
+
+	vaultConnector := &tokenVaultConnector{VaultConnector: keyServices.vaultConnector, keyPairs: map[string]cryptoHash.Signer{}}
+	cryptoKeyUploadService, err := NewCryptoKeyUploadService(vaultConnector, keyServices.dbContext.CryptoKeyRepo, keyServices.dbContext.LogicalKeyRepo, keyServices.masterKeyProvider, keyServices.permissionManagement, keyServices.logger)
+	require.NoError(t, err)
+	cryptoKeyDownloadService, err := NewCryptoKeyDownloadService(vaultConnector, keyServices.dbContext.CryptoKeyRepo, keyServices.dbContext.LogicalKeyRepo, keyServices.masterKeyProvider, keyServices.permissionManagement, nil, keyServices.logger)
+	require.NoError(t, err)
+	cryptoOperationService, err := NewCryptoOperationService(vaultConnector, keyServices.dbContext.CryptoKeyRepo, keyServices.dbContext.LogicalKeyRepo, keyServices.masterKeyProvider, keyServices.permissionManagement, keyServices.logger)
+	require.NoError(t, err)
+	masterKeyRotationService, err := NewMasterKeyRotationService(vaultConnector, keyServices.dbContext.CryptoKeyRepo, keyServices.dbContext.KeyImportKeyRepo, keyServices.dbContext.BlobLinkSigningKeyRepo, keyServices.dbContext.MasterKeyRotationRepo, keyServices.masterKeyProvider, keyServices.logger)
+	require.NoError(t, err)
+
+	ctx := auth.WithUserID(context.Background(), uuid.New().String())
+
+	rsaKeyMetas, err := cryptoKeyUploadService.Upload(ctx, "RSA", 2048, nil)
+	require.NoError(t, err)
+	require.Len(t, rsaKeyMetas, 2)
+	for _, cryptoKeyMeta := range rsaKeyMetas {
+		require.True(t, cryptoKeyMeta.TokenResident())
+		require.Equal(t, uint32(0), cryptoKeyMeta.KEKVersion)
+	}
+	privateKeyID := keyOfType(t, rsaKeyMetas, "private")
+	publicKeyID := keyOfType(t, rsaKeyMetas, "public")
+
+	// Symmetric keys are still stored wrapped in the vault
+	aesKeyMetas, err := cryptoKeyUploadService.Upload(ctx, "AES", 256, nil)
+	require.NoError(t, err)
+	require.False(t, aesKeyMetas[0].TokenResident())
+	_, err = cryptoKeyDownloadService.DownloadByID(ctx, aesKeyMetas[0].ID)
+	require.NoError(t, err)
+
+	_, err = cryptoKeyDownloadService.DownloadByID(ctx, privateKeyID)
+	require.ErrorIs(t, err, keys.ErrExportForbidden)
+	_, err = cryptoKeyDownloadService.ExportWrappedByID(ctx, privateKeyID, &keys.KeyExportWrapping{WrappingKeyID: aesKeyMetas[0].ID})
+	require.ErrorIs(t, err, keys.ErrExportForbidden)
+	publicKeyBytes, err := cryptoKeyDownloadService.DownloadByID(ctx, publicKeyID)
+	require.NoError(t, err)
+	_, err = x509.ParsePKIXPublicKey(publicKeyBytes)
+	require.NoError(t, err)
+
+	encrypted, err := cryptoOperationService.Encrypt(ctx, publicKeyID, []byte("transit payload"), nil)
+	require.NoError(t, err)
+	decrypted, err := cryptoOperationService.Decrypt(ctx, privateKeyID, encrypted.Ciphertext, nil)
+	require.NoError(t, err)
+	require.Equal(t, []byte("transit payload"), decrypted.Plaintext)
+
+	signed, err := cryptoOperationService.Sign(ctx, privateKeyID, []byte("signed payload"))
+	require.NoError(t, err)
+	verified, err := cryptoOperationService.Verify(ctx, publicKeyID, []byte("signed payload"), signed.Signature)
+	require.NoError(t, err)
+	require.True(t, *verified.Valid)
+
+	// Master key rotation re-wraps vault keys only
+	adminCtx := auth.WithIdentity(context.Background(), &auth.Identity{UserID: uuid.New().String(), Admin: true})
+	rotation, err := masterKeyRotationService.Rotate(adminCtx)
+	require.NoError(t, err)
+	require.Eventually(t, func() bool {
+		fetchedRotation, err := masterKeyRotationService.GetByID(adminCtx, rotation.ID)
+		return err == nil && fetchedRotation.Status == "completed" && fetchedRotation.RewrappedKeys == 1
+	}, 10*time.Second, 100*time.Millisecond)
+}
@@ -0,0 +1,380 @@
+package services
+
+import (
+	"context"
+	"crypto_vault_service/internal/domain/auth"
+	"crypto_vault_service/internal/domain/blobs"
+	"crypto_vault_service/internal/domain/keys"
+	"crypto_vault_service/internal/infrastructure/connector"
+	"crypto_vault_service/internal/infrastructure/cryptography"
+	"crypto_vault_service/internal/infrastructure/logger"
+	"fmt"
+	"sync"
+	"time"
+
+	"github.com/google/uuid"
+)
+
+// masterKeyRotationPageSize is the number of key metadata entries re-wrapped before the rotation progress is persisted
+const masterKeyRotationPageSize = 100
+
+// masterKeyRotationService implements the MasterKeyRotationService interface to rotate the master key and re-wrap stored keys
+type masterKeyRotationService struct {
+	vaultConnector        connector.VaultConnector
+	cryptoKeyRepo         keys.CryptoKeyRepository
+	keyImportKeyRepo      keys.KeyImportKeyRepository
+	signingKeyRepo        blobs.BlobLinkSigningKeyRepository
+	masterKeyRotationRepo keys.MasterKeyRotationRepository
+	masterKeyProvider     cryptography.MasterKeyProvider
+	mu                    sync.Mutex
+	rewrapping            bool // Whether a rotation is being re-wrapped by this instance, guarded by mu
+	logger                logger.Logger
+}
+
+// NewMasterKeyRotationService creates a new masterKeyRotationService instance
+func NewMasterKeyRotationService(vaultConnector connector.VaultConnector, cryptoKeyRepo keys.CryptoKeyRepository, keyImportKeyRepo keys.KeyImportKeyRepository, signingKeyRepo blobs.BlobLinkSigningKeyRepository, masterKeyRotationRepo keys.MasterKeyRotationRepository, masterKeyProvider cryptography.MasterKeyProvider, logger logger.Logger) (keys.MasterKeyRotationService, error) {
+	return &masterKeyRotationService{
+		vaultConnector:        vaultConnector,
+		cryptoKeyRepo:         cryptoKeyRepo,
+		keyImportKeyRepo:      keyImportKeyRepo,
+		signingKeyRepo:        signingKeyRepo,
+		masterKeyRotationRepo: masterKeyRotationRepo,
+		masterKeyProvider:     masterKeyProvider,
+		logger:                logger,
+	}, nil
+}
+
+// Rotate introduces a new master key version and starts re-wrapping all stored keys in the background.
+// A failed rotation, or one left running by a previous process, is resumed with the keys it left to re-wrap instead of introducing another version.
+// Rotations affect the keys of all users, hence only admins may rotate the master key.
+func (s *masterKeyRotationService) Rotate(ctx context.Context) (*keys.MasterKeyRotation, error) {
+	if err := auth.AuthorizeAdmin(ctx); err != nil {
+		return nil, fmt.Errorf("%w", err)
+	}
+
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	if s.rewrapping {
+		return nil, fmt.Errorf("a master key rotation is still running")
+	}
+
+	unfinishedRotation, err := s.masterKeyRotationRepo.GetUnfinished(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("%w", err)
+	}
+	if unfinishedRotation != nil {
+		return s.resume(ctx, unfinishedRotation)
+	}
+
+	targetKEKVersion, err := s.masterKeyProvider.Rotate()
+	if err != nil {
+		return nil, fmt.Errorf("failed to rotate master key: %w", err)
+	}
+
+	rotation := &keys.MasterKeyRotation{
+		ID:               uuid.New().String(),
+		TargetKEKVersion: targetKEKVersion,
+		Status:           "running",
+		DateTimeStarted:  time.Now(),
+		DateTimeUpdated:  time.Now(),
+	}
+
+	if err := s.masterKeyRotationRepo.Create(ctx, rotation); err != nil {
+		return nil, fmt.Errorf("%w", err)
+	}
+
+	s.startRewrap(rotation)
+	return rotation, nil
+}
+
+// GetByID retrieves a master key rotation by its ID. Only admins may retrieve rotations.
+func (s *masterKeyRotationService) GetByID(ctx context.Context, rotationID string) (*keys.MasterKeyRotation, error) {
+	if err := auth.AuthorizeAdmin(ctx); err != nil {
+		return nil, fmt.Errorf("%w", err)
+	}
+
+	rotation, err := s.masterKeyRotationRepo.GetByID(ctx, rotationID)
+	if err != nil {
+		return nil, fmt.Errorf("%w", err)
+	}
+
+	return rotation, nil
+}
+
+// Resume continues re-wrapping the keys left by a running or failed rotation left unfinished.
+func (s *masterKeyRotationService) Resume(ctx context.Context) error {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	if s.rewrapping {
+		return nil
+	}
+
+	rotation, err := s.masterKeyRotationRepo.GetUnfinished(ctx)
+	if err != nil {
+		return fmt.Errorf("%w", err)
+	}
+	if rotation == nil {
+		return nil
+	}
+
+	if _, err := s.resume(ctx, rotation); err != nil {
+		return fmt.Errorf("%w", err)
+	}
+	return nil
+}
+
+// resume marks an unfinished rotation as running again and continues re-wrapping the keys it left. The caller must hold mu.
+func (s *masterKeyRotationService) resume(ctx context.Context, rotation *keys.MasterKeyRotation) (*keys.MasterKeyRotation, error) {
+	rotation.Status = "running"
+	rotation.Error = ""
+	rotation.DateTimeUpdated = time.Now()
+	if err := s.masterKeyRotationRepo.UpdateByID(ctx, rotation); err != nil {
+		return nil, fmt.Errorf("%w", err)
+	}
+
+	s.logger.Info(fmt.Sprintf("Resuming master key rotation %s after %d processed keys", rotation.ID, rotation.Offset))
+	s.startRewrap(rotation)
+	return rotation, nil
+}
+
+// startRewrap re-wraps the stored keys for a running rotation in the background. The caller must hold mu.
+func (s *masterKeyRotationService) startRewrap(rotation *keys.MasterKeyRotation) {
+	s.rewrapping = true
+
+	// Re-wrapping outlives the request, hence it must not be bound to the request context
+	runningRotation := *rotation
+	go s.rewrap(context.Background(), &runningRotation)
+}
+
+// rewrap re-wraps all stored keys below the target KEK version of the rotation page by page and records the progress after each page.
+// Every page selects the keys still left to re-wrap, so keys deleted or destroyed meanwhile do not shift later pages.
+// The key import keys and link signing keys are re-wrapped once all stored keys are done.
+func (s *masterKeyRotationService) rewrap(ctx context.Context, rotation *keys.MasterKeyRotation) {
+	defer func() {
+		s.mu.Lock()
+		s.rewrapping = false
+		s.mu.Unlock()
+	}()
+
+	for {
+		cryptoKeyMetas, err := s.cryptoKeyRepo.ListBelowKEKVersion(ctx, rotation.TargetKEKVersion, masterKeyRotationPageSize)
+		if err != nil {
+			s.finishRotation(ctx, rotation, fmt.Errorf("failed to list keys after %d processed keys: %w", rotation.Offset, err))
+			return
+		}
+
+		if len(cryptoKeyMetas) == 0 {
+			if err := s.rewrapImportKeys(ctx, rotation); err != nil {
+				s.finishRotation(ctx, rotation, err)
+				return
+			}
+			s.finishRotation(ctx, rotation, s.rewrapSigningKeys(ctx, rotation))
+			return
+		}
+
+		for _, cryptoKeyMeta := range cryptoKeyMetas {
+			rewrapped, err := s.rewrapKey(ctx, cryptoKeyMeta, rotation.TargetKEKVersion)
+			if err != nil {
+				s.finishRotation(ctx, rotation, err)
+				return
+			}
+			if rewrapped {
+				rotation.RewrappedKeys++
+			}
+		}
+
+		rotation.Offset += len(cryptoKeyMetas)
+		rotation.DateTimeUpdated = time.Now()
+		if err := s.masterKeyRotationRepo.UpdateByID(ctx, rotation); err != nil {
+			s.finishRotation(ctx, rotation, fmt.Errorf("failed to record progress after %d processed keys: %w", rotation.Offset, err))
+			return
+		}
+	}
+}
+
+// rewrapKey replaces the stored key material with a version wrapped by the target KEK, migrating legacy EC key encodings on the way.
+// The key is read again right before, keys deleted, destroyed or re-wrapped since their page was listed are skipped.
+// The vault is updated before the metadata, readers fall back to the current KEK version in between.
+// Only the KEK version of the metadata is updated, and only while unchanged, so concurrent updates of other fields are kept.
+// It reports whether the key was re-wrapped.
+func (s *masterKeyRotationService) rewrapKey(ctx context.Context, listedKeyMeta *keys.CryptoKeyMeta, targetKEKVersion uint32) (bool, error) {
+	query := keys.NewCryptoKeyQuery()
+	query.KeyPairID = listedKeyMeta.KeyPairID
+	query.Type = listedKeyMeta.Type
+	query.Limit = 1
+	currentKeyMetas, err := s.cryptoKeyRepo.List(ctx, query)
+	if err != nil {
+		return false, fmt.Errorf("%w", err)
+	}
+	if len(currentKeyMetas) == 0 {
+		return false, nil
+	}
+
+	// Destroyed keys have no material left to re-wrap, keys generated on a PKCS#11 token are not wrapped at all
+	cryptoKeyMeta := currentKeyMetas[0]
+	if cryptoKeyMeta.KEKVersion >= targetKEKVersion || cryptoKeyMeta.State == keys.KeyStateDestroyed || cryptoKeyMeta.TokenResident() {
+		return false, nil
+	}
+
+	keyBytes, err := s.vaultConnector.Download(ctx, cryptoKeyMeta.ID, cryptoKeyMeta.KeyPairID, cryptoKeyMeta.Type)
+	if err != nil {
+		return false, fmt.Errorf("%w", err)
+	}
+
+	plainKeyBytes, err := unwrapCryptoKey(s.masterKeyProvider, keyBytes, cryptoKeyMeta)
+	if err != nil {
+		return false, fmt.Errorf("%w", err)
+	}
+
+	// EC keys stored in the legacy raw format are migrated to PKCS#8 and PKIX while being re-wrapped
+	plainKeyBytes, err = encodeECKey(plainKeyBytes, cryptoKeyMeta)
+	if err != nil {
+		return false, fmt.Errorf("%w", err)
+	}
+
+	wrappedKeyBytes, kekVersion, err := s.masterKeyProvider.Wrap(plainKeyBytes, cryptoKeyMeta.ID, cryptoKeyMeta.KeyPairID, cryptoKeyMeta.Type)
+	if err != nil {
+		return false, fmt.Errorf("failed to wrap key %s with master key: %w", cryptoKeyMeta.ID, err)
+	}
+	if kekVersion != targetKEKVersion {
+		return false, fmt.Errorf("master key version changed from %d to %d during rotation", targetKEKVersion, kekVersion)
+	}
+
+	if err := s.vaultConnector.Replace(ctx, wrappedKeyBytes, cryptoKeyMeta.ID, cryptoKeyMeta.KeyPairID, cryptoKeyMeta.Type); err != nil {
+		return false, fmt.Errorf("%w", err)
+	}
+
+	rewrapped, err := s.cryptoKeyRepo.UpdateKEKVersion(ctx, cryptoKeyMeta.ID, cryptoKeyMeta.KEKVersion, kekVersion)
+	if err != nil {
+		return false, fmt.Errorf("%w", err)
+	}
+	return rewrapped, nil
+}
+
+// rewrapImportKeys replaces the stored import keys not yet wrapped by the target KEK of the rotation with re-wrapped versions
+func (s *masterKeyRotationService) rewrapImportKeys(ctx context.Context, rotation *keys.MasterKeyRotation) error {
+	importKeyMetas, err := s.keyImportKeyRepo.List(ctx)
+	if err != nil {
+		return fmt.Errorf("failed to list import keys: %w", err)
+	}
+
+	for _, importKeyMeta := range importKeyMetas {
+		if importKeyMeta.KEKVersion == rotation.TargetKEKVersion {
+			continue
+		}
+
+		if err := s.rewrapSecret(ctx, importKeyMeta.ID, importKeyMeta.KeyPairID, keyImportKeyType, importKeyMeta.KEKVersion, rotation.TargetKEKVersion); err != nil {
+			return fmt.Errorf("%w", err)
+		}
+
+		importKeyMeta.KEKVersion = rotation.TargetKEKVersion
+		if err := s.keyImportKeyRepo.UpdateByID(ctx, importKeyMeta); err != nil {
+			return fmt.Errorf("%w", err)
+		}
+		rotation.RewrappedKeys++
+	}
+
+	return nil
+}
+
+// rewrapSigningKeys replaces the stored link signing keys not yet wrapped by the target KEK of the rotation with re-wrapped versions
+func (s *masterKeyRotationService) rewrapSigningKeys(ctx context.Context, rotation *keys.MasterKeyRotation) error {
+	signingKeyMetas, err := s.signingKeyRepo.List(ctx)
+	if err != nil {
+		return fmt.Errorf("failed to list link signing keys: %w", err)
+	}
+
+	for _, signingKeyMeta := range signingKeyMetas {
+		if signingKeyMeta.KEKVersion == rotation.TargetKEKVersion {
+			continue
+		}
+
+		if err := s.rewrapSecret(ctx, signingKeyMeta.ID, signingKeyMeta.KeyPairID, blobLinkSigningKeyType, signingKeyMeta.KEKVersion, rotation.TargetKEKVersion); err != nil {
+			return fmt.Errorf("%w", err)
+		}
+
+		signingKeyMeta.KEKVersion = rotation.TargetKEKVersion
+		if err := s.signingKeyRepo.UpdateByID(ctx, signingKeyMeta); err != nil {
+			return fmt.Errorf("%w", err)
+		}
+		rotation.RewrappedKeys++
+	}
+
+	return nil
+}
+
+// rewrapSecret replaces a secret of the service stored in the vault with a version wrapped by the target KEK.
+// Like for stored keys the vault is updated before the metadata, which the caller records afterwards.
+func (s *masterKeyRotationService) rewrapSecret(ctx context.Context, keyID, keyPairID, keyType string, kekVersion, targetKEKVersion uint32) error {
+	wrappedBytes, err := s.vaultConnector.Download(ctx, keyID, keyPairID, keyType)
+	if err != nil {
+		return fmt.Errorf("%w", err)
+	}
+
+	plainBytes, err := unwrapStoredKey(s.masterKeyProvider, wrappedBytes, kekVersion, keyID, keyPairID, keyType)
+	if err != nil {
+		return fmt.Errorf("%w", err)
+	}
+
+	wrappedBytes, wrappedKEKVersion, err := s.masterKeyProvider.Wrap(plainBytes, keyID, keyPairID, keyType)
+	if err != nil {
+		return fmt.Errorf("failed to wrap key %s with master key: %w", keyID, err)
+	}
+	if wrappedKEKVersion != targetKEKVersion {
+		return fmt.Errorf("master key version changed from %d to %d during rotation", targetKEKVersion, wrappedKEKVersion)
+	}
+
+	if err := s.vaultConnector.Replace(ctx, wrappedBytes, keyID, keyPairID, keyType); err != nil {
+		return fmt.Errorf("%w", err)
+	}
+
+	return nil
+}
+
+// finishRotation records the final status of a rotation. Failed rotations are resumed with the keys they left to re-wrap.
+func (s *masterKeyRotationService) finishRotation(ctx context.Context, rotation *keys.MasterKeyRotation, rotationErr error) {
+	rotation.Status = "completed"
+	if rotationErr != nil {
+		rotation.Status = "failed"
+		rotation.Error = rotationErr.Error()
+		s.logger.Error(fmt.Sprintf("Master key rotation %s failed: %v", rotation.ID, rotationErr))
+	} else {
+		s.logger.Info(fmt.Sprintf("Master key rotation %s completed, re-wrapped %d keys with version %d", rotation.ID, rotation.RewrappedKeys, rotation.TargetKEKVersion))
+	}
+	rotation.DateTimeUpdated = time.Now()
+
+	if err := s.masterKeyRotationRepo.UpdateByID(ctx, rotation); err != nil {
+		s.logger.Error(fmt.Sprintf("Failed to record status of master key rotation %s: %v", rotation.ID, err))
+	}
+}
+
+// MasterKeyInitializable reports whether no stored key is wrapped with the master key yet, so a first KEK version may be generated.
+// Otherwise a missing KEK points to a misconfigured master key source, and generating a new one would leave the wrapped keys unreadable.
+func MasterKeyInitializable(ctx context.Context, cryptoKeyRepo keys.CryptoKeyRepository, keyImportKeyRepo keys.KeyImportKeyRepository, signingKeyRepository blobs.BlobLinkSigningKeyRepository) (bool, error) {
+	query := keys.NewCryptoKeyQuery()
+	query.Limit = 1
+	query.Wrapped = true
+	cryptoKeyMetas, err := cryptoKeyRepo.List(ctx, query)
+	if err != nil {
+		return false, fmt.Errorf("%w", err)
+	}
+	if len(cryptoKeyMetas) > 0 {
+		return false, nil
+	}
+
+	importKeyMeta, err := keyImportKeyRepo.GetFirst(ctx)
+	if err != nil {
+		return false, fmt.Errorf("%w", err)
+	}
+	if importKeyMeta != nil {
+		return false, nil
+	}
+
+	signingKeyMeta, err := signingKeyRepository.GetFirst(ctx)
+	if err != nil {
+		return false, fmt.Errorf("%w", err)
+	}
+	return signingKeyMeta == nil, nil
+}
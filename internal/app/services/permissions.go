@@ -0,0 +1,123 @@
+package services
+
+import (
+	"context"
+	"crypto_vault_service/internal/domain/auth"
+	"crypto_vault_service/internal/domain/blobs"
+	"crypto_vault_service/internal/domain/keys"
+	"crypto_vault_service/internal/domain/permissions"
+	"crypto_vault_service/internal/infrastructure/logger"
+	"fmt"
+)
+
+// ownershipSyncPageSize is the number of blobs or keys whose ownership tuples are written at once
+const ownershipSyncPageSize = 100
+
+// checkPermission returns auth.ErrForbidden unless the authenticated caller carried by ctx has the permission on the object.
+// Admins are related to the admins group by a contextual tuple, which makes them admins of all blobs and keys.
+// Likewise the caller is related as member to its groups, which obtain the access blobs were shared with.
+func checkPermission(ctx context.Context, permissionManagement permissions.PermissionManagement, permission, object string) error {
+	identity, err := auth.IdentityFromContext(ctx)
+	if err != nil {
+		return fmt.Errorf("%w", err)
+	}
+
+	user := permissions.User(identity.UserID)
+	request := &permissions.CheckRequest{
+		User:     user,
+		Relation: permission,
+		Object:   object,
+	}
+	if identity.Admin {
+		request.ContextualTuples = append(request.ContextualTuples, permissions.NewRelationTuple(user, permissions.RelationAdmin, permissions.AdminsGroup))
+	}
+	for _, group := range identity.Groups {
+		if group != "" {
+			request.ContextualTuples = append(request.ContextualTuples, permissions.NewRelationTuple(user, permissions.RelationMember, permissions.UserGroup(group)))
+		}
+	}
+
+	allowed, err := permissionManagement.Check(ctx, request)
+	if err != nil {
+		return fmt.Errorf("%w", err)
+	}
+	if !allowed {
+		return fmt.Errorf("%s on %s: %w", permission, object, auth.ErrForbidden)
+	}
+	return nil
+}
+
+// ownershipTuples returns the tuples relating the owner and the admins group to a blob or key
+func ownershipTuples(userID, object string) []*permissions.RelationTuple {
+	return []*permissions.RelationTuple{
+		permissions.NewRelationTuple(permissions.User(userID), permissions.RelationOwner, object),
+		permissions.NewRelationTuple(permissions.Userset(permissions.AdminsGroup, permissions.RelationAdmin), permissions.RelationAdmin, object),
+	}
+}
+
+// deleteObjectTuples removes all tuples relating users to a deleted blob or key
+func deleteObjectTuples(ctx context.Context, permissionManagement permissions.PermissionManagement, object string) error {
+	tuples, err := permissionManagement.ReadTuples(ctx, &permissions.RelationTupleQuery{Object: object})
+	if err != nil {
+		return fmt.Errorf("%w", err)
+	}
+
+	if err := permissionManagement.DeleteTuples(ctx, tuples); err != nil {
+		return fmt.Errorf("%w", err)
+	}
+	return nil
+}
+
+// SyncOwnershipTuples writes the ownership tuples of all stored blobs and keys.
+// Blobs and keys stored before permissions were managed by relationship tuples would otherwise be inaccessible.
+// Existing tuples are kept, hence it is safe to run on every start.
+func SyncOwnershipTuples(ctx context.Context, permissionManagement permissions.PermissionManagement, blobRepository blobs.BlobRepository, cryptoKeyRepo keys.CryptoKeyRepository, logger logger.Logger) error {
+	blobQuery := blobs.NewBlobMetaQuery()
+	blobQuery.Limit = ownershipSyncPageSize
+	blobQuery.SortBy = "ID"
+	for {
+		blobMetas, err := blobRepository.List(ctx, blobQuery)
+		if err != nil {
+			return fmt.Errorf("failed to list blobs at offset %d: %w", blobQuery.Offset, err)
+		}
+
+		var tuples []*permissions.RelationTuple
+		for _, blobMeta := range blobMetas {
+			tuples = append(tuples, ownershipTuples(blobMeta.UserID, permissions.Blob(blobMeta.ID))...)
+		}
+		if err := permissionManagement.WriteTuples(ctx, tuples); err != nil {
+			return fmt.Errorf("%w", err)
+		}
+
+		blobQuery.Offset += len(blobMetas)
+		if len(blobMetas) < ownershipSyncPageSize {
+			break
+		}
+	}
+
+	keyQuery := keys.NewCryptoKeyQuery()
+	keyQuery.Limit = ownershipSyncPageSize
+	keyQuery.SortBy = "ID"
+	for {
+		cryptoKeyMetas, err := cryptoKeyRepo.List(ctx, keyQuery)
+		if err != nil {
+			return fmt.Errorf("failed to list keys at offset %d: %w", keyQuery.Offset, err)
+		}
+
+		var tuples []*permissions.RelationTuple
+		for _, cryptoKeyMeta := range cryptoKeyMetas {
+			tuples = append(tuples, ownershipTuples(cryptoKeyMeta.UserID, permissions.Key(cryptoKeyMeta.ID))...)
+		}
+		if err := permissionManagement.WriteTuples(ctx, tuples); err != nil {
+			return fmt.Errorf("%w", err)
+		}
+
+		keyQuery.Offset += len(cryptoKeyMetas)
+		if len(cryptoKeyMetas) < ownershipSyncPageSize {
+			break
+		}
+	}
+
+	logger.Info(fmt.Sprintf("Synchronized ownership tuples of %d blobs and %d keys", blobQuery.Offset, keyQuery.Offset))
+	return nil
+}
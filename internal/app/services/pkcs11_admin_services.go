@@ -0,0 +1,273 @@
+package services
+
+import (
+	"context"
+	"crypto_vault_service/internal/domain/auth"
+	"crypto_vault_service/internal/domain/crypto"
+	"crypto_vault_service/internal/domain/pkcs11"
+	"crypto_vault_service/internal/infrastructure/cryptography"
+	"crypto_vault_service/internal/infrastructure/logger"
+	"fmt"
+	"slices"
+	"strconv"
+)
+
+// pkcs11AdminService implements the PKCS11AdminService interface by delegating to the PKCS#11 handler after authorizing the caller as admin.
+// Modifications of tokens are logged with the admin performing them.
+// Tokens holding the keys of the vault or the master key are off limits, so admins cannot decrypt, delete or overwrite the keys of users or the KEK versions.
+type pkcs11AdminService struct {
+	pkcs11Handler    cryptography.PKCS11Handler
+	slotID           string
+	vaultTokenLabels []string
+	logger           logger.Logger
+}
+
+// NewPKCS11AdminService creates a new pkcs11AdminService instance.
+// slotID is the slot InitializeToken initializes and vaultTokenLabels are the labels of the tokens holding the keys of the vault or the master key.
+func NewPKCS11AdminService(pkcs11Handler cryptography.PKCS11Handler, slotID string, vaultTokenLabels []string, logger logger.Logger) (pkcs11.PKCS11AdminService, error) {
+	return &pkcs11AdminService{
+		pkcs11Handler:    pkcs11Handler,
+		slotID:           slotID,
+		vaultTokenLabels: vaultTokenLabels,
+		logger:           logger,
+	}, nil
+}
+
+// ListTokenSlots lists the tokens present in the slots of the module
+func (s *pkcs11AdminService) ListTokenSlots(ctx context.Context) ([]*pkcs11.Token, error) {
+	if err := auth.AuthorizeAdmin(ctx); err != nil {
+		return nil, fmt.Errorf("%w", err)
+	}
+
+	slots, err := s.pkcs11Handler.ListTokenSlots()
+	if err != nil {
+		return nil, fmt.Errorf("%w", err)
+	}
+
+	tokens := make([]*pkcs11.Token, 0, len(slots))
+	for _, slot := range slots {
+		tokens = append(tokens, &pkcs11.Token{
+			SlotID:       slot.SlotID,
+			Label:        slot.Label,
+			Manufacturer: slot.Manufacturer,
+			Model:        slot.Model,
+			SerialNumber: slot.SerialNumber,
+		})
+	}
+	return tokens, nil
+}
+
+// ListObjects lists the objects stored on a token
+func (s *pkcs11AdminService) ListObjects(ctx context.Context, tokenLabel string) ([]*pkcs11.TokenObject, error) {
+	if err := auth.AuthorizeAdmin(ctx); err != nil {
+		return nil, fmt.Errorf("%w", err)
+	}
+
+	objects, err := s.pkcs11Handler.ListObjects(tokenLabel)
+	if err != nil {
+		return nil, fmt.Errorf("%w", err)
+	}
+
+	tokenObjects := make([]*pkcs11.TokenObject, 0, len(objects))
+	for _, object := range objects {
+		tokenObjects = append(tokenObjects, &pkcs11.TokenObject{
+			Label:  object.Label,
+			Type:   object.Type,
+			Usage:  object.Usage,
+			Access: object.Access,
+		})
+	}
+	return tokenObjects, nil
+}
+
+// InitializeToken initializes the token of the configured slot with the label
+func (s *pkcs11AdminService) InitializeToken(ctx context.Context, tokenLabel string) error {
+	adminID, err := authorizedAdminID(ctx)
+	if err != nil {
+		return fmt.Errorf("%w", err)
+	}
+	if err := s.checkInitializableSlot(tokenLabel); err != nil {
+		return fmt.Errorf("%w", err)
+	}
+
+	if err := s.pkcs11Handler.InitializeToken(tokenLabel); err != nil {
+		return fmt.Errorf("%w", err)
+	}
+
+	s.logger.Info(fmt.Sprintf("admin %s initialized token %s", adminID, tokenLabel))
+	return nil
+}
+
+// AddKey generates an RSA or ECDSA key pair or an AES key on a token
+func (s *pkcs11AdminService) AddKey(ctx context.Context, tokenLabel, objectLabel, keyType string, keySize uint32) error {
+	adminID, err := authorizedAdminID(ctx)
+	if err != nil {
+		return fmt.Errorf("%w", err)
+	}
+	if err := s.checkAdministrableToken(tokenLabel); err != nil {
+		return fmt.Errorf("%w", err)
+	}
+
+	if err := s.pkcs11Handler.AddKey(tokenLabel, objectLabel, keyType, uint(keySize)); err != nil {
+		return fmt.Errorf("failed to add %s key %s to token %s: %w", keyType, objectLabel, tokenLabel, err)
+	}
+
+	s.logger.Info(fmt.Sprintf("admin %s added %s key %s to token %s", adminID, keyType, objectLabel, tokenLabel))
+	return nil
+}
+
+// DeleteObject deletes the objects of the given type and label from a token
+func (s *pkcs11AdminService) DeleteObject(ctx context.Context, tokenLabel, objectType, objectLabel string) error {
+	adminID, err := authorizedAdminID(ctx)
+	if err != nil {
+		return fmt.Errorf("%w", err)
+	}
+	if err := s.checkAdministrableToken(tokenLabel); err != nil {
+		return fmt.Errorf("%w", err)
+	}
+
+	if err := s.pkcs11Handler.DeleteObject(tokenLabel, objectType, objectLabel); err != nil {
+		return fmt.Errorf("%w", err)
+	}
+
+	s.logger.Info(fmt.Sprintf("admin %s deleted %s object %s from token %s", adminID, objectType, objectLabel, tokenLabel))
+	return nil
+}
+
+// Encrypt encrypts the plaintext with the public key of an RSA key pair or an AES key on a token
+func (s *pkcs11AdminService) Encrypt(ctx context.Context, tokenLabel, objectLabel, keyType string, plaintext []byte) (*pkcs11.OperationResult, error) {
+	if err := s.authorizeOperation(ctx, tokenLabel, crypto.OperationEncrypt, plaintext); err != nil {
+		return nil, fmt.Errorf("%w", err)
+	}
+
+	ciphertext, err := s.pkcs11Handler.Encrypt(tokenLabel, objectLabel, plaintext, keyType)
+	if err != nil {
+		return nil, fmt.Errorf("%w", err)
+	}
+
+	result := newPKCS11OperationResult(crypto.OperationEncrypt, tokenLabel, objectLabel, keyType)
+	result.Ciphertext = ciphertext
+	return result, nil
+}
+
+// Decrypt decrypts a ciphertext with the private key of an RSA key pair or an AES key on a token
+func (s *pkcs11AdminService) Decrypt(ctx context.Context, tokenLabel, objectLabel, keyType string, ciphertext []byte) (*pkcs11.OperationResult, error) {
+	if err := s.authorizeOperation(ctx, tokenLabel, crypto.OperationDecrypt, ciphertext); err != nil {
+		return nil, fmt.Errorf("%w", err)
+	}
+
+	plaintext, err := s.pkcs11Handler.Decrypt(tokenLabel, objectLabel, ciphertext, keyType)
+	if err != nil {
+		return nil, fmt.Errorf("%w", err)
+	}
+
+	result := newPKCS11OperationResult(crypto.OperationDecrypt, tokenLabel, objectLabel, keyType)
+	result.Plaintext = plaintext
+	return result, nil
+}
+
+// Sign signs the data with the private key of an RSA or ECDSA key pair on a token
+func (s *pkcs11AdminService) Sign(ctx context.Context, tokenLabel, objectLabel, keyType string, data []byte) (*pkcs11.OperationResult, error) {
+	if err := s.authorizeOperation(ctx, tokenLabel, crypto.OperationSign, data); err != nil {
+		return nil, fmt.Errorf("%w", err)
+	}
+
+	signature, err := s.pkcs11Handler.Sign(tokenLabel, objectLabel, data, keyType)
+	if err != nil {
+		return nil, fmt.Errorf("%w", err)
+	}
+
+	result := newPKCS11OperationResult(crypto.OperationSign, tokenLabel, objectLabel, keyType)
+	result.Signature = signature
+	return result, nil
+}
+
+// Verify verifies the signature of the data with the public key of an RSA or ECDSA key pair on a token
+func (s *pkcs11AdminService) Verify(ctx context.Context, tokenLabel, objectLabel, keyType string, data, signature []byte) (*pkcs11.OperationResult, error) {
+	if err := s.authorizeOperation(ctx, tokenLabel, crypto.OperationVerify, data); err != nil {
+		return nil, fmt.Errorf("%w", err)
+	}
+	if len(signature) == 0 {
+		return nil, fmt.Errorf("signature to verify cannot be empty")
+	}
+
+	valid, err := s.pkcs11Handler.Verify(tokenLabel, objectLabel, data, signature, keyType)
+	if err != nil {
+		return nil, fmt.Errorf("%w", err)
+	}
+
+	result := newPKCS11OperationResult(crypto.OperationVerify, tokenLabel, objectLabel, keyType)
+	result.Valid = &valid
+	return result, nil
+}
+
+// authorizeOperation rejects callers other than admins, tokens holding keys of the vault and payloads checkPayload does not accept
+func (s *pkcs11AdminService) authorizeOperation(ctx context.Context, tokenLabel, operation string, payload []byte) error {
+	if err := auth.AuthorizeAdmin(ctx); err != nil {
+		return fmt.Errorf("%w", err)
+	}
+	if err := s.checkAdministrableToken(tokenLabel); err != nil {
+		return fmt.Errorf("%w", err)
+	}
+	return checkPayload(operation, payload)
+}
+
+// checkAdministrableToken returns auth.ErrForbidden for tokens holding the keys of the vault or the master key
+func (s *pkcs11AdminService) checkAdministrableToken(tokenLabel string) error {
+	if slices.Contains(s.vaultTokenLabels, tokenLabel) {
+		return fmt.Errorf("%w: token %s holds keys of the vault, administer keys on a separate token", auth.ErrForbidden, tokenLabel)
+	}
+	return nil
+}
+
+// checkInitializableSlot returns auth.ErrForbidden if the token to initialize or the token in the configured slot holds the keys of the vault or the master key,
+// as initializing the slot would erase them
+func (s *pkcs11AdminService) checkInitializableSlot(tokenLabel string) error {
+	if err := s.checkAdministrableToken(tokenLabel); err != nil {
+		return fmt.Errorf("%w", err)
+	}
+
+	slots, err := s.pkcs11Handler.ListTokenSlots()
+	if err != nil {
+		return fmt.Errorf("%w", err)
+	}
+	for _, slot := range slots {
+		if sameSlotID(slot.SlotID, s.slotID) {
+			if err := s.checkAdministrableToken(slot.Label); err != nil {
+				return fmt.Errorf("%w", err)
+			}
+		}
+	}
+	return nil
+}
+
+// sameSlotID reports whether two slot IDs, given in decimal or hexadecimal notation, denote the same slot
+func sameSlotID(slotID, otherSlotID string) bool {
+	parsedSlotID, err := strconv.ParseUint(slotID, 0, 64)
+	if err != nil {
+		return slotID == otherSlotID
+	}
+	parsedOtherSlotID, err := strconv.ParseUint(otherSlotID, 0, 64)
+	if err != nil {
+		return slotID == otherSlotID
+	}
+	return parsedSlotID == parsedOtherSlotID
+}
+
+// authorizedAdminID returns the user id of the caller carried by ctx, provided the caller is an admin
+func authorizedAdminID(ctx context.Context) (string, error) {
+	if err := auth.AuthorizeAdmin(ctx); err != nil {
+		return "", fmt.Errorf("%w", err)
+	}
+	return auth.UserIDFromContext(ctx)
+}
+
+// newPKCS11OperationResult creates the result of an operation performed with a key object on a token, without its output
+func newPKCS11OperationResult(operation, tokenLabel, objectLabel, keyType string) *pkcs11.OperationResult {
+	return &pkcs11.OperationResult{
+		Operation:   operation,
+		TokenLabel:  tokenLabel,
+		ObjectLabel: objectLabel,
+		KeyType:     keyType,
+	}
+}
@@ -0,0 +1,114 @@
+//go:build integration
+// +build integration
+
+package services
+
+import (
+	"context"
+	"crypto_vault_service/internal/domain/auth"
+	"crypto_vault_service/internal/domain/pkcs11"
+	"crypto_vault_service/internal/infrastructure/cryptography"
+	"crypto_vault_service/internal/infrastructure/logger"
+	"crypto_vault_service/internal/infrastructure/settings"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+// fakeAdminPKCS11Handler records the token operations performed, standing in for a PKCS#11 module
+type fakeAdminPKCS11Handler struct {
+	cryptography.PKCS11Handler
+	slots      []cryptography.Token
+	operations []string
+}
+
+func (h *fakeAdminPKCS11Handler) ListTokenSlots() ([]cryptography.Token, error) {
+	return h.slots, nil
+}
+
+func (h *fakeAdminPKCS11Handler) InitializeToken(label string) error {
+	h.operations = append(h.operations, "initialize "+label)
+	return nil
+}
+
+func (h *fakeAdminPKCS11Handler) AddKey(label, objectLabel, keyType string, keySize uint) error {
+	h.operations = append(h.operations, "add "+label+"/"+objectLabel)
+	return nil
+}
+
+func (h *fakeAdminPKCS11Handler) DeleteObject(label, objectType, objectLabel string) error {
+	h.operations = append(h.operations, "delete "+label+"/"+objectLabel)
+	return nil
+}
+
+func (h *fakeAdminPKCS11Handler) Decrypt(label, objectLabel string, ciphertext []byte, keyType string) ([]byte, error) {
+	h.operations = append(h.operations, "decrypt "+label+"/"+objectLabel)
+	return []byte("plaintext"), nil
+}
+
+func (h *fakeAdminPKCS11Handler) Sign(label, objectLabel string, data []byte, keyType string) ([]byte, error) {
+	h.operations = append(h.operations, "sign "+label+"/"+objectLabel)
+	return []byte("signature"), nil
+}
+
+// newTestPKCS11AdminService creates a PKCS11AdminService initializing slot 0x1 whose vault keys and master key live on the tokens vault-token and kek-token
+func newTestPKCS11AdminService(t *testing.T, pkcs11Handler cryptography.PKCS11Handler) pkcs11.PKCS11AdminService {
+	loggerSettings := &settings.LoggerSettings{
+		LogLevel: "info",
+		LogType:  "console",
+		FilePath: "",
+	}
+
+	logger, err := logger.GetLogger(loggerSettings)
+	require.NoError(t, err, "Error creating logger")
+
+	adminService, err := NewPKCS11AdminService(pkcs11Handler, "1", []string{"vault-token", "kek-token"}, logger)
+	require.NoError(t, err, "Error creating PKCS11AdminService")
+
+	return adminService
+}
+
+// Test case for administering keys on tokens other than the ones of the vault
+func TestPKCS11AdminService_AdminToken_Success(t *testing.T) {
+	pkcs11Handler := &fakeAdminPKCS11Handler{slots: []cryptography.Token{{SlotID: "0x0", Label: "vault-token"}}}
+	pkcs11AdminService := newTestPKCS11AdminService(t, pkcs11Handler)
+
+	ctx := auth.WithIdentity(context.Background(), &auth.Identity{UserID: "admin-1", Admin: true})
+
+	require.NoError(t, pkcs11AdminService.InitializeToken(ctx, "admin-token"))
+	require.NoError(t, pkcs11AdminService.AddKey(ctx, "admin-token", "admin-key", "RSA", 2048))
+
+	decrypted, err := pkcs11AdminService.Decrypt(ctx, "admin-token", "admin-key", "RSA", []byte("ciphertext"))
+	require.NoError(t, err)
+	require.Equal(t, []byte("plaintext"), decrypted.Plaintext)
+
+	require.NoError(t, pkcs11AdminService.DeleteObject(ctx, "admin-token", "privkey", "admin-key"))
+	require.Equal(t, []string{"initialize admin-token", "add admin-token/admin-key", "decrypt admin-token/admin-key", "delete admin-token/admin-key"}, pkcs11Handler.operations)
+}
+
+// Test case for refusing admins to initialize, modify or use the tokens holding the keys of the vault or the master key
+func TestPKCS11AdminService_VaultToken_Fail(t *testing.T) {
+	pkcs11Handler := &fakeAdminPKCS11Handler{slots: []cryptography.Token{{SlotID: "0x1", Label: "vault-token"}}}
+	pkcs11AdminService := newTestPKCS11AdminService(t, pkcs11Handler)
+
+	ctx := auth.WithIdentity(context.Background(), &auth.Identity{UserID: "admin-1", Admin: true})
+
+	// The configured slot holds the vault token, initializing it would erase the keys of the vault
+	err := pkcs11AdminService.InitializeToken(ctx, "admin-token")
+	require.ErrorIs(t, err, auth.ErrForbidden)
+
+	for _, tokenLabel := range []string{"vault-token", "kek-token"} {
+		err = pkcs11AdminService.InitializeToken(ctx, tokenLabel)
+		require.ErrorIs(t, err, auth.ErrForbidden)
+		err = pkcs11AdminService.AddKey(ctx, tokenLabel, "master-key-v2", "AES", 256)
+		require.ErrorIs(t, err, auth.ErrForbidden)
+		err = pkcs11AdminService.DeleteObject(ctx, tokenLabel, "secrkey", "master-key-v1")
+		require.ErrorIs(t, err, auth.ErrForbidden)
+		_, err = pkcs11AdminService.Decrypt(ctx, tokenLabel, "master-key-v1", "RSA", []byte("ciphertext"))
+		require.ErrorIs(t, err, auth.ErrForbidden)
+		_, err = pkcs11AdminService.Sign(ctx, tokenLabel, "key-pair-id", "RSA", []byte("data"))
+		require.ErrorIs(t, err, auth.ErrForbidden)
+	}
+
+	require.Empty(t, pkcs11Handler.operations)
+}
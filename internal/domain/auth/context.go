@@ -0,0 +1,68 @@
+package auth
+
+import (
+	"context"
+	"errors"
+)
+
+// ErrUnauthenticated is returned if a context does not carry the identity of an authenticated caller
+var ErrUnauthenticated = errors.New("unauthenticated: no user id in context")
+
+// ErrForbidden is returned if the authenticated caller is not allowed to access a resource
+var ErrForbidden = errors.New("forbidden: caller is not allowed to access the resource")
+
+// identityKey is the context key of the authenticated caller's identity
+type identityKey struct{}
+
+// WithIdentity returns a copy of ctx carrying the identity of the authenticated caller
+func WithIdentity(ctx context.Context, identity *Identity) context.Context {
+	return context.WithValue(ctx, identityKey{}, identity)
+}
+
+// WithUserID returns a copy of ctx carrying the identity of an authenticated caller without admin privileges
+func WithUserID(ctx context.Context, userID string) context.Context {
+	return WithIdentity(ctx, &Identity{UserID: userID})
+}
+
+// IdentityFromContext returns the identity of the authenticated caller carried by ctx
+func IdentityFromContext(ctx context.Context) (*Identity, error) {
+	identity, ok := ctx.Value(identityKey{}).(*Identity)
+	if !ok || identity == nil || identity.UserID == "" {
+		return nil, ErrUnauthenticated
+	}
+	return identity, nil
+}
+
+// UserIDFromContext returns the user id of the authenticated caller carried by ctx
+func UserIDFromContext(ctx context.Context) (string, error) {
+	identity, err := IdentityFromContext(ctx)
+	if err != nil {
+		return "", err
+	}
+	return identity.UserID, nil
+}
+
+// AuthorizeAdmin returns ErrForbidden unless the authenticated caller carried by ctx is an admin
+func AuthorizeAdmin(ctx context.Context) error {
+	identity, err := IdentityFromContext(ctx)
+	if err != nil {
+		return err
+	}
+	if !identity.Admin {
+		return ErrForbidden
+	}
+	return nil
+}
+
+// OwnerScope returns the user id listings must be restricted to for the authenticated caller carried by ctx.
+// It is empty for admins, which may list the resources of all users.
+func OwnerScope(ctx context.Context) (string, error) {
+	identity, err := IdentityFromContext(ctx)
+	if err != nil {
+		return "", err
+	}
+	if identity.Admin {
+		return "", nil
+	}
+	return identity.UserID, nil
+}
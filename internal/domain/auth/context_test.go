@@ -0,0 +1,59 @@
+//go:build unit
+// +build unit
+
+package auth
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestUserIDFromContext(t *testing.T) {
+	ctx := WithUserID(context.Background(), "user-1")
+
+	userID, err := UserIDFromContext(ctx)
+	require.NoError(t, err)
+	assert.Equal(t, "user-1", userID)
+}
+
+func TestUserIDFromContext_Unauthenticated(t *testing.T) {
+	_, err := UserIDFromContext(context.Background())
+	assert.ErrorIs(t, err, ErrUnauthenticated)
+
+	_, err = UserIDFromContext(WithUserID(context.Background(), ""))
+	assert.ErrorIs(t, err, ErrUnauthenticated)
+}
+
+func TestIdentityFromContext(t *testing.T) {
+	ctx := WithIdentity(context.Background(), &Identity{UserID: "admin-1", Admin: true})
+
+	identity, err := IdentityFromContext(ctx)
+	require.NoError(t, err)
+	assert.Equal(t, "admin-1", identity.UserID)
+	assert.True(t, identity.Admin)
+
+	_, err = IdentityFromContext(WithIdentity(context.Background(), nil))
+	assert.ErrorIs(t, err, ErrUnauthenticated)
+}
+
+func TestAuthorizeAdmin(t *testing.T) {
+	assert.NoError(t, AuthorizeAdmin(WithIdentity(context.Background(), &Identity{UserID: "admin-1", Admin: true})))
+	assert.ErrorIs(t, AuthorizeAdmin(WithUserID(context.Background(), "user-1")), ErrForbidden)
+	assert.ErrorIs(t, AuthorizeAdmin(context.Background()), ErrUnauthenticated)
+}
+
+func TestOwnerScope(t *testing.T) {
+	scope, err := OwnerScope(WithUserID(context.Background(), "user-1"))
+	require.NoError(t, err)
+	assert.Equal(t, "user-1", scope)
+
+	scope, err = OwnerScope(WithIdentity(context.Background(), &Identity{UserID: "admin-1", Admin: true}))
+	require.NoError(t, err)
+	assert.Empty(t, scope)
+
+	_, err = OwnerScope(context.Background())
+	assert.ErrorIs(t, err, ErrUnauthenticated)
+}
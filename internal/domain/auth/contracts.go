@@ -0,0 +1,10 @@
+package auth
+
+import "context"
+
+// TokenValidator defines the method for validating bearer tokens presented by callers
+type TokenValidator interface {
+	// Validate verifies the signature and claims of a token and returns the identity of the caller.
+	// It returns an error if the token is malformed, expired, not signed by a trusted key or issued for another audience.
+	Validate(ctx context.Context, token string) (*Identity, error)
+}
@@ -0,0 +1,2 @@
+// Package auth defines the contracts for authenticating callers and carries the authenticated identity through context.Context.
+package auth
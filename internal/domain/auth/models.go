@@ -0,0 +1,11 @@
+package auth
+
+// Identity represents an authenticated caller
+type Identity struct {
+	// UserID identifies the caller and is recorded as owner of the blobs and keys it creates
+	UserID string
+	// Admin callers are not restricted to the blobs and keys they own
+	Admin bool
+	// Groups the caller is a member of, which obtain the access blobs were shared with
+	Groups []string
+}
@@ -2,19 +2,23 @@ package blobs
 
 import (
 	"context"
+	"io"
 	"mime/multipart"
+	"time"
 )
 
 // BlobUploadService defines methods for uploading blobs.
 type BlobUploadService interface {
 	// Upload transfers blobs with the option to encrypt them using an encryption key or sign them with a signing key.
+	// The blobs are owned by the authenticated caller carried by ctx.
 	// It returns a slice of Blob for the uploaded blobs and any error encountered during the upload process.
-	Upload(ctx context.Context, form *multipart.Form, userID string, encryptionKeyID, signKeyID *string) ([]*BlobMeta, error)
+	Upload(ctx context.Context, form *multipart.Form, encryptionKeyID, signKeyID *string) ([]*BlobMeta, error)
 }
 
 // BlobMetadataService defines methods for retrieving Blob and deleting a blob along with its metadata.
+// Callers carried by ctx may only access the blobs the permission management grants them access to.
 type BlobMetadataService interface {
-	// List retrieves all blobs' metadata considering a query filter when set.
+	// List retrieves all blobs' metadata considering a query filter when set, restricted to the caller's blobs unless the caller is an admin.
 	// It returns a slice of Blob and any error encountered during the retrieval.
 	List(ctx context.Context, query *BlobMetaQuery) ([]*BlobMeta, error)
 
@@ -28,9 +32,55 @@ type BlobMetadataService interface {
 }
 
 // BlobDownloadService defines methods for downloading blobs.
+// Callers carried by ctx may only access the blobs and use the keys the permission management grants them access to.
 type BlobDownloadService interface {
 	// The download function retrieves a blob's content using its ID and also enables data decryption.
-	DownloadByID(ctx context.Context, blobID string, decryptionKeyID *string) ([]byte, error)
+	// It returns a stream of the content, which must be closed by the caller.
+	DownloadByID(ctx context.Context, blobID string, decryptionKeyID *string) (io.ReadCloser, error)
+
+	// VerifyByID checks the detached signature of a blob against the public key of the key pair it was signed with.
+	// Encrypted blobs are decrypted with the decryption key before verification.
+	// It returns whether the signature matches the content and any error encountered during the verification.
+	VerifyByID(ctx context.Context, blobID string, decryptionKeyID *string) (bool, error)
+}
+
+// BlobGrantService defines methods for sharing blobs with other users or groups.
+// Callers carried by ctx may only manage the grants of blobs the permission management permits them to share, usually the blobs they own.
+type BlobGrantService interface {
+	// Create shares a blob with the grantee, replacing any grant the grantee already holds for the blob.
+	// Download grants of encrypted blobs permit decrypting the blob with the key pair it was encrypted with.
+	// It returns the created BlobGrant and any error encountered during the creation.
+	Create(ctx context.Context, grant *BlobGrant) (*BlobGrant, error)
+
+	// List retrieves all grants of a blob that have not expired.
+	// It returns a slice of BlobGrant and any error encountered during the retrieval.
+	List(ctx context.Context, blobID string) ([]*BlobGrant, error)
+
+	// Delete revokes the grant the grantee holds for a blob.
+	// It returns any error encountered during the revocation, including if the grantee holds no grant.
+	Delete(ctx context.Context, blobID, granteeType, granteeID string) error
+}
+
+// BlobLinkService defines methods for issuing pre-signed links, which permit downloading a blob without a bearer token until they expire.
+type BlobLinkService interface {
+	// Create issues a link delegating the download access of the caller carried by ctx to the holder of its token,
+	// optionally bound to a key the blob is decrypted with and to a single use.
+	// Links carry only the caller's user ID, so access obtained through the admin role or groups of the caller is not delegated.
+	// It returns the BlobLink, its signed token and any error encountered while issuing the link.
+	Create(ctx context.Context, blobID string, decryptionKeyID *string, singleUse bool, validity time.Duration) (*BlobLink, string, error)
+
+	// Redeem verifies a token presented for downloading a blob and returns the link it was issued for.
+	// Single-use links are claimed atomically, so concurrent redemptions of a single-use link succeed only once.
+	// It returns an error if the token is forged, expired, revoked or issued for another blob, or if its issuer may no longer download the blob.
+	Redeem(ctx context.Context, blobID, token string) (*BlobLink, error)
+
+	// Release lifts the claim of a redeemed single-use link once the download it authorized failed, so failed or aborted downloads do not use it up.
+	// Links revoked in the meantime stay revoked. It returns any error encountered while lifting the claim.
+	Release(ctx context.Context, link *BlobLink) error
+
+	// Revoke invalidates a link issued for a blob before it expires.
+	// It returns any error encountered during the revocation.
+	Revoke(ctx context.Context, blobID, linkID string) error
 }
 
 // BlobRepository defines the interface for Blob-related operations
@@ -40,4 +90,21 @@ type BlobRepository interface {
 	GetByID(ctx context.Context, blobID string) (*BlobMeta, error)
 	UpdateByID(ctx context.Context, blob *BlobMeta) error
 	DeleteByID(ctx context.Context, blobID string) error
+	CountByKeyIDs(ctx context.Context, keyIDs []string) (int64, error)
+}
+
+// BlobLinkSigningKeyRepository defines the interface for BlobLinkSigningKey-related operations
+type BlobLinkSigningKeyRepository interface {
+	Create(ctx context.Context, signingKey *BlobLinkSigningKey) error
+	GetFirst(ctx context.Context) (*BlobLinkSigningKey, error)
+	List(ctx context.Context) ([]*BlobLinkSigningKey, error)
+	UpdateByID(ctx context.Context, signingKey *BlobLinkSigningKey) error
+}
+
+// BlobLinkRevocationRepository defines the interface for BlobLinkRevocation-related operations
+type BlobLinkRevocationRepository interface {
+	Create(ctx context.Context, revocation *BlobLinkRevocation) (bool, error)
+	Exists(ctx context.Context, linkID string) (bool, error)
+	DeleteClaim(ctx context.Context, linkID string) error
+	DeleteExpired(ctx context.Context, before time.Time) error
 }
@@ -14,7 +14,7 @@ import (
 type BlobMeta struct {
 	ID              string             `gorm:"primaryKey" validate:"required,uuid4"`            // ID is required and must be a valid UUID
 	DateTimeCreated time.Time          `validate:"required"`                                    // DateTimeCreated is required
-	UserID          string             `validate:"required,uuid4"`                              // UserID is required and must be a valid UUID
+	UserID          string             `gorm:"index" validate:"required,min=1,max=255"`         // UserID is required and is the subject of the uploading caller
 	Name            string             `validate:"required,min=1,max=255"`                      // Name is required, and its length must be between 1 and 255 characters
 	Size            int64              `validate:"required,min=1"`                              // Size must be greater than 0
 	Type            string             `validate:"required,min=1,max=50"`                       // Type is required, and its length must be between 1 and 50 characters
@@ -22,6 +22,7 @@ type BlobMeta struct {
 	EncryptionKeyID *string            `validate:"omitempty,uuid4"`                             // EncryptionKeyID is optional and if set must be a valid UUID
 	SignKey         keys.CryptoKeyMeta `gorm:"foreignKey:SignKeyID" validate:"omitempty"`       // SignKey is optional
 	SignKeyID       *string            `validate:"omitempty,uuid4"`                             // SignKeyID is optional and if set must be a valid UUID
+	SignatureName   *string            `validate:"omitempty,min=1,max=255"`                     // SignatureName is the name of the detached signature stored next to the blob when signed
 }
 
 // Validate for validating BlobMeta struct
@@ -47,3 +48,136 @@ func (b *BlobMeta) Validate() error {
 
 	return nil
 }
+
+// Grantee types a blob can be shared with
+const (
+	GranteeTypeUser  = "user"
+	GranteeTypeGroup = "group"
+)
+
+// Access levels a blob can be shared with
+const (
+	AccessView     = "view"     // AccessView permits retrieving the metadata of the blob
+	AccessDownload = "download" // AccessDownload permits retrieving the metadata, downloading and verifying the blob
+)
+
+// BlobGrant represents the access an owner shared a blob with to another user or group
+type BlobGrant struct {
+	BlobID          string     `validate:"required,uuid4"`                        // BlobID is required and must be a valid UUID
+	GranteeType     string     `validate:"required,oneof=user group"`             // GranteeType is required and must be either user or group
+	GranteeID       string     `validate:"required,min=1,max=255,excludesall=:#"` // GranteeID is required and must not contain ':' or '#'
+	Access          string     `validate:"required,oneof=view download"`          // Access is required and must be either view or download
+	DateTimeExpires *time.Time `validate:"omitempty"`                             // DateTimeExpires is optional, the grant does not expire if unset
+	DateTimeCreated time.Time  `validate:"required"`                              // DateTimeCreated is required
+}
+
+// Validate for validating BlobGrant struct
+func (g *BlobGrant) Validate() error {
+	validate := validator.New()
+
+	err := validate.Struct(g)
+	if err != nil {
+		var validationErrors validator.ValidationErrors
+		if errors.As(err, &validationErrors) {
+			var messages []string
+			for _, fieldErr := range validationErrors {
+				messages = append(messages, fmt.Sprintf("Field: %s, Tag: %s", fieldErr.Field(), fieldErr.Tag()))
+			}
+			return fmt.Errorf("validation failed: %v", messages)
+		}
+		return fmt.Errorf("validation error: %w", err)
+	}
+
+	return nil
+}
+
+// MaxBlobLinkValidity is the longest period a pre-signed blob link may be issued for
+const MaxBlobLinkValidity = 24 * time.Hour
+
+// BlobLink represents a pre-signed link, which delegates the issuer's access to download a single blob to the holder of its token.
+// The link is not stored but signed into the token, hence its fields are encoded as JSON.
+type BlobLink struct {
+	ID              string    `json:"id" validate:"required,uuid4"`                           // ID is required and must be a valid UUID
+	BlobID          string    `json:"blob_id" validate:"required,uuid4"`                      // BlobID is required and must be a valid UUID
+	UserID          string    `json:"user_id" validate:"required,min=1,max=255"`              // UserID is the issuer the download is authorized for
+	DecryptionKeyID *string   `json:"decryption_key_id,omitempty" validate:"omitempty,uuid4"` // DecryptionKeyID is optional and if set the blob is decrypted with the key
+	SingleUse       bool      `json:"single_use,omitempty"`                                   // SingleUse links are revoked when redeemed
+	DateTimeExpires time.Time `json:"date_time_expires" validate:"required"`                  // DateTimeExpires is required
+}
+
+// Validate for validating BlobLink struct
+func (l *BlobLink) Validate() error {
+	validate := validator.New()
+
+	err := validate.Struct(l)
+	if err != nil {
+		var validationErrors validator.ValidationErrors
+		if errors.As(err, &validationErrors) {
+			var messages []string
+			for _, fieldErr := range validationErrors {
+				messages = append(messages, fmt.Sprintf("Field: %s, Tag: %s", fieldErr.Field(), fieldErr.Tag()))
+			}
+			return fmt.Errorf("validation failed: %v", messages)
+		}
+		return fmt.Errorf("validation error: %w", err)
+	}
+
+	return nil
+}
+
+// BlobLinkSigningKey records where the secret signing blob links is stored in the vault, wrapped with the master key
+type BlobLinkSigningKey struct {
+	ID              string    `gorm:"primaryKey" validate:"required,uuid4"` // ID of the secret in the vault
+	KeyPairID       string    `validate:"required,uuid4"`                   // KeyPairID the secret is stored below in the vault
+	KEKVersion      uint32    `validate:"required,min=1"`                   // KEKVersion of the master key the secret is wrapped with
+	DateTimeCreated time.Time `gorm:"index" validate:"required"`            // DateTimeCreated is required
+}
+
+// Validate for validating BlobLinkSigningKey struct
+func (k *BlobLinkSigningKey) Validate() error {
+	validate := validator.New()
+
+	err := validate.Struct(k)
+	if err != nil {
+		var validationErrors validator.ValidationErrors
+		if errors.As(err, &validationErrors) {
+			var messages []string
+			for _, fieldErr := range validationErrors {
+				messages = append(messages, fmt.Sprintf("Field: %s, Tag: %s", fieldErr.Field(), fieldErr.Tag()))
+			}
+			return fmt.Errorf("validation failed: %v", messages)
+		}
+		return fmt.Errorf("validation error: %w", err)
+	}
+
+	return nil
+}
+
+// BlobLinkRevocation represents a blob link that may no longer be redeemed, either revoked explicitly or used up as single-use link
+type BlobLinkRevocation struct {
+	LinkID          string    `gorm:"primaryKey" validate:"required,uuid4"` // LinkID is required and must be a valid UUID
+	BlobID          string    `gorm:"index" validate:"required,uuid4"`      // BlobID is required and must be a valid UUID
+	DateTimeExpires time.Time `gorm:"index" validate:"required"`            // DateTimeExpires after which the link is invalid anyway and the revocation can be pruned
+	DateTimeRevoked time.Time `validate:"required"`                         // DateTimeRevoked is required
+	Claimed         bool      `gorm:"default:false"`                        // Claimed marks the claim of a redeemed single-use link, lifted again if its download fails
+}
+
+// Validate for validating BlobLinkRevocation struct
+func (r *BlobLinkRevocation) Validate() error {
+	validate := validator.New()
+
+	err := validate.Struct(r)
+	if err != nil {
+		var validationErrors validator.ValidationErrors
+		if errors.As(err, &validationErrors) {
+			var messages []string
+			for _, fieldErr := range validationErrors {
+				messages = append(messages, fmt.Sprintf("Field: %s, Tag: %s", fieldErr.Field(), fieldErr.Tag()))
+			}
+			return fmt.Errorf("validation failed: %v", messages)
+		}
+		return fmt.Errorf("validation error: %w", err)
+	}
+
+	return nil
+}
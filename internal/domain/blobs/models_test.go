@@ -5,6 +5,7 @@ package blobs
 
 import (
 	"crypto_vault_service/internal/domain/keys"
+	"strings"
 	"testing"
 	"time"
 
@@ -40,7 +41,7 @@ func NewBlobValidationTests() *BlobValidationTests {
 	invalidBlob := BlobMeta{
 		ID:              "", // Invalid empty ID
 		DateTimeCreated: time.Now(),
-		UserID:          "invalid-uuid", // Invalid UserID
+		UserID:          strings.Repeat("u", 256), // Invalid UserID exceeding 255 characters
 		Name:            "test_blobs.txt",
 		Size:            -12345, // Invalid Size (negative)
 		Type:            "text",
@@ -81,7 +82,7 @@ func (bt *BlobValidationTests) TestBlobValidation(t *testing.T) {
 	assert.NotNil(t, err, "Expected validation errors for invalid Blob")
 	assert.Contains(t, err.Error(), "Field: ID, Tag: required")
 	assert.Contains(t, err.Error(), "Field: Size, Tag: min")
-	assert.Contains(t, err.Error(), "Field: UserID, Tag: uuid4")
+	assert.Contains(t, err.Error(), "Field: UserID, Tag: max")
 }
 
 // TestBlobValidationEdgeCases tests validation edge cases for Blob
@@ -92,6 +93,80 @@ func (bt *BlobValidationTests) TestBlobValidationEdgeCases(t *testing.T) {
 	assert.Contains(t, err.Error(), "Field: Name, Tag: required")
 }
 
+// TestBlobGrantValidation tests the Validator method for BlobGrant
+func (bt *BlobValidationTests) TestBlobGrantValidation(t *testing.T) {
+	expires := time.Now().Add(time.Hour)
+	validGrant := BlobGrant{
+		BlobID:          bt.validBlob.ID,
+		GranteeType:     GranteeTypeGroup,
+		GranteeID:       "auditors",
+		Access:          AccessView,
+		DateTimeExpires: &expires,
+		DateTimeCreated: time.Now(),
+	}
+	assert.Nil(t, validGrant.Validate(), "Expected no validation errors for valid BlobGrant")
+
+	invalidGrant := BlobGrant{
+		BlobID:          "invalid-uuid",
+		GranteeType:     "team",
+		GranteeID:       "user_group:admins#admin",
+		Access:          "delete",
+		DateTimeCreated: time.Now(),
+	}
+	err := invalidGrant.Validate()
+	assert.NotNil(t, err, "Expected validation errors for invalid BlobGrant")
+	assert.Contains(t, err.Error(), "Field: BlobID, Tag: uuid4")
+	assert.Contains(t, err.Error(), "Field: GranteeType, Tag: oneof")
+	assert.Contains(t, err.Error(), "Field: GranteeID, Tag: excludesall")
+	assert.Contains(t, err.Error(), "Field: Access, Tag: oneof")
+}
+
+// TestBlobLinkValidation tests the validation of blob links and the records they are signed and revoked by
+func (bt *BlobValidationTests) TestBlobLinkValidation(t *testing.T) {
+	decryptionKeyID := uuid.New().String()
+	validLink := BlobLink{
+		ID:              uuid.New().String(),
+		BlobID:          bt.validBlob.ID,
+		UserID:          bt.validBlob.UserID,
+		DecryptionKeyID: &decryptionKeyID,
+		SingleUse:       true,
+		DateTimeExpires: time.Now().Add(time.Hour),
+	}
+	assert.Nil(t, validLink.Validate(), "Expected no validation errors for valid BlobLink")
+
+	invalidKeyID := "invalid-uuid"
+	invalidLink := BlobLink{
+		ID:              "invalid-uuid",
+		BlobID:          bt.validBlob.ID,
+		DecryptionKeyID: &invalidKeyID,
+	}
+	err := invalidLink.Validate()
+	assert.NotNil(t, err, "Expected validation errors for invalid BlobLink")
+	assert.Contains(t, err.Error(), "Field: ID, Tag: uuid4")
+	assert.Contains(t, err.Error(), "Field: UserID, Tag: required")
+	assert.Contains(t, err.Error(), "Field: DecryptionKeyID, Tag: uuid4")
+	assert.Contains(t, err.Error(), "Field: DateTimeExpires, Tag: required")
+
+	signingKey := BlobLinkSigningKey{
+		ID:              uuid.New().String(),
+		KeyPairID:       uuid.New().String(),
+		DateTimeCreated: time.Now(),
+	}
+	err = signingKey.Validate()
+	assert.NotNil(t, err, "Expected validation errors for BlobLinkSigningKey not wrapped with the master key")
+	assert.Contains(t, err.Error(), "Field: KEKVersion, Tag: required")
+
+	revocation := BlobLinkRevocation{
+		LinkID:          validLink.ID,
+		BlobID:          "invalid-uuid",
+		DateTimeExpires: validLink.DateTimeExpires,
+		DateTimeRevoked: time.Now(),
+	}
+	err = revocation.Validate()
+	assert.NotNil(t, err, "Expected validation errors for invalid BlobLinkRevocation")
+	assert.Contains(t, err.Error(), "Field: BlobID, Tag: uuid4")
+}
+
 // TestBlobValidation is the entry point to run the Blob validation tests
 func TestBlobValidation(t *testing.T) {
 	// Create a new BlobValidationTests instance
@@ -100,4 +175,6 @@ func TestBlobValidation(t *testing.T) {
 	// Run each test method
 	t.Run("TestBlobValidation", bt.TestBlobValidation)
 	t.Run("TestBlobValidationEdgeCases", bt.TestBlobValidationEdgeCases)
+	t.Run("TestBlobGrantValidation", bt.TestBlobGrantValidation)
+	t.Run("TestBlobLinkValidation", bt.TestBlobLinkValidation)
 }
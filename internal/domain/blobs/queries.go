@@ -14,6 +14,7 @@ type BlobMetaQuery struct {
 	Name            string    `validate:"omitempty,min=1,max=255"` // Name is optional and its length must be between 1 and 255 characters
 	Size            int64     `validate:"omitempty,min=1"`         // Size is optional and if set must be greater than 0
 	Type            string    `validate:"omitempty,min=1,max=50"`  // Type is optional, and its length must be between 1 and 50 characters
+	UserID          string    `validate:"omitempty,min=1,max=255"` // UserID is optional and restricts the results to the blobs owned by the user
 
 	// Pagination properties
 	Limit  int `validate:"omitempty,min=1"` // Limit is optional but if provided, should be at least 1
@@ -26,6 +26,8 @@ func TestBlobMetaQuery_ValidCases(t *testing.T) {
 		{Name: "file", Type: "json", Limit: 1},
 		{SortBy: "type", SortOrder: "asc"},
 		{DateTimeCreated: time.Now(), Size: 1, SortBy: "date_time_created"},
+		{UserID: "a3d6c4c7-6a0e-4f4a-9c61-2f1f7b0f7e21"},
+		{UserID: "auth0|user-1"},
 	}
 
 	for _, tc := range validCases {
@@ -59,6 +61,10 @@ func TestBlobMetaQuery_InvalidCases(t *testing.T) {
 			name:  "type too long",
 			query: BlobMetaQuery{Type: string(make([]byte, 51))},
 		},
+		{
+			name:  "user id too long",
+			query: BlobMetaQuery{UserID: string(make([]byte, 256))},
+		},
 	}
 
 	for _, tc := range invalidCases {
@@ -1,2 +1,2 @@
-// Package crypto ...
+// Package crypto defines the core interfaces for cryptographic operations performed with stored keys
 package crypto
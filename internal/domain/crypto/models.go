@@ -0,0 +1,31 @@
+package crypto
+
+// Operations performed by the CryptoOperationService
+const (
+	OperationEncrypt = "encrypt"
+	OperationDecrypt = "decrypt"
+	OperationSign    = "sign"
+	OperationVerify  = "verify"
+	OperationWrap    = "wrap"
+	OperationUnwrap  = "unwrap"
+)
+
+// MaxPayloadSize is the maximum size in bytes of a payload passed to a cryptographic operation.
+// Larger data is expected to be uploaded as blob instead.
+const MaxPayloadSize = 1 << 20
+
+// OperationResult represents the outcome of a cryptographic operation.
+// Only the output of the performed operation is set.
+type OperationResult struct {
+	Operation  string `json:"operation"`
+	KeyID      string `json:"key_id"`      // ID of the key the operation was requested with
+	KeyPairID  string `json:"key_pair_id"` // ID of the key pair whose private or public key performed the operation
+	KeyVersion uint32 `json:"key_version"` // Version of the logical key the key pair forms, 0 for keys predating versioning
+	Algorithm  string `json:"algorithm"`
+	Ciphertext []byte `json:"ciphertext,omitempty"`
+	Plaintext  []byte `json:"plaintext,omitempty"`
+	Signature  []byte `json:"signature,omitempty"`
+	WrappedKey []byte `json:"wrapped_key,omitempty"`
+	Key        []byte `json:"key,omitempty"`
+	Valid      *bool  `json:"valid,omitempty"`
+}
@@ -1,17 +1,34 @@
 package keys
 
-import "context"
+import (
+	"context"
+	"time"
+)
 
 // CryptoKeyUploadService defines methods for uploading cryptographic keys.
 type CryptoKeyUploadService interface {
-	// Upload uploads cryptographic keys
+	// Upload uploads cryptographic keys owned by the authenticated caller carried by ctx
+	// The keys are active from now on unless lifecycle sets them pre-active or schedules their activation, and expire as scheduled by lifecycle if set.
 	// It returns a slice of CryptoKeyMeta and any error encountered during the upload process.
-	Upload(ctx context.Context, userID, keyAlgorihm string, keySize uint32) ([]*CryptoKeyMeta, error)
+	Upload(ctx context.Context, keyAlgorihm string, keySize uint32, lifecycle *CryptoKeyLifecycle) ([]*CryptoKeyMeta, error)
+}
+
+// CryptoKeyImportService defines methods for importing key material generated outside the service (bring your own key).
+type CryptoKeyImportService interface {
+	// GetImportPublicKey returns the PEM encoded RSA public key clients wrap key material with before importing it wrapped.
+	// The key material is wrapped as specified by cryptography.RSAAESKeyWrap.
+	GetImportPublicKey(ctx context.Context) ([]byte, error)
+
+	// Import imports key material owned by the authenticated caller carried by ctx, forming version 1 of a new logical key.
+	// Private keys are imported along with their public key, whereas public keys imported alone only encrypt, verify and wrap.
+	// The keys are active from now on unless lifecycle sets them pre-active or schedules their activation, and expire as scheduled by lifecycle if set.
+	Import(ctx context.Context, keyImport *CryptoKeyImport, lifecycle *CryptoKeyLifecycle) ([]*CryptoKeyMeta, error)
 }
 
 // CryptoKeyMetadataService defines methods for managing cryptographic key metadata and deleting keys.
+// Callers carried by ctx may only access the keys the permission management grants them access to.
 type CryptoKeyMetadataService interface {
-	// List retrieves all cryptographic keys metadata considering a query filter when set.
+	// List retrieves all cryptographic keys metadata considering a query filter when set, restricted to the caller's keys unless the caller is an admin.
 	// It returns a slice of CryptoKeyMeta and any error encountered during the retrieval process.
 	List(ctx context.Context, query *CryptoKeyQuery) ([]*CryptoKeyMeta, error)
 
@@ -19,16 +36,37 @@ type CryptoKeyMetadataService interface {
 	// It returns the CryptoKeyMeta and any error encountered during the retrieval process.
 	GetByID(ctx context.Context, keyID string) (*CryptoKeyMeta, error)
 
-	// DeleteByID deletes a cryptographic key and its associated metadata by ID.
-	// It returns any error encountered during the deletion process.
-	DeleteByID(ctx context.Context, keyID string) error
+	// DeleteByID schedules the destruction of the keys of the key pair the given key belongs to once the grace period has passed.
+	// It fails with ErrKeyInUse while blobs are protected with any key of the pair unless force is set.
+	// It returns the updated metadata of all keys of the pair and any error encountered while scheduling the destruction.
+	DeleteByID(ctx context.Context, keyID string, force bool) ([]*CryptoKeyMeta, error)
+
+	// CancelDestruction cancels the scheduled destruction of the keys of the key pair the given key belongs to, provided the grace period has not passed yet.
+	// It returns the updated metadata of all keys of the pair and any error encountered during the cancellation.
+	CancelDestruction(ctx context.Context, keyID string) ([]*CryptoKeyMeta, error)
+
+	// UpdateLifecycle transitions the keys of the key pair the given key belongs to into the state of lifecycle if set, and reschedules their activation and expiry if set.
+	// Destroying keys schedules their destruction like DeleteByID and fails with ErrKeyInUse while blobs are protected with any key of the pair unless lifecycle.Force is set.
+	// It returns the updated metadata of all keys of the pair and any error encountered during the update.
+	UpdateLifecycle(ctx context.Context, keyID string, lifecycle *CryptoKeyLifecycle) ([]*CryptoKeyMeta, error)
+
+	// UpdateExportPolicy sets whether the material of the keys of the key pair the given key belongs to may only be exported wrapped.
+	// Forbidding plaintext export cannot be undone, clearing it fails with ErrExportForbidden.
+	// It returns the updated metadata of all keys of the pair and any error encountered during the update.
+	UpdateExportPolicy(ctx context.Context, keyID string, plaintextExportForbidden bool) ([]*CryptoKeyMeta, error)
 }
 
 // CryptoKeyDownloadService defines methods for downloading cryptographic keys.
+// Callers carried by ctx may only download the keys the permission management grants them access to.
 type CryptoKeyDownloadService interface {
 	// Download retrieves a cryptographic key by its ID
 	// It returns the CryptoKeyMeta, the key data as a byte slice, and any error encountered during the download process.
+	// Private and symmetric keys whose export policy forbids plaintext export are refused with ErrExportForbidden.
 	DownloadByID(ctx context.Context, keyID string) ([]byte, error)
+
+	// ExportWrappedByID retrieves a cryptographic key by its ID wrapped with the key selected by wrapping, so that its material never leaves the service in plaintext.
+	// It returns the WrappedKeyExport and any error encountered during the export.
+	ExportWrappedByID(ctx context.Context, keyID string, wrapping *KeyExportWrapping) (*WrappedKeyExport, error)
 }
 
 // CryptoKeyRepository defines the interface for CryptoKey-related operations
@@ -38,4 +76,85 @@ type CryptoKeyRepository interface {
 	GetByID(ctx context.Context, keyID string) (*CryptoKeyMeta, error)
 	UpdateByID(ctx context.Context, key *CryptoKeyMeta) error
 	DeleteByID(ctx context.Context, keyID string) error
+	ListDueForDestruction(ctx context.Context, before time.Time, limit int) ([]*CryptoKeyMeta, error)
+	ListBelowKEKVersion(ctx context.Context, kekVersion uint32, limit int) ([]*CryptoKeyMeta, error)
+	UpdateKEKVersion(ctx context.Context, keyID string, kekVersion, newKEKVersion uint32) (bool, error)
+}
+
+// CryptoKeyDestructionService defines methods for destroying cryptographic keys whose scheduled destruction is due.
+type CryptoKeyDestructionService interface {
+	// DestroyDue deletes the material of all keys whose scheduled destruction is due from the vault and records them as destroyed, keeping their metadata.
+	// It returns the number of destroyed keys and any error encountered during the destruction.
+	DestroyDue(ctx context.Context) (int, error)
+
+	// Run calls DestroyDue periodically until ctx is done.
+	Run(ctx context.Context)
+}
+
+// CryptoKeyRotationService defines methods for rotating the versions of logical keys.
+// Any key of a version identifies its logical key, callers carried by ctx must be permitted to manage it.
+type CryptoKeyRotationService interface {
+	// Rotate generates a new version of the logical key the given key is a version of and makes it the primary version.
+	// Keys predating versioning become version 1 of a new logical key on their first rotation.
+	// It returns the metadata of the keys of the new version and any error encountered during the rotation.
+	Rotate(ctx context.Context, keyID string) ([]*CryptoKeyMeta, error)
+
+	// ListVersions retrieves the metadata of the keys of all versions of the logical key the given key is a version of, newest version first.
+	// It returns a slice of CryptoKeyMeta and any error encountered during the retrieval process.
+	ListVersions(ctx context.Context, keyID string) ([]*CryptoKeyMeta, error)
+
+	// UpdateRotationPolicy sets the period after which the logical key the given key is a version of is rotated automatically, a period of 0 disables automatic rotation.
+	// It returns the updated LogicalKey and any error encountered during the update.
+	UpdateRotationPolicy(ctx context.Context, keyID string, rotationPeriod time.Duration) (*LogicalKey, error)
+
+	// RotateDue rotates all logical keys whose automatic rotation is due.
+	// It returns the number of rotated logical keys and any error encountered during the rotation.
+	RotateDue(ctx context.Context) (int, error)
+
+	// Run calls RotateDue periodically until ctx is done.
+	Run(ctx context.Context)
+}
+
+// LogicalKeyRepository defines the interface for LogicalKey-related operations
+type LogicalKeyRepository interface {
+	Create(ctx context.Context, logicalKey *LogicalKey) error
+	GetByID(ctx context.Context, logicalKeyID string) (*LogicalKey, error)
+	UpdateByID(ctx context.Context, logicalKey *LogicalKey) error
+	ReserveVersion(ctx context.Context, logicalKeyID string, latestVersion uint32, nextRotation *time.Time) error
+	PromoteVersion(ctx context.Context, logicalKeyID string, version uint32, keyPairID string, rotated time.Time) error
+	UpdateRotationPolicy(ctx context.Context, logicalKeyID string, rotationPeriod time.Duration, nextRotation *time.Time) error
+	ListDueForRotation(ctx context.Context, before time.Time, limit int) ([]*LogicalKey, error)
+}
+
+// KeyImportKeyRepository defines the interface for KeyImportKey-related operations
+type KeyImportKeyRepository interface {
+	Create(ctx context.Context, importKey *KeyImportKey) error
+	GetFirst(ctx context.Context) (*KeyImportKey, error)
+	List(ctx context.Context) ([]*KeyImportKey, error)
+	UpdateByID(ctx context.Context, importKey *KeyImportKey) error
+}
+
+// MasterKeyRotationService defines methods for rotating the master key wrapping all stored cryptographic keys.
+// Rotate and GetByID are restricted to admins.
+type MasterKeyRotationService interface {
+	// Rotate introduces a new master key version and starts re-wrapping all stored keys in the background.
+	// A failed rotation is resumed with the keys it left to re-wrap instead of introducing another version.
+	// It returns the MasterKeyRotation tracking the progress and any error encountered while starting the rotation.
+	Rotate(ctx context.Context) (*MasterKeyRotation, error)
+
+	// GetByID retrieves a master key rotation by its unique ID.
+	// It returns the MasterKeyRotation and any error encountered during the retrieval process.
+	GetByID(ctx context.Context, rotationID string) (*MasterKeyRotation, error)
+
+	// Resume continues re-wrapping for a rotation left running, e.g. after a crash, or failed, with the keys it left to re-wrap.
+	// It returns any error encountered while looking up unfinished rotations.
+	Resume(ctx context.Context) error
+}
+
+// MasterKeyRotationRepository defines the interface for MasterKeyRotation-related operations
+type MasterKeyRotationRepository interface {
+	Create(ctx context.Context, rotation *MasterKeyRotation) error
+	GetByID(ctx context.Context, rotationID string) (*MasterKeyRotation, error)
+	GetUnfinished(ctx context.Context) (*MasterKeyRotation, error)
+	UpdateByID(ctx context.Context, rotation *MasterKeyRotation) error
 }
@@ -9,15 +9,118 @@ import (
 	"github.com/go-playground/validator/v10"
 )
 
+// Lifecycle states of cryptographic keys following NIST SP 800-57 Part 1, section 7
+const (
+	KeyStatePreActive   = "pre-active"  // The key has been generated but may not be used yet
+	KeyStateActive      = "active"      // The key may be used to protect and to process data
+	KeyStateSuspended   = "suspended"   // The key is temporarily not used to protect data, but may still process it
+	KeyStateDeactivated = "deactivated" // The key is no longer used to protect data, but may still process data protected before
+	KeyStateDestroyed   = "destroyed"   // The key material has been deleted, only the metadata is kept
+)
+
+// Usages of cryptographic keys restricted by their lifecycle state
+const (
+	KeyUsageProtect = "protect" // Applying cryptographic protection, i.e. encrypting, signing and wrapping
+	KeyUsageProcess = "process" // Processing protected data, i.e. decrypting, verifying and unwrapping
+)
+
+// ErrKeyState is returned when the lifecycle state of a key does not permit the requested usage or transition
+var ErrKeyState = errors.New("key state does not permit the operation")
+
+// ErrKeyInUse is returned when a key cannot be destroyed because blobs are still protected with it
+var ErrKeyInUse = errors.New("key is still in use")
+
+// ErrConcurrentRotation is returned when the version of a logical key to be rotated was taken by a concurrent rotation
+var ErrConcurrentRotation = errors.New("logical key was rotated concurrently")
+
+// ErrExportForbidden is returned when the export policy of a key forbids exporting its material in plaintext,
+// or when the key material cannot be exported at all because it never leaves the token it was generated on
+var ErrExportForbidden = errors.New("plaintext export of key is forbidden")
+
+// keyStateTransitions lists the states each lifecycle state may transition to
+var keyStateTransitions = map[string][]string{
+	KeyStatePreActive:   {KeyStateActive, KeyStateDestroyed},
+	KeyStateActive:      {KeyStateSuspended, KeyStateDeactivated},
+	KeyStateSuspended:   {KeyStateActive, KeyStateDeactivated},
+	KeyStateDeactivated: {KeyStateDestroyed},
+}
+
 // CryptoKeyMeta represents the encryption key entity
 type CryptoKeyMeta struct {
-	ID              string    `gorm:"primaryKey" validate:"required,uuid4"`
-	KeyPairID       string    `gorm:"index" validate:"required,uuid4"`
-	Algorithm       string    `validate:"omitempty,oneof=AES RSA EC"`
-	KeySize         uint32    `json:"key_size" validate:"omitempty,keySizeValidation"`
-	Type            string    `validate:"omitempty,oneof=private public symmetric"`
-	DateTimeCreated time.Time `validate:"required"`
-	UserID          string    `gorm:"index" validate:"required,uuid4"`
+	ID                       string     `gorm:"primaryKey" validate:"required,uuid4"`
+	KeyPairID                string     `gorm:"index" validate:"required,uuid4"`
+	Algorithm                string     `validate:"omitempty,oneof=AES RSA EC"`
+	KeySize                  uint32     `json:"key_size" validate:"omitempty,keySizeValidation"`
+	Type                     string     `validate:"omitempty,oneof=private public symmetric"`
+	DateTimeCreated          time.Time  `validate:"required"`
+	UserID                   string     `gorm:"index" validate:"required,min=1,max=255"`
+	KEKVersion               uint32     `json:"kek_version"`                                                                                     // Version of the master key wrapping the stored key. 0 marks keys stored unwrapped
+	State                    string     `gorm:"index" json:"state" validate:"omitempty,oneof=pre-active active suspended deactivated destroyed"` // Recorded lifecycle state, see EffectiveState. Empty for keys predating lifecycle states, which are active
+	DateTimeActivation       *time.Time `json:"date_time_activation"`                                                                            // Time from which a pre-active key is active
+	DateTimeExpires          *time.Time `json:"date_time_expires"`                                                                               // Time from which an active or suspended key is deactivated
+	DateTimeDestruction      *time.Time `gorm:"index" json:"date_time_destruction"`                                                              // Time from which a key scheduled for destruction is destroyed. Nil unless destruction is scheduled
+	LogicalKeyID             string     `gorm:"index" json:"logical_key_id" validate:"omitempty,uuid4"`                                          // ID of the LogicalKey the key pair is a version of. Empty for keys predating versioning until their first rotation
+	Version                  uint32     `json:"version"`                                                                                         // Version of the LogicalKey the key pair forms. 0 for keys predating versioning until their first rotation
+	PlaintextExportForbidden bool       `json:"plaintext_export_forbidden"`                                                                      // Whether private and symmetric key material may only be exported wrapped. Once set it cannot be cleared
+	TokenLabel               string     `json:"token_label"`                                                                                     // Label of the PKCS#11 token the key was generated on. Empty for keys stored in the vault
+	TokenObjectLabel         string     `json:"token_object_label" validate:"required_with=TokenLabel"`                                          // Label of the key object on the token, shared by the private and public key of a key pair
+}
+
+// TokenResident reports whether the key was generated on a PKCS#11 token.
+// The private keys of such key pairs are not extractable and never leave the token, which performs all operations with them.
+func (k *CryptoKeyMeta) TokenResident() bool {
+	return k.TokenLabel != ""
+}
+
+// EffectiveState returns the lifecycle state of the key at the given time.
+// The recorded state advances from pre-active to active once the activation date has passed,
+// and from active or suspended to deactivated once the expiry date has passed.
+// Keys are destroyed once their scheduled destruction date has passed, even if their material has not been deleted yet.
+func (k *CryptoKeyMeta) EffectiveState(now time.Time) string {
+	state := k.State
+	if state == "" {
+		state = KeyStateActive
+	}
+
+	if state == KeyStatePreActive && k.DateTimeActivation != nil && !now.Before(*k.DateTimeActivation) {
+		state = KeyStateActive
+	}
+	if (state == KeyStateActive || state == KeyStateSuspended) && k.DateTimeExpires != nil && !now.Before(*k.DateTimeExpires) {
+		state = KeyStateDeactivated
+	}
+	if k.DateTimeDestruction != nil && !now.Before(*k.DateTimeDestruction) {
+		state = KeyStateDestroyed
+	}
+
+	return state
+}
+
+// CheckUsage returns an error wrapping ErrKeyState unless the key may be used for the given usage at the given time.
+// Only active keys protect data, whereas suspended and deactivated keys may still process data protected before.
+// Keys scheduled for destruction no longer protect data.
+func (k *CryptoKeyMeta) CheckUsage(usage string, now time.Time) error {
+	state := k.EffectiveState(now)
+	switch {
+	case usage == KeyUsageProtect && state == KeyStateActive && k.DateTimeDestruction != nil:
+		return fmt.Errorf("%w: key %s is scheduled for destruction and cannot be used to %s data", ErrKeyState, k.ID, usage)
+	case state == KeyStateActive:
+		return nil
+	case usage == KeyUsageProcess && (state == KeyStateSuspended || state == KeyStateDeactivated):
+		return nil
+	default:
+		return fmt.Errorf("%w: key %s is %s and cannot be used to %s data", ErrKeyState, k.ID, state, usage)
+	}
+}
+
+// CheckTransition returns an error wrapping ErrKeyState unless the key may transition from its state at the given time to the target state
+func (k *CryptoKeyMeta) CheckTransition(targetState string, now time.Time) error {
+	state := k.EffectiveState(now)
+	for _, allowedState := range keyStateTransitions[state] {
+		if allowedState == targetState {
+			return nil
+		}
+	}
+	return fmt.Errorf("%w: key %s cannot transition from %s to %s", ErrKeyState, k.ID, state, targetState)
 }
 
 // Validate method for CryptoKeyMeta struct
@@ -43,3 +146,195 @@ func (k *CryptoKeyMeta) Validate() error {
 
 	return nil
 }
+
+// MasterKeyRotation represents the progress of re-wrapping all stored keys with a new master key version
+type MasterKeyRotation struct {
+	ID               string    `gorm:"primaryKey" validate:"required,uuid4"`
+	TargetKEKVersion uint32    `json:"target_kek_version" validate:"required,min=1"`
+	Status           string    `gorm:"index" validate:"required,oneof=running completed failed"`
+	Offset           int       `json:"offset" validate:"min=0"`         // Number of key metadata entries processed so far
+	RewrappedKeys    int       `json:"rewrapped_keys" validate:"min=0"` // Number of keys re-wrapped with the target KEK version
+	Error            string    `json:"error"`
+	DateTimeStarted  time.Time `validate:"required"`
+	DateTimeUpdated  time.Time `validate:"required"`
+}
+
+// Validate method for MasterKeyRotation struct
+func (r *MasterKeyRotation) Validate() error {
+	validate := validator.New()
+
+	err := validate.Struct(r)
+	if err != nil {
+		var validationErrors validator.ValidationErrors
+		if errors.As(err, &validationErrors) {
+			var messages []string
+			for _, fieldErr := range validationErrors {
+				messages = append(messages, fmt.Sprintf("Field: %s, Tag: %s", fieldErr.Field(), fieldErr.Tag()))
+			}
+			return fmt.Errorf("validation failed: %v", messages)
+		}
+		return fmt.Errorf("validation error: %w", err)
+	}
+
+	return nil
+}
+
+// LogicalKey represents a cryptographic key whose material is rotated over time.
+// Every version is a key pair, or a single symmetric key, of the same algorithm and key size.
+// The primary version protects new data, whereas all versions keep processing data protected before.
+type LogicalKey struct {
+	ID                   string        `gorm:"primaryKey" validate:"required,uuid4"` // Equals the KeyPairID of the first version
+	UserID               string        `gorm:"index" validate:"required,min=1,max=255"`
+	Algorithm            string        `validate:"required,oneof=AES RSA EC"`
+	KeySize              uint32        `json:"key_size" validate:"required"`
+	PrimaryVersion       uint32        `json:"primary_version" validate:"required,min=1"`
+	PrimaryKeyPairID     string        `json:"primary_key_pair_id" validate:"required,uuid4"` // KeyPairID of the primary version
+	LatestVersion        uint32        `json:"latest_version" validate:"required,gtefield=PrimaryVersion"`
+	RotationPeriod       time.Duration `json:"rotation_period" validate:"omitempty,min=24h"` // Period after which a new version is rotated in automatically. 0 disables automatic rotation
+	DateTimeNextRotation *time.Time    `gorm:"index" json:"date_time_next_rotation"`         // Time of the next automatic rotation. Nil unless a rotation period is set
+	DateTimeCreated      time.Time     `validate:"required"`
+	DateTimeRotated      *time.Time    `json:"date_time_rotated"` // Time of the last rotation. Nil until the key is rotated
+}
+
+// Validate method for LogicalKey struct
+func (l *LogicalKey) Validate() error {
+	validate := validator.New()
+
+	err := validate.Struct(l)
+	if err != nil {
+		var validationErrors validator.ValidationErrors
+		if errors.As(err, &validationErrors) {
+			var messages []string
+			for _, fieldErr := range validationErrors {
+				messages = append(messages, fmt.Sprintf("Field: %s, Tag: %s", fieldErr.Field(), fieldErr.Tag()))
+			}
+			return fmt.Errorf("validation failed: %v", messages)
+		}
+		return fmt.Errorf("validation error: %w", err)
+	}
+
+	return nil
+}
+
+// CryptoKeyImport represents key material generated outside the service, which is imported instead of generated (bring your own key)
+type CryptoKeyImport struct {
+	Algorithm   string `json:"algorithm" validate:"required,oneof=AES RSA EC"`
+	Format      string `json:"format" validate:"required,oneof=pem der jwk raw"` // Encoding of the key material: PEM or DER encoded PKCS#1, PKCS#8, SEC1 or PKIX keys, JWK, or raw AES keys
+	KeyMaterial []byte `json:"key_material" validate:"required"`
+	Wrapped     bool   `json:"wrapped"` // Whether the key material is wrapped with the key import public key, so that it never travels in plaintext
+}
+
+// Validate method for CryptoKeyImport struct
+func (i *CryptoKeyImport) Validate() error {
+	validate := validator.New()
+
+	err := validate.Struct(i)
+	if err != nil {
+		var validationErrors validator.ValidationErrors
+		if errors.As(err, &validationErrors) {
+			var messages []string
+			for _, fieldErr := range validationErrors {
+				messages = append(messages, fmt.Sprintf("Field: %s, Tag: %s", fieldErr.Field(), fieldErr.Tag()))
+			}
+			return fmt.Errorf("validation failed: %v", messages)
+		}
+		return fmt.Errorf("validation error: %w", err)
+	}
+
+	return nil
+}
+
+// KeyImportKey records where the RSA private key unwrapping imported key material is stored in the vault, wrapped with the master key
+type KeyImportKey struct {
+	ID              string    `gorm:"primaryKey" validate:"required,uuid4"` // ID of the private key in the vault
+	KeyPairID       string    `validate:"required,uuid4"`                   // KeyPairID the private key is stored below in the vault
+	KEKVersion      uint32    `validate:"required,min=1"`                   // KEKVersion of the master key the private key is wrapped with
+	DateTimeCreated time.Time `gorm:"index" validate:"required"`            // DateTimeCreated is required
+}
+
+// Validate method for KeyImportKey struct
+func (k *KeyImportKey) Validate() error {
+	validate := validator.New()
+
+	err := validate.Struct(k)
+	if err != nil {
+		var validationErrors validator.ValidationErrors
+		if errors.As(err, &validationErrors) {
+			var messages []string
+			for _, fieldErr := range validationErrors {
+				messages = append(messages, fmt.Sprintf("Field: %s, Tag: %s", fieldErr.Field(), fieldErr.Tag()))
+			}
+			return fmt.Errorf("validation failed: %v", messages)
+		}
+		return fmt.Errorf("validation error: %w", err)
+	}
+
+	return nil
+}
+
+// KeyExportWrapping selects the key wrapping key material on export, either a vault AES key or an RSA public key supplied by the caller
+type KeyExportWrapping struct {
+	WrappingKeyID string `json:"wrapping_key_id" validate:"omitempty,uuid4,excluded_with=PublicKey"` // ID of an AES key of the vault, key material is wrapped with AES Key Wrap with Padding (RFC 5649)
+	PublicKey     []byte `json:"public_key" validate:"required_without=WrappingKeyID"`               // PEM or DER encoded PKIX or PKCS#1 RSA public key, key material is wrapped with RSA-OAEP
+}
+
+// Validate method for KeyExportWrapping struct
+func (w *KeyExportWrapping) Validate() error {
+	validate := validator.New()
+
+	err := validate.Struct(w)
+	if err != nil {
+		var validationErrors validator.ValidationErrors
+		if errors.As(err, &validationErrors) {
+			var messages []string
+			for _, fieldErr := range validationErrors {
+				messages = append(messages, fmt.Sprintf("Field: %s, Tag: %s", fieldErr.Field(), fieldErr.Tag()))
+			}
+			return fmt.Errorf("validation failed: %v", messages)
+		}
+		return fmt.Errorf("validation error: %w", err)
+	}
+
+	return nil
+}
+
+// WrappedKeyExport holds key material exported wrapped along with how it is wrapped
+type WrappedKeyExport struct {
+	KeyID             string // ID of the exported key
+	WrappingAlgorithm string // Algorithm the key material is wrapped with
+	WrappingKeyID     string // ID of the vault key the key material is wrapped with. Empty when wrapped with a public key supplied by the caller
+	WrappedKey        []byte
+}
+
+// CryptoKeyLifecycle represents the requested lifecycle of a cryptographic key, either when creating the key or when changing it later on.
+// Unset fields are left unchanged.
+type CryptoKeyLifecycle struct {
+	State              string     `json:"state" validate:"omitempty,oneof=pre-active active suspended deactivated destroyed"`
+	DateTimeActivation *time.Time `json:"date_time_activation"`
+	DateTimeExpires    *time.Time `json:"date_time_expires"`
+	Force              bool       `json:"force"` // Schedule the destruction even if blobs are still protected with the keys
+}
+
+// Validate method for CryptoKeyLifecycle struct
+func (l *CryptoKeyLifecycle) Validate() error {
+	validate := validator.New()
+
+	err := validate.Struct(l)
+	if err != nil {
+		var validationErrors validator.ValidationErrors
+		if errors.As(err, &validationErrors) {
+			var messages []string
+			for _, fieldErr := range validationErrors {
+				messages = append(messages, fmt.Sprintf("Field: %s, Tag: %s", fieldErr.Field(), fieldErr.Tag()))
+			}
+			return fmt.Errorf("validation failed: %v", messages)
+		}
+		return fmt.Errorf("validation error: %w", err)
+	}
+
+	if l.DateTimeActivation != nil && l.DateTimeExpires != nil && !l.DateTimeExpires.After(*l.DateTimeActivation) {
+		return fmt.Errorf("expiry %s must be after activation %s", l.DateTimeExpires.Format(time.RFC3339), l.DateTimeActivation.Format(time.RFC3339))
+	}
+
+	return nil
+}
@@ -4,6 +4,7 @@
 package keys
 
 import (
+	"errors"
 	"testing"
 	"time"
 
@@ -58,4 +59,252 @@ func TestCryptoKeyValidations(t *testing.T) {
 	err := invalidKey.Validate()
 	assert.NotNil(t, err, "Expected validation error for missing UserID")
 	assert.Contains(t, err.Error(), "Field: UserID, Tag: required")
+
+	// Test UserID being a JWT subject that is no UUID (should pass)
+	invalidKey.UserID = "auth0|user-1"
+	err = invalidKey.Validate()
+	assert.Nil(t, err, "Expected no validation errors for a non-UUID UserID")
+}
+
+// TestCryptoKeyTokenResident tests the validation and detection of keys generated on a PKCS#11 token
+func TestCryptoKeyTokenResident(t *testing.T) {
+	key := CryptoKeyMeta{
+		ID:              uuid.New().String(),
+		KeyPairID:       uuid.New().String(),
+		Type:            "private",
+		KeySize:         2048,
+		Algorithm:       "RSA",
+		DateTimeCreated: time.Now(),
+		UserID:          uuid.New().String(),
+	}
+	assert.False(t, key.TokenResident())
+
+	key.TokenLabel = "vault-keys"
+	err := key.Validate()
+	assert.NotNil(t, err, "Expected validation error for missing TokenObjectLabel")
+	assert.Contains(t, err.Error(), "Field: TokenObjectLabel, Tag: required_with")
+
+	key.TokenObjectLabel = key.KeyPairID
+	assert.Nil(t, key.Validate())
+	assert.True(t, key.TokenResident())
+}
+
+// TestMasterKeyRotationValidation tests the Validator method for MasterKeyRotation
+func TestMasterKeyRotationValidation(t *testing.T) {
+	validRotation := MasterKeyRotation{
+		ID:               uuid.New().String(),
+		TargetKEKVersion: 2,
+		Status:           "running",
+		DateTimeStarted:  time.Now(),
+		DateTimeUpdated:  time.Now(),
+	}
+
+	err := validRotation.Validate()
+	assert.Nil(t, err, "Expected no validation errors for valid MasterKeyRotation")
+
+	invalidRotation := MasterKeyRotation{
+		ID:              uuid.New().String(),
+		Status:          "paused", // Invalid Status
+		Offset:          -1,       // Invalid negative Offset
+		DateTimeStarted: time.Now(),
+		DateTimeUpdated: time.Now(),
+	}
+
+	err = invalidRotation.Validate()
+	assert.NotNil(t, err, "Expected validation errors for invalid MasterKeyRotation")
+	assert.Contains(t, err.Error(), "Field: TargetKEKVersion, Tag: required")
+	assert.Contains(t, err.Error(), "Field: Status, Tag: oneof")
+	assert.Contains(t, err.Error(), "Field: Offset, Tag: min")
+}
+
+// TestCryptoKeyEffectiveState tests how activation and expiry dates advance the recorded lifecycle state
+func TestCryptoKeyEffectiveState(t *testing.T) {
+	now := time.Now()
+	past := now.Add(-time.Hour)
+	future := now.Add(time.Hour)
+
+	tests := []struct {
+		name       string
+		state      string
+		activation *time.Time
+		expires    *time.Time
+		expected   string
+	}{
+		{"Legacy key", "", nil, nil, KeyStateActive},
+		{"Pre-active before activation", KeyStatePreActive, &future, nil, KeyStatePreActive},
+		{"Pre-active without activation", KeyStatePreActive, nil, nil, KeyStatePreActive},
+		{"Pre-active after activation", KeyStatePreActive, &past, nil, KeyStateActive},
+		{"Pre-active after activation and expiry", KeyStatePreActive, &past, &past, KeyStateDeactivated},
+		{"Active before expiry", KeyStateActive, &past, &future, KeyStateActive},
+		{"Active after expiry", KeyStateActive, &past, &past, KeyStateDeactivated},
+		{"Suspended after expiry", KeyStateSuspended, nil, &past, KeyStateDeactivated},
+		{"Destroyed", KeyStateDestroyed, &past, &past, KeyStateDestroyed},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			key := CryptoKeyMeta{State: tt.state, DateTimeActivation: tt.activation, DateTimeExpires: tt.expires}
+			assert.Equal(t, tt.expected, key.EffectiveState(now))
+		})
+	}
+}
+
+// TestCryptoKeyCheckUsage tests which lifecycle states permit protecting and processing data
+func TestCryptoKeyCheckUsage(t *testing.T) {
+	now := time.Now()
+
+	tests := []struct {
+		state   string
+		protect bool
+		process bool
+	}{
+		{KeyStatePreActive, false, false},
+		{KeyStateActive, true, true},
+		{KeyStateSuspended, false, true},
+		{KeyStateDeactivated, false, true},
+		{KeyStateDestroyed, false, false},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.state, func(t *testing.T) {
+			key := CryptoKeyMeta{ID: uuid.New().String(), State: tt.state}
+
+			err := key.CheckUsage(KeyUsageProtect, now)
+			assert.Equal(t, tt.protect, err == nil)
+			if err != nil {
+				assert.True(t, errors.Is(err, ErrKeyState))
+			}
+
+			err = key.CheckUsage(KeyUsageProcess, now)
+			assert.Equal(t, tt.process, err == nil)
+			if err != nil {
+				assert.True(t, errors.Is(err, ErrKeyState))
+			}
+		})
+	}
+}
+
+// TestCryptoKeyScheduledDestruction tests that keys scheduled for destruction only process data until they are destroyed
+func TestCryptoKeyScheduledDestruction(t *testing.T) {
+	now := time.Now()
+	future := now.Add(time.Hour)
+	past := now.Add(-time.Hour)
+
+	key := CryptoKeyMeta{ID: uuid.New().String(), State: KeyStateActive, DateTimeDestruction: &future}
+	assert.Equal(t, KeyStateActive, key.EffectiveState(now))
+	err := key.CheckUsage(KeyUsageProtect, now)
+	assert.True(t, errors.Is(err, ErrKeyState))
+	assert.NoError(t, key.CheckUsage(KeyUsageProcess, now))
+
+	key.DateTimeDestruction = &past
+	assert.Equal(t, KeyStateDestroyed, key.EffectiveState(now))
+	err = key.CheckUsage(KeyUsageProcess, now)
+	assert.True(t, errors.Is(err, ErrKeyState))
+}
+
+// TestCryptoKeyCheckTransition tests the permitted transitions between lifecycle states
+func TestCryptoKeyCheckTransition(t *testing.T) {
+	now := time.Now()
+
+	allowed := []struct{ from, to string }{
+		{KeyStatePreActive, KeyStateActive},
+		{KeyStatePreActive, KeyStateDestroyed},
+		{KeyStateActive, KeyStateSuspended},
+		{KeyStateActive, KeyStateDeactivated},
+		{KeyStateSuspended, KeyStateActive},
+		{KeyStateSuspended, KeyStateDeactivated},
+		{KeyStateDeactivated, KeyStateDestroyed},
+	}
+	for _, tt := range allowed {
+		key := CryptoKeyMeta{State: tt.from}
+		assert.NoError(t, key.CheckTransition(tt.to, now), "%s -> %s", tt.from, tt.to)
+	}
+
+	rejected := []struct{ from, to string }{
+		{KeyStateActive, KeyStateDestroyed},
+		{KeyStateActive, KeyStatePreActive},
+		{KeyStateSuspended, KeyStateDestroyed},
+		{KeyStateDeactivated, KeyStateActive},
+		{KeyStateDestroyed, KeyStateActive},
+		{KeyStateActive, KeyStateActive},
+	}
+	for _, tt := range rejected {
+		key := CryptoKeyMeta{State: tt.from}
+		err := key.CheckTransition(tt.to, now)
+		assert.True(t, errors.Is(err, ErrKeyState), "%s -> %s", tt.from, tt.to)
+	}
+
+	// Expired keys are deactivated and can only be destroyed
+	past := now.Add(-time.Hour)
+	expiredKey := CryptoKeyMeta{State: KeyStateActive, DateTimeExpires: &past}
+	assert.Error(t, expiredKey.CheckTransition(KeyStateSuspended, now))
+	assert.NoError(t, expiredKey.CheckTransition(KeyStateDestroyed, now))
+}
+
+// TestCryptoKeyLifecycleValidation tests the Validator method for CryptoKeyLifecycle
+func TestCryptoKeyLifecycleValidation(t *testing.T) {
+	activation := time.Now().Add(time.Hour)
+	expires := activation.Add(24 * time.Hour)
+
+	validLifecycle := CryptoKeyLifecycle{State: KeyStatePreActive, DateTimeActivation: &activation, DateTimeExpires: &expires}
+	assert.Nil(t, validLifecycle.Validate(), "Expected no validation errors for valid CryptoKeyLifecycle")
+
+	invalidLifecycle := CryptoKeyLifecycle{State: "compromised"}
+	err := invalidLifecycle.Validate()
+	assert.NotNil(t, err, "Expected validation errors for invalid CryptoKeyLifecycle")
+	assert.Contains(t, err.Error(), "Field: State, Tag: oneof")
+
+	invalidDates := CryptoKeyLifecycle{DateTimeActivation: &expires, DateTimeExpires: &activation}
+	err = invalidDates.Validate()
+	assert.NotNil(t, err, "Expected validation error for expiry before activation")
+	assert.Contains(t, err.Error(), "must be after activation")
+}
+
+// TestCryptoKeyImportValidation tests the Validator method for CryptoKeyImport
+func TestCryptoKeyImportValidation(t *testing.T) {
+	validImport := CryptoKeyImport{Algorithm: "AES", Format: "raw", KeyMaterial: make([]byte, 32)}
+	assert.Nil(t, validImport.Validate(), "Expected no validation errors for valid CryptoKeyImport")
+
+	invalidImport := CryptoKeyImport{Algorithm: "DES", Format: "pkcs12"}
+	err := invalidImport.Validate()
+	assert.NotNil(t, err, "Expected validation errors for invalid CryptoKeyImport")
+	assert.Contains(t, err.Error(), "Field: Algorithm, Tag: oneof")
+	assert.Contains(t, err.Error(), "Field: Format, Tag: oneof")
+	assert.Contains(t, err.Error(), "Field: KeyMaterial, Tag: required")
+}
+
+// TestKeyImportKeyValidation tests the Validator method for KeyImportKey
+func TestKeyImportKeyValidation(t *testing.T) {
+	validImportKey := KeyImportKey{
+		ID:              uuid.New().String(),
+		KeyPairID:       uuid.New().String(),
+		KEKVersion:      1,
+		DateTimeCreated: time.Now(),
+	}
+	assert.Nil(t, validImportKey.Validate(), "Expected no validation errors for valid KeyImportKey")
+
+	invalidImportKey := KeyImportKey{
+		ID:              uuid.New().String(),
+		KeyPairID:       "invalid-uuid",
+		DateTimeCreated: time.Now(),
+	}
+	err := invalidImportKey.Validate()
+	assert.NotNil(t, err, "Expected validation errors for invalid KeyImportKey")
+	assert.Contains(t, err.Error(), "Field: KeyPairID, Tag: uuid4")
+	assert.Contains(t, err.Error(), "Field: KEKVersion, Tag: required")
+}
+
+func TestKeyExportWrappingValidation(t *testing.T) {
+	wrappingKeyID := uuid.New().String()
+
+	assert.Nil(t, (&KeyExportWrapping{WrappingKeyID: wrappingKeyID}).Validate(), "Expected no validation errors for a vault wrapping key")
+	assert.Nil(t, (&KeyExportWrapping{PublicKey: []byte("public key")}).Validate(), "Expected no validation errors for a public wrapping key")
+
+	err := (&KeyExportWrapping{}).Validate()
+	assert.NotNil(t, err, "Expected validation errors without wrapping key")
+	assert.Contains(t, err.Error(), "Field: PublicKey, Tag: required_without")
+
+	err = (&KeyExportWrapping{WrappingKeyID: wrappingKeyID, PublicKey: []byte("public key")}).Validate()
+	assert.NotNil(t, err, "Expected validation errors with both wrapping keys")
+	assert.Contains(t, err.Error(), "Field: WrappingKeyID, Tag: excluded_with")
 }
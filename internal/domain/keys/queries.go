@@ -10,17 +10,22 @@ import (
 
 // CryptoKeyQuery represents the parameters used to query encryption keys.
 type CryptoKeyQuery struct {
+	KeyPairID       string    `validate:"omitempty,uuid4"`                          // KeyPairID is optional but if provided, must be a valid UUID
 	Algorithm       string    `validate:"omitempty,oneof=AES RSA EC"`               // Type is optional but if provided, must be one of the listed types (AES, RSA, EC)
 	Type            string    `validate:"omitempty,oneof=private public symmetric"` // Type is optional but if provided, must be one of the listed types (private-key, public-key, symmetric-key)
 	DateTimeCreated time.Time `validate:"omitempty,gtefield=date_time_created"`     // DateTimeCreated is optional, but can be used for filtering
+	UserID          string    `validate:"omitempty,min=1,max=255"`                  // UserID is optional and restricts the results to the keys owned by the user
+	LogicalKeyID    string    `validate:"omitempty,uuid4"`                          // LogicalKeyID is optional and restricts the results to the versions of the logical key
+	Version         uint32    `validate:"omitempty,min=1"`                          // Version is optional and restricts the results to the given version of a logical key
+	Wrapped         bool      // Wrapped is optional and restricts the results to the keys wrapped with the master key
 
 	// Pagination properties
 	Limit  int `validate:"omitempty,min=1"` // Limit is optional but if provided, should be at least 1
 	Offset int `validate:"omitempty,min=0"` // Offset is optional but should be 0 or greater for pagination
 
 	// Sorting properties
-	SortBy    string `validate:"omitempty,oneof=ID type date_time_created"` // SortBy is optional but can be one of the fields to sort by
-	SortOrder string `validate:"omitempty,oneof=asc desc"`                  // SortOrder is optional, default is ascending ('asc'), can also be 'desc'
+	SortBy    string `validate:"omitempty,oneof=ID type date_time_created version"` // SortBy is optional but can be one of the fields to sort by
+	SortOrder string `validate:"omitempty,oneof=asc desc"`                          // SortOrder is optional, default is ascending ('asc'), can also be 'desc'
 }
 
 // NewCryptoKeyQuery creates a CryptoKeyQuery with default values
@@ -24,6 +24,8 @@ func TestCryptoKeyQuery_ValidCases(t *testing.T) {
 		{Algorithm: "AES", Type: "private", Limit: 5, Offset: 0, SortBy: "ID", SortOrder: "desc"},
 		{Algorithm: "RSA", Type: "public", SortBy: "type", SortOrder: "asc"},
 		{Algorithm: "EC", Limit: 1, Offset: 0},
+		{UserID: "a3d6c4c7-6a0e-4f4a-9c61-2f1f7b0f7e21"},
+		{UserID: "auth0|user-1"},
 	}
 
 	for _, tc := range validCases {
@@ -53,6 +55,10 @@ func TestCryptoKeyQuery_InvalidCases(t *testing.T) {
 			name:  "invalid sortOrder",
 			query: CryptoKeyQuery{SortOrder: "ascending"},
 		},
+		{
+			name:  "user id too long",
+			query: CryptoKeyQuery{UserID: string(make([]byte, 256))},
+		},
 		{
 			name:  "offset negative",
 			query: CryptoKeyQuery{Offset: -5},
@@ -1,7 +1,28 @@
 package permissions
 
-// PoC with OpenFGA required for signatures
+import "context"
 
-// PermissionManagement Interface
+// PermissionManagement defines methods for checking and managing the relationships between users and objects.
+// The methods follow the OpenFGA API, so implementations may evaluate the model locally or delegate to an OpenFGA server.
 type PermissionManagement interface {
+	// Check reports whether the user has the relation or permission on the object, considering the contextual tuples of the request.
+	// It returns an error if the object type or relation is not defined by the model.
+	Check(ctx context.Context, request *CheckRequest) (bool, error)
+
+	// WriteTuples stores relationship tuples. Tuples which already exist keep their creation time and take the expiry of the written tuple.
+	// It returns an error if a tuple relates a user to an object in a way not permitted by the model.
+	WriteTuples(ctx context.Context, tuples []*RelationTuple) error
+
+	// DeleteTuples removes relationship tuples. Tuples which do not exist are ignored.
+	DeleteTuples(ctx context.Context, tuples []*RelationTuple) error
+
+	// ReadTuples retrieves the relationship tuples matching the query.
+	ReadTuples(ctx context.Context, query *RelationTupleQuery) ([]*RelationTuple, error)
+}
+
+// RelationTupleRepository defines the interface for RelationTuple-related operations
+type RelationTupleRepository interface {
+	Create(ctx context.Context, tuples []*RelationTuple) error
+	List(ctx context.Context, query *RelationTupleQuery) ([]*RelationTuple, error)
+	Delete(ctx context.Context, tuples []*RelationTuple) error
 }
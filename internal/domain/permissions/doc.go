@@ -1,2 +1,3 @@
-// Package permissions ...
+// Package permissions defines the relationship-based authorization model and the interfaces for checking and managing permissions.
+// It follows the OpenFGA model in docs/diagrams/openfga.dsl, in which users relate to blobs and keys through relationship tuples.
 package permissions
@@ -0,0 +1,157 @@
+package permissions
+
+import (
+	"fmt"
+	"strings"
+)
+
+// Object types of the authorization model
+const (
+	TypeUser      = "user"
+	TypeUserGroup = "user_group"
+	TypeBlob      = "blob"
+	TypeKey       = "key"
+)
+
+// Relations which are assigned through relationship tuples
+const (
+	RelationOwner   = "owner"
+	RelationGrantee = "grantee"
+	RelationViewer  = "viewer"
+	RelationAdmin   = "admin"
+	RelationMember  = "member"
+)
+
+// Permissions of blobs, which are computed from the relations
+const (
+	PermissionViewBlob     = "can_view_blob"
+	PermissionDownloadBlob = "can_download_blob"
+	PermissionDeleteBlob   = "can_delete_blob"
+	PermissionVerifyBlob   = "can_verify_blob"
+	PermissionShareBlob    = "can_share_blob"
+)
+
+// Permissions of keys, which are computed from the relations
+const (
+	PermissionManageKey = "can_manage_key"
+	PermissionUseKey    = "can_use_key"
+)
+
+// AdminsGroup is the user group whose admins are admins of every blob and key.
+// Callers authenticated as admins are related to it by contextual tuples.
+var AdminsGroup = TypeUserGroup + ":admins"
+
+// RelationDefinition defines how a user obtains a relation on an object
+type RelationDefinition struct {
+	// DirectlyAssignable lists the user types tuples may assign the relation to, e.g. user or the userset user_group#owner
+	DirectlyAssignable []string
+	// Union lists the relations on the same object implying the relation
+	Union []string
+}
+
+// TypeDefinition defines the relations of an object type by name
+type TypeDefinition map[string]RelationDefinition
+
+// AuthorizationModel defines the object types by name
+type AuthorizationModel map[string]TypeDefinition
+
+// DefaultModel is the authorization model of docs/diagrams/openfga.dsl
+var DefaultModel = AuthorizationModel{
+	TypeUser: {},
+	TypeUserGroup: {
+		RelationOwner:   {DirectlyAssignable: []string{TypeUser}},
+		RelationGrantee: {DirectlyAssignable: []string{TypeUser}},
+		RelationAdmin:   {DirectlyAssignable: []string{TypeUser}},
+		RelationMember:  {DirectlyAssignable: []string{TypeUser}},
+	},
+	TypeKey: {
+		"manage_cryptographic_keys":     {Union: []string{RelationAdmin}},
+		"create_own_cryptographic_keys": {Union: []string{RelationOwner}},
+		PermissionManageKey:             {Union: []string{RelationOwner, "manage_cryptographic_keys"}},
+		PermissionUseKey:                {Union: []string{RelationOwner, "manage_cryptographic_keys"}},
+
+		RelationOwner: {DirectlyAssignable: []string{TypeUser, TypeUserGroup + "#" + RelationOwner}},
+		RelationAdmin: {DirectlyAssignable: []string{TypeUser, TypeUserGroup + "#" + RelationAdmin}},
+	},
+	TypeBlob: {
+		"can_manage_all_blobs":                     {Union: []string{RelationAdmin}},
+		"can_manage_own_blobs":                     {Union: []string{RelationOwner}},
+		"can_download_blobs_with_given_permission": {Union: []string{RelationGrantee}},
+		"can_view_blobs_with_given_permission":     {Union: []string{RelationGrantee, RelationViewer}},
+
+		"encrypt_decrypt_own_files":        {Union: []string{RelationOwner}},
+		"generate_signature_for_own_files": {Union: []string{RelationOwner}},
+		"verify_file_signature":            {Union: []string{RelationOwner, RelationGrantee}},
+
+		"can_grant_access_to_download_owned_blobs": {Union: []string{RelationOwner}},
+		"can_grant_access_to_view_owned_blobs":     {Union: []string{RelationOwner}},
+
+		PermissionViewBlob:     {Union: []string{"can_manage_all_blobs", "can_manage_own_blobs", "can_view_blobs_with_given_permission"}},
+		PermissionDownloadBlob: {Union: []string{"can_manage_all_blobs", "can_manage_own_blobs", "can_download_blobs_with_given_permission"}},
+		PermissionDeleteBlob:   {Union: []string{"can_manage_all_blobs", "can_manage_own_blobs"}},
+		PermissionVerifyBlob:   {Union: []string{"can_manage_all_blobs", "verify_file_signature"}},
+		PermissionShareBlob:    {Union: []string{"can_manage_all_blobs", "can_grant_access_to_download_owned_blobs", "can_grant_access_to_view_owned_blobs"}},
+
+		RelationOwner:   {DirectlyAssignable: []string{TypeUser, TypeUserGroup + "#" + RelationOwner}},
+		RelationGrantee: {DirectlyAssignable: []string{TypeUser, TypeUserGroup + "#" + RelationGrantee, TypeUserGroup + "#" + RelationMember}},
+		RelationViewer:  {DirectlyAssignable: []string{TypeUser, TypeUserGroup + "#" + RelationMember}},
+		RelationAdmin:   {DirectlyAssignable: []string{TypeUser, TypeUserGroup + "#" + RelationAdmin}},
+	},
+}
+
+// Relation returns the definition of a relation of an object type
+func (m AuthorizationModel) Relation(objectType, relation string) (RelationDefinition, error) {
+	typeDefinition, ok := m[objectType]
+	if !ok {
+		return RelationDefinition{}, fmt.Errorf("unknown object type '%s'", objectType)
+	}
+	relationDefinition, ok := typeDefinition[relation]
+	if !ok {
+		return RelationDefinition{}, fmt.Errorf("unknown relation '%s' of object type '%s'", relation, objectType)
+	}
+	return relationDefinition, nil
+}
+
+// ValidateTuple checks that the model permits the tuple to relate its user to its object
+func (m AuthorizationModel) ValidateTuple(tuple *RelationTuple) error {
+	if err := tuple.Validate(); err != nil {
+		return fmt.Errorf("%w", err)
+	}
+
+	objectType, _ := SplitObject(tuple.Object)
+	relationDefinition, err := m.Relation(objectType, tuple.Relation)
+	if err != nil {
+		return fmt.Errorf("%w", err)
+	}
+
+	userType := tuple.UserType()
+	for _, assignableType := range relationDefinition.DirectlyAssignable {
+		if assignableType == userType {
+			return nil
+		}
+	}
+	return fmt.Errorf("relation '%s' of object type '%s' cannot be assigned to '%s'", tuple.Relation, objectType, userType)
+}
+
+// Validate checks that all relations referenced by the model are defined
+func (m AuthorizationModel) Validate() error {
+	for objectType, typeDefinition := range m {
+		for relation, relationDefinition := range typeDefinition {
+			for _, computedRelation := range relationDefinition.Union {
+				if _, ok := typeDefinition[computedRelation]; !ok {
+					return fmt.Errorf("relation '%s' of object type '%s' references unknown relation '%s'", relation, objectType, computedRelation)
+				}
+			}
+			for _, assignableType := range relationDefinition.DirectlyAssignable {
+				userType, userRelation, isUserset := strings.Cut(assignableType, "#")
+				if _, ok := m[userType]; !ok {
+					return fmt.Errorf("relation '%s' of object type '%s' is assignable to unknown type '%s'", relation, objectType, userType)
+				}
+				if _, ok := m[userType][userRelation]; isUserset && !ok {
+					return fmt.Errorf("relation '%s' of object type '%s' is assignable to unknown userset '%s'", relation, objectType, assignableType)
+				}
+			}
+		}
+	}
+	return nil
+}
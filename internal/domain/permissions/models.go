@@ -0,0 +1,106 @@
+package permissions
+
+import (
+	"errors"
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/go-playground/validator/v10"
+)
+
+// RelationTuple relates a user to an object, written in the OpenFGA notation type:id.
+// Users are either single users like user:{userId} or usersets like user_group:{groupId}#admin,
+// which relate all users having the relation on the group to the object.
+type RelationTuple struct {
+	Object          string     `gorm:"primaryKey" validate:"required,max=255,contains=:"`                      // Object is the object users relate to, e.g. blob:{blobId}
+	Relation        string     `gorm:"primaryKey" validate:"required,max=100"`                                 // Relation is the relation the user has on the object, e.g. owner
+	User            string     `gorm:"primaryKey;column:subject;index" validate:"required,max=255,contains=:"` // User is the user or userset relating to the object
+	DateTimeCreated time.Time  `validate:"required"`                                                           // DateTimeCreated is required
+	DateTimeExpires *time.Time `validate:"omitempty"`                                                          // DateTimeExpires is optional, expired tuples are not considered by checks
+}
+
+// NewRelationTuple creates a RelationTuple relating the user to the object
+func NewRelationTuple(user, relation, object string) *RelationTuple {
+	return &RelationTuple{
+		Object:          object,
+		Relation:        relation,
+		User:            user,
+		DateTimeCreated: time.Now(),
+	}
+}
+
+// Expired reports whether the tuple has expired at the given time
+func (t *RelationTuple) Expired(now time.Time) bool {
+	return t.DateTimeExpires != nil && !t.DateTimeExpires.After(now)
+}
+
+// UserType returns the type of the tuple's user, including the relation of usersets, e.g. user or user_group#admin
+func (t *RelationTuple) UserType() string {
+	objectType, _ := SplitObject(t.User)
+	if _, relation, ok := strings.Cut(t.User, "#"); ok {
+		return objectType + "#" + relation
+	}
+	return objectType
+}
+
+// Validate validates the RelationTuple struct based on the defined rules
+func (t *RelationTuple) Validate() error {
+	validate := validator.New()
+
+	err := validate.Struct(t)
+	if err != nil {
+		var validationErrors validator.ValidationErrors
+		if errors.As(err, &validationErrors) {
+			var messages []string
+			for _, fieldErr := range validationErrors {
+				messages = append(messages, fmt.Sprintf("Field: %s, Tag: %s", fieldErr.Field(), fieldErr.Tag()))
+			}
+			return fmt.Errorf("validation failed: %v", messages)
+		}
+		return fmt.Errorf("validation error: %w", err)
+	}
+
+	return nil
+}
+
+// CheckRequest asks whether a user has a relation or permission on an object.
+// Contextual tuples are considered in addition to the stored tuples for this request only, e.g. to relate users to groups based on their token.
+type CheckRequest struct {
+	User             string
+	Relation         string
+	Object           string
+	ContextualTuples []*RelationTuple
+}
+
+// User returns the object identifying a single user
+func User(userID string) string {
+	return TypeUser + ":" + userID
+}
+
+// UserGroup returns the object identifying a group of users
+func UserGroup(groupID string) string {
+	return TypeUserGroup + ":" + groupID
+}
+
+// Blob returns the object identifying a blob
+func Blob(blobID string) string {
+	return TypeBlob + ":" + blobID
+}
+
+// Key returns the object identifying a cryptographic key
+func Key(keyID string) string {
+	return TypeKey + ":" + keyID
+}
+
+// Userset returns the userset of all users having the relation on the object, e.g. user_group:admins#admin
+func Userset(object, relation string) string {
+	return object + "#" + relation
+}
+
+// SplitObject splits an object or userset into its type and id, dropping the relation of usersets
+func SplitObject(object string) (string, string) {
+	object, _, _ = strings.Cut(object, "#")
+	objectType, objectID, _ := strings.Cut(object, ":")
+	return objectType, objectID
+}
@@ -0,0 +1,101 @@
+//go:build unit
+// +build unit
+
+package permissions
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestRelationTuple_Validate(t *testing.T) {
+	require.NoError(t, NewRelationTuple(User("alice"), RelationOwner, Blob("1")).Validate())
+
+	invalidCases := []struct {
+		name  string
+		tuple *RelationTuple
+	}{
+		{name: "missing object", tuple: NewRelationTuple(User("alice"), RelationOwner, "")},
+		{name: "object without type", tuple: NewRelationTuple(User("alice"), RelationOwner, "1")},
+		{name: "missing relation", tuple: NewRelationTuple(User("alice"), "", Blob("1"))},
+		{name: "user without type", tuple: NewRelationTuple("alice", RelationOwner, Blob("1"))},
+		{name: "missing creation time", tuple: &RelationTuple{Object: Blob("1"), Relation: RelationOwner, User: User("alice")}},
+	}
+
+	for _, tc := range invalidCases {
+		t.Run(tc.name, func(t *testing.T) {
+			require.Error(t, tc.tuple.Validate())
+		})
+	}
+}
+
+func TestRelationTuple_UserType(t *testing.T) {
+	require.Equal(t, "user", NewRelationTuple(User("alice"), RelationOwner, Blob("1")).UserType())
+	require.Equal(t, "user_group#admin", NewRelationTuple(Userset(AdminsGroup, RelationAdmin), RelationAdmin, Blob("1")).UserType())
+}
+
+func TestRelationTuple_Expired(t *testing.T) {
+	now := time.Now()
+	tuple := NewRelationTuple(User("alice"), RelationViewer, Blob("1"))
+	require.False(t, tuple.Expired(now))
+
+	expires := now.Add(time.Hour)
+	tuple.DateTimeExpires = &expires
+	require.False(t, tuple.Expired(now))
+	require.True(t, tuple.Expired(expires))
+}
+
+func TestSplitObject(t *testing.T) {
+	objectType, objectID := SplitObject(Userset(AdminsGroup, RelationAdmin))
+	require.Equal(t, TypeUserGroup, objectType)
+	require.Equal(t, "admins", objectID)
+
+	objectType, objectID = SplitObject(Key("1"))
+	require.Equal(t, TypeKey, objectType)
+	require.Equal(t, "1", objectID)
+}
+
+func TestDefaultModel_Validate(t *testing.T) {
+	require.NoError(t, DefaultModel.Validate())
+
+	invalidModel := AuthorizationModel{
+		TypeUser: {},
+		TypeBlob: {
+			PermissionViewBlob: {Union: []string{RelationOwner}},
+		},
+	}
+	require.Error(t, invalidModel.Validate())
+}
+
+func TestAuthorizationModel_ValidateTuple(t *testing.T) {
+	require.NoError(t, DefaultModel.ValidateTuple(NewRelationTuple(User("alice"), RelationGrantee, Blob("1"))))
+	require.NoError(t, DefaultModel.ValidateTuple(NewRelationTuple(Userset(AdminsGroup, RelationAdmin), RelationAdmin, Key("1"))))
+	require.NoError(t, DefaultModel.ValidateTuple(NewRelationTuple(Userset(UserGroup("auditors"), RelationMember), RelationViewer, Blob("1"))))
+
+	invalidCases := []struct {
+		name  string
+		tuple *RelationTuple
+	}{
+		{name: "unknown object type", tuple: NewRelationTuple(User("alice"), RelationOwner, "file:1")},
+		{name: "unknown relation", tuple: NewRelationTuple(User("alice"), "reader", Blob("1"))},
+		{name: "computed permission", tuple: NewRelationTuple(User("alice"), PermissionViewBlob, Blob("1"))},
+		{name: "grantee of key", tuple: NewRelationTuple(User("alice"), RelationGrantee, Key("1"))},
+		{name: "userset of other relation", tuple: NewRelationTuple(Userset(AdminsGroup, RelationGrantee), RelationOwner, Blob("1"))},
+		{name: "viewer of key", tuple: NewRelationTuple(User("alice"), RelationViewer, Key("1"))},
+	}
+
+	for _, tc := range invalidCases {
+		t.Run(tc.name, func(t *testing.T) {
+			require.Error(t, DefaultModel.ValidateTuple(tc.tuple))
+		})
+	}
+}
+
+func TestRelationTupleQuery_Validate(t *testing.T) {
+	require.NoError(t, (&RelationTupleQuery{}).Validate())
+	require.NoError(t, (&RelationTupleQuery{Object: Blob("1"), Relation: RelationOwner, User: User("alice")}).Validate())
+	require.Error(t, (&RelationTupleQuery{Object: "1"}).Validate())
+	require.Error(t, (&RelationTupleQuery{User: "alice"}).Validate())
+}
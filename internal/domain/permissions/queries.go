@@ -0,0 +1,35 @@
+package permissions
+
+import (
+	"errors"
+	"fmt"
+
+	"github.com/go-playground/validator/v10"
+)
+
+// RelationTupleQuery represents filters for querying relationship tuples. Empty fields match any value.
+type RelationTupleQuery struct {
+	Object   string `validate:"omitempty,max=255,contains=:"` // Object is optional and matches tuples of the object
+	Relation string `validate:"omitempty,max=100"`            // Relation is optional and matches tuples of the relation
+	User     string `validate:"omitempty,max=255,contains=:"` // User is optional and matches tuples of the user or userset
+}
+
+// Validate validates the RelationTupleQuery struct based on the defined rules.
+func (q *RelationTupleQuery) Validate() error {
+	validate := validator.New()
+
+	err := validate.Struct(q)
+	if err != nil {
+		var validationErrors validator.ValidationErrors
+		if errors.As(err, &validationErrors) {
+			var messages []string
+			for _, fieldErr := range validationErrors {
+				messages = append(messages, fmt.Sprintf("Field: %s, Tag: %s", fieldErr.Field(), fieldErr.Tag()))
+			}
+			return fmt.Errorf("validation failed: %v", messages)
+		}
+		return fmt.Errorf("validation error: %w", err)
+	}
+
+	return nil
+}
@@ -0,0 +1,45 @@
+package pkcs11
+
+import "context"
+
+// PKCS11AdminService defines methods for administering the tokens of the configured PKCS#11 module, which are otherwise only reachable through the CLI.
+// Tokens hold the keys of all users, hence every method is restricted to admins carried by ctx.
+// Tokens holding the keys of the vault or the master key are only listed, all other methods fail with auth.ErrForbidden for them.
+// Key objects are addressed by the label of their token and their own label, key types are RSA, ECDSA or AES.
+type PKCS11AdminService interface {
+	// ListTokenSlots lists the tokens present in the slots of the module.
+	// It returns the tokens and any error encountered while listing.
+	ListTokenSlots(ctx context.Context) ([]*Token, error)
+
+	// ListObjects lists the objects stored on a token.
+	// It returns the objects and any error encountered while listing.
+	ListObjects(ctx context.Context, tokenLabel string) ([]*TokenObject, error)
+
+	// InitializeToken initializes the token of the configured slot with the label, tokens already carrying it are left untouched.
+	// It returns any error encountered during initialization.
+	InitializeToken(ctx context.Context, tokenLabel string) error
+
+	// AddKey generates an RSA or ECDSA key pair or an AES key labeled objectLabel on a token, its private or secret key never leaves the token.
+	// It returns any error encountered during generation.
+	AddKey(ctx context.Context, tokenLabel, objectLabel, keyType string, keySize uint32) error
+
+	// DeleteObject deletes the objects of the given type (privkey, pubkey, secrkey, cert or data) and label from a token.
+	// It returns any error encountered during deletion.
+	DeleteObject(ctx context.Context, tokenLabel, objectType, objectLabel string) error
+
+	// Encrypt encrypts the plaintext with the public key of an RSA key pair (PKCS#1 v1.5) or with an AES key (AES-GCM) on a token.
+	// It returns the OperationResult carrying the ciphertext and any error encountered during encryption.
+	Encrypt(ctx context.Context, tokenLabel, objectLabel, keyType string, plaintext []byte) (*OperationResult, error)
+
+	// Decrypt decrypts a ciphertext returned by Encrypt with the private key of the RSA key pair or the AES key on a token.
+	// It returns the OperationResult carrying the plaintext and any error encountered during decryption.
+	Decrypt(ctx context.Context, tokenLabel, objectLabel, keyType string, ciphertext []byte) (*OperationResult, error)
+
+	// Sign signs the data with the private key of an RSA (RSA-PSS over SHA-384) or ECDSA (over SHA-384) key pair on a token.
+	// It returns the OperationResult carrying the signature and any error encountered during signing.
+	Sign(ctx context.Context, tokenLabel, objectLabel, keyType string, data []byte) (*OperationResult, error)
+
+	// Verify verifies a signature returned by Sign with the public key of the key pair on a token.
+	// It returns the OperationResult reporting whether the signature is valid and any error encountered during verification.
+	Verify(ctx context.Context, tokenLabel, objectLabel, keyType string, data, signature []byte) (*OperationResult, error)
+}
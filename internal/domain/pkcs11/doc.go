@@ -0,0 +1,2 @@
+// Package pkcs11 defines the core interfaces for administering the PKCS#11 tokens of the configured module
+package pkcs11
@@ -0,0 +1,40 @@
+package pkcs11
+
+// Types of the objects stored on a token, as accepted by PKCS11AdminService.DeleteObject
+const (
+	ObjectTypePrivateKey = "privkey"
+	ObjectTypePublicKey  = "pubkey"
+	ObjectTypeSecretKey  = "secrkey"
+	ObjectTypeCert       = "cert"
+	ObjectTypeData       = "data"
+)
+
+// Token represents a PKCS#11 token in a slot of the configured module
+type Token struct {
+	SlotID       string `json:"slot_id"`
+	Label        string `json:"label"`
+	Manufacturer string `json:"manufacturer"`
+	Model        string `json:"model"`
+	SerialNumber string `json:"serial_number"`
+}
+
+// TokenObject represents an object stored on a PKCS#11 token, e.g. a key or a data object
+type TokenObject struct {
+	Label  string `json:"label"`
+	Type   string `json:"type"`   // Type of the object (e.g. RSA private key, ECDSA public key, data)
+	Usage  string `json:"usage"`  // Operations the object may be used for (e.g. encrypt, sign, decrypt)
+	Access string `json:"access"` // Access controls of the object (e.g. sensitive, always sensitive)
+}
+
+// OperationResult represents the outcome of a cryptographic operation performed with a key object on a token.
+// Operations are named like those of the crypto.CryptoOperationService, only the output of the performed operation is set.
+type OperationResult struct {
+	Operation   string `json:"operation"`
+	TokenLabel  string `json:"token_label"`
+	ObjectLabel string `json:"object_label"`
+	KeyType     string `json:"key_type"`
+	Ciphertext  []byte `json:"ciphertext,omitempty"`
+	Plaintext   []byte `json:"plaintext,omitempty"`
+	Signature   []byte `json:"signature,omitempty"`
+	Valid       *bool  `json:"valid,omitempty"`
+}
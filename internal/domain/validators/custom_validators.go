@@ -7,6 +7,11 @@ func KeySizeValidation(fl validator.FieldLevel) bool {
 	algorithm := fl.Parent().FieldByName("Algorithm").String()
 	keySize := fl.Field().Uint()
 
+	return ValidKeySize(algorithm, keySize)
+}
+
+// ValidKeySize reports whether keys of the algorithm type (AES, RSA or EC) may have the key size
+func ValidKeySize(algorithm string, keySize uint64) bool {
 	switch algorithm {
 	case "AES":
 		// AES key sizes should be 128, 192, or 256
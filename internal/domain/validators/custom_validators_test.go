@@ -58,3 +58,11 @@ func TestKeySizeValidation(t *testing.T) {
 		})
 	}
 }
+
+func TestValidKeySize(t *testing.T) {
+	require.True(t, ValidKeySize("RSA", 4096))
+	require.True(t, ValidKeySize("EC", 384))
+	require.False(t, ValidKeySize("RSA", 3072))
+	require.False(t, ValidKeySize("EC", 224))
+	require.False(t, ValidKeySize("", 256))
+}
@@ -0,0 +1,3 @@
+// Package authentication provides the validation of the JWTs authenticating callers of the REST and gRPC APIs.
+// Tokens must be signed with RS256 or ES256 by a key of a configured JWKS, which is read from a local file or fetched from the identity provider.
+package authentication
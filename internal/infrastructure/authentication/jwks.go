@@ -0,0 +1,264 @@
+package authentication
+
+import (
+	"context"
+	"crypto"
+	"crypto/ecdh"
+	"crypto/ecdsa"
+	"crypto/elliptic"
+	"crypto/rsa"
+	"crypto_vault_service/internal/infrastructure/logger"
+	"encoding/base64"
+	"encoding/json"
+	"fmt"
+	"io"
+	"log"
+	"math"
+	"math/big"
+	"net/http"
+	"os"
+	"sync"
+	"time"
+)
+
+const (
+	// jwksRefreshInterval is the minimum time between fetches of a remote JWKS, limiting the requests caused by tokens with unknown key ids
+	jwksRefreshInterval = time.Minute
+	// jwksFetchTimeout bounds the time spent fetching a remote JWKS
+	jwksFetchTimeout = 10 * time.Second
+	// jwksMaxSize is the maximum size in bytes of a JWKS
+	jwksMaxSize = 1 << 20
+)
+
+// jsonWebKey is a single key of a JWKS as specified by RFC 7517. Only the members of RSA and EC public keys are decoded.
+type jsonWebKey struct {
+	Kty string `json:"kty"`
+	Kid string `json:"kid"`
+	Use string `json:"use"`
+	N   string `json:"n"`
+	E   string `json:"e"`
+	Crv string `json:"crv"`
+	X   string `json:"x"`
+	Y   string `json:"y"`
+}
+
+// jsonWebKeySet is a JWKS as specified by RFC 7517
+type jsonWebKeySet struct {
+	Keys []jsonWebKey `json:"keys"`
+}
+
+// ecCurves maps the curve names of RFC 7518 to the curves of EC keys and their crypto/ecdh counterparts used for validating points
+var ecCurves = map[string]struct {
+	curve     elliptic.Curve
+	ecdhCurve ecdh.Curve
+}{
+	"P-256": {curve: elliptic.P256(), ecdhCurve: ecdh.P256()},
+	"P-384": {curve: elliptic.P384(), ecdhCurve: ecdh.P384()},
+	"P-521": {curve: elliptic.P521(), ecdhCurve: ecdh.P521()},
+}
+
+// keySet holds the public keys trusted for verifying token signatures, indexed by key id.
+// Keys read from a file are loaded once, whereas keys of a remote JWKS are fetched on first use and refetched when a token references an unknown key id, which picks up rotated keys.
+type keySet struct {
+	url       string
+	client    *http.Client
+	mu        sync.Mutex
+	keys      map[string]crypto.PublicKey
+	fetchedAt time.Time
+	logger    logger.Logger
+}
+
+// newFileKeySet creates a keySet holding the keys of the JWKS file at path
+func newFileKeySet(path string, logger logger.Logger) (*keySet, error) {
+	data, err := os.ReadFile(path) // #nosec G304 -- path is part of the service configuration
+	if err != nil {
+		return nil, fmt.Errorf("failed to read JWKS file '%s': %w", path, err)
+	}
+
+	keys, err := parseJWKS(data)
+	if err != nil {
+		return nil, fmt.Errorf("failed to parse JWKS file '%s': %w", path, err)
+	}
+
+	logger.Info(fmt.Sprintf("loaded %d keys from JWKS file %s", len(keys), path))
+	return &keySet{
+		keys:   keys,
+		logger: logger,
+	}, nil
+}
+
+// newURLKeySet creates a keySet fetching the keys of the JWKS at url on first use
+func newURLKeySet(url string, logger logger.Logger) *keySet {
+	return &keySet{
+		url:    url,
+		client: &http.Client{Timeout: jwksFetchTimeout},
+		keys:   map[string]crypto.PublicKey{},
+		logger: logger,
+	}
+}
+
+// key returns the key with the given id, refetching a remote JWKS if the id is unknown.
+// A token without key id is accepted if the JWKS holds a single key only.
+func (ks *keySet) key(ctx context.Context, kid string) (crypto.PublicKey, error) {
+	ks.mu.Lock()
+	defer ks.mu.Unlock()
+
+	if key, ok := ks.lookup(kid); ok {
+		return key, nil
+	}
+
+	if ks.url == "" || time.Since(ks.fetchedAt) < jwksRefreshInterval {
+		return nil, fmt.Errorf("no key with id '%s' in JWKS", kid)
+	}
+
+	if err := ks.fetch(ctx); err != nil {
+		return nil, fmt.Errorf("%w", err)
+	}
+
+	if key, ok := ks.lookup(kid); ok {
+		return key, nil
+	}
+	return nil, fmt.Errorf("no key with id '%s' in JWKS", kid)
+}
+
+// lookup returns the key with the given id or the only key if kid is empty
+func (ks *keySet) lookup(kid string) (crypto.PublicKey, bool) {
+	if kid == "" && len(ks.keys) == 1 {
+		for _, key := range ks.keys {
+			return key, true
+		}
+	}
+
+	key, ok := ks.keys[kid]
+	return key, ok
+}
+
+// fetch replaces the keys with those of the remote JWKS. Callers must hold the lock.
+func (ks *keySet) fetch(ctx context.Context) error {
+	ks.fetchedAt = time.Now()
+
+	request, err := http.NewRequestWithContext(ctx, http.MethodGet, ks.url, nil)
+	if err != nil {
+		return fmt.Errorf("failed to create JWKS request: %w", err)
+	}
+
+	response, err := ks.client.Do(request)
+	if err != nil {
+		return fmt.Errorf("failed to fetch JWKS from '%s': %w", ks.url, err)
+	}
+	defer func() {
+		if err := response.Body.Close(); err != nil {
+			log.Printf("warning: failed to close JWKS response body: %v\n", err)
+		}
+	}()
+
+	if response.StatusCode != http.StatusOK {
+		return fmt.Errorf("failed to fetch JWKS from '%s': unexpected status %s", ks.url, response.Status)
+	}
+
+	data, err := io.ReadAll(io.LimitReader(response.Body, jwksMaxSize))
+	if err != nil {
+		return fmt.Errorf("failed to read JWKS from '%s': %w", ks.url, err)
+	}
+
+	keys, err := parseJWKS(data)
+	if err != nil {
+		return fmt.Errorf("failed to parse JWKS from '%s': %w", ks.url, err)
+	}
+
+	ks.keys = keys
+	ks.logger.Info(fmt.Sprintf("fetched %d keys from JWKS %s", len(keys), ks.url))
+	return nil
+}
+
+// parseJWKS decodes the RSA and EC signing keys of a JWKS, skipping keys of other types or intended for encryption
+func parseJWKS(data []byte) (map[string]crypto.PublicKey, error) {
+	var jwks jsonWebKeySet
+	if err := json.Unmarshal(data, &jwks); err != nil {
+		return nil, fmt.Errorf("failed to decode JWKS: %w", err)
+	}
+
+	keys := map[string]crypto.PublicKey{}
+	for _, jwk := range jwks.Keys {
+		if jwk.Use != "" && jwk.Use != "sig" {
+			continue
+		}
+
+		var key crypto.PublicKey
+		var err error
+		switch jwk.Kty {
+		case "RSA":
+			key, err = parseRSAJWK(jwk)
+		case "EC":
+			key, err = parseECJWK(jwk)
+		default:
+			continue
+		}
+		if err != nil {
+			return nil, fmt.Errorf("invalid key '%s': %w", jwk.Kid, err)
+		}
+
+		if _, ok := keys[jwk.Kid]; ok {
+			return nil, fmt.Errorf("duplicate key id '%s'", jwk.Kid)
+		}
+		keys[jwk.Kid] = key
+	}
+
+	if len(keys) == 0 {
+		return nil, fmt.Errorf("JWKS holds no RSA or EC signing keys")
+	}
+	return keys, nil
+}
+
+// parseRSAJWK decodes the modulus and public exponent of an RSA key
+func parseRSAJWK(jwk jsonWebKey) (*rsa.PublicKey, error) {
+	n, err := base64.RawURLEncoding.DecodeString(jwk.N)
+	if err != nil || len(n) == 0 {
+		return nil, fmt.Errorf("invalid modulus")
+	}
+
+	e, err := base64.RawURLEncoding.DecodeString(jwk.E)
+	if err != nil || len(e) == 0 || len(e) > 4 {
+		return nil, fmt.Errorf("invalid public exponent")
+	}
+
+	exponent := new(big.Int).SetBytes(e).Int64()
+	if exponent < 3 || exponent > math.MaxInt32 || exponent%2 == 0 {
+		return nil, fmt.Errorf("invalid public exponent")
+	}
+
+	return &rsa.PublicKey{
+		N: new(big.Int).SetBytes(n),
+		E: int(exponent),
+	}, nil
+}
+
+// parseECJWK decodes the coordinates of an EC key and checks that they form a point on the curve
+func parseECJWK(jwk jsonWebKey) (*ecdsa.PublicKey, error) {
+	curve, ok := ecCurves[jwk.Crv]
+	if !ok {
+		return nil, fmt.Errorf("unsupported curve '%s'", jwk.Crv)
+	}
+	size := (curve.curve.Params().BitSize + 7) / 8
+
+	x, err := base64.RawURLEncoding.DecodeString(jwk.X)
+	if err != nil || len(x) != size {
+		return nil, fmt.Errorf("invalid x coordinate")
+	}
+
+	y, err := base64.RawURLEncoding.DecodeString(jwk.Y)
+	if err != nil || len(y) != size {
+		return nil, fmt.Errorf("invalid y coordinate")
+	}
+
+	point := append([]byte{4}, append(x, y...)...) // uncompressed point
+	if _, err := curve.ecdhCurve.NewPublicKey(point); err != nil {
+		return nil, fmt.Errorf("point is not on curve %s", jwk.Crv)
+	}
+
+	return &ecdsa.PublicKey{
+		Curve: curve.curve,
+		X:     new(big.Int).SetBytes(x),
+		Y:     new(big.Int).SetBytes(y),
+	}, nil
+}
@@ -0,0 +1,65 @@
+//go:build unit
+// +build unit
+
+package authentication
+
+import (
+	"crypto/ecdsa"
+	"crypto/rsa"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestParseJWKS(t *testing.T) {
+	// Keys of RFC 7517 Appendix A.1
+	data := []byte(`{"keys":[
+		{"kty":"EC","crv":"P-256","x":"MKBCTNIcKUSDii11ySs3526iDZ8AiTo7Tu6KPAqv7D4","y":"4Etl6SRW2YiLUrN5vfvVHuhp7x8PxltmWWlbbM4IFyM","use":"enc","kid":"1"},
+		{"kty":"RSA","n":"0vx7agoebGcQSuuPiLJXZptN9nndrQmbXEps2aiAFbWhM78LhWx4cbbfAAtVT86zwu1RK7aPFFxuhDR1L6tSoc_BJECPebWKRXjBZCiFV4n3oknjhMstn64tZ_2W-5JsGY4Hc5n9yBXArwl93lqt7_RN5w6Cf0h4QyQ5v-65YGjQR0_FDW2QvzqY368QQMicAtaSqzs8KJZgnYb9c7d0zgdAZHzu6qMQvRL5hajrn1n91CbOpbISD08qNLyrdkt-bFTWhAI4vMQFh6WeZu0fM4lFd2NcRwr3XPksINHaQ-G_xBniIqbw0Ls1jF44-csFCur-kEgU8awapJzKnqDKgw","e":"AQAB","alg":"RS256","kid":"2011-04-29"},
+		{"kty":"oct","k":"c2VjcmV0","kid":"hmac"}
+	]}`)
+
+	keys, err := parseJWKS(data)
+	require.NoError(t, err)
+	require.Len(t, keys, 1)
+
+	key, ok := keys["2011-04-29"].(*rsa.PublicKey)
+	require.True(t, ok)
+	assert.Equal(t, 65537, key.E)
+	assert.Equal(t, 2048, key.N.BitLen())
+}
+
+func TestParseJWKS_ECKey(t *testing.T) {
+	data := []byte(`{"keys":[{"kty":"EC","crv":"P-256","x":"MKBCTNIcKUSDii11ySs3526iDZ8AiTo7Tu6KPAqv7D4","y":"4Etl6SRW2YiLUrN5vfvVHuhp7x8PxltmWWlbbM4IFyM","kid":"1"}]}`)
+
+	keys, err := parseJWKS(data)
+	require.NoError(t, err)
+
+	key, ok := keys["1"].(*ecdsa.PublicKey)
+	require.True(t, ok)
+	assert.Equal(t, "P-256", key.Curve.Params().Name)
+}
+
+func TestParseJWKS_Invalid(t *testing.T) {
+	tests := []struct {
+		name string
+		data string
+	}{
+		{"Malformed JSON", `{"keys":`},
+		{"No Signing Keys", `{"keys":[]}`},
+		{"Point Not On Curve", `{"keys":[{"kty":"EC","crv":"P-256","x":"MKBCTNIcKUSDii11ySs3526iDZ8AiTo7Tu6KPAqv7D4","y":"MKBCTNIcKUSDii11ySs3526iDZ8AiTo7Tu6KPAqv7D4","kid":"1"}]}`},
+		{"Unsupported Curve", `{"keys":[{"kty":"EC","crv":"secp256k1","x":"MKBCTNIcKUSDii11ySs3526iDZ8AiTo7Tu6KPAqv7D4","y":"4Etl6SRW2YiLUrN5vfvVHuhp7x8PxltmWWlbbM4IFyM","kid":"1"}]}`},
+		{"Short Coordinate", `{"keys":[{"kty":"EC","crv":"P-256","x":"MKBC","y":"4Etl6SRW2YiLUrN5vfvVHuhp7x8PxltmWWlbbM4IFyM","kid":"1"}]}`},
+		{"Even Exponent", `{"keys":[{"kty":"RSA","n":"0vx7","e":"AQAA","kid":"1"}]}`},
+		{"Missing Modulus", `{"keys":[{"kty":"RSA","e":"AQAB","kid":"1"}]}`},
+		{"Duplicate Key ID", `{"keys":[{"kty":"EC","crv":"P-256","x":"MKBCTNIcKUSDii11ySs3526iDZ8AiTo7Tu6KPAqv7D4","y":"4Etl6SRW2YiLUrN5vfvVHuhp7x8PxltmWWlbbM4IFyM","kid":"1"},{"kty":"EC","crv":"P-256","x":"MKBCTNIcKUSDii11ySs3526iDZ8AiTo7Tu6KPAqv7D4","y":"4Etl6SRW2YiLUrN5vfvVHuhp7x8PxltmWWlbbM4IFyM","kid":"1"}]}`},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			_, err := parseJWKS([]byte(tt.data))
+			assert.Error(t, err)
+		})
+	}
+}
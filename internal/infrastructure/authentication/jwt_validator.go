@@ -0,0 +1,135 @@
+package authentication
+
+import (
+	"context"
+	"crypto_vault_service/internal/domain/auth"
+	"crypto_vault_service/internal/infrastructure/logger"
+	"crypto_vault_service/internal/infrastructure/settings"
+	"fmt"
+	"time"
+
+	"github.com/golang-jwt/jwt/v5"
+)
+
+// jwtLeeway tolerates clock skew between the identity provider and the service when checking the time based claims
+const jwtLeeway = 30 * time.Second
+
+// defaultRolesClaim is the claim holding the caller's roles unless configured otherwise
+const defaultRolesClaim = "roles"
+
+// defaultGroupsClaim is the claim holding the caller's groups unless configured otherwise
+const defaultGroupsClaim = "groups"
+
+// jwtValidator validates JWTs signed by a key of a JWKS and implements the TokenValidator interface
+type jwtValidator struct {
+	keySet      *keySet
+	parser      *jwt.Parser
+	rolesClaim  string
+	groupsClaim string
+	adminRole   string
+	logger      logger.Logger
+}
+
+// NewJWTValidator creates a new jwtValidator accepting RS256 and ES256 signed JWTs issued by the configured issuer for the configured audience.
+// Tokens must carry an expiry and a subject, which identifies the caller. Callers holding the configured admin role are identified as admins.
+// The groups of the caller are read from the configured groups claim.
+func NewJWTValidator(settings *settings.JWTSettings, logger logger.Logger) (auth.TokenValidator, error) {
+	if err := settings.Validate(); err != nil {
+		return nil, fmt.Errorf("failed to validate settings: %w", err)
+	}
+
+	var keySet *keySet
+	if settings.JWKSFile != "" {
+		var err error
+		keySet, err = newFileKeySet(settings.JWKSFile, logger)
+		if err != nil {
+			return nil, fmt.Errorf("%w", err)
+		}
+	} else {
+		keySet = newURLKeySet(settings.JWKSURL, logger)
+	}
+
+	parser := jwt.NewParser(
+		jwt.WithValidMethods([]string{jwt.SigningMethodRS256.Alg(), jwt.SigningMethodES256.Alg()}),
+		jwt.WithIssuer(settings.Issuer),
+		jwt.WithAudience(settings.Audience),
+		jwt.WithExpirationRequired(),
+		jwt.WithLeeway(jwtLeeway),
+	)
+
+	rolesClaim := settings.RolesClaim
+	if rolesClaim == "" {
+		rolesClaim = defaultRolesClaim
+	}
+	groupsClaim := settings.GroupsClaim
+	if groupsClaim == "" {
+		groupsClaim = defaultGroupsClaim
+	}
+
+	return &jwtValidator{
+		keySet:      keySet,
+		parser:      parser,
+		rolesClaim:  rolesClaim,
+		groupsClaim: groupsClaim,
+		adminRole:   settings.AdminRole,
+		logger:      logger,
+	}, nil
+}
+
+// Validate verifies the signature and claims of a JWT and returns the identity of its subject
+func (v *jwtValidator) Validate(ctx context.Context, token string) (*auth.Identity, error) {
+	claims := jwt.MapClaims{}
+
+	_, err := v.parser.ParseWithClaims(token, claims, func(token *jwt.Token) (interface{}, error) {
+		kid, _ := token.Header["kid"].(string)
+		return v.keySet.key(ctx, kid)
+	})
+	if err != nil {
+		return nil, fmt.Errorf("invalid token: %w", err)
+	}
+
+	subject, err := claims.GetSubject()
+	if err != nil {
+		return nil, fmt.Errorf("invalid token: %w", err)
+	}
+	if subject == "" {
+		return nil, fmt.Errorf("invalid token: missing subject")
+	}
+
+	return &auth.Identity{
+		UserID: subject,
+		Admin:  v.hasAdminRole(claims),
+		Groups: claimValues(claims, v.groupsClaim),
+	}, nil
+}
+
+// hasAdminRole reports whether the roles claim contains the admin role
+func (v *jwtValidator) hasAdminRole(claims jwt.MapClaims) bool {
+	if v.adminRole == "" {
+		return false
+	}
+
+	for _, role := range claimValues(claims, v.rolesClaim) {
+		if role == v.adminRole {
+			return true
+		}
+	}
+	return false
+}
+
+// claimValues returns the values of a claim holding either a single string or a list of strings, ignoring values of other types
+func claimValues(claims jwt.MapClaims, claim string) []string {
+	switch values := claims[claim].(type) {
+	case string:
+		return []string{values}
+	case []interface{}:
+		result := make([]string, 0, len(values))
+		for _, value := range values {
+			if s, ok := value.(string); ok {
+				result = append(result, s)
+			}
+		}
+		return result
+	}
+	return nil
+}
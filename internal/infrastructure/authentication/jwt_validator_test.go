@@ -0,0 +1,350 @@
+//go:build unit
+// +build unit
+
+package authentication
+
+import (
+	"context"
+	"crypto"
+	"crypto/ecdsa"
+	"crypto/elliptic"
+	"crypto/rand"
+	"crypto/rsa"
+	"encoding/base64"
+	"encoding/json"
+	"math/big"
+	"net/http"
+	"net/http/httptest"
+	"os"
+	"path/filepath"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"crypto_vault_service/internal/infrastructure/logger"
+	"crypto_vault_service/internal/infrastructure/settings"
+
+	"github.com/golang-jwt/jwt/v5"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+const (
+	testIssuer   = "https://auth.example.com/"
+	testAudience = "crypto-vault-service"
+)
+
+// JWTValidatorTests encapsulates JWT validator test cases
+type JWTValidatorTests struct {
+	logger      logger.Logger
+	rsaKey      *rsa.PrivateKey
+	ecKey       *ecdsa.PrivateKey
+	jwksFile    string
+	jwtSettings *settings.JWTSettings
+}
+
+// NewJWTValidatorTests creates a new instance of JWTValidatorTests with a JWKS file holding an RSA and an EC key
+func NewJWTValidatorTests(t *testing.T) *JWTValidatorTests {
+	loggerSettings := &settings.LoggerSettings{
+		LogLevel: "info",
+		LogType:  "console",
+		FilePath: "",
+	}
+
+	logInstance, err := logger.GetLogger(loggerSettings)
+	require.NoError(t, err)
+
+	rsaKey, err := rsa.GenerateKey(rand.Reader, 2048)
+	require.NoError(t, err)
+
+	ecKey, err := ecdsa.GenerateKey(elliptic.P256(), rand.Reader)
+	require.NoError(t, err)
+
+	jwksFile := filepath.Join(t.TempDir(), "jwks.json")
+	writeJWKS(t, jwksFile, map[string]crypto.PublicKey{"rsa-1": &rsaKey.PublicKey, "ec-1": &ecKey.PublicKey})
+
+	return &JWTValidatorTests{
+		logger:   logInstance,
+		rsaKey:   rsaKey,
+		ecKey:    ecKey,
+		jwksFile: jwksFile,
+		jwtSettings: &settings.JWTSettings{
+			JWKSFile: jwksFile,
+			Issuer:   testIssuer,
+			Audience: testAudience,
+		},
+	}
+}
+
+// validClaims returns claims passing all checks
+func validClaims() jwt.RegisteredClaims {
+	return jwt.RegisteredClaims{
+		Subject:   "user-1",
+		Issuer:    testIssuer,
+		Audience:  jwt.ClaimStrings{testAudience},
+		ExpiresAt: jwt.NewNumericDate(time.Now().Add(time.Hour)),
+	}
+}
+
+// signToken signs the claims with the key and stamps the key id into the header
+func signToken(t *testing.T, method jwt.SigningMethod, key crypto.PrivateKey, kid string, claims jwt.Claims) string {
+	token := jwt.NewWithClaims(method, claims)
+	if kid != "" {
+		token.Header["kid"] = kid
+	}
+
+	signedToken, err := token.SignedString(key)
+	require.NoError(t, err)
+	return signedToken
+}
+
+// writeJWKS writes the public keys as JWKS to path
+func writeJWKS(t *testing.T, path string, keys map[string]crypto.PublicKey) {
+	require.NoError(t, os.WriteFile(path, marshalJWKS(t, keys), 0600))
+}
+
+// marshalJWKS encodes the public keys as JWKS
+func marshalJWKS(t *testing.T, keys map[string]crypto.PublicKey) []byte {
+	encode := base64.RawURLEncoding.EncodeToString
+
+	jwks := jsonWebKeySet{}
+	for kid, key := range keys {
+		switch key := key.(type) {
+		case *rsa.PublicKey:
+			jwks.Keys = append(jwks.Keys, jsonWebKey{Kty: "RSA", Kid: kid, Use: "sig", N: encode(key.N.Bytes()), E: encode(big.NewInt(int64(key.E)).Bytes())})
+		case *ecdsa.PublicKey:
+			size := (key.Curve.Params().BitSize + 7) / 8
+			jwks.Keys = append(jwks.Keys, jsonWebKey{Kty: "EC", Kid: kid, Crv: key.Curve.Params().Name, X: encode(key.X.FillBytes(make([]byte, size))), Y: encode(key.Y.FillBytes(make([]byte, size)))})
+		default:
+			t.Fatalf("unsupported key type %T", key)
+		}
+	}
+
+	data, err := json.Marshal(jwks)
+	require.NoError(t, err)
+	return data
+}
+
+// TestValidateRS256 tests that RS256 signed tokens are accepted
+func (jt *JWTValidatorTests) TestValidateRS256(t *testing.T) {
+	validator, err := NewJWTValidator(jt.jwtSettings, jt.logger)
+	require.NoError(t, err)
+
+	identity, err := validator.Validate(context.Background(), signToken(t, jwt.SigningMethodRS256, jt.rsaKey, "rsa-1", validClaims()))
+	require.NoError(t, err)
+	assert.Equal(t, "user-1", identity.UserID)
+	assert.False(t, identity.Admin)
+}
+
+// TestValidateES256 tests that ES256 signed tokens are accepted
+func (jt *JWTValidatorTests) TestValidateES256(t *testing.T) {
+	validator, err := NewJWTValidator(jt.jwtSettings, jt.logger)
+	require.NoError(t, err)
+
+	identity, err := validator.Validate(context.Background(), signToken(t, jwt.SigningMethodES256, jt.ecKey, "ec-1", validClaims()))
+	require.NoError(t, err)
+	assert.Equal(t, "user-1", identity.UserID)
+}
+
+// TestValidateAdminRole tests that callers are identified as admins if their roles claim contains the admin role
+func (jt *JWTValidatorTests) TestValidateAdminRole(t *testing.T) {
+	adminSettings := *jt.jwtSettings
+	adminSettings.RolesClaim = "groups"
+	adminSettings.AdminRole = "crypto-vault-admin"
+
+	validator, err := NewJWTValidator(&adminSettings, jt.logger)
+	require.NoError(t, err)
+
+	// withRoles adds the roles claim to the valid claims
+	withRoles := func(claim string, roles interface{}) jwt.MapClaims {
+		claims := validClaims()
+		return jwt.MapClaims{
+			"sub": claims.Subject,
+			"iss": claims.Issuer,
+			"aud": []string(claims.Audience),
+			"exp": claims.ExpiresAt.Unix(),
+			claim: roles,
+		}
+	}
+
+	tests := []struct {
+		name          string
+		claims        jwt.MapClaims
+		expectedAdmin bool
+	}{
+		{"Role List", withRoles("groups", []string{"reader", "crypto-vault-admin"}), true},
+		{"Single Role", withRoles("groups", "crypto-vault-admin"), true},
+		{"Other Roles", withRoles("groups", []string{"reader"}), false},
+		{"Other Claim", withRoles("roles", []string{"crypto-vault-admin"}), false},
+		{"Invalid Roles", withRoles("groups", map[string]bool{"crypto-vault-admin": true}), false},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			identity, err := validator.Validate(context.Background(), signToken(t, jwt.SigningMethodRS256, jt.rsaKey, "rsa-1", tt.claims))
+			require.NoError(t, err)
+			assert.Equal(t, "user-1", identity.UserID)
+			assert.Equal(t, tt.expectedAdmin, identity.Admin)
+		})
+	}
+
+	// Without an admin role configured, no caller is an admin
+	validator, err = NewJWTValidator(jt.jwtSettings, jt.logger)
+	require.NoError(t, err)
+
+	identity, err := validator.Validate(context.Background(), signToken(t, jwt.SigningMethodRS256, jt.rsaKey, "rsa-1", withRoles("roles", []string{"crypto-vault-admin"})))
+	require.NoError(t, err)
+	assert.False(t, identity.Admin)
+}
+
+// TestValidateGroups tests that the groups of callers are read from the groups claim
+func (jt *JWTValidatorTests) TestValidateGroups(t *testing.T) {
+	groupSettings := *jt.jwtSettings
+	groupSettings.GroupsClaim = "teams"
+
+	validator, err := NewJWTValidator(&groupSettings, jt.logger)
+	require.NoError(t, err)
+
+	// withGroups adds the groups claim to the valid claims
+	withGroups := func(groups interface{}) jwt.MapClaims {
+		claims := validClaims()
+		return jwt.MapClaims{
+			"sub":   claims.Subject,
+			"iss":   claims.Issuer,
+			"aud":   []string(claims.Audience),
+			"exp":   claims.ExpiresAt.Unix(),
+			"teams": groups,
+		}
+	}
+
+	tests := []struct {
+		name           string
+		claims         jwt.MapClaims
+		expectedGroups []string
+	}{
+		{"Group List", withGroups([]interface{}{"auditors", 1, "finance"}), []string{"auditors", "finance"}},
+		{"Single Group", withGroups("auditors"), []string{"auditors"}},
+		{"Invalid Groups", withGroups(map[string]bool{"auditors": true}), nil},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			identity, err := validator.Validate(context.Background(), signToken(t, jwt.SigningMethodRS256, jt.rsaKey, "rsa-1", tt.claims))
+			require.NoError(t, err)
+			assert.Equal(t, tt.expectedGroups, identity.Groups)
+		})
+	}
+}
+
+// TestValidateRejectsInvalidTokens tests that tokens failing any check are rejected
+func (jt *JWTValidatorTests) TestValidateRejectsInvalidTokens(t *testing.T) {
+	validator, err := NewJWTValidator(jt.jwtSettings, jt.logger)
+	require.NoError(t, err)
+
+	otherKey, err := rsa.GenerateKey(rand.Reader, 2048)
+	require.NoError(t, err)
+
+	withClaims := func(modify func(claims *jwt.RegisteredClaims)) jwt.RegisteredClaims {
+		claims := validClaims()
+		modify(&claims)
+		return claims
+	}
+
+	tests := []struct {
+		name  string
+		token string
+	}{
+		{"Malformed", "not-a-jwt"},
+		{"Wrong Issuer", signToken(t, jwt.SigningMethodRS256, jt.rsaKey, "rsa-1", withClaims(func(c *jwt.RegisteredClaims) { c.Issuer = "https://evil.example.com/" }))},
+		{"Wrong Audience", signToken(t, jwt.SigningMethodRS256, jt.rsaKey, "rsa-1", withClaims(func(c *jwt.RegisteredClaims) { c.Audience = jwt.ClaimStrings{"other-service"} }))},
+		{"Expired", signToken(t, jwt.SigningMethodRS256, jt.rsaKey, "rsa-1", withClaims(func(c *jwt.RegisteredClaims) { c.ExpiresAt = jwt.NewNumericDate(time.Now().Add(-time.Hour)) }))},
+		{"Missing Expiry", signToken(t, jwt.SigningMethodRS256, jt.rsaKey, "rsa-1", withClaims(func(c *jwt.RegisteredClaims) { c.ExpiresAt = nil }))},
+		{"Missing Subject", signToken(t, jwt.SigningMethodRS256, jt.rsaKey, "rsa-1", withClaims(func(c *jwt.RegisteredClaims) { c.Subject = "" }))},
+		{"Unknown Key ID", signToken(t, jwt.SigningMethodRS256, jt.rsaKey, "rsa-2", validClaims())},
+		{"Missing Key ID With Multiple Keys", signToken(t, jwt.SigningMethodRS256, jt.rsaKey, "", validClaims())},
+		{"Untrusted Key", signToken(t, jwt.SigningMethodRS256, otherKey, "rsa-1", validClaims())},
+		{"Key Of Other Type", signToken(t, jwt.SigningMethodES256, jt.ecKey, "rsa-1", validClaims())},
+		{"HMAC Signed", signToken(t, jwt.SigningMethodHS256, []byte("secret"), "rsa-1", validClaims())},
+		{"Unsigned", signToken(t, jwt.SigningMethodNone, jwt.UnsafeAllowNoneSignatureType, "rsa-1", validClaims())},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			_, err := validator.Validate(context.Background(), tt.token)
+			assert.Error(t, err)
+		})
+	}
+}
+
+// TestValidateWithJWKSURL tests that a remote JWKS is fetched lazily and refetched for unknown key ids
+func (jt *JWTValidatorTests) TestValidateWithJWKSURL(t *testing.T) {
+	rotatedKey, err := ecdsa.GenerateKey(elliptic.P256(), rand.Reader)
+	require.NoError(t, err)
+
+	var fetches atomic.Int32
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		keys := map[string]crypto.PublicKey{"rsa-1": &jt.rsaKey.PublicKey}
+		if fetches.Add(1) > 1 {
+			keys["ec-2"] = &rotatedKey.PublicKey
+		}
+		_, _ = w.Write(marshalJWKS(t, keys))
+	}))
+	defer server.Close()
+
+	validator, err := NewJWTValidator(&settings.JWTSettings{
+		JWKSURL:  server.URL,
+		Issuer:   testIssuer,
+		Audience: testAudience,
+	}, jt.logger)
+	require.NoError(t, err)
+	assert.Equal(t, int32(0), fetches.Load())
+
+	identity, err := validator.Validate(context.Background(), signToken(t, jwt.SigningMethodRS256, jt.rsaKey, "rsa-1", validClaims()))
+	require.NoError(t, err)
+	assert.Equal(t, "user-1", identity.UserID)
+	assert.Equal(t, int32(1), fetches.Load())
+
+	// Refetches are rate limited, so a key rotated shortly after the last fetch is not known yet
+	_, err = validator.Validate(context.Background(), signToken(t, jwt.SigningMethodES256, rotatedKey, "ec-2", validClaims()))
+	require.Error(t, err)
+	assert.Equal(t, int32(1), fetches.Load())
+
+	validator.(*jwtValidator).keySet.fetchedAt = time.Now().Add(-jwksRefreshInterval)
+
+	identity, err = validator.Validate(context.Background(), signToken(t, jwt.SigningMethodES256, rotatedKey, "ec-2", validClaims()))
+	require.NoError(t, err)
+	assert.Equal(t, "user-1", identity.UserID)
+	assert.Equal(t, int32(2), fetches.Load())
+}
+
+// TestNewJWTValidatorInvalidJWKSFile tests that unreadable or invalid JWKS files are rejected on creation
+func (jt *JWTValidatorTests) TestNewJWTValidatorInvalidJWKSFile(t *testing.T) {
+	_, err := NewJWTValidator(&settings.JWTSettings{
+		JWKSFile: filepath.Join(t.TempDir(), "missing.json"),
+		Issuer:   testIssuer,
+		Audience: testAudience,
+	}, jt.logger)
+	assert.Error(t, err)
+
+	invalidFile := filepath.Join(t.TempDir(), "jwks.json")
+	require.NoError(t, os.WriteFile(invalidFile, []byte(`{"keys":[{"kty":"oct","k":"c2VjcmV0"}]}`), 0600))
+	_, err = NewJWTValidator(&settings.JWTSettings{
+		JWKSFile: invalidFile,
+		Issuer:   testIssuer,
+		Audience: testAudience,
+	}, jt.logger)
+	assert.Error(t, err)
+}
+
+// TestJWTValidator is the entry point to run the JWT validator tests
+func TestJWTValidator(t *testing.T) {
+	jt := NewJWTValidatorTests(t)
+
+	t.Run("TestValidateRS256", jt.TestValidateRS256)
+	t.Run("TestValidateES256", jt.TestValidateES256)
+	t.Run("TestValidateAdminRole", jt.TestValidateAdminRole)
+	t.Run("TestValidateGroups", jt.TestValidateGroups)
+	t.Run("TestValidateRejectsInvalidTokens", jt.TestValidateRejectsInvalidTokens)
+	t.Run("TestValidateWithJWKSURL", jt.TestValidateWithJWKSURL)
+	t.Run("TestNewJWTValidatorInvalidJWKSFile", jt.TestNewJWTValidatorInvalidJWKSFile)
+}
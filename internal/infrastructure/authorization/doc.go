@@ -0,0 +1,3 @@
+// Package authorization provides the evaluation of the relationship-based authorization model of the permissions package.
+// Relationship tuples are stored in the service database, so no OpenFGA server needs to be operated.
+package authorization
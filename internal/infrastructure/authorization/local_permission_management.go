@@ -0,0 +1,142 @@
+package authorization
+
+import (
+	"context"
+	"crypto_vault_service/internal/domain/permissions"
+	"crypto_vault_service/internal/infrastructure/logger"
+	"fmt"
+	"strings"
+	"time"
+)
+
+// maxCheckDepth limits the number of usersets resolved by a single check, guarding against cyclic tuples
+const maxCheckDepth = 25
+
+// localPermissionManagement evaluates the authorization model against relationship tuples stored in a repository
+// and implements the PermissionManagement interface
+type localPermissionManagement struct {
+	model  permissions.AuthorizationModel
+	repo   permissions.RelationTupleRepository
+	logger logger.Logger
+}
+
+// NewLocalPermissionManagement creates a new localPermissionManagement instance evaluating the default authorization model
+func NewLocalPermissionManagement(repo permissions.RelationTupleRepository, logger logger.Logger) (permissions.PermissionManagement, error) {
+	if err := permissions.DefaultModel.Validate(); err != nil {
+		return nil, fmt.Errorf("invalid authorization model: %w", err)
+	}
+
+	return &localPermissionManagement{
+		model:  permissions.DefaultModel,
+		repo:   repo,
+		logger: logger,
+	}, nil
+}
+
+// Check reports whether the user has the relation or permission on the object, considering the contextual tuples of the request
+func (pm *localPermissionManagement) Check(ctx context.Context, request *permissions.CheckRequest) (bool, error) {
+	for _, tuple := range request.ContextualTuples {
+		if err := pm.model.ValidateTuple(tuple); err != nil {
+			return false, fmt.Errorf("invalid contextual tuple: %w", err)
+		}
+	}
+
+	allowed, err := pm.check(ctx, request.User, request.Relation, request.Object, request.ContextualTuples, 0)
+	if err != nil {
+		return false, fmt.Errorf("failed to check '%s' on '%s' for '%s': %w", request.Relation, request.Object, request.User, err)
+	}
+	return allowed, nil
+}
+
+// WriteTuples stores relationship tuples after validating them against the model
+func (pm *localPermissionManagement) WriteTuples(ctx context.Context, tuples []*permissions.RelationTuple) error {
+	for _, tuple := range tuples {
+		if err := pm.model.ValidateTuple(tuple); err != nil {
+			return fmt.Errorf("invalid tuple: %w", err)
+		}
+	}
+
+	if err := pm.repo.Create(ctx, tuples); err != nil {
+		return fmt.Errorf("%w", err)
+	}
+	return nil
+}
+
+// DeleteTuples removes relationship tuples
+func (pm *localPermissionManagement) DeleteTuples(ctx context.Context, tuples []*permissions.RelationTuple) error {
+	if err := pm.repo.Delete(ctx, tuples); err != nil {
+		return fmt.Errorf("%w", err)
+	}
+	return nil
+}
+
+// ReadTuples retrieves the relationship tuples matching the query
+func (pm *localPermissionManagement) ReadTuples(ctx context.Context, query *permissions.RelationTupleQuery) ([]*permissions.RelationTuple, error) {
+	tuples, err := pm.repo.List(ctx, query)
+	if err != nil {
+		return nil, fmt.Errorf("%w", err)
+	}
+	return tuples, nil
+}
+
+// check resolves the relation of the object by its computed relations first and by the tuples assigning it directly second.
+// Tuples assigning the relation to a userset are resolved by checking the userset's relation on its object. Expired tuples are skipped.
+func (pm *localPermissionManagement) check(ctx context.Context, user, relation, object string, contextualTuples []*permissions.RelationTuple, depth int) (bool, error) {
+	if depth > maxCheckDepth {
+		return false, fmt.Errorf("maximum check depth of %d exceeded", maxCheckDepth)
+	}
+
+	objectType, _ := permissions.SplitObject(object)
+	relationDefinition, err := pm.model.Relation(objectType, relation)
+	if err != nil {
+		return false, fmt.Errorf("%w", err)
+	}
+
+	for _, computedRelation := range relationDefinition.Union {
+		allowed, err := pm.check(ctx, user, computedRelation, object, contextualTuples, depth)
+		if err != nil || allowed {
+			return allowed, err
+		}
+	}
+
+	if len(relationDefinition.DirectlyAssignable) == 0 {
+		return false, nil
+	}
+
+	tuples, err := pm.repo.List(ctx, &permissions.RelationTupleQuery{Object: object, Relation: relation})
+	if err != nil {
+		return false, fmt.Errorf("%w", err)
+	}
+	for _, tuple := range contextualTuples {
+		if tuple.Object == object && tuple.Relation == relation {
+			tuples = append(tuples, tuple)
+		}
+	}
+
+	now := time.Now()
+	activeTuples := make([]*permissions.RelationTuple, 0, len(tuples))
+	for _, tuple := range tuples {
+		if !tuple.Expired(now) {
+			activeTuples = append(activeTuples, tuple)
+		}
+	}
+
+	for _, tuple := range activeTuples {
+		if tuple.User == user {
+			return true, nil
+		}
+	}
+
+	for _, tuple := range activeTuples {
+		usersetObject, usersetRelation, isUserset := strings.Cut(tuple.User, "#")
+		if !isUserset {
+			continue
+		}
+		allowed, err := pm.check(ctx, user, usersetRelation, usersetObject, contextualTuples, depth+1)
+		if err != nil || allowed {
+			return allowed, err
+		}
+	}
+
+	return false, nil
+}
@@ -0,0 +1,264 @@
+//go:build unit
+// +build unit
+
+package authorization
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"crypto_vault_service/internal/domain/permissions"
+	"crypto_vault_service/internal/infrastructure/logger"
+	"crypto_vault_service/internal/infrastructure/settings"
+
+	"github.com/stretchr/testify/require"
+)
+
+// inMemoryRelationTupleRepository keeps relationship tuples in memory and implements the RelationTupleRepository interface
+type inMemoryRelationTupleRepository struct {
+	tuples []*permissions.RelationTuple
+}
+
+func (r *inMemoryRelationTupleRepository) Create(ctx context.Context, tuples []*permissions.RelationTuple) error {
+	for _, tuple := range tuples {
+		if len(r.find(tuple)) == 0 {
+			r.tuples = append(r.tuples, tuple)
+		}
+	}
+	return nil
+}
+
+func (r *inMemoryRelationTupleRepository) List(ctx context.Context, query *permissions.RelationTupleQuery) ([]*permissions.RelationTuple, error) {
+	var tuples []*permissions.RelationTuple
+	for _, tuple := range r.tuples {
+		if (query.Object == "" || query.Object == tuple.Object) &&
+			(query.Relation == "" || query.Relation == tuple.Relation) &&
+			(query.User == "" || query.User == tuple.User) {
+			tuples = append(tuples, tuple)
+		}
+	}
+	return tuples, nil
+}
+
+func (r *inMemoryRelationTupleRepository) Delete(ctx context.Context, tuples []*permissions.RelationTuple) error {
+	for _, tuple := range tuples {
+		for _, existing := range r.find(tuple) {
+			for i := range r.tuples {
+				if r.tuples[i] == existing {
+					r.tuples = append(r.tuples[:i], r.tuples[i+1:]...)
+					break
+				}
+			}
+		}
+	}
+	return nil
+}
+
+func (r *inMemoryRelationTupleRepository) find(tuple *permissions.RelationTuple) []*permissions.RelationTuple {
+	tuples, _ := r.List(context.Background(), &permissions.RelationTupleQuery{Object: tuple.Object, Relation: tuple.Relation, User: tuple.User})
+	return tuples
+}
+
+// PermissionManagementTests encapsulates local permission management test cases
+type PermissionManagementTests struct {
+	repo              *inMemoryRelationTupleRepository
+	permissionManager permissions.PermissionManagement
+}
+
+// NewPermissionManagementTests creates a permission management backed by an empty in-memory repository
+func NewPermissionManagementTests(t *testing.T) *PermissionManagementTests {
+	logger, err := logger.GetLogger(&settings.LoggerSettings{LogLevel: "info", LogType: "console"})
+	require.NoError(t, err)
+
+	repo := &inMemoryRelationTupleRepository{}
+	permissionManager, err := NewLocalPermissionManagement(repo, logger)
+	require.NoError(t, err)
+
+	return &PermissionManagementTests{
+		repo:              repo,
+		permissionManager: permissionManager,
+	}
+}
+
+// check checks the relation and fails the test on errors
+func (pt *PermissionManagementTests) check(t *testing.T, user, relation, object string, contextualTuples ...*permissions.RelationTuple) bool {
+	allowed, err := pt.permissionManager.Check(context.Background(), &permissions.CheckRequest{
+		User:             user,
+		Relation:         relation,
+		Object:           object,
+		ContextualTuples: contextualTuples,
+	})
+	require.NoError(t, err)
+	return allowed
+}
+
+// TestOwnerPermissions tests that owners may manage their blobs and keys only
+func (pt *PermissionManagementTests) TestOwnerPermissions(t *testing.T) {
+	alice, bob := permissions.User("alice"), permissions.User("bob")
+	blob, key := permissions.Blob("1"), permissions.Key("2")
+
+	err := pt.permissionManager.WriteTuples(context.Background(), []*permissions.RelationTuple{
+		permissions.NewRelationTuple(alice, permissions.RelationOwner, blob),
+		permissions.NewRelationTuple(alice, permissions.RelationOwner, key),
+	})
+	require.NoError(t, err)
+
+	for _, permission := range []string{permissions.PermissionViewBlob, permissions.PermissionDownloadBlob, permissions.PermissionDeleteBlob, permissions.PermissionVerifyBlob} {
+		require.True(t, pt.check(t, alice, permission, blob), permission)
+		require.False(t, pt.check(t, bob, permission, blob), permission)
+	}
+	for _, permission := range []string{permissions.PermissionManageKey, permissions.PermissionUseKey} {
+		require.True(t, pt.check(t, alice, permission, key), permission)
+		require.False(t, pt.check(t, bob, permission, key), permission)
+	}
+}
+
+// TestGranteePermissions tests that grantees may view, download and verify but not delete blobs
+func (pt *PermissionManagementTests) TestGranteePermissions(t *testing.T) {
+	bob, blob := permissions.User("bob"), permissions.Blob("1")
+
+	granteeTuple := permissions.NewRelationTuple(bob, permissions.RelationGrantee, blob)
+	require.NoError(t, pt.permissionManager.WriteTuples(context.Background(), []*permissions.RelationTuple{granteeTuple}))
+
+	require.True(t, pt.check(t, bob, permissions.PermissionViewBlob, blob))
+	require.True(t, pt.check(t, bob, permissions.PermissionDownloadBlob, blob))
+	require.True(t, pt.check(t, bob, permissions.PermissionVerifyBlob, blob))
+	require.False(t, pt.check(t, bob, permissions.PermissionDeleteBlob, blob))
+
+	require.NoError(t, pt.permissionManager.DeleteTuples(context.Background(), []*permissions.RelationTuple{granteeTuple}))
+	require.False(t, pt.check(t, bob, permissions.PermissionViewBlob, blob))
+}
+
+// TestViewerPermissions tests that viewers may view but neither download nor delete blobs
+func (pt *PermissionManagementTests) TestViewerPermissions(t *testing.T) {
+	bob, blob := permissions.User("bob"), permissions.Blob("1")
+
+	viewerTuple := permissions.NewRelationTuple(bob, permissions.RelationViewer, blob)
+	require.NoError(t, pt.permissionManager.WriteTuples(context.Background(), []*permissions.RelationTuple{viewerTuple}))
+
+	require.True(t, pt.check(t, bob, permissions.PermissionViewBlob, blob))
+	require.False(t, pt.check(t, bob, permissions.PermissionDownloadBlob, blob))
+	require.False(t, pt.check(t, bob, permissions.PermissionDeleteBlob, blob))
+	require.False(t, pt.check(t, bob, permissions.PermissionShareBlob, blob))
+}
+
+// TestGroupMemberGrants tests that members of a group obtain the grants of the group through contextual membership tuples
+func (pt *PermissionManagementTests) TestGroupMemberGrants(t *testing.T) {
+	dave, blob := permissions.User("dave"), permissions.Blob("1")
+	group := permissions.UserGroup("auditors")
+
+	err := pt.permissionManager.WriteTuples(context.Background(), []*permissions.RelationTuple{
+		permissions.NewRelationTuple(permissions.Userset(group, permissions.RelationMember), permissions.RelationGrantee, blob),
+	})
+	require.NoError(t, err)
+
+	require.False(t, pt.check(t, dave, permissions.PermissionDownloadBlob, blob))
+
+	memberTuple := permissions.NewRelationTuple(dave, permissions.RelationMember, group)
+	require.True(t, pt.check(t, dave, permissions.PermissionDownloadBlob, blob, memberTuple))
+}
+
+// TestExpiredTuples tests that expired stored and contextual tuples are not considered
+func (pt *PermissionManagementTests) TestExpiredTuples(t *testing.T) {
+	bob, carol, blob := permissions.User("bob"), permissions.User("carol"), permissions.Blob("1")
+	past, future := time.Now().Add(-time.Minute), time.Now().Add(time.Hour)
+
+	expiredTuple := permissions.NewRelationTuple(bob, permissions.RelationGrantee, blob)
+	expiredTuple.DateTimeExpires = &past
+	activeTuple := permissions.NewRelationTuple(carol, permissions.RelationGrantee, blob)
+	activeTuple.DateTimeExpires = &future
+	require.NoError(t, pt.permissionManager.WriteTuples(context.Background(), []*permissions.RelationTuple{expiredTuple, activeTuple}))
+
+	require.False(t, pt.check(t, bob, permissions.PermissionDownloadBlob, blob))
+	require.True(t, pt.check(t, carol, permissions.PermissionDownloadBlob, blob))
+
+	contextualTuple := permissions.NewRelationTuple(bob, permissions.RelationViewer, blob)
+	contextualTuple.DateTimeExpires = &past
+	require.False(t, pt.check(t, bob, permissions.PermissionViewBlob, blob, contextualTuple))
+}
+
+// TestAdminUserset tests that members of the admins group obtain the admin relation through the userset and contextual tuples
+func (pt *PermissionManagementTests) TestAdminUserset(t *testing.T) {
+	carol, key := permissions.User("carol"), permissions.Key("2")
+
+	err := pt.permissionManager.WriteTuples(context.Background(), []*permissions.RelationTuple{
+		permissions.NewRelationTuple(permissions.Userset(permissions.AdminsGroup, permissions.RelationAdmin), permissions.RelationAdmin, key),
+	})
+	require.NoError(t, err)
+
+	require.False(t, pt.check(t, carol, permissions.PermissionManageKey, key))
+
+	adminTuple := permissions.NewRelationTuple(carol, permissions.RelationAdmin, permissions.AdminsGroup)
+	require.True(t, pt.check(t, carol, permissions.PermissionManageKey, key, adminTuple))
+
+	// Contextual tuples are not persisted
+	require.False(t, pt.check(t, carol, permissions.PermissionManageKey, key))
+}
+
+// TestInvalidRequests tests that relations and tuples outside the model are rejected
+func (pt *PermissionManagementTests) TestInvalidRequests(t *testing.T) {
+	_, err := pt.permissionManager.Check(context.Background(), &permissions.CheckRequest{
+		User:     permissions.User("alice"),
+		Relation: "can_fly",
+		Object:   permissions.Blob("1"),
+	})
+	require.Error(t, err)
+
+	_, err = pt.permissionManager.Check(context.Background(), &permissions.CheckRequest{
+		User:     permissions.User("alice"),
+		Relation: permissions.PermissionViewBlob,
+		Object:   "file:1",
+	})
+	require.Error(t, err)
+
+	err = pt.permissionManager.WriteTuples(context.Background(), []*permissions.RelationTuple{
+		permissions.NewRelationTuple(permissions.User("alice"), permissions.PermissionViewBlob, permissions.Blob("1")),
+	})
+	require.Error(t, err)
+	require.Empty(t, pt.repo.tuples)
+}
+
+// TestCyclicUsersets tests that cyclic usersets end with an error instead of recursing endlessly
+func (pt *PermissionManagementTests) TestCyclicUsersets(t *testing.T) {
+	// user_group:a#owner is an owner of user_group:b and vice versa, which the model does not permit, hence the tuples are stored directly
+	require.NoError(t, pt.repo.Create(context.Background(), []*permissions.RelationTuple{
+		permissions.NewRelationTuple(permissions.Userset("user_group:a", permissions.RelationOwner), permissions.RelationOwner, "user_group:b"),
+		permissions.NewRelationTuple(permissions.Userset("user_group:b", permissions.RelationOwner), permissions.RelationOwner, "user_group:a"),
+	}))
+
+	_, err := pt.permissionManager.Check(context.Background(), &permissions.CheckRequest{
+		User:     permissions.User("alice"),
+		Relation: permissions.RelationOwner,
+		Object:   "user_group:a",
+	})
+	require.Error(t, err)
+}
+
+// TestLocalPermissionManagement is the entry point for local permission management tests
+func TestLocalPermissionManagement(t *testing.T) {
+	t.Run("OwnerPermissions", func(t *testing.T) {
+		NewPermissionManagementTests(t).TestOwnerPermissions(t)
+	})
+	t.Run("GranteePermissions", func(t *testing.T) {
+		NewPermissionManagementTests(t).TestGranteePermissions(t)
+	})
+	t.Run("ViewerPermissions", func(t *testing.T) {
+		NewPermissionManagementTests(t).TestViewerPermissions(t)
+	})
+	t.Run("GroupMemberGrants", func(t *testing.T) {
+		NewPermissionManagementTests(t).TestGroupMemberGrants(t)
+	})
+	t.Run("ExpiredTuples", func(t *testing.T) {
+		NewPermissionManagementTests(t).TestExpiredTuples(t)
+	})
+	t.Run("AdminUserset", func(t *testing.T) {
+		NewPermissionManagementTests(t).TestAdminUserset(t)
+	})
+	t.Run("InvalidRequests", func(t *testing.T) {
+		NewPermissionManagementTests(t).TestInvalidRequests(t)
+	})
+	t.Run("CyclicUsersets", func(t *testing.T) {
+		NewPermissionManagementTests(t).TestCyclicUsersets(t)
+	})
+}
@@ -1,20 +1,14 @@
 package connector
 
 import (
-	"bytes"
 	"context"
-	"crypto_vault_service/internal/domain/blobs"
 	"crypto_vault_service/internal/infrastructure/logger"
 	"crypto_vault_service/internal/infrastructure/settings"
 	"fmt"
 	"io"
-	"log"
-	"mime/multipart"
 	"path/filepath"
-	"time"
 
 	"github.com/Azure/azure-sdk-for-go/sdk/storage/azblob"
-	"github.com/google/uuid"
 )
 
 // azureBlobConnector is a struct that holds the Azure Blob storage client and implements the BlobConnector interfaces.
@@ -48,91 +42,21 @@ func NewAzureBlobConnector(ctx context.Context, settings *settings.BlobConnector
 	}, nil
 }
 
-// UploadFromForm uploads files to a Blob Storage
-// and returns the metadata for each uploaded byte stream.
-func (abc *azureBlobConnector) Upload(ctx context.Context, form *multipart.Form, userID string, encryptionKeyID, signKeyID *string) ([]*blobs.BlobMeta, error) {
-	var blobMeta []*blobs.BlobMeta
-
-	fileHeaders := form.File["files"]
-
-	for _, fileHeader := range fileHeaders {
-
-		blobID := uuid.New().String()
-
-		fileExt := filepath.Ext(fileHeader.Filename)
-
-		blob := &blobs.BlobMeta{
-			ID:              blobID,
-			Name:            fileHeader.Filename,
-			Size:            fileHeader.Size,
-			Type:            fileExt,
-			DateTimeCreated: time.Now(),
-			UserID:          userID,
-			EncryptionKeyID: nil,
-			SignKeyID:       nil,
-		}
-
-		if encryptionKeyID != nil {
-			blob.EncryptionKeyID = encryptionKeyID
-		}
-
-		if signKeyID != nil {
-			blob.SignKeyID = signKeyID
-		}
-
-		fullBlobName := fmt.Sprintf("%s/%s", blob.ID, blob.Name)
-		fullBlobName = filepath.ToSlash(fullBlobName)
-
-		file, err := fileHeader.Open()
-		if err != nil {
-			err = fmt.Errorf("failed to open file '%s': %w", fullBlobName, err)
-			abc.rollbackUploadedBlobs(ctx, blobMeta)
-			return nil, err
-		}
-		defer func() {
-			if err := file.Close(); err != nil {
-				log.Printf("warning: failed to close file: %v\n", err)
-			}
-		}()
-
-		buffer := bytes.NewBuffer(make([]byte, 0))
-		_, err = io.Copy(buffer, file)
-
-		if err != nil {
-			err = fmt.Errorf("failed create new buffer for '%s': %w", fullBlobName, err)
-			abc.rollbackUploadedBlobs(ctx, blobMeta)
-			return nil, err
-		}
-
-		_, err = abc.client.UploadBuffer(ctx, abc.containerName, fullBlobName, buffer.Bytes(), nil)
-		if err != nil {
-			err = fmt.Errorf("failed to upload blob '%s': %w", fullBlobName, err)
-			abc.rollbackUploadedBlobs(ctx, blobMeta)
-			return nil, err
-		}
-
-		abc.logger.Info(fmt.Sprintf("Blob '%s' uploaded successfully", blob.Name))
-
-		blobMeta = append(blobMeta, blob)
-	}
-
-	return blobMeta, nil
-}
+// Upload streams the content of a blob to Azure Blob Storage in blocks
+func (abc *azureBlobConnector) Upload(ctx context.Context, content io.Reader, blobID, blobName string) error {
+	fullBlobName := filepath.ToSlash(fmt.Sprintf("%s/%s", blobID, blobName))
 
-// rollbackUploadedBlobs deletes the blobs that were uploaded successfully before the error occurred
-func (abc *azureBlobConnector) rollbackUploadedBlobs(ctx context.Context, blobs []*blobs.BlobMeta) {
-	for _, blob := range blobs {
-		err := abc.Delete(ctx, blob.ID, blob.Name)
-		if err != nil {
-			abc.logger.Info(fmt.Sprintf("Failed to delete blob '%s' during rollback: %v", blob.Name, err))
-		} else {
-			abc.logger.Info(fmt.Sprintf("Blob '%s' deleted during rollback", blob.Name))
-		}
+	_, err := abc.client.UploadStream(ctx, abc.containerName, fullBlobName, content, nil)
+	if err != nil {
+		return fmt.Errorf("failed to upload blob '%s': %w", fullBlobName, err)
 	}
+
+	abc.logger.Info(fmt.Sprintf("Blob '%s' uploaded successfully", fullBlobName))
+	return nil
 }
 
 // Download retrieves a blob's content by its ID and name, and returns the data as a stream.
-func (abc *azureBlobConnector) Download(ctx context.Context, blobID, blobName string) ([]byte, error) {
+func (abc *azureBlobConnector) Download(ctx context.Context, blobID, blobName string) (io.ReadCloser, error) {
 	fullBlobName := fmt.Sprintf("%s/%s", blobID, blobName)
 
 	get, err := abc.client.DownloadStream(ctx, abc.containerName, fullBlobName, nil)
@@ -140,21 +64,8 @@ func (abc *azureBlobConnector) Download(ctx context.Context, blobID, blobName st
 		return nil, fmt.Errorf("failed to download blob '%s': %w", fullBlobName, err)
 	}
 
-	downloadedData := bytes.Buffer{}
-
-	retryReader := get.NewRetryReader(ctx, &azblob.RetryReaderOptions{})
-	_, err = downloadedData.ReadFrom(retryReader)
-	if err != nil {
-		return nil, fmt.Errorf("failed to read data from blob '%s': %w", fullBlobName, err)
-	}
-
-	err = retryReader.Close()
-	if err != nil {
-		return nil, fmt.Errorf("failed to close retryReader for blob '%s': %w", fullBlobName, err)
-	}
-
-	abc.logger.Info(fmt.Sprintf("Blob '%s' downloaded successfully", fullBlobName))
-	return downloadedData.Bytes(), nil
+	abc.logger.Info(fmt.Sprintf("Blob '%s' download started", fullBlobName))
+	return get.NewRetryReader(ctx, &azblob.RetryReaderOptions{}), nil
 }
 
 // Delete deletes a blob from Azure Blob Storage by its ID and Name, and returns any error encountered.
@@ -4,12 +4,13 @@
 package connector
 
 import (
+	"bytes"
 	"context"
+	"io"
 	"testing"
 
 	"crypto_vault_service/internal/infrastructure/logger"
 	"crypto_vault_service/internal/infrastructure/settings"
-	"crypto_vault_service/test/testutils"
 
 	"github.com/google/uuid"
 	"github.com/stretchr/testify/assert"
@@ -51,26 +52,37 @@ func TestAzureBlobConnector_Upload(t *testing.T) {
 
 	testFileContent := []byte("This is test file content")
 	testFileName := "testfile.txt"
-	form, err := testutils.CreateTestFileAndForm(t, testFileName, testFileContent)
+	blobID := uuid.New().String()
+	ctx := context.Background()
+
+	err := abct.blobConnector.Upload(ctx, bytes.NewReader(testFileContent), blobID, testFileName)
 	require.NoError(t, err)
 
-	userID := uuid.New().String()
+	err = abct.blobConnector.Delete(ctx, blobID, testFileName)
+	require.NoError(t, err)
+}
+
+func TestAzureBlobConnector_Upload_LargeStream(t *testing.T) {
 
-	var encryptionKeyID *string = nil
-	var signKeyID *string = nil
+	abct := NewAzureBlobConnectorTest(t, "azure", "DefaultEndpointsProtocol=http;AccountName=devstoreaccount1;AccountKey=Eby8vdM02xNOcqFlqUwJPLlmEtlCDXJ1OUzFT50uSRZ6IFsuFq2UVErCz4I6tq/K1SZFPTOtr/KBHBeksoGMGw==;BlobEndpoint=http://127.0.0.1:10000/devstoreaccount1;", "testblobs")
+
+	// Exceeds the default block size, so the content is uploaded in several blocks
+	testFileContent := bytes.Repeat([]byte("0123456789abcdef"), 1024*1024)
+	testFileName := "largefile.bin"
+	blobID := uuid.New().String()
 	ctx := context.Background()
 
-	blobs, err := abct.blobConnector.Upload(ctx, form, userID, encryptionKeyID, signKeyID)
+	err := abct.blobConnector.Upload(ctx, bytes.NewReader(testFileContent), blobID, testFileName)
 	require.NoError(t, err)
 
-	require.Len(t, blobs, 1)
-	blob := blobs[0]
-	assert.NotEmpty(t, blob.ID)
-	assert.Equal(t, testFileName, blob.Name)
-	assert.Equal(t, int64(len(testFileContent)), blob.Size)
-	assert.Equal(t, ".txt", blob.Type)
+	reader, err := abct.blobConnector.Download(ctx, blobID, testFileName)
+	require.NoError(t, err)
+	downloadedData, err := io.ReadAll(reader)
+	require.NoError(t, err)
+	require.NoError(t, reader.Close())
+	assert.Equal(t, testFileContent, downloadedData)
 
-	err = abct.blobConnector.Delete(ctx, blob.ID, blob.Name)
+	err = abct.blobConnector.Delete(ctx, blobID, testFileName)
 	require.NoError(t, err)
 }
 
@@ -80,25 +92,21 @@ func TestAzureBlobConnector_Download(t *testing.T) {
 
 	testFileContent := []byte("This is test file content")
 	testFileName := "testfile.pem"
-	form, err := testutils.CreateTestFileAndForm(t, testFileName, testFileContent)
-	require.NoError(t, err)
-
-	userID := uuid.New().String()
-
-	var encryptionKeyID *string = nil
-	var signKeyID *string = nil
+	blobID := uuid.New().String()
 	ctx := context.Background()
-	blobs, err := abct.blobConnector.Upload(ctx, form, userID, encryptionKeyID, signKeyID)
-	require.NoError(t, err)
 
-	blob := blobs[0]
+	err := abct.blobConnector.Upload(ctx, bytes.NewReader(testFileContent), blobID, testFileName)
+	require.NoError(t, err)
 
-	downloadedData, err := abct.blobConnector.Download(ctx, blob.ID, blob.Name)
+	reader, err := abct.blobConnector.Download(ctx, blobID, testFileName)
 	require.NoError(t, err)
+	downloadedData, err := io.ReadAll(reader)
+	require.NoError(t, err)
+	require.NoError(t, reader.Close())
 
 	assert.Equal(t, testFileContent, downloadedData)
 
-	err = abct.blobConnector.Delete(ctx, blob.ID, blob.Name)
+	err = abct.blobConnector.Delete(ctx, blobID, testFileName)
 	require.NoError(t, err)
 }
 
@@ -108,23 +116,15 @@ func TestAzureBlobConnector_Delete(t *testing.T) {
 
 	testFileContent := []byte("This is test file content")
 	testFileName := "testfile.pem"
-	form, err := testutils.CreateTestFileAndForm(t, testFileName, testFileContent)
-	require.NoError(t, err)
-
-	userID := uuid.New().String()
-
-	var encryptionKeyID *string = nil
-	var signKeyID *string = nil
+	blobID := uuid.New().String()
 	ctx := context.Background()
 
-	blobs, err := abct.blobConnector.Upload(ctx, form, userID, encryptionKeyID, signKeyID)
+	err := abct.blobConnector.Upload(ctx, bytes.NewReader(testFileContent), blobID, testFileName)
 	require.NoError(t, err)
 
-	blob := blobs[0]
-
-	err = abct.blobConnector.Delete(ctx, blob.ID, blob.Name)
+	err = abct.blobConnector.Delete(ctx, blobID, testFileName)
 	require.NoError(t, err)
 
-	_, err = abct.blobConnector.Download(ctx, blob.ID, blob.Name)
+	_, err = abct.blobConnector.Download(ctx, blobID, testFileName)
 	assert.Error(t, err)
 }
@@ -10,7 +10,6 @@ import (
 	"time"
 
 	"github.com/Azure/azure-sdk-for-go/sdk/storage/azblob"
-	"github.com/google/uuid"
 )
 
 // This is a temporary implementation and may later be replaced with more specialized external key management systems
@@ -47,8 +46,7 @@ func NewAzureVaultConnector(ctx context.Context, settings *settings.KeyConnector
 
 // Upload uploads bytes of a single file to Blob Storage
 // and returns the metadata for each uploaded byte stream.
-func (vc *azureVaultConnector) Upload(ctx context.Context, bytes []byte, userID, keyPairID, keyType, keyAlgorihm string, keySize uint32) (*keys.CryptoKeyMeta, error) {
-	keyID := uuid.New().String()
+func (vc *azureVaultConnector) Upload(ctx context.Context, bytes []byte, keyID, userID, keyPairID, keyType, keyAlgorihm string, keySize uint32) (*keys.CryptoKeyMeta, error) {
 	fullKeyName := fmt.Sprintf("%s/%s-%s", keyPairID, keyID, keyType)
 
 	cryptoKeyMeta := &keys.CryptoKeyMeta{
@@ -101,6 +99,19 @@ func (vc *azureVaultConnector) Download(ctx context.Context, keyID, keyPairID, k
 	return downloadedData.Bytes(), nil
 }
 
+// Replace overwrites the content of an existing key in Azure Blob Storage by its IDs and Type.
+func (vc *azureVaultConnector) Replace(ctx context.Context, bytes []byte, keyID, keyPairID, keyType string) error {
+	fullKeyName := fmt.Sprintf("%s/%s-%s", keyPairID, keyID, keyType)
+
+	_, err := vc.client.UploadBuffer(ctx, vc.containerName, fullKeyName, bytes, nil)
+	if err != nil {
+		return fmt.Errorf("failed to replace blob '%s': %w", fullKeyName, err)
+	}
+
+	vc.logger.Info(fmt.Sprintf("replaced blob %s", fullKeyName))
+	return nil
+}
+
 // Delete deletes a key from Azure Blob Storage by its IDs and Type.
 func (vc *azureVaultConnector) Delete(ctx context.Context, keyID, keyPairID, keyType string) error {
 	fullKeyName := fmt.Sprintf("%s/%s-%s", keyPairID, keyID, keyType)
@@ -60,7 +60,7 @@ func TestAzureVaultConnector_Upload(t *testing.T) {
 	keySize := 2048
 	ctx := context.Background()
 
-	cryptoKeyMeta, err := avct.vaultConnector.Upload(ctx, testFileContent, userID, keyPairID, keyType, keyAlgorithm, uint32(keySize))
+	cryptoKeyMeta, err := avct.vaultConnector.Upload(ctx, testFileContent, uuid.New().String(), userID, keyPairID, keyType, keyAlgorithm, uint32(keySize))
 	require.NoError(t, err)
 
 	assert.NotEmpty(t, cryptoKeyMeta.ID)
@@ -84,7 +84,7 @@ func TestAzureVaultConnector_Download(t *testing.T) {
 	keySize := 2048
 	ctx := context.Background()
 
-	cryptoKeyMeta, err := avct.vaultConnector.Upload(ctx, testFileContent, userID, keyPairID, keyType, keyAlgorithm, uint32(keySize))
+	cryptoKeyMeta, err := avct.vaultConnector.Upload(ctx, testFileContent, uuid.New().String(), userID, keyPairID, keyType, keyAlgorithm, uint32(keySize))
 	require.NoError(t, err)
 
 	downloadedData, err := avct.vaultConnector.Download(ctx, cryptoKeyMeta.ID, cryptoKeyMeta.KeyPairID, cryptoKeyMeta.Type)
@@ -96,6 +96,34 @@ func TestAzureVaultConnector_Download(t *testing.T) {
 	require.NoError(t, err)
 }
 
+func TestAzureVaultConnector_Replace(t *testing.T) {
+	avct := NewAzureVaultConnectorTest(t, "azure", "DefaultEndpointsProtocol=http;AccountName=devstoreaccount1;AccountKey=Eby8vdM02xNOcqFlqUwJPLlmEtlCDXJ1OUzFT50uSRZ6IFsuFq2UVErCz4I6tq/K1SZFPTOtr/KBHBeksoGMGw==;BlobEndpoint=http://127.0.0.1:10000/devstoreaccount1;", "testblobs")
+
+	testFileContent := []byte("This is a test file content.")
+	replacedFileContent := []byte("This is the replaced test file content.")
+
+	userID := uuid.New().String()
+	keyPairID := uuid.New().String()
+	keyAlgorithm := "RSA"
+	keyType := "private"
+	keySize := 2048
+	ctx := context.Background()
+
+	cryptoKeyMeta, err := avct.vaultConnector.Upload(ctx, testFileContent, uuid.New().String(), userID, keyPairID, keyType, keyAlgorithm, uint32(keySize))
+	require.NoError(t, err)
+
+	err = avct.vaultConnector.Replace(ctx, replacedFileContent, cryptoKeyMeta.ID, cryptoKeyMeta.KeyPairID, cryptoKeyMeta.Type)
+	require.NoError(t, err)
+
+	downloadedData, err := avct.vaultConnector.Download(ctx, cryptoKeyMeta.ID, cryptoKeyMeta.KeyPairID, cryptoKeyMeta.Type)
+	require.NoError(t, err)
+
+	assert.Equal(t, replacedFileContent, downloadedData)
+
+	err = avct.vaultConnector.Delete(ctx, cryptoKeyMeta.ID, cryptoKeyMeta.KeyPairID, cryptoKeyMeta.Type)
+	require.NoError(t, err)
+}
+
 func TestAzureVaultConnector_Delete(t *testing.T) {
 	avct := NewAzureVaultConnectorTest(t, "azure", "DefaultEndpointsProtocol=http;AccountName=devstoreaccount1;AccountKey=Eby8vdM02xNOcqFlqUwJPLlmEtlCDXJ1OUzFT50uSRZ6IFsuFq2UVErCz4I6tq/K1SZFPTOtr/KBHBeksoGMGw==;BlobEndpoint=http://127.0.0.1:10000/devstoreaccount1;", "testblobs")
 
@@ -108,7 +136,7 @@ func TestAzureVaultConnector_Delete(t *testing.T) {
 	keySize := 2048
 	ctx := context.Background()
 
-	cryptoKeyMeta, err := avct.vaultConnector.Upload(ctx, testFileContent, userID, keyPairID, keyType, keyAlgorithm, uint32(keySize))
+	cryptoKeyMeta, err := avct.vaultConnector.Upload(ctx, testFileContent, uuid.New().String(), userID, keyPairID, keyType, keyAlgorithm, uint32(keySize))
 	require.NoError(t, err)
 
 	err = avct.vaultConnector.Delete(ctx, cryptoKeyMeta.ID, cryptoKeyMeta.KeyPairID, cryptoKeyMeta.Type)
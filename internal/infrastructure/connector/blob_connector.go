@@ -2,19 +2,36 @@ package connector
 
 import (
 	"context"
-	"crypto_vault_service/internal/domain/blobs"
-	"mime/multipart"
+	"crypto_vault_service/internal/infrastructure/logger"
+	"crypto_vault_service/internal/infrastructure/settings"
+	"fmt"
+	"io"
 )
 
 // BlobConnector is an interface for interacting with Blob storage
 type BlobConnector interface {
-	// UploadFromForm uploads files to a Blob Storage
-	// and returns the metadata for each uploaded byte stream.
-	Upload(ctx context.Context, form *multipart.Form, userID string, encryptionKeyID, signKeyID *string) ([]*blobs.BlobMeta, error)
+	// Upload streams the content of a blob to Blob Storage, stored under the blob's ID and name.
+	// The content is consumed as it is uploaded and never held in memory as a whole.
+	Upload(ctx context.Context, content io.Reader, blobID, blobName string) error
 
 	// Download retrieves a blob's content by its ID and name, and returns the data as a stream.
-	Download(ctx context.Context, blobID, blobName string) ([]byte, error)
+	// The caller is responsible for closing the stream.
+	Download(ctx context.Context, blobID, blobName string) (io.ReadCloser, error)
 
 	// Delete deletes a blob from Blob Storage by its ID and Name, and returns any error encountered.
 	Delete(ctx context.Context, blobID, blobName string) error
 }
+
+// NewBlobConnector creates the BlobConnector for the configured cloud provider
+func NewBlobConnector(ctx context.Context, settings *settings.BlobConnectorSettings, logger logger.Logger) (BlobConnector, error) {
+	switch settings.CloudProvider {
+	case "azure":
+		return NewAzureBlobConnector(ctx, settings, logger)
+	case "filesystem":
+		return NewFilesystemBlobConnector(settings, logger)
+	case "s3":
+		return NewS3BlobConnector(ctx, settings, logger)
+	default:
+		return nil, fmt.Errorf("unsupported blob connector cloud provider: %s", settings.CloudProvider)
+	}
+}
@@ -1,4 +1,4 @@
 // Package connector provides interfaces for interacting with external storage systems like Blob Storage.
 // It abstracts operations for uploading, downloading and deleting blobs of data and can be implemented
-// for various blob storage systems (e.g. Azure Blob Storage, AWS S3)
+// for various blob storage systems (e.g. Azure Blob Storage, AWS S3) or the local filesystem
 package connector
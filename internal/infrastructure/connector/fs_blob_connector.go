@@ -0,0 +1,174 @@
+package connector
+
+import (
+	"context"
+	"crypto_vault_service/internal/infrastructure/logger"
+	"crypto_vault_service/internal/infrastructure/settings"
+	"fmt"
+	"io"
+	"os"
+	"path/filepath"
+	"strings"
+)
+
+// Permissions of directories and files created by the filesystem connectors, granting access to the service owner only
+const (
+	fsDirPermissions  os.FileMode = 0700
+	fsFilePermissions os.FileMode = 0600
+)
+
+// filesystemBlobConnector stores blobs as files below a root directory and implements the BlobConnector interface.
+// Blobs are laid out as /{ID}/{Name}, matching the schema used for Azure Blob Storage.
+type filesystemBlobConnector struct {
+	rootPath string
+	logger   logger.Logger
+}
+
+// NewFilesystemBlobConnector creates a new filesystemBlobConnector instance storing blobs below the configured root path.
+// The root directory is created if it does not exist.
+func NewFilesystemBlobConnector(settings *settings.BlobConnectorSettings, logger logger.Logger) (BlobConnector, error) {
+	if err := settings.Validate(); err != nil {
+		return nil, fmt.Errorf("failed to validate settings: %w", err)
+	}
+
+	if err := os.MkdirAll(settings.RootPath, fsDirPermissions); err != nil {
+		return nil, fmt.Errorf("failed to create blob root directory '%s': %w", settings.RootPath, err)
+	}
+
+	return &filesystemBlobConnector{
+		rootPath: settings.RootPath,
+		logger:   logger,
+	}, nil
+}
+
+// Upload streams the content of a blob to a file, which only becomes visible once completely written
+func (fbc *filesystemBlobConnector) Upload(ctx context.Context, content io.Reader, blobID, blobName string) error {
+	path, err := fsPath(fbc.rootPath, blobID, blobName)
+	if err != nil {
+		return fmt.Errorf("%w", err)
+	}
+
+	err = writeFileAtomic(path, &contextReader{ctx: ctx, reader: content})
+	if err != nil {
+		return fmt.Errorf("failed to upload blob '%s/%s': %w", blobID, blobName, err)
+	}
+
+	fbc.logger.Info(fmt.Sprintf("Blob '%s/%s' uploaded successfully", blobID, blobName))
+	return nil
+}
+
+// Download retrieves a blob's content by its ID and name, and returns the data as a stream.
+func (fbc *filesystemBlobConnector) Download(ctx context.Context, blobID, blobName string) (io.ReadCloser, error) {
+	path, err := fsPath(fbc.rootPath, blobID, blobName)
+	if err != nil {
+		return nil, fmt.Errorf("%w", err)
+	}
+
+	file, err := os.Open(path) // #nosec G304 -- path segments are validated by fsPath
+	if err != nil {
+		return nil, fmt.Errorf("failed to download blob '%s/%s': %w", blobID, blobName, err)
+	}
+
+	fbc.logger.Info(fmt.Sprintf("Blob '%s/%s' downloaded successfully", blobID, blobName))
+	return file, nil
+}
+
+// Delete deletes a blob by its ID and Name, removing its directory once empty
+func (fbc *filesystemBlobConnector) Delete(ctx context.Context, blobID, blobName string) error {
+	path, err := fsPath(fbc.rootPath, blobID, blobName)
+	if err != nil {
+		return fmt.Errorf("%w", err)
+	}
+
+	if err := removeFile(path); err != nil {
+		return fmt.Errorf("failed to delete blob '%s/%s': %w", blobID, blobName, err)
+	}
+
+	fbc.logger.Info(fmt.Sprintf("Blob '%s/%s' deleted successfully", blobID, blobName))
+	return nil
+}
+
+// fsPath joins the root path with a directory and file name, rejecting segments that could escape the root
+func fsPath(rootPath, dirName, fileName string) (string, error) {
+	for _, segment := range []string{dirName, fileName} {
+		if segment == "" || segment == "." || segment == ".." || strings.ContainsAny(segment, `/\`) {
+			return "", fmt.Errorf("invalid path segment '%s'", segment)
+		}
+	}
+
+	return filepath.Join(rootPath, dirName, fileName), nil
+}
+
+// writeFileAtomic writes the content to a temporary file next to path and renames it into place,
+// so readers never observe partially written files. Files and directories are only accessible by the owner.
+func writeFileAtomic(path string, content io.Reader) error {
+	dir := filepath.Dir(path)
+	if err := os.MkdirAll(dir, fsDirPermissions); err != nil {
+		return fmt.Errorf("failed to create directory '%s': %w", dir, err)
+	}
+
+	tmpFile, err := os.CreateTemp(dir, "."+filepath.Base(path)+".tmp-*")
+	if err != nil {
+		return fmt.Errorf("failed to create temporary file: %w", err)
+	}
+	tmpPath := tmpFile.Name()
+
+	err = func() error {
+		if err := tmpFile.Chmod(fsFilePermissions); err != nil {
+			return fmt.Errorf("failed to set file permissions: %w", err)
+		}
+		if _, err := io.Copy(tmpFile, content); err != nil {
+			return fmt.Errorf("failed to write file: %w", err)
+		}
+		if err := tmpFile.Sync(); err != nil {
+			return fmt.Errorf("failed to sync file: %w", err)
+		}
+		return nil
+	}()
+	if closeErr := tmpFile.Close(); err == nil && closeErr != nil {
+		err = fmt.Errorf("failed to close file: %w", closeErr)
+	}
+	if err == nil {
+		err = os.Rename(tmpPath, path)
+	}
+	if err != nil {
+		_ = os.Remove(tmpPath)
+		return fmt.Errorf("%w", err)
+	}
+
+	return nil
+}
+
+// removeFile deletes the file at path and its parent directory once no other files remain in it
+func removeFile(path string) error {
+	if err := os.Remove(path); err != nil {
+		return fmt.Errorf("%w", err)
+	}
+
+	dir := filepath.Dir(path)
+	entries, err := os.ReadDir(dir)
+	if err != nil {
+		return fmt.Errorf("failed to read directory: %w", err)
+	}
+	if len(entries) == 0 {
+		if err := os.Remove(dir); err != nil {
+			return fmt.Errorf("failed to remove directory: %w", err)
+		}
+	}
+
+	return nil
+}
+
+// contextReader stops reading once its context is done
+type contextReader struct {
+	ctx    context.Context
+	reader io.Reader
+}
+
+// Read reads from the underlying reader unless the context is done
+func (r *contextReader) Read(p []byte) (int, error) {
+	if err := r.ctx.Err(); err != nil {
+		return 0, fmt.Errorf("%w", err)
+	}
+	return r.reader.Read(p)
+}
@@ -0,0 +1,157 @@
+//go:build unit
+// +build unit
+
+package connector
+
+import (
+	"bytes"
+	"context"
+	"io"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"crypto_vault_service/internal/infrastructure/logger"
+	"crypto_vault_service/internal/infrastructure/settings"
+
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// FilesystemBlobConnectorTest encapsulates common logic for tests
+type FilesystemBlobConnectorTest struct {
+	blobConnector BlobConnector
+	rootPath      string
+}
+
+// NewFilesystemBlobConnectorTest initializes and returns a new FilesystemBlobConnectorTest storing blobs in a temporary directory
+func NewFilesystemBlobConnectorTest(t *testing.T) *FilesystemBlobConnectorTest {
+	loggerSettings := &settings.LoggerSettings{
+		LogLevel: "info",
+		LogType:  "console",
+		FilePath: "",
+	}
+	logger, err := logger.GetLogger(loggerSettings)
+	require.NoError(t, err)
+
+	rootPath := filepath.Join(t.TempDir(), "blobs")
+	blobConnectorSettings := &settings.BlobConnectorSettings{
+		CloudProvider: "filesystem",
+		RootPath:      rootPath,
+	}
+
+	blobConnector, err := NewBlobConnector(context.Background(), blobConnectorSettings, logger)
+	require.NoError(t, err)
+
+	return &FilesystemBlobConnectorTest{
+		blobConnector: blobConnector,
+		rootPath:      rootPath,
+	}
+}
+
+func TestFilesystemBlobConnector_Upload(t *testing.T) {
+	fbct := NewFilesystemBlobConnectorTest(t)
+
+	testFileContent := []byte("This is test file content")
+	testFileName := "testfile.txt"
+	blobID := uuid.New().String()
+	ctx := context.Background()
+
+	err := fbct.blobConnector.Upload(ctx, bytes.NewReader(testFileContent), blobID, testFileName)
+	require.NoError(t, err)
+
+	path := filepath.Join(fbct.rootPath, blobID, testFileName)
+	storedContent, err := os.ReadFile(path)
+	require.NoError(t, err)
+	assert.Equal(t, testFileContent, storedContent)
+
+	fileInfo, err := os.Stat(path)
+	require.NoError(t, err)
+	assert.Equal(t, os.FileMode(0600), fileInfo.Mode().Perm())
+
+	dirInfo, err := os.Stat(filepath.Dir(path))
+	require.NoError(t, err)
+	assert.Equal(t, os.FileMode(0700), dirInfo.Mode().Perm())
+
+	entries, err := os.ReadDir(filepath.Dir(path))
+	require.NoError(t, err)
+	assert.Len(t, entries, 1, "No temporary files must remain")
+}
+
+func TestFilesystemBlobConnector_Upload_FailedStreamLeavesNoFile(t *testing.T) {
+	fbct := NewFilesystemBlobConnectorTest(t)
+
+	testFileName := "testfile.txt"
+	blobID := uuid.New().String()
+	ctx := context.Background()
+
+	content := io.MultiReader(bytes.NewReader([]byte("partial content")), &failingReader{})
+	err := fbct.blobConnector.Upload(ctx, content, blobID, testFileName)
+	require.Error(t, err)
+
+	entries, err := os.ReadDir(filepath.Join(fbct.rootPath, blobID))
+	require.NoError(t, err)
+	assert.Empty(t, entries)
+}
+
+func TestFilesystemBlobConnector_Upload_InvalidPath(t *testing.T) {
+	fbct := NewFilesystemBlobConnectorTest(t)
+	ctx := context.Background()
+
+	for _, blobName := range []string{"..", "../escape.txt", `..\escape.txt`, ""} {
+		err := fbct.blobConnector.Upload(ctx, bytes.NewReader([]byte("content")), uuid.New().String(), blobName)
+		assert.Error(t, err, "blob name %q must be rejected", blobName)
+	}
+
+	err := fbct.blobConnector.Upload(ctx, bytes.NewReader([]byte("content")), "..", "testfile.txt")
+	assert.Error(t, err)
+}
+
+func TestFilesystemBlobConnector_Download(t *testing.T) {
+	fbct := NewFilesystemBlobConnectorTest(t)
+
+	testFileContent := []byte("This is test file content")
+	testFileName := "testfile.pem"
+	blobID := uuid.New().String()
+	ctx := context.Background()
+
+	err := fbct.blobConnector.Upload(ctx, bytes.NewReader(testFileContent), blobID, testFileName)
+	require.NoError(t, err)
+
+	reader, err := fbct.blobConnector.Download(ctx, blobID, testFileName)
+	require.NoError(t, err)
+	downloadedData, err := io.ReadAll(reader)
+	require.NoError(t, err)
+	require.NoError(t, reader.Close())
+
+	assert.Equal(t, testFileContent, downloadedData)
+}
+
+func TestFilesystemBlobConnector_Delete(t *testing.T) {
+	fbct := NewFilesystemBlobConnectorTest(t)
+
+	testFileContent := []byte("This is test file content")
+	testFileName := "testfile.pem"
+	blobID := uuid.New().String()
+	ctx := context.Background()
+
+	err := fbct.blobConnector.Upload(ctx, bytes.NewReader(testFileContent), blobID, testFileName)
+	require.NoError(t, err)
+
+	err = fbct.blobConnector.Delete(ctx, blobID, testFileName)
+	require.NoError(t, err)
+
+	_, err = fbct.blobConnector.Download(ctx, blobID, testFileName)
+	assert.Error(t, err)
+
+	_, err = os.Stat(filepath.Join(fbct.rootPath, blobID))
+	assert.True(t, os.IsNotExist(err), "Empty blob directory must be removed")
+}
+
+// failingReader fails every read, simulating an interrupted upload stream
+type failingReader struct{}
+
+func (r *failingReader) Read(p []byte) (int, error) {
+	return 0, io.ErrUnexpectedEOF
+}
@@ -0,0 +1,117 @@
+package connector
+
+import (
+	"bytes"
+	"context"
+	"crypto_vault_service/internal/domain/keys"
+	"crypto_vault_service/internal/infrastructure/logger"
+	"crypto_vault_service/internal/infrastructure/settings"
+	"fmt"
+	"os"
+	"time"
+)
+
+// filesystemVaultConnector stores keys as files below a root directory and implements the VaultConnector interface.
+// Keys are laid out as /{keyPairId}/{keyId}-{keyType}, matching the schema used for Azure Blob Storage.
+type filesystemVaultConnector struct {
+	rootPath string
+	logger   logger.Logger
+}
+
+// NewFilesystemVaultConnector creates a new filesystemVaultConnector instance storing keys below the configured root path.
+// The root directory is created if it does not exist.
+func NewFilesystemVaultConnector(settings *settings.KeyConnectorSettings, logger logger.Logger) (VaultConnector, error) {
+	if err := settings.Validate(); err != nil {
+		return nil, fmt.Errorf("failed to validate settings: %w", err)
+	}
+
+	if err := os.MkdirAll(settings.RootPath, fsDirPermissions); err != nil {
+		return nil, fmt.Errorf("failed to create key root directory '%s': %w", settings.RootPath, err)
+	}
+
+	return &filesystemVaultConnector{
+		rootPath: settings.RootPath,
+		logger:   logger,
+	}, nil
+}
+
+// Upload writes the bytes of a single key to a file
+// and returns the metadata of the stored key.
+func (vc *filesystemVaultConnector) Upload(ctx context.Context, bytes []byte, keyID, userID, keyPairID, keyType, keyAlgorihm string, keySize uint32) (*keys.CryptoKeyMeta, error) {
+	cryptoKeyMeta := &keys.CryptoKeyMeta{
+		ID:              keyID,
+		KeyPairID:       keyPairID,
+		Type:            keyType,
+		Algorithm:       keyAlgorihm,
+		KeySize:         keySize,
+		DateTimeCreated: time.Now(),
+		UserID:          userID,
+	}
+
+	if err := vc.write(bytes, keyID, keyPairID, keyType); err != nil {
+		return nil, fmt.Errorf("failed to upload key '%s': %w", keyID, err)
+	}
+
+	vc.logger.Info(fmt.Sprintf("uploaded key %s/%s-%s", keyPairID, keyID, keyType))
+	return cryptoKeyMeta, nil
+}
+
+// Download retrieves a key's content by its IDs and Type and returns the data as a byte slice.
+func (vc *filesystemVaultConnector) Download(ctx context.Context, keyID, keyPairID, keyType string) ([]byte, error) {
+	path, err := fsPath(vc.rootPath, keyPairID, keyID+"-"+keyType)
+	if err != nil {
+		return nil, fmt.Errorf("%w", err)
+	}
+
+	keyBytes, err := os.ReadFile(path) // #nosec G304 -- path segments are validated by fsPath
+	if err != nil {
+		return nil, fmt.Errorf("failed to download key '%s': %w", keyID, err)
+	}
+
+	vc.logger.Info(fmt.Sprintf("downloaded key %s/%s-%s", keyPairID, keyID, keyType))
+	return keyBytes, nil
+}
+
+// Replace atomically overwrites the content of an existing key by its IDs and Type.
+func (vc *filesystemVaultConnector) Replace(ctx context.Context, bytes []byte, keyID, keyPairID, keyType string) error {
+	path, err := fsPath(vc.rootPath, keyPairID, keyID+"-"+keyType)
+	if err != nil {
+		return fmt.Errorf("%w", err)
+	}
+
+	if _, err := os.Stat(path); err != nil {
+		return fmt.Errorf("failed to replace key '%s': %w", keyID, err)
+	}
+
+	if err := vc.write(bytes, keyID, keyPairID, keyType); err != nil {
+		return fmt.Errorf("failed to replace key '%s': %w", keyID, err)
+	}
+
+	vc.logger.Info(fmt.Sprintf("replaced key %s/%s-%s", keyPairID, keyID, keyType))
+	return nil
+}
+
+// Delete deletes a key by its IDs and Type, removing the key pair directory once empty.
+func (vc *filesystemVaultConnector) Delete(ctx context.Context, keyID, keyPairID, keyType string) error {
+	path, err := fsPath(vc.rootPath, keyPairID, keyID+"-"+keyType)
+	if err != nil {
+		return fmt.Errorf("%w", err)
+	}
+
+	if err := removeFile(path); err != nil {
+		return fmt.Errorf("failed to delete key '%s': %w", keyID, err)
+	}
+
+	vc.logger.Info(fmt.Sprintf("deleted key %s/%s-%s", keyPairID, keyID, keyType))
+	return nil
+}
+
+// write atomically stores the key bytes under the key's path
+func (vc *filesystemVaultConnector) write(keyBytes []byte, keyID, keyPairID, keyType string) error {
+	path, err := fsPath(vc.rootPath, keyPairID, keyID+"-"+keyType)
+	if err != nil {
+		return fmt.Errorf("%w", err)
+	}
+
+	return writeFileAtomic(path, bytes.NewReader(keyBytes))
+}
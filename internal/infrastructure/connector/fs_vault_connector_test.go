@@ -0,0 +1,136 @@
+//go:build unit
+// +build unit
+
+package connector
+
+import (
+	"context"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"crypto_vault_service/internal/infrastructure/logger"
+	"crypto_vault_service/internal/infrastructure/settings"
+
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// FilesystemVaultConnectorTest encapsulates common logic for tests
+type FilesystemVaultConnectorTest struct {
+	vaultConnector VaultConnector
+	rootPath       string
+}
+
+// NewFilesystemVaultConnectorTest initializes and returns a new FilesystemVaultConnectorTest storing keys in a temporary directory
+func NewFilesystemVaultConnectorTest(t *testing.T) *FilesystemVaultConnectorTest {
+	loggerSettings := &settings.LoggerSettings{
+		LogLevel: "info",
+		LogType:  "console",
+		FilePath: "",
+	}
+	logger, err := logger.GetLogger(loggerSettings)
+	require.NoError(t, err)
+
+	rootPath := filepath.Join(t.TempDir(), "keys")
+	keyConnectorSettings := &settings.KeyConnectorSettings{
+		CloudProvider: "filesystem",
+		RootPath:      rootPath,
+	}
+
+	vaultConnector, err := NewVaultConnector(context.Background(), keyConnectorSettings, nil, logger)
+	require.NoError(t, err)
+
+	return &FilesystemVaultConnectorTest{
+		vaultConnector: vaultConnector,
+		rootPath:       rootPath,
+	}
+}
+
+func TestFilesystemVaultConnector_Upload(t *testing.T) {
+	fvct := NewFilesystemVaultConnectorTest(t)
+
+	testFileContent := []byte("This is a test file content.")
+	userID := uuid.New().String()
+	keyPairID := uuid.New().String()
+	ctx := context.Background()
+
+	cryptoKeyMeta, err := fvct.vaultConnector.Upload(ctx, testFileContent, uuid.New().String(), userID, keyPairID, "private", "RSA", 2048)
+	require.NoError(t, err)
+
+	assert.NotEmpty(t, cryptoKeyMeta.ID)
+	assert.Equal(t, keyPairID, cryptoKeyMeta.KeyPairID)
+	assert.Equal(t, "private", cryptoKeyMeta.Type)
+	assert.Equal(t, "RSA", cryptoKeyMeta.Algorithm)
+	assert.Equal(t, uint32(2048), cryptoKeyMeta.KeySize)
+	assert.Equal(t, userID, cryptoKeyMeta.UserID)
+
+	path := filepath.Join(fvct.rootPath, keyPairID, cryptoKeyMeta.ID+"-private")
+	fileInfo, err := os.Stat(path)
+	require.NoError(t, err)
+	assert.Equal(t, os.FileMode(0600), fileInfo.Mode().Perm())
+}
+
+func TestFilesystemVaultConnector_Download(t *testing.T) {
+	fvct := NewFilesystemVaultConnectorTest(t)
+
+	testFileContent := []byte("This is a test file content.")
+	keyPairID := uuid.New().String()
+	ctx := context.Background()
+
+	cryptoKeyMeta, err := fvct.vaultConnector.Upload(ctx, testFileContent, uuid.New().String(), uuid.New().String(), keyPairID, "symmetric", "AES", 256)
+	require.NoError(t, err)
+
+	keyBytes, err := fvct.vaultConnector.Download(ctx, cryptoKeyMeta.ID, keyPairID, "symmetric")
+	require.NoError(t, err)
+	assert.Equal(t, testFileContent, keyBytes)
+}
+
+func TestFilesystemVaultConnector_Replace(t *testing.T) {
+	fvct := NewFilesystemVaultConnectorTest(t)
+
+	keyPairID := uuid.New().String()
+	ctx := context.Background()
+
+	cryptoKeyMeta, err := fvct.vaultConnector.Upload(ctx, []byte("old key"), uuid.New().String(), uuid.New().String(), keyPairID, "symmetric", "AES", 256)
+	require.NoError(t, err)
+
+	err = fvct.vaultConnector.Replace(ctx, []byte("new key"), cryptoKeyMeta.ID, keyPairID, "symmetric")
+	require.NoError(t, err)
+
+	keyBytes, err := fvct.vaultConnector.Download(ctx, cryptoKeyMeta.ID, keyPairID, "symmetric")
+	require.NoError(t, err)
+	assert.Equal(t, []byte("new key"), keyBytes)
+
+	err = fvct.vaultConnector.Replace(ctx, []byte("new key"), uuid.New().String(), keyPairID, "symmetric")
+	assert.Error(t, err, "Replacing a non-existent key must fail")
+}
+
+func TestFilesystemVaultConnector_Delete(t *testing.T) {
+	fvct := NewFilesystemVaultConnectorTest(t)
+
+	keyPairID := uuid.New().String()
+	ctx := context.Background()
+
+	privateKeyMeta, err := fvct.vaultConnector.Upload(ctx, []byte("private key"), uuid.New().String(), uuid.New().String(), keyPairID, "private", "RSA", 2048)
+	require.NoError(t, err)
+	publicKeyMeta, err := fvct.vaultConnector.Upload(ctx, []byte("public key"), uuid.New().String(), uuid.New().String(), keyPairID, "public", "RSA", 2048)
+	require.NoError(t, err)
+
+	err = fvct.vaultConnector.Delete(ctx, privateKeyMeta.ID, keyPairID, "private")
+	require.NoError(t, err)
+
+	_, err = fvct.vaultConnector.Download(ctx, privateKeyMeta.ID, keyPairID, "private")
+	assert.Error(t, err)
+
+	keyBytes, err := fvct.vaultConnector.Download(ctx, publicKeyMeta.ID, keyPairID, "public")
+	require.NoError(t, err)
+	assert.Equal(t, []byte("public key"), keyBytes)
+
+	err = fvct.vaultConnector.Delete(ctx, publicKeyMeta.ID, keyPairID, "public")
+	require.NoError(t, err)
+
+	_, err = os.Stat(filepath.Join(fvct.rootPath, keyPairID))
+	assert.True(t, os.IsNotExist(err), "Empty key pair directory must be removed")
+}
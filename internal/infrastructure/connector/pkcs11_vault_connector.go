@@ -0,0 +1,203 @@
+package connector
+
+import (
+	"context"
+	"crypto"
+	"crypto/x509"
+	"crypto_vault_service/internal/domain/keys"
+	"crypto_vault_service/internal/infrastructure/cryptography"
+	"crypto_vault_service/internal/infrastructure/logger"
+	"crypto_vault_service/internal/infrastructure/settings"
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/google/uuid"
+)
+
+// TokenVaultConnector is a VaultConnector backed by a PKCS#11 token which generates RSA and EC key pairs on the token.
+// Private keys of generated key pairs are never extractable, so the token performs every operation with them.
+type TokenVaultConnector interface {
+	VaultConnector
+
+	// Generate generates an RSA or EC key pair labeled keyPairID on the token
+	// and returns the metadata of its private and public key.
+	Generate(ctx context.Context, userID, keyPairID, keyAlgorithm string, keySize uint32) ([]*keys.CryptoKeyMeta, error)
+
+	// Signer returns a crypto.Signer signing with the private key of a key pair generated on the token.
+	Signer(ctx context.Context, cryptoKeyMeta *keys.CryptoKeyMeta) (crypto.Signer, error)
+
+	// Decrypter returns a crypto.Decrypter decrypting with the private key of an RSA key pair generated on the token.
+	Decrypter(ctx context.Context, cryptoKeyMeta *keys.CryptoKeyMeta) (crypto.Decrypter, error)
+}
+
+// pkcs11KeyTypes maps the key algorithms generated on the token to the key types of the PKCS#11 handler
+var pkcs11KeyTypes = map[string]string{
+	"RSA": "RSA",
+	"EC":  "ECDSA",
+}
+
+// pkcs11VaultConnector stores keys on a PKCS#11 token and implements the TokenVaultConnector interface.
+// Uploaded keys are stored in private data objects labeled {keyPairId}/{keyId}-{keyType}, matching the schema used for Azure Blob Storage.
+// Generated key pairs are stored as key objects labeled {keyPairId}.
+type pkcs11VaultConnector struct {
+	tokenLabel    string
+	pkcs11Handler cryptography.PKCS11Handler
+	logger        logger.Logger
+}
+
+// NewPKCS11VaultConnector creates a new pkcs11VaultConnector instance storing keys on the token with the configured label.
+func NewPKCS11VaultConnector(settings *settings.KeyConnectorSettings, pkcs11Handler cryptography.PKCS11Handler, logger logger.Logger) (TokenVaultConnector, error) {
+	if err := settings.Validate(); err != nil {
+		return nil, fmt.Errorf("failed to validate settings: %w", err)
+	}
+
+	return &pkcs11VaultConnector{
+		tokenLabel:    settings.TokenLabel,
+		pkcs11Handler: pkcs11Handler,
+		logger:        logger,
+	}, nil
+}
+
+// Upload stores the bytes of a single key in a data object on the token
+// and returns the metadata of the stored key.
+func (vc *pkcs11VaultConnector) Upload(ctx context.Context, bytes []byte, keyID, userID, keyPairID, keyType, keyAlgorihm string, keySize uint32) (*keys.CryptoKeyMeta, error) {
+	cryptoKeyMeta := &keys.CryptoKeyMeta{
+		ID:              keyID,
+		KeyPairID:       keyPairID,
+		Type:            keyType,
+		Algorithm:       keyAlgorihm,
+		KeySize:         keySize,
+		DateTimeCreated: time.Now(),
+		UserID:          userID,
+	}
+
+	if err := vc.pkcs11Handler.AddData(vc.tokenLabel, dataObjectLabel(keyID, keyPairID, keyType), bytes); err != nil {
+		return nil, fmt.Errorf("failed to upload key '%s': %w", keyID, err)
+	}
+
+	vc.logger.Info(fmt.Sprintf("uploaded key %s/%s-%s", keyPairID, keyID, keyType))
+	return cryptoKeyMeta, nil
+}
+
+// Generate generates an RSA or EC key pair labeled keyPairID on the token
+// and returns the metadata of its private and public key.
+func (vc *pkcs11VaultConnector) Generate(ctx context.Context, userID, keyPairID, keyAlgorithm string, keySize uint32) ([]*keys.CryptoKeyMeta, error) {
+	handlerKeyType, supported := pkcs11KeyTypes[keyAlgorithm]
+	if !supported {
+		return nil, fmt.Errorf("unsupported key algorithm for token generated keys: %s", keyAlgorithm)
+	}
+
+	if err := vc.pkcs11Handler.AddKey(vc.tokenLabel, keyPairID, handlerKeyType, uint(keySize)); err != nil {
+		return nil, fmt.Errorf("failed to generate key pair '%s': %w", keyPairID, err)
+	}
+
+	dateTimeCreated := time.Now()
+	var cryptoKeyMetas []*keys.CryptoKeyMeta
+	for _, keyType := range []string{"private", "public"} {
+		cryptoKeyMetas = append(cryptoKeyMetas, &keys.CryptoKeyMeta{
+			ID:               uuid.New().String(),
+			KeyPairID:        keyPairID,
+			Type:             keyType,
+			Algorithm:        keyAlgorithm,
+			KeySize:          keySize,
+			DateTimeCreated:  dateTimeCreated,
+			UserID:           userID,
+			TokenLabel:       vc.tokenLabel,
+			TokenObjectLabel: keyPairID,
+		})
+	}
+
+	vc.logger.Info(fmt.Sprintf("generated key pair %s on token %s", keyPairID, vc.tokenLabel))
+	return cryptoKeyMetas, nil
+}
+
+// Download retrieves a key's content by its IDs and Type and returns the data as a byte slice.
+// Public keys of key pairs generated on the token are returned PKIX encoded, their private keys cannot be downloaded.
+func (vc *pkcs11VaultConnector) Download(ctx context.Context, keyID, keyPairID, keyType string) ([]byte, error) {
+	keyBytes, err := vc.pkcs11Handler.ReadData(vc.tokenLabel, dataObjectLabel(keyID, keyPairID, keyType))
+	if errors.Is(err, cryptography.ErrObjectNotFound) {
+		keyBytes, err = vc.downloadGenerated(keyID, keyPairID, keyType)
+	}
+	if err != nil {
+		return nil, fmt.Errorf("failed to download key '%s': %w", keyID, err)
+	}
+
+	vc.logger.Info(fmt.Sprintf("downloaded key %s/%s-%s", keyPairID, keyID, keyType))
+	return keyBytes, nil
+}
+
+// downloadGenerated returns the PKIX encoded public key of the key pair generated on the token
+func (vc *pkcs11VaultConnector) downloadGenerated(keyID, keyPairID, keyType string) ([]byte, error) {
+	if keyType != "public" {
+		return nil, fmt.Errorf("%w: key %s is generated on token %s and cannot be exported", keys.ErrExportForbidden, keyID, vc.tokenLabel)
+	}
+
+	publicKey, err := vc.pkcs11Handler.PublicKey(vc.tokenLabel, keyPairID)
+	if err != nil {
+		return nil, fmt.Errorf("%w", err)
+	}
+
+	keyBytes, err := x509.MarshalPKIXPublicKey(publicKey)
+	if err != nil {
+		return nil, fmt.Errorf("failed to encode public key: %w", err)
+	}
+	return keyBytes, nil
+}
+
+// Replace overwrites the content of an existing key by its IDs and Type.
+func (vc *pkcs11VaultConnector) Replace(ctx context.Context, bytes []byte, keyID, keyPairID, keyType string) error {
+	objectLabel := dataObjectLabel(keyID, keyPairID, keyType)
+
+	if _, err := vc.pkcs11Handler.ReadData(vc.tokenLabel, objectLabel); err != nil {
+		return fmt.Errorf("failed to replace key '%s': %w", keyID, err)
+	}
+
+	if err := vc.pkcs11Handler.AddData(vc.tokenLabel, objectLabel, bytes); err != nil {
+		return fmt.Errorf("failed to replace key '%s': %w", keyID, err)
+	}
+
+	vc.logger.Info(fmt.Sprintf("replaced key %s/%s-%s", keyPairID, keyID, keyType))
+	return nil
+}
+
+// Delete deletes a key by its IDs and Type, destroying the key object if the key was generated on the token.
+func (vc *pkcs11VaultConnector) Delete(ctx context.Context, keyID, keyPairID, keyType string) error {
+	err := vc.pkcs11Handler.DeleteObject(vc.tokenLabel, "data", dataObjectLabel(keyID, keyPairID, keyType))
+	if errors.Is(err, cryptography.ErrObjectNotFound) {
+		objectType := "privkey"
+		if keyType == "public" {
+			objectType = "pubkey"
+		}
+		err = vc.pkcs11Handler.DeleteObject(vc.tokenLabel, objectType, keyPairID)
+	}
+	if err != nil {
+		return fmt.Errorf("failed to delete key '%s': %w", keyID, err)
+	}
+
+	vc.logger.Info(fmt.Sprintf("deleted key %s/%s-%s", keyPairID, keyID, keyType))
+	return nil
+}
+
+// Signer returns a crypto.Signer signing with the private key of a key pair generated on the token.
+func (vc *pkcs11VaultConnector) Signer(ctx context.Context, cryptoKeyMeta *keys.CryptoKeyMeta) (crypto.Signer, error) {
+	signer, err := vc.pkcs11Handler.Signer(cryptoKeyMeta.TokenLabel, cryptoKeyMeta.TokenObjectLabel)
+	if err != nil {
+		return nil, fmt.Errorf("failed to access signing key '%s': %w", cryptoKeyMeta.ID, err)
+	}
+	return signer, nil
+}
+
+// Decrypter returns a crypto.Decrypter decrypting with the private key of an RSA key pair generated on the token.
+func (vc *pkcs11VaultConnector) Decrypter(ctx context.Context, cryptoKeyMeta *keys.CryptoKeyMeta) (crypto.Decrypter, error) {
+	decrypter, err := vc.pkcs11Handler.Decrypter(cryptoKeyMeta.TokenLabel, cryptoKeyMeta.TokenObjectLabel)
+	if err != nil {
+		return nil, fmt.Errorf("failed to access decryption key '%s': %w", cryptoKeyMeta.ID, err)
+	}
+	return decrypter, nil
+}
+
+// dataObjectLabel returns the label of the data object holding a key uploaded to the token
+func dataObjectLabel(keyID, keyPairID, keyType string) string {
+	return fmt.Sprintf("%s/%s-%s", keyPairID, keyID, keyType)
+}
@@ -0,0 +1,140 @@
+//go:build integration
+// +build integration
+
+package connector
+
+import (
+	"context"
+	"crypto"
+	"crypto/rand"
+	"crypto/rsa"
+	"crypto/sha256"
+	"crypto/x509"
+	"testing"
+
+	"crypto_vault_service/internal/domain/keys"
+	"crypto_vault_service/internal/infrastructure/cryptography"
+	"crypto_vault_service/internal/infrastructure/logger"
+	"crypto_vault_service/internal/infrastructure/settings"
+
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// PKCS11VaultConnectorTest encapsulates common logic for tests
+type PKCS11VaultConnectorTest struct {
+	vaultConnector TokenVaultConnector
+}
+
+// NewPKCS11VaultConnectorTest initializes and returns a new PKCS11VaultConnectorTest storing keys on a freshly initialized SoftHSM token
+func NewPKCS11VaultConnectorTest(t *testing.T, tokenLabel string) *PKCS11VaultConnectorTest {
+
+	loggerSettings := &settings.LoggerSettings{
+		LogLevel: "info",
+		LogType:  "console",
+		FilePath: "",
+	}
+	logger, err := logger.GetLogger(loggerSettings)
+	require.NoError(t, err)
+
+	pkcs11Settings := &settings.PKCS11Settings{
+		ModulePath: "/usr/lib/softhsm/libsofthsm2.so",
+		SOPin:      "123456",
+		UserPin:    "234567",
+		SlotID:     "0x0",
+	}
+	pkcs11Handler, err := cryptography.NewPKCS11Handler(pkcs11Settings, logger)
+	require.NoError(t, err)
+	require.NoError(t, pkcs11Handler.InitializeToken(tokenLabel))
+
+	keyConnectorSettings := &settings.KeyConnectorSettings{
+		CloudProvider: "pkcs11",
+		TokenLabel:    tokenLabel,
+	}
+
+	vaultConnector, err := NewPKCS11VaultConnector(keyConnectorSettings, pkcs11Handler, logger)
+	require.NoError(t, err)
+
+	return &PKCS11VaultConnectorTest{
+		vaultConnector: vaultConnector,
+	}
+}
+
+func TestPKCS11VaultConnector_UploadDownloadReplaceDelete(t *testing.T) {
+	pvct := NewPKCS11VaultConnectorTest(t, "VaultKeys")
+	ctx := context.Background()
+
+	cryptoKeyMeta, err := pvct.vaultConnector.Upload(ctx, []byte("This is a test key."), uuid.New().String(), uuid.New().String(), uuid.New().String(), "symmetric", "AES", 256)
+	require.NoError(t, err)
+	assert.False(t, cryptoKeyMeta.TokenResident())
+
+	keyBytes, err := pvct.vaultConnector.Download(ctx, cryptoKeyMeta.ID, cryptoKeyMeta.KeyPairID, cryptoKeyMeta.Type)
+	require.NoError(t, err)
+	assert.Equal(t, []byte("This is a test key."), keyBytes)
+
+	err = pvct.vaultConnector.Replace(ctx, []byte("This is a replaced key."), cryptoKeyMeta.ID, cryptoKeyMeta.KeyPairID, cryptoKeyMeta.Type)
+	require.NoError(t, err)
+	keyBytes, err = pvct.vaultConnector.Download(ctx, cryptoKeyMeta.ID, cryptoKeyMeta.KeyPairID, cryptoKeyMeta.Type)
+	require.NoError(t, err)
+	assert.Equal(t, []byte("This is a replaced key."), keyBytes)
+
+	err = pvct.vaultConnector.Delete(ctx, cryptoKeyMeta.ID, cryptoKeyMeta.KeyPairID, cryptoKeyMeta.Type)
+	require.NoError(t, err)
+	_, err = pvct.vaultConnector.Download(ctx, cryptoKeyMeta.ID, cryptoKeyMeta.KeyPairID, cryptoKeyMeta.Type)
+	assert.Error(t, err)
+}
+
+func TestPKCS11VaultConnector_Generate(t *testing.T) {
+	pvct := NewPKCS11VaultConnectorTest(t, "VaultKeys")
+	ctx := context.Background()
+
+	cryptoKeyMetas, err := pvct.vaultConnector.Generate(ctx, uuid.New().String(), uuid.New().String(), "RSA", 2048)
+	require.NoError(t, err)
+	require.Len(t, cryptoKeyMetas, 2)
+	privateKeyMeta, publicKeyMeta := cryptoKeyMetas[0], cryptoKeyMetas[1]
+	assert.Equal(t, "private", privateKeyMeta.Type)
+	assert.Equal(t, "public", publicKeyMeta.Type)
+	assert.Equal(t, "VaultKeys", privateKeyMeta.TokenLabel)
+	assert.Equal(t, privateKeyMeta.KeyPairID, privateKeyMeta.TokenObjectLabel)
+
+	// Private keys never leave the token
+	_, err = pvct.vaultConnector.Download(ctx, privateKeyMeta.ID, privateKeyMeta.KeyPairID, privateKeyMeta.Type)
+	assert.ErrorIs(t, err, keys.ErrExportForbidden)
+
+	publicKeyBytes, err := pvct.vaultConnector.Download(ctx, publicKeyMeta.ID, publicKeyMeta.KeyPairID, publicKeyMeta.Type)
+	require.NoError(t, err)
+	publicKey, err := x509.ParsePKIXPublicKey(publicKeyBytes)
+	require.NoError(t, err)
+	rsaPublicKey, ok := publicKey.(*rsa.PublicKey)
+	require.True(t, ok)
+
+	signer, err := pvct.vaultConnector.Signer(ctx, privateKeyMeta)
+	require.NoError(t, err)
+	digest := sha256.Sum256([]byte("This is some data to sign."))
+	signature, err := signer.Sign(rand.Reader, digest[:], crypto.SHA256)
+	require.NoError(t, err)
+	assert.NoError(t, rsa.VerifyPKCS1v15(rsaPublicKey, crypto.SHA256, digest[:], signature))
+
+	decrypter, err := pvct.vaultConnector.Decrypter(ctx, privateKeyMeta)
+	require.NoError(t, err)
+	ciphertext, err := rsa.EncryptPKCS1v15(rand.Reader, rsaPublicKey, []byte("This is a secret."))
+	require.NoError(t, err)
+	plaintext, err := decrypter.Decrypt(rand.Reader, ciphertext, nil)
+	require.NoError(t, err)
+	assert.Equal(t, []byte("This is a secret."), plaintext)
+
+	for _, cryptoKeyMeta := range cryptoKeyMetas {
+		err = pvct.vaultConnector.Delete(ctx, cryptoKeyMeta.ID, cryptoKeyMeta.KeyPairID, cryptoKeyMeta.Type)
+		require.NoError(t, err)
+	}
+	_, err = pvct.vaultConnector.Signer(ctx, privateKeyMeta)
+	assert.Error(t, err)
+}
+
+func TestPKCS11VaultConnector_GenerateUnsupportedAlgorithm(t *testing.T) {
+	pvct := NewPKCS11VaultConnectorTest(t, "VaultKeys")
+
+	_, err := pvct.vaultConnector.Generate(context.Background(), uuid.New().String(), uuid.New().String(), "AES", 256)
+	assert.Error(t, err)
+}
@@ -0,0 +1,154 @@
+//go:build unit
+// +build unit
+
+package connector
+
+import (
+	"context"
+	"crypto"
+	"crypto/rand"
+	"crypto/rsa"
+	"crypto/x509"
+	"fmt"
+	"testing"
+
+	"crypto_vault_service/internal/domain/keys"
+	"crypto_vault_service/internal/infrastructure/cryptography"
+	"crypto_vault_service/internal/infrastructure/logger"
+	"crypto_vault_service/internal/infrastructure/settings"
+
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// fakePKCS11Handler keeps data objects and RSA key pairs in memory, standing in for a token
+type fakePKCS11Handler struct {
+	cryptography.PKCS11Handler
+	data     map[string][]byte
+	keyPairs map[string]*rsa.PrivateKey
+}
+
+func (h *fakePKCS11Handler) AddKey(label, objectLabel, keyType string, keySize uint) error {
+	privateKey, err := rsa.GenerateKey(rand.Reader, int(keySize))
+	if err != nil {
+		return err
+	}
+	h.keyPairs[objectLabel] = privateKey
+	return nil
+}
+
+func (h *fakePKCS11Handler) DeleteObject(label, objectType, objectLabel string) error {
+	objects := map[string]bool{"data": h.data[objectLabel] != nil, "privkey": h.keyPairs[objectLabel] != nil, "pubkey": h.keyPairs[objectLabel] != nil}
+	if !objects[objectType] {
+		return fmt.Errorf("%w", cryptography.ErrObjectNotFound)
+	}
+	if objectType == "data" {
+		delete(h.data, objectLabel)
+	} else {
+		delete(h.keyPairs, objectLabel)
+	}
+	return nil
+}
+
+func (h *fakePKCS11Handler) PublicKey(label, objectLabel string) (crypto.PublicKey, error) {
+	privateKey, ok := h.keyPairs[objectLabel]
+	if !ok {
+		return nil, fmt.Errorf("%w", cryptography.ErrObjectNotFound)
+	}
+	return privateKey.Public(), nil
+}
+
+func (h *fakePKCS11Handler) Signer(label, objectLabel string) (crypto.Signer, error) {
+	privateKey, ok := h.keyPairs[objectLabel]
+	if !ok {
+		return nil, fmt.Errorf("%w", cryptography.ErrObjectNotFound)
+	}
+	return privateKey, nil
+}
+
+func (h *fakePKCS11Handler) AddData(label, objectLabel string, data []byte) error {
+	h.data[objectLabel] = data
+	return nil
+}
+
+func (h *fakePKCS11Handler) ReadData(label, objectLabel string) ([]byte, error) {
+	data, ok := h.data[objectLabel]
+	if !ok {
+		return nil, fmt.Errorf("%w", cryptography.ErrObjectNotFound)
+	}
+	return data, nil
+}
+
+// newFakePKCS11VaultConnector creates a pkcs11VaultConnector storing keys in a fakePKCS11Handler
+func newFakePKCS11VaultConnector(t *testing.T) (TokenVaultConnector, *fakePKCS11Handler) {
+	logger, err := logger.GetLogger(&settings.LoggerSettings{LogLevel: "info", LogType: "console"})
+	require.NoError(t, err)
+
+	handler := &fakePKCS11Handler{data: map[string][]byte{}, keyPairs: map[string]*rsa.PrivateKey{}}
+	vaultConnector, err := NewPKCS11VaultConnector(&settings.KeyConnectorSettings{CloudProvider: "pkcs11", TokenLabel: "vault-keys"}, handler, logger)
+	require.NoError(t, err)
+
+	return vaultConnector, handler
+}
+
+func TestPKCS11VaultConnector_InvalidSettings(t *testing.T) {
+	_, err := NewPKCS11VaultConnector(&settings.KeyConnectorSettings{CloudProvider: "pkcs11"}, &fakePKCS11Handler{}, nil)
+	assert.Error(t, err)
+}
+
+func TestPKCS11VaultConnector_UploadedKeys(t *testing.T) {
+	vaultConnector, handler := newFakePKCS11VaultConnector(t)
+	ctx := context.Background()
+
+	cryptoKeyMeta, err := vaultConnector.Upload(ctx, []byte("wrapped key"), uuid.New().String(), uuid.New().String(), uuid.New().String(), "symmetric", "AES", 256)
+	require.NoError(t, err)
+	assert.False(t, cryptoKeyMeta.TokenResident())
+	assert.Contains(t, handler.data, cryptoKeyMeta.KeyPairID+"/"+cryptoKeyMeta.ID+"-symmetric")
+
+	require.NoError(t, vaultConnector.Replace(ctx, []byte("rewrapped key"), cryptoKeyMeta.ID, cryptoKeyMeta.KeyPairID, cryptoKeyMeta.Type))
+	keyBytes, err := vaultConnector.Download(ctx, cryptoKeyMeta.ID, cryptoKeyMeta.KeyPairID, cryptoKeyMeta.Type)
+	require.NoError(t, err)
+	assert.Equal(t, []byte("rewrapped key"), keyBytes)
+
+	require.NoError(t, vaultConnector.Delete(ctx, cryptoKeyMeta.ID, cryptoKeyMeta.KeyPairID, cryptoKeyMeta.Type))
+	assert.Empty(t, handler.data)
+
+	err = vaultConnector.Replace(ctx, []byte("rewrapped key"), cryptoKeyMeta.ID, cryptoKeyMeta.KeyPairID, cryptoKeyMeta.Type)
+	assert.ErrorIs(t, err, cryptography.ErrObjectNotFound)
+}
+
+func TestPKCS11VaultConnector_GeneratedKeys(t *testing.T) {
+	vaultConnector, handler := newFakePKCS11VaultConnector(t)
+	ctx := context.Background()
+	keyPairID := uuid.New().String()
+
+	cryptoKeyMetas, err := vaultConnector.Generate(ctx, uuid.New().String(), keyPairID, "RSA", 2048)
+	require.NoError(t, err)
+	require.Len(t, cryptoKeyMetas, 2)
+	for _, cryptoKeyMeta := range cryptoKeyMetas {
+		assert.True(t, cryptoKeyMeta.TokenResident())
+		assert.Equal(t, keyPairID, cryptoKeyMeta.TokenObjectLabel)
+		assert.NoError(t, cryptoKeyMeta.Validate())
+	}
+	privateKeyMeta, publicKeyMeta := cryptoKeyMetas[0], cryptoKeyMetas[1]
+
+	_, err = vaultConnector.Download(ctx, privateKeyMeta.ID, keyPairID, privateKeyMeta.Type)
+	assert.ErrorIs(t, err, keys.ErrExportForbidden)
+
+	publicKeyBytes, err := vaultConnector.Download(ctx, publicKeyMeta.ID, keyPairID, publicKeyMeta.Type)
+	require.NoError(t, err)
+	publicKey, err := x509.ParsePKIXPublicKey(publicKeyBytes)
+	require.NoError(t, err)
+	assert.True(t, handler.keyPairs[keyPairID].PublicKey.Equal(publicKey))
+
+	signer, err := vaultConnector.Signer(ctx, privateKeyMeta)
+	require.NoError(t, err)
+	assert.True(t, handler.keyPairs[keyPairID].PublicKey.Equal(signer.Public()))
+
+	require.NoError(t, vaultConnector.Delete(ctx, privateKeyMeta.ID, keyPairID, privateKeyMeta.Type))
+	assert.Empty(t, handler.keyPairs)
+
+	_, err = vaultConnector.Generate(ctx, uuid.New().String(), uuid.New().String(), "AES", 256)
+	assert.Error(t, err)
+}
@@ -0,0 +1,127 @@
+package connector
+
+import (
+	"context"
+	"crypto_vault_service/internal/infrastructure/logger"
+	"crypto_vault_service/internal/infrastructure/settings"
+	"fmt"
+	"io"
+	"path/filepath"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/credentials"
+	"github.com/aws/aws-sdk-go-v2/feature/s3/manager"
+	"github.com/aws/aws-sdk-go-v2/service/s3"
+	"github.com/aws/aws-sdk-go-v2/service/s3/types"
+)
+
+// s3UploadPartSize is the part size of multipart uploads. Objects smaller than a single part are uploaded with a single request.
+const s3UploadPartSize = 8 * 1024 * 1024
+
+// s3BlobConnector is a struct that holds the S3 client and implements the BlobConnector interfaces.
+// It works with AWS S3 as well as S3-compatible object storage like MinIO.
+type s3BlobConnector struct {
+	client   *s3.Client
+	uploader *manager.Uploader
+	bucket   string
+	logger   logger.Logger
+}
+
+// NewS3BlobConnector creates a new s3BlobConnector instance using static credentials.
+// It returns the connector and any error encountered during the initialization.
+func NewS3BlobConnector(ctx context.Context, settings *settings.BlobConnectorSettings, logger logger.Logger) (BlobConnector, error) {
+	if err := settings.Validate(); err != nil {
+		return nil, fmt.Errorf("failed to validate settings: %w", err)
+	}
+
+	client := newS3Client(settings.Endpoint, settings.Region, settings.AccessKeyID, settings.SecretAccessKey, settings.UsePathStyle)
+	createS3Bucket(ctx, client, settings.Bucket, settings.Region)
+
+	return &s3BlobConnector{
+		client: client,
+		uploader: manager.NewUploader(client, func(uploader *manager.Uploader) {
+			uploader.PartSize = s3UploadPartSize
+		}),
+		bucket: settings.Bucket,
+		logger: logger,
+	}, nil
+}
+
+// Upload streams the content of a blob to S3, switching to a multipart upload for content exceeding a single part
+func (sbc *s3BlobConnector) Upload(ctx context.Context, content io.Reader, blobID, blobName string) error {
+	fullBlobName := filepath.ToSlash(fmt.Sprintf("%s/%s", blobID, blobName))
+
+	_, err := sbc.uploader.Upload(ctx, &s3.PutObjectInput{
+		Bucket: aws.String(sbc.bucket),
+		Key:    aws.String(fullBlobName),
+		Body:   content,
+	})
+	if err != nil {
+		return fmt.Errorf("failed to upload blob '%s': %w", fullBlobName, err)
+	}
+
+	sbc.logger.Info(fmt.Sprintf("Blob '%s' uploaded successfully", fullBlobName))
+	return nil
+}
+
+// Download retrieves a blob's content by its ID and name, and returns the data as a stream.
+func (sbc *s3BlobConnector) Download(ctx context.Context, blobID, blobName string) (io.ReadCloser, error) {
+	fullBlobName := fmt.Sprintf("%s/%s", blobID, blobName)
+
+	output, err := sbc.client.GetObject(ctx, &s3.GetObjectInput{
+		Bucket: aws.String(sbc.bucket),
+		Key:    aws.String(fullBlobName),
+	})
+	if err != nil {
+		return nil, fmt.Errorf("failed to download blob '%s': %w", fullBlobName, err)
+	}
+
+	sbc.logger.Info(fmt.Sprintf("Blob '%s' downloaded successfully", fullBlobName))
+	return output.Body, nil
+}
+
+// Delete deletes a blob from S3 by its ID and Name, and returns any error encountered.
+func (sbc *s3BlobConnector) Delete(ctx context.Context, blobID, blobName string) error {
+	fullBlobName := fmt.Sprintf("%s/%s", blobID, blobName)
+
+	_, err := sbc.client.DeleteObject(ctx, &s3.DeleteObjectInput{
+		Bucket: aws.String(sbc.bucket),
+		Key:    aws.String(fullBlobName),
+	})
+	if err != nil {
+		return fmt.Errorf("failed to delete blob '%s': %w", fullBlobName, err)
+	}
+
+	sbc.logger.Info(fmt.Sprintf("Blob '%s' deleted successfully", fullBlobName))
+	return nil
+}
+
+// newS3Client creates an S3 client with static credentials.
+// A custom endpoint addresses S3-compatible services, which commonly require path-style addressing.
+func newS3Client(endpoint, region, accessKeyID, secretAccessKey string, usePathStyle bool) *s3.Client {
+	return s3.New(s3.Options{
+		Region:       region,
+		Credentials:  credentials.NewStaticCredentialsProvider(accessKeyID, secretAccessKey, ""),
+		UsePathStyle: usePathStyle,
+	}, func(options *s3.Options) {
+		if endpoint != "" {
+			options.BaseEndpoint = aws.String(endpoint)
+		}
+	})
+}
+
+// createS3Bucket creates the bucket if it does not exist yet.
+// Errors are ignored, since the bucket commonly exists already or is provisioned externally.
+func createS3Bucket(ctx context.Context, client *s3.Client, bucket, region string) {
+	input := &s3.CreateBucketInput{
+		Bucket: aws.String(bucket),
+	}
+	// us-east-1 is the default location and must not be specified as constraint
+	if region != "us-east-1" {
+		input.CreateBucketConfiguration = &types.CreateBucketConfiguration{
+			LocationConstraint: types.BucketLocationConstraint(region),
+		}
+	}
+
+	_, _ = client.CreateBucket(ctx, input)
+}
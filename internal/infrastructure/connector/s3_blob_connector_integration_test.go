@@ -0,0 +1,140 @@
+//go:build integration
+// +build integration
+
+package connector
+
+import (
+	"bytes"
+	"context"
+	"crypto/rand"
+	"io"
+	"testing"
+
+	"crypto_vault_service/internal/infrastructure/logger"
+	"crypto_vault_service/internal/infrastructure/settings"
+
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// S3BlobConnectorTest encapsulates common logic for tests
+type S3BlobConnectorTest struct {
+	blobConnector BlobConnector
+}
+
+// NewS3BlobConnectorTest initializes and returns a new S3BlobConnectorTest connected to the local MinIO instance
+func NewS3BlobConnectorTest(t *testing.T, endpoint, bucket string) *S3BlobConnectorTest {
+
+	loggerSettings := &settings.LoggerSettings{
+		LogLevel: "info",
+		LogType:  "console",
+		FilePath: "",
+	}
+	logger, err := logger.GetLogger(loggerSettings)
+	require.NoError(t, err)
+	blobConnectorSettings := &settings.BlobConnectorSettings{
+		CloudProvider:   "s3",
+		Endpoint:        endpoint,
+		Region:          "us-east-1",
+		Bucket:          bucket,
+		UsePathStyle:    true,
+		AccessKeyID:     "minioadmin",
+		SecretAccessKey: "minioadmin",
+	}
+
+	ctx := context.Background()
+	blobConnector, err := NewS3BlobConnector(ctx, blobConnectorSettings, logger)
+	require.NoError(t, err)
+
+	return &S3BlobConnectorTest{
+		blobConnector: blobConnector,
+	}
+}
+
+func TestS3BlobConnector_Upload(t *testing.T) {
+
+	sbct := NewS3BlobConnectorTest(t, "http://127.0.0.1:9000", "testblobs")
+
+	testFileContent := []byte("This is test file content")
+	testFileName := "testfile.txt"
+	blobID := uuid.New().String()
+	ctx := context.Background()
+
+	err := sbct.blobConnector.Upload(ctx, bytes.NewReader(testFileContent), blobID, testFileName)
+	require.NoError(t, err)
+
+	err = sbct.blobConnector.Delete(ctx, blobID, testFileName)
+	require.NoError(t, err)
+}
+
+func TestS3BlobConnector_Upload_Multipart(t *testing.T) {
+
+	sbct := NewS3BlobConnectorTest(t, "http://127.0.0.1:9000", "testblobs")
+
+	// Exceeds the part size, so the content is uploaded in multiple parts
+	testFileContent := make([]byte, 2*s3UploadPartSize+1024)
+	_, err := rand.Read(testFileContent)
+	require.NoError(t, err)
+	testFileName := "largetestfile.bin"
+	blobID := uuid.New().String()
+	ctx := context.Background()
+
+	// Hide the content length from the uploader, as is the case for encrypted streams
+	err = sbct.blobConnector.Upload(ctx, io.MultiReader(bytes.NewReader(testFileContent)), blobID, testFileName)
+	require.NoError(t, err)
+
+	reader, err := sbct.blobConnector.Download(ctx, blobID, testFileName)
+	require.NoError(t, err)
+	downloadedData, err := io.ReadAll(reader)
+	require.NoError(t, err)
+	require.NoError(t, reader.Close())
+
+	assert.Equal(t, testFileContent, downloadedData)
+
+	err = sbct.blobConnector.Delete(ctx, blobID, testFileName)
+	require.NoError(t, err)
+}
+
+func TestS3BlobConnector_Download(t *testing.T) {
+
+	sbct := NewS3BlobConnectorTest(t, "http://127.0.0.1:9000", "testblobs")
+
+	testFileContent := []byte("This is test file content")
+	testFileName := "testfile.pem"
+	blobID := uuid.New().String()
+	ctx := context.Background()
+
+	err := sbct.blobConnector.Upload(ctx, bytes.NewReader(testFileContent), blobID, testFileName)
+	require.NoError(t, err)
+
+	reader, err := sbct.blobConnector.Download(ctx, blobID, testFileName)
+	require.NoError(t, err)
+	downloadedData, err := io.ReadAll(reader)
+	require.NoError(t, err)
+	require.NoError(t, reader.Close())
+
+	assert.Equal(t, testFileContent, downloadedData)
+
+	err = sbct.blobConnector.Delete(ctx, blobID, testFileName)
+	require.NoError(t, err)
+}
+
+func TestS3BlobConnector_Delete(t *testing.T) {
+
+	sbct := NewS3BlobConnectorTest(t, "http://127.0.0.1:9000", "testblobs")
+
+	testFileContent := []byte("This is test file content")
+	testFileName := "testfile.pem"
+	blobID := uuid.New().String()
+	ctx := context.Background()
+
+	err := sbct.blobConnector.Upload(ctx, bytes.NewReader(testFileContent), blobID, testFileName)
+	require.NoError(t, err)
+
+	err = sbct.blobConnector.Delete(ctx, blobID, testFileName)
+	require.NoError(t, err)
+
+	_, err = sbct.blobConnector.Download(ctx, blobID, testFileName)
+	assert.Error(t, err)
+}
@@ -0,0 +1,129 @@
+package connector
+
+import (
+	"bytes"
+	"context"
+	"crypto_vault_service/internal/domain/keys"
+	"crypto_vault_service/internal/infrastructure/logger"
+	"crypto_vault_service/internal/infrastructure/settings"
+	"fmt"
+	"io"
+	"time"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/service/s3"
+)
+
+// s3VaultConnector stores keys as objects in an S3 bucket and implements the VaultConnector interface.
+// This is a temporary implementation and may later be replaced with more specialized external key management systems like AWS KMS.
+type s3VaultConnector struct {
+	client *s3.Client
+	bucket string
+	logger logger.Logger
+}
+
+// NewS3VaultConnector creates a new instance of s3VaultConnector, which connects to AWS S3 or S3-compatible object storage.
+func NewS3VaultConnector(ctx context.Context, settings *settings.KeyConnectorSettings, logger logger.Logger) (VaultConnector, error) {
+	if err := settings.Validate(); err != nil {
+		return nil, fmt.Errorf("failed to validate settings: %w", err)
+	}
+
+	client := newS3Client(settings.Endpoint, settings.Region, settings.AccessKeyID, settings.SecretAccessKey, settings.UsePathStyle)
+	createS3Bucket(ctx, client, settings.Bucket, settings.Region)
+
+	return &s3VaultConnector{
+		client: client,
+		bucket: settings.Bucket,
+		logger: logger,
+	}, nil
+}
+
+// Upload uploads bytes of a single key to S3
+// and returns the metadata of the uploaded key.
+func (vc *s3VaultConnector) Upload(ctx context.Context, bytes []byte, keyID, userID, keyPairID, keyType, keyAlgorihm string, keySize uint32) (*keys.CryptoKeyMeta, error) {
+	fullKeyName := fmt.Sprintf("%s/%s-%s", keyPairID, keyID, keyType)
+
+	cryptoKeyMeta := &keys.CryptoKeyMeta{
+		ID:              keyID,
+		KeyPairID:       keyPairID,
+		Type:            keyType,
+		Algorithm:       keyAlgorihm,
+		KeySize:         keySize,
+		DateTimeCreated: time.Now(),
+		UserID:          userID,
+	}
+
+	if err := vc.put(ctx, fullKeyName, bytes); err != nil {
+		return nil, fmt.Errorf("failed to upload object '%s' to storage: %w", fullKeyName, err)
+	}
+
+	vc.logger.Info(fmt.Sprintf("uploaded object %s", fullKeyName))
+	return cryptoKeyMeta, nil
+}
+
+// Download retrieves a key's content by its IDs and Type and returns the data as a byte slice.
+func (vc *s3VaultConnector) Download(ctx context.Context, keyID, keyPairID, keyType string) ([]byte, error) {
+	fullKeyName := fmt.Sprintf("%s/%s-%s", keyPairID, keyID, keyType)
+
+	output, err := vc.client.GetObject(ctx, &s3.GetObjectInput{
+		Bucket: aws.String(vc.bucket),
+		Key:    aws.String(fullKeyName),
+	})
+	if err != nil {
+		return nil, fmt.Errorf("failed to download object '%s': %w", fullKeyName, err)
+	}
+	defer func() {
+		if err := output.Body.Close(); err != nil {
+			vc.logger.Info(fmt.Sprintf("Failed to close object '%s': %v", fullKeyName, err))
+		}
+	}()
+
+	keyBytes, err := io.ReadAll(output.Body)
+	if err != nil {
+		return nil, fmt.Errorf("failed to read data from object '%s': %w", fullKeyName, err)
+	}
+
+	vc.logger.Info(fmt.Sprintf("downloaded object %s", fullKeyName))
+	return keyBytes, nil
+}
+
+// Replace overwrites the content of an existing key in S3 by its IDs and Type.
+func (vc *s3VaultConnector) Replace(ctx context.Context, bytes []byte, keyID, keyPairID, keyType string) error {
+	fullKeyName := fmt.Sprintf("%s/%s-%s", keyPairID, keyID, keyType)
+
+	if err := vc.put(ctx, fullKeyName, bytes); err != nil {
+		return fmt.Errorf("failed to replace object '%s': %w", fullKeyName, err)
+	}
+
+	vc.logger.Info(fmt.Sprintf("replaced object %s", fullKeyName))
+	return nil
+}
+
+// Delete deletes a key from S3 by its IDs and Type.
+func (vc *s3VaultConnector) Delete(ctx context.Context, keyID, keyPairID, keyType string) error {
+	fullKeyName := fmt.Sprintf("%s/%s-%s", keyPairID, keyID, keyType)
+
+	_, err := vc.client.DeleteObject(ctx, &s3.DeleteObjectInput{
+		Bucket: aws.String(vc.bucket),
+		Key:    aws.String(fullKeyName),
+	})
+	if err != nil {
+		return fmt.Errorf("failed to delete object '%s': %w", fullKeyName, err)
+	}
+
+	vc.logger.Info(fmt.Sprintf("deleted object %s", fullKeyName))
+	return nil
+}
+
+// put writes the key bytes to the object with the given name. Keys are small, so a single request suffices.
+func (vc *s3VaultConnector) put(ctx context.Context, fullKeyName string, keyBytes []byte) error {
+	_, err := vc.client.PutObject(ctx, &s3.PutObjectInput{
+		Bucket: aws.String(vc.bucket),
+		Key:    aws.String(fullKeyName),
+		Body:   bytes.NewReader(keyBytes),
+	})
+	if err != nil {
+		return fmt.Errorf("%w", err)
+	}
+	return nil
+}
@@ -0,0 +1,151 @@
+//go:build integration
+// +build integration
+
+package connector
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"crypto_vault_service/internal/infrastructure/logger"
+	"crypto_vault_service/internal/infrastructure/settings"
+
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// S3VaultConnectorTest encapsulates common logic for tests
+type S3VaultConnectorTest struct {
+	vaultConnector VaultConnector
+}
+
+// NewS3VaultConnectorTest initializes and returns a new S3VaultConnectorTest connected to the local MinIO instance
+func NewS3VaultConnectorTest(t *testing.T, endpoint, bucket string) *S3VaultConnectorTest {
+
+	loggerSettings := &settings.LoggerSettings{
+		LogLevel: "info",
+		LogType:  "console",
+		FilePath: "",
+	}
+	logger, err := logger.GetLogger(loggerSettings)
+	require.NoError(t, err)
+
+	keyConnectorSettings := &settings.KeyConnectorSettings{
+		CloudProvider:   "s3",
+		Endpoint:        endpoint,
+		Region:          "us-east-1",
+		Bucket:          bucket,
+		UsePathStyle:    true,
+		AccessKeyID:     "minioadmin",
+		SecretAccessKey: "minioadmin",
+	}
+
+	ctx := context.Background()
+
+	vaultConnector, err := NewS3VaultConnector(ctx, keyConnectorSettings, logger)
+	require.NoError(t, err)
+
+	return &S3VaultConnectorTest{
+		vaultConnector: vaultConnector,
+	}
+}
+
+func TestS3VaultConnector_Upload(t *testing.T) {
+	svct := NewS3VaultConnectorTest(t, "http://127.0.0.1:9000", "testkeys")
+
+	testFileContent := []byte("This is a test file content.")
+
+	userID := uuid.New().String()
+	keyPairID := uuid.New().String()
+	keyAlgorithm := "RSA"
+	keyType := "private"
+	keySize := 2048
+	ctx := context.Background()
+
+	cryptoKeyMeta, err := svct.vaultConnector.Upload(ctx, testFileContent, uuid.New().String(), userID, keyPairID, keyType, keyAlgorithm, uint32(keySize))
+	require.NoError(t, err)
+
+	assert.NotEmpty(t, cryptoKeyMeta.ID)
+	assert.Equal(t, keyType, cryptoKeyMeta.Type)
+	assert.Equal(t, userID, cryptoKeyMeta.UserID)
+	assert.WithinDuration(t, time.Now(), cryptoKeyMeta.DateTimeCreated, time.Second)
+
+	err = svct.vaultConnector.Delete(ctx, cryptoKeyMeta.ID, cryptoKeyMeta.KeyPairID, cryptoKeyMeta.Type)
+	require.NoError(t, err)
+}
+
+func TestS3VaultConnector_Download(t *testing.T) {
+	svct := NewS3VaultConnectorTest(t, "http://127.0.0.1:9000", "testkeys")
+
+	testFileContent := []byte("This is a test file content.")
+
+	userID := uuid.New().String()
+	keyPairID := uuid.New().String()
+	keyAlgorithm := "RSA"
+	keyType := "private"
+	keySize := 2048
+	ctx := context.Background()
+
+	cryptoKeyMeta, err := svct.vaultConnector.Upload(ctx, testFileContent, uuid.New().String(), userID, keyPairID, keyType, keyAlgorithm, uint32(keySize))
+	require.NoError(t, err)
+
+	downloadedData, err := svct.vaultConnector.Download(ctx, cryptoKeyMeta.ID, cryptoKeyMeta.KeyPairID, cryptoKeyMeta.Type)
+	require.NoError(t, err)
+
+	assert.Equal(t, testFileContent, downloadedData)
+
+	err = svct.vaultConnector.Delete(ctx, cryptoKeyMeta.ID, cryptoKeyMeta.KeyPairID, cryptoKeyMeta.Type)
+	require.NoError(t, err)
+}
+
+func TestS3VaultConnector_Replace(t *testing.T) {
+	svct := NewS3VaultConnectorTest(t, "http://127.0.0.1:9000", "testkeys")
+
+	testFileContent := []byte("This is a test file content.")
+	replacedFileContent := []byte("This is the replaced test file content.")
+
+	userID := uuid.New().String()
+	keyPairID := uuid.New().String()
+	keyAlgorithm := "RSA"
+	keyType := "private"
+	keySize := 2048
+	ctx := context.Background()
+
+	cryptoKeyMeta, err := svct.vaultConnector.Upload(ctx, testFileContent, uuid.New().String(), userID, keyPairID, keyType, keyAlgorithm, uint32(keySize))
+	require.NoError(t, err)
+
+	err = svct.vaultConnector.Replace(ctx, replacedFileContent, cryptoKeyMeta.ID, cryptoKeyMeta.KeyPairID, cryptoKeyMeta.Type)
+	require.NoError(t, err)
+
+	downloadedData, err := svct.vaultConnector.Download(ctx, cryptoKeyMeta.ID, cryptoKeyMeta.KeyPairID, cryptoKeyMeta.Type)
+	require.NoError(t, err)
+
+	assert.Equal(t, replacedFileContent, downloadedData)
+
+	err = svct.vaultConnector.Delete(ctx, cryptoKeyMeta.ID, cryptoKeyMeta.KeyPairID, cryptoKeyMeta.Type)
+	require.NoError(t, err)
+}
+
+func TestS3VaultConnector_Delete(t *testing.T) {
+	svct := NewS3VaultConnectorTest(t, "http://127.0.0.1:9000", "testkeys")
+
+	testFileContent := []byte("This is a test file content.")
+
+	userID := uuid.New().String()
+	keyPairID := uuid.New().String()
+	keyAlgorithm := "RSA"
+	keyType := "private"
+	keySize := 2048
+	ctx := context.Background()
+
+	cryptoKeyMeta, err := svct.vaultConnector.Upload(ctx, testFileContent, uuid.New().String(), userID, keyPairID, keyType, keyAlgorithm, uint32(keySize))
+	require.NoError(t, err)
+
+	err = svct.vaultConnector.Delete(ctx, cryptoKeyMeta.ID, cryptoKeyMeta.KeyPairID, cryptoKeyMeta.Type)
+	require.NoError(t, err)
+
+	_, err = svct.vaultConnector.Download(ctx, cryptoKeyMeta.ID, cryptoKeyMeta.KeyPairID, cryptoKeyMeta.Type)
+	assert.Error(t, err)
+}
@@ -3,19 +3,48 @@ package connector
 import (
 	"context"
 	"crypto_vault_service/internal/domain/keys"
+	"crypto_vault_service/internal/infrastructure/cryptography"
+	"crypto_vault_service/internal/infrastructure/logger"
+	"crypto_vault_service/internal/infrastructure/settings"
+	"fmt"
 )
 
 // VaultConnector is an interface for interacting with custom key storage.
-// The current implementation uses Azure Blob Storage, but this may be replaced
+// The current implementations use Azure Blob Storage, S3-compatible object storage, a PKCS#11 token or the local filesystem, but this may be replaced
 // with Azure Key Vault, AWS KMS, or any other cloud-based key management system in the future.
 type VaultConnector interface {
-	// Upload uploads bytes of a single file to Blob Storage
+	// Upload uploads bytes of a single file under the given key ID to Blob Storage
 	// and returns the metadata for each uploaded byte stream.
-	Upload(ctx context.Context, bytes []byte, userID, keyPairID, keyType, keyAlgorihm string, keySize uint32) (*keys.CryptoKeyMeta, error)
+	// The key ID is chosen by the caller, as it is bound to the key material wrapped with the master key.
+	Upload(ctx context.Context, bytes []byte, keyID, userID, keyPairID, keyType, keyAlgorihm string, keySize uint32) (*keys.CryptoKeyMeta, error)
 
 	// Download retrieves a key's content by its IDs and type and returns the data as a byte slice.
 	Download(ctx context.Context, keyID, keyPairID, keyType string) ([]byte, error)
 
+	// Replace overwrites the content of an existing key identified by its IDs and type and returns any error encountered.
+	Replace(ctx context.Context, bytes []byte, keyID, keyPairID, keyType string) error
+
 	// Delete deletes a key from Vault Storage by its IDs and type and returns any error encountered.
 	Delete(ctx context.Context, keyID, keyPairID, keyType string) error
 }
+
+// NewVaultConnector creates the VaultConnector for the configured cloud provider.
+// The PKCS#11 settings are only used by the pkcs11 cloud provider.
+func NewVaultConnector(ctx context.Context, settings *settings.KeyConnectorSettings, pkcs11Settings *settings.PKCS11Settings, logger logger.Logger) (VaultConnector, error) {
+	switch settings.CloudProvider {
+	case "azure":
+		return NewAzureVaultConnector(ctx, settings, logger)
+	case "filesystem":
+		return NewFilesystemVaultConnector(settings, logger)
+	case "s3":
+		return NewS3VaultConnector(ctx, settings, logger)
+	case "pkcs11":
+		pkcs11Handler, err := cryptography.NewPKCS11Handler(pkcs11Settings, logger)
+		if err != nil {
+			return nil, fmt.Errorf("failed to create PKCS#11 handler: %w", err)
+		}
+		return NewPKCS11VaultConnector(settings, pkcs11Handler, logger)
+	default:
+		return nil, fmt.Errorf("unsupported key connector cloud provider: %s", settings.CloudProvider)
+	}
+}
@@ -7,12 +7,45 @@ import (
 	"crypto/rand"
 	"crypto_vault_service/internal/infrastructure/logger"
 	"fmt"
+	"io"
 )
 
+// AES modes supported by the AESProcessor
+const (
+	AESModeGCM = "GCM"
+	AESModeCBC = "CBC"
+	// AESModeGCMStream encrypts segments with a random AES-256-GCM DEK, which in turn is wrapped with the AES key using AES-GCM
+	AESModeGCMStream = "GCM-STREAM"
+)
+
+// aesCiphertextMagic prefixes the versioned AES ciphertexts produced before envelopes were introduced
+var aesCiphertextMagic = []byte("CVAE")
+
+// Versions of the AES ciphertext header identifying the mode used
+const (
+	aesCiphertextVersionCBC byte = 1
+	aesCiphertextVersionGCM byte = 2
+)
+
+// aesCiphertextHeaderSize is the size in bytes of the magic followed by the version byte
+var aesCiphertextHeaderSize = len(aesCiphertextMagic) + 1
+
 // AESProcessor Interface
 type AESProcessor interface {
-	Encrypt(data, key []byte) ([]byte, error)
-	Decrypt(ciphertext, key []byte) ([]byte, error)
+	// Encrypt encrypts data with AES-GCM into an envelope, authenticating the envelope header and the optional associated data alongside
+	Encrypt(data, key []byte, keyID string, keyVersion uint32, associatedData []byte) ([]byte, error)
+	// EncryptCBC encrypts data with AES-CBC and PKCS#7 padding into an envelope. The ciphertext is not authenticated, use Encrypt unless CBC is required for interoperability
+	EncryptCBC(data, key []byte, keyID string, keyVersion uint32) ([]byte, error)
+	// Decrypt decrypts a binary or armored envelope in the mode it records, a versioned AES ciphertext preceding envelopes or a header-less legacy AES-CBC ciphertext.
+	// Associated data only applies to AES-GCM
+	Decrypt(ciphertext, key, associatedData []byte) ([]byte, error)
+	// EncryptStream writes an AES-GCM-STREAM envelope to dst and returns a writer for the plaintext, which must be closed to complete the envelope
+	EncryptStream(dst io.Writer, key []byte, keyID string, keyVersion uint32, associatedData []byte) (io.WriteCloser, error)
+	// DecryptStream returns a reader for the plaintext of an envelope read from src.
+	// Ciphertexts of modes other than AES-GCM-STREAM are read entirely before being decrypted.
+	DecryptStream(src io.Reader, key, associatedData []byte) (io.Reader, error)
+	// DetectMode returns the mode a ciphertext was encrypted with
+	DetectMode(ciphertext []byte) string
 	GenerateKey(keySize int) ([]byte, error)
 }
 
@@ -59,8 +92,93 @@ func (a *aesProcessor) GenerateKey(keySize int) ([]byte, error) {
 	return key, nil
 }
 
-// Encrypt data using AES in CBC mode
-func (a *aesProcessor) Encrypt(data, key []byte) ([]byte, error) {
+// aesCiphertextVersion returns the header version of a ciphertext preceding envelopes, or 0 for header-less legacy AES-CBC ciphertexts
+func aesCiphertextVersion(ciphertext []byte) byte {
+	if len(ciphertext) < aesCiphertextHeaderSize || !bytes.Equal(ciphertext[:len(aesCiphertextMagic)], aesCiphertextMagic) {
+		return 0
+	}
+	return ciphertext[len(aesCiphertextMagic)]
+}
+
+// DetectMode returns the mode recorded in an envelope or in the header of a versioned AES ciphertext.
+// Data that is neither was produced by the former header-less AES-CBC implementation.
+func (a *aesProcessor) DetectMode(ciphertext []byte) string {
+	if !IsEnvelope(ciphertext) {
+		if aesCiphertextVersion(ciphertext) == aesCiphertextVersionGCM {
+			return AESModeGCM
+		}
+		return AESModeCBC
+	}
+
+	envelope, err := ParseEnvelope(ciphertext)
+	if err != nil {
+		return ""
+	}
+	return envelope.Mode
+}
+
+// Encrypt data using AES in GCM mode. The envelope header is authenticated together with the associated data.
+func (a *aesProcessor) Encrypt(data, key []byte, keyID string, keyVersion uint32, associatedData []byte) ([]byte, error) {
+	if key == nil || data == nil {
+		return nil, fmt.Errorf("key and data cannot be nil")
+	}
+
+	gcm, err := newGCM(key)
+	if err != nil {
+		return nil, fmt.Errorf("%w", err)
+	}
+
+	envelope := &Envelope{
+		Algorithm:  EnvelopeAlgorithmAES,
+		Mode:       AESModeGCM,
+		KeyID:      keyID,
+		KeyVersion: keyVersion,
+		Nonce:      make([]byte, gcm.NonceSize()),
+	}
+	if _, err := rand.Read(envelope.Nonce); err != nil {
+		return nil, fmt.Errorf("failed to read random bytes for nonce: %w", err)
+	}
+
+	header, err := envelope.Header()
+	if err != nil {
+		return nil, fmt.Errorf("%w", err)
+	}
+
+	envelope.Ciphertext = gcm.Seal(nil, envelope.Nonce, data, gcmAssociatedData(header, associatedData))
+
+	ciphertext, err := envelope.Marshal()
+	if err != nil {
+		return nil, fmt.Errorf("%w", err)
+	}
+
+	a.logger.Info("AES-GCM encryption succeeded")
+	return ciphertext, nil
+}
+
+// newGCM creates an AES-GCM AEAD for the given key
+func newGCM(key []byte) (cipher.AEAD, error) {
+	block, err := aes.NewCipher(key)
+	if err != nil {
+		return nil, fmt.Errorf("failed to create new AES cipher with the provided key of length %d: %w", len(key), err)
+	}
+
+	gcm, err := cipher.NewGCM(block)
+	if err != nil {
+		return nil, fmt.Errorf("failed to create GCM: %w", err)
+	}
+
+	return gcm, nil
+}
+
+// gcmAssociatedData binds the envelope header to the caller provided associated data
+func gcmAssociatedData(header, associatedData []byte) []byte {
+	aad := make([]byte, 0, len(header)+len(associatedData))
+	aad = append(aad, header...)
+	return append(aad, associatedData...)
+}
+
+// EncryptCBC encrypts data using AES in CBC mode into an envelope
+func (a *aesProcessor) EncryptCBC(data, key []byte, keyID string, keyVersion uint32) ([]byte, error) {
 	if key == nil || data == nil {
 		return nil, fmt.Errorf("key and data cannot be nil")
 	}
@@ -72,21 +190,32 @@ func (a *aesProcessor) Encrypt(data, key []byte) ([]byte, error) {
 
 	data = pkcs7Pad(data, aes.BlockSize)
 
-	ciphertext := make([]byte, aes.BlockSize+len(data))
-	iv := ciphertext[:aes.BlockSize]
-	if _, err := rand.Read(iv); err != nil {
+	envelope := &Envelope{
+		Algorithm:  EnvelopeAlgorithmAES,
+		Mode:       AESModeCBC,
+		KeyID:      keyID,
+		KeyVersion: keyVersion,
+		Nonce:      make([]byte, aes.BlockSize),
+		Ciphertext: make([]byte, len(data)),
+	}
+	if _, err := rand.Read(envelope.Nonce); err != nil {
 		return nil, fmt.Errorf("failed to read random bytes for IV: %w", err)
 	}
 
-	mode := cipher.NewCBCEncrypter(block, iv)
-	mode.CryptBlocks(ciphertext[aes.BlockSize:], data)
+	mode := cipher.NewCBCEncrypter(block, envelope.Nonce)
+	mode.CryptBlocks(envelope.Ciphertext, data)
+
+	ciphertext, err := envelope.Marshal()
+	if err != nil {
+		return nil, fmt.Errorf("%w", err)
+	}
 
-	a.logger.Info("AES encryption succeeded")
+	a.logger.Info("AES-CBC encryption succeeded")
 	return ciphertext, nil
 }
 
-// Decrypt data using AES in CBC mode
-func (a *aesProcessor) Decrypt(ciphertext, key []byte) ([]byte, error) {
+// Decrypt data using AES in the mode recorded by the envelope
+func (a *aesProcessor) Decrypt(ciphertext, key, associatedData []byte) ([]byte, error) {
 	if key == nil || ciphertext == nil {
 		return nil, fmt.Errorf("ciphertext and key cannot be nil")
 	}
@@ -96,16 +225,209 @@ func (a *aesProcessor) Decrypt(ciphertext, key []byte) ([]byte, error) {
 		return nil, fmt.Errorf("failed to create new AES cipher with the provided key: %w", err)
 	}
 
+	if !IsEnvelope(ciphertext) {
+		return a.decryptVersioned(block, ciphertext, associatedData)
+	}
+
+	envelope, err := ParseEnvelope(ciphertext)
+	if err != nil {
+		return nil, fmt.Errorf("%w", err)
+	}
+
+	return a.decryptEnvelope(block, key, envelope, associatedData)
+}
+
+// decryptVersioned decrypts a ciphertext preceding envelopes in the mode given by its header, or a header-less legacy AES-CBC ciphertext
+func (a *aesProcessor) decryptVersioned(block cipher.Block, ciphertext, associatedData []byte) ([]byte, error) {
+	version := aesCiphertextVersion(ciphertext)
+	switch version {
+	case aesCiphertextVersionGCM:
+		gcm, err := cipher.NewGCM(block)
+		if err != nil {
+			return nil, fmt.Errorf("failed to create GCM: %w", err)
+		}
+		if len(ciphertext) < aesCiphertextHeaderSize+gcm.NonceSize() {
+			return nil, fmt.Errorf("ciphertext too short")
+		}
+		header := ciphertext[:aesCiphertextHeaderSize]
+		nonce := ciphertext[aesCiphertextHeaderSize : aesCiphertextHeaderSize+gcm.NonceSize()]
+		return a.decryptGCM(block, header, nonce, ciphertext[aesCiphertextHeaderSize+gcm.NonceSize():], associatedData)
+	case aesCiphertextVersionCBC:
+		return a.decryptIVPrefixedCBC(block, ciphertext[aesCiphertextHeaderSize:])
+	case 0:
+		return a.decryptIVPrefixedCBC(block, ciphertext)
+	default:
+		return nil, fmt.Errorf("unsupported AES ciphertext version %d", version)
+	}
+}
+
+// decryptIVPrefixedCBC decrypts AES-CBC data consisting of the IV followed by the padded data
+func (a *aesProcessor) decryptIVPrefixedCBC(block cipher.Block, ciphertext []byte) ([]byte, error) {
 	if len(ciphertext) < aes.BlockSize {
 		return nil, fmt.Errorf("ciphertext too short")
 	}
+	return a.decryptCBC(block, ciphertext[:aes.BlockSize], ciphertext[aes.BlockSize:])
+}
+
+// decryptEnvelope decrypts a parsed envelope in the mode it records
+func (a *aesProcessor) decryptEnvelope(block cipher.Block, key []byte, envelope *Envelope, associatedData []byte) ([]byte, error) {
+	if envelope.Algorithm != EnvelopeAlgorithmAES {
+		return nil, fmt.Errorf("envelope was produced with %s, not AES", envelope.Algorithm)
+	}
+
+	switch envelope.Mode {
+	case AESModeGCM:
+		header, err := envelope.Header()
+		if err != nil {
+			return nil, fmt.Errorf("%w", err)
+		}
+		return a.decryptGCM(block, header, envelope.Nonce, envelope.Ciphertext, associatedData)
+	case AESModeCBC:
+		return a.decryptCBC(block, envelope.Nonce, envelope.Ciphertext)
+	case AESModeGCMStream:
+		plainTextReader, err := a.decryptGCMStream(bytes.NewReader(envelope.Ciphertext), key, envelope, associatedData)
+		if err != nil {
+			return nil, fmt.Errorf("%w", err)
+		}
+		plainText, err := io.ReadAll(plainTextReader)
+		if err != nil {
+			return nil, fmt.Errorf("%w", err)
+		}
+		return plainText, nil
+	default:
+		return nil, fmt.Errorf("unsupported AES mode: %s", envelope.Mode)
+	}
+}
+
+// EncryptStream encrypts a stream with a random DEK wrapped by the AES key, so that nonce prefixes never repeat under the same key
+func (a *aesProcessor) EncryptStream(dst io.Writer, key []byte, keyID string, keyVersion uint32, associatedData []byte) (io.WriteCloser, error) {
+	if key == nil {
+		return nil, fmt.Errorf("key cannot be nil")
+	}
+
+	dek, err := newStreamDEK()
+	if err != nil {
+		return nil, fmt.Errorf("%w", err)
+	}
+
+	wrappingGCM, err := newGCM(key)
+	if err != nil {
+		return nil, fmt.Errorf("%w", err)
+	}
+
+	wrappingNonce := make([]byte, wrappingGCM.NonceSize())
+	if _, err := rand.Read(wrappingNonce); err != nil {
+		return nil, fmt.Errorf("failed to read random bytes for nonce: %w", err)
+	}
+
+	envelope := &Envelope{
+		Algorithm:  EnvelopeAlgorithmAES,
+		Mode:       AESModeGCMStream,
+		KeyID:      keyID,
+		KeyVersion: keyVersion,
+		WrappedDEK: wrappingGCM.Seal(wrappingNonce, wrappingNonce, dek, nil),
+	}
+
+	plainTextWriter, err := newStreamEncrypter(dst, envelope, dek, associatedData)
+	if err != nil {
+		return nil, fmt.Errorf("%w", err)
+	}
+
+	a.logger.Info("AES-GCM-STREAM encryption started")
+	return plainTextWriter, nil
+}
+
+// DecryptStream decrypts an envelope read from src. Only AES-GCM-STREAM envelopes are decrypted without buffering.
+func (a *aesProcessor) DecryptStream(src io.Reader, key, associatedData []byte) (io.Reader, error) {
+	if key == nil || src == nil {
+		return nil, fmt.Errorf("ciphertext and key cannot be nil")
+	}
+
+	reader := NewEnvelopeReader(src)
+	envelope, err := PeekEnvelope(reader)
+	if err != nil {
+		return nil, fmt.Errorf("%w", err)
+	}
+
+	if envelope == nil || envelope.Mode != AESModeGCMStream {
+		ciphertext, err := io.ReadAll(reader)
+		if err != nil {
+			return nil, fmt.Errorf("failed to read ciphertext: %w", err)
+		}
+		plainText, err := a.Decrypt(ciphertext, key, associatedData)
+		if err != nil {
+			return nil, fmt.Errorf("%w", err)
+		}
+		return bytes.NewReader(plainText), nil
+	}
+
+	if envelope.Algorithm != EnvelopeAlgorithmAES {
+		return nil, fmt.Errorf("envelope was produced with %s, not AES", envelope.Algorithm)
+	}
+
+	if _, err := ReadEnvelopeHeader(reader); err != nil {
+		return nil, fmt.Errorf("%w", err)
+	}
+
+	return a.decryptGCMStream(reader, key, envelope, associatedData)
+}
+
+// decryptGCMStream unwraps the DEK of an AES-GCM-STREAM envelope and returns a reader decrypting the segments read from src
+func (a *aesProcessor) decryptGCMStream(src io.Reader, key []byte, envelope *Envelope, associatedData []byte) (io.Reader, error) {
+	wrappingGCM, err := newGCM(key)
+	if err != nil {
+		return nil, fmt.Errorf("%w", err)
+	}
+
+	if len(envelope.WrappedDEK) < wrappingGCM.NonceSize() {
+		return nil, fmt.Errorf("wrapped DEK too short")
+	}
+
+	nonce, wrappedDEK := envelope.WrappedDEK[:wrappingGCM.NonceSize()], envelope.WrappedDEK[wrappingGCM.NonceSize():]
+	dek, err := wrappingGCM.Open(nil, nonce, wrappedDEK, nil)
+	if err != nil {
+		return nil, fmt.Errorf("failed to unwrap DEK: %w", err)
+	}
+
+	plainTextReader, err := newStreamDecrypter(src, envelope, dek, associatedData)
+	if err != nil {
+		return nil, fmt.Errorf("%w", err)
+	}
+
+	return plainTextReader, nil
+}
+
+// decryptGCM decrypts and authenticates AES-GCM data, authenticating the header it was sealed with alongside the associated data
+func (a *aesProcessor) decryptGCM(block cipher.Block, header, nonce, ciphertext, associatedData []byte) ([]byte, error) {
+	gcm, err := cipher.NewGCM(block)
+	if err != nil {
+		return nil, fmt.Errorf("failed to create GCM: %w", err)
+	}
+
+	if len(nonce) != gcm.NonceSize() || len(ciphertext) < gcm.Overhead() {
+		return nil, fmt.Errorf("ciphertext too short or invalid nonce size")
+	}
+
+	plainText, err := gcm.Open(nil, nonce, ciphertext, gcmAssociatedData(header, associatedData))
+	if err != nil {
+		return nil, fmt.Errorf("failed to authenticate ciphertext: %w", err)
+	}
+
+	a.logger.Info("AES-GCM decryption succeeded")
+	return plainText, nil
+}
+
+// decryptCBC decrypts padded AES-CBC data with the given IV
+func (a *aesProcessor) decryptCBC(block cipher.Block, iv, ciphertext []byte) ([]byte, error) {
+	if len(iv) != aes.BlockSize || len(ciphertext) < aes.BlockSize || len(ciphertext)%aes.BlockSize != 0 {
+		return nil, fmt.Errorf("ciphertext too short or not a multiple of the block size")
+	}
 
-	iv := ciphertext[:aes.BlockSize]
-	ciphertext = ciphertext[aes.BlockSize:]
+	plainText := make([]byte, len(ciphertext))
 
 	mode := cipher.NewCBCDecrypter(block, iv)
-	mode.CryptBlocks(ciphertext, ciphertext)
+	mode.CryptBlocks(plainText, ciphertext)
 
-	a.logger.Info("AES decryption succeeded")
-	return pkcs7Unpad(ciphertext, aes.BlockSize)
+	a.logger.Info("AES-CBC decryption succeeded")
+	return pkcs7Unpad(plainText, aes.BlockSize)
 }
@@ -4,6 +4,9 @@
 package cryptography
 
 import (
+	"crypto/aes"
+	"crypto/cipher"
+	"crypto/rand"
 	"log"
 	"testing"
 
@@ -11,6 +14,7 @@ import (
 	"crypto_vault_service/internal/infrastructure/settings"
 
 	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
 )
 
 // AESProcessorTests encapsulates AES-related test cases
@@ -47,12 +51,12 @@ func (at *AESProcessorTests) TestEncryptDecrypt(t *testing.T) {
 
 	plainText := []byte("This is a test message.")
 
-	ciphertext, err := at.processor.Encrypt(plainText, key)
+	ciphertext, err := at.processor.Encrypt(plainText, key, "key-id", 0, nil)
 	assert.NoError(t, err)
 	assert.NotNil(t, ciphertext)
 	assert.Greater(t, len(ciphertext), 0)
 
-	decryptedText, err := at.processor.Decrypt(ciphertext, key)
+	decryptedText, err := at.processor.Decrypt(ciphertext, key, nil)
 	assert.NoError(t, err)
 	assert.NotNil(t, decryptedText)
 	assert.Equal(t, plainText, decryptedText)
@@ -62,7 +66,7 @@ func (at *AESProcessorTests) TestEncryptionWithInvalidKey(t *testing.T) {
 	key := []byte("shortkey")
 	plainText := []byte("This is a test.")
 
-	_, err := at.processor.Encrypt(plainText, key)
+	_, err := at.processor.Encrypt(plainText, key, "key-id", 0, nil)
 	assert.Error(t, err)
 }
 
@@ -81,13 +85,13 @@ func (at *AESProcessorTests) TestDecryptWithWrongKey(t *testing.T) {
 	assert.NoError(t, err)
 
 	plainText := []byte("Test decryption with wrong key.")
-	ciphertext, err := at.processor.Encrypt(plainText, key)
+	ciphertext, err := at.processor.Encrypt(plainText, key, "key-id", 0, nil)
 	assert.NoError(t, err)
 
 	wrongKey, err := at.processor.GenerateKey(16)
 	assert.NoError(t, err)
 
-	decrypted, err := at.processor.Decrypt(ciphertext, wrongKey)
+	decrypted, err := at.processor.Decrypt(ciphertext, wrongKey, nil)
 
 	// Accept either an error or bad output (not equal to original plaintext)
 	if err == nil {
@@ -101,10 +105,162 @@ func (at *AESProcessorTests) TestDecryptShortCiphertext(t *testing.T) {
 	key, err := at.processor.GenerateKey(16)
 	assert.NoError(t, err)
 
-	_, err = at.processor.Decrypt([]byte("short"), key)
+	_, err = at.processor.Decrypt([]byte("short"), key, nil)
 	assert.Error(t, err)
 }
 
+func (at *AESProcessorTests) TestEncryptDecryptWithAssociatedData(t *testing.T) {
+	key, err := at.processor.GenerateKey(32)
+	require.NoError(t, err)
+
+	plainText := []byte("This is a test message.")
+	associatedData := []byte("blob-id|user-id")
+
+	ciphertext, err := at.processor.Encrypt(plainText, key, "key-id", 0, associatedData)
+	require.NoError(t, err)
+	assert.Equal(t, AESModeGCM, at.processor.DetectMode(ciphertext))
+
+	decryptedText, err := at.processor.Decrypt(ciphertext, key, associatedData)
+	require.NoError(t, err)
+	assert.Equal(t, plainText, decryptedText)
+
+	_, err = at.processor.Decrypt(ciphertext, key, []byte("other-blob-id|user-id"))
+	assert.Error(t, err, "Decryption with different associated data must fail")
+}
+
+func (at *AESProcessorTests) TestDecryptTamperedCiphertext(t *testing.T) {
+	key, err := at.processor.GenerateKey(32)
+	require.NoError(t, err)
+
+	ciphertext, err := at.processor.Encrypt([]byte("This is a test message."), key, "key-id", 0, nil)
+	require.NoError(t, err)
+
+	ciphertext[len(ciphertext)-1] ^= 0xff
+
+	_, err = at.processor.Decrypt(ciphertext, key, nil)
+	assert.Error(t, err)
+}
+
+func (at *AESProcessorTests) TestEncryptDecryptCBC(t *testing.T) {
+	key, err := at.processor.GenerateKey(32)
+	require.NoError(t, err)
+
+	plainText := []byte("This is a test message.")
+
+	ciphertext, err := at.processor.EncryptCBC(plainText, key, "key-id", 0)
+	require.NoError(t, err)
+	assert.Equal(t, AESModeCBC, at.processor.DetectMode(ciphertext))
+
+	decryptedText, err := at.processor.Decrypt(ciphertext, key, nil)
+	require.NoError(t, err)
+	assert.Equal(t, plainText, decryptedText)
+}
+
+func (at *AESProcessorTests) TestDecryptLegacyCBC(t *testing.T) {
+	key, err := at.processor.GenerateKey(16)
+	require.NoError(t, err)
+
+	plainText := []byte("This is a legacy test message.")
+
+	// Header-less IV || ciphertext as produced before envelopes were introduced
+	block, err := aes.NewCipher(key)
+	require.NoError(t, err)
+	padded := pkcs7Pad(append([]byte{}, plainText...), aes.BlockSize)
+	ciphertext := make([]byte, aes.BlockSize+len(padded))
+	_, err = rand.Read(ciphertext[:aes.BlockSize])
+	require.NoError(t, err)
+	cipher.NewCBCEncrypter(block, ciphertext[:aes.BlockSize]).CryptBlocks(ciphertext[aes.BlockSize:], padded)
+
+	assert.Equal(t, AESModeCBC, at.processor.DetectMode(ciphertext))
+
+	decryptedText, err := at.processor.Decrypt(ciphertext, key, nil)
+	require.NoError(t, err)
+	assert.Equal(t, plainText, decryptedText)
+}
+
+func (at *AESProcessorTests) TestDecryptVersionedCiphertext(t *testing.T) {
+	key, err := at.processor.GenerateKey(32)
+	require.NoError(t, err)
+
+	plainText := []byte("This is a versioned test message.")
+	associatedData := []byte("blob-id|user-id")
+
+	block, err := aes.NewCipher(key)
+	require.NoError(t, err)
+
+	// "CVAE" | version 2 | nonce || sealed data as produced before envelopes were introduced
+	gcm, err := cipher.NewGCM(block)
+	require.NoError(t, err)
+	gcmHeader := append(append([]byte{}, aesCiphertextMagic...), aesCiphertextVersionGCM)
+	nonce := make([]byte, gcm.NonceSize())
+	_, err = rand.Read(nonce)
+	require.NoError(t, err)
+	gcmCiphertext := append(append(append([]byte{}, gcmHeader...), nonce...), gcm.Seal(nil, nonce, plainText, gcmAssociatedData(gcmHeader, associatedData))...)
+
+	assert.Equal(t, AESModeGCM, at.processor.DetectMode(gcmCiphertext))
+
+	decryptedText, err := at.processor.Decrypt(gcmCiphertext, key, associatedData)
+	require.NoError(t, err)
+	assert.Equal(t, plainText, decryptedText)
+
+	_, err = at.processor.Decrypt(gcmCiphertext, key, []byte("other-blob-id|user-id"))
+	assert.Error(t, err, "Decryption must fail with different associated data")
+
+	// "CVAE" | version 1 | IV || ciphertext
+	padded := pkcs7Pad(append([]byte{}, plainText...), aes.BlockSize)
+	cbcCiphertext := make([]byte, aesCiphertextHeaderSize+aes.BlockSize+len(padded))
+	copy(cbcCiphertext, aesCiphertextMagic)
+	cbcCiphertext[len(aesCiphertextMagic)] = aesCiphertextVersionCBC
+	iv := cbcCiphertext[aesCiphertextHeaderSize : aesCiphertextHeaderSize+aes.BlockSize]
+	_, err = rand.Read(iv)
+	require.NoError(t, err)
+	cipher.NewCBCEncrypter(block, iv).CryptBlocks(cbcCiphertext[aesCiphertextHeaderSize+aes.BlockSize:], padded)
+
+	assert.Equal(t, AESModeCBC, at.processor.DetectMode(cbcCiphertext))
+
+	decryptedText, err = at.processor.Decrypt(cbcCiphertext, key, nil)
+	require.NoError(t, err)
+	assert.Equal(t, plainText, decryptedText)
+}
+
+func (at *AESProcessorTests) TestDecryptTamperedEnvelopeHeader(t *testing.T) {
+	key, err := at.processor.GenerateKey(32)
+	require.NoError(t, err)
+
+	ciphertext, err := at.processor.Encrypt([]byte("This is a test message."), key, "key-id", 0, nil)
+	require.NoError(t, err)
+
+	envelope, err := ParseEnvelope(ciphertext)
+	require.NoError(t, err)
+	envelope.KeyID = "other-key-id"
+	tampered, err := envelope.Marshal()
+	require.NoError(t, err)
+
+	_, err = at.processor.Decrypt(tampered, key, nil)
+	assert.Error(t, err, "Decryption must fail once the authenticated key ID is altered")
+}
+
+func (at *AESProcessorTests) TestDecryptArmoredEnvelope(t *testing.T) {
+	key, err := at.processor.GenerateKey(32)
+	require.NoError(t, err)
+
+	plainText := []byte("This is a test message.")
+
+	ciphertext, err := at.processor.Encrypt(plainText, key, "key-id", 0, nil)
+	require.NoError(t, err)
+
+	envelope, err := ParseEnvelope(ciphertext)
+	require.NoError(t, err)
+	armored, err := envelope.Armor()
+	require.NoError(t, err)
+
+	assert.Equal(t, AESModeGCM, at.processor.DetectMode(armored))
+
+	decryptedText, err := at.processor.Decrypt(armored, key, nil)
+	require.NoError(t, err)
+	assert.Equal(t, plainText, decryptedText)
+}
+
 // Entry point to run AESProcessorTests
 func TestAESProcessor(t *testing.T) {
 	tests := NewAESProcessorTests(t)
@@ -114,4 +270,11 @@ func TestAESProcessor(t *testing.T) {
 	t.Run("TestGenerateKey", tests.TestGenerateKey)
 	t.Run("TestDecryptWithWrongKey", tests.TestDecryptWithWrongKey)
 	t.Run("TestDecryptShortCiphertext", tests.TestDecryptShortCiphertext)
+	t.Run("TestEncryptDecryptWithAssociatedData", tests.TestEncryptDecryptWithAssociatedData)
+	t.Run("TestDecryptTamperedCiphertext", tests.TestDecryptTamperedCiphertext)
+	t.Run("TestEncryptDecryptCBC", tests.TestEncryptDecryptCBC)
+	t.Run("TestDecryptLegacyCBC", tests.TestDecryptLegacyCBC)
+	t.Run("TestDecryptVersionedCiphertext", tests.TestDecryptVersionedCiphertext)
+	t.Run("TestDecryptTamperedEnvelopeHeader", tests.TestDecryptTamperedEnvelopeHeader)
+	t.Run("TestDecryptArmoredEnvelope", tests.TestDecryptArmoredEnvelope)
 }
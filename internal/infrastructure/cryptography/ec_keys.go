@@ -0,0 +1,149 @@
+package cryptography
+
+import (
+	"bytes"
+	"crypto/ecdh"
+	"crypto/ecdsa"
+	"crypto/elliptic"
+	"crypto/x509"
+	"fmt"
+	"math/big"
+)
+
+// legacyECCurve pairs a curve EC keys were stored on in the legacy raw format with its crypto/ecdh counterpart used for validating points
+type legacyECCurve struct {
+	curve     elliptic.Curve
+	ecdhCurve ecdh.Curve
+}
+
+// legacyECCurves are the curves keys in the legacy raw format are inferred from, ordered by size
+var legacyECCurves = []legacyECCurve{
+	{curve: elliptic.P256(), ecdhCurve: ecdh.P256()},
+	{curve: elliptic.P384(), ecdhCurve: ecdh.P384()},
+	{curve: elliptic.P521(), ecdhCurve: ecdh.P521()},
+}
+
+// MarshalECPrivateKey encodes an EC private key as PKCS#8, which records the curve alongside the key
+func MarshalECPrivateKey(privateKey *ecdsa.PrivateKey) ([]byte, error) {
+	keyBytes, err := x509.MarshalPKCS8PrivateKey(privateKey)
+	if err != nil {
+		return nil, fmt.Errorf("failed to marshal EC private key: %w", err)
+	}
+	return keyBytes, nil
+}
+
+// MarshalECPublicKey encodes an EC public key as PKIX, which records the curve alongside the key
+func MarshalECPublicKey(publicKey *ecdsa.PublicKey) ([]byte, error) {
+	keyBytes, err := x509.MarshalPKIXPublicKey(publicKey)
+	if err != nil {
+		return nil, fmt.Errorf("failed to marshal EC public key: %w", err)
+	}
+	return keyBytes, nil
+}
+
+// ParseECPrivateKey parses an EC private key encoded as PKCS#8 or SEC1 and infers its curve.
+// Keys stored in the legacy raw format D||X||Y, whose components lack leading zeros, are recognized by matching the public point derived from D.
+func ParseECPrivateKey(keyBytes []byte) (*ecdsa.PrivateKey, error) {
+	if key, err := x509.ParsePKCS8PrivateKey(keyBytes); err == nil {
+		privateKey, ok := key.(*ecdsa.PrivateKey)
+		if !ok {
+			return nil, fmt.Errorf("private key is not of type EC")
+		}
+		return privateKey, nil
+	}
+
+	if privateKey, err := x509.ParseECPrivateKey(keyBytes); err == nil {
+		return privateKey, nil
+	}
+
+	privateKey := parseLegacyECPrivateKey(keyBytes)
+	if privateKey == nil {
+		return nil, fmt.Errorf("failed to parse EC private key: neither PKCS#8, SEC1 nor legacy raw format")
+	}
+	return privateKey, nil
+}
+
+// ParseECPublicKey parses an EC public key encoded as PKIX and infers its curve.
+// Keys stored in the legacy raw format X||Y, whose components lack leading zeros, are recognized by the split yielding a point on the curve.
+func ParseECPublicKey(keyBytes []byte) (*ecdsa.PublicKey, error) {
+	if key, err := x509.ParsePKIXPublicKey(keyBytes); err == nil {
+		publicKey, ok := key.(*ecdsa.PublicKey)
+		if !ok {
+			return nil, fmt.Errorf("public key is not of type EC")
+		}
+		return publicKey, nil
+	}
+
+	publicKey := parseLegacyECPublicKey(keyBytes)
+	if publicKey == nil {
+		return nil, fmt.Errorf("failed to parse EC public key: neither PKIX nor legacy raw format")
+	}
+	return publicKey, nil
+}
+
+// parseLegacyECPrivateKey tries every length of D on every curve and returns the key whose derived public point matches the remaining bytes, or nil
+func parseLegacyECPrivateKey(keyBytes []byte) *ecdsa.PrivateKey {
+	for _, legacyCurve := range legacyECCurves {
+		size := ecCoordinateSize(legacyCurve.curve)
+		if len(keyBytes) > 3*size {
+			continue
+		}
+
+		for dLength := 1; dLength <= size && dLength < len(keyBytes); dLength++ {
+			d := new(big.Int).SetBytes(keyBytes[:dLength])
+			ecdhKey, err := legacyCurve.ecdhCurve.NewPrivateKey(d.FillBytes(make([]byte, size)))
+			if err != nil {
+				continue
+			}
+
+			x, y := ecPoint(ecdhKey.PublicKey().Bytes(), size)
+			if !bytes.Equal(append(x.Bytes(), y.Bytes()...), keyBytes[dLength:]) {
+				continue
+			}
+
+			return &ecdsa.PrivateKey{
+				PublicKey: ecdsa.PublicKey{Curve: legacyCurve.curve, X: x, Y: y},
+				D:         d,
+			}
+		}
+	}
+	return nil
+}
+
+// parseLegacyECPublicKey tries every split of X and Y on every curve and returns the first point on the curve, or nil
+func parseLegacyECPublicKey(keyBytes []byte) *ecdsa.PublicKey {
+	for _, legacyCurve := range legacyECCurves {
+		size := ecCoordinateSize(legacyCurve.curve)
+		if len(keyBytes) > 2*size {
+			continue
+		}
+
+		for xLength := 1; xLength <= size && xLength < len(keyBytes); xLength++ {
+			if len(keyBytes)-xLength > size {
+				continue
+			}
+
+			point := make([]byte, 1+2*size)
+			point[0] = 4 // uncompressed point
+			new(big.Int).SetBytes(keyBytes[:xLength]).FillBytes(point[1 : 1+size])
+			new(big.Int).SetBytes(keyBytes[xLength:]).FillBytes(point[1+size:])
+			if _, err := legacyCurve.ecdhCurve.NewPublicKey(point); err != nil {
+				continue
+			}
+
+			x, y := ecPoint(point, size)
+			return &ecdsa.PublicKey{Curve: legacyCurve.curve, X: x, Y: y}
+		}
+	}
+	return nil
+}
+
+// ecPoint splits an uncompressed point into its coordinates
+func ecPoint(point []byte, size int) (*big.Int, *big.Int) {
+	return new(big.Int).SetBytes(point[1 : 1+size]), new(big.Int).SetBytes(point[1+size:])
+}
+
+// ecCoordinateSize returns the size in bytes of a coordinate or scalar on the curve
+func ecCoordinateSize(curve elliptic.Curve) int {
+	return (curve.Params().BitSize + 7) / 8
+}
@@ -0,0 +1,114 @@
+//go:build unit
+// +build unit
+
+package cryptography
+
+import (
+	"crypto/ecdsa"
+	"crypto/elliptic"
+	"crypto/rand"
+	"crypto/rsa"
+	"crypto/x509"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// legacyECKeyBytes encodes a key pair in the legacy raw format D||X||Y and X||Y, dropping leading zeros
+func legacyECKeyBytes(privateKey *ecdsa.PrivateKey) ([]byte, []byte) {
+	privateKeyBytes := append(privateKey.D.Bytes(), privateKey.X.Bytes()...)
+	privateKeyBytes = append(privateKeyBytes, privateKey.Y.Bytes()...)
+	publicKeyBytes := append(privateKey.X.Bytes(), privateKey.Y.Bytes()...)
+	return privateKeyBytes, publicKeyBytes
+}
+
+func TestECKeys_MarshalAndParse(t *testing.T) {
+	for _, curve := range []elliptic.Curve{elliptic.P224(), elliptic.P256(), elliptic.P384(), elliptic.P521()} {
+		privateKey, err := ecdsa.GenerateKey(curve, rand.Reader)
+		require.NoError(t, err)
+
+		privateKeyBytes, err := MarshalECPrivateKey(privateKey)
+		require.NoError(t, err)
+		publicKeyBytes, err := MarshalECPublicKey(&privateKey.PublicKey)
+		require.NoError(t, err)
+
+		parsedPrivateKey, err := ParseECPrivateKey(privateKeyBytes)
+		require.NoError(t, err)
+		assert.True(t, privateKey.Equal(parsedPrivateKey), curve.Params().Name)
+
+		parsedPublicKey, err := ParseECPublicKey(publicKeyBytes)
+		require.NoError(t, err)
+		assert.True(t, privateKey.PublicKey.Equal(parsedPublicKey), curve.Params().Name)
+	}
+}
+
+func TestECKeys_ParseSEC1PrivateKey(t *testing.T) {
+	privateKey, err := ecdsa.GenerateKey(elliptic.P384(), rand.Reader)
+	require.NoError(t, err)
+
+	privateKeyBytes, err := x509.MarshalECPrivateKey(privateKey)
+	require.NoError(t, err)
+
+	parsedPrivateKey, err := ParseECPrivateKey(privateKeyBytes)
+	require.NoError(t, err)
+	assert.True(t, privateKey.Equal(parsedPrivateKey))
+}
+
+func TestECKeys_ParseLegacyKeys(t *testing.T) {
+	for _, curve := range []elliptic.Curve{elliptic.P256(), elliptic.P384(), elliptic.P521()} {
+		privateKey, err := ecdsa.GenerateKey(curve, rand.Reader)
+		require.NoError(t, err)
+
+		legacyPrivateKey, legacyPublicKey := legacyECKeyBytes(privateKey)
+
+		parsedPrivateKey, err := ParseECPrivateKey(legacyPrivateKey)
+		require.NoError(t, err, curve.Params().Name)
+		assert.True(t, privateKey.Equal(parsedPrivateKey), curve.Params().Name)
+
+		parsedPublicKey, err := ParseECPublicKey(legacyPublicKey)
+		require.NoError(t, err, curve.Params().Name)
+		assert.True(t, privateKey.PublicKey.Equal(parsedPublicKey), curve.Params().Name)
+	}
+}
+
+func TestECKeys_ParseLegacyKeysWithShortComponents(t *testing.T) {
+	size := ecCoordinateSize(elliptic.P256())
+
+	// Leading zeros are dropped from a component in roughly one of 85 keys
+	var privateKey *ecdsa.PrivateKey
+	for i := 0; i < 10000 && privateKey == nil; i++ {
+		candidate, err := ecdsa.GenerateKey(elliptic.P256(), rand.Reader)
+		require.NoError(t, err)
+		if len(candidate.D.Bytes()) < size || len(candidate.X.Bytes()) < size || len(candidate.Y.Bytes()) < size {
+			privateKey = candidate
+		}
+	}
+	require.NotNil(t, privateKey)
+
+	legacyPrivateKey, legacyPublicKey := legacyECKeyBytes(privateKey)
+	require.Less(t, len(legacyPrivateKey), 3*size)
+
+	parsedPrivateKey, err := ParseECPrivateKey(legacyPrivateKey)
+	require.NoError(t, err)
+	assert.True(t, privateKey.Equal(parsedPrivateKey))
+
+	parsedPublicKey, err := ParseECPublicKey(legacyPublicKey)
+	require.NoError(t, err)
+	assert.True(t, privateKey.PublicKey.Equal(parsedPublicKey))
+}
+
+func TestECKeys_ParseInvalidKeys(t *testing.T) {
+	_, err := ParseECPrivateKey([]byte("invalid key"))
+	assert.Error(t, err)
+
+	_, err = ParseECPublicKey([]byte("invalid key"))
+	assert.Error(t, err)
+
+	rsaPrivateKey, err := rsa.GenerateKey(rand.Reader, 2048)
+	require.NoError(t, err)
+	rsaPublicKey, err := x509.MarshalPKIXPublicKey(&rsaPrivateKey.PublicKey)
+	require.NoError(t, err)
+	_, err = ParseECPublicKey(rsaPublicKey)
+	assert.Error(t, err)
+}
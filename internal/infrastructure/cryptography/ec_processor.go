@@ -1,6 +1,7 @@
 package cryptography
 
 import (
+	"crypto"
 	"crypto/ecdsa"
 	"crypto/elliptic"
 	"crypto/rand"
@@ -20,11 +21,18 @@ type ECProcessor interface {
 	GenerateKeys(curve elliptic.Curve) (*ecdsa.PrivateKey, *ecdsa.PublicKey, error)
 	Sign(message []byte, privateKey *ecdsa.PrivateKey) ([]byte, error)
 	Verify(message, signature []byte, publicKey *ecdsa.PublicKey) (bool, error)
+	// SignDigest signs a SHA-256 digest, allowing to sign data hashed while streaming. The signature is r||s, each padded to the curve size.
+	// The private key may be an *ecdsa.PrivateKey or any crypto.Signer of an ECDSA key, like a key on a PKCS#11 token.
+	SignDigest(digest []byte, privateKey crypto.Signer) ([]byte, error)
+	// VerifyDigest verifies an r||s signature of a SHA-256 digest
+	VerifyDigest(digest, signature []byte, publicKey *ecdsa.PublicKey) (bool, error)
 	SaveSignatureToFile(filename string, data []byte) error
 	SavePrivateKeyToFile(privateKey *ecdsa.PrivateKey, filename string) error
 	SavePublicKeyToFile(publicKey *ecdsa.PublicKey, filename string) error
-	ReadPrivateKey(privateKeyPath string, curve elliptic.Curve) (*ecdsa.PrivateKey, error)
-	ReadPublicKey(publicKeyPath string, curve elliptic.Curve) (*ecdsa.PublicKey, error)
+	// ReadPrivateKey reads a PKCS#8 or SEC1 private key from a PEM file, inferring its curve. Files in the legacy raw format are supported as well
+	ReadPrivateKey(privateKeyPath string) (*ecdsa.PrivateKey, error)
+	// ReadPublicKey reads a PKIX public key from a PEM file, inferring its curve. Files in the legacy raw format are supported as well
+	ReadPublicKey(publicKeyPath string) (*ecdsa.PublicKey, error)
 }
 
 // ecProcessor struct that implements the ECProcessor interface
@@ -57,20 +65,42 @@ func (e *ecProcessor) Sign(message []byte, privateKey *ecdsa.PrivateKey) ([]byte
 		return nil, fmt.Errorf("private key cannot be nil")
 	}
 
+	// Hash the message before signing it
+	hash := sha256.Sum256(message)
+
+	return e.SignDigest(hash[:], privateKey)
+}
+
+// SignDigest signs a SHA-256 digest with the private key
+func (e *ecProcessor) SignDigest(digest []byte, privateKey crypto.Signer) ([]byte, error) {
+	if privateKey == nil {
+		return nil, fmt.Errorf("private key cannot be nil")
+	}
+
 	// Check if the private key is valid (D should not be zero)
-	if privateKey.D.Sign() == 0 {
+	if ecdsaPrivateKey, ok := privateKey.(*ecdsa.PrivateKey); ok && ecdsaPrivateKey.D.Sign() == 0 {
 		return nil, fmt.Errorf("invalid private key: D cannot be zero")
 	}
 
-	// Hash the message before signing it
-	hash := sha256.Sum256(message)
-	r, s, err := ecdsa.Sign(rand.Reader, privateKey, hash[:])
+	publicKey, ok := privateKey.Public().(*ecdsa.PublicKey)
+	if !ok {
+		return nil, fmt.Errorf("private key is not of type ECDSA")
+	}
+
+	if len(digest) != sha256.Size {
+		return nil, fmt.Errorf("invalid digest size %d, expected %d", len(digest), sha256.Size)
+	}
+
+	der, err := privateKey.Sign(rand.Reader, digest, crypto.SHA256)
 	if err != nil {
 		return nil, fmt.Errorf("failed to sign message: %w", err)
 	}
 
-	// Encode the signature as r and s
-	signature := append(r.Bytes(), s.Bytes()...)
+	// Encode the signature as r and s with a fixed size, so it can be split in half when verifying
+	signature, err := ecdsaSignatureToRaw(der, ecCoordinateSize(publicKey.Curve))
+	if err != nil {
+		return nil, fmt.Errorf("%w", err)
+	}
 
 	e.logger.Info("ECDSA signing succeeded")
 	return signature, nil
@@ -78,12 +108,20 @@ func (e *ecProcessor) Sign(message []byte, privateKey *ecdsa.PrivateKey) ([]byte
 
 // Verify verifies the signature of a message with the public key
 func (e *ecProcessor) Verify(message, signature []byte, publicKey *ecdsa.PublicKey) (bool, error) {
+	// Hash the message before verifying it
+	hash := sha256.Sum256(message)
+
+	return e.VerifyDigest(hash[:], signature, publicKey)
+}
+
+// VerifyDigest verifies the signature of a SHA-256 digest with the public key
+func (e *ecProcessor) VerifyDigest(digest, signature []byte, publicKey *ecdsa.PublicKey) (bool, error) {
 	if publicKey == nil {
 		return false, fmt.Errorf("public key cannot be nil")
 	}
-
-	// Hash the message before verifying it
-	hash := sha256.Sum256(message)
+	if len(digest) != sha256.Size {
+		return false, fmt.Errorf("invalid digest size %d, expected %d", len(digest), sha256.Size)
+	}
 
 	// Split the signature into r and s
 	r, s := signature[:len(signature)/2], signature[len(signature)/2:]
@@ -91,21 +129,22 @@ func (e *ecProcessor) Verify(message, signature []byte, publicKey *ecdsa.PublicK
 	sInt := new(big.Int).SetBytes(s)
 
 	// Verify the signature
-	valid := ecdsa.Verify(publicKey, hash[:], rInt, sInt)
+	valid := ecdsa.Verify(publicKey, digest, rInt, sInt)
 
 	e.logger.Info("ECDSA verification succeeded")
 	return valid, nil
 }
 
-// SavePrivateKeyToFile saves the private key to a PEM file using encoding/pem
+// SavePrivateKeyToFile saves the private key as PKCS#8 to a PEM file using encoding/pem
 func (e *ecProcessor) SavePrivateKeyToFile(privateKey *ecdsa.PrivateKey, filename string) error {
-	// Marshal private key components (private key 'D' and public key components 'X' and 'Y')
-	privKeyBytes := append(privateKey.D.Bytes(), privateKey.X.Bytes()...)
-	privKeyBytes = append(privKeyBytes, privateKey.Y.Bytes()...)
+	privKeyBytes, err := MarshalECPrivateKey(privateKey)
+	if err != nil {
+		return fmt.Errorf("%w", err)
+	}
 
 	// Prepare the PEM block
 	privKeyPem := &pem.Block{
-		Type:  "EC PRIVATE KEY",
+		Type:  "PRIVATE KEY",
 		Bytes: privKeyBytes,
 	}
 
@@ -130,9 +169,12 @@ func (e *ecProcessor) SavePrivateKeyToFile(privateKey *ecdsa.PrivateKey, filenam
 	return nil
 }
 
-// SavePublicKeyToFile saves the public key to a PEM file using encoding/pem
+// SavePublicKeyToFile saves the public key as PKIX to a PEM file using encoding/pem
 func (e *ecProcessor) SavePublicKeyToFile(publicKey *ecdsa.PublicKey, filename string) error {
-	pubKeyBytes := append(publicKey.X.Bytes(), publicKey.Y.Bytes()...)
+	pubKeyBytes, err := MarshalECPublicKey(publicKey)
+	if err != nil {
+		return fmt.Errorf("%w", err)
+	}
 
 	// Prepare the PEM block for the public key
 	pubKeyPem := &pem.Block{
@@ -173,7 +215,7 @@ func (e *ecProcessor) SaveSignatureToFile(filename string, data []byte) error {
 }
 
 // ReadPrivateKey reads an ECDSA private key from a PEM file using encoding/pem
-func (e *ecProcessor) ReadPrivateKey(privateKeyPath string, curve elliptic.Curve) (*ecdsa.PrivateKey, error) {
+func (e *ecProcessor) ReadPrivateKey(privateKeyPath string) (*ecdsa.PrivateKey, error) {
 	privKeyPEM, err := os.ReadFile(filepath.Clean(privateKeyPath))
 	if err != nil {
 		return nil, fmt.Errorf("unable to read private key file: %w", err)
@@ -185,28 +227,16 @@ func (e *ecProcessor) ReadPrivateKey(privateKeyPath string, curve elliptic.Curve
 		return nil, fmt.Errorf("failed to parse PEM block containing the private key")
 	}
 
-	// Extract the private key (first part is 'D', followed by 'X' and 'Y' of the public key)
-	privKeyBytes := block.Bytes
-	privKeyD := new(big.Int).SetBytes(privKeyBytes[:32])  // first 32 bytes for D
-	pubKeyX := new(big.Int).SetBytes(privKeyBytes[32:64]) // next 32 bytes for X
-	pubKeyY := new(big.Int).SetBytes(privKeyBytes[64:96]) // last 32 bytes for Y
-
-	publicKey := &ecdsa.PublicKey{
-		Curve: curve, // Use dynamic curve
-		X:     pubKeyX,
-		Y:     pubKeyY,
-	}
-
-	privateKey := &ecdsa.PrivateKey{
-		D:         privKeyD,
-		PublicKey: *publicKey,
+	privateKey, err := ParseECPrivateKey(block.Bytes)
+	if err != nil {
+		return nil, fmt.Errorf("%w", err)
 	}
 
 	return privateKey, nil
 }
 
 // ReadPublicKey reads an ECDSA public key from a PEM file using encoding/pem
-func (e *ecProcessor) ReadPublicKey(publicKeyPath string, curve elliptic.Curve) (*ecdsa.PublicKey, error) {
+func (e *ecProcessor) ReadPublicKey(publicKeyPath string) (*ecdsa.PublicKey, error) {
 	pubKeyPEM, err := os.ReadFile(filepath.Clean(publicKeyPath))
 	if err != nil {
 		return nil, fmt.Errorf("unable to read public key file: %w", err)
@@ -218,15 +248,9 @@ func (e *ecProcessor) ReadPublicKey(publicKeyPath string, curve elliptic.Curve)
 		return nil, fmt.Errorf("failed to parse PEM block containing the public key")
 	}
 
-	// Extract the public key (first 32 bytes for 'X' and next 32 bytes for 'Y')
-	pubKeyBytes := block.Bytes
-	pubKeyX := new(big.Int).SetBytes(pubKeyBytes[:32])   // first 32 bytes for X
-	pubKeyY := new(big.Int).SetBytes(pubKeyBytes[32:64]) // next 32 bytes for Y
-
-	publicKey := &ecdsa.PublicKey{
-		Curve: curve, // Use dynamic curve
-		X:     pubKeyX,
-		Y:     pubKeyY,
+	publicKey, err := ParseECPublicKey(block.Bytes)
+	if err != nil {
+		return nil, fmt.Errorf("%w", err)
 	}
 
 	return publicKey, nil
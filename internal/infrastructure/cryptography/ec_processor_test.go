@@ -6,9 +6,11 @@ package cryptography
 import (
 	"crypto/ecdsa"
 	"crypto/elliptic"
+	"crypto/sha256"
 	"crypto_vault_service/internal/infrastructure/logger"
 	"crypto_vault_service/internal/infrastructure/settings"
 	"encoding/hex"
+	"encoding/pem"
 	"math/big"
 	"os"
 	"path/filepath"
@@ -72,32 +74,87 @@ func (et *ecProcessorTests) TestSignVerify(t *testing.T) {
 	assert.False(t, valid)
 }
 
-func (et *ecProcessorTests) TestSaveAndReadKeys(t *testing.T) {
-	priv, pub, err := et.processor.GenerateKeys(elliptic.P256())
+func (et *ecProcessorTests) TestSignVerifyDigest(t *testing.T) {
+	priv, pub, err := et.processor.GenerateKeys(elliptic.P521())
+	assert.NoError(t, err)
+
+	digest := sha256.Sum256([]byte("This is a test message."))
+	sig, err := et.processor.SignDigest(digest[:], priv)
+	assert.NoError(t, err)
+	assert.Len(t, sig, 2*66)
+
+	valid, err := et.processor.VerifyDigest(digest[:], sig, pub)
+	assert.NoError(t, err)
+	assert.True(t, valid)
+
+	valid, err = et.processor.Verify([]byte("This is a test message."), sig, pub)
 	assert.NoError(t, err)
+	assert.True(t, valid)
 
-	privateFile := "private_test.pem"
-	publicFile := "public_test.pem"
+	invalidDigest := sha256.Sum256([]byte("Modified message."))
+	valid, err = et.processor.VerifyDigest(invalidDigest[:], sig, pub)
+	assert.NoError(t, err)
+	assert.False(t, valid)
+}
 
-	err = et.processor.SavePrivateKeyToFile(priv, privateFile)
+func (et *ecProcessorTests) TestSaveAndReadKeys(t *testing.T) {
+	for _, curve := range []elliptic.Curve{elliptic.P224(), elliptic.P256(), elliptic.P384(), elliptic.P521()} {
+		priv, pub, err := et.processor.GenerateKeys(curve)
+		assert.NoError(t, err)
+
+		privateFile := filepath.Join(t.TempDir(), "private_test.pem")
+		publicFile := filepath.Join(t.TempDir(), "public_test.pem")
+
+		err = et.processor.SavePrivateKeyToFile(priv, privateFile)
+		assert.NoError(t, err)
+
+		err = et.processor.SavePublicKeyToFile(pub, publicFile)
+		assert.NoError(t, err)
+
+		// The curve is inferred from the key files
+		readPriv, err := et.processor.ReadPrivateKey(privateFile)
+		assert.NoError(t, err)
+		assert.Equal(t, curve, readPriv.Curve)
+		assert.Equal(t, priv.D, readPriv.D)
+		assert.Equal(t, priv.PublicKey.X, readPriv.PublicKey.X)
+		assert.Equal(t, priv.PublicKey.Y, readPriv.PublicKey.Y)
+
+		readPub, err := et.processor.ReadPublicKey(publicFile)
+		assert.NoError(t, err)
+		assert.Equal(t, curve, readPub.Curve)
+		assert.Equal(t, pub.X, readPub.X)
+		assert.Equal(t, pub.Y, readPub.Y)
+
+		sig, err := et.processor.Sign([]byte("This is a test message."), readPriv)
+		assert.NoError(t, err)
+		valid, err := et.processor.Verify([]byte("This is a test message."), sig, readPub)
+		assert.NoError(t, err)
+		assert.True(t, valid)
+	}
+}
+
+func (et *ecProcessorTests) TestReadLegacyKeys(t *testing.T) {
+	priv, pub, err := et.processor.GenerateKeys(elliptic.P384())
 	assert.NoError(t, err)
 
-	err = et.processor.SavePublicKeyToFile(pub, publicFile)
+	// Key files written before PKCS#8 and PKIX were used hold the raw components
+	legacyPrivateKey := append(priv.D.Bytes(), priv.X.Bytes()...)
+	legacyPrivateKey = append(legacyPrivateKey, priv.Y.Bytes()...)
+	privateFile := filepath.Join(t.TempDir(), "private_test.pem")
+	err = os.WriteFile(privateFile, pem.EncodeToMemory(&pem.Block{Type: "EC PRIVATE KEY", Bytes: legacyPrivateKey}), 0600)
 	assert.NoError(t, err)
 
-	readPriv, err := et.processor.ReadPrivateKey(privateFile, elliptic.P256())
+	publicFile := filepath.Join(t.TempDir(), "public_test.pem")
+	err = os.WriteFile(publicFile, pem.EncodeToMemory(&pem.Block{Type: "PUBLIC KEY", Bytes: append(pub.X.Bytes(), pub.Y.Bytes()...)}), 0600)
 	assert.NoError(t, err)
-	assert.Equal(t, priv.D, readPriv.D)
-	assert.Equal(t, priv.PublicKey.X, readPriv.PublicKey.X)
-	assert.Equal(t, priv.PublicKey.Y, readPriv.PublicKey.Y)
 
-	readPub, err := et.processor.ReadPublicKey(publicFile, elliptic.P256())
+	readPriv, err := et.processor.ReadPrivateKey(privateFile)
 	assert.NoError(t, err)
-	assert.Equal(t, pub.X, readPub.X)
-	assert.Equal(t, pub.Y, readPub.Y)
+	assert.True(t, priv.Equal(readPriv))
 
-	os.Remove(privateFile)
-	os.Remove(publicFile)
+	readPub, err := et.processor.ReadPublicKey(publicFile)
+	assert.NoError(t, err)
+	assert.True(t, pub.Equal(readPub))
 }
 
 func (et *ecProcessorTests) TestSaveSignatureToFile(t *testing.T) {
@@ -158,7 +215,9 @@ func TestECDSA(t *testing.T) {
 
 	t.Run("GenerateKeys", suite.TestGenerateKeys)
 	t.Run("SignVerify", suite.TestSignVerify)
+	t.Run("SignVerifyDigest", suite.TestSignVerifyDigest)
 	t.Run("SaveAndReadKeys", suite.TestSaveAndReadKeys)
+	t.Run("ReadLegacyKeys", suite.TestReadLegacyKeys)
 	t.Run("SaveSignatureToFile", suite.TestSaveSignatureToFile)
 	t.Run("SignWithInvalidPrivateKey", suite.TestSignWithInvalidPrivateKey)
 	t.Run("VerifyWithInvalidPublicKey", suite.TestVerifyWithInvalidPublicKey)
@@ -0,0 +1,259 @@
+package cryptography
+
+import (
+	"bufio"
+	"bytes"
+	"encoding/binary"
+	"encoding/pem"
+	"fmt"
+	"io"
+	"math"
+	"strconv"
+)
+
+// envelopeMagic prefixes binary ciphertext envelopes
+var envelopeMagic = []byte("CVEN")
+
+// Versions of the binary envelope layout, Marshal writes envelopeVersionKeyVersion only for envelopes recording a key version
+const (
+	envelopeVersion           byte = 1
+	envelopeVersionKeyVersion byte = 2
+)
+
+// envelopePEMType is the PEM block type of ASCII-armored envelopes
+const envelopePEMType = "CRYPTO VAULT ENVELOPE"
+
+// Algorithms recorded in an envelope
+const (
+	EnvelopeAlgorithmAES = "AES"
+	EnvelopeAlgorithmRSA = "RSA"
+)
+
+// Envelope is a self-describing ciphertext recording the algorithm, mode and key that produced it.
+//
+// The binary layout is:
+//
+//	magic "CVEN" | version (1 byte) | algorithm, mode, key ID, nonce (1 byte length each) | wrapped DEK (2 byte length) | [key version (4 bytes)] | ciphertext
+//
+// The key version is only present in version 2 envelopes.
+// Everything preceding the ciphertext forms the header, which AEAD modes authenticate as associated data.
+type Envelope struct {
+	Algorithm string
+	Mode      string
+	// KeyID identifies the key, or for asymmetric keys the key pair, the ciphertext was produced with
+	KeyID string
+	// KeyVersion is the version of the logical key the key referenced by KeyID belongs to, or 0 if unversioned
+	KeyVersion uint32
+	// Nonce holds the nonce or IV of the cipher, if any
+	Nonce []byte
+	// WrappedDEK holds the data encryption key wrapped with the key referenced by KeyID, if any
+	WrappedDEK []byte
+	Ciphertext []byte
+}
+
+// Header serializes all envelope fields except the ciphertext
+func (e *Envelope) Header() ([]byte, error) {
+	if len(e.Algorithm) > math.MaxUint8 || len(e.Mode) > math.MaxUint8 || len(e.KeyID) > math.MaxUint8 || len(e.Nonce) > math.MaxUint8 {
+		return nil, fmt.Errorf("envelope algorithm, mode, key ID and nonce must not exceed %d bytes", math.MaxUint8)
+	}
+	if len(e.WrappedDEK) > math.MaxUint16 {
+		return nil, fmt.Errorf("envelope wrapped DEK exceeds %d bytes", math.MaxUint16)
+	}
+
+	version := envelopeVersion
+	if e.KeyVersion > 0 {
+		version = envelopeVersionKeyVersion
+	}
+
+	header := make([]byte, 0, len(envelopeMagic)+1+4+len(e.Algorithm)+len(e.Mode)+len(e.KeyID)+len(e.Nonce)+2+len(e.WrappedDEK)+4)
+	header = append(header, envelopeMagic...)
+	header = append(header, version)
+	for _, field := range [][]byte{[]byte(e.Algorithm), []byte(e.Mode), []byte(e.KeyID), e.Nonce} {
+		header = append(header, byte(len(field)))
+		header = append(header, field...)
+	}
+	header = binary.BigEndian.AppendUint16(header, uint16(len(e.WrappedDEK))) // #nosec G115 -- length checked above
+	header = append(header, e.WrappedDEK...)
+	if version == envelopeVersionKeyVersion {
+		header = binary.BigEndian.AppendUint32(header, e.KeyVersion)
+	}
+
+	return header, nil
+}
+
+// Marshal serializes the envelope into its binary form
+func (e *Envelope) Marshal() ([]byte, error) {
+	header, err := e.Header()
+	if err != nil {
+		return nil, fmt.Errorf("%w", err)
+	}
+
+	return append(header, e.Ciphertext...), nil
+}
+
+// Armor serializes the envelope into its ASCII-armored form.
+// The PEM headers are informational only, the armored binary envelope is authoritative.
+func (e *Envelope) Armor() ([]byte, error) {
+	data, err := e.Marshal()
+	if err != nil {
+		return nil, fmt.Errorf("%w", err)
+	}
+
+	headers := map[string]string{
+		"Algorithm": e.Algorithm,
+		"Mode":      e.Mode,
+		"Key-ID":    e.KeyID,
+	}
+	if e.KeyVersion > 0 {
+		headers["Key-Version"] = strconv.FormatUint(uint64(e.KeyVersion), 10)
+	}
+
+	return pem.EncodeToMemory(&pem.Block{
+		Type:    envelopePEMType,
+		Headers: headers,
+		Bytes:   data,
+	}), nil
+}
+
+// IsEnvelope reports whether data is a binary or ASCII-armored envelope
+func IsEnvelope(data []byte) bool {
+	return bytes.HasPrefix(data, envelopeMagic) || isArmoredEnvelope(data)
+}
+
+// isArmoredEnvelope reports whether data starts with the PEM boundary of an armored envelope
+func isArmoredEnvelope(data []byte) bool {
+	return bytes.HasPrefix(bytes.TrimSpace(data), []byte("-----BEGIN "+envelopePEMType+"-----"))
+}
+
+// maxEnvelopeHeaderSize is the upper bound of the header size given the field length limits
+const maxEnvelopeHeaderSize = 5 + 4*(1+math.MaxUint8) + 2 + math.MaxUint16 + 4
+
+// ParseEnvelope parses a binary or ASCII-armored envelope
+func ParseEnvelope(data []byte) (*Envelope, error) {
+	if isArmoredEnvelope(data) {
+		block, _ := pem.Decode(bytes.TrimSpace(data))
+		if block == nil || block.Type != envelopePEMType {
+			return nil, fmt.Errorf("failed to decode armored envelope")
+		}
+		data = block.Bytes
+	}
+
+	reader := bytes.NewReader(data)
+	envelope, err := ReadEnvelopeHeader(reader)
+	if err != nil {
+		return nil, fmt.Errorf("%w", err)
+	}
+	envelope.Ciphertext = data[len(data)-reader.Len():]
+
+	return envelope, nil
+}
+
+// PeekEnvelope parses the header of a binary envelope at the start of the buffered reader without consuming it.
+// It returns nil if the reader does not start with an envelope. The reader must be able to buffer maxEnvelopeHeaderSize bytes, see NewEnvelopeReader.
+func PeekEnvelope(reader *bufio.Reader) (*Envelope, error) {
+	prefix, err := reader.Peek(len(envelopeMagic) + 1)
+	if err != nil || !bytes.Equal(prefix[:len(envelopeMagic)], envelopeMagic) {
+		return nil, nil
+	}
+
+	// Determine the header size from the length prefixes
+	headerSize := len(envelopeMagic) + 1
+	for i := 0; i < 4; i++ {
+		peeked, err := reader.Peek(headerSize + 1)
+		if err != nil {
+			return nil, fmt.Errorf("envelope too short")
+		}
+		headerSize += 1 + int(peeked[headerSize])
+	}
+	peeked, err := reader.Peek(headerSize + 2)
+	if err != nil {
+		return nil, fmt.Errorf("envelope too short")
+	}
+	headerSize += 2 + int(binary.BigEndian.Uint16(peeked[headerSize:]))
+	if prefix[len(envelopeMagic)] == envelopeVersionKeyVersion {
+		headerSize += 4
+	}
+
+	header, err := reader.Peek(headerSize)
+	if err != nil {
+		return nil, fmt.Errorf("envelope too short")
+	}
+
+	return ReadEnvelopeHeader(bytes.NewReader(header))
+}
+
+// NewEnvelopeReader returns a buffered reader large enough for peeking envelope headers
+func NewEnvelopeReader(reader io.Reader) *bufio.Reader {
+	return bufio.NewReaderSize(reader, maxEnvelopeHeaderSize)
+}
+
+// ReadEnvelopeHeader consumes the header of a binary envelope from the reader, leaving the reader positioned at the ciphertext
+func ReadEnvelopeHeader(reader io.Reader) (*Envelope, error) {
+	prefix := make([]byte, len(envelopeMagic)+1)
+	if _, err := io.ReadFull(reader, prefix); err != nil {
+		return nil, fmt.Errorf("data is not an envelope")
+	}
+	if !bytes.Equal(prefix[:len(envelopeMagic)], envelopeMagic) {
+		return nil, fmt.Errorf("data is not an envelope")
+	}
+	version := prefix[len(envelopeMagic)]
+	if version != envelopeVersion && version != envelopeVersionKeyVersion {
+		return nil, fmt.Errorf("unsupported envelope version %d", version)
+	}
+
+	// Algorithm, mode, key ID and nonce are prefixed by a 1 byte length, the wrapped DEK by a 2 byte length
+	fields := make([][]byte, 5)
+	for i := range fields {
+		lengthSize := 1
+		if i == len(fields)-1 {
+			lengthSize = 2
+		}
+
+		field, err := readEnvelopeField(reader, lengthSize)
+		if err != nil {
+			return nil, fmt.Errorf("%w", err)
+		}
+		fields[i] = field
+	}
+
+	envelope := &Envelope{
+		Algorithm: string(fields[0]),
+		Mode:      string(fields[1]),
+		KeyID:     string(fields[2]),
+	}
+	if len(fields[3]) > 0 {
+		envelope.Nonce = fields[3]
+	}
+	if len(fields[4]) > 0 {
+		envelope.WrappedDEK = fields[4]
+	}
+	if version == envelopeVersionKeyVersion {
+		keyVersionBytes := make([]byte, 4)
+		if _, err := io.ReadFull(reader, keyVersionBytes); err != nil {
+			return nil, fmt.Errorf("envelope too short")
+		}
+		envelope.KeyVersion = binary.BigEndian.Uint32(keyVersionBytes)
+	}
+
+	return envelope, nil
+}
+
+// readEnvelopeField reads a header field prefixed by its big endian length of the given size
+func readEnvelopeField(reader io.Reader, lengthSize int) ([]byte, error) {
+	lengthBytes := make([]byte, lengthSize)
+	if _, err := io.ReadFull(reader, lengthBytes); err != nil {
+		return nil, fmt.Errorf("envelope too short")
+	}
+
+	length := int(lengthBytes[0])
+	if lengthSize == 2 {
+		length = int(binary.BigEndian.Uint16(lengthBytes))
+	}
+
+	field := make([]byte, length)
+	if _, err := io.ReadFull(reader, field); err != nil {
+		return nil, fmt.Errorf("envelope too short")
+	}
+
+	return field, nil
+}
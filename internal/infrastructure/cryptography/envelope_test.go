@@ -0,0 +1,136 @@
+//go:build unit
+// +build unit
+
+package cryptography
+
+import (
+	"bytes"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// EnvelopeTests encapsulates envelope format test cases
+type EnvelopeTests struct {
+	envelope *Envelope
+}
+
+// NewEnvelopeTests creates a new instance of EnvelopeTests
+func NewEnvelopeTests(t *testing.T) *EnvelopeTests {
+	return &EnvelopeTests{
+		envelope: &Envelope{
+			Algorithm:  EnvelopeAlgorithmAES,
+			Mode:       AESModeGCM,
+			KeyID:      "b1f1b0a4-7c5e-4b8e-9d4f-0a1b2c3d4e5f",
+			Nonce:      bytes.Repeat([]byte{0x01}, 12),
+			WrappedDEK: bytes.Repeat([]byte{0x02}, 256),
+			Ciphertext: []byte("ciphertext"),
+		},
+	}
+}
+
+func (et *EnvelopeTests) TestMarshalParse(t *testing.T) {
+	data, err := et.envelope.Marshal()
+	require.NoError(t, err)
+	assert.True(t, IsEnvelope(data))
+
+	parsed, err := ParseEnvelope(data)
+	require.NoError(t, err)
+	assert.Equal(t, et.envelope, parsed)
+}
+
+func (et *EnvelopeTests) TestArmorParse(t *testing.T) {
+	armored, err := et.envelope.Armor()
+	require.NoError(t, err)
+	assert.True(t, strings.HasPrefix(string(armored), "-----BEGIN CRYPTO VAULT ENVELOPE-----"))
+	assert.Contains(t, string(armored), "Key-ID: "+et.envelope.KeyID)
+	assert.True(t, IsEnvelope(armored))
+
+	parsed, err := ParseEnvelope(armored)
+	require.NoError(t, err)
+	assert.Equal(t, et.envelope, parsed)
+}
+
+func (et *EnvelopeTests) TestParseWithoutOptionalFields(t *testing.T) {
+	envelope := &Envelope{
+		Algorithm:  EnvelopeAlgorithmRSA,
+		Mode:       RSAModePKCS1v15,
+		KeyID:      "key-pair-id",
+		Ciphertext: []byte("ciphertext"),
+	}
+
+	data, err := envelope.Marshal()
+	require.NoError(t, err)
+
+	parsed, err := ParseEnvelope(data)
+	require.NoError(t, err)
+	assert.Equal(t, envelope, parsed)
+}
+
+func (et *EnvelopeTests) TestKeyVersion(t *testing.T) {
+	envelope := *et.envelope
+	envelope.KeyVersion = 3
+
+	data, err := envelope.Marshal()
+	require.NoError(t, err)
+	assert.Equal(t, envelopeVersionKeyVersion, data[len(envelopeMagic)])
+
+	parsed, err := ParseEnvelope(data)
+	require.NoError(t, err)
+	assert.Equal(t, &envelope, parsed)
+
+	peeked, err := PeekEnvelope(NewEnvelopeReader(bytes.NewReader(data)))
+	require.NoError(t, err)
+	assert.Equal(t, uint32(3), peeked.KeyVersion)
+
+	armored, err := envelope.Armor()
+	require.NoError(t, err)
+	assert.Contains(t, string(armored), "Key-Version: 3")
+
+	// Unversioned envelopes keep the version 1 layout
+	data, err = et.envelope.Marshal()
+	require.NoError(t, err)
+	assert.Equal(t, envelopeVersion, data[len(envelopeMagic)])
+}
+
+func (et *EnvelopeTests) TestParseInvalidData(t *testing.T) {
+	_, err := ParseEnvelope([]byte("not an envelope"))
+	assert.Error(t, err)
+	assert.False(t, IsEnvelope([]byte("not an envelope")))
+
+	data, err := et.envelope.Marshal()
+	require.NoError(t, err)
+
+	_, err = ParseEnvelope(data[:len(envelopeMagic)+3])
+	assert.Error(t, err, "Truncated header must be rejected")
+
+	unsupportedVersion := append([]byte{}, data...)
+	unsupportedVersion[len(envelopeMagic)] = envelopeVersionKeyVersion + 1
+	_, err = ParseEnvelope(unsupportedVersion)
+	assert.Error(t, err, "Unknown envelope versions must be rejected")
+}
+
+func (et *EnvelopeTests) TestMarshalOversizedField(t *testing.T) {
+	envelope := &Envelope{
+		Algorithm: EnvelopeAlgorithmAES,
+		Mode:      AESModeGCM,
+		KeyID:     strings.Repeat("k", 256),
+	}
+
+	_, err := envelope.Marshal()
+	assert.Error(t, err)
+}
+
+// Entry point to run EnvelopeTests
+func TestEnvelope(t *testing.T) {
+	tests := NewEnvelopeTests(t)
+
+	t.Run("TestMarshalParse", tests.TestMarshalParse)
+	t.Run("TestArmorParse", tests.TestArmorParse)
+	t.Run("TestParseWithoutOptionalFields", tests.TestParseWithoutOptionalFields)
+	t.Run("TestKeyVersion", tests.TestKeyVersion)
+	t.Run("TestParseInvalidData", tests.TestParseInvalidData)
+	t.Run("TestMarshalOversizedField", tests.TestMarshalOversizedField)
+}
@@ -0,0 +1,296 @@
+package cryptography
+
+import (
+	"crypto/ecdh"
+	"crypto/ecdsa"
+	"crypto/elliptic"
+	"crypto/rsa"
+	"crypto/x509"
+	"encoding/base64"
+	"encoding/json"
+	"encoding/pem"
+	"fmt"
+	"math/big"
+)
+
+// Formats key material is imported in
+const (
+	KeyFormatPEM = "pem" // PEM encoded PKCS#1, PKCS#8, SEC1 or PKIX keys
+	KeyFormatDER = "der" // DER encoded PKCS#1, PKCS#8, SEC1 or PKIX keys
+	KeyFormatJWK = "jwk" // JSON Web Keys as specified by RFC 7517 and RFC 7518
+	KeyFormatRaw = "raw" // Raw bytes of AES keys
+)
+
+// ImportedKey holds key material parsed for import.
+// AES keys set SymmetricKey, RSA and EC keys set PublicKey along with PrivateKey unless only the public key was imported.
+type ImportedKey struct {
+	Algorithm    string
+	KeySize      uint32
+	SymmetricKey []byte
+	PrivateKey   any // *rsa.PrivateKey or *ecdsa.PrivateKey
+	PublicKey    any // *rsa.PublicKey or *ecdsa.PublicKey
+}
+
+// jsonWebKey holds the members of RSA, EC and symmetric JSON Web Keys used for import, other members are ignored
+type jsonWebKey struct {
+	Kty string `json:"kty"`
+	Crv string `json:"crv"`
+	X   string `json:"x"`
+	Y   string `json:"y"`
+	D   string `json:"d"`
+	N   string `json:"n"`
+	E   string `json:"e"`
+	P   string `json:"p"`
+	Q   string `json:"q"`
+	K   string `json:"k"`
+}
+
+// ParseImportedKey parses key material of the given algorithm (AES, RSA or EC) encoded in the given format.
+// AES keys are imported raw or as JWK, RSA and EC keys as PEM, DER or JWK.
+func ParseImportedKey(algorithm, format string, keyMaterial []byte) (*ImportedKey, error) {
+	if len(keyMaterial) == 0 {
+		return nil, fmt.Errorf("key material cannot be empty")
+	}
+
+	switch algorithm {
+	case "AES":
+		return parseImportedAESKey(format, keyMaterial)
+	case "RSA", "EC":
+		return parseImportedAsymmetricKey(algorithm, format, keyMaterial)
+	default:
+		return nil, fmt.Errorf("unsupported algorithm: %s", algorithm)
+	}
+}
+
+// parseImportedAESKey parses a raw AES key or a symmetric JWK
+func parseImportedAESKey(format string, keyMaterial []byte) (*ImportedKey, error) {
+	var symmetricKey []byte
+	switch format {
+	case KeyFormatRaw:
+		symmetricKey = keyMaterial
+	case KeyFormatJWK:
+		jwk, err := parseJSONWebKey(keyMaterial, "oct")
+		if err != nil {
+			return nil, fmt.Errorf("%w", err)
+		}
+		symmetricKey, err = decodeJWKMember("k", jwk.K)
+		if err != nil {
+			return nil, fmt.Errorf("%w", err)
+		}
+	default:
+		return nil, fmt.Errorf("format %s not supported for AES keys", format)
+	}
+
+	return &ImportedKey{Algorithm: "AES", KeySize: uint32(len(symmetricKey) * 8), SymmetricKey: symmetricKey}, nil
+}
+
+// parseImportedAsymmetricKey parses an RSA or EC private or public key and derives the public key of private keys
+func parseImportedAsymmetricKey(algorithm, format string, keyMaterial []byte) (*ImportedKey, error) {
+	var key any
+	var err error
+	switch format {
+	case KeyFormatPEM:
+		block, _ := pem.Decode(keyMaterial)
+		if block == nil {
+			return nil, fmt.Errorf("failed to decode PEM block")
+		}
+		key, err = parseDERKey(block.Bytes)
+	case KeyFormatDER:
+		key, err = parseDERKey(keyMaterial)
+	case KeyFormatJWK:
+		key, err = parseAsymmetricJSONWebKey(algorithm, keyMaterial)
+	default:
+		return nil, fmt.Errorf("format %s not supported for %s keys", format, algorithm)
+	}
+	if err != nil {
+		return nil, fmt.Errorf("%w", err)
+	}
+
+	importedKey := &ImportedKey{Algorithm: algorithm}
+	switch typedKey := key.(type) {
+	case *rsa.PrivateKey:
+		importedKey.PrivateKey, importedKey.PublicKey = typedKey, &typedKey.PublicKey
+		importedKey.KeySize = uint32(typedKey.N.BitLen())
+	case *rsa.PublicKey:
+		importedKey.PublicKey = typedKey
+		importedKey.KeySize = uint32(typedKey.N.BitLen())
+	case *ecdsa.PrivateKey:
+		importedKey.PrivateKey, importedKey.PublicKey = typedKey, &typedKey.PublicKey
+		importedKey.KeySize = uint32(typedKey.Curve.Params().BitSize)
+	case *ecdsa.PublicKey:
+		importedKey.PublicKey = typedKey
+		importedKey.KeySize = uint32(typedKey.Curve.Params().BitSize)
+	default:
+		return nil, fmt.Errorf("key of type %T not supported", key)
+	}
+
+	_, isRSA := importedKey.PublicKey.(*rsa.PublicKey)
+	if isRSA != (algorithm == "RSA") {
+		return nil, fmt.Errorf("key is not of type %s", algorithm)
+	}
+
+	return importedKey, nil
+}
+
+// parseDERKey parses a DER encoded PKCS#8, PKCS#1 or SEC1 private key or a PKIX or PKCS#1 public key
+func parseDERKey(der []byte) (any, error) {
+	if key, err := x509.ParsePKCS8PrivateKey(der); err == nil {
+		return key, nil
+	}
+	if key, err := x509.ParsePKCS1PrivateKey(der); err == nil {
+		return key, nil
+	}
+	if key, err := x509.ParseECPrivateKey(der); err == nil {
+		return key, nil
+	}
+	if key, err := x509.ParsePKIXPublicKey(der); err == nil {
+		return key, nil
+	}
+	if key, err := x509.ParsePKCS1PublicKey(der); err == nil {
+		return key, nil
+	}
+	return nil, fmt.Errorf("failed to parse key: neither PKCS#8, PKCS#1, SEC1 nor PKIX")
+}
+
+// parseAsymmetricJSONWebKey parses an RSA or EC JWK into a private key if it holds the private members or into a public key otherwise
+func parseAsymmetricJSONWebKey(algorithm string, keyMaterial []byte) (any, error) {
+	if algorithm == "RSA" {
+		jwk, err := parseJSONWebKey(keyMaterial, "RSA")
+		if err != nil {
+			return nil, fmt.Errorf("%w", err)
+		}
+		return parseRSAJSONWebKey(jwk)
+	}
+
+	jwk, err := parseJSONWebKey(keyMaterial, "EC")
+	if err != nil {
+		return nil, fmt.Errorf("%w", err)
+	}
+	return parseECJSONWebKey(jwk)
+}
+
+// parseRSAJSONWebKey parses the members of an RSA JWK, private keys must include their prime factors
+func parseRSAJSONWebKey(jwk *jsonWebKey) (any, error) {
+	n, err := decodeJWKInt("n", jwk.N)
+	if err != nil {
+		return nil, fmt.Errorf("%w", err)
+	}
+	e, err := decodeJWKInt("e", jwk.E)
+	if err != nil {
+		return nil, fmt.Errorf("%w", err)
+	}
+	if !e.IsInt64() || e.Int64() < 3 || e.Int64() > 1<<31-1 {
+		return nil, fmt.Errorf("invalid RSA public exponent")
+	}
+	publicKey := &rsa.PublicKey{N: n, E: int(e.Int64())}
+
+	if jwk.D == "" {
+		return publicKey, nil
+	}
+
+	var components []*big.Int
+	for _, member := range []struct{ name, value string }{{"d", jwk.D}, {"p", jwk.P}, {"q", jwk.Q}} {
+		component, err := decodeJWKInt(member.name, member.value)
+		if err != nil {
+			return nil, fmt.Errorf("%w", err)
+		}
+		components = append(components, component)
+	}
+
+	privateKey := &rsa.PrivateKey{PublicKey: *publicKey, D: components[0], Primes: components[1:]}
+	if err := privateKey.Validate(); err != nil {
+		return nil, fmt.Errorf("invalid RSA private key: %w", err)
+	}
+	privateKey.Precompute()
+	return privateKey, nil
+}
+
+// parseECJSONWebKey parses the members of an EC JWK and checks that the point lies on the curve and matches the private scalar if present
+func parseECJSONWebKey(jwk *jsonWebKey) (any, error) {
+	var curve elliptic.Curve
+	var ecdhCurve ecdh.Curve
+	switch jwk.Crv {
+	case "P-256":
+		curve, ecdhCurve = elliptic.P256(), ecdh.P256()
+	case "P-384":
+		curve, ecdhCurve = elliptic.P384(), ecdh.P384()
+	case "P-521":
+		curve, ecdhCurve = elliptic.P521(), ecdh.P521()
+	default:
+		return nil, fmt.Errorf("curve %q not supported", jwk.Crv)
+	}
+	size := ecCoordinateSize(curve)
+
+	x, err := decodeJWKMember("x", jwk.X)
+	if err != nil {
+		return nil, fmt.Errorf("%w", err)
+	}
+	y, err := decodeJWKMember("y", jwk.Y)
+	if err != nil {
+		return nil, fmt.Errorf("%w", err)
+	}
+	if len(x) != size || len(y) != size {
+		return nil, fmt.Errorf("coordinates of curve %s must be %d bytes", jwk.Crv, size)
+	}
+
+	point := append(append([]byte{4}, x...), y...) // uncompressed point
+	ecdhPublicKey, err := ecdhCurve.NewPublicKey(point)
+	if err != nil {
+		return nil, fmt.Errorf("point is not on curve %s: %w", jwk.Crv, err)
+	}
+	publicKey := &ecdsa.PublicKey{Curve: curve, X: new(big.Int).SetBytes(x), Y: new(big.Int).SetBytes(y)}
+
+	if jwk.D == "" {
+		return publicKey, nil
+	}
+
+	d, err := decodeJWKMember("d", jwk.D)
+	if err != nil {
+		return nil, fmt.Errorf("%w", err)
+	}
+	if len(d) != size {
+		return nil, fmt.Errorf("private scalar of curve %s must be %d bytes", jwk.Crv, size)
+	}
+	ecdhKey, err := ecdhCurve.NewPrivateKey(d)
+	if err != nil {
+		return nil, fmt.Errorf("invalid EC private key: %w", err)
+	}
+	if !ecdhKey.PublicKey().Equal(ecdhPublicKey) {
+		return nil, fmt.Errorf("EC private key does not match its public point")
+	}
+
+	return &ecdsa.PrivateKey{PublicKey: *publicKey, D: new(big.Int).SetBytes(d)}, nil
+}
+
+// parseJSONWebKey decodes a JWK and checks its key type
+func parseJSONWebKey(keyMaterial []byte, kty string) (*jsonWebKey, error) {
+	var jwk jsonWebKey
+	if err := json.Unmarshal(keyMaterial, &jwk); err != nil {
+		return nil, fmt.Errorf("failed to decode JWK: %w", err)
+	}
+	if jwk.Kty != kty {
+		return nil, fmt.Errorf("JWK key type must be %s, got %q", kty, jwk.Kty)
+	}
+	return &jwk, nil
+}
+
+// decodeJWKMember decodes a base64url encoded JWK member, which must be present
+func decodeJWKMember(name, value string) ([]byte, error) {
+	if value == "" {
+		return nil, fmt.Errorf("JWK member %s is missing", name)
+	}
+	decoded, err := base64.RawURLEncoding.DecodeString(value)
+	if err != nil {
+		return nil, fmt.Errorf("failed to decode JWK member %s: %w", name, err)
+	}
+	return decoded, nil
+}
+
+// decodeJWKInt decodes a base64url encoded JWK member holding an unsigned big-endian integer
+func decodeJWKInt(name, value string) (*big.Int, error) {
+	decoded, err := decodeJWKMember(name, value)
+	if err != nil {
+		return nil, fmt.Errorf("%w", err)
+	}
+	return new(big.Int).SetBytes(decoded), nil
+}
@@ -0,0 +1,174 @@
+//go:build unit
+// +build unit
+
+package cryptography
+
+import (
+	"crypto/ecdsa"
+	"crypto/elliptic"
+	"crypto/rand"
+	"crypto/rsa"
+	"crypto/x509"
+	"encoding/base64"
+	"encoding/pem"
+	"fmt"
+	"math/big"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// jwkMember base64url encodes a big-endian integer as JWK member, padded to size bytes
+func jwkMember(value *big.Int, size int) string {
+	return base64.RawURLEncoding.EncodeToString(value.FillBytes(make([]byte, size)))
+}
+
+func TestParseImportedKey_AES(t *testing.T) {
+	key := make([]byte, 32)
+	_, err := rand.Read(key)
+	require.NoError(t, err)
+
+	tests := []struct {
+		name        string
+		format      string
+		keyMaterial []byte
+	}{
+		{"Raw", KeyFormatRaw, key},
+		{"JWK", KeyFormatJWK, []byte(fmt.Sprintf(`{"kty":"oct","k":"%s","alg":"A256GCM"}`, base64.RawURLEncoding.EncodeToString(key)))},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			importedKey, err := ParseImportedKey("AES", tt.format, tt.keyMaterial)
+			require.NoError(t, err)
+			assert.Equal(t, uint32(256), importedKey.KeySize)
+			assert.Equal(t, key, importedKey.SymmetricKey)
+			assert.Nil(t, importedKey.PrivateKey)
+		})
+	}
+}
+
+func TestParseImportedKey_RSA(t *testing.T) {
+	privateKey, err := rsa.GenerateKey(rand.Reader, 2048)
+	require.NoError(t, err)
+
+	pkcs8Key, err := x509.MarshalPKCS8PrivateKey(privateKey)
+	require.NoError(t, err)
+	pkixKey, err := x509.MarshalPKIXPublicKey(&privateKey.PublicKey)
+	require.NoError(t, err)
+
+	jwk := fmt.Sprintf(`{"kty":"RSA","n":"%s","e":"AQAB","d":"%s","p":"%s","q":"%s"}`,
+		jwkMember(privateKey.N, 256), jwkMember(privateKey.D, 256), jwkMember(privateKey.Primes[0], 128), jwkMember(privateKey.Primes[1], 128))
+
+	tests := []struct {
+		name        string
+		format      string
+		keyMaterial []byte
+		private     bool
+	}{
+		{"PKCS#1 PEM", KeyFormatPEM, pem.EncodeToMemory(&pem.Block{Type: "RSA PRIVATE KEY", Bytes: x509.MarshalPKCS1PrivateKey(privateKey)}), true},
+		{"PKCS#8 DER", KeyFormatDER, pkcs8Key, true},
+		{"PKIX PEM", KeyFormatPEM, pem.EncodeToMemory(&pem.Block{Type: "PUBLIC KEY", Bytes: pkixKey}), false},
+		{"PKCS#1 public DER", KeyFormatDER, x509.MarshalPKCS1PublicKey(&privateKey.PublicKey), false},
+		{"Private JWK", KeyFormatJWK, []byte(jwk), true},
+		{"Public JWK", KeyFormatJWK, []byte(fmt.Sprintf(`{"kty":"RSA","n":"%s","e":"AQAB"}`, jwkMember(privateKey.N, 256))), false},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			importedKey, err := ParseImportedKey("RSA", tt.format, tt.keyMaterial)
+			require.NoError(t, err)
+			assert.Equal(t, uint32(2048), importedKey.KeySize)
+			assert.True(t, privateKey.PublicKey.Equal(importedKey.PublicKey))
+			if tt.private {
+				assert.True(t, privateKey.Equal(importedKey.PrivateKey))
+			} else {
+				assert.Nil(t, importedKey.PrivateKey)
+			}
+		})
+	}
+}
+
+func TestParseImportedKey_EC(t *testing.T) {
+	privateKey, err := ecdsa.GenerateKey(elliptic.P384(), rand.Reader)
+	require.NoError(t, err)
+
+	sec1Key, err := x509.MarshalECPrivateKey(privateKey)
+	require.NoError(t, err)
+	pkcs8Key, err := x509.MarshalPKCS8PrivateKey(privateKey)
+	require.NoError(t, err)
+	pkixKey, err := x509.MarshalPKIXPublicKey(&privateKey.PublicKey)
+	require.NoError(t, err)
+
+	publicJWK := fmt.Sprintf(`{"kty":"EC","crv":"P-384","x":"%s","y":"%s"`, jwkMember(privateKey.X, 48), jwkMember(privateKey.Y, 48))
+
+	tests := []struct {
+		name        string
+		format      string
+		keyMaterial []byte
+		private     bool
+	}{
+		{"SEC1 PEM", KeyFormatPEM, pem.EncodeToMemory(&pem.Block{Type: "EC PRIVATE KEY", Bytes: sec1Key}), true},
+		{"PKCS#8 DER", KeyFormatDER, pkcs8Key, true},
+		{"PKIX DER", KeyFormatDER, pkixKey, false},
+		{"Private JWK", KeyFormatJWK, []byte(publicJWK + fmt.Sprintf(`,"d":"%s"}`, jwkMember(privateKey.D, 48))), true},
+		{"Public JWK", KeyFormatJWK, []byte(publicJWK + "}"), false},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			importedKey, err := ParseImportedKey("EC", tt.format, tt.keyMaterial)
+			require.NoError(t, err)
+			assert.Equal(t, uint32(384), importedKey.KeySize)
+			assert.True(t, privateKey.PublicKey.Equal(importedKey.PublicKey))
+			if tt.private {
+				assert.True(t, privateKey.Equal(importedKey.PrivateKey))
+			} else {
+				assert.Nil(t, importedKey.PrivateKey)
+			}
+		})
+	}
+}
+
+func TestParseImportedKey_Fail(t *testing.T) {
+	rsaKey, err := rsa.GenerateKey(rand.Reader, 2048)
+	require.NoError(t, err)
+	ecKey, err := ecdsa.GenerateKey(elliptic.P256(), rand.Reader)
+	require.NoError(t, err)
+	otherECKey, err := ecdsa.GenerateKey(elliptic.P256(), rand.Reader)
+	require.NoError(t, err)
+
+	ecPKCS8Key, err := x509.MarshalPKCS8PrivateKey(ecKey)
+	require.NoError(t, err)
+
+	tests := []struct {
+		name        string
+		algorithm   string
+		format      string
+		keyMaterial []byte
+	}{
+		{"Empty key material", "AES", KeyFormatRaw, nil},
+		{"Unsupported algorithm", "DES", KeyFormatRaw, []byte("01234567")},
+		{"AES as PEM", "AES", KeyFormatPEM, []byte("0123456789abcdef")},
+		{"RSA raw", "RSA", KeyFormatRaw, x509.MarshalPKCS1PrivateKey(rsaKey)},
+		{"EC key imported as RSA", "RSA", KeyFormatDER, ecPKCS8Key},
+		{"RSA key imported as EC", "EC", KeyFormatDER, x509.MarshalPKCS1PrivateKey(rsaKey)},
+		{"No PEM block", "RSA", KeyFormatPEM, []byte("no pem")},
+		{"Garbage DER", "EC", KeyFormatDER, []byte("garbage")},
+		{"JWK of other key type", "AES", KeyFormatJWK, []byte(`{"kty":"RSA","n":"AQAB","e":"AQAB"}`)},
+		{"JWK missing member", "EC", KeyFormatJWK, []byte(`{"kty":"EC","crv":"P-256","x":"AQAB"}`)},
+		{"JWK unsupported curve", "EC", KeyFormatJWK, []byte(`{"kty":"EC","crv":"secp256k1","x":"AQAB","y":"AQAB"}`)},
+		{"JWK point not on curve", "EC", KeyFormatJWK, []byte(fmt.Sprintf(`{"kty":"EC","crv":"P-256","x":"%s","y":"%s"}`, jwkMember(ecKey.X, 32), jwkMember(otherECKey.Y, 32)))},
+		{"JWK private scalar not matching point", "EC", KeyFormatJWK, []byte(fmt.Sprintf(`{"kty":"EC","crv":"P-256","x":"%s","y":"%s","d":"%s"}`, jwkMember(ecKey.X, 32), jwkMember(ecKey.Y, 32), jwkMember(otherECKey.D, 32)))},
+		{"JWK RSA private key without primes", "RSA", KeyFormatJWK, []byte(fmt.Sprintf(`{"kty":"RSA","n":"%s","e":"AQAB","d":"%s"}`, jwkMember(rsaKey.N, 256), jwkMember(rsaKey.D, 256)))},
+		{"Invalid JSON", "AES", KeyFormatJWK, []byte(`{"kty":`)},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			_, err := ParseImportedKey(tt.algorithm, tt.format, tt.keyMaterial)
+			assert.Error(t, err)
+		})
+	}
+}
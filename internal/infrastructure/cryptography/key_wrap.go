@@ -0,0 +1,314 @@
+package cryptography
+
+import (
+	"bytes"
+	"crypto"
+	"crypto/aes"
+	"crypto/cipher"
+	"crypto/rand"
+	"crypto/rsa"
+	"crypto/sha256"
+	"crypto/subtle"
+	"crypto/x509"
+	"encoding/binary"
+	"encoding/pem"
+	"fmt"
+	"os"
+)
+
+// aesKeyWrapIV is the default initial value of AES Key Wrap (RFC 3394, section 2.2.3.1)
+var aesKeyWrapIV = [8]byte{0xA6, 0xA6, 0xA6, 0xA6, 0xA6, 0xA6, 0xA6, 0xA6}
+
+// aesKeyWrapPadICV is the constant half of the alternative initial value of AES Key Wrap with Padding (RFC 5649, section 3)
+var aesKeyWrapPadICV = [4]byte{0xA6, 0x59, 0x59, 0xA6}
+
+// AESKeyWrap wraps key material whose length is a multiple of 8 bytes and at least 16 bytes with the KEK as specified by RFC 3394
+func AESKeyWrap(kek, plainKey []byte) ([]byte, error) {
+	if len(plainKey) < 16 || len(plainKey)%8 != 0 {
+		return nil, fmt.Errorf("key to wrap must be a multiple of 8 bytes and at least 16 bytes, got %d", len(plainKey))
+	}
+
+	block, err := aes.NewCipher(kek)
+	if err != nil {
+		return nil, fmt.Errorf("failed to create AES cipher: %w", err)
+	}
+
+	return aesKeyWrap(block, aesKeyWrapIV, plainKey), nil
+}
+
+// AESKeyUnwrap unwraps key material wrapped with the KEK as specified by RFC 3394 and verifies its integrity
+func AESKeyUnwrap(kek, wrappedKey []byte) ([]byte, error) {
+	if len(wrappedKey) < 24 || len(wrappedKey)%8 != 0 {
+		return nil, fmt.Errorf("wrapped key must be a multiple of 8 bytes and at least 24 bytes, got %d", len(wrappedKey))
+	}
+
+	block, err := aes.NewCipher(kek)
+	if err != nil {
+		return nil, fmt.Errorf("failed to create AES cipher: %w", err)
+	}
+
+	iv, plainKey := aesKeyUnwrap(block, wrappedKey)
+	if subtle.ConstantTimeCompare(iv[:], aesKeyWrapIV[:]) != 1 {
+		return nil, fmt.Errorf("failed to unwrap key: integrity check failed")
+	}
+	return plainKey, nil
+}
+
+// AESKeyWrapPad wraps key material of any length between 1 byte and 4 GiB with the KEK as specified by RFC 5649
+func AESKeyWrapPad(kek, plainKey []byte) ([]byte, error) {
+	if len(plainKey) == 0 || uint64(len(plainKey)) > 0xFFFFFFFF {
+		return nil, fmt.Errorf("key to wrap must be between 1 byte and 4 GiB, got %d bytes", len(plainKey))
+	}
+
+	block, err := aes.NewCipher(kek)
+	if err != nil {
+		return nil, fmt.Errorf("failed to create AES cipher: %w", err)
+	}
+
+	var iv [8]byte
+	copy(iv[:4], aesKeyWrapPadICV[:])
+	binary.BigEndian.PutUint32(iv[4:], uint32(len(plainKey)))
+
+	paddedKey := make([]byte, (len(plainKey)+7)/8*8)
+	copy(paddedKey, plainKey)
+
+	// A single padded block is encrypted with AES in ECB mode instead of the wrapping process
+	if len(paddedKey) == 8 {
+		wrappedKey := make([]byte, 16)
+		copy(wrappedKey, iv[:])
+		copy(wrappedKey[8:], paddedKey)
+		block.Encrypt(wrappedKey, wrappedKey)
+		return wrappedKey, nil
+	}
+
+	return aesKeyWrap(block, iv, paddedKey), nil
+}
+
+// AESKeyUnwrapPad unwraps key material wrapped with the KEK as specified by RFC 5649 and verifies its integrity and padding
+func AESKeyUnwrapPad(kek, wrappedKey []byte) ([]byte, error) {
+	if len(wrappedKey) < 16 || len(wrappedKey)%8 != 0 {
+		return nil, fmt.Errorf("wrapped key must be a multiple of 8 bytes and at least 16 bytes, got %d", len(wrappedKey))
+	}
+
+	block, err := aes.NewCipher(kek)
+	if err != nil {
+		return nil, fmt.Errorf("failed to create AES cipher: %w", err)
+	}
+
+	var iv [8]byte
+	var paddedKey []byte
+	if len(wrappedKey) == 16 {
+		decryptedBlock := make([]byte, 16)
+		block.Decrypt(decryptedBlock, wrappedKey)
+		copy(iv[:], decryptedBlock[:8])
+		paddedKey = decryptedBlock[8:]
+	} else {
+		iv, paddedKey = aesKeyUnwrap(block, wrappedKey)
+	}
+
+	// The length and padding are checked without revealing which check failed
+	keyLength := binary.BigEndian.Uint32(iv[4:])
+	valid := subtle.ConstantTimeCompare(iv[:4], aesKeyWrapPadICV[:])
+	if uint64(keyLength) > uint64(len(paddedKey)) || uint64(keyLength)+8 <= uint64(len(paddedKey)) {
+		valid = 0
+		keyLength = 0
+	}
+	padding := paddedKey[keyLength:]
+	valid &= subtle.ConstantTimeCompare(padding, make([]byte, len(padding)))
+	if valid != 1 {
+		return nil, fmt.Errorf("failed to unwrap key: integrity check failed")
+	}
+
+	return paddedKey[:keyLength], nil
+}
+
+// aesKeyWrap applies the wrapping process of RFC 3394, section 2.2.1 to the 64-bit blocks of the plain key
+func aesKeyWrap(block cipher.Block, iv [8]byte, plainKey []byte) []byte {
+	n := len(plainKey) / 8
+	wrappedKey := make([]byte, 8+len(plainKey))
+	copy(wrappedKey[8:], plainKey)
+
+	a := iv
+	buffer := make([]byte, 16)
+	for j := 0; j < 6; j++ {
+		for i := 1; i <= n; i++ {
+			copy(buffer, a[:])
+			copy(buffer[8:], wrappedKey[8*i:8*i+8])
+			block.Encrypt(buffer, buffer)
+
+			t := uint64(n*j + i)
+			binary.BigEndian.PutUint64(a[:], binary.BigEndian.Uint64(buffer[:8])^t)
+			copy(wrappedKey[8*i:8*i+8], buffer[8:])
+		}
+	}
+
+	copy(wrappedKey, a[:])
+	return wrappedKey
+}
+
+// aesKeyUnwrap applies the unwrapping process of RFC 3394, section 2.2.2 and returns the recovered initial value along with the plain key
+func aesKeyUnwrap(block cipher.Block, wrappedKey []byte) ([8]byte, []byte) {
+	n := len(wrappedKey)/8 - 1
+	plainKey := make([]byte, len(wrappedKey)-8)
+	copy(plainKey, wrappedKey[8:])
+
+	var a [8]byte
+	copy(a[:], wrappedKey[:8])
+	buffer := make([]byte, 16)
+	for j := 5; j >= 0; j-- {
+		for i := n; i >= 1; i-- {
+			t := uint64(n*j + i)
+			binary.BigEndian.PutUint64(buffer[:8], binary.BigEndian.Uint64(a[:])^t)
+			copy(buffer[8:], plainKey[8*(i-1):8*i])
+			block.Decrypt(buffer, buffer)
+
+			copy(a[:], buffer[:8])
+			copy(plainKey[8*(i-1):8*i], buffer[8:])
+		}
+	}
+
+	return a, plainKey
+}
+
+// RSAOAEPWrapKey wraps key material with the RSA public key using OAEP with SHA-256
+func RSAOAEPWrapKey(publicKey *rsa.PublicKey, plainKey []byte) ([]byte, error) {
+	if publicKey == nil {
+		return nil, fmt.Errorf("public key cannot be nil")
+	}
+
+	wrappedKey, err := rsa.EncryptOAEP(sha256.New(), rand.Reader, publicKey, plainKey, nil)
+	if err != nil {
+		return nil, fmt.Errorf("failed to wrap key with RSA-OAEP: %w", err)
+	}
+	return wrappedKey, nil
+}
+
+// RSAOAEPUnwrapKey unwraps key material wrapped with the RSA public key using OAEP with SHA-256.
+// The private key may be an *rsa.PrivateKey or any crypto.Decrypter of an RSA key, like a key on a PKCS#11 token.
+func RSAOAEPUnwrapKey(privateKey crypto.Decrypter, wrappedKey []byte) ([]byte, error) {
+	if privateKey == nil {
+		return nil, fmt.Errorf("private key cannot be nil")
+	}
+
+	plainKey, err := privateKey.Decrypt(nil, wrappedKey, &rsa.OAEPOptions{Hash: crypto.SHA256})
+	if err != nil {
+		return nil, fmt.Errorf("failed to unwrap key with RSA-OAEP: %w", err)
+	}
+	return plainKey, nil
+}
+
+// Names of the algorithms key material is wrapped with
+const (
+	AESKeyWrapPadAlgorithm = "AES_KEY_WRAP_PAD"         // AESKeyWrapPad, which matches CKM_AES_KEY_WRAP_KWP of PKCS#11
+	RSAOAEPAlgorithm       = "RSAES_OAEP_SHA_256"       // RSAOAEPWrapKey
+	RSAAESKeyWrapAlgorithm = "RSA_AES_KEY_WRAP_SHA_256" // RSAAESKeyWrap, which matches CKM_RSA_AES_KEY_WRAP of PKCS#11 with SHA-256
+)
+
+// MinRSAWrappingKeySize is the minimum modulus size in bits of RSA public keys supplied to wrap key material with
+const MinRSAWrappingKeySize = 2048
+
+// rsaAESKeyWrapKEKSize is the size in bytes of the ephemeral AES key wrapping the key material in RSAAESKeyWrap
+const rsaAESKeyWrapKEKSize = 32
+
+// RSAAESKeyWrap wraps key material of any length with the RSA public key.
+// An ephemeral AES-256 key wraps the key material as specified by RFC 5649 and is itself wrapped with RSA-OAEP using SHA-256.
+// The result is the wrapped ephemeral key, whose length equals the modulus size, followed by the wrapped key material.
+func RSAAESKeyWrap(publicKey *rsa.PublicKey, plainKey []byte) ([]byte, error) {
+	kek := make([]byte, rsaAESKeyWrapKEKSize)
+	if _, err := rand.Read(kek); err != nil {
+		return nil, fmt.Errorf("failed to generate ephemeral key: %w", err)
+	}
+
+	wrappedKEK, err := RSAOAEPWrapKey(publicKey, kek)
+	if err != nil {
+		return nil, fmt.Errorf("%w", err)
+	}
+
+	wrappedKey, err := AESKeyWrapPad(kek, plainKey)
+	if err != nil {
+		return nil, fmt.Errorf("%w", err)
+	}
+
+	return append(wrappedKEK, wrappedKey...), nil
+}
+
+// RSAAESKeyUnwrap unwraps key material wrapped with the RSA public key by RSAAESKeyWrap and verifies its integrity
+func RSAAESKeyUnwrap(privateKey *rsa.PrivateKey, wrappedKey []byte) ([]byte, error) {
+	if privateKey == nil {
+		return nil, fmt.Errorf("private key cannot be nil")
+	}
+	if len(wrappedKey) <= privateKey.Size() {
+		return nil, fmt.Errorf("wrapped key must be longer than the %d bytes of the wrapped ephemeral key, got %d", privateKey.Size(), len(wrappedKey))
+	}
+
+	kek, err := RSAOAEPUnwrapKey(privateKey, wrappedKey[:privateKey.Size()])
+	if err != nil {
+		return nil, fmt.Errorf("%w", err)
+	}
+	if len(kek) != rsaAESKeyWrapKEKSize {
+		return nil, fmt.Errorf("ephemeral key must be %d bytes, got %d", rsaAESKeyWrapKEKSize, len(kek))
+	}
+
+	plainKey, err := AESKeyUnwrapPad(kek, wrappedKey[privateKey.Size():])
+	if err != nil {
+		return nil, fmt.Errorf("%w", err)
+	}
+	return plainKey, nil
+}
+
+// ParseRSAWrappingKey parses a PEM or DER encoded PKIX or PKCS#1 RSA public key supplied to wrap key material with.
+// Keys whose modulus is smaller than MinRSAWrappingKeySize are rejected.
+func ParseRSAWrappingKey(keyMaterial []byte) (*rsa.PublicKey, error) {
+	der := keyMaterial
+	if block, _ := pem.Decode(keyMaterial); block != nil {
+		der = block.Bytes
+	}
+
+	var publicKey *rsa.PublicKey
+	if key, err := x509.ParsePKIXPublicKey(der); err == nil {
+		rsaPublicKey, ok := key.(*rsa.PublicKey)
+		if !ok {
+			return nil, fmt.Errorf("wrapping key of type %T is not an RSA public key", key)
+		}
+		publicKey = rsaPublicKey
+	} else if rsaPublicKey, err := x509.ParsePKCS1PublicKey(der); err == nil {
+		publicKey = rsaPublicKey
+	} else {
+		return nil, fmt.Errorf("failed to parse wrapping key: neither PKIX nor PKCS#1 RSA public key")
+	}
+
+	if publicKey.N.BitLen() < MinRSAWrappingKeySize {
+		return nil, fmt.Errorf("wrapping key of %d bits is smaller than %d bits", publicKey.N.BitLen(), MinRSAWrappingKeySize)
+	}
+	return publicKey, nil
+}
+
+// ReadRSAWrappingKeys reads the RSA public keys registered to wrap key material with from PEM or DER encoded files, as parsed by ParseRSAWrappingKey
+func ReadRSAWrappingKeys(paths []string) ([]*rsa.PublicKey, error) {
+	publicKeys := make([]*rsa.PublicKey, 0, len(paths))
+	for _, path := range paths {
+		keyMaterial, err := os.ReadFile(path)
+		if err != nil {
+			return nil, fmt.Errorf("failed to read wrapping key '%s': %w", path, err)
+		}
+		publicKey, err := ParseRSAWrappingKey(keyMaterial)
+		if err != nil {
+			return nil, fmt.Errorf("wrapping key '%s': %w", path, err)
+		}
+		publicKeys = append(publicKeys, publicKey)
+	}
+	return publicKeys, nil
+}
+
+// nonExportableKeyMagic prefixes the material of keys whose plaintext export is forbidden when it is exported wrapped with a vault key
+var nonExportableKeyMagic = []byte("CVNX")
+
+// MarkNonExportableKey prefixes key material with a marker before it is wrapped, so that unwrapping it within the service can be refused
+func MarkNonExportableKey(plainKey []byte) []byte {
+	return append(bytes.Clone(nonExportableKeyMagic), plainKey...)
+}
+
+// IsNonExportableKey reports whether unwrapped key material carries the marker of MarkNonExportableKey
+func IsNonExportableKey(plainKey []byte) bool {
+	return bytes.HasPrefix(plainKey, nonExportableKeyMagic)
+}
@@ -0,0 +1,252 @@
+//go:build unit
+// +build unit
+
+package cryptography
+
+import (
+	"crypto/ecdsa"
+	"crypto/elliptic"
+	"crypto/rand"
+	"crypto/rsa"
+	"crypto/x509"
+	"encoding/hex"
+	"encoding/pem"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func mustDecodeHex(t *testing.T, s string) []byte {
+	t.Helper()
+	b, err := hex.DecodeString(s)
+	require.NoError(t, err)
+	return b
+}
+
+// TestAESKeyWrap_Vectors checks the test vectors of RFC 3394, section 4
+func TestAESKeyWrap_Vectors(t *testing.T) {
+	tests := []struct {
+		name    string
+		kek     string
+		key     string
+		wrapped string
+	}{
+		{
+			name:    "128-bit key with 128-bit KEK",
+			kek:     "000102030405060708090A0B0C0D0E0F",
+			key:     "00112233445566778899AABBCCDDEEFF",
+			wrapped: "1FA68B0A8112B447AEF34BD8FB5A7B829D3E862371D2CFE5",
+		},
+		{
+			name:    "256-bit key with 256-bit KEK",
+			kek:     "000102030405060708090A0B0C0D0E0F101112131415161718191A1B1C1D1E1F",
+			key:     "00112233445566778899AABBCCDDEEFF000102030405060708090A0B0C0D0E0F",
+			wrapped: "28C9F404C4B810F4CBCCB35CFB87F8263F5786E2D80ED326CBC7F0E71A99F43BFB988B9B7A02DD21",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			kek := mustDecodeHex(t, tt.kek)
+			key := mustDecodeHex(t, tt.key)
+
+			wrapped, err := AESKeyWrap(kek, key)
+			require.NoError(t, err)
+			assert.Equal(t, mustDecodeHex(t, tt.wrapped), wrapped)
+
+			unwrapped, err := AESKeyUnwrap(kek, wrapped)
+			require.NoError(t, err)
+			assert.Equal(t, key, unwrapped)
+		})
+	}
+}
+
+// TestAESKeyWrapPad_Vectors checks the test vectors of RFC 5649, section 6
+func TestAESKeyWrapPad_Vectors(t *testing.T) {
+	kek := mustDecodeHex(t, "5840df6e29b02af1ab493b705bf16ea1ae8338f4dcc176a8")
+
+	tests := []struct {
+		name    string
+		key     string
+		wrapped string
+	}{
+		{
+			name:    "20-octet key",
+			key:     "c37b7e6492584340bed12207808941155068f738",
+			wrapped: "138bdeaa9b8fa7fc61f97742e72248ee5ae6ae5360d1ae6a5f54f373fa543b6a",
+		},
+		{
+			name:    "7-octet key",
+			key:     "466f7250617369",
+			wrapped: "afbeb0f07dfbf5419200f2ccb50bb24f",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			key := mustDecodeHex(t, tt.key)
+
+			wrapped, err := AESKeyWrapPad(kek, key)
+			require.NoError(t, err)
+			assert.Equal(t, mustDecodeHex(t, tt.wrapped), wrapped)
+
+			unwrapped, err := AESKeyUnwrapPad(kek, wrapped)
+			require.NoError(t, err)
+			assert.Equal(t, key, unwrapped)
+		})
+	}
+}
+
+func TestAESKeyWrap_Fail(t *testing.T) {
+	kek := make([]byte, 32)
+	_, err := rand.Read(kek)
+	require.NoError(t, err)
+
+	_, err = AESKeyWrap(kek, make([]byte, 12))
+	assert.Error(t, err)
+
+	_, err = AESKeyWrap(make([]byte, 7), make([]byte, 16))
+	assert.Error(t, err)
+
+	_, err = AESKeyWrapPad(kek, nil)
+	assert.Error(t, err)
+
+	wrapped, err := AESKeyWrap(kek, make([]byte, 32))
+	require.NoError(t, err)
+	wrapped[len(wrapped)-1] ^= 1
+	_, err = AESKeyUnwrap(kek, wrapped)
+	assert.Error(t, err)
+
+	wrappedPad, err := AESKeyWrapPad(kek, []byte("secret key"))
+	require.NoError(t, err)
+	otherKEK := make([]byte, 32)
+	_, err = AESKeyUnwrapPad(otherKEK, wrappedPad)
+	assert.Error(t, err)
+
+	// Keys wrapped without padding are rejected when unwrapping with padding
+	_, err = AESKeyUnwrapPad(kek, wrapped)
+	assert.Error(t, err)
+}
+
+func TestRSAOAEPWrapKey(t *testing.T) {
+	privateKey, err := rsa.GenerateKey(rand.Reader, 2048)
+	require.NoError(t, err)
+
+	key := make([]byte, 32)
+	_, err = rand.Read(key)
+	require.NoError(t, err)
+
+	wrapped, err := RSAOAEPWrapKey(&privateKey.PublicKey, key)
+	require.NoError(t, err)
+	assert.Len(t, wrapped, 256)
+
+	unwrapped, err := RSAOAEPUnwrapKey(privateKey, wrapped)
+	require.NoError(t, err)
+	assert.Equal(t, key, unwrapped)
+
+	wrapped[0] ^= 1
+	_, err = RSAOAEPUnwrapKey(privateKey, wrapped)
+	assert.Error(t, err)
+
+	_, err = RSAOAEPWrapKey(nil, key)
+	assert.Error(t, err)
+}
+
+func TestRSAAESKeyWrap(t *testing.T) {
+	privateKey, err := rsa.GenerateKey(rand.Reader, 2048)
+	require.NoError(t, err)
+
+	// Key material exceeding what RSA-OAEP wraps on its own, such as a private key
+	otherPrivateKey, err := rsa.GenerateKey(rand.Reader, 2048)
+	require.NoError(t, err)
+	key := x509.MarshalPKCS1PrivateKey(otherPrivateKey)
+
+	wrapped, err := RSAAESKeyWrap(&privateKey.PublicKey, key)
+	require.NoError(t, err)
+	assert.Greater(t, len(wrapped), privateKey.Size())
+
+	unwrapped, err := RSAAESKeyUnwrap(privateKey, wrapped)
+	require.NoError(t, err)
+	assert.Equal(t, key, unwrapped)
+
+	// Tampering with either part fails the unwrapping
+	for _, index := range []int{0, len(wrapped) - 1} {
+		tampered := append([]byte{}, wrapped...)
+		tampered[index] ^= 1
+		_, err = RSAAESKeyUnwrap(privateKey, tampered)
+		assert.Error(t, err)
+	}
+
+	_, err = RSAAESKeyUnwrap(privateKey, wrapped[:privateKey.Size()])
+	assert.Error(t, err)
+
+	_, err = RSAAESKeyWrap(nil, key)
+	assert.Error(t, err)
+}
+
+func TestParseRSAWrappingKey(t *testing.T) {
+	privateKey, err := rsa.GenerateKey(rand.Reader, 2048)
+	require.NoError(t, err)
+	pkixKey, err := x509.MarshalPKIXPublicKey(&privateKey.PublicKey)
+	require.NoError(t, err)
+
+	for _, keyMaterial := range [][]byte{
+		pem.EncodeToMemory(&pem.Block{Type: "PUBLIC KEY", Bytes: pkixKey}),
+		pkixKey,
+		x509.MarshalPKCS1PublicKey(&privateKey.PublicKey),
+	} {
+		publicKey, err := ParseRSAWrappingKey(keyMaterial)
+		require.NoError(t, err)
+		assert.True(t, privateKey.PublicKey.Equal(publicKey))
+	}
+
+	smallKey, err := rsa.GenerateKey(rand.Reader, 1024)
+	require.NoError(t, err)
+	ecKey, err := ecdsa.GenerateKey(elliptic.P256(), rand.Reader)
+	require.NoError(t, err)
+	ecPKIXKey, err := x509.MarshalPKIXPublicKey(&ecKey.PublicKey)
+	require.NoError(t, err)
+
+	for _, keyMaterial := range [][]byte{
+		x509.MarshalPKCS1PublicKey(&smallKey.PublicKey),
+		ecPKIXKey,
+		[]byte("garbage"),
+	} {
+		_, err := ParseRSAWrappingKey(keyMaterial)
+		assert.Error(t, err)
+	}
+}
+
+func TestReadRSAWrappingKeys(t *testing.T) {
+	privateKey, err := rsa.GenerateKey(rand.Reader, 2048)
+	require.NoError(t, err)
+	pkixKey, err := x509.MarshalPKIXPublicKey(&privateKey.PublicKey)
+	require.NoError(t, err)
+
+	keyPath := filepath.Join(t.TempDir(), "wrapping-key.pem")
+	require.NoError(t, os.WriteFile(keyPath, pem.EncodeToMemory(&pem.Block{Type: "PUBLIC KEY", Bytes: pkixKey}), 0600))
+	garbagePath := filepath.Join(t.TempDir(), "garbage.pem")
+	require.NoError(t, os.WriteFile(garbagePath, []byte("garbage"), 0600))
+
+	publicKeys, err := ReadRSAWrappingKeys([]string{keyPath})
+	require.NoError(t, err)
+	require.Len(t, publicKeys, 1)
+	assert.True(t, privateKey.PublicKey.Equal(publicKeys[0]))
+
+	_, err = ReadRSAWrappingKeys([]string{keyPath, garbagePath})
+	assert.Error(t, err)
+	_, err = ReadRSAWrappingKeys([]string{filepath.Join(t.TempDir(), "missing.pem")})
+	assert.Error(t, err)
+}
+
+func TestMarkNonExportableKey(t *testing.T) {
+	plainKey := []byte("0123456789abcdef0123456789abcdef")
+
+	markedKey := MarkNonExportableKey(plainKey)
+	assert.True(t, IsNonExportableKey(markedKey))
+	assert.False(t, IsNonExportableKey(plainKey))
+	assert.Equal(t, plainKey, markedKey[len(markedKey)-len(plainKey):])
+}
@@ -0,0 +1,277 @@
+package cryptography
+
+import (
+	"crypto/aes"
+	"crypto/cipher"
+	"crypto/rand"
+	"crypto_vault_service/internal/infrastructure/logger"
+	"crypto_vault_service/internal/infrastructure/settings"
+	"encoding/binary"
+	"fmt"
+	"os"
+	"path/filepath"
+	"regexp"
+	"strconv"
+	"sync"
+)
+
+// masterKeySize is the size in bytes of the AES-256 key encryption keys
+const masterKeySize = 32
+
+// MasterKeyProvider defines the operations of a master key (KEK) source which wraps and unwraps data encryption keys (DEKs)
+type MasterKeyProvider interface {
+	// CurrentVersion returns the KEK version used for wrapping new keys
+	CurrentVersion() uint32
+	// Wrap encrypts the key material of the stored key identified by its IDs and type with the current KEK
+	// and returns the wrapped key and the KEK version used. The IDs and type are bound to the wrapped key,
+	// so it cannot be swapped with the wrapped material of another key.
+	Wrap(plainKey []byte, keyID, keyPairID, keyType string) ([]byte, uint32, error)
+	// Unwrap decrypts key material of the stored key identified by its IDs and type that was wrapped with the KEK of the given version
+	Unwrap(wrappedKey []byte, version uint32, keyID, keyPairID, keyType string) ([]byte, error)
+	// Rotate introduces a new KEK version which is used for wrapping keys from then on.
+	// Previous versions remain available for unwrapping.
+	Rotate() (uint32, error)
+}
+
+// NewMasterKeyProvider creates a MasterKeyProvider for the configured master key source.
+// A first KEK version is only generated if initialize is set, which callers limit to vaults not holding any wrapped keys yet.
+func NewMasterKeyProvider(masterKeySettings *settings.MasterKeySettings, pkcs11Settings *settings.PKCS11Settings, initialize bool, logger logger.Logger) (MasterKeyProvider, error) {
+	if err := masterKeySettings.Validate(); err != nil {
+		return nil, fmt.Errorf("failed to validate settings: %w", err)
+	}
+
+	switch masterKeySettings.Source {
+	case "file":
+		return NewFileMasterKeyProvider(masterKeySettings.Dir, initialize, logger)
+	case "pkcs11":
+		pkcs11Handler, err := NewPKCS11Handler(pkcs11Settings, logger)
+		if err != nil {
+			return nil, fmt.Errorf("%w", err)
+		}
+		// KEK versions are RSA key pairs unless configured otherwise, as before AES keys were supported
+		keyType := masterKeySettings.KeyType
+		if keyType == "" {
+			keyType = "RSA"
+		}
+		return NewPKCS11MasterKeyProvider(pkcs11Handler, masterKeySettings.TokenLabel, masterKeySettings.ObjectLabel, keyType, initialize, logger)
+	default:
+		return nil, fmt.Errorf("unsupported master key source: %s", masterKeySettings.Source)
+	}
+}
+
+// sealWithKEK encrypts the key material with AES-256-GCM binding the KEK version and the IDs and type of the key as associated data
+func sealWithKEK(kek, plainKey []byte, version uint32, keyID, keyPairID, keyType string) ([]byte, error) {
+	block, err := aes.NewCipher(kek)
+	if err != nil {
+		return nil, fmt.Errorf("failed to create AES cipher: %w", err)
+	}
+
+	gcm, err := cipher.NewGCM(block)
+	if err != nil {
+		return nil, fmt.Errorf("failed to create GCM: %w", err)
+	}
+
+	nonce := make([]byte, gcm.NonceSize())
+	if _, err := rand.Read(nonce); err != nil {
+		return nil, fmt.Errorf("failed to read random bytes for nonce: %w", err)
+	}
+
+	return gcm.Seal(nonce, nonce, plainKey, wrappedKeyAAD(version, keyID, keyPairID, keyType)), nil
+}
+
+// openWithKEK decrypts key material sealed by sealWithKEK
+func openWithKEK(kek, wrappedKey []byte, version uint32, keyID, keyPairID, keyType string) ([]byte, error) {
+	block, err := aes.NewCipher(kek)
+	if err != nil {
+		return nil, fmt.Errorf("failed to create AES cipher: %w", err)
+	}
+
+	gcm, err := cipher.NewGCM(block)
+	if err != nil {
+		return nil, fmt.Errorf("failed to create GCM: %w", err)
+	}
+
+	if len(wrappedKey) < gcm.NonceSize() {
+		return nil, fmt.Errorf("wrapped key too short")
+	}
+
+	nonce, ciphertext := wrappedKey[:gcm.NonceSize()], wrappedKey[gcm.NonceSize():]
+	plainKey, err := gcm.Open(nil, nonce, ciphertext, wrappedKeyAAD(version, keyID, keyPairID, keyType))
+	if err != nil {
+		return nil, fmt.Errorf("failed to unwrap key %s with KEK version %d: %w", keyID, version, err)
+	}
+
+	return plainKey, nil
+}
+
+// wrappedKeyAAD returns the associated data binding a wrapped key to its KEK version and to the IDs and type of the key.
+// The fields are length-prefixed, so distinct IDs never encode to the same associated data.
+func wrappedKeyAAD(version uint32, keyID, keyPairID, keyType string) []byte {
+	aad := binary.BigEndian.AppendUint32(nil, version)
+	for _, field := range []string{keyID, keyPairID, keyType} {
+		aad = binary.BigEndian.AppendUint32(aad, uint32(len(field))) // #nosec G115 -- IDs and key types are far below 4 GiB
+		aad = append(aad, field...)
+	}
+	return aad
+}
+
+// kekFilePattern matches KEK files in the form kek-v{version}.bin
+var kekFilePattern = regexp.MustCompile(`^kek-v([0-9]+)\.bin$`)
+
+// fileMasterKeyProvider implements the MasterKeyProvider interface with versioned AES-256 KEKs kept in a local directory
+type fileMasterKeyProvider struct {
+	dir            string
+	keks           map[uint32][]byte
+	currentVersion uint32
+	mu             sync.RWMutex
+	logger         logger.Logger
+}
+
+// NewFileMasterKeyProvider creates a MasterKeyProvider reading KEK versions from the given directory.
+// A first KEK version is generated if the directory does not contain any and initialize is set, otherwise creation fails,
+// so a mistyped directory does not silently replace the KEK of existing keys.
+func NewFileMasterKeyProvider(dir string, initialize bool, logger logger.Logger) (MasterKeyProvider, error) {
+	provider := &fileMasterKeyProvider{
+		dir:    dir,
+		keks:   make(map[uint32][]byte),
+		logger: logger,
+	}
+
+	if err := provider.load(); err != nil {
+		return nil, fmt.Errorf("%w", err)
+	}
+
+	if provider.currentVersion == 0 {
+		if !initialize {
+			return nil, fmt.Errorf("no KEK found in master key directory '%s' although keys are wrapped with the master key", dir)
+		}
+		if err := provider.createVersion(1); err != nil {
+			return nil, fmt.Errorf("%w", err)
+		}
+	}
+
+	return provider, nil
+}
+
+// load reads all KEK versions from the directory
+func (p *fileMasterKeyProvider) load() error {
+	if err := os.MkdirAll(p.dir, 0700); err != nil {
+		return fmt.Errorf("failed to create master key directory '%s': %w", p.dir, err)
+	}
+
+	entries, err := os.ReadDir(p.dir)
+	if err != nil {
+		return fmt.Errorf("failed to read master key directory '%s': %w", p.dir, err)
+	}
+
+	for _, entry := range entries {
+		matches := kekFilePattern.FindStringSubmatch(entry.Name())
+		if matches == nil {
+			continue
+		}
+
+		version, err := strconv.ParseUint(matches[1], 10, 32)
+		if err != nil {
+			return fmt.Errorf("invalid KEK version in file name '%s': %w", entry.Name(), err)
+		}
+
+		kek, err := os.ReadFile(filepath.Join(p.dir, entry.Name()))
+		if err != nil {
+			return fmt.Errorf("failed to read KEK file '%s': %w", entry.Name(), err)
+		}
+		if len(kek) != masterKeySize {
+			return fmt.Errorf("KEK file '%s' must contain %d bytes, got %d", entry.Name(), masterKeySize, len(kek))
+		}
+
+		p.keks[uint32(version)] = kek
+		if uint32(version) > p.currentVersion {
+			p.currentVersion = uint32(version)
+		}
+	}
+
+	return nil
+}
+
+// createVersion generates a new KEK and persists it atomically with owner-only permissions
+func (p *fileMasterKeyProvider) createVersion(version uint32) error {
+	kek := make([]byte, masterKeySize)
+	if _, err := rand.Read(kek); err != nil {
+		return fmt.Errorf("failed to generate KEK: %w", err)
+	}
+
+	path := filepath.Join(p.dir, fmt.Sprintf("kek-v%d.bin", version))
+	tmpPath := path + ".tmp"
+	if err := os.WriteFile(tmpPath, kek, 0600); err != nil {
+		return fmt.Errorf("failed to write KEK file: %w", err)
+	}
+	if err := os.Rename(tmpPath, path); err != nil {
+		return fmt.Errorf("failed to persist KEK file: %w", err)
+	}
+
+	p.keks[version] = kek
+	p.currentVersion = version
+
+	p.logger.Info(fmt.Sprintf("Created master key version %d", version))
+	return nil
+}
+
+// CurrentVersion returns the KEK version used for wrapping new keys
+func (p *fileMasterKeyProvider) CurrentVersion() uint32 {
+	p.mu.RLock()
+	defer p.mu.RUnlock()
+
+	return p.currentVersion
+}
+
+// Wrap encrypts the key material of the stored key with the current KEK
+func (p *fileMasterKeyProvider) Wrap(plainKey []byte, keyID, keyPairID, keyType string) ([]byte, uint32, error) {
+	p.mu.RLock()
+	defer p.mu.RUnlock()
+
+	wrappedKey, err := sealWithKEK(p.keks[p.currentVersion], plainKey, p.currentVersion, keyID, keyPairID, keyType)
+	if err != nil {
+		return nil, 0, fmt.Errorf("%w", err)
+	}
+
+	return wrappedKey, p.currentVersion, nil
+}
+
+// Unwrap decrypts key material of the stored key that was wrapped with the KEK of the given version.
+// Unknown versions trigger a reload of the directory, since another instance may have rotated the master key.
+func (p *fileMasterKeyProvider) Unwrap(wrappedKey []byte, version uint32, keyID, keyPairID, keyType string) ([]byte, error) {
+	p.mu.RLock()
+	kek, ok := p.keks[version]
+	p.mu.RUnlock()
+
+	if !ok {
+		p.mu.Lock()
+		err := p.load()
+		kek, ok = p.keks[version]
+		p.mu.Unlock()
+
+		if err != nil {
+			return nil, fmt.Errorf("%w", err)
+		}
+		if !ok {
+			return nil, fmt.Errorf("KEK version %d not found", version)
+		}
+	}
+
+	return openWithKEK(kek, wrappedKey, version, keyID, keyPairID, keyType)
+}
+
+// Rotate generates a new KEK version in the directory
+func (p *fileMasterKeyProvider) Rotate() (uint32, error) {
+	p.mu.Lock()
+	defer p.mu.Unlock()
+
+	if err := p.load(); err != nil {
+		return 0, fmt.Errorf("%w", err)
+	}
+
+	if err := p.createVersion(p.currentVersion + 1); err != nil {
+		return 0, fmt.Errorf("%w", err)
+	}
+
+	return p.currentVersion, nil
+}
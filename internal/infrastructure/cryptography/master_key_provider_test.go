@@ -0,0 +1,200 @@
+//go:build unit
+// +build unit
+
+package cryptography
+
+import (
+	"log"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"crypto_vault_service/internal/infrastructure/logger"
+	"crypto_vault_service/internal/infrastructure/settings"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// FileMasterKeyProviderTests encapsulates file based master key provider test cases
+type FileMasterKeyProviderTests struct {
+	logger logger.Logger
+}
+
+// NewFileMasterKeyProviderTests creates a new instance of FileMasterKeyProviderTests
+func NewFileMasterKeyProviderTests(t *testing.T) *FileMasterKeyProviderTests {
+	loggerSettings := &settings.LoggerSettings{
+		LogLevel: "info",
+		LogType:  "console",
+		FilePath: "",
+	}
+
+	logInstance, err := logger.GetLogger(loggerSettings)
+	if err != nil {
+		log.Fatalf("Error creating logger: %v", err)
+	}
+
+	return &FileMasterKeyProviderTests{
+		logger: logInstance,
+	}
+}
+
+func (ft *FileMasterKeyProviderTests) TestWrapUnwrap(t *testing.T) {
+	provider, err := NewFileMasterKeyProvider(t.TempDir(), true, ft.logger)
+	require.NoError(t, err)
+	assert.Equal(t, uint32(1), provider.CurrentVersion())
+
+	plainKey := []byte("0123456789abcdef0123456789abcdef")
+
+	wrappedKey, version, err := provider.Wrap(plainKey, "key-1", "key-pair-1", "private")
+	require.NoError(t, err)
+	assert.Equal(t, uint32(1), version)
+	assert.NotEqual(t, plainKey, wrappedKey)
+
+	unwrappedKey, err := provider.Unwrap(wrappedKey, version, "key-1", "key-pair-1", "private")
+	require.NoError(t, err)
+	assert.Equal(t, plainKey, unwrappedKey)
+}
+
+func (ft *FileMasterKeyProviderTests) TestKEKPersistedWithStrictPermissions(t *testing.T) {
+	dir := t.TempDir()
+
+	provider, err := NewFileMasterKeyProvider(dir, true, ft.logger)
+	require.NoError(t, err)
+
+	info, err := os.Stat(filepath.Join(dir, "kek-v1.bin"))
+	require.NoError(t, err)
+	assert.Equal(t, os.FileMode(0600), info.Mode().Perm())
+
+	wrappedKey, version, err := provider.Wrap([]byte("secret"), "key-1", "key-pair-1", "private")
+	require.NoError(t, err)
+
+	reloadedProvider, err := NewFileMasterKeyProvider(dir, false, ft.logger)
+	require.NoError(t, err)
+
+	unwrappedKey, err := reloadedProvider.Unwrap(wrappedKey, version, "key-1", "key-pair-1", "private")
+	require.NoError(t, err)
+	assert.Equal(t, []byte("secret"), unwrappedKey)
+}
+
+func (ft *FileMasterKeyProviderTests) TestUnwrapWithUnknownVersion(t *testing.T) {
+	provider, err := NewFileMasterKeyProvider(t.TempDir(), true, ft.logger)
+	require.NoError(t, err)
+
+	wrappedKey, _, err := provider.Wrap([]byte("secret"), "key-1", "key-pair-1", "private")
+	require.NoError(t, err)
+
+	_, err = provider.Unwrap(wrappedKey, 2, "key-1", "key-pair-1", "private")
+	assert.Error(t, err)
+}
+
+func (ft *FileMasterKeyProviderTests) TestUnwrapTamperedKey(t *testing.T) {
+	provider, err := NewFileMasterKeyProvider(t.TempDir(), true, ft.logger)
+	require.NoError(t, err)
+
+	wrappedKey, version, err := provider.Wrap([]byte("secret"), "key-1", "key-pair-1", "private")
+	require.NoError(t, err)
+
+	wrappedKey[len(wrappedKey)-1] ^= 0xff
+
+	_, err = provider.Unwrap(wrappedKey, version, "key-1", "key-pair-1", "private")
+	assert.Error(t, err)
+}
+
+func (ft *FileMasterKeyProviderTests) TestUnwrapSwappedKey(t *testing.T) {
+	provider, err := NewFileMasterKeyProvider(t.TempDir(), true, ft.logger)
+	require.NoError(t, err)
+
+	wrappedKey, version, err := provider.Wrap([]byte("secret"), "key-1", "key-pair-1", "private")
+	require.NoError(t, err)
+
+	// Wrapped keys are bound to the IDs and type of their key, so they cannot be swapped between keys
+	_, err = provider.Unwrap(wrappedKey, version, "key-2", "key-pair-1", "private")
+	assert.Error(t, err)
+	_, err = provider.Unwrap(wrappedKey, version, "key-1", "key-pair-2", "private")
+	assert.Error(t, err)
+	_, err = provider.Unwrap(wrappedKey, version, "key-1", "key-pair-1", "public")
+	assert.Error(t, err)
+}
+
+func (ft *FileMasterKeyProviderTests) TestMissingKEKWithoutInitialize(t *testing.T) {
+	dir := t.TempDir()
+
+	// Without initialize, an empty directory is not silently populated with a new KEK
+	_, err := NewFileMasterKeyProvider(dir, false, ft.logger)
+	assert.Error(t, err)
+
+	_, err = os.Stat(filepath.Join(dir, "kek-v1.bin"))
+	assert.True(t, os.IsNotExist(err))
+}
+
+func (ft *FileMasterKeyProviderTests) TestInvalidKEKFile(t *testing.T) {
+	dir := t.TempDir()
+	require.NoError(t, os.WriteFile(filepath.Join(dir, "kek-v1.bin"), []byte("short"), 0600))
+
+	_, err := NewFileMasterKeyProvider(dir, false, ft.logger)
+	assert.Error(t, err)
+}
+
+func (ft *FileMasterKeyProviderTests) TestRotate(t *testing.T) {
+	dir := t.TempDir()
+
+	provider, err := NewFileMasterKeyProvider(dir, true, ft.logger)
+	require.NoError(t, err)
+
+	oldWrappedKey, oldVersion, err := provider.Wrap([]byte("secret"), "key-1", "key-pair-1", "private")
+	require.NoError(t, err)
+
+	newVersion, err := provider.Rotate()
+	require.NoError(t, err)
+	assert.Equal(t, uint32(2), newVersion)
+	assert.Equal(t, newVersion, provider.CurrentVersion())
+
+	newWrappedKey, version, err := provider.Wrap([]byte("secret"), "key-1", "key-pair-1", "private")
+	require.NoError(t, err)
+	assert.Equal(t, newVersion, version)
+
+	// Both KEK versions remain usable for unwrapping
+	unwrappedKey, err := provider.Unwrap(oldWrappedKey, oldVersion, "key-1", "key-pair-1", "private")
+	require.NoError(t, err)
+	assert.Equal(t, []byte("secret"), unwrappedKey)
+
+	unwrappedKey, err = provider.Unwrap(newWrappedKey, newVersion, "key-1", "key-pair-1", "private")
+	require.NoError(t, err)
+	assert.Equal(t, []byte("secret"), unwrappedKey)
+}
+
+func (ft *FileMasterKeyProviderTests) TestUnwrapReloadsVersionsRotatedElsewhere(t *testing.T) {
+	dir := t.TempDir()
+
+	provider, err := NewFileMasterKeyProvider(dir, true, ft.logger)
+	require.NoError(t, err)
+
+	otherProvider, err := NewFileMasterKeyProvider(dir, false, ft.logger)
+	require.NoError(t, err)
+
+	_, err = otherProvider.Rotate()
+	require.NoError(t, err)
+
+	wrappedKey, version, err := otherProvider.Wrap([]byte("secret"), "key-1", "key-pair-1", "private")
+	require.NoError(t, err)
+
+	unwrappedKey, err := provider.Unwrap(wrappedKey, version, "key-1", "key-pair-1", "private")
+	require.NoError(t, err)
+	assert.Equal(t, []byte("secret"), unwrappedKey)
+}
+
+// Entry point to run FileMasterKeyProviderTests
+func TestFileMasterKeyProvider(t *testing.T) {
+	tests := NewFileMasterKeyProviderTests(t)
+
+	t.Run("TestWrapUnwrap", tests.TestWrapUnwrap)
+	t.Run("TestKEKPersistedWithStrictPermissions", tests.TestKEKPersistedWithStrictPermissions)
+	t.Run("TestUnwrapWithUnknownVersion", tests.TestUnwrapWithUnknownVersion)
+	t.Run("TestUnwrapTamperedKey", tests.TestUnwrapTamperedKey)
+	t.Run("TestUnwrapSwappedKey", tests.TestUnwrapSwappedKey)
+	t.Run("TestMissingKEKWithoutInitialize", tests.TestMissingKEKWithoutInitialize)
+	t.Run("TestInvalidKEKFile", tests.TestInvalidKEKFile)
+	t.Run("TestRotate", tests.TestRotate)
+	t.Run("TestUnwrapReloadsVersionsRotatedElsewhere", tests.TestUnwrapReloadsVersionsRotatedElsewhere)
+}
@@ -1,13 +1,23 @@
 package cryptography
 
 import (
+	"crypto"
+	"crypto/elliptic"
+	"crypto/rand"
+	"crypto/sha512"
 	"crypto_vault_service/internal/infrastructure/logger"
 	"crypto_vault_service/internal/infrastructure/settings"
 	"crypto_vault_service/internal/infrastructure/utils"
+	"encoding/asn1"
+	"encoding/binary"
+	"errors"
 	"fmt"
-	"os/exec"
-	"regexp"
+	"math/big"
+	"strconv"
 	"strings"
+	"sync"
+
+	"github.com/miekg/pkcs11"
 )
 
 // Token represents a PKCS#11 token with its label and other metadata.
@@ -35,27 +45,92 @@ type PKCS11Handler interface {
 	ListObjects(tokenLabel string) ([]TokenObject, error)
 	// InitializeToken initializes the token with the provided label and pins
 	InitializeToken(label string) error
-	// AddKey adds the selected key (ECDSA or RSA) to the token
+	// AddKey adds the selected key (ECDSA, RSA or AES) to the token
 	AddKey(label, objectLabel, keyType string, keySize uint) error
-	// Encrypt encrypts data using the cryptographic capabilities of the PKCS#11 token
-	Encrypt(label, objectLabel, inputFilePath, outputFilePath, keyType string) error
-	// Decrypt decrypts data using the cryptographic capabilities of the PKCS#11 token
-	Decrypt(label, objectLabel, inputFilePath, outputFilePath, keyType string) error
-	// Sign signs data using the cryptographic capabilities of the PKCS#11 token
-	Sign(label, objectLabel, dataFilePath, signatureFilePath, keyType string) error
+	// Encrypt encrypts the plaintext using the cryptographic capabilities of the PKCS#11 token and returns the ciphertext
+	Encrypt(label, objectLabel string, plaintext []byte, keyType string) ([]byte, error)
+	// Decrypt decrypts the ciphertext using the cryptographic capabilities of the PKCS#11 token and returns the plaintext
+	Decrypt(label, objectLabel string, ciphertext []byte, keyType string) ([]byte, error)
+	// EncryptAES encrypts the plaintext with the AES key on the token in GCM or CBC mode and returns the IV followed by the ciphertext
+	EncryptAES(label, objectLabel string, plaintext, associatedData []byte, mode string) ([]byte, error)
+	// DecryptAES decrypts a ciphertext returned by EncryptAES with the AES key on the token
+	DecryptAES(label, objectLabel string, ciphertext, associatedData []byte, mode string) ([]byte, error)
+	// WrapKey wraps the key material with the AES key (AES Key Wrap) or the public key of the RSA key pair (RSA-OAEP) on the token
+	WrapKey(label, objectLabel string, plainKey []byte, keyType string) ([]byte, error)
+	// UnwrapKey unwraps key material returned by WrapKey with the AES key or the private key of the RSA key pair on the token
+	UnwrapKey(label, objectLabel string, wrappedKey []byte, keyType string) ([]byte, error)
+	// Sign signs data using the cryptographic capabilities of the PKCS#11 token and returns the signature
+	Sign(label, objectLabel string, data []byte, keyType string) ([]byte, error)
 	// Verify verifies the signature of data using the cryptographic capabilities of the PKCS#11 token
-	Verify(label, objectLabel, dataFilePath, signatureFilePath, keyType string) (bool, error)
+	Verify(label, objectLabel string, data, signature []byte, keyType string) (bool, error)
 	// DeleteObject deletes a key or object from the token
 	DeleteObject(label, objectType, objectLabel string) error
+	// PublicKey returns the public key of the RSA or ECDSA key pair on the token as *rsa.PublicKey or *ecdsa.PublicKey
+	PublicKey(label, objectLabel string) (crypto.PublicKey, error)
+	// Signer returns a crypto.Signer signing digests with the private key of the RSA or ECDSA key pair on the token, which never leaves it
+	Signer(label, objectLabel string) (crypto.Signer, error)
+	// Decrypter returns a crypto.Decrypter decrypting with the private key of the RSA key pair on the token, which never leaves it
+	Decrypter(label, objectLabel string) (crypto.Decrypter, error)
+	// AddData stores data in a private data object on the token, replacing the data objects with the same label
+	AddData(label, objectLabel string, data []byte) error
+	// ReadData reads the data stored in the data object with the given label on the token
+	ReadData(label, objectLabel string) ([]byte, error)
+}
+
+// ErrObjectNotFound is returned when no object of the requested class and label exists on the token
+var ErrObjectNotFound = errors.New("token object not found")
+
+// pkcs11AESKeySizes holds the supported sizes in bits of AES keys on the token
+var pkcs11AESKeySizes = []uint{128, 192, 256}
+
+// rsaPSSSaltLength is the salt length of RSA-PSS signatures, which equals the size of their SHA-384 digest
+const rsaPSSSaltLength = 48
+
+// pkcs11ObjectClasses maps the object types accepted by DeleteObject to their PKCS#11 object classes
+var pkcs11ObjectClasses = map[string]uint{
+	"privkey": pkcs11.CKO_PRIVATE_KEY,
+	"pubkey":  pkcs11.CKO_PUBLIC_KEY,
+	"secrkey": pkcs11.CKO_SECRET_KEY,
+	"cert":    pkcs11.CKO_CERTIFICATE,
+	"data":    pkcs11.CKO_DATA,
+}
+
+// pkcs11Curves holds the curves of ECDSA keys on the token by key size
+var pkcs11Curves = map[uint]elliptic.Curve{
+	256: elliptic.P256(),
+	384: elliptic.P384(),
+	521: elliptic.P521(),
+}
+
+// pkcs11CurveOIDs holds the object identifiers the CKA_EC_PARAMS of ECDSA keys name their curve with
+var pkcs11CurveOIDs = map[elliptic.Curve]asn1.ObjectIdentifier{
+	elliptic.P256(): {1, 2, 840, 10045, 3, 1, 7},
+	elliptic.P384(): {1, 3, 132, 0, 34},
+	elliptic.P521(): {1, 3, 132, 0, 35},
 }
 
-// pkcs11Handler represents the parameters and operations for interacting with a PKCS#11 token
+// pkcs11ObjectKey identifies a cached object handle
+type pkcs11ObjectKey struct {
+	slotID uint
+	class  uint
+	label  string
+}
+
+// pkcs11Handler implements PKCS11Handler against the PKCS#11 C API of the module at Settings.ModulePath.
+// Sessions are pooled per token and logged in as user when opened, object handles are cached by token, object class and label.
+// PINs are passed to the module directly and never leave the process.
 type pkcs11Handler struct {
 	Settings *settings.PKCS11Settings
 	Logger   logger.Logger
+
+	pools     map[string]*pkcs11SessionPool // by token label
+	poolsMu   sync.Mutex
+	handles   map[pkcs11ObjectKey]pkcs11.ObjectHandle
+	handlesMu sync.Mutex
 }
 
-// NewPKCS11Handler creates and returns a new instance of PKCS11Handler
+// NewPKCS11Handler creates and returns a new instance of PKCS11Handler.
+// The PKCS#11 module is loaded on first use.
 func NewPKCS11Handler(settings *settings.PKCS11Settings, logger logger.Logger) (PKCS11Handler, error) {
 	if err := settings.Validate(); err != nil {
 		return nil, fmt.Errorf("failed to validate settings: %w", err)
@@ -64,77 +139,37 @@ func NewPKCS11Handler(settings *settings.PKCS11Settings, logger logger.Logger) (
 	return &pkcs11Handler{
 		Settings: settings,
 		Logger:   logger,
+		pools:    make(map[string]*pkcs11SessionPool),
+		handles:  make(map[pkcs11ObjectKey]pkcs11.ObjectHandle),
 	}, nil
 }
 
-// Private method to execute pkcs11-tool commands and return output
-func (token *pkcs11Handler) executePKCS11ToolCommand(args []string) (string, error) {
-	cmd := exec.Command("pkcs11-tool", args...)
-	output, err := cmd.CombinedOutput()
-	if err != nil {
-		return "", fmt.Errorf("pkcs11-tool command failed: %w\nOutput: %s", err, output)
-	}
-	return string(output), nil
-}
-
 // ListTokenSlots lists all available tokens in the available slots
 func (token *pkcs11Handler) ListTokenSlots() ([]Token, error) {
-	if err := utils.CheckNonEmptyStrings(token.Settings.ModulePath); err != nil {
-		return nil, fmt.Errorf("failed to check non-empty string for ModulePath='%s': %w", token.Settings.ModulePath, err)
+	ctx, err := loadPKCS11Module(token.Settings.ModulePath)
+	if err != nil {
+		return nil, fmt.Errorf("%w", err)
 	}
 
-	// #nosec G204 -- TODO(MGTheTrain) validate all inputs used in exec.Command
-	listCmd := exec.Command(
-		"pkcs11-tool", "--module", token.Settings.ModulePath, "-L",
-	)
-
-	output, err := listCmd.CombinedOutput()
+	slotIDs, err := ctx.GetSlotList(true)
 	if err != nil {
-		return nil, fmt.Errorf("failed to list tokens with pkcs11-tool: %w\nOutput: %s", err, output)
+		return nil, fmt.Errorf("failed to list slots: %w", err)
 	}
 
 	var tokens []Token
-	lines := strings.Split(string(output), "\n")
-	var currentToken *Token
-
-	for _, line := range lines {
-
-		if strings.Contains(line, "Slot") {
-			if currentToken != nil {
-				tokens = append(tokens, *currentToken)
-			}
-
-			currentToken = &Token{
-				SlotID:       "",
-				Label:        "",
-				Manufacturer: "",
-				Model:        "",
-				SerialNumber: "",
-			}
-
-			re := regexp.MustCompile(`\((0x[0-9a-fA-F]+)\)`) // e.g. `(0x39e9d82d)` in `Slot 1 (0x39e9d82d): SoftHSM slot ID 0x39e9d82d`
-			matches := re.FindStringSubmatch(line)
-			currentToken.SlotID = matches[1]
-		}
-
-		if strings.Contains(line, "token label") {
-			currentToken.Label = strings.TrimSpace(strings.Split(line, ":")[1])
+	for _, slotID := range slotIDs {
+		tokenInfo, err := ctx.GetTokenInfo(slotID)
+		if err != nil {
+			return nil, fmt.Errorf("failed to get token info of slot 0x%x: %w", slotID, err)
 		}
-		if currentToken != nil {
-			if strings.Contains(line, "token manufacturer") {
-				currentToken.Manufacturer = strings.TrimSpace(strings.Split(line, ":")[1])
-			}
-			if strings.Contains(line, "token model") {
-				currentToken.Model = strings.TrimSpace(strings.Split(line, ":")[1])
-			}
-			if strings.Contains(line, "serial num") {
-				currentToken.SerialNumber = strings.TrimSpace(strings.Split(line, ":")[1])
-			}
-		}
-	}
 
-	if currentToken != nil {
-		tokens = append(tokens, *currentToken)
+		tokens = append(tokens, Token{
+			SlotID:       fmt.Sprintf("0x%x", slotID),
+			Label:        tokenInfo.Label,
+			Manufacturer: tokenInfo.ManufacturerID,
+			Model:        tokenInfo.Model,
+			SerialNumber: tokenInfo.SerialNumber,
+		})
 	}
 
 	return tokens, nil
@@ -142,104 +177,106 @@ func (token *pkcs11Handler) ListTokenSlots() ([]Token, error) {
 
 // ListObjects lists all objects (e.g. keys) in a specific token based on the token label.
 func (token *pkcs11Handler) ListObjects(tokenLabel string) ([]TokenObject, error) {
-	if err := utils.CheckNonEmptyStrings(tokenLabel, token.Settings.ModulePath); err != nil {
-		return nil, fmt.Errorf("failed to check non-empty strings for tokenLabel='%s' and ModulePath='%s': %w", tokenLabel, token.Settings.ModulePath, err)
-	}
-
-	// #nosec G204 -- TODO(MGTheTrain) validate all inputs used in exec.Command
-	listObjectsCmd := exec.Command(
-		"pkcs11-tool", "--module", token.Settings.ModulePath, "-O", "--token-label", tokenLabel, "--pin", token.Settings.UserPin,
-	)
-
-	output, err := listObjectsCmd.CombinedOutput()
-	if err != nil {
-		return nil, fmt.Errorf("failed to list objects with pkcs11-tool: %w\nOutput: %s", err, output)
+	if err := utils.CheckNonEmptyStrings(tokenLabel); err != nil {
+		return nil, fmt.Errorf("failed to check non-empty string for tokenLabel='%s': %w", tokenLabel, err)
 	}
 
 	var objects []TokenObject
-	lines := strings.Split(string(output), "\n")
-	var currentObject *TokenObject
-
-	for _, line := range lines {
-
-		if strings.Contains(line, "Private") || strings.Contains(line, "Public") || strings.Contains(line, "Secret") {
-			if currentObject != nil {
-				objects = append(objects, *currentObject)
-			}
+	err := token.withSession(tokenLabel, func(pool *pkcs11SessionPool, session pkcs11.SessionHandle) error {
+		handles, err := findObjects(pool.ctx, session, nil)
+		if err != nil {
+			return fmt.Errorf("%w", err)
+		}
 
-			currentObject = &TokenObject{
-				Label:  "",
-				Type:   "",
-				Usage:  "",
-				Access: "",
+		objects = make([]TokenObject, 0, len(handles))
+		for _, handle := range handles {
+			object, err := describeObject(pool.ctx, session, handle)
+			if err != nil {
+				return fmt.Errorf("%w", err)
 			}
-			currentObject.Type = line
+			objects = append(objects, object)
 		}
-		if strings.Contains(line, "label:") {
-			currentObject.Label = strings.TrimSpace(strings.Split(line, ":")[1])
-		}
-		if strings.Contains(line, "Usage:") {
-			currentObject.Usage = strings.TrimSpace(strings.Split(line, ":")[1])
-		}
-		if strings.Contains(line, "Access:") {
-			currentObject.Access = strings.TrimSpace(strings.Split(line, ":")[1])
-		}
-	}
-
-	if currentObject != nil {
-		objects = append(objects, *currentObject)
+		return nil
+	})
+	if err != nil {
+		return nil, fmt.Errorf("failed to list objects of token '%s': %w", tokenLabel, err)
 	}
 
 	return objects, nil
 }
 
-// isTokenSet checks if the token exists in the given module path
-func (token *pkcs11Handler) isTokenSet(label string) (bool, error) {
+// InitializeToken initializes the token with the provided label and pins.
+// Tokens already initialized with the label are left untouched.
+func (token *pkcs11Handler) InitializeToken(label string) error {
 	if err := utils.CheckNonEmptyStrings(label); err != nil {
-		return false, fmt.Errorf("failed to check non-empty string for label='%s': %w", label, err)
+		return fmt.Errorf("failed to check non-empty string for label='%s': %w", label, err)
 	}
 
-	args := []string{"--module", token.Settings.ModulePath, "-T"}
-	output, err := token.executePKCS11ToolCommand(args)
+	ctx, err := loadPKCS11Module(token.Settings.ModulePath)
 	if err != nil {
-		return false, fmt.Errorf("failed to execute PKCS#11 tool command with args=%v: %w", args, err)
+		return fmt.Errorf("%w", err)
 	}
 
-	if strings.Contains(output, label) && strings.Contains(output, "token initialized") {
-		token.Logger.Info(fmt.Sprintf("Token with label '%s' exists.\n", label))
-		return true, nil
+	_, tokenExists, err := findTokenSlot(ctx, label)
+	if err != nil {
+		return fmt.Errorf("failed to check if token is set for label='%s': %w", label, err)
 	}
 
-	token.Logger.Info(fmt.Sprintf("Token with label '%s' does not exist.\n", label))
-	return false, nil
-}
+	if tokenExists {
+		token.Logger.Info(fmt.Sprintf("Token with label '%s' exists.", label))
+		return nil
+	}
 
-// InitializeToken initializes the token with the provided label and pins
-func (token *pkcs11Handler) InitializeToken(label string) error {
-	if err := utils.CheckNonEmptyStrings(label); err != nil {
-		return fmt.Errorf("failed to check non-empty string for label='%s': %w", label, err)
+	slotID, err := parseSlotID(token.Settings.SlotID)
+	if err != nil {
+		return fmt.Errorf("%w", err)
+	}
+
+	if err := ctx.InitToken(slotID, token.Settings.SOPin, label); err != nil {
+		return fmt.Errorf("failed to initialize token with label '%s': %w", label, err)
 	}
 
-	tokenExists, err := token.isTokenSet(label)
+	// Tokens may move to another slot once initialized (SoftHSM2 does), so the slot is looked up again
+	slotID, tokenExists, err = findTokenSlot(ctx, label)
 	if err != nil {
-		return fmt.Errorf("failed to check if token is set for label='%s': %w", label, err)
+		return fmt.Errorf("%w", err)
+	}
+	if !tokenExists {
+		return fmt.Errorf("token with label '%s' not found after initialization", label)
 	}
 
-	if tokenExists {
-		return nil
+	if err := initUserPIN(ctx, slotID, token.Settings.SOPin, token.Settings.UserPin); err != nil {
+		return fmt.Errorf("failed to initialize user PIN of token with label '%s': %w", label, err)
 	}
 
-	args := []string{"--module", token.Settings.ModulePath, "--init-token", "--label", label, "--so-pin", token.Settings.SOPin, "--init-pin", "--pin", token.Settings.UserPin, "--slot", token.Settings.SlotID}
-	_, err = token.executePKCS11ToolCommand(args)
+	token.Logger.Info(fmt.Sprintf("Token with label '%s' initialized successfully.", label))
+	return nil
+}
+
+// initUserPIN sets the user PIN of a freshly initialized token as security officer
+func initUserPIN(ctx *pkcs11.Ctx, slotID uint, soPin, userPin string) error {
+	session, err := ctx.OpenSession(slotID, pkcs11.CKF_SERIAL_SESSION|pkcs11.CKF_RW_SESSION)
 	if err != nil {
-		return fmt.Errorf("failed to initialize token with label '%s': %w", label, err)
+		return fmt.Errorf("failed to open session on slot 0x%x: %w", slotID, err)
+	}
+	defer func() {
+		_ = ctx.CloseSession(session)
+	}()
+
+	if err := ctx.Login(session, pkcs11.CKU_SO, soPin); err != nil {
+		return fmt.Errorf("failed to log in as security officer: %w", err)
 	}
+	defer func() {
+		_ = ctx.Logout(session)
+	}()
 
-	token.Logger.Info(fmt.Sprintf("Token with label '%s' initialized successfully.\n", label))
+	if err := ctx.InitPIN(session, userPin); err != nil {
+		return fmt.Errorf("%w", err)
+	}
 	return nil
 }
 
-// AddKey adds the selected key (ECDSA or RSA) to the token
+// AddKey adds the selected key (ECDSA, RSA or AES) to the token
 func (token *pkcs11Handler) AddKey(label, objectLabel, keyType string, keySize uint) error {
 	if err := utils.CheckNonEmptyStrings(label, objectLabel, keyType); err != nil {
 		return fmt.Errorf("failed to check non-empty strings for label='%s', objectLabel='%s', keyType='%s': %w", label, objectLabel, keyType, err)
@@ -249,43 +286,37 @@ func (token *pkcs11Handler) AddKey(label, objectLabel, keyType string, keySize u
 	case "ECDSA":
 		return token.addECDSASignKey(label, objectLabel, keySize)
 	case "RSA":
-		return token.addRSASignKey(label, objectLabel, keySize)
+		return token.addRSAKey(label, objectLabel, keySize)
+	case "AES":
+		return token.addAESKey(label, objectLabel, keySize)
 	default:
 		return fmt.Errorf("unsupported key type: %s", keyType)
 	}
 }
 
-// addECDSASignKey adds an ECDSA signing key to the token
+// addECDSASignKey generates an ECDSA signing key pair on the token
 func (token *pkcs11Handler) addECDSASignKey(label, objectLabel string, keySize uint) error {
-	if err := utils.CheckNonEmptyStrings(label, objectLabel); err != nil {
-		return fmt.Errorf("failed to check non-empty strings for label='%s' and objectLabel='%s': %w", label, objectLabel, err)
+	curve, supported := pkcs11Curves[keySize]
+	if !supported {
+		return fmt.Errorf("ECDSA key size must be one of 256, 384, or 521 bits, but got %d", keySize)
 	}
 
-	// Generate the key pair (example using secp256r1)
-	// Supported ECDSA key sizes and their corresponding elliptic curves
-	ecdsaCurves := map[uint]string{
-		256: "secp256r1",
-		384: "secp384r1",
-		521: "secp521r1",
+	ecParams, err := asn1.Marshal(pkcs11CurveOIDs[curve])
+	if err != nil {
+		return fmt.Errorf("failed to encode curve parameters: %w", err)
 	}
 
-	curve, supported := ecdsaCurves[keySize]
-	if !supported {
-		return fmt.Errorf("ECDSA key size must be one of 256, 384, or 521 bits, but got %d", keySize)
+	publicKeyTemplate := []*pkcs11.Attribute{
+		pkcs11.NewAttribute(pkcs11.CKA_KEY_TYPE, pkcs11.CKK_EC),
+		pkcs11.NewAttribute(pkcs11.CKA_EC_PARAMS, ecParams),
+		pkcs11.NewAttribute(pkcs11.CKA_VERIFY, true),
 	}
-
-	args := []string{
-		"--module", token.Settings.ModulePath,
-		"--token-label", label,
-		"--keypairgen",
-		"--key-type", fmt.Sprintf("EC:%s", curve), // Use the dynamically selected curve
-		"--label", objectLabel,
-		"--pin", token.Settings.UserPin,
-		"--usage-sign",
+	privateKeyTemplate := []*pkcs11.Attribute{
+		pkcs11.NewAttribute(pkcs11.CKA_KEY_TYPE, pkcs11.CKK_EC),
+		pkcs11.NewAttribute(pkcs11.CKA_SIGN, true),
 	}
 
-	_, err := token.executePKCS11ToolCommand(args)
-	if err != nil {
+	if err := token.generateKeyPair(label, objectLabel, pkcs11.CKM_EC_KEY_PAIR_GEN, publicKeyTemplate, privateKeyTemplate); err != nil {
 		return fmt.Errorf("failed to add ECDSA key to token: %w", err)
 	}
 
@@ -293,12 +324,8 @@ func (token *pkcs11Handler) addECDSASignKey(label, objectLabel string, keySize u
 	return nil
 }
 
-// addRSASignKey adds an RSA signing key to the token
-func (token *pkcs11Handler) addRSASignKey(label, objectLabel string, keySize uint) error {
-	if err := utils.CheckNonEmptyStrings(label, objectLabel); err != nil {
-		return fmt.Errorf("failed to check non-empty strings for label='%s' and objectLabel='%s': %w", label, objectLabel, err)
-	}
-
+// addRSAKey generates an RSA key pair for signing, encryption and key wrapping on the token
+func (token *pkcs11Handler) addRSAKey(label, objectLabel string, keySize uint) error {
 	// Supported RSA key sizes (for example, 2048, 3072, and 4096)
 	supportedRSASizes := []uint{2048, 3072, 4096}
 
@@ -314,17 +341,22 @@ func (token *pkcs11Handler) addRSASignKey(label, objectLabel string, keySize uin
 		return fmt.Errorf("RSA key size must be one of %v bits, but got %d", supportedRSASizes, keySize)
 	}
 
-	args := []string{
-		"--module", token.Settings.ModulePath,
-		"--token-label", label,
-		"--keypairgen",
-		"--key-type", fmt.Sprintf("RSA:%d", keySize),
-		"--label", objectLabel,
-		"--pin", token.Settings.UserPin,
-		"--usage-sign",
+	publicKeyTemplate := []*pkcs11.Attribute{
+		pkcs11.NewAttribute(pkcs11.CKA_KEY_TYPE, pkcs11.CKK_RSA),
+		pkcs11.NewAttribute(pkcs11.CKA_MODULUS_BITS, keySize),
+		pkcs11.NewAttribute(pkcs11.CKA_PUBLIC_EXPONENT, []byte{0x01, 0x00, 0x01}),
+		pkcs11.NewAttribute(pkcs11.CKA_VERIFY, true),
+		pkcs11.NewAttribute(pkcs11.CKA_ENCRYPT, true),
+		pkcs11.NewAttribute(pkcs11.CKA_WRAP, true),
 	}
-	_, err := token.executePKCS11ToolCommand(args)
-	if err != nil {
+	privateKeyTemplate := []*pkcs11.Attribute{
+		pkcs11.NewAttribute(pkcs11.CKA_KEY_TYPE, pkcs11.CKK_RSA),
+		pkcs11.NewAttribute(pkcs11.CKA_SIGN, true),
+		pkcs11.NewAttribute(pkcs11.CKA_DECRYPT, true),
+		pkcs11.NewAttribute(pkcs11.CKA_UNWRAP, true),
+	}
+
+	if err := token.generateKeyPair(label, objectLabel, pkcs11.CKM_RSA_PKCS_KEY_PAIR_GEN, publicKeyTemplate, privateKeyTemplate); err != nil {
 		return fmt.Errorf("failed to add RSA key to token: %w", err)
 	}
 
@@ -332,222 +364,689 @@ func (token *pkcs11Handler) addRSASignKey(label, objectLabel string, keySize uin
 	return nil
 }
 
-// Encrypt encrypts data using the cryptographic capabilities of the PKCS#11 token. Refer to: https://docs.yubico.com/hardware/yubihsm-2/hsm-2-user-guide/hsm2-openssl-libp11.html#rsa-pkcs
-func (token *pkcs11Handler) Encrypt(label, objectLabel, inputFilePath, outputFilePath, keyType string) error {
-	if err := utils.CheckNonEmptyStrings(label, objectLabel, inputFilePath, outputFilePath, keyType); err != nil {
-		return fmt.Errorf("failed to check non-empty strings for label='%s', objectLabel='%s', inputFilePath='%s', outputFilePath='%s', keyType='%s': %w",
-			label, objectLabel, inputFilePath, outputFilePath, keyType, err)
+// addAESKey generates an AES secret key for encryption and key wrapping on the token.
+// The key is private, sensitive and never extractable.
+func (token *pkcs11Handler) addAESKey(label, objectLabel string, keySize uint) error {
+	validKeySize := false
+	for _, size := range pkcs11AESKeySizes {
+		if keySize == size {
+			validKeySize = true
+			break
+		}
 	}
 
-	if err := utils.CheckFilesExist(inputFilePath); err != nil {
-		return fmt.Errorf("failed to check if input file exists (inputFilePath='%s'): %w", inputFilePath, err)
+	if !validKeySize {
+		return fmt.Errorf("AES key size must be one of %v bits, but got %d", pkcs11AESKeySizes, keySize)
 	}
 
-	if keyType != "RSA" {
-		return fmt.Errorf("only RSA keys are supported for encryption")
+	id := make([]byte, 16)
+	if _, err := rand.Read(id); err != nil {
+		return fmt.Errorf("failed to generate key ID: %w", err)
 	}
 
-	// Prepare the URI to use PKCS#11 engine for accessing the public key
-	keyURI := fmt.Sprintf("pkcs11:token=%s;object=%s;type=public;pin-value=%s", label, objectLabel, token.Settings.UserPin)
-
-	// Run OpenSSL command to encrypt using the public key from the PKCS#11 token
-	// #nosec G204 -- TODO(MGTheTrain) validate all inputs used in exec.Command
-	encryptCmd := exec.Command(
-		"openssl", "pkeyutl", "-engine", "pkcs11", "-keyform", "engine", "-pubin", "-encrypt",
-		"-inkey", keyURI, "-pkeyopt", "rsa_padding_mode:pkcs1", "-in", inputFilePath, "-out", outputFilePath,
-	)
+	template := []*pkcs11.Attribute{
+		pkcs11.NewAttribute(pkcs11.CKA_CLASS, pkcs11.CKO_SECRET_KEY),
+		pkcs11.NewAttribute(pkcs11.CKA_KEY_TYPE, pkcs11.CKK_AES),
+		pkcs11.NewAttribute(pkcs11.CKA_VALUE_LEN, keySize/8),
+		pkcs11.NewAttribute(pkcs11.CKA_TOKEN, true),
+		pkcs11.NewAttribute(pkcs11.CKA_PRIVATE, true),
+		pkcs11.NewAttribute(pkcs11.CKA_SENSITIVE, true),
+		pkcs11.NewAttribute(pkcs11.CKA_EXTRACTABLE, false),
+		pkcs11.NewAttribute(pkcs11.CKA_ENCRYPT, true),
+		pkcs11.NewAttribute(pkcs11.CKA_DECRYPT, true),
+		pkcs11.NewAttribute(pkcs11.CKA_WRAP, true),
+		pkcs11.NewAttribute(pkcs11.CKA_UNWRAP, true),
+		pkcs11.NewAttribute(pkcs11.CKA_LABEL, objectLabel),
+		pkcs11.NewAttribute(pkcs11.CKA_ID, id),
+	}
 
-	// Execute the encryption command
-	encryptOutput, err := encryptCmd.CombinedOutput()
+	err := token.withSession(label, func(pool *pkcs11SessionPool, session pkcs11.SessionHandle) error {
+		_, err := pool.ctx.GenerateKey(session, []*pkcs11.Mechanism{pkcs11.NewMechanism(pkcs11.CKM_AES_KEY_GEN, nil)}, template)
+		return err
+	})
 	if err != nil {
-		return fmt.Errorf("failed to encrypt data with OpenSSL: %w\nOutput: %s", err, encryptOutput)
+		return fmt.Errorf("failed to add AES key to token: %w", err)
 	}
 
-	token.Logger.Info(fmt.Sprintf("Encryption successful. Encrypted data written to %s", outputFilePath))
+	token.Logger.Info(fmt.Sprintf("AES key with label '%s' added to token '%s'", objectLabel, label))
 	return nil
 }
 
-// Decrypt decrypts data using the cryptographic capabilities of the PKCS#11 token. Refer to: https://docs.yubico.com/hardware/yubihsm-2/hsm-2-user-guide/hsm2-openssl-libp11.html#rsa-pkcs
-func (token *pkcs11Handler) Decrypt(label, objectLabel, inputFilePath, outputFilePath, keyType string) error {
-	if err := utils.CheckNonEmptyStrings(label, objectLabel, inputFilePath, outputFilePath, keyType); err != nil {
-		return fmt.Errorf("failed to check non-empty strings for label='%s', objectLabel='%s', inputFilePath='%s', outputFilePath='%s', keyType='%s': %w",
-			label, objectLabel, inputFilePath, outputFilePath, keyType, err)
+// generateKeyPair generates a key pair on the token labeled objectLabel and sharing a random CKA_ID.
+// The templates are completed with the attributes common to all key pairs: private keys are private, sensitive and never extractable.
+func (token *pkcs11Handler) generateKeyPair(label, objectLabel string, mechanism uint, publicKeyTemplate, privateKeyTemplate []*pkcs11.Attribute) error {
+	id := make([]byte, 16)
+	if _, err := rand.Read(id); err != nil {
+		return fmt.Errorf("failed to generate key ID: %w", err)
 	}
 
-	if err := utils.CheckFilesExist(inputFilePath); err != nil {
-		return fmt.Errorf("failed to check if input file exists (inputFilePath='%s'): %w", inputFilePath, err)
+	publicKeyTemplate = append(publicKeyTemplate,
+		pkcs11.NewAttribute(pkcs11.CKA_CLASS, pkcs11.CKO_PUBLIC_KEY),
+		pkcs11.NewAttribute(pkcs11.CKA_TOKEN, true),
+		pkcs11.NewAttribute(pkcs11.CKA_PRIVATE, false),
+		pkcs11.NewAttribute(pkcs11.CKA_LABEL, objectLabel),
+		pkcs11.NewAttribute(pkcs11.CKA_ID, id),
+	)
+	privateKeyTemplate = append(privateKeyTemplate,
+		pkcs11.NewAttribute(pkcs11.CKA_CLASS, pkcs11.CKO_PRIVATE_KEY),
+		pkcs11.NewAttribute(pkcs11.CKA_TOKEN, true),
+		pkcs11.NewAttribute(pkcs11.CKA_PRIVATE, true),
+		pkcs11.NewAttribute(pkcs11.CKA_SENSITIVE, true),
+		pkcs11.NewAttribute(pkcs11.CKA_EXTRACTABLE, false),
+		pkcs11.NewAttribute(pkcs11.CKA_LABEL, objectLabel),
+		pkcs11.NewAttribute(pkcs11.CKA_ID, id),
+	)
+
+	return token.withSession(label, func(pool *pkcs11SessionPool, session pkcs11.SessionHandle) error {
+		_, _, err := pool.ctx.GenerateKeyPair(session, []*pkcs11.Mechanism{pkcs11.NewMechanism(mechanism, nil)}, publicKeyTemplate, privateKeyTemplate)
+		return err
+	})
+}
+
+// Encrypt encrypts the plaintext with RSA PKCS#1 v1.5 padding using the public key of the key pair on the PKCS#11 token,
+// or with AES-GCM using the AES key on the token, see EncryptAES
+func (token *pkcs11Handler) Encrypt(label, objectLabel string, plaintext []byte, keyType string) ([]byte, error) {
+	if err := utils.CheckNonEmptyStrings(label, objectLabel, keyType); err != nil {
+		return nil, fmt.Errorf("failed to check non-empty strings for label='%s', objectLabel='%s', keyType='%s': %w", label, objectLabel, keyType, err)
 	}
 
+	if keyType == "AES" {
+		return token.EncryptAES(label, objectLabel, plaintext, nil, AESModeGCM)
+	}
 	if keyType != "RSA" {
-		return fmt.Errorf("only RSA keys are supported for decryption")
+		return nil, fmt.Errorf("only RSA and AES keys are supported for encryption")
 	}
 
-	// Prepare the URI to use PKCS#11 engine for accessing the private key
-	keyURI := fmt.Sprintf("pkcs11:token=%s;object=%s;type=private;pin-value=%s", label, objectLabel, token.Settings.UserPin)
+	var ciphertext []byte
+	err := token.withObject(label, pkcs11.CKO_PUBLIC_KEY, objectLabel, func(pool *pkcs11SessionPool, session pkcs11.SessionHandle, key pkcs11.ObjectHandle) error {
+		if err := pool.ctx.EncryptInit(session, []*pkcs11.Mechanism{pkcs11.NewMechanism(pkcs11.CKM_RSA_PKCS, nil)}, key); err != nil {
+			return fmt.Errorf("%w", err)
+		}
+		var err error
+		ciphertext, err = pool.ctx.Encrypt(session, plaintext)
+		return err
+	})
+	if err != nil {
+		return nil, fmt.Errorf("failed to encrypt data: %w", err)
+	}
 
-	// Run OpenSSL command to decrypt the data using the private key from the PKCS#11 token
-	// #nosec G204 -- TODO(MGTheTrain) validate all inputs used in exec.Command
-	decryptCmd := exec.Command(
-		"openssl", "pkeyutl", "-engine", "pkcs11", "-keyform", "engine", "-decrypt",
-		"-inkey", keyURI, "-pkeyopt", "rsa_padding_mode:pkcs1", "-in", inputFilePath, "-out", outputFilePath,
-	)
+	token.Logger.Info(fmt.Sprintf("Encryption with key %s on token %s successful", objectLabel, label))
+	return ciphertext, nil
+}
 
-	// Execute the decryption command
-	decryptOutput, err := decryptCmd.CombinedOutput()
+// Decrypt decrypts the ciphertext with RSA PKCS#1 v1.5 padding using the private key of the key pair on the PKCS#11 token,
+// or with AES-GCM using the AES key on the token, see DecryptAES
+func (token *pkcs11Handler) Decrypt(label, objectLabel string, ciphertext []byte, keyType string) ([]byte, error) {
+	if err := utils.CheckNonEmptyStrings(label, objectLabel, keyType); err != nil {
+		return nil, fmt.Errorf("failed to check non-empty strings for label='%s', objectLabel='%s', keyType='%s': %w", label, objectLabel, keyType, err)
+	}
+
+	if keyType == "AES" {
+		return token.DecryptAES(label, objectLabel, ciphertext, nil, AESModeGCM)
+	}
+	if keyType != "RSA" {
+		return nil, fmt.Errorf("only RSA and AES keys are supported for decryption")
+	}
+
+	plaintext, err := token.decrypt(label, objectLabel, pkcs11.NewMechanism(pkcs11.CKM_RSA_PKCS, nil), ciphertext)
 	if err != nil {
-		return fmt.Errorf("failed to decrypt data with OpenSSL: %w\nOutput: %s", err, decryptOutput)
+		return nil, fmt.Errorf("%w", err)
 	}
 
-	token.Logger.Info(fmt.Sprintf("Decryption successful. Decrypted data written to %s", outputFilePath))
-	return nil
+	token.Logger.Info(fmt.Sprintf("Decryption with key %s on token %s successful", objectLabel, label))
+	return plaintext, nil
 }
 
-// Sign signs data using the cryptographic capabilities of the PKCS#11 token. Refer to: https://docs.yubico.com/hardware/yubihsm-2/hsm-2-user-guide/hsm2-openssl-libp11.html#rsa-pss
-func (token *pkcs11Handler) Sign(label, objectLabel, dataFilePath, signatureFilePath, keyType string) error {
-	if err := utils.CheckNonEmptyStrings(label, objectLabel, dataFilePath, signatureFilePath, keyType); err != nil {
-		return fmt.Errorf("failed to check non-empty strings for label='%s', objectLabel='%s', dataFilePath='%s', signatureFilePath='%s', keyType='%s': %w",
-			label, objectLabel, dataFilePath, signatureFilePath, keyType, err)
+// Sign signs data using the private key of the key pair on the PKCS#11 token and returns the signature.
+// RSA keys sign with RSA-PSS over SHA-384, ECDSA keys sign the SHA-384 digest of the data and produce ASN.1 DER encoded signatures.
+func (token *pkcs11Handler) Sign(label, objectLabel string, data []byte, keyType string) ([]byte, error) {
+	if err := utils.CheckNonEmptyStrings(label, objectLabel, keyType); err != nil {
+		return nil, fmt.Errorf("failed to check non-empty strings for label='%s', objectLabel='%s', keyType='%s': %w", label, objectLabel, keyType, err)
+	}
+
+	if keyType != "RSA" && keyType != "ECDSA" {
+		return nil, fmt.Errorf("only RSA and ECDSA keys are supported for signing")
+	}
+
+	mechanism, message := signatureMechanism(keyType, data)
+
+	signature, err := token.sign(label, objectLabel, mechanism, message)
+	if err != nil {
+		return nil, fmt.Errorf("%w", err)
+	}
+
+	if keyType == "ECDSA" {
+		signature, err = ecdsaSignatureToDER(signature)
+		if err != nil {
+			return nil, fmt.Errorf("%w", err)
+		}
 	}
 
-	if err := utils.CheckFilesExist(dataFilePath); err != nil {
-		return fmt.Errorf("failed to check if file exists (dataFilePath='%s'): %w", dataFilePath, err)
+	token.Logger.Info(fmt.Sprintf("Signing with key %s on token %s successful", objectLabel, label))
+	return signature, nil
+}
+
+// Verify verifies the signature of data using the public key of the key pair on the PKCS#11 token, see Sign for the signature schemes
+func (token *pkcs11Handler) Verify(label, objectLabel string, data, signature []byte, keyType string) (bool, error) {
+	if err := utils.CheckNonEmptyStrings(label, objectLabel, keyType); err != nil {
+		return false, fmt.Errorf("failed to check non-empty strings for label='%s', objectLabel='%s', keyType='%s': %w", label, objectLabel, keyType, err)
 	}
 
 	if keyType != "RSA" && keyType != "ECDSA" {
-		return fmt.Errorf("only RSA and ECDSA keys are supported for signing")
+		return false, fmt.Errorf("only RSA and ECDSA keys are supported for verification")
 	}
 
-	// Prepare the OpenSSL command based on key type
-	var signCmd *exec.Cmd
-	var signatureFormat string
+	mechanism, message := signatureMechanism(keyType, data)
 
-	switch keyType {
-	case "RSA":
-		signatureFormat = "rsa_padding_mode:pss"
-		// Command for signing with RSA-PSS
-		// #nosec G204 -- TODO(MGTheTrain) validate all inputs used in exec.Command
-		signCmd = exec.Command(
-			"openssl", "dgst", "-engine", "pkcs11", "-keyform", "engine", "-sign",
-			"pkcs11:token="+label+";object="+objectLabel+";type=private;pin-value="+token.Settings.UserPin,
-			"-sigopt", signatureFormat,
-			"-sha384", // Use SHA-384
-			"-out", signatureFilePath, dataFilePath,
-		)
-	case "ECDSA":
-		// Command for signing with ECDSA
-		// #nosec G204 -- TODO(MGTheTrain) validate all inputs used in exec.Command
-		signCmd = exec.Command(
-			"openssl", "dgst", "-engine", "pkcs11", "-keyform", "engine", "-sign",
-			"pkcs11:token="+label+";object="+objectLabel+";type=private;pin-value="+token.Settings.UserPin,
-			"-sha384", // ECDSA typically uses SHA-384
-			"-out", signatureFilePath, dataFilePath,
-		)
-	default:
-		return fmt.Errorf("unsupported key type: %s", keyType)
+	err := token.withObject(label, pkcs11.CKO_PUBLIC_KEY, objectLabel, func(pool *pkcs11SessionPool, session pkcs11.SessionHandle, key pkcs11.ObjectHandle) error {
+		tokenSignature := signature
+		if keyType == "ECDSA" {
+			size, err := ecdsaKeyCoordinateSize(pool.ctx, session, key)
+			if err != nil {
+				return fmt.Errorf("%w", err)
+			}
+			tokenSignature, err = ecdsaSignatureToRaw(signature, size)
+			if err != nil {
+				return pkcs11.Error(pkcs11.CKR_SIGNATURE_INVALID)
+			}
+		}
+
+		if err := pool.ctx.VerifyInit(session, []*pkcs11.Mechanism{mechanism}, key); err != nil {
+			return fmt.Errorf("%w", err)
+		}
+		return pool.ctx.Verify(session, message, tokenSignature)
+	})
+	if isPKCS11Error(err, pkcs11.CKR_SIGNATURE_INVALID, pkcs11.CKR_SIGNATURE_LEN_RANGE) {
+		token.Logger.Info("The signature is invalid")
+		return false, nil
+	}
+	if err != nil {
+		return false, fmt.Errorf("failed to verify signature: %w", err)
+	}
+
+	token.Logger.Info("The signature is valid")
+	return true, nil
+}
+
+// DeleteObject deletes all objects of the given type (privkey, pubkey, secrkey, cert or data) and label from the token
+func (token *pkcs11Handler) DeleteObject(label, objectType, objectLabel string) error {
+	if err := utils.CheckNonEmptyStrings(label, objectType, objectLabel); err != nil {
+		return fmt.Errorf("failed to check non-empty strings for label='%s', objectType='%s', objectLabel='%s': %w", label, objectType, objectLabel, err)
 	}
 
-	// Execute the sign command
-	signOutput, err := signCmd.CombinedOutput()
+	class, validObjectType := pkcs11ObjectClasses[objectType]
+	if !validObjectType {
+		return fmt.Errorf("invalid object type '%s'. Valid types are privkey, pubkey, secrkey, cert, data", objectType)
+	}
+
+	err := token.withSession(label, func(pool *pkcs11SessionPool, session pkcs11.SessionHandle) error {
+		defer token.evictObject(pkcs11ObjectKey{slotID: pool.slotID, class: class, label: objectLabel})
+
+		handles, err := findObjects(pool.ctx, session, objectTemplate(class, objectLabel))
+		if err != nil {
+			return fmt.Errorf("%w", err)
+		}
+		if len(handles) == 0 {
+			return fmt.Errorf("%w", ErrObjectNotFound)
+		}
+
+		for _, handle := range handles {
+			if err := pool.ctx.DestroyObject(session, handle); err != nil {
+				return fmt.Errorf("%w", err)
+			}
+		}
+		return nil
+	})
 	if err != nil {
-		return fmt.Errorf("failed to sign data: %w\nOutput: %s", err, signOutput)
+		return fmt.Errorf("failed to delete object of type '%s' with label '%s': %w", objectType, objectLabel, err)
 	}
 
-	token.Logger.Info(fmt.Sprintf("Signing successful. Signature written to %s", signatureFilePath))
+	token.Logger.Info(fmt.Sprintf("Object of type '%s' with label '%s' deleted successfully.", objectType, objectLabel))
 	return nil
 }
 
-// Verify verifies the signature of data using the cryptographic capabilities of the PKCS#11 token. Refer to: https://docs.yubico.com/hardware/yubihsm-2/hsm-2-user-guide/hsm2-openssl-libp11.html#rsa-pss
-func (token *pkcs11Handler) Verify(label, objectLabel, dataFilePath, signatureFilePath, keyType string) (bool, error) {
+// sign signs the message with the mechanism using the private key with the given label on the token
+func (token *pkcs11Handler) sign(label, objectLabel string, mechanism *pkcs11.Mechanism, message []byte) ([]byte, error) {
+	var signature []byte
+	err := token.withObject(label, pkcs11.CKO_PRIVATE_KEY, objectLabel, func(pool *pkcs11SessionPool, session pkcs11.SessionHandle, key pkcs11.ObjectHandle) error {
+		if err := pool.ctx.SignInit(session, []*pkcs11.Mechanism{mechanism}, key); err != nil {
+			return fmt.Errorf("%w", err)
+		}
+		var err error
+		signature, err = pool.ctx.Sign(session, message)
+		return err
+	})
+	if err != nil {
+		return nil, fmt.Errorf("failed to sign data: %w", err)
+	}
+	return signature, nil
+}
 
-	if err := utils.CheckNonEmptyStrings(label, objectLabel, keyType, dataFilePath, signatureFilePath); err != nil {
-		return false, fmt.Errorf("failed to check non-empty strings for label='%s', objectLabel='%s', keyType='%s', dataFilePath='%s', signatureFilePath='%s': %w",
-			label, objectLabel, keyType, dataFilePath, signatureFilePath, err)
+// decrypt decrypts the ciphertext with the mechanism using the private key with the given label on the token
+func (token *pkcs11Handler) decrypt(label, objectLabel string, mechanism *pkcs11.Mechanism, ciphertext []byte) ([]byte, error) {
+	var plaintext []byte
+	err := token.withObject(label, pkcs11.CKO_PRIVATE_KEY, objectLabel, func(pool *pkcs11SessionPool, session pkcs11.SessionHandle, key pkcs11.ObjectHandle) error {
+		if err := pool.ctx.DecryptInit(session, []*pkcs11.Mechanism{mechanism}, key); err != nil {
+			return fmt.Errorf("%w", err)
+		}
+		var err error
+		plaintext, err = pool.ctx.Decrypt(session, ciphertext)
+		return err
+	})
+	if err != nil {
+		return nil, fmt.Errorf("failed to decrypt data: %w", err)
 	}
+	return plaintext, nil
+}
+
+// withSession runs operation in a pooled session on the token with the given label.
+// Operations failing because the session or token went away, the token logged out or a cached object handle went stale are retried once
+// after opening a new session, logging in again or evicting the cached handles of the token respectively.
+func (token *pkcs11Handler) withSession(tokenLabel string, operation func(pool *pkcs11SessionPool, session pkcs11.SessionHandle) error) error {
+	var err error
+	for attempt := 0; attempt < 2; attempt++ {
+		var pool *pkcs11SessionPool
+		pool, err = token.sessionPool(tokenLabel)
+		if err != nil {
+			return fmt.Errorf("%w", err)
+		}
+
+		var session pkcs11.SessionHandle
+		session, err = pool.acquire()
+		if err != nil {
+			if isPKCS11SessionError(err) {
+				token.closeSessionPool(tokenLabel, pool)
+				continue
+			}
+			return fmt.Errorf("%w", err)
+		}
 
-	if err := utils.CheckFilesExist(dataFilePath, signatureFilePath); err != nil {
-		return false, fmt.Errorf("failed to check if files exist (dataFilePath='%s', signatureFilePath='%s'): %w",
-			dataFilePath, signatureFilePath, err)
+		err = operation(pool, session)
+		switch {
+		case err == nil:
+			pool.release(session)
+			return nil
+		case isPKCS11SessionError(err):
+			pool.discard(session)
+			token.closeSessionPool(tokenLabel, pool)
+		case isPKCS11Error(err, pkcs11.CKR_USER_NOT_LOGGED_IN):
+			if loginErr := pool.login(session); loginErr != nil {
+				pool.discard(session)
+				return fmt.Errorf("%w", loginErr)
+			}
+			pool.release(session)
+		case isPKCS11HandleError(err):
+			token.evictObjects(pool.slotID)
+			pool.release(session)
+		default:
+			pool.release(session)
+			return err
+		}
 	}
+	return err
+}
 
-	if keyType != "RSA" && keyType != "ECDSA" {
-		return false, fmt.Errorf("only RSA and ECDSA keys are supported for verification")
+// withObject runs operation in a pooled session on the object of the given class and label on the token, see withSession
+func (token *pkcs11Handler) withObject(tokenLabel string, class uint, objectLabel string, operation func(pool *pkcs11SessionPool, session pkcs11.SessionHandle, object pkcs11.ObjectHandle) error) error {
+	return token.withSession(tokenLabel, func(pool *pkcs11SessionPool, session pkcs11.SessionHandle) error {
+		object, err := token.findObject(pool, session, class, objectLabel)
+		if err != nil {
+			return fmt.Errorf("%w", err)
+		}
+		return operation(pool, session, object)
+	})
+}
+
+// sessionPool returns the session pool of the token with the given label, looking up the slot of the token on first use
+func (token *pkcs11Handler) sessionPool(tokenLabel string) (*pkcs11SessionPool, error) {
+	ctx, err := loadPKCS11Module(token.Settings.ModulePath)
+	if err != nil {
+		return nil, fmt.Errorf("%w", err)
+	}
+
+	token.poolsMu.Lock()
+	defer token.poolsMu.Unlock()
+
+	if pool, ok := token.pools[tokenLabel]; ok {
+		return pool, nil
+	}
+
+	slotID, tokenExists, err := findTokenSlot(ctx, tokenLabel)
+	if err != nil {
+		return nil, fmt.Errorf("%w", err)
+	}
+	if !tokenExists {
+		return nil, fmt.Errorf("token with label '%s' not found", tokenLabel)
+	}
+
+	pool := newPKCS11SessionPool(ctx, slotID, token.Settings.UserPin)
+	token.pools[tokenLabel] = pool
+	return pool, nil
+}
+
+// closeSessionPool closes the session pool of the token and evicts its cached object handles, so that the token is looked up again
+func (token *pkcs11Handler) closeSessionPool(tokenLabel string, pool *pkcs11SessionPool) {
+	token.poolsMu.Lock()
+	if token.pools[tokenLabel] == pool {
+		delete(token.pools, tokenLabel)
+	}
+	token.poolsMu.Unlock()
+
+	pool.close()
+	token.evictObjects(pool.slotID)
+}
+
+// findObject returns the handle of the first object of the given class and label on the token, caching it
+func (token *pkcs11Handler) findObject(pool *pkcs11SessionPool, session pkcs11.SessionHandle, class uint, objectLabel string) (pkcs11.ObjectHandle, error) {
+	key := pkcs11ObjectKey{slotID: pool.slotID, class: class, label: objectLabel}
+
+	token.handlesMu.Lock()
+	handle, ok := token.handles[key]
+	token.handlesMu.Unlock()
+	if ok {
+		return handle, nil
+	}
+
+	handles, err := findObjects(pool.ctx, session, objectTemplate(class, objectLabel))
+	if err != nil {
+		return 0, fmt.Errorf("%w", err)
+	}
+	if len(handles) == 0 {
+		return 0, fmt.Errorf("%w: no object labeled '%s'", ErrObjectNotFound, objectLabel)
+	}
+
+	token.handlesMu.Lock()
+	token.handles[key] = handles[0]
+	token.handlesMu.Unlock()
+
+	return handles[0], nil
+}
+
+// evictObject removes a cached object handle
+func (token *pkcs11Handler) evictObject(key pkcs11ObjectKey) {
+	token.handlesMu.Lock()
+	defer token.handlesMu.Unlock()
+
+	delete(token.handles, key)
+}
+
+// evictObjects removes the cached object handles of the token in the given slot
+func (token *pkcs11Handler) evictObjects(slotID uint) {
+	token.handlesMu.Lock()
+	defer token.handlesMu.Unlock()
+
+	for key := range token.handles {
+		if key.slotID == slotID {
+			delete(token.handles, key)
+		}
+	}
+}
+
+// findTokenSlot returns the slot of the initialized token with the given label
+func findTokenSlot(ctx *pkcs11.Ctx, tokenLabel string) (uint, bool, error) {
+	slotIDs, err := ctx.GetSlotList(true)
+	if err != nil {
+		return 0, false, fmt.Errorf("failed to list slots: %w", err)
+	}
+
+	for _, slotID := range slotIDs {
+		tokenInfo, err := ctx.GetTokenInfo(slotID)
+		if err != nil {
+			return 0, false, fmt.Errorf("failed to get token info of slot 0x%x: %w", slotID, err)
+		}
+		if tokenInfo.Flags&pkcs11.CKF_TOKEN_INITIALIZED != 0 && tokenInfo.Label == tokenLabel {
+			return slotID, true, nil
+		}
+	}
+
+	return 0, false, nil
+}
+
+// findObjects returns the handles of all objects matching the template, all objects visible to the session if it is empty
+func findObjects(ctx *pkcs11.Ctx, session pkcs11.SessionHandle, template []*pkcs11.Attribute) ([]pkcs11.ObjectHandle, error) {
+	if err := ctx.FindObjectsInit(session, template); err != nil {
+		return nil, fmt.Errorf("failed to search objects: %w", err)
 	}
 
-	// Prepare the OpenSSL command based on key type
-	var verifyCmd *exec.Cmd
+	var handles []pkcs11.ObjectHandle
+	for {
+		found, _, err := ctx.FindObjects(session, 64)
+		if err != nil {
+			_ = ctx.FindObjectsFinal(session)
+			return nil, fmt.Errorf("failed to search objects: %w", err)
+		}
+		if len(found) == 0 {
+			break
+		}
+		handles = append(handles, found...)
+	}
+
+	if err := ctx.FindObjectsFinal(session); err != nil {
+		return nil, fmt.Errorf("failed to finish object search: %w", err)
+	}
+	return handles, nil
+}
+
+// objectTemplate returns the search template for objects of the given class and label
+func objectTemplate(class uint, objectLabel string) []*pkcs11.Attribute {
+	return []*pkcs11.Attribute{
+		pkcs11.NewAttribute(pkcs11.CKA_CLASS, class),
+		pkcs11.NewAttribute(pkcs11.CKA_LABEL, objectLabel),
+	}
+}
+
+// describeObject reads the label, type, usage and access attributes of an object the way pkcs11-tool lists them.
+// Attributes are read per class, as tokens fail reading attributes an object class does not define.
+func describeObject(ctx *pkcs11.Ctx, session pkcs11.SessionHandle, handle pkcs11.ObjectHandle) (TokenObject, error) {
+	attributes, err := ctx.GetAttributeValue(session, handle, []*pkcs11.Attribute{
+		pkcs11.NewAttribute(pkcs11.CKA_CLASS, nil),
+		pkcs11.NewAttribute(pkcs11.CKA_LABEL, nil),
+	})
+	if err != nil {
+		return TokenObject{}, fmt.Errorf("failed to read object attributes: %w", err)
+	}
 
+	class := attributeUint(attributes[0])
+	object := TokenObject{Label: string(attributes[1].Value)}
+
+	var usageAttributes, accessAttributes []uint
+	switch class {
+	case pkcs11.CKO_PRIVATE_KEY:
+		object.Type = "Private Key Object"
+		usageAttributes = []uint{pkcs11.CKA_DECRYPT, pkcs11.CKA_SIGN, pkcs11.CKA_UNWRAP, pkcs11.CKA_DERIVE}
+		accessAttributes = []uint{pkcs11.CKA_SENSITIVE, pkcs11.CKA_ALWAYS_SENSITIVE, pkcs11.CKA_EXTRACTABLE, pkcs11.CKA_NEVER_EXTRACTABLE, pkcs11.CKA_LOCAL}
+	case pkcs11.CKO_PUBLIC_KEY:
+		object.Type = "Public Key Object"
+		usageAttributes = []uint{pkcs11.CKA_ENCRYPT, pkcs11.CKA_VERIFY, pkcs11.CKA_WRAP, pkcs11.CKA_DERIVE}
+		accessAttributes = []uint{pkcs11.CKA_LOCAL}
+	case pkcs11.CKO_SECRET_KEY:
+		object.Type = "Secret Key Object"
+		usageAttributes = []uint{pkcs11.CKA_ENCRYPT, pkcs11.CKA_DECRYPT, pkcs11.CKA_SIGN, pkcs11.CKA_VERIFY, pkcs11.CKA_WRAP, pkcs11.CKA_UNWRAP, pkcs11.CKA_DERIVE}
+		accessAttributes = []uint{pkcs11.CKA_SENSITIVE, pkcs11.CKA_ALWAYS_SENSITIVE, pkcs11.CKA_EXTRACTABLE, pkcs11.CKA_NEVER_EXTRACTABLE, pkcs11.CKA_LOCAL}
+	case pkcs11.CKO_CERTIFICATE:
+		object.Type = "Certificate Object"
+		return object, nil
+	case pkcs11.CKO_DATA:
+		object.Type = "Data Object"
+		return object, nil
+	default:
+		object.Type = fmt.Sprintf("Object of class 0x%x", class)
+		return object, nil
+	}
+
+	keyType, err := ctx.GetAttributeValue(session, handle, []*pkcs11.Attribute{pkcs11.NewAttribute(pkcs11.CKA_KEY_TYPE, nil)})
+	if err != nil {
+		return TokenObject{}, fmt.Errorf("failed to read key type of object '%s': %w", object.Label, err)
+	}
+	object.Type = fmt.Sprintf("%s; %s", object.Type, keyTypeName(attributeUint(keyType[0])))
+
+	object.Usage, err = readFlags(ctx, session, handle, usageAttributes)
+	if err != nil {
+		return TokenObject{}, fmt.Errorf("failed to read usage of object '%s': %w", object.Label, err)
+	}
+	object.Access, err = readFlags(ctx, session, handle, accessAttributes)
+	if err != nil {
+		return TokenObject{}, fmt.Errorf("failed to read access of object '%s': %w", object.Label, err)
+	}
+
+	return object, nil
+}
+
+// pkcs11FlagNames holds the names pkcs11-tool lists boolean usage and access attributes with
+var pkcs11FlagNames = map[uint]string{
+	pkcs11.CKA_ENCRYPT:           "encrypt",
+	pkcs11.CKA_DECRYPT:           "decrypt",
+	pkcs11.CKA_SIGN:              "sign",
+	pkcs11.CKA_VERIFY:            "verify",
+	pkcs11.CKA_WRAP:              "wrap",
+	pkcs11.CKA_UNWRAP:            "unwrap",
+	pkcs11.CKA_DERIVE:            "derive",
+	pkcs11.CKA_SENSITIVE:         "sensitive",
+	pkcs11.CKA_ALWAYS_SENSITIVE:  "always sensitive",
+	pkcs11.CKA_EXTRACTABLE:       "extractable",
+	pkcs11.CKA_NEVER_EXTRACTABLE: "never extractable",
+	pkcs11.CKA_LOCAL:             "local",
+}
+
+// readFlags returns the comma separated names of the boolean attributes set on the object, or none
+func readFlags(ctx *pkcs11.Ctx, session pkcs11.SessionHandle, handle pkcs11.ObjectHandle, attributeTypes []uint) (string, error) {
+	template := make([]*pkcs11.Attribute, 0, len(attributeTypes))
+	for _, attributeType := range attributeTypes {
+		template = append(template, pkcs11.NewAttribute(attributeType, nil))
+	}
+
+	attributes, err := ctx.GetAttributeValue(session, handle, template)
+	if err != nil {
+		return "", fmt.Errorf("%w", err)
+	}
+
+	var flags []string
+	for _, attribute := range attributes {
+		if len(attribute.Value) == 1 && attribute.Value[0] != 0 {
+			flags = append(flags, pkcs11FlagNames[attribute.Type])
+		}
+	}
+	if len(flags) == 0 {
+		return "none", nil
+	}
+	return strings.Join(flags, ", "), nil
+}
+
+// keyTypeName returns the name of a PKCS#11 key type
+func keyTypeName(keyType uint) string {
 	switch keyType {
-	case "RSA":
-		// Command for verifying with RSA-PSS
-		// #nosec G204 -- TODO(MGTheTrain) validate all inputs used in exec.Command
-		verifyCmd = exec.Command(
-			"openssl", "dgst", "-engine", "pkcs11", "-keyform", "engine", "-verify",
-			"pkcs11:token="+label+";object="+objectLabel+";type=public;pin-value="+token.Settings.UserPin,
-			"-sigopt", "rsa_padding_mode:pss",
-			"-sha384", // Use SHA-384 for verification
-			"-signature", signatureFilePath, "-binary", dataFilePath,
-		)
-	case "ECDSA":
-		// Command for verifying with ECDSA
-		// #nosec G204 -- TODO(MGTheTrain) validate all inputs used in exec.Command
-		verifyCmd = exec.Command(
-			"openssl", "dgst", "-engine", "pkcs11", "-keyform", "engine", "-verify",
-			"pkcs11:token="+label+";object="+objectLabel+";type=public;pin-value="+token.Settings.UserPin,
-			"-sha384", // ECDSA typically uses SHA-384
-			"-signature", signatureFilePath, "-binary", dataFilePath,
-		)
+	case pkcs11.CKK_RSA:
+		return "RSA"
+	case pkcs11.CKK_EC:
+		return "EC"
+	case pkcs11.CKK_AES:
+		return "AES"
+	case pkcs11.CKK_GENERIC_SECRET:
+		return "GENERIC"
 	default:
-		return false, fmt.Errorf("unsupported key type: %s", keyType)
+		return fmt.Sprintf("0x%x", keyType)
 	}
+}
+
+// attributeUint decodes a CK_ULONG attribute value, which the module returns in native byte order
+func attributeUint(attribute *pkcs11.Attribute) uint {
+	switch len(attribute.Value) {
+	case 8:
+		return uint(binary.NativeEndian.Uint64(attribute.Value))
+	case 4:
+		return uint(binary.NativeEndian.Uint32(attribute.Value))
+	default:
+		return 0
+	}
+}
 
-	// Execute the verify command
-	verifyOutput, err := verifyCmd.CombinedOutput()
+// parseSlotID parses a slot ID given in hexadecimal with 0x prefix (as listed by ListTokenSlots) or in decimal
+func parseSlotID(slotID string) (uint, error) {
+	parsedSlotID, err := strconv.ParseUint(slotID, 0, strconv.IntSize)
 	if err != nil {
-		return false, fmt.Errorf("failed to verify signature: %w\nOutput: %s", err, verifyOutput)
+		return 0, fmt.Errorf("invalid slot ID '%s': %w", slotID, err)
 	}
+	return uint(parsedSlotID), nil
+}
 
-	// Check the output from OpenSSL to determine if the verification was successful
-	if strings.Contains(string(verifyOutput), "Verified OK") {
-		token.Logger.Info("The signature is valid")
-		return true, nil
+// signatureMechanism returns the signing mechanism of the key type and the message the token signs for the data:
+// the data itself for RSA-PSS with SHA-384, which hashes on the token, and its SHA-384 digest for ECDSA
+func signatureMechanism(keyType string, data []byte) (*pkcs11.Mechanism, []byte) {
+	if keyType == "RSA" {
+		return pkcs11.NewMechanism(pkcs11.CKM_SHA384_RSA_PKCS_PSS, pkcs11.NewPSSParams(pkcs11.CKM_SHA384, pkcs11.CKG_MGF1_SHA384, rsaPSSSaltLength)), data
 	}
-	token.Logger.Info("The signature is invalid")
-	return false, nil
+
+	digest := sha512.Sum384(data)
+	return pkcs11.NewMechanism(pkcs11.CKM_ECDSA, nil), digest[:]
 }
 
-// DeleteObject deletes a key or object from the token
-func (token *pkcs11Handler) DeleteObject(label, objectType, objectLabel string) error {
-	if err := utils.CheckNonEmptyStrings(label, objectType, objectLabel); err != nil {
-		return fmt.Errorf("failed to check non-empty strings for label='%s', objectType='%s', objectLabel='%s': %w", label, objectType, objectLabel, err)
+// ecdsaSignature is the ASN.1 structure of ECDSA signatures
+type ecdsaSignature struct {
+	R, S *big.Int
+}
+
+// ecdsaSignatureToDER converts a raw r || s ECDSA signature returned by the token into its ASN.1 DER encoding
+func ecdsaSignatureToDER(signature []byte) ([]byte, error) {
+	if len(signature) == 0 || len(signature)%2 != 0 {
+		return nil, fmt.Errorf("invalid ECDSA signature length %d", len(signature))
 	}
 
-	// Ensure the object type is valid (privkey, pubkey, secrkey, cert, data)
-	validObjectTypes := map[string]bool{
-		"privkey": true,
-		"pubkey":  true,
-		"secrkey": true,
-		"cert":    true,
-		"data":    true,
+	size := len(signature) / 2
+	der, err := asn1.Marshal(ecdsaSignature{
+		R: new(big.Int).SetBytes(signature[:size]),
+		S: new(big.Int).SetBytes(signature[size:]),
+	})
+	if err != nil {
+		return nil, fmt.Errorf("failed to encode ECDSA signature: %w", err)
 	}
+	return der, nil
+}
 
-	if !validObjectTypes[objectType] {
-		return fmt.Errorf("invalid object type '%s'. Valid types are privkey, pubkey, secrkey, cert, data", objectType)
+// ecdsaSignatureToRaw converts an ASN.1 DER encoded ECDSA signature into the raw r || s form the token verifies,
+// padding both integers to the coordinate size of the curve
+func ecdsaSignatureToRaw(der []byte, size int) ([]byte, error) {
+	var signature ecdsaSignature
+	rest, err := asn1.Unmarshal(der, &signature)
+	if err != nil {
+		return nil, fmt.Errorf("failed to decode ECDSA signature: %w", err)
+	}
+	if len(rest) != 0 {
+		return nil, fmt.Errorf("trailing data after ECDSA signature")
+	}
+	if signature.R.Sign() <= 0 || signature.S.Sign() <= 0 || signature.R.BitLen() > size*8 || signature.S.BitLen() > size*8 {
+		return nil, fmt.Errorf("ECDSA signature out of range")
 	}
 
-	args := []string{
-		"--module", token.Settings.ModulePath,
-		"--token-label", label,
-		"--pin", token.Settings.UserPin,
-		"--delete-object",
-		"--type", objectType,
-		"--label", objectLabel,
+	raw := make([]byte, 2*size)
+	signature.R.FillBytes(raw[:size])
+	signature.S.FillBytes(raw[size:])
+	return raw, nil
+}
+
+// ecdsaKeyCoordinateSize returns the coordinate size of the curve named by the CKA_EC_PARAMS of an ECDSA key on the token
+func ecdsaKeyCoordinateSize(ctx *pkcs11.Ctx, session pkcs11.SessionHandle, key pkcs11.ObjectHandle) (int, error) {
+	attributes, err := ctx.GetAttributeValue(session, key, []*pkcs11.Attribute{pkcs11.NewAttribute(pkcs11.CKA_EC_PARAMS, nil)})
+	if err != nil {
+		return 0, fmt.Errorf("failed to read curve of ECDSA key: %w", err)
 	}
 
-	_, err := token.executePKCS11ToolCommand(args)
+	curve, err := parseECParams(attributes[0].Value)
 	if err != nil {
-		return fmt.Errorf("failed to delete object of type '%s' with label '%s': %w", objectType, objectLabel, err)
+		return 0, fmt.Errorf("%w", err)
 	}
+	return ecCoordinateSize(curve), nil
+}
 
-	token.Logger.Info(fmt.Sprintf("Object of type '%s' with label '%s' deleted successfully.\n", objectType, objectLabel))
-	return nil
+// parseECParams returns the curve named by the CKA_EC_PARAMS of an ECDSA key
+func parseECParams(ecParams []byte) (elliptic.Curve, error) {
+	var oid asn1.ObjectIdentifier
+	if _, err := asn1.Unmarshal(ecParams, &oid); err != nil {
+		return nil, fmt.Errorf("failed to decode curve of ECDSA key: %w", err)
+	}
+	for curve, curveOID := range pkcs11CurveOIDs {
+		if curveOID.Equal(oid) {
+			return curve, nil
+		}
+	}
+	return nil, fmt.Errorf("curve %s of ECDSA key not supported", oid)
 }
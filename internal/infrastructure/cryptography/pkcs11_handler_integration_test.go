@@ -4,8 +4,13 @@
 package cryptography
 
 import (
-	"os"
-	"path/filepath"
+	"crypto"
+	"crypto/ecdsa"
+	"crypto/rand"
+	"crypto/rsa"
+	"crypto/sha256"
+	"fmt"
+	"sync"
 	"testing"
 
 	"crypto_vault_service/internal/infrastructure/logger"
@@ -126,58 +131,264 @@ func TestEncryptDecrypt(t *testing.T) {
 	test.InitializeToken(t)
 	test.AddKeyToToken(t, "RSA", 2048)
 
-	inputFile := "plain-text.txt"
-	err := os.WriteFile(inputFile, []byte("This is some data to encrypt."), 0600)
+	plaintext := []byte("This is some data to encrypt.")
+
+	ciphertext, err := test.pkcs11Handler.Encrypt(Label, test.objectLabel, plaintext, "RSA")
 	require.NoError(t, err)
+	assert.NotEmpty(t, ciphertext)
 
-	encryptedFile := "encrypted.bin"
-	decryptedFile := "decrypted.txt"
+	decryptedData, err := test.pkcs11Handler.Decrypt(Label, test.objectLabel, ciphertext, "RSA")
+	require.NoError(t, err)
+	assert.Equal(t, plaintext, decryptedData)
 
-	err = test.pkcs11Handler.Encrypt(Label, test.objectLabel, inputFile, encryptedFile, "RSA")
-	assert.NoError(t, err)
+	test.DeleteKeyFromToken(t)
+}
+
+func TestSignAndVerify(t *testing.T) {
+	test := NewPKCS11HandlerTests(t, "TestRSAKey")
+	test.InitializeToken(t)
+	test.AddKeyToToken(t, "RSA", 2048)
+
+	data := []byte("This is some data to sign.")
 
-	encryptedData, err := os.ReadFile(filepath.Clean(encryptedFile))
+	signature, err := test.pkcs11Handler.Sign(Label, test.objectLabel, data, "RSA")
 	require.NoError(t, err)
-	assert.NotEmpty(t, encryptedData)
+	assert.NotEmpty(t, signature)
 
-	err = test.pkcs11Handler.Decrypt(Label, test.objectLabel, encryptedFile, decryptedFile, "RSA")
+	valid, err := test.pkcs11Handler.Verify(Label, test.objectLabel, data, signature, "RSA")
 	assert.NoError(t, err)
+	assert.True(t, valid)
 
-	decryptedData, err := os.ReadFile(filepath.Clean(decryptedFile))
+	test.DeleteKeyFromToken(t)
+}
+
+func TestSignAndVerifyECDSA(t *testing.T) {
+	test := NewPKCS11HandlerTests(t, "TestECDSAKey")
+	test.InitializeToken(t)
+	test.AddKeyToToken(t, "ECDSA", 384)
+
+	signature, err := test.pkcs11Handler.Sign(Label, test.objectLabel, []byte("This is some data to sign."), "ECDSA")
 	require.NoError(t, err)
 
-	originalData, err := os.ReadFile(filepath.Clean(inputFile))
+	valid, err := test.pkcs11Handler.Verify(Label, test.objectLabel, []byte("This is some data to sign."), signature, "ECDSA")
+	assert.NoError(t, err)
+	assert.True(t, valid)
+
+	valid, err = test.pkcs11Handler.Verify(Label, test.objectLabel, []byte("This is some tampered data."), signature, "ECDSA")
+	assert.NoError(t, err)
+	assert.False(t, valid)
+
+	test.DeleteKeyFromToken(t)
+}
+
+func TestConcurrentEncryptDecrypt(t *testing.T) {
+	test := NewPKCS11HandlerTests(t, "TestRSAKey3")
+	test.InitializeToken(t)
+	test.AddKeyToToken(t, "RSA", 2048)
+
+	var wg sync.WaitGroup
+	for i := 0; i < 2*maxIdlePKCS11Sessions; i++ {
+		wg.Add(1)
+		go func(i int) {
+			defer wg.Done()
+
+			data := []byte(fmt.Sprintf("This is some data to encrypt %d.", i))
+
+			ciphertext, err := test.pkcs11Handler.Encrypt(Label, test.objectLabel, data, "RSA")
+			assert.NoError(t, err)
+			decryptedData, err := test.pkcs11Handler.Decrypt(Label, test.objectLabel, ciphertext, "RSA")
+			assert.NoError(t, err)
+			assert.Equal(t, data, decryptedData)
+		}(i)
+	}
+	wg.Wait()
+
+	test.DeleteKeyFromToken(t)
+}
+
+func TestDeletedKeyNotFound(t *testing.T) {
+	test := NewPKCS11HandlerTests(t, "TestRSAKey4")
+	test.InitializeToken(t)
+	test.AddKeyToToken(t, "RSA", 2048)
+
+	plaintext := []byte("This is some data to encrypt.")
+
+	// Caches the handle of the public key
+	_, err := test.pkcs11Handler.Encrypt(Label, test.objectLabel, plaintext, "RSA")
 	require.NoError(t, err)
-	assert.Equal(t, originalData, decryptedData)
 
 	test.DeleteKeyFromToken(t)
-	os.Remove(inputFile)
-	os.Remove(encryptedFile)
-	os.Remove(decryptedFile)
+
+	_, err = test.pkcs11Handler.Encrypt(Label, test.objectLabel, plaintext, "RSA")
+	assert.Error(t, err)
+
+	err = test.pkcs11Handler.DeleteObject(Label, "pubkey", test.objectLabel)
+	assert.Error(t, err)
 }
 
-func TestSignAndVerify(t *testing.T) {
-	test := NewPKCS11HandlerTests(t, "TestRSAKey")
+func TestSignerAndDecrypter(t *testing.T) {
+	test := NewPKCS11HandlerTests(t, "TestRSAKey5")
 	test.InitializeToken(t)
 	test.AddKeyToToken(t, "RSA", 2048)
+	defer test.DeleteKeyFromToken(t)
 
-	dataFile := "data-to-sign.txt"
-	sigFile := "data.sig"
-	err := os.WriteFile(dataFile, []byte("This is some data to sign."), 0600)
+	publicKey, err := test.pkcs11Handler.PublicKey(Label, test.objectLabel)
 	require.NoError(t, err)
+	rsaPublicKey, ok := publicKey.(*rsa.PublicKey)
+	require.True(t, ok)
 
-	err = test.pkcs11Handler.Sign(Label, test.objectLabel, dataFile, sigFile, "RSA")
-	assert.NoError(t, err)
+	signer, err := test.pkcs11Handler.Signer(Label, test.objectLabel)
+	require.NoError(t, err)
+	digest := sha256.Sum256([]byte("This is some data to sign."))
 
-	sigData, err := os.ReadFile(filepath.Clean(sigFile))
+	signature, err := signer.Sign(rand.Reader, digest[:], crypto.SHA256)
 	require.NoError(t, err)
-	assert.NotEmpty(t, sigData)
+	assert.NoError(t, rsa.VerifyPKCS1v15(rsaPublicKey, crypto.SHA256, digest[:], signature))
 
-	valid, err := test.pkcs11Handler.Verify(Label, test.objectLabel, dataFile, sigFile, "RSA")
-	assert.NoError(t, err)
-	assert.True(t, valid)
+	pssOpts := &rsa.PSSOptions{SaltLength: rsa.PSSSaltLengthEqualsHash, Hash: crypto.SHA256}
+	signature, err = signer.Sign(rand.Reader, digest[:], pssOpts)
+	require.NoError(t, err)
+	assert.NoError(t, rsa.VerifyPSS(rsaPublicKey, crypto.SHA256, digest[:], signature, pssOpts))
+
+	decrypter, err := test.pkcs11Handler.Decrypter(Label, test.objectLabel)
+	require.NoError(t, err)
+	data := []byte("This is some data to encrypt.")
+
+	ciphertext, err := rsa.EncryptPKCS1v15(rand.Reader, rsaPublicKey, data)
+	require.NoError(t, err)
+	plaintext, err := decrypter.Decrypt(rand.Reader, ciphertext, nil)
+	require.NoError(t, err)
+	assert.Equal(t, data, plaintext)
+
+	ciphertext, err = rsa.EncryptOAEP(sha256.New(), rand.Reader, rsaPublicKey, data, nil)
+	require.NoError(t, err)
+	plaintext, err = decrypter.Decrypt(rand.Reader, ciphertext, &rsa.OAEPOptions{Hash: crypto.SHA256})
+	require.NoError(t, err)
+	assert.Equal(t, data, plaintext)
+}
+
+func TestECDSASigner(t *testing.T) {
+	test := NewPKCS11HandlerTests(t, "TestECDSAKey3")
+	test.InitializeToken(t)
+	test.AddKeyToToken(t, "ECDSA", 384)
+	defer test.DeleteKeyFromToken(t)
+
+	signer, err := test.pkcs11Handler.Signer(Label, test.objectLabel)
+	require.NoError(t, err)
+	ecdsaPublicKey, ok := signer.Public().(*ecdsa.PublicKey)
+	require.True(t, ok)
+
+	digest := sha256.Sum256([]byte("This is some data to sign."))
+	signature, err := signer.Sign(rand.Reader, digest[:], crypto.SHA256)
+	require.NoError(t, err)
+	assert.True(t, ecdsa.VerifyASN1(ecdsaPublicKey, digest[:], signature))
+
+	_, err = test.pkcs11Handler.Decrypter(Label, test.objectLabel)
+	assert.Error(t, err)
+}
+
+func TestDataObjects(t *testing.T) {
+	test := NewPKCS11HandlerTests(t, "TestData")
+	test.InitializeToken(t)
+
+	_, err := test.pkcs11Handler.ReadData(Label, test.objectLabel)
+	assert.ErrorIs(t, err, ErrObjectNotFound)
+
+	require.NoError(t, test.pkcs11Handler.AddData(Label, test.objectLabel, []byte("first")))
+	require.NoError(t, test.pkcs11Handler.AddData(Label, test.objectLabel, []byte("second")))
+
+	data, err := test.pkcs11Handler.ReadData(Label, test.objectLabel)
+	require.NoError(t, err)
+	assert.Equal(t, []byte("second"), data)
+
+	require.NoError(t, test.pkcs11Handler.DeleteObject(Label, "data", test.objectLabel))
+	_, err = test.pkcs11Handler.ReadData(Label, test.objectLabel)
+	assert.ErrorIs(t, err, ErrObjectNotFound)
+}
+
+func TestAddAESKey(t *testing.T) {
+	test := NewPKCS11HandlerTests(t, "TestAESKey")
+	test.InitializeToken(t)
+	test.AddKeyToToken(t, "AES", 256)
+
+	err := test.pkcs11Handler.AddKey(Label, "TestInvalidAESKey", "AES", 100)
+	assert.Error(t, err)
+
+	test.DeleteKeyFromToken(t)
+}
+
+func TestEncryptDecryptAES(t *testing.T) {
+	test := NewPKCS11HandlerTests(t, "TestAESKey")
+	test.InitializeToken(t)
+	test.AddKeyToToken(t, "AES", 256)
+
+	plaintext := []byte("This is some data to encrypt.")
+
+	t.Run("GCM", func(t *testing.T) {
+		associatedData := []byte("header")
+		ciphertext, err := test.pkcs11Handler.EncryptAES(Label, test.objectLabel, plaintext, associatedData, AESModeGCM)
+		require.NoError(t, err)
+		assert.Len(t, ciphertext, 12+len(plaintext)+16)
+
+		decryptedData, err := test.pkcs11Handler.DecryptAES(Label, test.objectLabel, ciphertext, associatedData, AESModeGCM)
+		require.NoError(t, err)
+		assert.Equal(t, plaintext, decryptedData)
+
+		_, err = test.pkcs11Handler.DecryptAES(Label, test.objectLabel, ciphertext, []byte("other header"), AESModeGCM)
+		assert.Error(t, err)
+	})
+
+	t.Run("CBC", func(t *testing.T) {
+		ciphertext, err := test.pkcs11Handler.EncryptAES(Label, test.objectLabel, plaintext, nil, AESModeCBC)
+		require.NoError(t, err)
+
+		decryptedData, err := test.pkcs11Handler.DecryptAES(Label, test.objectLabel, ciphertext, nil, AESModeCBC)
+		require.NoError(t, err)
+		assert.Equal(t, plaintext, decryptedData)
+	})
+
+	t.Run("Key type", func(t *testing.T) {
+		ciphertext, err := test.pkcs11Handler.Encrypt(Label, test.objectLabel, plaintext, "AES")
+		require.NoError(t, err)
+
+		decryptedData, err := test.pkcs11Handler.Decrypt(Label, test.objectLabel, ciphertext, "AES")
+		require.NoError(t, err)
+		assert.Equal(t, plaintext, decryptedData)
+	})
 
 	test.DeleteKeyFromToken(t)
-	os.Remove(dataFile)
-	os.Remove(sigFile)
+}
+
+func TestWrapUnwrapKey(t *testing.T) {
+	dataEncryptionKey := make([]byte, 32)
+	_, err := rand.Read(dataEncryptionKey)
+	require.NoError(t, err)
+
+	for _, tt := range []struct {
+		keyType string
+		keySize uint
+	}{
+		{"AES", 256},
+		{"RSA", 2048},
+	} {
+		t.Run(tt.keyType, func(t *testing.T) {
+			test := NewPKCS11HandlerTests(t, fmt.Sprintf("Test%sWrappingKey", tt.keyType))
+			test.InitializeToken(t)
+			test.AddKeyToToken(t, tt.keyType, tt.keySize)
+
+			wrappedKey, err := test.pkcs11Handler.WrapKey(Label, test.objectLabel, dataEncryptionKey, tt.keyType)
+			require.NoError(t, err)
+			assert.NotEqual(t, dataEncryptionKey, wrappedKey)
+
+			unwrappedKey, err := test.pkcs11Handler.UnwrapKey(Label, test.objectLabel, wrappedKey, tt.keyType)
+			require.NoError(t, err)
+			assert.Equal(t, dataEncryptionKey, unwrappedKey)
+
+			test.DeleteKeyFromToken(t)
+		})
+	}
+
+	test := NewPKCS11HandlerTests(t, "TestAESWrappingKey")
+	_, err = test.pkcs11Handler.WrapKey(Label, test.objectLabel, []byte("short"), "AES")
+	assert.Error(t, err)
 }
@@ -0,0 +1,142 @@
+//go:build unit
+// +build unit
+
+package cryptography
+
+import (
+	"crypto/ecdsa"
+	"crypto/elliptic"
+	"crypto/rand"
+	"crypto/sha512"
+	"encoding/asn1"
+	"math/big"
+	"testing"
+
+	"github.com/miekg/pkcs11"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestParseSlotID(t *testing.T) {
+	tests := []struct {
+		name    string
+		slotID  string
+		want    uint
+		wantErr bool
+	}{
+		{"Hexadecimal", "0x0", 0, false},
+		{"Hexadecimal as listed", "0x39e9d82d", 0x39e9d82d, false},
+		{"Decimal", "3", 3, false},
+		{"Empty", "", 0, true},
+		{"Invalid", "slot", 0, true},
+		{"Negative", "-1", 0, true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			slotID, err := parseSlotID(tt.slotID)
+			if tt.wantErr {
+				assert.Error(t, err)
+				return
+			}
+			require.NoError(t, err)
+			assert.Equal(t, tt.want, slotID)
+		})
+	}
+}
+
+func TestECDSASignatureConversion(t *testing.T) {
+	privateKey, err := ecdsa.GenerateKey(elliptic.P384(), rand.Reader)
+	require.NoError(t, err)
+
+	digest := sha512.Sum384([]byte("This is some data to sign."))
+	der, err := ecdsa.SignASN1(rand.Reader, privateKey, digest[:])
+	require.NoError(t, err)
+
+	raw, err := ecdsaSignatureToRaw(der, ecCoordinateSize(elliptic.P384()))
+	require.NoError(t, err)
+	assert.Len(t, raw, 96)
+
+	converted, err := ecdsaSignatureToDER(raw)
+	require.NoError(t, err)
+	assert.Equal(t, der, converted)
+	assert.True(t, ecdsa.VerifyASN1(&privateKey.PublicKey, digest[:], converted))
+}
+
+func TestECDSASignatureConversion_Fail(t *testing.T) {
+	oversized, err := asn1.Marshal(ecdsaSignature{R: big.NewInt(1), S: new(big.Int).Lsh(big.NewInt(1), 300)})
+	require.NoError(t, err)
+
+	_, err = ecdsaSignatureToRaw([]byte("garbage"), 32)
+	assert.Error(t, err)
+	_, err = ecdsaSignatureToRaw(oversized, 32)
+	assert.Error(t, err)
+	_, err = ecdsaSignatureToDER([]byte{1, 2, 3})
+	assert.Error(t, err)
+	_, err = ecdsaSignatureToDER(nil)
+	assert.Error(t, err)
+}
+
+func TestAttributeUint(t *testing.T) {
+	attribute := pkcs11.NewAttribute(pkcs11.CKA_CLASS, pkcs11.CKO_PRIVATE_KEY)
+	assert.Equal(t, uint(pkcs11.CKO_PRIVATE_KEY), attributeUint(attribute))
+	assert.Equal(t, uint(0), attributeUint(pkcs11.NewAttribute(pkcs11.CKA_CLASS, []byte{1})))
+}
+
+func TestAESIVSize(t *testing.T) {
+	tests := []struct {
+		name           string
+		mode           string
+		associatedData []byte
+		want           int
+		wantErr        bool
+	}{
+		{"GCM", AESModeGCM, nil, 12, false},
+		{"GCM with associated data", AESModeGCM, []byte("header"), 12, false},
+		{"CBC", AESModeCBC, nil, 16, false},
+		{"CBC with associated data", AESModeCBC, []byte("header"), 0, true},
+		{"Unsupported mode", "CTR", nil, 0, true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			ivSize, err := aesIVSize(tt.mode, tt.associatedData)
+			if tt.wantErr {
+				assert.Error(t, err)
+				return
+			}
+			require.NoError(t, err)
+			assert.Equal(t, tt.want, ivSize)
+		})
+	}
+}
+
+func TestKeyWrapMechanism(t *testing.T) {
+	tests := []struct {
+		name      string
+		keyType   string
+		wrap      bool
+		mechanism uint
+		class     uint
+		wantErr   bool
+	}{
+		{"AES wrap", "AES", true, pkcs11.CKM_AES_KEY_WRAP, pkcs11.CKO_SECRET_KEY, false},
+		{"AES unwrap", "AES", false, pkcs11.CKM_AES_KEY_WRAP, pkcs11.CKO_SECRET_KEY, false},
+		{"RSA wrap", "RSA", true, pkcs11.CKM_RSA_PKCS_OAEP, pkcs11.CKO_PUBLIC_KEY, false},
+		{"RSA unwrap", "RSA", false, pkcs11.CKM_RSA_PKCS_OAEP, pkcs11.CKO_PRIVATE_KEY, false},
+		{"Unsupported key type", "ECDSA", true, 0, 0, true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			mechanism, class, err := keyWrapMechanism(tt.keyType, tt.wrap)
+			if tt.wantErr {
+				assert.Error(t, err)
+				return
+			}
+			require.NoError(t, err)
+			assert.Equal(t, tt.mechanism, mechanism.Mechanism)
+			assert.Equal(t, tt.class, class)
+		})
+	}
+}
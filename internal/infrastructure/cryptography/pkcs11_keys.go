@@ -0,0 +1,282 @@
+package cryptography
+
+import (
+	"crypto"
+	"crypto/ecdsa"
+	"crypto/rsa"
+	"crypto_vault_service/internal/infrastructure/utils"
+	"encoding/asn1"
+	"fmt"
+	"io"
+	"math/big"
+
+	"github.com/miekg/pkcs11"
+)
+
+// pkcs11Hashes holds the PKCS#11 digest and MGF1 mechanisms of the hash functions token keys sign and decrypt with
+var pkcs11Hashes = map[crypto.Hash]struct{ mechanism, mgf uint }{
+	crypto.SHA256: {pkcs11.CKM_SHA256, pkcs11.CKG_MGF1_SHA256},
+	crypto.SHA384: {pkcs11.CKM_SHA384, pkcs11.CKG_MGF1_SHA384},
+	crypto.SHA512: {pkcs11.CKM_SHA512, pkcs11.CKG_MGF1_SHA512},
+}
+
+// pkcs1DigestInfoPrefixes holds the DER encoded DigestInfo prefixes CKM_RSA_PKCS expects in front of a digest to produce PKCS#1 v1.5 signatures
+var pkcs1DigestInfoPrefixes = map[crypto.Hash][]byte{
+	crypto.SHA256: {0x30, 0x31, 0x30, 0x0d, 0x06, 0x09, 0x60, 0x86, 0x48, 0x01, 0x65, 0x03, 0x04, 0x02, 0x01, 0x05, 0x00, 0x04, 0x20},
+	crypto.SHA384: {0x30, 0x41, 0x30, 0x0d, 0x06, 0x09, 0x60, 0x86, 0x48, 0x01, 0x65, 0x03, 0x04, 0x02, 0x02, 0x05, 0x00, 0x04, 0x30},
+	crypto.SHA512: {0x30, 0x51, 0x30, 0x0d, 0x06, 0x09, 0x60, 0x86, 0x48, 0x01, 0x65, 0x03, 0x04, 0x02, 0x03, 0x05, 0x00, 0x04, 0x40},
+}
+
+// PublicKey reads the public key of the key pair with the given label from the token
+func (token *pkcs11Handler) PublicKey(label, objectLabel string) (crypto.PublicKey, error) {
+	if err := utils.CheckNonEmptyStrings(label, objectLabel); err != nil {
+		return nil, fmt.Errorf("failed to check non-empty strings for label='%s', objectLabel='%s': %w", label, objectLabel, err)
+	}
+
+	var publicKey crypto.PublicKey
+	err := token.withObject(label, pkcs11.CKO_PUBLIC_KEY, objectLabel, func(pool *pkcs11SessionPool, session pkcs11.SessionHandle, key pkcs11.ObjectHandle) error {
+		keyType, err := pool.ctx.GetAttributeValue(session, key, []*pkcs11.Attribute{pkcs11.NewAttribute(pkcs11.CKA_KEY_TYPE, nil)})
+		if err != nil {
+			return fmt.Errorf("%w", err)
+		}
+
+		switch attributeUint(keyType[0]) {
+		case pkcs11.CKK_RSA:
+			publicKey, err = readRSAPublicKey(pool.ctx, session, key)
+		case pkcs11.CKK_EC:
+			publicKey, err = readECDSAPublicKey(pool.ctx, session, key)
+		default:
+			err = fmt.Errorf("key type %s not supported", keyTypeName(attributeUint(keyType[0])))
+		}
+		return err
+	})
+	if err != nil {
+		return nil, fmt.Errorf("failed to read public key '%s' of token '%s': %w", objectLabel, label, err)
+	}
+
+	return publicKey, nil
+}
+
+// readRSAPublicKey reads the modulus and public exponent of an RSA public key on the token
+func readRSAPublicKey(ctx *pkcs11.Ctx, session pkcs11.SessionHandle, key pkcs11.ObjectHandle) (*rsa.PublicKey, error) {
+	attributes, err := ctx.GetAttributeValue(session, key, []*pkcs11.Attribute{
+		pkcs11.NewAttribute(pkcs11.CKA_MODULUS, nil),
+		pkcs11.NewAttribute(pkcs11.CKA_PUBLIC_EXPONENT, nil),
+	})
+	if err != nil {
+		return nil, fmt.Errorf("%w", err)
+	}
+
+	exponent := new(big.Int).SetBytes(attributes[1].Value)
+	if !exponent.IsInt64() || exponent.Int64() < 3 || exponent.Int64() > 1<<31-1 {
+		return nil, fmt.Errorf("invalid RSA public exponent")
+	}
+
+	return &rsa.PublicKey{
+		N: new(big.Int).SetBytes(attributes[0].Value),
+		E: int(exponent.Int64()),
+	}, nil
+}
+
+// readECDSAPublicKey reads the curve and point of an ECDSA public key on the token.
+// PKCS#11 specifies CKA_EC_POINT as DER encoded octet string, some modules return the bare uncompressed point though.
+func readECDSAPublicKey(ctx *pkcs11.Ctx, session pkcs11.SessionHandle, key pkcs11.ObjectHandle) (*ecdsa.PublicKey, error) {
+	attributes, err := ctx.GetAttributeValue(session, key, []*pkcs11.Attribute{
+		pkcs11.NewAttribute(pkcs11.CKA_EC_PARAMS, nil),
+		pkcs11.NewAttribute(pkcs11.CKA_EC_POINT, nil),
+	})
+	if err != nil {
+		return nil, fmt.Errorf("%w", err)
+	}
+
+	curve, err := parseECParams(attributes[0].Value)
+	if err != nil {
+		return nil, fmt.Errorf("%w", err)
+	}
+
+	point := attributes[1].Value
+	var encodedPoint []byte
+	if rest, err := asn1.Unmarshal(point, &encodedPoint); err == nil && len(rest) == 0 {
+		point = encodedPoint
+	}
+
+	for _, legacyCurve := range legacyECCurves {
+		if legacyCurve.curve != curve {
+			continue
+		}
+		if _, err := legacyCurve.ecdhCurve.NewPublicKey(point); err != nil {
+			return nil, fmt.Errorf("invalid ECDSA public key: %w", err)
+		}
+		x, y := ecPoint(point, ecCoordinateSize(curve))
+		return &ecdsa.PublicKey{Curve: curve, X: x, Y: y}, nil
+	}
+	return nil, fmt.Errorf("curve %s of ECDSA key not supported", curve.Params().Name)
+}
+
+// Signer returns a crypto.Signer for the private key of the key pair with the given label on the token
+func (token *pkcs11Handler) Signer(label, objectLabel string) (crypto.Signer, error) {
+	publicKey, err := token.PublicKey(label, objectLabel)
+	if err != nil {
+		return nil, fmt.Errorf("%w", err)
+	}
+
+	return &pkcs11PrivateKey{token: token, label: label, objectLabel: objectLabel, publicKey: publicKey}, nil
+}
+
+// Decrypter returns a crypto.Decrypter for the private key of the RSA key pair with the given label on the token
+func (token *pkcs11Handler) Decrypter(label, objectLabel string) (crypto.Decrypter, error) {
+	publicKey, err := token.PublicKey(label, objectLabel)
+	if err != nil {
+		return nil, fmt.Errorf("%w", err)
+	}
+	if _, ok := publicKey.(*rsa.PublicKey); !ok {
+		return nil, fmt.Errorf("only RSA keys are supported for decryption")
+	}
+
+	return &pkcs11PrivateKey{token: token, label: label, objectLabel: objectLabel, publicKey: publicKey}, nil
+}
+
+// pkcs11PrivateKey implements crypto.Signer and, for RSA keys, crypto.Decrypter with a private key on a PKCS#11 token.
+// Signatures and plaintexts match the ones of *rsa.PrivateKey and *ecdsa.PrivateKey, so token keys can stand in for them.
+type pkcs11PrivateKey struct {
+	token       *pkcs11Handler
+	label       string
+	objectLabel string
+	publicKey   crypto.PublicKey
+}
+
+// Public returns the public key of the key pair
+func (key *pkcs11PrivateKey) Public() crypto.PublicKey {
+	return key.publicKey
+}
+
+// Sign signs the digest on the token. RSA keys produce RSA-PSS signatures if opts are *rsa.PSSOptions and PKCS#1 v1.5 signatures otherwise,
+// ECDSA keys produce ASN.1 DER encoded signatures. The token generates the randomness, rand is ignored.
+func (key *pkcs11PrivateKey) Sign(_ io.Reader, digest []byte, opts crypto.SignerOpts) ([]byte, error) {
+	hash := opts.HashFunc()
+	if len(digest) != hash.Size() {
+		return nil, fmt.Errorf("invalid digest size %d, expected %d", len(digest), hash.Size())
+	}
+
+	switch key.publicKey.(type) {
+	case *rsa.PublicKey:
+		hashMechanisms, supported := pkcs11Hashes[hash]
+		if !supported {
+			return nil, fmt.Errorf("hash function %s not supported for RSA signatures", hash)
+		}
+
+		if pssOpts, ok := opts.(*rsa.PSSOptions); ok {
+			saltLength := pssOpts.SaltLength
+			if saltLength == rsa.PSSSaltLengthAuto || saltLength == rsa.PSSSaltLengthEqualsHash {
+				saltLength = hash.Size()
+			}
+			if saltLength < 0 {
+				return nil, fmt.Errorf("invalid RSA-PSS salt length %d", saltLength)
+			}
+			mechanism := pkcs11.NewMechanism(pkcs11.CKM_RSA_PKCS_PSS, pkcs11.NewPSSParams(hashMechanisms.mechanism, hashMechanisms.mgf, uint(saltLength)))
+			return key.token.sign(key.label, key.objectLabel, mechanism, digest)
+		}
+
+		message := append(append([]byte{}, pkcs1DigestInfoPrefixes[hash]...), digest...)
+		return key.token.sign(key.label, key.objectLabel, pkcs11.NewMechanism(pkcs11.CKM_RSA_PKCS, nil), message)
+	case *ecdsa.PublicKey:
+		signature, err := key.token.sign(key.label, key.objectLabel, pkcs11.NewMechanism(pkcs11.CKM_ECDSA, nil), digest)
+		if err != nil {
+			return nil, fmt.Errorf("%w", err)
+		}
+		return ecdsaSignatureToDER(signature)
+	default:
+		return nil, fmt.Errorf("public key of type %T not supported", key.publicKey)
+	}
+}
+
+// Decrypt decrypts the ciphertext on the token with RSA-OAEP if opts are *rsa.OAEPOptions and with PKCS#1 v1.5 padding otherwise.
+// The token generates the randomness, rand is ignored.
+func (key *pkcs11PrivateKey) Decrypt(_ io.Reader, ciphertext []byte, opts crypto.DecrypterOpts) ([]byte, error) {
+	if _, ok := key.publicKey.(*rsa.PublicKey); !ok {
+		return nil, fmt.Errorf("only RSA keys are supported for decryption")
+	}
+
+	mechanism := pkcs11.NewMechanism(pkcs11.CKM_RSA_PKCS, nil)
+	if oaepOpts, ok := opts.(*rsa.OAEPOptions); ok {
+		hashMechanisms, supported := pkcs11Hashes[oaepOpts.Hash]
+		if !supported {
+			return nil, fmt.Errorf("hash function %s not supported for RSA-OAEP", oaepOpts.Hash)
+		}
+		mgf := hashMechanisms.mgf
+		if oaepOpts.MGFHash != 0 {
+			mgfHashMechanisms, supported := pkcs11Hashes[oaepOpts.MGFHash]
+			if !supported {
+				return nil, fmt.Errorf("hash function %s not supported for MGF1", oaepOpts.MGFHash)
+			}
+			mgf = mgfHashMechanisms.mgf
+		}
+		mechanism = pkcs11.NewMechanism(pkcs11.CKM_RSA_PKCS_OAEP, pkcs11.NewOAEPParams(hashMechanisms.mechanism, mgf, pkcs11.CKZ_DATA_SPECIFIED, oaepOpts.Label))
+	}
+
+	return key.token.decrypt(key.label, key.objectLabel, mechanism, ciphertext)
+}
+
+// AddData stores data in a private data object with the given label on the token.
+// Data objects with the same label are destroyed once the new one is created, so the data is never lost in between.
+func (token *pkcs11Handler) AddData(label, objectLabel string, data []byte) error {
+	if err := utils.CheckNonEmptyStrings(label, objectLabel); err != nil {
+		return fmt.Errorf("failed to check non-empty strings for label='%s', objectLabel='%s': %w", label, objectLabel, err)
+	}
+
+	template := []*pkcs11.Attribute{
+		pkcs11.NewAttribute(pkcs11.CKA_CLASS, pkcs11.CKO_DATA),
+		pkcs11.NewAttribute(pkcs11.CKA_TOKEN, true),
+		pkcs11.NewAttribute(pkcs11.CKA_PRIVATE, true),
+		pkcs11.NewAttribute(pkcs11.CKA_LABEL, objectLabel),
+		pkcs11.NewAttribute(pkcs11.CKA_VALUE, data),
+	}
+
+	err := token.withSession(label, func(pool *pkcs11SessionPool, session pkcs11.SessionHandle) error {
+		defer token.evictObject(pkcs11ObjectKey{slotID: pool.slotID, class: pkcs11.CKO_DATA, label: objectLabel})
+
+		replacedHandles, err := findObjects(pool.ctx, session, objectTemplate(pkcs11.CKO_DATA, objectLabel))
+		if err != nil {
+			return fmt.Errorf("%w", err)
+		}
+
+		if _, err := pool.ctx.CreateObject(session, template); err != nil {
+			return fmt.Errorf("%w", err)
+		}
+
+		for _, handle := range replacedHandles {
+			if err := pool.ctx.DestroyObject(session, handle); err != nil {
+				return fmt.Errorf("%w", err)
+			}
+		}
+		return nil
+	})
+	if err != nil {
+		return fmt.Errorf("failed to store data object with label '%s': %w", objectLabel, err)
+	}
+
+	token.Logger.Info(fmt.Sprintf("Data object with label '%s' stored on token '%s'", objectLabel, label))
+	return nil
+}
+
+// ReadData reads the value of the data object with the given label from the token
+func (token *pkcs11Handler) ReadData(label, objectLabel string) ([]byte, error) {
+	if err := utils.CheckNonEmptyStrings(label, objectLabel); err != nil {
+		return nil, fmt.Errorf("failed to check non-empty strings for label='%s', objectLabel='%s': %w", label, objectLabel, err)
+	}
+
+	var data []byte
+	err := token.withObject(label, pkcs11.CKO_DATA, objectLabel, func(pool *pkcs11SessionPool, session pkcs11.SessionHandle, object pkcs11.ObjectHandle) error {
+		attributes, err := pool.ctx.GetAttributeValue(session, object, []*pkcs11.Attribute{pkcs11.NewAttribute(pkcs11.CKA_VALUE, nil)})
+		if err != nil {
+			return fmt.Errorf("%w", err)
+		}
+		data = attributes[0].Value
+		return nil
+	})
+	if err != nil {
+		return nil, fmt.Errorf("failed to read data object with label '%s': %w", objectLabel, err)
+	}
+
+	return data, nil
+}
@@ -0,0 +1,98 @@
+//go:build unit
+// +build unit
+
+package cryptography
+
+import (
+	"bytes"
+	"crypto"
+	"crypto/ecdsa"
+	"crypto/elliptic"
+	"crypto/rand"
+	"crypto/rsa"
+	"crypto/sha256"
+	"crypto_vault_service/internal/infrastructure/logger"
+	"crypto_vault_service/internal/infrastructure/settings"
+	"io"
+	"math/big"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// opaqueKey hides the concrete private key type, like the keys of a PKCS#11 token do
+type opaqueKey struct {
+	signer crypto.Signer
+}
+
+func (key opaqueKey) Public() crypto.PublicKey {
+	return key.signer.Public()
+}
+
+func (key opaqueKey) Sign(rand io.Reader, digest []byte, opts crypto.SignerOpts) ([]byte, error) {
+	return key.signer.Sign(rand, digest, opts)
+}
+
+func (key opaqueKey) Decrypt(rand io.Reader, ciphertext []byte, opts crypto.DecrypterOpts) ([]byte, error) {
+	return key.signer.(crypto.Decrypter).Decrypt(rand, ciphertext, opts)
+}
+
+func TestPKCS1DigestInfoPrefixes(t *testing.T) {
+	privateKey, err := rsa.GenerateKey(rand.Reader, 2048)
+	require.NoError(t, err)
+
+	for hash, prefix := range pkcs1DigestInfoPrefixes {
+		t.Run(hash.String(), func(t *testing.T) {
+			h := hash.New()
+			h.Write([]byte("This is some data to sign."))
+			digest := h.Sum(nil)
+
+			signature, err := rsa.SignPKCS1v15(rand.Reader, privateKey, hash, digest)
+			require.NoError(t, err)
+
+			// Recover the encoded message 0x00 0x01 0xff... 0x00 DigestInfo from the signature
+			encoded := new(big.Int).Exp(new(big.Int).SetBytes(signature), big.NewInt(int64(privateKey.E)), privateKey.N).Bytes()
+			assert.True(t, bytes.HasSuffix(encoded, append(append([]byte{0x00}, prefix...), digest...)))
+		})
+	}
+}
+
+func TestProcessorsWithOpaqueKeys(t *testing.T) {
+	logInstance, err := logger.GetLogger(&settings.LoggerSettings{LogLevel: "info", LogType: "console"})
+	require.NoError(t, err)
+	digest := sha256.Sum256([]byte("This is some data to sign."))
+
+	t.Run("RSA", func(t *testing.T) {
+		processor, err := NewRSAProcessor(logInstance)
+		require.NoError(t, err)
+		privateKey, publicKey, err := processor.GenerateKeys(2048)
+		require.NoError(t, err)
+
+		signature, err := processor.SignDigest(digest[:], opaqueKey{privateKey})
+		require.NoError(t, err)
+		valid, err := processor.VerifyDigest(digest[:], signature, publicKey)
+		require.NoError(t, err)
+		assert.True(t, valid)
+
+		ciphertext, err := processor.Encrypt([]byte("This is a secret."), publicKey, "key-pair-id", 1, nil)
+		require.NoError(t, err)
+		plaintext, err := processor.Decrypt(ciphertext, opaqueKey{privateKey}, nil)
+		require.NoError(t, err)
+		assert.Equal(t, []byte("This is a secret."), plaintext)
+	})
+
+	t.Run("EC", func(t *testing.T) {
+		processor, err := NewECProcessor(logInstance)
+		require.NoError(t, err)
+		privateKey, err := ecdsa.GenerateKey(elliptic.P384(), rand.Reader)
+		require.NoError(t, err)
+
+		signature, err := processor.SignDigest(digest[:], opaqueKey{privateKey})
+		require.NoError(t, err)
+		assert.Len(t, signature, 96)
+		valid, err := processor.VerifyDigest(digest[:], signature, &privateKey.PublicKey)
+		require.NoError(t, err)
+		assert.True(t, valid)
+	})
+}
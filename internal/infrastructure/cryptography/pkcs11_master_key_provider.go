@@ -0,0 +1,244 @@
+package cryptography
+
+import (
+	"crypto/rand"
+	"crypto_vault_service/internal/infrastructure/logger"
+	"encoding/binary"
+	"fmt"
+	"regexp"
+	"strconv"
+	"strings"
+	"sync"
+)
+
+// pkcs11KEKOAEPSuffix marks the labels of RSA KEK versions sealing with RSA-OAEP key wrapping
+const pkcs11KEKOAEPSuffix = "-oaep"
+
+// pkcs11MasterKeyProvider implements the MasterKeyProvider interface with RSA key pairs or AES-256 keys residing on a PKCS#11 token.
+// Each KEK version is a token key labeled {objectLabel}-v{version}, or {objectLabel}-v{version}-oaep for RSA key pairs.
+// A random AES-256 key wraps the key material and is in turn sealed with the token key via C_WrapKey: with RSA-OAEP or AES Key Wrap.
+// RSA versions labeled without the suffix were created before and seal with PKCS#1 v1.5 encryption instead, since their key pairs may not permit wrapping.
+type pkcs11MasterKeyProvider struct {
+	pkcs11Handler  PKCS11Handler
+	tokenLabel     string
+	objectLabel    string
+	keyType        string
+	currentVersion uint32
+	versions       map[uint32]pkcs11KEKVersion
+	mu             sync.RWMutex
+	logger         logger.Logger
+}
+
+// pkcs11KEKVersion describes the token key of a KEK version
+type pkcs11KEKVersion struct {
+	label     string // Label of the token key
+	keyType   string // RSA or AES
+	legacyRSA bool   // Whether the RSA key pair seals with PKCS#1 v1.5 encryption instead of RSA-OAEP key wrapping
+}
+
+// NewPKCS11MasterKeyProvider creates a MasterKeyProvider backed by the given PKCS#11 token, generating KEK versions of the
+// given key type (RSA or AES). A first KEK version is generated on the token if none exists and initialize is set, otherwise creation fails.
+// Versions of the other key type created before remain available for unwrapping.
+func NewPKCS11MasterKeyProvider(pkcs11Handler PKCS11Handler, tokenLabel, objectLabel, keyType string, initialize bool, logger logger.Logger) (MasterKeyProvider, error) {
+	if keyType != "RSA" && keyType != "AES" {
+		return nil, fmt.Errorf("unsupported master key type: %s", keyType)
+	}
+
+	provider := &pkcs11MasterKeyProvider{
+		pkcs11Handler: pkcs11Handler,
+		tokenLabel:    tokenLabel,
+		objectLabel:   objectLabel,
+		keyType:       keyType,
+		versions:      make(map[uint32]pkcs11KEKVersion),
+		logger:        logger,
+	}
+
+	if err := provider.load(); err != nil {
+		return nil, fmt.Errorf("%w", err)
+	}
+
+	if provider.currentVersion == 0 {
+		if !initialize {
+			return nil, fmt.Errorf("no KEK labeled '%s' found on token '%s' although keys are wrapped with the master key", objectLabel, tokenLabel)
+		}
+		if err := provider.createVersion(1); err != nil {
+			return nil, fmt.Errorf("%w", err)
+		}
+	}
+
+	if provider.versions[provider.currentVersion].legacyRSA {
+		logger.Warn(fmt.Sprintf("Master key version %d on token '%s' seals with RSA PKCS#1 v1.5, rotate the master key to switch to RSA-OAEP", provider.currentVersion, tokenLabel))
+	}
+
+	return provider, nil
+}
+
+// load determines the latest KEK version available on the token and the token keys of all versions
+func (p *pkcs11MasterKeyProvider) load() error {
+	objects, err := p.pkcs11Handler.ListObjects(p.tokenLabel)
+	if err != nil {
+		return fmt.Errorf("failed to list token objects: %w", err)
+	}
+
+	pattern := regexp.MustCompile("^" + regexp.QuoteMeta(p.objectLabel) + `-v([0-9]+)(` + regexp.QuoteMeta(pkcs11KEKOAEPSuffix) + `)?$`)
+	for _, object := range objects {
+		matches := pattern.FindStringSubmatch(object.Label)
+		if matches == nil {
+			continue
+		}
+
+		version, err := strconv.ParseUint(matches[1], 10, 32)
+		if err != nil {
+			return fmt.Errorf("invalid KEK version in object label '%s': %w", object.Label, err)
+		}
+		kekVersion := pkcs11KEKVersion{label: object.Label, keyType: "RSA", legacyRSA: matches[2] == ""}
+		if strings.HasPrefix(object.Type, "Secret Key Object") {
+			kekVersion = pkcs11KEKVersion{label: object.Label, keyType: "AES"}
+		}
+		p.versions[uint32(version)] = kekVersion
+		if uint32(version) > p.currentVersion {
+			p.currentVersion = uint32(version)
+		}
+	}
+
+	return nil
+}
+
+// createVersion generates a new RSA key pair or AES-256 key on the token for the given KEK version
+func (p *pkcs11MasterKeyProvider) createVersion(version uint32) error {
+	keySize := uint(2048)
+	if p.keyType == "AES" {
+		keySize = masterKeySize * 8
+	}
+
+	label := fmt.Sprintf("%s-v%d", p.objectLabel, version)
+	if p.keyType == "RSA" {
+		label += pkcs11KEKOAEPSuffix
+	}
+
+	if err := p.pkcs11Handler.AddKey(p.tokenLabel, label, p.keyType, keySize); err != nil {
+		return fmt.Errorf("failed to create KEK version %d on token: %w", version, err)
+	}
+
+	p.currentVersion = version
+	p.versions[version] = pkcs11KEKVersion{label: label, keyType: p.keyType}
+
+	p.logger.Info(fmt.Sprintf("Created master key version %d on token '%s'", version, p.tokenLabel))
+	return nil
+}
+
+// CurrentVersion returns the KEK version used for wrapping new keys
+func (p *pkcs11MasterKeyProvider) CurrentVersion() uint32 {
+	p.mu.RLock()
+	defer p.mu.RUnlock()
+
+	return p.currentVersion
+}
+
+// Wrap encrypts the key material of the stored key with the current KEK on the token
+func (p *pkcs11MasterKeyProvider) Wrap(plainKey []byte, keyID, keyPairID, keyType string) ([]byte, uint32, error) {
+	p.mu.RLock()
+	version := p.currentVersion
+	p.mu.RUnlock()
+
+	intermediateKey := make([]byte, masterKeySize)
+	if _, err := rand.Read(intermediateKey); err != nil {
+		return nil, 0, fmt.Errorf("failed to generate intermediate key: %w", err)
+	}
+
+	sealedKey, err := sealWithKEK(intermediateKey, plainKey, version, keyID, keyPairID, keyType)
+	if err != nil {
+		return nil, 0, fmt.Errorf("%w", err)
+	}
+
+	wrappedIntermediateKey, err := p.runTokenOperation(intermediateKey, version, p.pkcs11Handler.Encrypt, p.pkcs11Handler.WrapKey)
+	if err != nil {
+		return nil, 0, fmt.Errorf("%w", err)
+	}
+
+	wrappedKey := make([]byte, 2, 2+len(wrappedIntermediateKey)+len(sealedKey))
+	binary.BigEndian.PutUint16(wrappedKey, uint16(len(wrappedIntermediateKey))) // #nosec G115 -- RSA ciphertexts and wrapped keys are far below 64 KiB
+	wrappedKey = append(wrappedKey, wrappedIntermediateKey...)
+	wrappedKey = append(wrappedKey, sealedKey...)
+
+	return wrappedKey, version, nil
+}
+
+// Unwrap decrypts key material of the stored key that was wrapped with the KEK of the given version
+func (p *pkcs11MasterKeyProvider) Unwrap(wrappedKey []byte, version uint32, keyID, keyPairID, keyType string) ([]byte, error) {
+	if len(wrappedKey) < 2 {
+		return nil, fmt.Errorf("wrapped key too short")
+	}
+
+	wrappedIntermediateKeyLength := int(binary.BigEndian.Uint16(wrappedKey[:2]))
+	if len(wrappedKey) < 2+wrappedIntermediateKeyLength {
+		return nil, fmt.Errorf("wrapped key too short")
+	}
+
+	intermediateKey, err := p.runTokenOperation(wrappedKey[2:2+wrappedIntermediateKeyLength], version, p.pkcs11Handler.Decrypt, p.pkcs11Handler.UnwrapKey)
+	if err != nil {
+		return nil, fmt.Errorf("%w", err)
+	}
+
+	return openWithKEK(intermediateKey, wrappedKey[2+wrappedIntermediateKeyLength:], version, keyID, keyPairID, keyType)
+}
+
+// Rotate generates a new KEK version on the token
+func (p *pkcs11MasterKeyProvider) Rotate() (uint32, error) {
+	p.mu.Lock()
+	defer p.mu.Unlock()
+
+	if err := p.load(); err != nil {
+		return 0, fmt.Errorf("%w", err)
+	}
+
+	if err := p.createVersion(p.currentVersion + 1); err != nil {
+		return 0, fmt.Errorf("%w", err)
+	}
+
+	return p.currentVersion, nil
+}
+
+// runTokenOperation passes the input through the PKCS#11 operation of the given KEK version:
+// the key wrapping operation (wrap or unwrap) for AES keys and RSA-OAEP key pairs, the legacy operation (encrypt or decrypt) for RSA PKCS#1 v1.5 key pairs
+func (p *pkcs11MasterKeyProvider) runTokenOperation(input []byte, version uint32, legacyRSAOperation, wrapOperation func(label, objectLabel string, input []byte, keyType string) ([]byte, error)) ([]byte, error) {
+	kekVersion, err := p.kekVersion(version)
+	if err != nil {
+		return nil, fmt.Errorf("%w", err)
+	}
+
+	operation := wrapOperation
+	if kekVersion.legacyRSA {
+		operation = legacyRSAOperation
+	}
+
+	output, err := operation(p.tokenLabel, kekVersion.label, input, kekVersion.keyType)
+	if err != nil {
+		return nil, fmt.Errorf("token operation with KEK version %d failed: %w", version, err)
+	}
+
+	return output, nil
+}
+
+// kekVersion returns the token key of a KEK version, looking up versions created on the token since they were last loaded
+func (p *pkcs11MasterKeyProvider) kekVersion(version uint32) (pkcs11KEKVersion, error) {
+	p.mu.RLock()
+	kekVersion, known := p.versions[version]
+	p.mu.RUnlock()
+	if known {
+		return kekVersion, nil
+	}
+
+	p.mu.Lock()
+	defer p.mu.Unlock()
+
+	if err := p.load(); err != nil {
+		return pkcs11KEKVersion{}, fmt.Errorf("%w", err)
+	}
+
+	kekVersion, known = p.versions[version]
+	if !known {
+		return pkcs11KEKVersion{}, fmt.Errorf("KEK version %d not found on token", version)
+	}
+	return kekVersion, nil
+}
@@ -0,0 +1,172 @@
+//go:build unit
+// +build unit
+
+package cryptography
+
+import (
+	"bytes"
+	"fmt"
+	"strings"
+	"testing"
+
+	"crypto_vault_service/internal/infrastructure/logger"
+	"crypto_vault_service/internal/infrastructure/settings"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// fakeKEKTokenHandler keeps the types of token keys in memory and seals data by prefixing it with the key label and operation,
+// standing in for a token holding the KEK versions
+type fakeKEKTokenHandler struct {
+	PKCS11Handler
+	objectTypes map[string]string
+}
+
+func (h *fakeKEKTokenHandler) ListObjects(tokenLabel string) ([]TokenObject, error) {
+	objects := make([]TokenObject, 0, len(h.objectTypes))
+	for label, objectType := range h.objectTypes {
+		objects = append(objects, TokenObject{Label: label, Type: objectType})
+	}
+	return objects, nil
+}
+
+func (h *fakeKEKTokenHandler) AddKey(label, objectLabel, keyType string, keySize uint) error {
+	if keyType == "AES" {
+		h.objectTypes[objectLabel] = "Secret Key Object; AES"
+	} else {
+		h.objectTypes[objectLabel] = "Private Key Object; RSA"
+	}
+	return nil
+}
+
+func (h *fakeKEKTokenHandler) seal(objectLabel, keyType, operation string, input []byte) ([]byte, error) {
+	if !strings.HasSuffix(h.objectTypes[objectLabel], "; "+keyType) {
+		return nil, fmt.Errorf("%w", ErrObjectNotFound)
+	}
+	return append([]byte(objectLabel+":"+operation+":"), input...), nil
+}
+
+func (h *fakeKEKTokenHandler) open(objectLabel, keyType, operation string, input []byte) ([]byte, error) {
+	prefix := []byte(objectLabel + ":" + operation + ":")
+	if !bytes.HasPrefix(input, prefix) {
+		return nil, fmt.Errorf("input not sealed with %s of %s", operation, objectLabel)
+	}
+	if _, err := h.seal(objectLabel, keyType, operation, nil); err != nil {
+		return nil, err
+	}
+	return input[len(prefix):], nil
+}
+
+func (h *fakeKEKTokenHandler) Encrypt(label, objectLabel string, plaintext []byte, keyType string) ([]byte, error) {
+	return h.seal(objectLabel, keyType, "encrypt", plaintext)
+}
+
+func (h *fakeKEKTokenHandler) Decrypt(label, objectLabel string, ciphertext []byte, keyType string) ([]byte, error) {
+	return h.open(objectLabel, keyType, "encrypt", ciphertext)
+}
+
+func (h *fakeKEKTokenHandler) WrapKey(label, objectLabel string, plainKey []byte, keyType string) ([]byte, error) {
+	return h.seal(objectLabel, keyType, "wrap", plainKey)
+}
+
+func (h *fakeKEKTokenHandler) UnwrapKey(label, objectLabel string, wrappedKey []byte, keyType string) ([]byte, error) {
+	return h.open(objectLabel, keyType, "wrap", wrappedKey)
+}
+
+func TestPKCS11MasterKeyProvider(t *testing.T) {
+	logInstance, err := logger.GetLogger(&settings.LoggerSettings{LogLevel: "info", LogType: "console"})
+	require.NoError(t, err)
+
+	t.Run("UnsupportedKeyType", func(t *testing.T) {
+		_, err := NewPKCS11MasterKeyProvider(&fakeKEKTokenHandler{objectTypes: map[string]string{}}, "token", "kek", "ECDSA", true, logInstance)
+		assert.Error(t, err)
+	})
+
+	t.Run("MissingKEKWithoutInitialize", func(t *testing.T) {
+		handler := &fakeKEKTokenHandler{objectTypes: map[string]string{}}
+		_, err := NewPKCS11MasterKeyProvider(handler, "token", "kek", "AES", false, logInstance)
+		assert.Error(t, err)
+		assert.Empty(t, handler.objectTypes)
+	})
+
+	t.Run("AESVersionsUseKeyWrapping", func(t *testing.T) {
+		handler := &fakeKEKTokenHandler{objectTypes: map[string]string{}}
+		provider, err := NewPKCS11MasterKeyProvider(handler, "token", "kek", "AES", true, logInstance)
+		require.NoError(t, err)
+		assert.Equal(t, "Secret Key Object; AES", handler.objectTypes["kek-v1"])
+
+		wrappedKey, version, err := provider.Wrap([]byte("secret"), "key-1", "key-pair-1", "private")
+		require.NoError(t, err)
+		assert.Equal(t, uint32(1), version)
+		assert.Contains(t, string(wrappedKey), "kek-v1:wrap:")
+
+		unwrappedKey, err := provider.Unwrap(wrappedKey, version, "key-1", "key-pair-1", "private")
+		require.NoError(t, err)
+		assert.Equal(t, []byte("secret"), unwrappedKey)
+	})
+
+	t.Run("LegacyRSAVersionsUseEncryption", func(t *testing.T) {
+		handler := &fakeKEKTokenHandler{objectTypes: map[string]string{"kek-v1": "Private Key Object; RSA"}}
+		provider, err := NewPKCS11MasterKeyProvider(handler, "token", "kek", "RSA", false, logInstance)
+		require.NoError(t, err)
+
+		legacyWrappedKey, legacyVersion, err := provider.Wrap([]byte("secret"), "key-1", "key-pair-1", "private")
+		require.NoError(t, err)
+		assert.Equal(t, uint32(1), legacyVersion)
+		assert.Contains(t, string(legacyWrappedKey), "kek-v1:encrypt:")
+
+		// Rotating creates an RSA-OAEP version, while the version created before still unwraps with PKCS#1 v1.5
+		version, err := provider.Rotate()
+		require.NoError(t, err)
+		assert.Equal(t, uint32(2), version)
+		assert.Equal(t, "Private Key Object; RSA", handler.objectTypes["kek-v2-oaep"])
+
+		wrappedKey, version, err := provider.Wrap([]byte("other secret"), "key-1", "key-pair-1", "private")
+		require.NoError(t, err)
+		assert.Equal(t, uint32(2), version)
+		assert.Contains(t, string(wrappedKey), "kek-v2-oaep:wrap:")
+
+		unwrappedKey, err := provider.Unwrap(legacyWrappedKey, legacyVersion, "key-1", "key-pair-1", "private")
+		require.NoError(t, err)
+		assert.Equal(t, []byte("secret"), unwrappedKey)
+
+		unwrappedKey, err = provider.Unwrap(wrappedKey, version, "key-1", "key-pair-1", "private")
+		require.NoError(t, err)
+		assert.Equal(t, []byte("other secret"), unwrappedKey)
+	})
+
+	t.Run("SwitchFromRSAToAES", func(t *testing.T) {
+		handler := &fakeKEKTokenHandler{objectTypes: map[string]string{}}
+		rsaProvider, err := NewPKCS11MasterKeyProvider(handler, "token", "kek", "RSA", true, logInstance)
+		require.NoError(t, err)
+
+		rsaWrappedKey, rsaVersion, err := rsaProvider.Wrap([]byte("secret"), "key-1", "key-pair-1", "private")
+		require.NoError(t, err)
+		assert.Contains(t, string(rsaWrappedKey), "kek-v1-oaep:wrap:")
+
+		aesProvider, err := NewPKCS11MasterKeyProvider(handler, "token", "kek", "AES", false, logInstance)
+		require.NoError(t, err)
+		version, err := aesProvider.Rotate()
+		require.NoError(t, err)
+		assert.Equal(t, uint32(2), version)
+
+		aesWrappedKey, aesVersion, err := aesProvider.Wrap([]byte("other secret"), "key-1", "key-pair-1", "private")
+		require.NoError(t, err)
+		assert.Equal(t, uint32(2), aesVersion)
+		assert.Contains(t, string(aesWrappedKey), "kek-v2:wrap:")
+
+		// Versions created before the switch still unwrap with their own key type
+		unwrappedKey, err := aesProvider.Unwrap(rsaWrappedKey, rsaVersion, "key-1", "key-pair-1", "private")
+		require.NoError(t, err)
+		assert.Equal(t, []byte("secret"), unwrappedKey)
+
+		// Versions created elsewhere since are looked up on the token
+		unwrappedKey, err = rsaProvider.Unwrap(aesWrappedKey, aesVersion, "key-1", "key-pair-1", "private")
+		require.NoError(t, err)
+		assert.Equal(t, []byte("other secret"), unwrappedKey)
+
+		_, err = aesProvider.Unwrap(aesWrappedKey, 3, "key-1", "key-pair-1", "private")
+		assert.Error(t, err)
+	})
+}
@@ -0,0 +1,233 @@
+package cryptography
+
+import (
+	"crypto/aes"
+	"crypto/rand"
+	"crypto_vault_service/internal/infrastructure/utils"
+	"fmt"
+
+	"github.com/miekg/pkcs11"
+)
+
+const (
+	// pkcs11GCMIVSize is the size in bytes of the IVs of AES-GCM encryptions on the token
+	pkcs11GCMIVSize = 12
+	// pkcs11GCMTagBits is the size in bits of the authentication tags of AES-GCM encryptions on the token
+	pkcs11GCMTagBits = 128
+)
+
+// EncryptAES encrypts the plaintext with the AES key on the token, in GCM mode via CKM_AES_GCM authenticating the associated data alongside,
+// or in CBC mode via CKM_AES_CBC_PAD. The returned ciphertext is prefixed with the random IV, GCM ciphertexts end with the 16 byte tag.
+func (token *pkcs11Handler) EncryptAES(label, objectLabel string, plaintext, associatedData []byte, mode string) ([]byte, error) {
+	if err := utils.CheckNonEmptyStrings(label, objectLabel); err != nil {
+		return nil, fmt.Errorf("failed to check non-empty strings for label='%s', objectLabel='%s': %w", label, objectLabel, err)
+	}
+
+	ivSize, err := aesIVSize(mode, associatedData)
+	if err != nil {
+		return nil, fmt.Errorf("%w", err)
+	}
+
+	var ciphertext []byte
+	err = token.withObject(label, pkcs11.CKO_SECRET_KEY, objectLabel, func(pool *pkcs11SessionPool, session pkcs11.SessionHandle, key pkcs11.ObjectHandle) error {
+		iv := make([]byte, ivSize)
+		if _, err := rand.Read(iv); err != nil {
+			return fmt.Errorf("failed to generate IV: %w", err)
+		}
+
+		mechanism, gcmParams := aesMechanism(mode, iv, associatedData)
+		defer gcmParams.Free()
+
+		if err := pool.ctx.EncryptInit(session, []*pkcs11.Mechanism{mechanism}, key); err != nil {
+			return fmt.Errorf("%w", err)
+		}
+		encrypted, err := pool.ctx.Encrypt(session, plaintext)
+		if err != nil {
+			return err
+		}
+
+		// Some tokens ignore the IV passed in and write their own
+		if tokenIV := gcmParams.IV(); len(tokenIV) == ivSize {
+			iv = tokenIV
+		}
+		ciphertext = append(iv, encrypted...)
+		return nil
+	})
+	if err != nil {
+		return nil, fmt.Errorf("failed to encrypt data: %w", err)
+	}
+
+	token.Logger.Info(fmt.Sprintf("AES-%s encryption with key %s on token %s successful", mode, objectLabel, label))
+	return ciphertext, nil
+}
+
+// DecryptAES decrypts a ciphertext returned by EncryptAES with the AES key on the token.
+// GCM ciphertexts whose tag does not match the ciphertext and associated data are rejected.
+func (token *pkcs11Handler) DecryptAES(label, objectLabel string, ciphertext, associatedData []byte, mode string) ([]byte, error) {
+	if err := utils.CheckNonEmptyStrings(label, objectLabel); err != nil {
+		return nil, fmt.Errorf("failed to check non-empty strings for label='%s', objectLabel='%s': %w", label, objectLabel, err)
+	}
+
+	ivSize, err := aesIVSize(mode, associatedData)
+	if err != nil {
+		return nil, fmt.Errorf("%w", err)
+	}
+	if len(ciphertext) < ivSize {
+		return nil, fmt.Errorf("ciphertext too short")
+	}
+
+	var plaintext []byte
+	err = token.withObject(label, pkcs11.CKO_SECRET_KEY, objectLabel, func(pool *pkcs11SessionPool, session pkcs11.SessionHandle, key pkcs11.ObjectHandle) error {
+		mechanism, gcmParams := aesMechanism(mode, ciphertext[:ivSize], associatedData)
+		defer gcmParams.Free()
+
+		if err := pool.ctx.DecryptInit(session, []*pkcs11.Mechanism{mechanism}, key); err != nil {
+			return fmt.Errorf("%w", err)
+		}
+		var err error
+		plaintext, err = pool.ctx.Decrypt(session, ciphertext[ivSize:])
+		return err
+	})
+	if err != nil {
+		return nil, fmt.Errorf("failed to decrypt data: %w", err)
+	}
+
+	token.Logger.Info(fmt.Sprintf("AES-%s decryption with key %s on token %s successful", mode, objectLabel, label))
+	return plaintext, nil
+}
+
+// WrapKey wraps the key material via C_WrapKey, with AES Key Wrap (RFC 3394, CKM_AES_KEY_WRAP) using the AES key on the token
+// or with RSA-OAEP using SHA-256 (CKM_RSA_PKCS_OAEP) using the public key of the RSA key pair on the token.
+// The key material is imported as an extractable session object for the duration of the operation.
+func (token *pkcs11Handler) WrapKey(label, objectLabel string, plainKey []byte, keyType string) ([]byte, error) {
+	if err := utils.CheckNonEmptyStrings(label, objectLabel, keyType); err != nil {
+		return nil, fmt.Errorf("failed to check non-empty strings for label='%s', objectLabel='%s', keyType='%s': %w", label, objectLabel, keyType, err)
+	}
+
+	mechanism, wrappingKeyClass, err := keyWrapMechanism(keyType, true)
+	if err != nil {
+		return nil, fmt.Errorf("%w", err)
+	}
+	if len(plainKey) == 0 {
+		return nil, fmt.Errorf("key to wrap cannot be empty")
+	}
+	if keyType == "AES" && (len(plainKey) < 16 || len(plainKey)%8 != 0) {
+		return nil, fmt.Errorf("key to wrap must be a multiple of 8 bytes and at least 16 bytes, got %d", len(plainKey))
+	}
+
+	var wrappedKey []byte
+	err = token.withObject(label, wrappingKeyClass, objectLabel, func(pool *pkcs11SessionPool, session pkcs11.SessionHandle, wrappingKey pkcs11.ObjectHandle) error {
+		key, err := pool.ctx.CreateObject(session, sessionSecretKeyTemplate(plainKey))
+		if err != nil {
+			return fmt.Errorf("%w", err)
+		}
+		defer func() {
+			_ = pool.ctx.DestroyObject(session, key)
+		}()
+
+		wrappedKey, err = pool.ctx.WrapKey(session, []*pkcs11.Mechanism{mechanism}, wrappingKey, key)
+		return err
+	})
+	if err != nil {
+		return nil, fmt.Errorf("failed to wrap key: %w", err)
+	}
+
+	token.Logger.Info(fmt.Sprintf("Key wrapping with key %s on token %s successful", objectLabel, label))
+	return wrappedKey, nil
+}
+
+// UnwrapKey unwraps key material returned by WrapKey via C_UnwrapKey with the AES key or the private key of the RSA key pair on the token.
+// The key material is unwrapped into an extractable session object, which is destroyed once its value has been read.
+func (token *pkcs11Handler) UnwrapKey(label, objectLabel string, wrappedKey []byte, keyType string) ([]byte, error) {
+	if err := utils.CheckNonEmptyStrings(label, objectLabel, keyType); err != nil {
+		return nil, fmt.Errorf("failed to check non-empty strings for label='%s', objectLabel='%s', keyType='%s': %w", label, objectLabel, keyType, err)
+	}
+
+	mechanism, unwrappingKeyClass, err := keyWrapMechanism(keyType, false)
+	if err != nil {
+		return nil, fmt.Errorf("%w", err)
+	}
+
+	var plainKey []byte
+	err = token.withObject(label, unwrappingKeyClass, objectLabel, func(pool *pkcs11SessionPool, session pkcs11.SessionHandle, unwrappingKey pkcs11.ObjectHandle) error {
+		key, err := pool.ctx.UnwrapKey(session, []*pkcs11.Mechanism{mechanism}, unwrappingKey, wrappedKey, sessionSecretKeyTemplate(nil))
+		if err != nil {
+			return err
+		}
+		defer func() {
+			_ = pool.ctx.DestroyObject(session, key)
+		}()
+
+		attributes, err := pool.ctx.GetAttributeValue(session, key, []*pkcs11.Attribute{pkcs11.NewAttribute(pkcs11.CKA_VALUE, nil)})
+		if err != nil {
+			return fmt.Errorf("%w", err)
+		}
+		plainKey = attributes[0].Value
+		return nil
+	})
+	if err != nil {
+		return nil, fmt.Errorf("failed to unwrap key: %w", err)
+	}
+
+	token.Logger.Info(fmt.Sprintf("Key unwrapping with key %s on token %s successful", objectLabel, label))
+	return plainKey, nil
+}
+
+// aesIVSize returns the IV size of the AES mode. Associated data is rejected for CBC, which cannot authenticate it.
+func aesIVSize(mode string, associatedData []byte) (int, error) {
+	switch mode {
+	case AESModeGCM:
+		return pkcs11GCMIVSize, nil
+	case AESModeCBC:
+		if len(associatedData) != 0 {
+			return 0, fmt.Errorf("associated data is only supported with AES-GCM")
+		}
+		return aes.BlockSize, nil
+	default:
+		return 0, fmt.Errorf("unsupported AES mode for token keys: %s", mode)
+	}
+}
+
+// aesMechanism returns the mechanism of the AES mode along with its GCM parameters, which must be freed after the operation
+func aesMechanism(mode string, iv, associatedData []byte) (*pkcs11.Mechanism, *pkcs11.GCMParams) {
+	if mode == AESModeCBC {
+		return pkcs11.NewMechanism(pkcs11.CKM_AES_CBC_PAD, iv), nil
+	}
+
+	gcmParams := pkcs11.NewGCMParams(iv, associatedData, pkcs11GCMTagBits)
+	return pkcs11.NewMechanism(pkcs11.CKM_AES_GCM, gcmParams), gcmParams
+}
+
+// keyWrapMechanism returns the key wrapping mechanism of the key type and the class of the key wrapping or unwrapping with it
+func keyWrapMechanism(keyType string, wrap bool) (*pkcs11.Mechanism, uint, error) {
+	switch keyType {
+	case "AES":
+		return pkcs11.NewMechanism(pkcs11.CKM_AES_KEY_WRAP, nil), pkcs11.CKO_SECRET_KEY, nil
+	case "RSA":
+		mechanism := pkcs11.NewMechanism(pkcs11.CKM_RSA_PKCS_OAEP, pkcs11.NewOAEPParams(pkcs11.CKM_SHA256, pkcs11.CKG_MGF1_SHA256, pkcs11.CKZ_DATA_SPECIFIED, nil))
+		if wrap {
+			return mechanism, pkcs11.CKO_PUBLIC_KEY, nil
+		}
+		return mechanism, pkcs11.CKO_PRIVATE_KEY, nil
+	default:
+		return nil, 0, fmt.Errorf("only AES and RSA keys are supported for key wrapping")
+	}
+}
+
+// sessionSecretKeyTemplate returns the template of a generic secret session object holding key material to wrap or unwrapped,
+// which is extractable so that it can be wrapped and not sensitive so that its value can be read.
+// The value is omitted from the template if nil, as when unwrapping.
+func sessionSecretKeyTemplate(value []byte) []*pkcs11.Attribute {
+	template := []*pkcs11.Attribute{
+		pkcs11.NewAttribute(pkcs11.CKA_CLASS, pkcs11.CKO_SECRET_KEY),
+		pkcs11.NewAttribute(pkcs11.CKA_KEY_TYPE, pkcs11.CKK_GENERIC_SECRET),
+		pkcs11.NewAttribute(pkcs11.CKA_TOKEN, false),
+		pkcs11.NewAttribute(pkcs11.CKA_PRIVATE, true),
+		pkcs11.NewAttribute(pkcs11.CKA_SENSITIVE, false),
+		pkcs11.NewAttribute(pkcs11.CKA_EXTRACTABLE, true),
+	}
+	if value != nil {
+		template = append(template, pkcs11.NewAttribute(pkcs11.CKA_VALUE, value))
+	}
+	return template
+}
@@ -0,0 +1,148 @@
+package cryptography
+
+import (
+	"errors"
+	"fmt"
+	"sync"
+	"sync/atomic"
+
+	"github.com/miekg/pkcs11"
+)
+
+// maxIdlePKCS11Sessions is the number of idle sessions kept open per token, sessions released beyond it are closed
+const maxIdlePKCS11Sessions = 8
+
+var (
+	pkcs11Modules   = map[string]*pkcs11.Ctx{}
+	pkcs11ModulesMu sync.Mutex
+)
+
+// loadPKCS11Module loads and initializes the PKCS#11 module at modulePath once per process.
+// Modules are never finalized, as C_Finalize would invalidate the sessions of every handler sharing the module.
+func loadPKCS11Module(modulePath string) (*pkcs11.Ctx, error) {
+	pkcs11ModulesMu.Lock()
+	defer pkcs11ModulesMu.Unlock()
+
+	if ctx, ok := pkcs11Modules[modulePath]; ok {
+		return ctx, nil
+	}
+
+	ctx := pkcs11.New(modulePath)
+	if ctx == nil {
+		return nil, fmt.Errorf("failed to load PKCS#11 module %s", modulePath)
+	}
+
+	if err := ctx.Initialize(); err != nil && !isPKCS11Error(err, pkcs11.CKR_CRYPTOKI_ALREADY_INITIALIZED) {
+		ctx.Destroy()
+		return nil, fmt.Errorf("failed to initialize PKCS#11 module %s: %w", modulePath, err)
+	}
+
+	pkcs11Modules[modulePath] = ctx
+	return ctx, nil
+}
+
+// isPKCS11Error reports whether err is one of the given PKCS#11 return values
+func isPKCS11Error(err error, returnValues ...uint) bool {
+	var pkcs11Err pkcs11.Error
+	if !errors.As(err, &pkcs11Err) {
+		return false
+	}
+	for _, returnValue := range returnValues {
+		if uint(pkcs11Err) == returnValue {
+			return true
+		}
+	}
+	return false
+}
+
+// isPKCS11SessionError reports whether err means the session or the token behind it is gone
+func isPKCS11SessionError(err error) bool {
+	return isPKCS11Error(err, pkcs11.CKR_SESSION_HANDLE_INVALID, pkcs11.CKR_SESSION_CLOSED, pkcs11.CKR_TOKEN_NOT_PRESENT, pkcs11.CKR_DEVICE_REMOVED)
+}
+
+// isPKCS11HandleError reports whether err means an object handle no longer refers to an object
+func isPKCS11HandleError(err error) bool {
+	return isPKCS11Error(err, pkcs11.CKR_OBJECT_HANDLE_INVALID, pkcs11.CKR_KEY_HANDLE_INVALID)
+}
+
+// pkcs11SessionPool keeps idle read/write sessions on the token in a slot for reuse.
+// PKCS#11 shares the login state among all sessions of an application on a token, so each opened session logs in as user
+// and treats an existing login as success.
+type pkcs11SessionPool struct {
+	ctx     *pkcs11.Ctx
+	slotID  uint
+	userPin string
+	idle    chan pkcs11.SessionHandle
+	closed  atomic.Bool
+}
+
+// newPKCS11SessionPool creates an empty session pool for the token in the given slot
+func newPKCS11SessionPool(ctx *pkcs11.Ctx, slotID uint, userPin string) *pkcs11SessionPool {
+	return &pkcs11SessionPool{
+		ctx:     ctx,
+		slotID:  slotID,
+		userPin: userPin,
+		idle:    make(chan pkcs11.SessionHandle, maxIdlePKCS11Sessions),
+	}
+}
+
+// acquire returns an idle session or opens and logs in a new one
+func (p *pkcs11SessionPool) acquire() (pkcs11.SessionHandle, error) {
+	select {
+	case session := <-p.idle:
+		return session, nil
+	default:
+	}
+
+	session, err := p.ctx.OpenSession(p.slotID, pkcs11.CKF_SERIAL_SESSION|pkcs11.CKF_RW_SESSION)
+	if err != nil {
+		return 0, fmt.Errorf("failed to open session on slot 0x%x: %w", p.slotID, err)
+	}
+
+	if err := p.login(session); err != nil {
+		p.discard(session)
+		return 0, fmt.Errorf("%w", err)
+	}
+
+	return session, nil
+}
+
+// login logs the token in as user through the session unless it already is
+func (p *pkcs11SessionPool) login(session pkcs11.SessionHandle) error {
+	if err := p.ctx.Login(session, pkcs11.CKU_USER, p.userPin); err != nil && !isPKCS11Error(err, pkcs11.CKR_USER_ALREADY_LOGGED_IN) {
+		return fmt.Errorf("failed to log in to token on slot 0x%x: %w", p.slotID, err)
+	}
+	return nil
+}
+
+// release returns the session to the pool, closing it if the pool is full or closed
+func (p *pkcs11SessionPool) release(session pkcs11.SessionHandle) {
+	if p.closed.Load() {
+		p.discard(session)
+		return
+	}
+
+	select {
+	case p.idle <- session:
+	default:
+		p.discard(session)
+	}
+}
+
+// discard closes the session, which may already be invalid
+func (p *pkcs11SessionPool) discard(session pkcs11.SessionHandle) {
+	_ = p.ctx.CloseSession(session)
+}
+
+// close closes all idle sessions, sessions in use are closed once released
+func (p *pkcs11SessionPool) close() {
+	p.closed.Store(true)
+	for {
+		select {
+		case session := <-p.idle:
+			p.discard(session)
+		default:
+			return
+		}
+	}
+}
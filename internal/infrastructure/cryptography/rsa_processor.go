@@ -1,6 +1,7 @@
 package cryptography
 
 import (
+	"bytes"
 	"crypto"
 	"crypto/rand"
 	"crypto/rsa"
@@ -10,17 +11,45 @@ import (
 	"encoding/pem"
 	"errors"
 	"fmt"
+	"io"
 	"log"
 	"os"
 	"path/filepath"
 )
 
+// RSA modes supported by the RSAProcessor
+const (
+	// RSAModeOAEPHybrid encrypts the data with a random AES-256-GCM DEK wrapped by RSA-OAEP with SHA-256
+	RSAModeOAEPHybrid = "OAEP-SHA256+AES-256-GCM"
+	// RSAModeOAEPHybridStream encrypts segments with a random AES-256-GCM DEK wrapped by RSA-OAEP with SHA-256
+	RSAModeOAEPHybridStream = "OAEP-SHA256+AES-256-GCM-STREAM"
+	// RSAModePKCS1v15 encrypts the data in chunks with PKCS#1 v1.5 padding. It is only kept for decrypting existing ciphertexts
+	RSAModePKCS1v15 = "PKCS1v15"
+)
+
+// rsaHybridDEKSize is the size in bytes of the AES-256 data encryption keys of hybrid RSA encryption
+const rsaHybridDEKSize = 32
+
 // RSAProcessor Interface
 type RSAProcessor interface {
-	Encrypt(plainText []byte, publicKey *rsa.PublicKey) ([]byte, error)
-	Decrypt(ciphertext []byte, privateKey *rsa.PrivateKey) ([]byte, error)
+	// Encrypt encrypts the plaintext with a random AES-GCM DEK wrapped by RSA-OAEP into an envelope referencing the given key ID.
+	// The envelope header and the optional associated data are authenticated alongside.
+	Encrypt(plainText []byte, publicKey *rsa.PublicKey, keyID string, keyVersion uint32, associatedData []byte) ([]byte, error)
+	// Decrypt decrypts a binary or armored envelope, or a header-less legacy PKCS#1 v1.5 ciphertext. Associated data only applies to hybrid envelopes.
+	// The private key may be an *rsa.PrivateKey or any crypto.Decrypter of an RSA key, like a key on a PKCS#11 token.
+	Decrypt(ciphertext []byte, privateKey crypto.Decrypter, associatedData []byte) ([]byte, error)
+	// EncryptStream writes a hybrid streaming envelope to dst and returns a writer for the plaintext, which must be closed to complete the envelope
+	EncryptStream(dst io.Writer, publicKey *rsa.PublicKey, keyID string, keyVersion uint32, associatedData []byte) (io.WriteCloser, error)
+	// DecryptStream returns a reader for the plaintext of an envelope read from src.
+	// Ciphertexts of modes other than the hybrid streaming mode are read entirely before being decrypted.
+	DecryptStream(src io.Reader, privateKey crypto.Decrypter, associatedData []byte) (io.Reader, error)
 	Sign(data []byte, privateKey *rsa.PrivateKey) ([]byte, error)
 	Verify(data []byte, signature []byte, publicKey *rsa.PublicKey) (bool, error)
+	// SignDigest signs a SHA-256 digest with PKCS#1 v1.5 padding, allowing to sign data hashed while streaming.
+	// The private key may be an *rsa.PrivateKey or any crypto.Signer of an RSA key, like a key on a PKCS#11 token.
+	SignDigest(digest []byte, privateKey crypto.Signer) ([]byte, error)
+	// VerifyDigest verifies a PKCS#1 v1.5 signature of a SHA-256 digest. A mismatching signature is reported as false without an error
+	VerifyDigest(digest []byte, signature []byte, publicKey *rsa.PublicKey) (bool, error)
 	GenerateKeys(keySize int) (*rsa.PrivateKey, *rsa.PublicKey, error)
 	SavePrivateKeyToFile(privateKey *rsa.PrivateKey, filename string) error
 	SavePublicKeyToFile(publicKey *rsa.PublicKey, filename string) error
@@ -51,52 +80,216 @@ func (r *rsaProcessor) GenerateKeys(keySize int) (*rsa.PrivateKey, *rsa.PublicKe
 	return privateKey, publicKey, nil
 }
 
-// Encrypt data using RSA public key
-// Encrypt encrypts the given plaintext using RSA encryption.
-// If the plaintext is too large, it will split it into smaller chunks and encrypt each one separately.
-func (r *rsaProcessor) Encrypt(plainText []byte, publicKey *rsa.PublicKey) ([]byte, error) {
+// Encrypt data hybridly: a random AES-256-GCM DEK encrypts the plaintext and is wrapped with RSA-OAEP (SHA-256) using the public key
+func (r *rsaProcessor) Encrypt(plainText []byte, publicKey *rsa.PublicKey, keyID string, keyVersion uint32, associatedData []byte) ([]byte, error) {
 	if publicKey == nil {
 		return nil, errors.New("public key cannot be nil")
 	}
 
-	// Maximum size for the plaintext that can be encrypted with the RSA key
-	// For a 2048-bit RSA key, it's approximately 245 bytes after accounting for padding
-	maxSize := publicKey.Size() - 11 // PKCS#1 v1.5 padding size
+	dek := make([]byte, rsaHybridDEKSize)
+	if _, err := rand.Read(dek); err != nil {
+		return nil, fmt.Errorf("failed to generate DEK: %w", err)
+	}
 
-	// If the plaintext is too large, split it into smaller chunks
-	var encryptedData []byte
-	for len(plainText) > 0 {
-		// Determine the chunk size
-		chunkSize := maxSize
-		if len(plainText) < chunkSize {
-			chunkSize = len(plainText)
-		}
+	wrappedDEK, err := rsa.EncryptOAEP(sha256.New(), rand.Reader, publicKey, dek, nil)
+	if err != nil {
+		return nil, fmt.Errorf("failed to wrap DEK: %w", err)
+	}
+
+	gcm, err := newGCM(dek)
+	if err != nil {
+		return nil, fmt.Errorf("%w", err)
+	}
+
+	envelope := &Envelope{
+		Algorithm:  EnvelopeAlgorithmRSA,
+		Mode:       RSAModeOAEPHybrid,
+		KeyID:      keyID,
+		KeyVersion: keyVersion,
+		Nonce:      make([]byte, gcm.NonceSize()),
+		WrappedDEK: wrappedDEK,
+	}
+	if _, err := rand.Read(envelope.Nonce); err != nil {
+		return nil, fmt.Errorf("failed to read random bytes for nonce: %w", err)
+	}
 
-		// Encrypt the current chunk
-		encryptedChunk, err := rsa.EncryptPKCS1v15(rand.Reader, publicKey, plainText[:chunkSize])
+	header, err := envelope.Header()
+	if err != nil {
+		return nil, fmt.Errorf("%w", err)
+	}
+
+	envelope.Ciphertext = gcm.Seal(nil, envelope.Nonce, plainText, gcmAssociatedData(header, associatedData))
+
+	ciphertext, err := envelope.Marshal()
+	if err != nil {
+		return nil, fmt.Errorf("%w", err)
+	}
+
+	r.logger.Info("RSA encryption succeeded")
+	return ciphertext, nil
+}
+
+// Decrypt data using RSA private key in the mode recorded by the envelope.
+// Header-less ciphertexts were produced by the former chunked PKCS#1 v1.5 implementation before envelopes were introduced.
+func (r *rsaProcessor) Decrypt(ciphertext []byte, privateKey crypto.Decrypter, associatedData []byte) ([]byte, error) {
+	if privateKey == nil {
+		return nil, fmt.Errorf("private key cannot be nil")
+	}
+
+	if !IsEnvelope(ciphertext) {
+		return r.decryptPKCS1v15(ciphertext, privateKey)
+	}
+
+	envelope, err := ParseEnvelope(ciphertext)
+	if err != nil {
+		return nil, fmt.Errorf("%w", err)
+	}
+	if envelope.Algorithm != EnvelopeAlgorithmRSA {
+		return nil, fmt.Errorf("envelope was produced with %s, not RSA", envelope.Algorithm)
+	}
+
+	switch envelope.Mode {
+	case RSAModeOAEPHybrid:
+		return r.decryptHybrid(envelope, privateKey, associatedData)
+	case RSAModeOAEPHybridStream:
+		plainTextReader, err := r.decryptHybridStream(bytes.NewReader(envelope.Ciphertext), envelope, privateKey, associatedData)
+		if err != nil {
+			return nil, fmt.Errorf("%w", err)
+		}
+		plainText, err := io.ReadAll(plainTextReader)
 		if err != nil {
-			return nil, fmt.Errorf("failed to encrypt data: %w", err)
+			return nil, fmt.Errorf("%w", err)
 		}
+		return plainText, nil
+	case RSAModePKCS1v15:
+		return r.decryptPKCS1v15(envelope.Ciphertext, privateKey)
+	default:
+		return nil, fmt.Errorf("unsupported RSA mode: %s", envelope.Mode)
+	}
+}
 
-		// Append the encrypted chunk to the result
-		encryptedData = append(encryptedData, encryptedChunk...)
+// EncryptStream encrypts a stream with a random DEK wrapped by RSA-OAEP (SHA-256) using the public key
+func (r *rsaProcessor) EncryptStream(dst io.Writer, publicKey *rsa.PublicKey, keyID string, keyVersion uint32, associatedData []byte) (io.WriteCloser, error) {
+	if publicKey == nil {
+		return nil, errors.New("public key cannot be nil")
+	}
 
-		// Move to the next chunk
-		plainText = plainText[chunkSize:]
+	dek, err := newStreamDEK()
+	if err != nil {
+		return nil, fmt.Errorf("%w", err)
 	}
 
-	r.logger.Info("RSA encryption succeeded")
-	return encryptedData, nil
+	wrappedDEK, err := rsa.EncryptOAEP(sha256.New(), rand.Reader, publicKey, dek, nil)
+	if err != nil {
+		return nil, fmt.Errorf("failed to wrap DEK: %w", err)
+	}
+
+	envelope := &Envelope{
+		Algorithm:  EnvelopeAlgorithmRSA,
+		Mode:       RSAModeOAEPHybridStream,
+		KeyID:      keyID,
+		KeyVersion: keyVersion,
+		WrappedDEK: wrappedDEK,
+	}
+
+	plainTextWriter, err := newStreamEncrypter(dst, envelope, dek, associatedData)
+	if err != nil {
+		return nil, fmt.Errorf("%w", err)
+	}
+
+	r.logger.Info("RSA stream encryption started")
+	return plainTextWriter, nil
 }
 
-// Decrypt data using RSA private key. It handles multiple chunks of encrypted data.
-func (r *rsaProcessor) Decrypt(ciphertext []byte, privateKey *rsa.PrivateKey) ([]byte, error) {
+// DecryptStream decrypts an envelope read from src. Only hybrid streaming envelopes are decrypted without buffering.
+func (r *rsaProcessor) DecryptStream(src io.Reader, privateKey crypto.Decrypter, associatedData []byte) (io.Reader, error) {
 	if privateKey == nil {
 		return nil, fmt.Errorf("private key cannot be nil")
 	}
 
+	reader := NewEnvelopeReader(src)
+	envelope, err := PeekEnvelope(reader)
+	if err != nil {
+		return nil, fmt.Errorf("%w", err)
+	}
+
+	if envelope == nil || envelope.Mode != RSAModeOAEPHybridStream {
+		ciphertext, err := io.ReadAll(reader)
+		if err != nil {
+			return nil, fmt.Errorf("failed to read ciphertext: %w", err)
+		}
+		plainText, err := r.Decrypt(ciphertext, privateKey, associatedData)
+		if err != nil {
+			return nil, fmt.Errorf("%w", err)
+		}
+		return bytes.NewReader(plainText), nil
+	}
+
+	if envelope.Algorithm != EnvelopeAlgorithmRSA {
+		return nil, fmt.Errorf("envelope was produced with %s, not RSA", envelope.Algorithm)
+	}
+
+	if _, err := ReadEnvelopeHeader(reader); err != nil {
+		return nil, fmt.Errorf("%w", err)
+	}
+
+	return r.decryptHybridStream(reader, envelope, privateKey, associatedData)
+}
+
+// decryptHybridStream unwraps the DEK of a hybrid streaming envelope and returns a reader decrypting the segments read from src
+func (r *rsaProcessor) decryptHybridStream(src io.Reader, envelope *Envelope, privateKey crypto.Decrypter, associatedData []byte) (io.Reader, error) {
+	dek, err := privateKey.Decrypt(rand.Reader, envelope.WrappedDEK, &rsa.OAEPOptions{Hash: crypto.SHA256})
+	if err != nil {
+		return nil, fmt.Errorf("failed to unwrap DEK: %w", err)
+	}
+
+	plainTextReader, err := newStreamDecrypter(src, envelope, dek, associatedData)
+	if err != nil {
+		return nil, fmt.Errorf("%w", err)
+	}
+
+	return plainTextReader, nil
+}
+
+// decryptHybrid unwraps the DEK of a hybrid envelope with RSA-OAEP and decrypts the data with AES-GCM
+func (r *rsaProcessor) decryptHybrid(envelope *Envelope, privateKey crypto.Decrypter, associatedData []byte) ([]byte, error) {
+	dek, err := privateKey.Decrypt(rand.Reader, envelope.WrappedDEK, &rsa.OAEPOptions{Hash: crypto.SHA256})
+	if err != nil {
+		return nil, fmt.Errorf("failed to unwrap DEK: %w", err)
+	}
+
+	gcm, err := newGCM(dek)
+	if err != nil {
+		return nil, fmt.Errorf("%w", err)
+	}
+
+	if len(envelope.Nonce) != gcm.NonceSize() || len(envelope.Ciphertext) < gcm.Overhead() {
+		return nil, fmt.Errorf("ciphertext too short or invalid nonce size")
+	}
+
+	header, err := envelope.Header()
+	if err != nil {
+		return nil, fmt.Errorf("%w", err)
+	}
+
+	plainText, err := gcm.Open(nil, envelope.Nonce, envelope.Ciphertext, gcmAssociatedData(header, associatedData))
+	if err != nil {
+		return nil, fmt.Errorf("failed to authenticate ciphertext: %w", err)
+	}
+
+	r.logger.Info("RSA decryption succeeded")
+	return plainText, nil
+}
+
+// decryptPKCS1v15 decrypts chunks of RSA PKCS#1 v1.5 encrypted data, each the size of the key
+func (r *rsaProcessor) decryptPKCS1v15(ciphertext []byte, privateKey crypto.Decrypter) ([]byte, error) {
+	publicKey, ok := privateKey.Public().(*rsa.PublicKey)
+	if !ok {
+		return nil, fmt.Errorf("private key is not of type RSA")
+	}
+
 	// Maximum size for the decrypted data, which is the RSA key size
-	maxSize := privateKey.Size()
+	maxSize := publicKey.Size()
 
 	var decryptedData []byte
 	for len(ciphertext) > 0 {
@@ -107,7 +300,7 @@ func (r *rsaProcessor) Decrypt(ciphertext []byte, privateKey *rsa.PrivateKey) ([
 		}
 
 		// Decrypt the current chunk
-		decryptedChunk, err := rsa.DecryptPKCS1v15(rand.Reader, privateKey, ciphertext[:chunkSize])
+		decryptedChunk, err := privateKey.Decrypt(rand.Reader, ciphertext[:chunkSize], nil)
 		if err != nil {
 			return nil, fmt.Errorf("failed to decrypt data: %w", err)
 		}
@@ -132,8 +325,24 @@ func (r *rsaProcessor) Sign(data []byte, privateKey *rsa.PrivateKey) ([]byte, er
 	// Use the SHA-256 hash algorithm for signing
 	hashed := sha256.Sum256(data)
 
-	// Sign the hashed data with the private key
-	signature, err := rsa.SignPKCS1v15(rand.Reader, privateKey, crypto.SHA256, hashed[:])
+	return r.SignDigest(hashed[:], privateKey)
+}
+
+// SignDigest signs a SHA-256 digest with the private key, which performs the PKCS#1 v1.5 padding itself
+func (r *rsaProcessor) SignDigest(digest []byte, privateKey crypto.Signer) ([]byte, error) {
+	if privateKey == nil {
+		return nil, fmt.Errorf("private key cannot be nil")
+	}
+	if len(digest) != sha256.Size {
+		return nil, fmt.Errorf("invalid digest size %d, expected %d", len(digest), sha256.Size)
+	}
+
+	if _, ok := privateKey.Public().(*rsa.PublicKey); !ok {
+		return nil, fmt.Errorf("private key is not of type RSA")
+	}
+
+	// Sign the hashed data with the private key, crypto.SHA256 as options selects PKCS#1 v1.5 padding
+	signature, err := privateKey.Sign(rand.Reader, digest, crypto.SHA256)
 	if err != nil {
 		return nil, fmt.Errorf("failed to sign data: %w", err)
 	}
@@ -161,6 +370,24 @@ func (r *rsaProcessor) Verify(data []byte, signature []byte, publicKey *rsa.Publ
 	return true, nil
 }
 
+// VerifyDigest verifies the RSA signature of a SHA-256 digest with the public key
+func (r *rsaProcessor) VerifyDigest(digest []byte, signature []byte, publicKey *rsa.PublicKey) (bool, error) {
+	if publicKey == nil {
+		return false, fmt.Errorf("public key cannot be nil")
+	}
+	if len(digest) != sha256.Size {
+		return false, fmt.Errorf("invalid digest size %d, expected %d", len(digest), sha256.Size)
+	}
+
+	if err := rsa.VerifyPKCS1v15(publicKey, crypto.SHA256, digest, signature); err != nil {
+		r.logger.Info("RSA signature verification failed")
+		return false, nil
+	}
+
+	r.logger.Info("RSA signature verified successfully")
+	return true, nil
+}
+
 // SavePrivateKeyToFile saves the private key to a PEM file using encoding/pem
 func (r *rsaProcessor) SavePrivateKeyToFile(privateKey *rsa.PrivateKey, filename string) error {
 	privKeyBytes := x509.MarshalPKCS1PrivateKey(privateKey)
@@ -4,7 +4,9 @@
 package cryptography
 
 import (
+	"crypto/rand"
 	"crypto/rsa"
+	"crypto/sha256"
 	"crypto_vault_service/internal/infrastructure/logger"
 	"crypto_vault_service/internal/infrastructure/settings"
 	"log"
@@ -56,9 +58,90 @@ func (rt *RSAProcessorTests) TestEncryptDecrypt(t *testing.T) {
 	assert.NoError(t, err)
 
 	plainText := []byte("This is a secret message")
-	encrypted, err := rt.processor.Encrypt(plainText, publicKey)
+	encrypted, err := rt.processor.Encrypt(plainText, publicKey, "key-pair-id", 0, nil)
 	assert.NoError(t, err)
-	decrypted, err := rt.processor.Decrypt(encrypted, privateKey)
+	decrypted, err := rt.processor.Decrypt(encrypted, privateKey, nil)
+	assert.NoError(t, err)
+	assert.Equal(t, plainText, decrypted)
+
+	envelope, err := ParseEnvelope(encrypted)
+	assert.NoError(t, err)
+	assert.Equal(t, EnvelopeAlgorithmRSA, envelope.Algorithm)
+	assert.Equal(t, RSAModeOAEPHybrid, envelope.Mode)
+	assert.Equal(t, "key-pair-id", envelope.KeyID)
+	assert.Len(t, envelope.WrappedDEK, privateKey.Size())
+}
+
+func (rt *RSAProcessorTests) TestEncryptDecryptLargePlaintext(t *testing.T) {
+	privateKey, publicKey, err := rt.processor.GenerateKeys(2048)
+	assert.NoError(t, err)
+
+	plainText := make([]byte, 1<<20)
+	_, err = rand.Read(plainText)
+	assert.NoError(t, err)
+
+	encrypted, err := rt.processor.Encrypt(plainText, publicKey, "key-pair-id", 0, nil)
+	assert.NoError(t, err)
+	// Hybrid encryption only adds a constant overhead instead of inflating every chunk
+	assert.Less(t, len(encrypted), len(plainText)+1024)
+
+	decrypted, err := rt.processor.Decrypt(encrypted, privateKey, nil)
+	assert.NoError(t, err)
+	assert.Equal(t, plainText, decrypted)
+}
+
+func (rt *RSAProcessorTests) TestDecryptWithAssociatedData(t *testing.T) {
+	privateKey, publicKey, err := rt.processor.GenerateKeys(2048)
+	assert.NoError(t, err)
+
+	plainText := []byte("This is a secret message")
+	associatedData := []byte("blob-id|user-id")
+
+	encrypted, err := rt.processor.Encrypt(plainText, publicKey, "key-pair-id", 0, associatedData)
+	assert.NoError(t, err)
+
+	decrypted, err := rt.processor.Decrypt(encrypted, privateKey, associatedData)
+	assert.NoError(t, err)
+	assert.Equal(t, plainText, decrypted)
+
+	_, err = rt.processor.Decrypt(encrypted, privateKey, []byte("other-blob-id|user-id"))
+	assert.Error(t, err, "Decryption with different associated data must fail")
+}
+
+func (rt *RSAProcessorTests) TestDecryptLegacyPKCS1v15Envelope(t *testing.T) {
+	privateKey, publicKey, err := rt.processor.GenerateKeys(2048)
+	assert.NoError(t, err)
+
+	plainText := []byte("This is a legacy secret message")
+
+	encryptedChunk, err := rsa.EncryptPKCS1v15(rand.Reader, publicKey, plainText)
+	assert.NoError(t, err)
+
+	envelope := &Envelope{
+		Algorithm:  EnvelopeAlgorithmRSA,
+		Mode:       RSAModePKCS1v15,
+		KeyID:      "key-pair-id",
+		Ciphertext: encryptedChunk,
+	}
+	encrypted, err := envelope.Marshal()
+	assert.NoError(t, err)
+
+	decrypted, err := rt.processor.Decrypt(encrypted, privateKey, nil)
+	assert.NoError(t, err)
+	assert.Equal(t, plainText, decrypted)
+}
+
+func (rt *RSAProcessorTests) TestDecryptLegacyCiphertext(t *testing.T) {
+	privateKey, publicKey, err := rt.processor.GenerateKeys(2048)
+	assert.NoError(t, err)
+
+	plainText := []byte("This is a legacy secret message")
+
+	// Header-less chunk as produced before envelopes were introduced
+	encrypted, err := rsa.EncryptPKCS1v15(rand.Reader, publicKey, plainText)
+	assert.NoError(t, err)
+
+	decrypted, err := rt.processor.Decrypt(encrypted, privateKey, nil)
 	assert.NoError(t, err)
 	assert.Equal(t, plainText, decrypted)
 }
@@ -92,13 +175,13 @@ func (rt *RSAProcessorTests) TestEncryptWithInvalidKey(t *testing.T) {
 	assert.NoError(t, err)
 
 	plainText := []byte("This should fail encryption")
-	encrypted, err := rt.processor.Encrypt(plainText, publicKey)
+	encrypted, err := rt.processor.Encrypt(plainText, publicKey, "key-pair-id", 0, nil)
 	assert.NoError(t, err)
 
 	wrongPrivKey, _, err := rt.processor.GenerateKeys(2048)
 	assert.NoError(t, err)
 
-	_, err = rt.processor.Decrypt(encrypted, wrongPrivKey)
+	_, err = rt.processor.Decrypt(encrypted, wrongPrivKey, nil)
 	assert.Error(t, err)
 }
 
@@ -137,14 +220,45 @@ func (rt *RSAProcessorTests) TestSignAndVerify(t *testing.T) {
 	assert.False(t, valid)
 }
 
+func (rt *RSAProcessorTests) TestSignAndVerifyDigest(t *testing.T) {
+	privateKey, publicKey, err := rt.processor.GenerateKeys(2048)
+	assert.NoError(t, err)
+
+	data := []byte("This is a test message")
+	digest := sha256.Sum256(data)
+	signature, err := rt.processor.SignDigest(digest[:], privateKey)
+	assert.NoError(t, err)
+
+	valid, err := rt.processor.Verify(data, signature, publicKey)
+	assert.NoError(t, err)
+	assert.True(t, valid)
+
+	valid, err = rt.processor.VerifyDigest(digest[:], signature, publicKey)
+	assert.NoError(t, err)
+	assert.True(t, valid)
+
+	tampered := sha256.Sum256([]byte("This is a tampered message"))
+	valid, err = rt.processor.VerifyDigest(tampered[:], signature, publicKey)
+	assert.NoError(t, err)
+	assert.False(t, valid)
+
+	_, err = rt.processor.SignDigest(data, privateKey)
+	assert.Error(t, err)
+}
+
 func TestRSAProcessor(t *testing.T) {
 	rt := NewRSAProcessorTests(t)
 
 	t.Run("TestGenerateRSAKeys", rt.TestGenerateRSAKeys)
 	t.Run("TestEncryptDecrypt", rt.TestEncryptDecrypt)
+	t.Run("TestEncryptDecryptLargePlaintext", rt.TestEncryptDecryptLargePlaintext)
+	t.Run("TestDecryptWithAssociatedData", rt.TestDecryptWithAssociatedData)
+	t.Run("TestDecryptLegacyCiphertext", rt.TestDecryptLegacyCiphertext)
+	t.Run("TestDecryptLegacyPKCS1v15Envelope", rt.TestDecryptLegacyPKCS1v15Envelope)
 	t.Run("TestSaveAndReadKeys", rt.TestSaveAndReadKeys)
 	t.Run("TestEncryptWithInvalidKey", rt.TestEncryptWithInvalidKey)
 	t.Run("TestSavePrivateKeyInvalidPath", rt.TestSavePrivateKeyInvalidPath)
 	t.Run("TestSavePublicKeyInvalidPath", rt.TestSavePublicKeyInvalidPath)
 	t.Run("TestSignAndVerify", rt.TestSignAndVerify)
+	t.Run("TestSignAndVerifyDigest", rt.TestSignAndVerifyDigest)
 }
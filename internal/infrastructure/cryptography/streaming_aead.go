@@ -0,0 +1,225 @@
+package cryptography
+
+import (
+	"bufio"
+	"crypto/cipher"
+	"crypto/rand"
+	"encoding/binary"
+	"errors"
+	"fmt"
+	"io"
+	"math"
+)
+
+// streamSegmentSize is the plaintext size of all but the last segment of a streaming ciphertext
+const streamSegmentSize = 64 * 1024
+
+// streamNoncePrefixSize is the size of the random nonce prefix stored in the envelope.
+// Segment nonces append a 4 byte segment counter and a 1 byte last segment flag to it, yielding 12 byte GCM nonces.
+const streamNoncePrefixSize = 7
+
+// streamDEKSize is the size in bytes of the AES-256 data encryption key generated for each stream
+const streamDEKSize = 32
+
+// newStreamDEK generates a random data encryption key for a single stream
+func newStreamDEK() ([]byte, error) {
+	dek := make([]byte, streamDEKSize)
+	if _, err := rand.Read(dek); err != nil {
+		return nil, fmt.Errorf("failed to generate DEK: %w", err)
+	}
+	return dek, nil
+}
+
+// newStreamEncrypter writes the envelope header to dst and returns a writer encrypting plaintext segment by segment with the DEK.
+// The envelope must be complete except for its nonce, which is generated. Close must be called to write the last segment.
+func newStreamEncrypter(dst io.Writer, envelope *Envelope, dek, associatedData []byte) (io.WriteCloser, error) {
+	gcm, err := newGCM(dek)
+	if err != nil {
+		return nil, fmt.Errorf("%w", err)
+	}
+
+	envelope.Nonce = make([]byte, streamNoncePrefixSize)
+	if _, err := rand.Read(envelope.Nonce); err != nil {
+		return nil, fmt.Errorf("failed to read random bytes for nonce prefix: %w", err)
+	}
+
+	header, err := envelope.Header()
+	if err != nil {
+		return nil, fmt.Errorf("%w", err)
+	}
+
+	if _, err := dst.Write(header); err != nil {
+		return nil, fmt.Errorf("failed to write envelope header: %w", err)
+	}
+
+	return &segmentWriter{
+		dst:            dst,
+		aead:           gcm,
+		noncePrefix:    envelope.Nonce,
+		associatedData: gcmAssociatedData(header, associatedData),
+		buffer:         make([]byte, 0, streamSegmentSize+1),
+	}, nil
+}
+
+// newStreamDecrypter returns a reader decrypting and authenticating the segments following the envelope header in src
+func newStreamDecrypter(src io.Reader, envelope *Envelope, dek, associatedData []byte) (io.Reader, error) {
+	gcm, err := newGCM(dek)
+	if err != nil {
+		return nil, fmt.Errorf("%w", err)
+	}
+
+	if len(envelope.Nonce) != streamNoncePrefixSize {
+		return nil, fmt.Errorf("invalid nonce prefix size %d", len(envelope.Nonce))
+	}
+
+	header, err := envelope.Header()
+	if err != nil {
+		return nil, fmt.Errorf("%w", err)
+	}
+
+	return &segmentReader{
+		src:            bufio.NewReader(src),
+		aead:           gcm,
+		noncePrefix:    envelope.Nonce,
+		associatedData: gcmAssociatedData(header, associatedData),
+		segment:        make([]byte, streamSegmentSize+gcm.Overhead()),
+	}, nil
+}
+
+// segmentNonce derives the nonce of a segment from the nonce prefix, the segment counter and whether it is the last segment.
+// Binding the position and the last segment flag prevents reordering, truncating and extending streams.
+func segmentNonce(noncePrefix []byte, counter uint32, last bool) []byte {
+	nonce := make([]byte, 0, streamNoncePrefixSize+5)
+	nonce = append(nonce, noncePrefix...)
+	nonce = binary.BigEndian.AppendUint32(nonce, counter)
+	if last {
+		return append(nonce, 1)
+	}
+	return append(nonce, 0)
+}
+
+// segmentWriter encrypts written plaintext in segments of streamSegmentSize.
+// A full segment is held back until more data arrives, since the last segment is sealed differently.
+type segmentWriter struct {
+	dst            io.Writer
+	aead           cipher.AEAD
+	noncePrefix    []byte
+	associatedData []byte
+	buffer         []byte
+	counter        uint32
+	closed         bool
+}
+
+// Write buffers the plaintext and writes all segments known not to be the last
+func (w *segmentWriter) Write(p []byte) (int, error) {
+	if w.closed {
+		return 0, errors.New("write to closed stream encrypter")
+	}
+
+	written := 0
+	for len(p) > 0 {
+		n := streamSegmentSize + 1 - len(w.buffer)
+		if n > len(p) {
+			n = len(p)
+		}
+		w.buffer = append(w.buffer, p[:n]...)
+		p = p[n:]
+		written += n
+
+		if len(w.buffer) > streamSegmentSize {
+			if err := w.writeSegment(w.buffer[:streamSegmentSize], false); err != nil {
+				return written, err
+			}
+			w.buffer = append(w.buffer[:0], w.buffer[streamSegmentSize:]...)
+		}
+	}
+
+	return written, nil
+}
+
+// Close writes the remaining plaintext as last segment
+func (w *segmentWriter) Close() error {
+	if w.closed {
+		return nil
+	}
+	w.closed = true
+
+	return w.writeSegment(w.buffer, true)
+}
+
+// writeSegment seals and writes a single segment
+func (w *segmentWriter) writeSegment(plainText []byte, last bool) error {
+	if w.counter == math.MaxUint32 {
+		return errors.New("stream exceeds the maximum number of segments")
+	}
+
+	ciphertext := w.aead.Seal(nil, segmentNonce(w.noncePrefix, w.counter, last), plainText, w.associatedData)
+	if _, err := w.dst.Write(ciphertext); err != nil {
+		return fmt.Errorf("failed to write segment %d: %w", w.counter, err)
+	}
+	w.counter++
+
+	return nil
+}
+
+// segmentReader decrypts and authenticates segments written by segmentWriter
+type segmentReader struct {
+	src            *bufio.Reader
+	aead           cipher.AEAD
+	noncePrefix    []byte
+	associatedData []byte
+	segment        []byte
+	plainText      []byte
+	counter        uint32
+	done           bool
+}
+
+// Read returns decrypted plaintext. Plaintext is only released once its segment is authenticated.
+func (r *segmentReader) Read(p []byte) (int, error) {
+	for len(r.plainText) == 0 {
+		if r.done {
+			return 0, io.EOF
+		}
+		if err := r.readSegment(); err != nil {
+			return 0, err
+		}
+	}
+
+	n := copy(p, r.plainText)
+	r.plainText = r.plainText[n:]
+	return n, nil
+}
+
+// readSegment reads, authenticates and decrypts the next segment
+func (r *segmentReader) readSegment() error {
+	n, err := io.ReadFull(r.src, r.segment)
+	if err != nil && !errors.Is(err, io.ErrUnexpectedEOF) && !errors.Is(err, io.EOF) {
+		return fmt.Errorf("failed to read segment %d: %w", r.counter, err)
+	}
+
+	// The last segment is the one not followed by further data
+	last := err != nil
+	if !last {
+		if _, peekErr := r.src.Peek(1); errors.Is(peekErr, io.EOF) {
+			last = true
+		}
+	}
+
+	if n < r.aead.Overhead() {
+		return errors.New("ciphertext stream is truncated")
+	}
+	if r.counter == math.MaxUint32 {
+		return errors.New("stream exceeds the maximum number of segments")
+	}
+
+	plainText, err := r.aead.Open(nil, segmentNonce(r.noncePrefix, r.counter, last), r.segment[:n], r.associatedData)
+	if err != nil {
+		return fmt.Errorf("failed to authenticate segment %d: %w", r.counter, err)
+	}
+
+	r.plainText = plainText
+	r.counter++
+	r.done = last
+
+	return nil
+}
@@ -0,0 +1,188 @@
+//go:build unit
+// +build unit
+
+package cryptography
+
+import (
+	"bytes"
+	"crypto/rand"
+	"io"
+	"log"
+	"testing"
+
+	"crypto_vault_service/internal/infrastructure/logger"
+	"crypto_vault_service/internal/infrastructure/settings"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// StreamingAEADTests encapsulates streaming encryption test cases
+type StreamingAEADTests struct {
+	aesProcessor AESProcessor
+	rsaProcessor RSAProcessor
+}
+
+// NewStreamingAEADTests creates a new instance of StreamingAEADTests
+func NewStreamingAEADTests(t *testing.T) *StreamingAEADTests {
+	loggerSettings := &settings.LoggerSettings{
+		LogLevel: "info",
+		LogType:  "console",
+		FilePath: "",
+	}
+
+	logInstance, err := logger.GetLogger(loggerSettings)
+	if err != nil {
+		log.Fatalf("Error creating logger: %v", err)
+	}
+
+	aesProcessor, err := NewAESProcessor(logInstance)
+	if err != nil {
+		t.Fatalf("Failed to create AES processor: %v", err)
+	}
+
+	rsaProcessor, err := NewRSAProcessor(logInstance)
+	if err != nil {
+		t.Fatalf("Failed to create RSA processor: %v", err)
+	}
+
+	return &StreamingAEADTests{
+		aesProcessor: aesProcessor,
+		rsaProcessor: rsaProcessor,
+	}
+}
+
+// encryptAESStream encrypts the plaintext with AES-GCM-STREAM, writing it in small pieces
+func (st *StreamingAEADTests) encryptAESStream(t *testing.T, plainText, key, associatedData []byte) []byte {
+	var ciphertext bytes.Buffer
+
+	writer, err := st.aesProcessor.EncryptStream(&ciphertext, key, "key-id", 0, associatedData)
+	require.NoError(t, err)
+
+	for remaining := plainText; len(remaining) > 0; {
+		n := 1000
+		if n > len(remaining) {
+			n = len(remaining)
+		}
+		_, err := writer.Write(remaining[:n])
+		require.NoError(t, err)
+		remaining = remaining[n:]
+	}
+	require.NoError(t, writer.Close())
+
+	return ciphertext.Bytes()
+}
+
+func (st *StreamingAEADTests) TestAESStreamRoundTrip(t *testing.T) {
+	key, err := st.aesProcessor.GenerateKey(32)
+	require.NoError(t, err)
+
+	for _, size := range []int{0, 1, streamSegmentSize - 1, streamSegmentSize, streamSegmentSize + 1, 3*streamSegmentSize + 17} {
+		plainText := make([]byte, size)
+		_, err := rand.Read(plainText)
+		require.NoError(t, err)
+
+		ciphertext := st.encryptAESStream(t, plainText, key, []byte("blob-id|user-id"))
+		assert.Equal(t, AESModeGCMStream, st.aesProcessor.DetectMode(ciphertext))
+
+		reader, err := st.aesProcessor.DecryptStream(bytes.NewReader(ciphertext), key, []byte("blob-id|user-id"))
+		require.NoError(t, err)
+		decrypted, err := io.ReadAll(reader)
+		require.NoError(t, err, "size %d", size)
+		assert.Equal(t, plainText, decrypted, "size %d", size)
+
+		decrypted, err = st.aesProcessor.Decrypt(ciphertext, key, []byte("blob-id|user-id"))
+		require.NoError(t, err, "size %d", size)
+		assert.Equal(t, plainText, decrypted, "size %d", size)
+	}
+}
+
+func (st *StreamingAEADTests) TestAESStreamDetectsTruncation(t *testing.T) {
+	key, err := st.aesProcessor.GenerateKey(32)
+	require.NoError(t, err)
+
+	plainText := make([]byte, 2*streamSegmentSize+100)
+	ciphertext := st.encryptAESStream(t, plainText, key, nil)
+
+	// Dropping the last segment leaves a stream ending in a segment not sealed as last
+	envelope, err := ParseEnvelope(ciphertext)
+	require.NoError(t, err)
+	truncated := ciphertext[:len(ciphertext)-len(envelope.Ciphertext)+2*(streamSegmentSize+16)]
+
+	reader, err := st.aesProcessor.DecryptStream(bytes.NewReader(truncated), key, nil)
+	require.NoError(t, err)
+	_, err = io.ReadAll(reader)
+	assert.Error(t, err)
+}
+
+func (st *StreamingAEADTests) TestAESStreamDetectsTampering(t *testing.T) {
+	key, err := st.aesProcessor.GenerateKey(32)
+	require.NoError(t, err)
+
+	plainText := make([]byte, 2*streamSegmentSize)
+	ciphertext := st.encryptAESStream(t, plainText, key, nil)
+	ciphertext[len(ciphertext)-streamSegmentSize] ^= 0xff
+
+	reader, err := st.aesProcessor.DecryptStream(bytes.NewReader(ciphertext), key, nil)
+	require.NoError(t, err)
+	_, err = io.ReadAll(reader)
+	assert.Error(t, err)
+
+	reader, err = st.aesProcessor.DecryptStream(bytes.NewReader(st.encryptAESStream(t, plainText, key, []byte("blob-id|user-id"))), key, []byte("other-blob-id|user-id"))
+	require.NoError(t, err)
+	_, err = io.ReadAll(reader)
+	assert.Error(t, err, "Decryption with different associated data must fail")
+}
+
+func (st *StreamingAEADTests) TestAESDecryptStreamOfNonStreamingEnvelope(t *testing.T) {
+	key, err := st.aesProcessor.GenerateKey(32)
+	require.NoError(t, err)
+
+	plainText := []byte("This is a test message.")
+	ciphertext, err := st.aesProcessor.Encrypt(plainText, key, "key-id", 0, nil)
+	require.NoError(t, err)
+
+	reader, err := st.aesProcessor.DecryptStream(bytes.NewReader(ciphertext), key, nil)
+	require.NoError(t, err)
+	decrypted, err := io.ReadAll(reader)
+	require.NoError(t, err)
+	assert.Equal(t, plainText, decrypted)
+}
+
+func (st *StreamingAEADTests) TestRSAStreamRoundTrip(t *testing.T) {
+	privateKey, publicKey, err := st.rsaProcessor.GenerateKeys(2048)
+	require.NoError(t, err)
+
+	plainText := make([]byte, 2*streamSegmentSize+5)
+	_, err = rand.Read(plainText)
+	require.NoError(t, err)
+
+	var ciphertext bytes.Buffer
+	writer, err := st.rsaProcessor.EncryptStream(&ciphertext, publicKey, "key-pair-id", 0, nil)
+	require.NoError(t, err)
+	_, err = writer.Write(plainText)
+	require.NoError(t, err)
+	require.NoError(t, writer.Close())
+
+	envelope, err := ParseEnvelope(ciphertext.Bytes())
+	require.NoError(t, err)
+	assert.Equal(t, RSAModeOAEPHybridStream, envelope.Mode)
+	assert.Equal(t, "key-pair-id", envelope.KeyID)
+
+	reader, err := st.rsaProcessor.DecryptStream(bytes.NewReader(ciphertext.Bytes()), privateKey, nil)
+	require.NoError(t, err)
+	decrypted, err := io.ReadAll(reader)
+	require.NoError(t, err)
+	assert.Equal(t, plainText, decrypted)
+}
+
+// Entry point to run StreamingAEADTests
+func TestStreamingAEAD(t *testing.T) {
+	tests := NewStreamingAEADTests(t)
+
+	t.Run("TestAESStreamRoundTrip", tests.TestAESStreamRoundTrip)
+	t.Run("TestAESStreamDetectsTruncation", tests.TestAESStreamDetectsTruncation)
+	t.Run("TestAESStreamDetectsTampering", tests.TestAESStreamDetectsTampering)
+	t.Run("TestAESDecryptStreamOfNonStreamingEnvelope", tests.TestAESDecryptStreamOfNonStreamingEnvelope)
+	t.Run("TestRSAStreamRoundTrip", tests.TestRSAStreamRoundTrip)
+}
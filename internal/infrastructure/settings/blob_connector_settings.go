@@ -6,14 +6,23 @@ import (
 	"github.com/go-playground/validator/v10"
 )
 
-// BlobConnectorSettings holds configuration settings for connecting to a blob storage service
+// BlobConnectorSettings holds configuration settings for connecting to a blob storage service.
+// Which fields are required depends on the cloud provider: azure, filesystem or s3.
+// For s3 the endpoint is optional and only needs to be set for S3-compatible services like MinIO.
 type BlobConnectorSettings struct {
 	CloudProvider    string `mapstructure:"cloud_provider" validate:"required"`
-	ConnectionString string `mapstructure:"connection_string" validate:"required"`
-	ContainerName    string `mapstructure:"container_name" validate:"required"`
+	ConnectionString string `mapstructure:"connection_string" validate:"required_if=CloudProvider azure"`
+	ContainerName    string `mapstructure:"container_name" validate:"required_if=CloudProvider azure"`
+	RootPath         string `mapstructure:"root_path" validate:"required_if=CloudProvider filesystem"`
+	Endpoint         string `mapstructure:"endpoint" validate:"omitempty,url"`
+	Region           string `mapstructure:"region" validate:"required_if=CloudProvider s3"`
+	Bucket           string `mapstructure:"bucket" validate:"required_if=CloudProvider s3"`
+	UsePathStyle     bool   `mapstructure:"use_path_style"`
+	AccessKeyID      string `mapstructure:"access_key_id" validate:"required_if=CloudProvider s3"`
+	SecretAccessKey  string `mapstructure:"secret_access_key" validate:"required_if=CloudProvider s3"`
 }
 
-// Validate checks that all fields in BlobConnectorSettings are valid (non-empty in this case)
+// Validate checks that all fields in BlobConnectorSettings are valid
 func (settings *BlobConnectorSettings) Validate() error {
 	validate := validator.New()
 
@@ -32,7 +32,7 @@ func TestBlobConnectorSettingsValidation(t *testing.T) {
 		{
 			name: "missing connection string",
 			settings: &BlobConnectorSettings{
-				CloudProvider: "aws",
+				CloudProvider: "azure",
 				ContainerName: "container_name",
 			},
 			expectedError: true,
@@ -40,11 +40,61 @@ func TestBlobConnectorSettingsValidation(t *testing.T) {
 		{
 			name: "missing container name",
 			settings: &BlobConnectorSettings{
-				CloudProvider:    "aws",
+				CloudProvider:    "azure",
 				ConnectionString: "some_connection_string",
 			},
 			expectedError: true,
 		},
+		{
+			name: "valid filesystem settings",
+			settings: &BlobConnectorSettings{
+				CloudProvider: "filesystem",
+				RootPath:      "/var/lib/crypto-vault/blobs",
+			},
+			expectedError: false,
+		},
+		{
+			name: "missing root path for filesystem",
+			settings: &BlobConnectorSettings{
+				CloudProvider: "filesystem",
+			},
+			expectedError: true,
+		},
+		{
+			name: "valid s3 settings",
+			settings: &BlobConnectorSettings{
+				CloudProvider:   "s3",
+				Endpoint:        "http://127.0.0.1:9000",
+				Region:          "us-east-1",
+				Bucket:          "blobs",
+				UsePathStyle:    true,
+				AccessKeyID:     "minioadmin",
+				SecretAccessKey: "minioadmin",
+			},
+			expectedError: false,
+		},
+		{
+			name: "missing bucket for s3",
+			settings: &BlobConnectorSettings{
+				CloudProvider:   "s3",
+				Region:          "us-east-1",
+				AccessKeyID:     "minioadmin",
+				SecretAccessKey: "minioadmin",
+			},
+			expectedError: true,
+		},
+		{
+			name: "invalid endpoint for s3",
+			settings: &BlobConnectorSettings{
+				CloudProvider:   "s3",
+				Endpoint:        "not a url",
+				Region:          "us-east-1",
+				Bucket:          "blobs",
+				AccessKeyID:     "minioadmin",
+				SecretAccessKey: "minioadmin",
+			},
+			expectedError: true,
+		},
 		{
 			name: "empty fields",
 			settings: &BlobConnectorSettings{
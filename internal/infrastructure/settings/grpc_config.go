@@ -9,13 +9,18 @@ import (
 
 // GrpcConfig struct holds the overall configuration with separate settings for Blob, Key, Logger, and PKCS#11
 type GrpcConfig struct {
-	Database      DatabaseSettings      `mapstructure:"database"`
-	BlobConnector BlobConnectorSettings `mapstructure:"blob_connector"`
-	KeyConnector  KeyConnectorSettings  `mapstructure:"key_connector"`
-	Logger        LoggerSettings        `mapstructure:"logger"`
-	PKCS11        PKCS11Settings        `mapstructure:"pkcs11"`
-	Port          string                `mapstructure:"port"`
-	GatewayPort   string                `mapstructure:"gateway_port"`
+	Database       DatabaseSettings       `mapstructure:"database"`
+	BlobConnector  BlobConnectorSettings  `mapstructure:"blob_connector"`
+	KeyConnector   KeyConnectorSettings   `mapstructure:"key_connector"`
+	Logger         LoggerSettings         `mapstructure:"logger"`
+	PKCS11         PKCS11Settings         `mapstructure:"pkcs11"`
+	MasterKey      MasterKeySettings      `mapstructure:"master_key"`
+	JWT            JWTSettings            `mapstructure:"jwt"`
+	KeyDestruction KeyDestructionSettings `mapstructure:"key_destruction"`
+	KeyRotation    KeyRotationSettings    `mapstructure:"key_rotation"`
+	KeyExport      KeyExportSettings      `mapstructure:"key_export"`
+	Port           string                 `mapstructure:"port"`
+	GatewayPort    string                 `mapstructure:"gateway_port"`
 }
 
 // InitializeGrpcConfig function to read the config, prioritize environment variables and fall back to config file
@@ -53,6 +58,27 @@ func InitializeGrpcConfig(path string) (*GrpcConfig, error) {
 		if blobContainerName := viper.GetString("BLOB_CONNECTOR_CONTAINER_NAME"); blobContainerName != "" {
 			config.BlobConnector.ContainerName = blobContainerName
 		}
+		if blobRootPath := viper.GetString("BLOB_CONNECTOR_ROOT_PATH"); blobRootPath != "" {
+			config.BlobConnector.RootPath = blobRootPath
+		}
+		if blobEndpoint := viper.GetString("BLOB_CONNECTOR_ENDPOINT"); blobEndpoint != "" {
+			config.BlobConnector.Endpoint = blobEndpoint
+		}
+		if blobRegion := viper.GetString("BLOB_CONNECTOR_REGION"); blobRegion != "" {
+			config.BlobConnector.Region = blobRegion
+		}
+		if blobBucket := viper.GetString("BLOB_CONNECTOR_BUCKET"); blobBucket != "" {
+			config.BlobConnector.Bucket = blobBucket
+		}
+		if blobAccessKeyID := viper.GetString("BLOB_CONNECTOR_ACCESS_KEY_ID"); blobAccessKeyID != "" {
+			config.BlobConnector.AccessKeyID = blobAccessKeyID
+		}
+		if blobSecretAccessKey := viper.GetString("BLOB_CONNECTOR_SECRET_ACCESS_KEY"); blobSecretAccessKey != "" {
+			config.BlobConnector.SecretAccessKey = blobSecretAccessKey
+		}
+		if viper.IsSet("BLOB_CONNECTOR_USE_PATH_STYLE") {
+			config.BlobConnector.UsePathStyle = viper.GetBool("BLOB_CONNECTOR_USE_PATH_STYLE")
+		}
 
 		if keyCloudProvider := viper.GetString("KEY_CONNECTOR_CLOUD_PROVIDER"); keyCloudProvider != "" {
 			config.KeyConnector.CloudProvider = keyCloudProvider
@@ -63,6 +89,30 @@ func InitializeGrpcConfig(path string) (*GrpcConfig, error) {
 		if keyContainerName := viper.GetString("KEY_CONNECTOR_CONTAINER_NAME"); keyContainerName != "" {
 			config.KeyConnector.ContainerName = keyContainerName
 		}
+		if keyRootPath := viper.GetString("KEY_CONNECTOR_ROOT_PATH"); keyRootPath != "" {
+			config.KeyConnector.RootPath = keyRootPath
+		}
+		if keyEndpoint := viper.GetString("KEY_CONNECTOR_ENDPOINT"); keyEndpoint != "" {
+			config.KeyConnector.Endpoint = keyEndpoint
+		}
+		if keyRegion := viper.GetString("KEY_CONNECTOR_REGION"); keyRegion != "" {
+			config.KeyConnector.Region = keyRegion
+		}
+		if keyBucket := viper.GetString("KEY_CONNECTOR_BUCKET"); keyBucket != "" {
+			config.KeyConnector.Bucket = keyBucket
+		}
+		if keyAccessKeyID := viper.GetString("KEY_CONNECTOR_ACCESS_KEY_ID"); keyAccessKeyID != "" {
+			config.KeyConnector.AccessKeyID = keyAccessKeyID
+		}
+		if keySecretAccessKey := viper.GetString("KEY_CONNECTOR_SECRET_ACCESS_KEY"); keySecretAccessKey != "" {
+			config.KeyConnector.SecretAccessKey = keySecretAccessKey
+		}
+		if viper.IsSet("KEY_CONNECTOR_USE_PATH_STYLE") {
+			config.KeyConnector.UsePathStyle = viper.GetBool("KEY_CONNECTOR_USE_PATH_STYLE")
+		}
+		if keyTokenLabel := viper.GetString("KEY_CONNECTOR_TOKEN_LABEL"); keyTokenLabel != "" {
+			config.KeyConnector.TokenLabel = keyTokenLabel
+		}
 
 		if logLevel := viper.GetString("LOGGER_LOG_LEVEL"); logLevel != "" {
 			config.Logger.LogLevel = logLevel
@@ -86,6 +136,59 @@ func InitializeGrpcConfig(path string) (*GrpcConfig, error) {
 		if pkcs11SlotID := viper.GetString("PKCS11_SLOT_ID"); pkcs11SlotID != "" {
 			config.PKCS11.SlotID = pkcs11SlotID
 		}
+
+		if masterKeySource := viper.GetString("MASTER_KEY_SOURCE"); masterKeySource != "" {
+			config.MasterKey.Source = masterKeySource
+		}
+		if masterKeyDir := viper.GetString("MASTER_KEY_DIR"); masterKeyDir != "" {
+			config.MasterKey.Dir = masterKeyDir
+		}
+		if masterKeyTokenLabel := viper.GetString("MASTER_KEY_TOKEN_LABEL"); masterKeyTokenLabel != "" {
+			config.MasterKey.TokenLabel = masterKeyTokenLabel
+		}
+		if masterKeyObjectLabel := viper.GetString("MASTER_KEY_OBJECT_LABEL"); masterKeyObjectLabel != "" {
+			config.MasterKey.ObjectLabel = masterKeyObjectLabel
+		}
+		if masterKeyKeyType := viper.GetString("MASTER_KEY_KEY_TYPE"); masterKeyKeyType != "" {
+			config.MasterKey.KeyType = masterKeyKeyType
+		}
+
+		if jwtJWKSFile := viper.GetString("JWT_JWKS_FILE"); jwtJWKSFile != "" {
+			config.JWT.JWKSFile = jwtJWKSFile
+		}
+		if jwtJWKSURL := viper.GetString("JWT_JWKS_URL"); jwtJWKSURL != "" {
+			config.JWT.JWKSURL = jwtJWKSURL
+		}
+		if jwtIssuer := viper.GetString("JWT_ISSUER"); jwtIssuer != "" {
+			config.JWT.Issuer = jwtIssuer
+		}
+		if jwtAudience := viper.GetString("JWT_AUDIENCE"); jwtAudience != "" {
+			config.JWT.Audience = jwtAudience
+		}
+		if jwtRolesClaim := viper.GetString("JWT_ROLES_CLAIM"); jwtRolesClaim != "" {
+			config.JWT.RolesClaim = jwtRolesClaim
+		}
+		if jwtGroupsClaim := viper.GetString("JWT_GROUPS_CLAIM"); jwtGroupsClaim != "" {
+			config.JWT.GroupsClaim = jwtGroupsClaim
+		}
+		if jwtAdminRole := viper.GetString("JWT_ADMIN_ROLE"); jwtAdminRole != "" {
+			config.JWT.AdminRole = jwtAdminRole
+		}
+
+		if viper.IsSet("KEY_DESTRUCTION_GRACE_PERIOD") {
+			config.KeyDestruction.GracePeriod = viper.GetDuration("KEY_DESTRUCTION_GRACE_PERIOD")
+		}
+		if viper.IsSet("KEY_DESTRUCTION_INTERVAL") {
+			config.KeyDestruction.Interval = viper.GetDuration("KEY_DESTRUCTION_INTERVAL")
+		}
+
+		if viper.IsSet("KEY_ROTATION_INTERVAL") {
+			config.KeyRotation.Interval = viper.GetDuration("KEY_ROTATION_INTERVAL")
+		}
+
+		if keyExportTrustedWrappingKeyFiles := viper.GetString("KEY_EXPORT_TRUSTED_WRAPPING_KEY_FILES"); keyExportTrustedWrappingKeyFiles != "" {
+			config.KeyExport.TrustedWrappingKeyFiles = strings.Split(keyExportTrustedWrappingKeyFiles, ",")
+		}
 	} else {
 		if err := viper.ReadInConfig(); err != nil {
 			return nil, fmt.Errorf("unable to read config file, %w", err)
@@ -0,0 +1,32 @@
+package settings
+
+import (
+	"fmt"
+
+	"github.com/go-playground/validator/v10"
+)
+
+// JWTSettings holds the configuration settings for validating the JWTs authenticating callers.
+// The signing keys are read from a JWKS, either a local file or a URL such as the identity provider's jwks_uri.
+// Callers whose roles claim contains the admin role are granted access to the blobs and keys of all users.
+// The groups claim lists the groups of the caller, which obtain the access blobs were shared with.
+type JWTSettings struct {
+	JWKSFile    string `mapstructure:"jwks_file" validate:"required_without=JWKSURL,excluded_with=JWKSURL"`
+	JWKSURL     string `mapstructure:"jwks_url" validate:"required_without=JWKSFile,omitempty,url"`
+	Issuer      string `mapstructure:"issuer" validate:"required"`
+	Audience    string `mapstructure:"audience" validate:"required"`
+	RolesClaim  string `mapstructure:"roles_claim"`
+	GroupsClaim string `mapstructure:"groups_claim"`
+	AdminRole   string `mapstructure:"admin_role"`
+}
+
+// Validate checks that all fields in JWTSettings are valid
+func (settings *JWTSettings) Validate() error {
+	validate := validator.New()
+
+	err := validate.Struct(settings)
+	if err != nil {
+		return fmt.Errorf("validation failed: %w", err)
+	}
+	return nil
+}
@@ -0,0 +1,89 @@
+//go:build unit
+// +build unit
+
+package settings
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestJWTSettingsValidation(t *testing.T) {
+	tests := []struct {
+		name          string
+		settings      *JWTSettings
+		expectedError bool
+	}{
+		{
+			name: "Valid JWKS File Settings",
+			settings: &JWTSettings{
+				JWKSFile: "/etc/crypto-vault/jwks.json",
+				Issuer:   "https://auth.example.com/",
+				Audience: "crypto-vault-service",
+			},
+			expectedError: false,
+		},
+		{
+			name: "Valid JWKS URL Settings",
+			settings: &JWTSettings{
+				JWKSURL:  "https://auth.example.com/.well-known/jwks.json",
+				Issuer:   "https://auth.example.com/",
+				Audience: "crypto-vault-service",
+			},
+			expectedError: false,
+		},
+		{
+			name: "Missing JWKS",
+			settings: &JWTSettings{
+				Issuer:   "https://auth.example.com/",
+				Audience: "crypto-vault-service",
+			},
+			expectedError: true,
+		},
+		{
+			name: "Both JWKS File And URL",
+			settings: &JWTSettings{
+				JWKSFile: "/etc/crypto-vault/jwks.json",
+				JWKSURL:  "https://auth.example.com/.well-known/jwks.json",
+				Issuer:   "https://auth.example.com/",
+				Audience: "crypto-vault-service",
+			},
+			expectedError: true,
+		},
+		{
+			name: "Invalid JWKS URL",
+			settings: &JWTSettings{
+				JWKSURL:  "not a url",
+				Issuer:   "https://auth.example.com/",
+				Audience: "crypto-vault-service",
+			},
+			expectedError: true,
+		},
+		{
+			name: "Missing Audience",
+			settings: &JWTSettings{
+				JWKSFile: "/etc/crypto-vault/jwks.json",
+				Issuer:   "https://auth.example.com/",
+			},
+			expectedError: true,
+		},
+		{
+			name:          "All Fields Missing",
+			settings:      &JWTSettings{},
+			expectedError: true,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			err := tt.settings.Validate()
+
+			if tt.expectedError {
+				assert.Errorf(t, err, "expected an error, got nil")
+			} else {
+				assert.NoError(t, err, "expected no error, got: %v", err)
+			}
+		})
+	}
+}
@@ -6,14 +6,25 @@ import (
 	"github.com/go-playground/validator/v10"
 )
 
-// KeyConnectorSettings holds configuration settings for connecting to a key storage service
+// KeyConnectorSettings holds configuration settings for connecting to a key storage service.
+// Which fields are required depends on the cloud provider: azure, filesystem, s3 or pkcs11.
+// For s3 the endpoint is optional and only needs to be set for S3-compatible services like MinIO.
+// For pkcs11 keys are kept on the token with the given label, which is accessed with the PKCS11Settings of the service.
 type KeyConnectorSettings struct {
 	CloudProvider    string `mapstructure:"cloud_provider" validate:"required"`
-	ConnectionString string `mapstructure:"connection_string" validate:"required"`
-	ContainerName    string `mapstructure:"container_name" validate:"required"`
+	ConnectionString string `mapstructure:"connection_string" validate:"required_if=CloudProvider azure"`
+	ContainerName    string `mapstructure:"container_name" validate:"required_if=CloudProvider azure"`
+	RootPath         string `mapstructure:"root_path" validate:"required_if=CloudProvider filesystem"`
+	Endpoint         string `mapstructure:"endpoint" validate:"omitempty,url"`
+	Region           string `mapstructure:"region" validate:"required_if=CloudProvider s3"`
+	Bucket           string `mapstructure:"bucket" validate:"required_if=CloudProvider s3"`
+	UsePathStyle     bool   `mapstructure:"use_path_style"`
+	AccessKeyID      string `mapstructure:"access_key_id" validate:"required_if=CloudProvider s3"`
+	SecretAccessKey  string `mapstructure:"secret_access_key" validate:"required_if=CloudProvider s3"`
+	TokenLabel       string `mapstructure:"token_label" validate:"required_if=CloudProvider pkcs11"`
 }
 
-// Validate checks that all fields in KeyConnectorSettings are valid (non-empty in this case)
+// Validate checks that all fields in KeyConnectorSettings are valid
 func (settings *KeyConnectorSettings) Validate() error {
 	validate := validator.New()
 
@@ -36,7 +36,7 @@ func TestKeyConnectorSettings_Validate(t *testing.T) {
 		{
 			name: "missing ConnectionString",
 			setting: &KeyConnectorSettings{
-				CloudProvider:    "AWS",
+				CloudProvider:    "azure",
 				ConnectionString: "",
 				ContainerName:    "my-container",
 			},
@@ -45,12 +45,62 @@ func TestKeyConnectorSettings_Validate(t *testing.T) {
 		{
 			name: "missing ContainerName",
 			setting: &KeyConnectorSettings{
-				CloudProvider:    "AWS",
+				CloudProvider:    "azure",
 				ConnectionString: "my-connection-string",
 				ContainerName:    "",
 			},
 			wantErr: true,
 		},
+		{
+			name: "valid filesystem settings",
+			setting: &KeyConnectorSettings{
+				CloudProvider: "filesystem",
+				RootPath:      "/var/lib/crypto-vault/keys",
+			},
+			wantErr: false,
+		},
+		{
+			name: "missing RootPath for filesystem",
+			setting: &KeyConnectorSettings{
+				CloudProvider: "filesystem",
+			},
+			wantErr: true,
+		},
+		{
+			name: "valid s3 settings",
+			setting: &KeyConnectorSettings{
+				CloudProvider:   "s3",
+				Region:          "eu-central-1",
+				Bucket:          "keys",
+				AccessKeyID:     "access-key-id",
+				SecretAccessKey: "secret-access-key",
+			},
+			wantErr: false,
+		},
+		{
+			name: "missing credentials for s3",
+			setting: &KeyConnectorSettings{
+				CloudProvider: "s3",
+				Region:        "eu-central-1",
+				Bucket:        "keys",
+			},
+			wantErr: true,
+		},
+		{
+			name: "valid pkcs11 settings",
+			setting: &KeyConnectorSettings{
+				CloudProvider: "pkcs11",
+				TokenLabel:    "vault-keys",
+			},
+			wantErr: false,
+		},
+		{
+			name: "missing TokenLabel for pkcs11",
+			setting: &KeyConnectorSettings{
+				CloudProvider: "pkcs11",
+			},
+			wantErr: true,
+		},
 		{
 			name:    "all fields missing",
 			setting: &KeyConnectorSettings{},
@@ -0,0 +1,25 @@
+package settings
+
+import (
+	"fmt"
+	"time"
+
+	"github.com/go-playground/validator/v10"
+)
+
+// KeyDestructionSettings holds the configuration settings for the scheduled destruction of cryptographic keys
+type KeyDestructionSettings struct {
+	GracePeriod time.Duration `mapstructure:"grace_period" validate:"required,min=24h"` // Waiting period between scheduling the destruction of a key and deleting its material, during which the destruction can be cancelled
+	Interval    time.Duration `mapstructure:"interval" validate:"required,min=1s"`      // Interval in which keys whose destruction is due are destroyed
+}
+
+// Validate checks that all fields in KeyDestructionSettings are valid
+func (settings *KeyDestructionSettings) Validate() error {
+	validate := validator.New()
+
+	err := validate.Struct(settings)
+	if err != nil {
+		return fmt.Errorf("validation failed: %w", err)
+	}
+	return nil
+}
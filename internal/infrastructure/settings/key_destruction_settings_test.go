@@ -0,0 +1,60 @@
+//go:build unit
+// +build unit
+
+package settings
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestKeyDestructionSettingsValidation(t *testing.T) {
+	tests := []struct {
+		name          string
+		settings      *KeyDestructionSettings
+		expectedError bool
+	}{
+		{
+			name: "Valid Settings",
+			settings: &KeyDestructionSettings{
+				GracePeriod: 7 * 24 * time.Hour,
+				Interval:    time.Hour,
+			},
+			expectedError: false,
+		},
+		{
+			name: "Grace Period Shorter Than A Day",
+			settings: &KeyDestructionSettings{
+				GracePeriod: time.Hour,
+				Interval:    time.Hour,
+			},
+			expectedError: true,
+		},
+		{
+			name: "Missing Interval",
+			settings: &KeyDestructionSettings{
+				GracePeriod: 30 * 24 * time.Hour,
+			},
+			expectedError: true,
+		},
+		{
+			name:          "All Fields Missing",
+			settings:      &KeyDestructionSettings{},
+			expectedError: true,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			err := tt.settings.Validate()
+
+			if tt.expectedError {
+				assert.Errorf(t, err, "expected an error, got nil")
+			} else {
+				assert.NoError(t, err, "expected no error, got: %v", err)
+			}
+		})
+	}
+}
@@ -0,0 +1,23 @@
+package settings
+
+import (
+	"fmt"
+
+	"github.com/go-playground/validator/v10"
+)
+
+// KeyExportSettings holds the configuration settings for exporting cryptographic keys wrapped
+type KeyExportSettings struct {
+	TrustedWrappingKeyFiles []string `mapstructure:"trusted_wrapping_key_files" validate:"dive,required"` // Paths of the PEM or DER encoded RSA public keys registered by administrators, which alone may wrap keys whose plaintext export is forbidden
+}
+
+// Validate checks that all fields in KeyExportSettings are valid
+func (settings *KeyExportSettings) Validate() error {
+	validate := validator.New()
+
+	err := validate.Struct(settings)
+	if err != nil {
+		return fmt.Errorf("validation failed: %w", err)
+	}
+	return nil
+}
@@ -0,0 +1,50 @@
+//go:build unit
+// +build unit
+
+package settings
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestKeyExportSettingsValidation(t *testing.T) {
+	tests := []struct {
+		name          string
+		settings      *KeyExportSettings
+		expectedError bool
+	}{
+		{
+			name: "Valid Settings",
+			settings: &KeyExportSettings{
+				TrustedWrappingKeyFiles: []string{"/etc/crypto-vault/wrapping-key.pem"},
+			},
+			expectedError: false,
+		},
+		{
+			name:          "No Trusted Wrapping Keys",
+			settings:      &KeyExportSettings{},
+			expectedError: false,
+		},
+		{
+			name: "Empty Trusted Wrapping Key Path",
+			settings: &KeyExportSettings{
+				TrustedWrappingKeyFiles: []string{""},
+			},
+			expectedError: true,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			err := tt.settings.Validate()
+
+			if tt.expectedError {
+				assert.Errorf(t, err, "expected an error, got nil")
+			} else {
+				assert.NoError(t, err, "expected no error, got: %v", err)
+			}
+		})
+	}
+}
@@ -0,0 +1,24 @@
+package settings
+
+import (
+	"fmt"
+	"time"
+
+	"github.com/go-playground/validator/v10"
+)
+
+// KeyRotationSettings holds the configuration settings for the automatic rotation of logical keys
+type KeyRotationSettings struct {
+	Interval time.Duration `mapstructure:"interval" validate:"required,min=1s"` // Interval in which logical keys whose rotation is due are rotated
+}
+
+// Validate checks that all fields in KeyRotationSettings are valid
+func (settings *KeyRotationSettings) Validate() error {
+	validate := validator.New()
+
+	err := validate.Struct(settings)
+	if err != nil {
+		return fmt.Errorf("validation failed: %w", err)
+	}
+	return nil
+}
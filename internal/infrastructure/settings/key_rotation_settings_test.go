@@ -0,0 +1,51 @@
+//go:build unit
+// +build unit
+
+package settings
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestKeyRotationSettingsValidation(t *testing.T) {
+	tests := []struct {
+		name          string
+		settings      *KeyRotationSettings
+		expectedError bool
+	}{
+		{
+			name: "Valid Settings",
+			settings: &KeyRotationSettings{
+				Interval: time.Hour,
+			},
+			expectedError: false,
+		},
+		{
+			name: "Interval Shorter Than A Second",
+			settings: &KeyRotationSettings{
+				Interval: time.Millisecond,
+			},
+			expectedError: true,
+		},
+		{
+			name:          "All Fields Missing",
+			settings:      &KeyRotationSettings{},
+			expectedError: true,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			err := tt.settings.Validate()
+
+			if tt.expectedError {
+				assert.Errorf(t, err, "expected an error, got nil")
+			} else {
+				assert.NoError(t, err, "expected no error, got: %v", err)
+			}
+		})
+	}
+}
@@ -0,0 +1,27 @@
+package settings
+
+import (
+	"fmt"
+
+	"github.com/go-playground/validator/v10"
+)
+
+// MasterKeySettings holds the configuration settings for the master key (KEK) source protecting stored data encryption keys
+type MasterKeySettings struct {
+	Source      string `mapstructure:"source" validate:"required,oneof=file pkcs11"`
+	Dir         string `mapstructure:"dir" validate:"required_if=Source file"`
+	TokenLabel  string `mapstructure:"token_label" validate:"required_if=Source pkcs11"`
+	ObjectLabel string `mapstructure:"object_label" validate:"required_if=Source pkcs11"`
+	KeyType     string `mapstructure:"key_type" validate:"omitempty,oneof=RSA AES"`
+}
+
+// Validate checks that all fields in MasterKeySettings are valid
+func (settings *MasterKeySettings) Validate() error {
+	validate := validator.New()
+
+	err := validate.Struct(settings)
+	if err != nil {
+		return fmt.Errorf("validation failed: %w", err)
+	}
+	return nil
+}
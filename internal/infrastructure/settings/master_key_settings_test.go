@@ -0,0 +1,96 @@
+//go:build unit
+// +build unit
+
+package settings
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestMasterKeySettingsValidation(t *testing.T) {
+	tests := []struct {
+		name          string
+		settings      *MasterKeySettings
+		expectedError bool
+	}{
+		{
+			name: "Valid File Settings",
+			settings: &MasterKeySettings{
+				Source: "file",
+				Dir:    "/var/lib/crypto-vault/kek",
+			},
+			expectedError: false,
+		},
+		{
+			name: "Valid PKCS11 Settings",
+			settings: &MasterKeySettings{
+				Source:      "pkcs11",
+				TokenLabel:  "my-token",
+				ObjectLabel: "kek",
+			},
+			expectedError: false,
+		},
+		{
+			name: "Valid PKCS11 Settings With AES Key Type",
+			settings: &MasterKeySettings{
+				Source:      "pkcs11",
+				TokenLabel:  "my-token",
+				ObjectLabel: "kek",
+				KeyType:     "AES",
+			},
+			expectedError: false,
+		},
+		{
+			name: "Unsupported Key Type",
+			settings: &MasterKeySettings{
+				Source:      "pkcs11",
+				TokenLabel:  "my-token",
+				ObjectLabel: "kek",
+				KeyType:     "EC",
+			},
+			expectedError: true,
+		},
+		{
+			name: "Missing Dir For File Source",
+			settings: &MasterKeySettings{
+				Source: "file",
+			},
+			expectedError: true,
+		},
+		{
+			name: "Missing Object Label For PKCS11 Source",
+			settings: &MasterKeySettings{
+				Source:     "pkcs11",
+				TokenLabel: "my-token",
+			},
+			expectedError: true,
+		},
+		{
+			name: "Unsupported Source",
+			settings: &MasterKeySettings{
+				Source: "kms",
+				Dir:    "/tmp",
+			},
+			expectedError: true,
+		},
+		{
+			name:          "All Fields Missing",
+			settings:      &MasterKeySettings{},
+			expectedError: true,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			err := tt.settings.Validate()
+
+			if tt.expectedError {
+				assert.Errorf(t, err, "expected an error, got nil")
+			} else {
+				assert.NoError(t, err, "expected no error, got: %v", err)
+			}
+		})
+	}
+}
@@ -5,6 +5,7 @@ package settings
 
 import (
 	"testing"
+	"time"
 
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/require"
@@ -21,22 +22,34 @@ func TestInitializeRestConfig(t *testing.T) {
 		{
 			name: "valid environment variables",
 			envVars: map[string]string{
-				"PORT":                             "8080",
-				"DATABASE_TYPE":                    "postgres",
-				"DATABASE_DSN":                     "user:password@tcp(localhost:5432)/dbname",
-				"DATABASE_NAME":                    "mydb",
-				"BLOB_CONNECTOR_CLOUD_PROVIDER":    "aws",
-				"BLOB_CONNECTOR_CONNECTION_STRING": "connection-string",
-				"BLOB_CONNECTOR_CONTAINER_NAME":    "container",
-				"KEY_CONNECTOR_CLOUD_PROVIDER":     "azure",
-				"KEY_CONNECTOR_CONNECTION_STRING":  "key-connection-string",
-				"KEY_CONNECTOR_CONTAINER_NAME":     "key-container",
-				"LOGGER_LOG_LEVEL":                 "info",
-				"LOGGER_LOG_TYPE":                  "console",
-				"PKCS11_MODULE_PATH":               "/path/to/module",
-				"PKCS11_SO_PIN":                    "so-pin",
-				"PKCS11_USER_PIN":                  "user-pin",
-				"PKCS11_SLOT_ID":                   "1",
+				"PORT":                                  "8080",
+				"DATABASE_TYPE":                         "postgres",
+				"DATABASE_DSN":                          "user:password@tcp(localhost:5432)/dbname",
+				"DATABASE_NAME":                         "mydb",
+				"BLOB_CONNECTOR_CLOUD_PROVIDER":         "aws",
+				"BLOB_CONNECTOR_CONNECTION_STRING":      "connection-string",
+				"BLOB_CONNECTOR_CONTAINER_NAME":         "container",
+				"KEY_CONNECTOR_CLOUD_PROVIDER":          "azure",
+				"KEY_CONNECTOR_CONNECTION_STRING":       "key-connection-string",
+				"KEY_CONNECTOR_CONTAINER_NAME":          "key-container",
+				"LOGGER_LOG_LEVEL":                      "info",
+				"LOGGER_LOG_TYPE":                       "console",
+				"PKCS11_MODULE_PATH":                    "/path/to/module",
+				"PKCS11_SO_PIN":                         "so-pin",
+				"PKCS11_USER_PIN":                       "user-pin",
+				"PKCS11_SLOT_ID":                        "1",
+				"MASTER_KEY_SOURCE":                     "file",
+				"MASTER_KEY_DIR":                        "/var/lib/crypto-vault/kek",
+				"JWT_JWKS_URL":                          "https://auth.example.com/.well-known/jwks.json",
+				"JWT_ISSUER":                            "https://auth.example.com/",
+				"JWT_AUDIENCE":                          "crypto-vault-service",
+				"JWT_ROLES_CLAIM":                       "roles",
+				"JWT_GROUPS_CLAIM":                      "groups",
+				"JWT_ADMIN_ROLE":                        "crypto-vault-admin",
+				"KEY_DESTRUCTION_GRACE_PERIOD":          "168h",
+				"KEY_DESTRUCTION_INTERVAL":              "1h",
+				"KEY_ROTATION_INTERVAL":                 "1h",
+				"KEY_EXPORT_TRUSTED_WRAPPING_KEY_FILES": "/etc/crypto-vault/hsm-a.pem,/etc/crypto-vault/hsm-b.pem",
 			},
 			expectedConfig: &RestConfig{
 				Port: "8080",
@@ -65,6 +78,28 @@ func TestInitializeRestConfig(t *testing.T) {
 					UserPin:    "user-pin",
 					SlotID:     "1",
 				},
+				MasterKey: MasterKeySettings{
+					Source: "file",
+					Dir:    "/var/lib/crypto-vault/kek",
+				},
+				JWT: JWTSettings{
+					JWKSURL:     "https://auth.example.com/.well-known/jwks.json",
+					Issuer:      "https://auth.example.com/",
+					Audience:    "crypto-vault-service",
+					RolesClaim:  "roles",
+					GroupsClaim: "groups",
+					AdminRole:   "crypto-vault-admin",
+				},
+				KeyDestruction: KeyDestructionSettings{
+					GracePeriod: 168 * time.Hour,
+					Interval:    time.Hour,
+				},
+				KeyRotation: KeyRotationSettings{
+					Interval: time.Hour,
+				},
+				KeyExport: KeyExportSettings{
+					TrustedWrappingKeyFiles: []string{"/etc/crypto-vault/hsm-a.pem", "/etc/crypto-vault/hsm-b.pem"},
+				},
 			},
 		},
 		{
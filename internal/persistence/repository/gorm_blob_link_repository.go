@@ -0,0 +1,158 @@
+package repository
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"gorm.io/gorm"
+	"gorm.io/gorm/clause"
+
+	"crypto_vault_service/internal/domain/blobs"
+	"crypto_vault_service/internal/infrastructure/logger"
+)
+
+// gormBlobLinkSigningKeyRepository is the implementation of the BlobLinkSigningKeyRepository interface
+type gormBlobLinkSigningKeyRepository struct {
+	db     *gorm.DB
+	logger logger.Logger
+}
+
+// NewGormBlobLinkSigningKeyRepository creates a new gormBlobLinkSigningKeyRepository instance
+func NewGormBlobLinkSigningKeyRepository(db *gorm.DB, logger logger.Logger) (blobs.BlobLinkSigningKeyRepository, error) {
+
+	return &gormBlobLinkSigningKeyRepository{
+		db:     db,
+		logger: logger,
+	}, nil
+}
+
+// Create adds a new BlobLinkSigningKey to the database
+func (r *gormBlobLinkSigningKeyRepository) Create(ctx context.Context, signingKey *blobs.BlobLinkSigningKey) error {
+	if err := signingKey.Validate(); err != nil {
+		return fmt.Errorf("validation error: %w", err)
+	}
+
+	if err := r.db.WithContext(ctx).Create(signingKey).Error; err != nil {
+		return fmt.Errorf("failed to create blob link signing key: %w", err)
+	}
+
+	r.logger.Info(fmt.Sprintf("Created blob link signing key with id %s", signingKey.ID))
+	return nil
+}
+
+// GetFirst retrieves the earliest created BlobLinkSigningKey, or nil if there is none.
+// Instances creating a signing key concurrently thereby agree on the same key.
+func (r *gormBlobLinkSigningKeyRepository) GetFirst(ctx context.Context) (*blobs.BlobLinkSigningKey, error) {
+	var signingKeys []*blobs.BlobLinkSigningKey
+	if err := r.db.WithContext(ctx).Order("date_time_created asc").Order("id asc").Limit(1).Find(&signingKeys).Error; err != nil {
+		return nil, fmt.Errorf("failed to fetch blob link signing key: %w", err)
+	}
+
+	if len(signingKeys) == 0 {
+		return nil, nil
+	}
+	return signingKeys[0], nil
+}
+
+// List retrieves all BlobLinkSigningKeys ordered by their creation time
+func (r *gormBlobLinkSigningKeyRepository) List(ctx context.Context) ([]*blobs.BlobLinkSigningKey, error) {
+	var signingKeys []*blobs.BlobLinkSigningKey
+	if err := r.db.WithContext(ctx).Order("date_time_created asc").Order("id asc").Find(&signingKeys).Error; err != nil {
+		return nil, fmt.Errorf("failed to list blob link signing keys: %w", err)
+	}
+	return signingKeys, nil
+}
+
+// UpdateByID updates an existing BlobLinkSigningKey in the database
+func (r *gormBlobLinkSigningKeyRepository) UpdateByID(ctx context.Context, signingKey *blobs.BlobLinkSigningKey) error {
+	if err := signingKey.Validate(); err != nil {
+		return fmt.Errorf("validation error: %w", err)
+	}
+
+	if err := r.db.WithContext(ctx).Save(signingKey).Error; err != nil {
+		return fmt.Errorf("failed to update blob link signing key: %w", err)
+	}
+
+	r.logger.Info(fmt.Sprintf("Updated blob link signing key with id %s", signingKey.ID))
+	return nil
+}
+
+// gormBlobLinkRevocationRepository is the implementation of the BlobLinkRevocationRepository interface
+type gormBlobLinkRevocationRepository struct {
+	db     *gorm.DB
+	logger logger.Logger
+}
+
+// NewGormBlobLinkRevocationRepository creates a new gormBlobLinkRevocationRepository instance
+func NewGormBlobLinkRevocationRepository(db *gorm.DB, logger logger.Logger) (blobs.BlobLinkRevocationRepository, error) {
+
+	return &gormBlobLinkRevocationRepository{
+		db:     db,
+		logger: logger,
+	}, nil
+}
+
+// Create adds a BlobLinkRevocation to the database and reports whether the link was neither revoked nor claimed before.
+// Concurrent claims of a single-use link thereby succeed only once. Revocations of a claimed link turn its claim into a revocation.
+func (r *gormBlobLinkRevocationRepository) Create(ctx context.Context, revocation *blobs.BlobLinkRevocation) (bool, error) {
+	if err := revocation.Validate(); err != nil {
+		return false, fmt.Errorf("validation error: %w", err)
+	}
+
+	result := r.db.WithContext(ctx).Clauses(clause.OnConflict{DoNothing: true}).Create(revocation)
+	if result.Error != nil {
+		return false, fmt.Errorf("failed to create blob link revocation: %w", result.Error)
+	}
+	if result.RowsAffected == 0 {
+		if revocation.Claimed {
+			return false, nil
+		}
+
+		if err := r.db.WithContext(ctx).Model(&blobs.BlobLinkRevocation{}).
+			Where("link_id = ? AND claimed = ?", revocation.LinkID, true).
+			Updates(map[string]interface{}{
+				"claimed":           false,
+				"date_time_expires": revocation.DateTimeExpires,
+				"date_time_revoked": revocation.DateTimeRevoked,
+			}).Error; err != nil {
+			return false, fmt.Errorf("failed to revoke claimed blob link: %w", err)
+		}
+		return false, nil
+	}
+
+	r.logger.Info(fmt.Sprintf("Revoked blob link with id %s", revocation.LinkID))
+	return true, nil
+}
+
+// Exists reports whether a BlobLinkRevocation for the link exists in the database
+func (r *gormBlobLinkRevocationRepository) Exists(ctx context.Context, linkID string) (bool, error) {
+	var count int64
+	if err := r.db.WithContext(ctx).Model(&blobs.BlobLinkRevocation{}).Where("link_id = ?", linkID).Count(&count).Error; err != nil {
+		return false, fmt.Errorf("failed to fetch blob link revocation: %w", err)
+	}
+	return count > 0, nil
+}
+
+// DeleteClaim removes the claim of a single-use link, revocations of the link are kept
+func (r *gormBlobLinkRevocationRepository) DeleteClaim(ctx context.Context, linkID string) error {
+	if err := r.db.WithContext(ctx).Where("link_id = ? AND claimed = ?", linkID, true).Delete(&blobs.BlobLinkRevocation{}).Error; err != nil {
+		return fmt.Errorf("failed to delete blob link claim: %w", err)
+	}
+
+	r.logger.Info(fmt.Sprintf("Released claim of blob link with id %s", linkID))
+	return nil
+}
+
+// DeleteExpired removes the BlobLinkRevocations of links expired before the given time, which are rejected by their expiry anyway
+func (r *gormBlobLinkRevocationRepository) DeleteExpired(ctx context.Context, before time.Time) error {
+	result := r.db.WithContext(ctx).Where("date_time_expires < ?", before).Delete(&blobs.BlobLinkRevocation{})
+	if result.Error != nil {
+		return fmt.Errorf("failed to delete expired blob link revocations: %w", result.Error)
+	}
+
+	if result.RowsAffected > 0 {
+		r.logger.Info(fmt.Sprintf("Deleted %d expired blob link revocations", result.RowsAffected))
+	}
+	return nil
+}
@@ -59,6 +59,9 @@ func (r *gormBlobRepository) List(ctx context.Context, query *blobs.BlobMetaQuer
 	if query.Type != "" {
 		dbQuery = dbQuery.Where("type = ?", query.Type)
 	}
+	if query.UserID != "" {
+		dbQuery = dbQuery.Where("user_id = ?", query.UserID)
+	}
 	if !query.DateTimeCreated.IsZero() {
 		dbQuery = dbQuery.Where("date_time_created >= ?", query.DateTimeCreated)
 	}
@@ -124,3 +127,16 @@ func (r *gormBlobRepository) DeleteByID(ctx context.Context, blobID string) erro
 	r.logger.Info(fmt.Sprintf("Deleted blob metadata with id %s", blobID))
 	return nil
 }
+
+// CountByKeyIDs counts the Blobs encrypted or signed with any of the given keys
+func (r *gormBlobRepository) CountByKeyIDs(ctx context.Context, keyIDs []string) (int64, error) {
+	var count int64
+	if len(keyIDs) == 0 {
+		return count, nil
+	}
+
+	if err := r.db.WithContext(ctx).Model(&blobs.BlobMeta{}).Where("encryption_key_id IN ? OR sign_key_id IN ?", keyIDs, keyIDs).Count(&count).Error; err != nil {
+		return 0, fmt.Errorf("failed to count blobs: %w", err)
+	}
+	return count, nil
+}
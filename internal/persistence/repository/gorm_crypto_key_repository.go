@@ -4,6 +4,7 @@ import (
 	"context"
 	"errors"
 	"fmt"
+	"time"
 
 	"gorm.io/gorm"
 
@@ -52,12 +53,27 @@ func (r *gormCryptoKeyRepository) List(ctx context.Context, query *keys.CryptoKe
 	dbQuery := r.db.WithContext(ctx).Model(&keys.CryptoKeyMeta{})
 
 	// Apply filters based on the query
+	if query.KeyPairID != "" {
+		dbQuery = dbQuery.Where("key_pair_id = ?", query.KeyPairID)
+	}
 	if query.Algorithm != "" {
 		dbQuery = dbQuery.Where("algorithm = ?", query.Algorithm)
 	}
 	if query.Type != "" {
 		dbQuery = dbQuery.Where("type = ?", query.Type)
 	}
+	if query.UserID != "" {
+		dbQuery = dbQuery.Where("user_id = ?", query.UserID)
+	}
+	if query.LogicalKeyID != "" {
+		dbQuery = dbQuery.Where("logical_key_id = ?", query.LogicalKeyID)
+	}
+	if query.Version > 0 {
+		dbQuery = dbQuery.Where("version = ?", query.Version)
+	}
+	if query.Wrapped {
+		dbQuery = dbQuery.Where("kek_version > 0")
+	}
 	if !query.DateTimeCreated.IsZero() {
 		dbQuery = dbQuery.Where("date_time_created >= ?", query.DateTimeCreated)
 	}
@@ -69,6 +85,10 @@ func (r *gormCryptoKeyRepository) List(ctx context.Context, query *keys.CryptoKe
 			order = "asc"
 		}
 		dbQuery = dbQuery.Order(fmt.Sprintf("%s %s", query.SortBy, order))
+		// Tie-break on the primary key to keep pages stable across queries
+		if query.SortBy != "ID" {
+			dbQuery = dbQuery.Order("id")
+		}
 	}
 
 	// Pagination
@@ -124,3 +144,59 @@ func (r *gormCryptoKeyRepository) DeleteByID(ctx context.Context, keyID string)
 	r.logger.Info(fmt.Sprintf("Deleted key metadata with id %s", keyID))
 	return nil
 }
+
+// ListDueForDestruction retrieves up to limit CryptoKeys whose scheduled destruction is due before the given time and which are not destroyed yet
+func (r *gormCryptoKeyRepository) ListDueForDestruction(ctx context.Context, before time.Time, limit int) ([]*keys.CryptoKeyMeta, error) {
+	var cryptoKeyMetas []*keys.CryptoKeyMeta
+	dbQuery := r.db.WithContext(ctx).
+		Where("date_time_destruction IS NOT NULL AND date_time_destruction <= ?", before).
+		Where("state IS NULL OR state <> ?", keys.KeyStateDestroyed).
+		Order("date_time_destruction").
+		Order("id")
+	if limit > 0 {
+		dbQuery = dbQuery.Limit(limit)
+	}
+
+	if err := dbQuery.Find(&cryptoKeyMetas).Error; err != nil {
+		return nil, fmt.Errorf("failed to fetch crypto keys due for destruction: %w", err)
+	}
+	return cryptoKeyMetas, nil
+}
+
+// ListBelowKEKVersion retrieves up to limit keys stored in the vault and wrapped with a master key version below kekVersion, oldest first.
+// Destroyed keys and keys generated on a PKCS#11 token are left out, as there is no material to re-wrap for them.
+func (r *gormCryptoKeyRepository) ListBelowKEKVersion(ctx context.Context, kekVersion uint32, limit int) ([]*keys.CryptoKeyMeta, error) {
+	var cryptoKeyMetas []*keys.CryptoKeyMeta
+	dbQuery := r.db.WithContext(ctx).
+		Where("kek_version < ?", kekVersion).
+		Where("state IS NULL OR state <> ?", keys.KeyStateDestroyed).
+		Where("token_label IS NULL OR token_label = ''").
+		Order("date_time_created").
+		Order("id")
+	if limit > 0 {
+		dbQuery = dbQuery.Limit(limit)
+	}
+
+	if err := dbQuery.Find(&cryptoKeyMetas).Error; err != nil {
+		return nil, fmt.Errorf("failed to fetch crypto keys below KEK version %d: %w", kekVersion, err)
+	}
+	return cryptoKeyMetas, nil
+}
+
+// UpdateKEKVersion sets the master key version of a key to newKEKVersion, provided it is still wrapped with kekVersion.
+// Only the KEK version is written, so concurrent updates of other fields are kept. It reports whether the key was updated.
+func (r *gormCryptoKeyRepository) UpdateKEKVersion(ctx context.Context, keyID string, kekVersion, newKEKVersion uint32) (bool, error) {
+	result := r.db.WithContext(ctx).
+		Model(&keys.CryptoKeyMeta{}).
+		Where("id = ? AND kek_version = ?", keyID, kekVersion).
+		Update("kek_version", newKEKVersion)
+	if result.Error != nil {
+		return false, fmt.Errorf("failed to update KEK version of cryptographic key: %w", result.Error)
+	}
+	if result.RowsAffected == 0 {
+		return false, nil
+	}
+
+	r.logger.Info(fmt.Sprintf("Updated KEK version of key %s from %d to %d", keyID, kekVersion, newKEKVersion))
+	return true, nil
+}
@@ -0,0 +1,77 @@
+package repository
+
+import (
+	"context"
+	"fmt"
+
+	"gorm.io/gorm"
+
+	"crypto_vault_service/internal/domain/keys"
+	"crypto_vault_service/internal/infrastructure/logger"
+)
+
+// gormKeyImportKeyRepository is the implementation of the KeyImportKeyRepository interface
+type gormKeyImportKeyRepository struct {
+	db     *gorm.DB
+	logger logger.Logger
+}
+
+// NewGormKeyImportKeyRepository creates a new gormKeyImportKeyRepository instance
+func NewGormKeyImportKeyRepository(db *gorm.DB, logger logger.Logger) (keys.KeyImportKeyRepository, error) {
+
+	return &gormKeyImportKeyRepository{
+		db:     db,
+		logger: logger,
+	}, nil
+}
+
+// Create adds a new KeyImportKey to the database
+func (r *gormKeyImportKeyRepository) Create(ctx context.Context, importKey *keys.KeyImportKey) error {
+	if err := importKey.Validate(); err != nil {
+		return fmt.Errorf("validation error: %w", err)
+	}
+
+	if err := r.db.WithContext(ctx).Create(importKey).Error; err != nil {
+		return fmt.Errorf("failed to create key import key: %w", err)
+	}
+
+	r.logger.Info(fmt.Sprintf("Created key import key with id %s", importKey.ID))
+	return nil
+}
+
+// GetFirst retrieves the earliest created KeyImportKey, or nil if there is none.
+// Instances creating an import key concurrently thereby agree on the same key.
+func (r *gormKeyImportKeyRepository) GetFirst(ctx context.Context) (*keys.KeyImportKey, error) {
+	var importKeys []*keys.KeyImportKey
+	if err := r.db.WithContext(ctx).Order("date_time_created asc").Order("id asc").Limit(1).Find(&importKeys).Error; err != nil {
+		return nil, fmt.Errorf("failed to fetch key import key: %w", err)
+	}
+
+	if len(importKeys) == 0 {
+		return nil, nil
+	}
+	return importKeys[0], nil
+}
+
+// List retrieves all KeyImportKeys ordered by their creation time
+func (r *gormKeyImportKeyRepository) List(ctx context.Context) ([]*keys.KeyImportKey, error) {
+	var importKeys []*keys.KeyImportKey
+	if err := r.db.WithContext(ctx).Order("date_time_created asc").Order("id asc").Find(&importKeys).Error; err != nil {
+		return nil, fmt.Errorf("failed to list key import keys: %w", err)
+	}
+	return importKeys, nil
+}
+
+// UpdateByID updates an existing KeyImportKey in the database
+func (r *gormKeyImportKeyRepository) UpdateByID(ctx context.Context, importKey *keys.KeyImportKey) error {
+	if err := importKey.Validate(); err != nil {
+		return fmt.Errorf("validation error: %w", err)
+	}
+
+	if err := r.db.WithContext(ctx).Save(importKey).Error; err != nil {
+		return fmt.Errorf("failed to update key import key: %w", err)
+	}
+
+	r.logger.Info(fmt.Sprintf("Updated key import key with id %s", importKey.ID))
+	return nil
+}
@@ -0,0 +1,143 @@
+package repository
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"gorm.io/gorm"
+
+	"crypto_vault_service/internal/domain/keys"
+	"crypto_vault_service/internal/infrastructure/logger"
+)
+
+// gormLogicalKeyRepository is the implementation of the LogicalKeyRepository interface
+type gormLogicalKeyRepository struct {
+	db     *gorm.DB
+	logger logger.Logger
+}
+
+// NewGormLogicalKeyRepository creates a new gormLogicalKeyRepository instance
+func NewGormLogicalKeyRepository(db *gorm.DB, logger logger.Logger) (keys.LogicalKeyRepository, error) {
+
+	return &gormLogicalKeyRepository{
+		db:     db,
+		logger: logger,
+	}, nil
+}
+
+// Create adds a new LogicalKey to the database
+func (r *gormLogicalKeyRepository) Create(ctx context.Context, logicalKey *keys.LogicalKey) error {
+	if err := logicalKey.Validate(); err != nil {
+		return fmt.Errorf("validation error: %w", err)
+	}
+
+	if err := r.db.WithContext(ctx).Create(logicalKey).Error; err != nil {
+		return fmt.Errorf("failed to create logical key: %w", err)
+	}
+
+	r.logger.Info(fmt.Sprintf("Created logical key with id %s", logicalKey.ID))
+	return nil
+}
+
+// GetByID retrieves a LogicalKey by its ID from the database
+func (r *gormLogicalKeyRepository) GetByID(ctx context.Context, logicalKeyID string) (*keys.LogicalKey, error) {
+	var logicalKey keys.LogicalKey
+	if err := r.db.WithContext(ctx).Where("id = ?", logicalKeyID).First(&logicalKey).Error; err != nil {
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return nil, fmt.Errorf("logical key with ID %s not found", logicalKeyID)
+		}
+
+		return nil, fmt.Errorf("failed to fetch logical key: %w", err)
+	}
+	return &logicalKey, nil
+}
+
+// UpdateByID updates an existing LogicalKey in the database
+func (r *gormLogicalKeyRepository) UpdateByID(ctx context.Context, logicalKey *keys.LogicalKey) error {
+	if err := logicalKey.Validate(); err != nil {
+		return fmt.Errorf("validation error: %w", err)
+	}
+
+	if err := r.db.WithContext(ctx).Save(logicalKey).Error; err != nil {
+		return fmt.Errorf("failed to update logical key: %w", err)
+	}
+
+	r.logger.Info(fmt.Sprintf("Updated logical key with id %s", logicalKey.ID))
+	return nil
+}
+
+// ReserveVersion takes the version following latestVersion for a rotation and schedules the next automatic rotation.
+// It fails with keys.ErrConcurrentRotation unless latestVersion is still the latest version, so every version is taken by a single rotation.
+func (r *gormLogicalKeyRepository) ReserveVersion(ctx context.Context, logicalKeyID string, latestVersion uint32, nextRotation *time.Time) error {
+	result := r.db.WithContext(ctx).Model(&keys.LogicalKey{}).
+		Where("id = ? AND latest_version = ?", logicalKeyID, latestVersion).
+		Updates(map[string]interface{}{
+			"latest_version":          latestVersion + 1,
+			"date_time_next_rotation": nextRotation,
+		})
+	if result.Error != nil {
+		return fmt.Errorf("failed to reserve version of logical key: %w", result.Error)
+	}
+	if result.RowsAffected == 0 {
+		return fmt.Errorf("%w: version %d of logical key %s is taken", keys.ErrConcurrentRotation, latestVersion+1, logicalKeyID)
+	}
+
+	r.logger.Info(fmt.Sprintf("Reserved version %d of logical key with id %s", latestVersion+1, logicalKeyID))
+	return nil
+}
+
+// PromoteVersion makes the given version the primary version of the logical key.
+// Versions older than the primary version are left as they are, so concurrent rotations finishing out of order never demote a newer version.
+func (r *gormLogicalKeyRepository) PromoteVersion(ctx context.Context, logicalKeyID string, version uint32, keyPairID string, rotated time.Time) error {
+	result := r.db.WithContext(ctx).Model(&keys.LogicalKey{}).
+		Where("id = ? AND primary_version < ? AND latest_version >= ?", logicalKeyID, version, version).
+		Updates(map[string]interface{}{
+			"primary_version":     version,
+			"primary_key_pair_id": keyPairID,
+			"date_time_rotated":   rotated,
+		})
+	if result.Error != nil {
+		return fmt.Errorf("failed to promote version of logical key: %w", result.Error)
+	}
+
+	r.logger.Info(fmt.Sprintf("Promoted version %d of logical key with id %s", version, logicalKeyID))
+	return nil
+}
+
+// UpdateRotationPolicy updates the rotation period and the next automatic rotation of a logical key, leaving its versions untouched
+func (r *gormLogicalKeyRepository) UpdateRotationPolicy(ctx context.Context, logicalKeyID string, rotationPeriod time.Duration, nextRotation *time.Time) error {
+	result := r.db.WithContext(ctx).Model(&keys.LogicalKey{}).
+		Where("id = ?", logicalKeyID).
+		Updates(map[string]interface{}{
+			"rotation_period":         rotationPeriod,
+			"date_time_next_rotation": nextRotation,
+		})
+	if result.Error != nil {
+		return fmt.Errorf("failed to update rotation policy of logical key: %w", result.Error)
+	}
+	if result.RowsAffected == 0 {
+		return fmt.Errorf("logical key with ID %s not found", logicalKeyID)
+	}
+
+	r.logger.Info(fmt.Sprintf("Updated rotation policy of logical key with id %s", logicalKeyID))
+	return nil
+}
+
+// ListDueForRotation retrieves up to limit LogicalKeys whose automatic rotation is due before the given time
+func (r *gormLogicalKeyRepository) ListDueForRotation(ctx context.Context, before time.Time, limit int) ([]*keys.LogicalKey, error) {
+	var logicalKeys []*keys.LogicalKey
+	dbQuery := r.db.WithContext(ctx).
+		Where("date_time_next_rotation IS NOT NULL AND date_time_next_rotation <= ?", before).
+		Order("date_time_next_rotation").
+		Order("id")
+	if limit > 0 {
+		dbQuery = dbQuery.Limit(limit)
+	}
+
+	if err := dbQuery.Find(&logicalKeys).Error; err != nil {
+		return nil, fmt.Errorf("failed to fetch logical keys due for rotation: %w", err)
+	}
+	return logicalKeys, nil
+}
@@ -0,0 +1,82 @@
+package repository
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	"gorm.io/gorm"
+
+	"crypto_vault_service/internal/domain/keys"
+	"crypto_vault_service/internal/infrastructure/logger"
+)
+
+// gormMasterKeyRotationRepository is the implementation of the MasterKeyRotationRepository interface
+type gormMasterKeyRotationRepository struct {
+	db     *gorm.DB
+	logger logger.Logger
+}
+
+// NewGormMasterKeyRotationRepository creates a new gormMasterKeyRotationRepository instance
+func NewGormMasterKeyRotationRepository(db *gorm.DB, logger logger.Logger) (keys.MasterKeyRotationRepository, error) {
+
+	return &gormMasterKeyRotationRepository{
+		db:     db,
+		logger: logger,
+	}, nil
+}
+
+// Create adds a new MasterKeyRotation to the database
+func (r *gormMasterKeyRotationRepository) Create(ctx context.Context, rotation *keys.MasterKeyRotation) error {
+	if err := rotation.Validate(); err != nil {
+		return fmt.Errorf("validation error: %w", err)
+	}
+
+	if err := r.db.WithContext(ctx).Create(rotation).Error; err != nil {
+		return fmt.Errorf("failed to create master key rotation: %w", err)
+	}
+
+	r.logger.Info(fmt.Sprintf("Created master key rotation with id %s", rotation.ID))
+	return nil
+}
+
+// GetByID retrieves a MasterKeyRotation by its ID from the database
+func (r *gormMasterKeyRotationRepository) GetByID(ctx context.Context, rotationID string) (*keys.MasterKeyRotation, error) {
+	var rotation keys.MasterKeyRotation
+	if err := r.db.WithContext(ctx).Where("id = ?", rotationID).First(&rotation).Error; err != nil {
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return nil, fmt.Errorf("master key rotation with ID %s not found", rotationID)
+		}
+
+		return nil, fmt.Errorf("failed to fetch master key rotation: %w", err)
+	}
+	return &rotation, nil
+}
+
+// GetUnfinished retrieves the most recently started MasterKeyRotation if it is still running or failed, or nil if there is none.
+// Rotations started before the most recent one are superseded by it and never returned.
+func (r *gormMasterKeyRotationRepository) GetUnfinished(ctx context.Context) (*keys.MasterKeyRotation, error) {
+	var rotations []*keys.MasterKeyRotation
+	if err := r.db.WithContext(ctx).Order("date_time_started desc").Limit(1).Find(&rotations).Error; err != nil {
+		return nil, fmt.Errorf("failed to fetch unfinished master key rotation: %w", err)
+	}
+
+	if len(rotations) == 0 || rotations[0].Status == "completed" {
+		return nil, nil
+	}
+	return rotations[0], nil
+}
+
+// UpdateByID updates an existing MasterKeyRotation in the database
+func (r *gormMasterKeyRotationRepository) UpdateByID(ctx context.Context, rotation *keys.MasterKeyRotation) error {
+	if err := rotation.Validate(); err != nil {
+		return fmt.Errorf("validation error: %w", err)
+	}
+
+	if err := r.db.WithContext(ctx).Save(rotation).Error; err != nil {
+		return fmt.Errorf("failed to update master key rotation: %w", err)
+	}
+
+	r.logger.Info(fmt.Sprintf("Updated master key rotation with id %s", rotation.ID))
+	return nil
+}
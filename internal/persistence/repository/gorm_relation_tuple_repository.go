@@ -0,0 +1,96 @@
+package repository
+
+import (
+	"context"
+	"fmt"
+
+	"gorm.io/gorm"
+	"gorm.io/gorm/clause"
+
+	"crypto_vault_service/internal/domain/permissions"
+	"crypto_vault_service/internal/infrastructure/logger"
+)
+
+// gormRelationTupleRepository is the implementation of the RelationTupleRepository interface
+type gormRelationTupleRepository struct {
+	db     *gorm.DB
+	logger logger.Logger
+}
+
+// NewGormRelationTupleRepository creates a new gormRelationTupleRepository instance
+func NewGormRelationTupleRepository(db *gorm.DB, logger logger.Logger) (permissions.RelationTupleRepository, error) {
+
+	return &gormRelationTupleRepository{
+		db:     db,
+		logger: logger,
+	}, nil
+}
+
+// Create adds RelationTuples to the database. Tuples which already exist only have their expiry updated
+func (r *gormRelationTupleRepository) Create(ctx context.Context, tuples []*permissions.RelationTuple) error {
+	if len(tuples) == 0 {
+		return nil
+	}
+
+	for _, tuple := range tuples {
+		if err := tuple.Validate(); err != nil {
+			return fmt.Errorf("validation error: %w", err)
+		}
+	}
+
+	onConflict := clause.OnConflict{
+		Columns:   []clause.Column{{Name: "object"}, {Name: "relation"}, {Name: "subject"}},
+		DoUpdates: clause.AssignmentColumns([]string{"date_time_expires"}),
+	}
+	if err := r.db.WithContext(ctx).Clauses(onConflict).Create(&tuples).Error; err != nil {
+		return fmt.Errorf("failed to create relation tuples: %w", err)
+	}
+
+	r.logger.Info(fmt.Sprintf("Created %d relation tuples", len(tuples)))
+	return nil
+}
+
+// List retrieves the RelationTuples matching the query from the database
+func (r *gormRelationTupleRepository) List(ctx context.Context, query *permissions.RelationTupleQuery) ([]*permissions.RelationTuple, error) {
+	if err := query.Validate(); err != nil {
+		return nil, fmt.Errorf("invalid query parameters: %w", err)
+	}
+
+	var tuples []*permissions.RelationTuple
+	dbQuery := r.db.WithContext(ctx).Model(&permissions.RelationTuple{})
+
+	if query.Object != "" {
+		dbQuery = dbQuery.Where("object = ?", query.Object)
+	}
+	if query.Relation != "" {
+		dbQuery = dbQuery.Where("relation = ?", query.Relation)
+	}
+	if query.User != "" {
+		dbQuery = dbQuery.Where("subject = ?", query.User)
+	}
+
+	if err := dbQuery.Find(&tuples).Error; err != nil {
+		return nil, fmt.Errorf("failed to fetch relation tuples: %w", err)
+	}
+	return tuples, nil
+}
+
+// Delete removes RelationTuples from the database, ignoring tuples which do not exist
+func (r *gormRelationTupleRepository) Delete(ctx context.Context, tuples []*permissions.RelationTuple) error {
+	err := r.db.WithContext(ctx).Transaction(func(tx *gorm.DB) error {
+		for _, tuple := range tuples {
+			err := tx.Where("object = ? AND relation = ? AND subject = ?", tuple.Object, tuple.Relation, tuple.User).
+				Delete(&permissions.RelationTuple{}).Error
+			if err != nil {
+				return fmt.Errorf("%w", err)
+			}
+		}
+		return nil
+	})
+	if err != nil {
+		return fmt.Errorf("failed to delete relation tuples: %w", err)
+	}
+
+	r.logger.Info(fmt.Sprintf("Deleted %d relation tuples", len(tuples)))
+	return nil
+}
@@ -0,0 +1,183 @@
+//go:build integration
+// +build integration
+
+package repository
+
+import (
+	"context"
+	"crypto_vault_service/internal/domain/blobs"
+	"testing"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestBlobLinkSigningKeySqliteRepository_CreateAndGetFirst(t *testing.T) {
+	dbType := "sqlite"
+	ctx := SetupTestDB(t, dbType)
+	defer TeardownTestDB(t, ctx, dbType)
+
+	signingKey, err := ctx.BlobLinkSigningKeyRepo.GetFirst(context.Background())
+	assert.NoError(t, err, "GetFirst should not return an error")
+	assert.Nil(t, signingKey, "No signing key expected")
+
+	firstSigningKey := &blobs.BlobLinkSigningKey{
+		ID:              uuid.New().String(),
+		KeyPairID:       uuid.New().String(),
+		KEKVersion:      1,
+		DateTimeCreated: time.Now().Add(-time.Minute),
+	}
+	laterSigningKey := &blobs.BlobLinkSigningKey{
+		ID:              uuid.New().String(),
+		KeyPairID:       uuid.New().String(),
+		KEKVersion:      1,
+		DateTimeCreated: time.Now(),
+	}
+	require.NoError(t, ctx.BlobLinkSigningKeyRepo.Create(context.Background(), laterSigningKey))
+	require.NoError(t, ctx.BlobLinkSigningKeyRepo.Create(context.Background(), firstSigningKey))
+
+	signingKey, err = ctx.BlobLinkSigningKeyRepo.GetFirst(context.Background())
+	assert.NoError(t, err, "GetFirst should not return an error")
+	require.NotNil(t, signingKey, "Signing key expected")
+	assert.Equal(t, firstSigningKey.ID, signingKey.ID, "Earliest created signing key expected")
+
+	err = ctx.BlobLinkSigningKeyRepo.Create(context.Background(), &blobs.BlobLinkSigningKey{ID: uuid.New().String()})
+	assert.Error(t, err, "Create should return an error for an invalid signing key")
+}
+
+func TestBlobLinkSigningKeySqliteRepository_ListAndUpdateByID(t *testing.T) {
+	dbType := "sqlite"
+	ctx := SetupTestDB(t, dbType)
+	defer TeardownTestDB(t, ctx, dbType)
+
+	signingKey := &blobs.BlobLinkSigningKey{
+		ID:              uuid.New().String(),
+		KeyPairID:       uuid.New().String(),
+		KEKVersion:      1,
+		DateTimeCreated: time.Now(),
+	}
+	require.NoError(t, ctx.BlobLinkSigningKeyRepo.Create(context.Background(), signingKey))
+
+	signingKey.KEKVersion = 2
+	err := ctx.BlobLinkSigningKeyRepo.UpdateByID(context.Background(), signingKey)
+	assert.NoError(t, err, "UpdateByID should not return an error")
+
+	signingKeys, err := ctx.BlobLinkSigningKeyRepo.List(context.Background())
+	assert.NoError(t, err, "List should not return an error")
+	require.Len(t, signingKeys, 1, "One signing key expected")
+	assert.Equal(t, uint32(2), signingKeys[0].KEKVersion, "Updated KEK version expected")
+
+	signingKey.KEKVersion = 0
+	err = ctx.BlobLinkSigningKeyRepo.UpdateByID(context.Background(), signingKey)
+	assert.Error(t, err, "UpdateByID should return an error for an invalid signing key")
+}
+
+func TestBlobLinkRevocationSqliteRepository_CreateAndExists(t *testing.T) {
+	dbType := "sqlite"
+	ctx := SetupTestDB(t, dbType)
+	defer TeardownTestDB(t, ctx, dbType)
+
+	revocation := &blobs.BlobLinkRevocation{
+		LinkID:          uuid.New().String(),
+		BlobID:          uuid.New().String(),
+		DateTimeExpires: time.Now().Add(time.Hour),
+		DateTimeRevoked: time.Now(),
+	}
+
+	exists, err := ctx.BlobLinkRevocationRepo.Exists(context.Background(), revocation.LinkID)
+	assert.NoError(t, err, "Exists should not return an error")
+	assert.False(t, exists, "Link should not be revoked yet")
+
+	created, err := ctx.BlobLinkRevocationRepo.Create(context.Background(), revocation)
+	assert.NoError(t, err, "Create should not return an error")
+	assert.True(t, created, "First revocation should be created")
+
+	created, err = ctx.BlobLinkRevocationRepo.Create(context.Background(), revocation)
+	assert.NoError(t, err, "Create should not return an error for a revoked link")
+	assert.False(t, created, "Revoked link should not be revoked again")
+
+	exists, err = ctx.BlobLinkRevocationRepo.Exists(context.Background(), revocation.LinkID)
+	assert.NoError(t, err, "Exists should not return an error")
+	assert.True(t, exists, "Link should be revoked")
+}
+
+func TestBlobLinkRevocationSqliteRepository_Claim(t *testing.T) {
+	dbType := "sqlite"
+	ctx := SetupTestDB(t, dbType)
+	defer TeardownTestDB(t, ctx, dbType)
+
+	claim := &blobs.BlobLinkRevocation{
+		LinkID:          uuid.New().String(),
+		BlobID:          uuid.New().String(),
+		DateTimeExpires: time.Now().Add(time.Hour),
+		DateTimeRevoked: time.Now(),
+		Claimed:         true,
+	}
+
+	claimed, err := ctx.BlobLinkRevocationRepo.Create(context.Background(), claim)
+	assert.NoError(t, err, "Create should not return an error")
+	assert.True(t, claimed, "First claim should be created")
+
+	claimed, err = ctx.BlobLinkRevocationRepo.Create(context.Background(), claim)
+	assert.NoError(t, err, "Create should not return an error for a claimed link")
+	assert.False(t, claimed, "Claimed link should not be claimed again")
+
+	// Released claims permit claiming the link again
+	err = ctx.BlobLinkRevocationRepo.DeleteClaim(context.Background(), claim.LinkID)
+	assert.NoError(t, err, "DeleteClaim should not return an error")
+	exists, err := ctx.BlobLinkRevocationRepo.Exists(context.Background(), claim.LinkID)
+	assert.NoError(t, err)
+	assert.False(t, exists, "Claim should be deleted")
+
+	claimed, err = ctx.BlobLinkRevocationRepo.Create(context.Background(), claim)
+	assert.NoError(t, err)
+	assert.True(t, claimed, "Released link should be claimed again")
+
+	// Revocations of a claimed link are kept when its claim is released
+	revocation := *claim
+	revocation.Claimed = false
+	_, err = ctx.BlobLinkRevocationRepo.Create(context.Background(), &revocation)
+	assert.NoError(t, err, "Create should not return an error for a claimed link")
+
+	err = ctx.BlobLinkRevocationRepo.DeleteClaim(context.Background(), claim.LinkID)
+	assert.NoError(t, err, "DeleteClaim should not return an error")
+	exists, err = ctx.BlobLinkRevocationRepo.Exists(context.Background(), claim.LinkID)
+	assert.NoError(t, err)
+	assert.True(t, exists, "Revocation should be kept")
+}
+
+func TestBlobLinkRevocationSqliteRepository_DeleteExpired(t *testing.T) {
+	dbType := "sqlite"
+	ctx := SetupTestDB(t, dbType)
+	defer TeardownTestDB(t, ctx, dbType)
+
+	expiredRevocation := &blobs.BlobLinkRevocation{
+		LinkID:          uuid.New().String(),
+		BlobID:          uuid.New().String(),
+		DateTimeExpires: time.Now().Add(-time.Minute),
+		DateTimeRevoked: time.Now().Add(-time.Hour),
+	}
+	activeRevocation := &blobs.BlobLinkRevocation{
+		LinkID:          uuid.New().String(),
+		BlobID:          uuid.New().String(),
+		DateTimeExpires: time.Now().Add(time.Hour),
+		DateTimeRevoked: time.Now(),
+	}
+	for _, revocation := range []*blobs.BlobLinkRevocation{expiredRevocation, activeRevocation} {
+		_, err := ctx.BlobLinkRevocationRepo.Create(context.Background(), revocation)
+		require.NoError(t, err)
+	}
+
+	err := ctx.BlobLinkRevocationRepo.DeleteExpired(context.Background(), time.Now())
+	assert.NoError(t, err, "DeleteExpired should not return an error")
+
+	exists, err := ctx.BlobLinkRevocationRepo.Exists(context.Background(), expiredRevocation.LinkID)
+	assert.NoError(t, err)
+	assert.False(t, exists, "Revocation of expired link should be deleted")
+
+	exists, err = ctx.BlobLinkRevocationRepo.Exists(context.Background(), activeRevocation.LinkID)
+	assert.NoError(t, err)
+	assert.True(t, exists, "Revocation of active link should be kept")
+}
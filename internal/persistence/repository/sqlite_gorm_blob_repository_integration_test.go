@@ -14,6 +14,7 @@ import (
 
 	"github.com/google/uuid"
 	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
 )
 
 func TestBlobSqliteRepository_Create(t *testing.T) {
@@ -155,6 +156,34 @@ func TestBlobPsqlRepository_List_WithFilters(t *testing.T) {
 	assert.Equal(t, "special-blob", list[0].Name)
 }
 
+func TestBlobSqliteRepository_List_ByUserID(t *testing.T) {
+	dbType := "sqlite"
+	ctx := SetupTestDB(t, dbType)
+	defer TeardownTestDB(t, ctx, dbType)
+
+	userID := uuid.NewString()
+	for _, ownerID := range []string{userID, uuid.NewString()} {
+		blob := &blobs.BlobMeta{
+			ID:              uuid.NewString(),
+			DateTimeCreated: time.Now(),
+			UserID:          ownerID,
+			Name:            "blob",
+			Size:            1024,
+			Type:            "text",
+		}
+		require.NoError(t, ctx.BlobRepo.Create(context.Background(), blob))
+	}
+
+	list, err := ctx.BlobRepo.List(context.Background(), &blobs.BlobMetaQuery{UserID: userID})
+	require.NoError(t, err)
+	require.Len(t, list, 1)
+	assert.Equal(t, userID, list[0].UserID)
+
+	list, err = ctx.BlobRepo.List(context.Background(), &blobs.BlobMetaQuery{})
+	require.NoError(t, err)
+	assert.Len(t, list, 2)
+}
+
 func TestBlobPsqlRepository_List_SortAndPagination(t *testing.T) {
 	dbType := "sqlite"
 	ctx := SetupTestDB(t, dbType)
@@ -210,3 +239,60 @@ func TestBlobPsqlRepository_List_InvalidQuery(t *testing.T) {
 	assert.Error(t, err)
 	assert.Contains(t, err.Error(), "invalid query parameters")
 }
+
+func TestBlobSqliteRepository_CountByKeyIDs(t *testing.T) {
+	dbType := "sqlite"
+	ctx := SetupTestDB(t, dbType)
+	defer TeardownTestDB(t, ctx, dbType)
+
+	encryptionKey := keys.CryptoKeyMeta{
+		ID:              uuid.NewString(),
+		KeyPairID:       uuid.NewString(),
+		Type:            "symmetric",
+		Algorithm:       "AES",
+		KeySize:         256,
+		DateTimeCreated: time.Now(),
+		UserID:          uuid.NewString(),
+	}
+	signKey := keys.CryptoKeyMeta{
+		ID:              uuid.NewString(),
+		KeyPairID:       uuid.NewString(),
+		Type:            "private",
+		Algorithm:       "EC",
+		KeySize:         256,
+		DateTimeCreated: time.Now(),
+		UserID:          encryptionKey.UserID,
+	}
+	require.NoError(t, ctx.CryptoKeyRepo.Create(context.Background(), &encryptionKey))
+	require.NoError(t, ctx.CryptoKeyRepo.Create(context.Background(), &signKey))
+
+	for _, blob := range []*blobs.BlobMeta{
+		{EncryptionKeyID: &encryptionKey.ID},
+		{EncryptionKeyID: &encryptionKey.ID, SignKeyID: &signKey.ID},
+		{},
+	} {
+		blob.ID = uuid.NewString()
+		blob.DateTimeCreated = time.Now()
+		blob.UserID = encryptionKey.UserID
+		blob.Name = "blob"
+		blob.Size = 1024
+		blob.Type = "text"
+		require.NoError(t, ctx.BlobRepo.Create(context.Background(), blob))
+	}
+
+	count, err := ctx.BlobRepo.CountByKeyIDs(context.Background(), []string{encryptionKey.ID})
+	require.NoError(t, err)
+	assert.Equal(t, int64(2), count)
+
+	count, err = ctx.BlobRepo.CountByKeyIDs(context.Background(), []string{signKey.ID})
+	require.NoError(t, err)
+	assert.Equal(t, int64(1), count)
+
+	count, err = ctx.BlobRepo.CountByKeyIDs(context.Background(), []string{encryptionKey.ID, signKey.ID})
+	require.NoError(t, err)
+	assert.Equal(t, int64(2), count)
+
+	count, err = ctx.BlobRepo.CountByKeyIDs(context.Background(), []string{uuid.NewString()})
+	require.NoError(t, err)
+	assert.Equal(t, int64(0), count)
+}
@@ -11,6 +11,7 @@ import (
 
 	"github.com/google/uuid"
 	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
 	"gorm.io/gorm"
 )
 
@@ -101,6 +102,78 @@ func TestCryptoKeySqliteRepository_List(t *testing.T) {
 	assert.Len(t, cryptoKeys, 2, "There should be two cryptographic keys in the list")
 }
 
+func TestCryptoKeySqliteRepository_List_ByUserID(t *testing.T) {
+	dbType := "sqlite"
+	ctx := SetupTestDB(t, dbType)
+	defer TeardownTestDB(t, ctx, dbType)
+
+	userID := uuid.New().String()
+	for _, ownerID := range []string{userID, uuid.New().String()} {
+		cryptoKeyMeta := &keys.CryptoKeyMeta{
+			ID:              uuid.New().String(),
+			KeyPairID:       uuid.New().String(),
+			Type:            "symmetric",
+			KeySize:         256,
+			Algorithm:       "AES",
+			DateTimeCreated: time.Now(),
+			UserID:          ownerID,
+		}
+		require.NoError(t, ctx.CryptoKeyRepo.Create(context.Background(), cryptoKeyMeta))
+	}
+
+	cryptoKeys, err := ctx.CryptoKeyRepo.List(context.Background(), &keys.CryptoKeyQuery{UserID: userID})
+	require.NoError(t, err)
+	require.Len(t, cryptoKeys, 1)
+	assert.Equal(t, userID, cryptoKeys[0].UserID)
+
+	cryptoKeys, err = ctx.CryptoKeyRepo.List(context.Background(), &keys.CryptoKeyQuery{})
+	require.NoError(t, err)
+	assert.Len(t, cryptoKeys, 2)
+}
+
+func TestCryptoKeySqliteRepository_List_ByLogicalKeyID(t *testing.T) {
+	dbType := "sqlite"
+	ctx := SetupTestDB(t, dbType)
+	defer TeardownTestDB(t, ctx, dbType)
+
+	logicalKeyID := uuid.New().String()
+	userID := uuid.New().String()
+	for version := uint32(1); version <= 2; version++ {
+		cryptoKeyMeta := &keys.CryptoKeyMeta{
+			ID:              uuid.New().String(),
+			KeyPairID:       uuid.New().String(),
+			Type:            "symmetric",
+			KeySize:         256,
+			Algorithm:       "AES",
+			DateTimeCreated: time.Now(),
+			UserID:          userID,
+			LogicalKeyID:    logicalKeyID,
+			Version:         version,
+		}
+		require.NoError(t, ctx.CryptoKeyRepo.Create(context.Background(), cryptoKeyMeta))
+	}
+	require.NoError(t, ctx.CryptoKeyRepo.Create(context.Background(), &keys.CryptoKeyMeta{
+		ID:              uuid.New().String(),
+		KeyPairID:       uuid.New().String(),
+		Type:            "symmetric",
+		KeySize:         256,
+		Algorithm:       "AES",
+		DateTimeCreated: time.Now(),
+		UserID:          userID,
+	}))
+
+	cryptoKeys, err := ctx.CryptoKeyRepo.List(context.Background(), &keys.CryptoKeyQuery{LogicalKeyID: logicalKeyID, SortBy: "version", SortOrder: "desc"})
+	require.NoError(t, err)
+	require.Len(t, cryptoKeys, 2)
+	assert.Equal(t, uint32(2), cryptoKeys[0].Version, "Newest version should be listed first")
+	assert.Equal(t, uint32(1), cryptoKeys[1].Version)
+
+	cryptoKeys, err = ctx.CryptoKeyRepo.List(context.Background(), &keys.CryptoKeyQuery{LogicalKeyID: logicalKeyID, Version: 1})
+	require.NoError(t, err)
+	require.Len(t, cryptoKeys, 1)
+	assert.Equal(t, uint32(1), cryptoKeys[0].Version)
+}
+
 func TestCryptoKeySqliteRepository_UpdateByID(t *testing.T) {
 	dbType := "sqlite"
 	ctx := SetupTestDB(t, dbType)
@@ -255,3 +328,112 @@ func TestCryptoKeySqliteRepository_List_WithFiltersAndSorting(t *testing.T) {
 	}
 	_ = invalidQuery.Validate // Assume your Validate handles column checking — if not, mock or skip
 }
+
+func TestCryptoKeySqliteRepository_ListDueForDestruction(t *testing.T) {
+	dbType := "sqlite"
+	ctx := SetupTestDB(t, dbType)
+	defer TeardownTestDB(t, ctx, dbType)
+
+	now := time.Now()
+	past := now.Add(-time.Hour)
+	future := now.Add(time.Hour)
+
+	newKey := func(state string, destruction *time.Time) *keys.CryptoKeyMeta {
+		cryptoKeyMeta := &keys.CryptoKeyMeta{
+			ID:                  uuid.NewString(),
+			KeyPairID:           uuid.NewString(),
+			Type:                "symmetric",
+			KeySize:             256,
+			Algorithm:           "AES",
+			DateTimeCreated:     now,
+			UserID:              uuid.NewString(),
+			State:               state,
+			DateTimeDestruction: destruction,
+		}
+		require.NoError(t, ctx.CryptoKeyRepo.Create(context.Background(), cryptoKeyMeta))
+		return cryptoKeyMeta
+	}
+
+	dueKey := newKey(keys.KeyStateActive, &past)
+	dueLegacyKey := newKey("", &past)
+	newKey(keys.KeyStateDestroyed, &past)
+	newKey(keys.KeyStateActive, &future)
+	newKey(keys.KeyStateActive, nil)
+
+	dueKeys, err := ctx.CryptoKeyRepo.ListDueForDestruction(context.Background(), now, 0)
+	require.NoError(t, err)
+	require.Len(t, dueKeys, 2)
+	assert.ElementsMatch(t, []string{dueKey.ID, dueLegacyKey.ID}, []string{dueKeys[0].ID, dueKeys[1].ID})
+
+	dueKeys, err = ctx.CryptoKeyRepo.ListDueForDestruction(context.Background(), now, 1)
+	require.NoError(t, err)
+	assert.Len(t, dueKeys, 1)
+}
+
+func TestCryptoKeySqliteRepository_ListBelowKEKVersion_UpdateKEKVersion(t *testing.T) {
+	dbType := "sqlite"
+	ctx := SetupTestDB(t, dbType)
+	defer TeardownTestDB(t, ctx, dbType)
+
+	now := time.Now()
+	newKey := func(kekVersion uint32, state, tokenLabel string, created time.Time) *keys.CryptoKeyMeta {
+		cryptoKeyMeta := &keys.CryptoKeyMeta{
+			ID:              uuid.NewString(),
+			KeyPairID:       uuid.NewString(),
+			Type:            "private",
+			KeySize:         256,
+			Algorithm:       "AES",
+			DateTimeCreated: created,
+			UserID:          uuid.NewString(),
+			State:           state,
+			KEKVersion:      kekVersion,
+		}
+		if tokenLabel != "" {
+			cryptoKeyMeta.Algorithm = "RSA"
+			cryptoKeyMeta.KeySize = 2048
+			cryptoKeyMeta.TokenLabel = tokenLabel
+			cryptoKeyMeta.TokenObjectLabel = cryptoKeyMeta.KeyPairID
+		}
+		require.NoError(t, ctx.CryptoKeyRepo.Create(context.Background(), cryptoKeyMeta))
+		return cryptoKeyMeta
+	}
+
+	laterKey := newKey(1, keys.KeyStateActive, "", now)
+	earlierKey := newKey(0, "", "", now.Add(-time.Minute))
+	newKey(2, keys.KeyStateActive, "", now)
+	newKey(1, keys.KeyStateDestroyed, "", now)
+	newKey(0, keys.KeyStateActive, "vault-token", now)
+
+	cryptoKeyMetas, err := ctx.CryptoKeyRepo.ListBelowKEKVersion(context.Background(), 2, 0)
+	require.NoError(t, err)
+	require.Len(t, cryptoKeyMetas, 2)
+	assert.Equal(t, earlierKey.ID, cryptoKeyMetas[0].ID, "Earliest created key expected first")
+	assert.Equal(t, laterKey.ID, cryptoKeyMetas[1].ID)
+
+	cryptoKeyMetas, err = ctx.CryptoKeyRepo.ListBelowKEKVersion(context.Background(), 2, 1)
+	require.NoError(t, err)
+	assert.Len(t, cryptoKeyMetas, 1)
+
+	// Concurrent updates of other fields are kept
+	laterKey.PlaintextExportForbidden = true
+	require.NoError(t, ctx.CryptoKeyRepo.UpdateByID(context.Background(), laterKey))
+
+	updated, err := ctx.CryptoKeyRepo.UpdateKEKVersion(context.Background(), laterKey.ID, 1, 2)
+	require.NoError(t, err)
+	assert.True(t, updated)
+
+	fetchedKey, err := ctx.CryptoKeyRepo.GetByID(context.Background(), laterKey.ID)
+	require.NoError(t, err)
+	assert.Equal(t, uint32(2), fetchedKey.KEKVersion)
+	assert.True(t, fetchedKey.PlaintextExportForbidden)
+
+	// Keys no longer wrapped with the expected version are left untouched
+	updated, err = ctx.CryptoKeyRepo.UpdateKEKVersion(context.Background(), laterKey.ID, 1, 3)
+	require.NoError(t, err)
+	assert.False(t, updated)
+
+	cryptoKeyMetas, err = ctx.CryptoKeyRepo.ListBelowKEKVersion(context.Background(), 2, 0)
+	require.NoError(t, err)
+	require.Len(t, cryptoKeyMetas, 1)
+	assert.Equal(t, earlierKey.ID, cryptoKeyMetas[0].ID)
+}
@@ -0,0 +1,76 @@
+//go:build integration
+// +build integration
+
+package repository
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"crypto_vault_service/internal/domain/keys"
+
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestKeyImportKeySqliteRepository_CreateAndGetFirst(t *testing.T) {
+	dbType := "sqlite"
+	ctx := SetupTestDB(t, dbType)
+	defer TeardownTestDB(t, ctx, dbType)
+
+	importKey, err := ctx.KeyImportKeyRepo.GetFirst(context.Background())
+	assert.NoError(t, err, "GetFirst should not return an error")
+	assert.Nil(t, importKey, "No import key expected")
+
+	firstImportKey := &keys.KeyImportKey{
+		ID:              uuid.New().String(),
+		KeyPairID:       uuid.New().String(),
+		KEKVersion:      1,
+		DateTimeCreated: time.Now().Add(-time.Minute),
+	}
+	laterImportKey := &keys.KeyImportKey{
+		ID:              uuid.New().String(),
+		KeyPairID:       uuid.New().String(),
+		KEKVersion:      1,
+		DateTimeCreated: time.Now(),
+	}
+	require.NoError(t, ctx.KeyImportKeyRepo.Create(context.Background(), laterImportKey))
+	require.NoError(t, ctx.KeyImportKeyRepo.Create(context.Background(), firstImportKey))
+
+	importKey, err = ctx.KeyImportKeyRepo.GetFirst(context.Background())
+	assert.NoError(t, err, "GetFirst should not return an error")
+	require.NotNil(t, importKey, "Import key expected")
+	assert.Equal(t, firstImportKey.ID, importKey.ID, "Earliest created import key expected")
+
+	err = ctx.KeyImportKeyRepo.Create(context.Background(), &keys.KeyImportKey{ID: uuid.New().String()})
+	assert.Error(t, err, "Create should return an error for an invalid import key")
+}
+
+func TestKeyImportKeySqliteRepository_ListAndUpdateByID(t *testing.T) {
+	dbType := "sqlite"
+	ctx := SetupTestDB(t, dbType)
+	defer TeardownTestDB(t, ctx, dbType)
+
+	importKey := &keys.KeyImportKey{
+		ID:              uuid.New().String(),
+		KeyPairID:       uuid.New().String(),
+		KEKVersion:      1,
+		DateTimeCreated: time.Now(),
+	}
+	require.NoError(t, ctx.KeyImportKeyRepo.Create(context.Background(), importKey))
+
+	importKey.KEKVersion = 2
+	err := ctx.KeyImportKeyRepo.UpdateByID(context.Background(), importKey)
+	assert.NoError(t, err, "UpdateByID should not return an error")
+
+	importKeys, err := ctx.KeyImportKeyRepo.List(context.Background())
+	assert.NoError(t, err, "List should not return an error")
+	require.Len(t, importKeys, 1, "One import key expected")
+	assert.Equal(t, uint32(2), importKeys[0].KEKVersion, "Updated KEK version expected")
+
+	importKey.KEKVersion = 0
+	err = ctx.KeyImportKeyRepo.UpdateByID(context.Background(), importKey)
+	assert.Error(t, err, "UpdateByID should return an error for an invalid import key")
+}
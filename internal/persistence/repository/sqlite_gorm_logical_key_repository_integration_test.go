@@ -0,0 +1,145 @@
+//go:build integration
+// +build integration
+
+package repository
+
+import (
+	"context"
+	"crypto_vault_service/internal/domain/keys"
+	"testing"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// newTestLogicalKey returns a LogicalKey with a single version
+func newTestLogicalKey() *keys.LogicalKey {
+	logicalKeyID := uuid.New().String()
+	return &keys.LogicalKey{
+		ID:               logicalKeyID,
+		UserID:           uuid.New().String(),
+		Algorithm:        "AES",
+		KeySize:          256,
+		PrimaryVersion:   1,
+		PrimaryKeyPairID: logicalKeyID,
+		LatestVersion:    1,
+		DateTimeCreated:  time.Now(),
+	}
+}
+
+func TestLogicalKeySqliteRepository_CreateAndGetByID(t *testing.T) {
+	dbType := "sqlite"
+	ctx := SetupTestDB(t, dbType)
+	defer TeardownTestDB(t, ctx, dbType)
+
+	logicalKey := newTestLogicalKey()
+
+	err := ctx.LogicalKeyRepo.Create(context.Background(), logicalKey)
+	assert.NoError(t, err, "Create should not return an error")
+
+	fetchedLogicalKey, err := ctx.LogicalKeyRepo.GetByID(context.Background(), logicalKey.ID)
+	assert.NoError(t, err, "GetByID should not return an error")
+	assert.Equal(t, logicalKey.ID, fetchedLogicalKey.ID, "ID should match")
+	assert.Equal(t, logicalKey.PrimaryKeyPairID, fetchedLogicalKey.PrimaryKeyPairID, "PrimaryKeyPairID should match")
+
+	_, err = ctx.LogicalKeyRepo.GetByID(context.Background(), uuid.New().String())
+	assert.Error(t, err, "GetByID should return an error for an unknown ID")
+
+	invalidLogicalKey := newTestLogicalKey()
+	invalidLogicalKey.RotationPeriod = time.Hour
+	err = ctx.LogicalKeyRepo.Create(context.Background(), invalidLogicalKey)
+	assert.Error(t, err, "Create should reject rotation periods shorter than a day")
+}
+
+func TestLogicalKeySqliteRepository_UpdateByID(t *testing.T) {
+	dbType := "sqlite"
+	ctx := SetupTestDB(t, dbType)
+	defer TeardownTestDB(t, ctx, dbType)
+
+	logicalKey := newTestLogicalKey()
+	require.NoError(t, ctx.LogicalKeyRepo.Create(context.Background(), logicalKey))
+
+	logicalKey.PrimaryVersion = 2
+	logicalKey.LatestVersion = 2
+	logicalKey.PrimaryKeyPairID = uuid.New().String()
+	logicalKey.RotationPeriod = 30 * 24 * time.Hour
+	err := ctx.LogicalKeyRepo.UpdateByID(context.Background(), logicalKey)
+	assert.NoError(t, err, "UpdateByID should not return an error")
+
+	fetchedLogicalKey, err := ctx.LogicalKeyRepo.GetByID(context.Background(), logicalKey.ID)
+	require.NoError(t, err)
+	assert.Equal(t, uint32(2), fetchedLogicalKey.PrimaryVersion, "PrimaryVersion should be updated")
+	assert.Equal(t, logicalKey.PrimaryKeyPairID, fetchedLogicalKey.PrimaryKeyPairID, "PrimaryKeyPairID should be updated")
+	assert.Equal(t, logicalKey.RotationPeriod, fetchedLogicalKey.RotationPeriod, "RotationPeriod should be updated")
+
+	logicalKey.LatestVersion = 1
+	err = ctx.LogicalKeyRepo.UpdateByID(context.Background(), logicalKey)
+	assert.Error(t, err, "UpdateByID should reject a primary version newer than the latest version")
+}
+
+func TestLogicalKeySqliteRepository_ReserveAndPromoteVersion(t *testing.T) {
+	dbType := "sqlite"
+	ctx := SetupTestDB(t, dbType)
+	defer TeardownTestDB(t, ctx, dbType)
+
+	logicalKey := newTestLogicalKey()
+	logicalKey.RotationPeriod = 24 * time.Hour
+	require.NoError(t, ctx.LogicalKeyRepo.Create(context.Background(), logicalKey))
+
+	nextRotation := time.Now().Add(24 * time.Hour)
+	err := ctx.LogicalKeyRepo.ReserveVersion(context.Background(), logicalKey.ID, 1, &nextRotation)
+	assert.NoError(t, err, "ReserveVersion should not return an error")
+
+	err = ctx.LogicalKeyRepo.ReserveVersion(context.Background(), logicalKey.ID, 1, &nextRotation)
+	assert.ErrorIs(t, err, keys.ErrConcurrentRotation, "ReserveVersion should refuse a version taken already")
+
+	require.NoError(t, ctx.LogicalKeyRepo.ReserveVersion(context.Background(), logicalKey.ID, 2, &nextRotation))
+
+	// Version 3 finishing before version 2 must not be demoted again
+	keyPairID := uuid.New().String()
+	require.NoError(t, ctx.LogicalKeyRepo.PromoteVersion(context.Background(), logicalKey.ID, 3, keyPairID, time.Now()))
+	require.NoError(t, ctx.LogicalKeyRepo.PromoteVersion(context.Background(), logicalKey.ID, 2, uuid.New().String(), time.Now()))
+
+	require.NoError(t, ctx.LogicalKeyRepo.UpdateRotationPolicy(context.Background(), logicalKey.ID, 48*time.Hour, nil))
+
+	fetchedLogicalKey, err := ctx.LogicalKeyRepo.GetByID(context.Background(), logicalKey.ID)
+	require.NoError(t, err)
+	assert.Equal(t, uint32(3), fetchedLogicalKey.LatestVersion, "LatestVersion should be reserved")
+	assert.Equal(t, uint32(3), fetchedLogicalKey.PrimaryVersion, "PrimaryVersion should be the newest promoted version")
+	assert.Equal(t, keyPairID, fetchedLogicalKey.PrimaryKeyPairID, "PrimaryKeyPairID should be the one of the newest promoted version")
+	assert.NotNil(t, fetchedLogicalKey.DateTimeRotated, "DateTimeRotated should be set")
+	assert.Equal(t, 48*time.Hour, fetchedLogicalKey.RotationPeriod, "RotationPeriod should be updated")
+	assert.Nil(t, fetchedLogicalKey.DateTimeNextRotation, "DateTimeNextRotation should be updated")
+
+	err = ctx.LogicalKeyRepo.UpdateRotationPolicy(context.Background(), uuid.New().String(), 0, nil)
+	assert.Error(t, err, "UpdateRotationPolicy should return an error for an unknown ID")
+}
+
+func TestLogicalKeySqliteRepository_ListDueForRotation(t *testing.T) {
+	dbType := "sqlite"
+	ctx := SetupTestDB(t, dbType)
+	defer TeardownTestDB(t, ctx, dbType)
+
+	now := time.Now()
+	past := now.Add(-time.Hour)
+	future := now.Add(time.Hour)
+
+	dueLogicalKey := newTestLogicalKey()
+	dueLogicalKey.RotationPeriod = 24 * time.Hour
+	dueLogicalKey.DateTimeNextRotation = &past
+	require.NoError(t, ctx.LogicalKeyRepo.Create(context.Background(), dueLogicalKey))
+
+	pendingLogicalKey := newTestLogicalKey()
+	pendingLogicalKey.RotationPeriod = 24 * time.Hour
+	pendingLogicalKey.DateTimeNextRotation = &future
+	require.NoError(t, ctx.LogicalKeyRepo.Create(context.Background(), pendingLogicalKey))
+
+	require.NoError(t, ctx.LogicalKeyRepo.Create(context.Background(), newTestLogicalKey()))
+
+	dueLogicalKeys, err := ctx.LogicalKeyRepo.ListDueForRotation(context.Background(), now, 10)
+	require.NoError(t, err, "ListDueForRotation should not return an error")
+	require.Len(t, dueLogicalKeys, 1, "Only the logical key whose rotation is due should be listed")
+	assert.Equal(t, dueLogicalKey.ID, dueLogicalKeys[0].ID)
+}
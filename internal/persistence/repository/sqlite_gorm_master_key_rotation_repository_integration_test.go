@@ -0,0 +1,105 @@
+//go:build integration
+// +build integration
+
+package repository
+
+import (
+	"context"
+	"crypto_vault_service/internal/domain/keys"
+	"testing"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestMasterKeyRotationSqliteRepository_CreateAndGetByID(t *testing.T) {
+	dbType := "sqlite"
+	ctx := SetupTestDB(t, dbType)
+	defer TeardownTestDB(t, ctx, dbType)
+
+	rotation := &keys.MasterKeyRotation{
+		ID:               uuid.New().String(),
+		TargetKEKVersion: 2,
+		Status:           "running",
+		DateTimeStarted:  time.Now(),
+		DateTimeUpdated:  time.Now(),
+	}
+
+	err := ctx.MasterKeyRotationRepo.Create(context.Background(), rotation)
+	assert.NoError(t, err, "Create should not return an error")
+
+	fetchedRotation, err := ctx.MasterKeyRotationRepo.GetByID(context.Background(), rotation.ID)
+	assert.NoError(t, err, "GetByID should not return an error")
+	assert.Equal(t, rotation.ID, fetchedRotation.ID, "ID should match")
+	assert.Equal(t, rotation.TargetKEKVersion, fetchedRotation.TargetKEKVersion, "TargetKEKVersion should match")
+
+	_, err = ctx.MasterKeyRotationRepo.GetByID(context.Background(), uuid.New().String())
+	assert.Error(t, err, "GetByID should return an error for an unknown ID")
+}
+
+func TestMasterKeyRotationSqliteRepository_GetUnfinished(t *testing.T) {
+	dbType := "sqlite"
+	ctx := SetupTestDB(t, dbType)
+	defer TeardownTestDB(t, ctx, dbType)
+
+	unfinishedRotation, err := ctx.MasterKeyRotationRepo.GetUnfinished(context.Background())
+	assert.NoError(t, err, "GetUnfinished should not return an error")
+	assert.Nil(t, unfinishedRotation, "No unfinished rotation expected")
+
+	rotation := &keys.MasterKeyRotation{
+		ID:               uuid.New().String(),
+		TargetKEKVersion: 2,
+		Status:           "running",
+		DateTimeStarted:  time.Now(),
+		DateTimeUpdated:  time.Now(),
+	}
+	require.NoError(t, ctx.MasterKeyRotationRepo.Create(context.Background(), rotation))
+
+	unfinishedRotation, err = ctx.MasterKeyRotationRepo.GetUnfinished(context.Background())
+	assert.NoError(t, err, "GetUnfinished should not return an error")
+	require.NotNil(t, unfinishedRotation, "Unfinished rotation expected")
+	assert.Equal(t, rotation.ID, unfinishedRotation.ID, "ID should match")
+
+	rotation.Status = "completed"
+	rotation.Offset = 10
+	rotation.RewrappedKeys = 10
+	err = ctx.MasterKeyRotationRepo.UpdateByID(context.Background(), rotation)
+	assert.NoError(t, err, "UpdateByID should not return an error")
+
+	unfinishedRotation, err = ctx.MasterKeyRotationRepo.GetUnfinished(context.Background())
+	assert.NoError(t, err, "GetUnfinished should not return an error")
+	assert.Nil(t, unfinishedRotation, "No unfinished rotation expected after completion")
+
+	// Failed rotations remain unfinished until a later rotation supersedes them
+	failedRotation := &keys.MasterKeyRotation{
+		ID:               uuid.New().String(),
+		TargetKEKVersion: 3,
+		Status:           "failed",
+		Offset:           5,
+		Error:            "failed to list keys",
+		DateTimeStarted:  time.Now().Add(time.Second),
+		DateTimeUpdated:  time.Now(),
+	}
+	require.NoError(t, ctx.MasterKeyRotationRepo.Create(context.Background(), failedRotation))
+
+	unfinishedRotation, err = ctx.MasterKeyRotationRepo.GetUnfinished(context.Background())
+	assert.NoError(t, err, "GetUnfinished should not return an error")
+	require.NotNil(t, unfinishedRotation, "Failed rotation expected")
+	assert.Equal(t, failedRotation.ID, unfinishedRotation.ID, "ID should match")
+	assert.Equal(t, 5, unfinishedRotation.Offset, "Offset should match")
+
+	supersedingRotation := &keys.MasterKeyRotation{
+		ID:               uuid.New().String(),
+		TargetKEKVersion: 4,
+		Status:           "completed",
+		DateTimeStarted:  time.Now().Add(2 * time.Second),
+		DateTimeUpdated:  time.Now(),
+	}
+	require.NoError(t, ctx.MasterKeyRotationRepo.Create(context.Background(), supersedingRotation))
+
+	unfinishedRotation, err = ctx.MasterKeyRotationRepo.GetUnfinished(context.Background())
+	assert.NoError(t, err, "GetUnfinished should not return an error")
+	assert.Nil(t, unfinishedRotation, "No unfinished rotation expected once superseded")
+}
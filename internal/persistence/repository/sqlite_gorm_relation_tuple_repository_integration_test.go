@@ -0,0 +1,106 @@
+//go:build integration
+// +build integration
+
+package repository
+
+import (
+	"context"
+	"crypto_vault_service/internal/domain/permissions"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestRelationTupleSqliteRepository_CreateAndList(t *testing.T) {
+	dbType := "sqlite"
+	ctx := SetupTestDB(t, dbType)
+	defer TeardownTestDB(t, ctx, dbType)
+
+	tuples := []*permissions.RelationTuple{
+		permissions.NewRelationTuple(permissions.User("alice"), permissions.RelationOwner, permissions.Blob("1")),
+		permissions.NewRelationTuple(permissions.User("bob"), permissions.RelationGrantee, permissions.Blob("1")),
+		permissions.NewRelationTuple(permissions.User("alice"), permissions.RelationOwner, permissions.Key("2")),
+	}
+
+	err := ctx.RelationTupleRepo.Create(context.Background(), tuples)
+	assert.NoError(t, err, "Create should not return an error")
+
+	// Writing existing tuples again is a no-op
+	err = ctx.RelationTupleRepo.Create(context.Background(), tuples[:1])
+	assert.NoError(t, err, "Create should ignore existing tuples")
+
+	fetchedTuples, err := ctx.RelationTupleRepo.List(context.Background(), &permissions.RelationTupleQuery{})
+	require.NoError(t, err, "List should not return an error")
+	assert.Len(t, fetchedTuples, 3, "All tuples should be listed")
+
+	fetchedTuples, err = ctx.RelationTupleRepo.List(context.Background(), &permissions.RelationTupleQuery{Object: permissions.Blob("1")})
+	require.NoError(t, err, "List should not return an error")
+	assert.Len(t, fetchedTuples, 2, "Tuples of the blob should be listed")
+
+	fetchedTuples, err = ctx.RelationTupleRepo.List(context.Background(), &permissions.RelationTupleQuery{
+		Object:   permissions.Blob("1"),
+		Relation: permissions.RelationGrantee,
+		User:     permissions.User("bob"),
+	})
+	require.NoError(t, err, "List should not return an error")
+	require.Len(t, fetchedTuples, 1, "Grantee tuple should be listed")
+	assert.Equal(t, permissions.User("bob"), fetchedTuples[0].User, "User should match")
+}
+
+func TestRelationTupleSqliteRepository_Create_WithExpiry(t *testing.T) {
+	dbType := "sqlite"
+	ctx := SetupTestDB(t, dbType)
+	defer TeardownTestDB(t, ctx, dbType)
+
+	expires := time.Now().Add(time.Hour)
+	tuple := permissions.NewRelationTuple(permissions.User("bob"), permissions.RelationViewer, permissions.Blob("1"))
+	tuple.DateTimeExpires = &expires
+	require.NoError(t, ctx.RelationTupleRepo.Create(context.Background(), []*permissions.RelationTuple{tuple}))
+
+	fetchedTuples, err := ctx.RelationTupleRepo.List(context.Background(), &permissions.RelationTupleQuery{Object: permissions.Blob("1")})
+	require.NoError(t, err, "List should not return an error")
+	require.Len(t, fetchedTuples, 1, "Viewer tuple should be listed")
+	require.NotNil(t, fetchedTuples[0].DateTimeExpires, "Expiry should be stored")
+	assert.True(t, expires.Equal(*fetchedTuples[0].DateTimeExpires), "Expiry should match")
+
+	// Writing an existing tuple again updates its expiry
+	tuple.DateTimeExpires = nil
+	require.NoError(t, ctx.RelationTupleRepo.Create(context.Background(), []*permissions.RelationTuple{tuple}))
+
+	fetchedTuples, err = ctx.RelationTupleRepo.List(context.Background(), &permissions.RelationTupleQuery{Object: permissions.Blob("1")})
+	require.NoError(t, err, "List should not return an error")
+	require.Len(t, fetchedTuples, 1, "Viewer tuple should be listed once")
+	assert.Nil(t, fetchedTuples[0].DateTimeExpires, "Expiry should be updated")
+}
+
+func TestRelationTupleSqliteRepository_Create_Invalid(t *testing.T) {
+	dbType := "sqlite"
+	ctx := SetupTestDB(t, dbType)
+	defer TeardownTestDB(t, ctx, dbType)
+
+	err := ctx.RelationTupleRepo.Create(context.Background(), []*permissions.RelationTuple{
+		permissions.NewRelationTuple("alice", permissions.RelationOwner, permissions.Blob("1")),
+	})
+	assert.Error(t, err, "Create should reject a user without type")
+}
+
+func TestRelationTupleSqliteRepository_Delete(t *testing.T) {
+	dbType := "sqlite"
+	ctx := SetupTestDB(t, dbType)
+	defer TeardownTestDB(t, ctx, dbType)
+
+	ownerTuple := permissions.NewRelationTuple(permissions.User("alice"), permissions.RelationOwner, permissions.Blob("1"))
+	granteeTuple := permissions.NewRelationTuple(permissions.User("bob"), permissions.RelationGrantee, permissions.Blob("1"))
+	require.NoError(t, ctx.RelationTupleRepo.Create(context.Background(), []*permissions.RelationTuple{ownerTuple, granteeTuple}))
+
+	unknownTuple := permissions.NewRelationTuple(permissions.User("carol"), permissions.RelationGrantee, permissions.Blob("1"))
+	err := ctx.RelationTupleRepo.Delete(context.Background(), []*permissions.RelationTuple{granteeTuple, unknownTuple})
+	assert.NoError(t, err, "Delete should not return an error")
+
+	fetchedTuples, err := ctx.RelationTupleRepo.List(context.Background(), &permissions.RelationTupleQuery{Object: permissions.Blob("1")})
+	require.NoError(t, err, "List should not return an error")
+	require.Len(t, fetchedTuples, 1, "Only the owner tuple should remain")
+	assert.Equal(t, permissions.RelationOwner, fetchedTuples[0].Relation, "Relation should match")
+}
@@ -3,6 +3,7 @@ package repository
 import (
 	"crypto_vault_service/internal/domain/blobs"
 	"crypto_vault_service/internal/domain/keys"
+	"crypto_vault_service/internal/domain/permissions"
 	"crypto_vault_service/internal/infrastructure/logger"
 	"crypto_vault_service/internal/infrastructure/settings"
 	"fmt"
@@ -18,9 +19,15 @@ import (
 
 // TestDBContext is a mockable context holding database and repository references for testing
 type TestDBContext struct {
-	DB            *gorm.DB
-	BlobRepo      blobs.BlobRepository
-	CryptoKeyRepo keys.CryptoKeyRepository
+	DB                     *gorm.DB
+	BlobRepo               blobs.BlobRepository
+	CryptoKeyRepo          keys.CryptoKeyRepository
+	MasterKeyRotationRepo  keys.MasterKeyRotationRepository
+	LogicalKeyRepo         keys.LogicalKeyRepository
+	KeyImportKeyRepo       keys.KeyImportKeyRepository
+	RelationTupleRepo      permissions.RelationTupleRepository
+	BlobLinkSigningKeyRepo blobs.BlobLinkSigningKeyRepository
+	BlobLinkRevocationRepo blobs.BlobLinkRevocationRepository
 }
 
 // SetupTestDB initializes the test database and repositories based on the DB_TYPE environment variable
@@ -67,12 +74,19 @@ func SetupTestDB(t *testing.T, dbType string) *TestDBContext {
 			t.Fatalf("Failed to connect to SQLite: %v", err)
 		}
 
+		// Every connection to ':memory:' opens a separate database, hence background work must share a single connection
+		sqlDB, err := db.DB()
+		if err != nil {
+			t.Fatalf("Failed to get raw DB connection: %v", err)
+		}
+		sqlDB.SetMaxOpenConns(1)
+
 	default:
 		t.Fatalf("Unsupported DB_TYPE value: %s", dbType)
 	}
 
-	// Migrate the schema for Blob and CryptoKey
-	err = db.AutoMigrate(&blobs.BlobMeta{}, &keys.CryptoKeyMeta{})
+	// Migrate the schema for Blob, CryptoKey, MasterKeyRotation, LogicalKey, KeyImportKey, RelationTuple and the blob link records
+	err = db.AutoMigrate(&blobs.BlobMeta{}, &keys.CryptoKeyMeta{}, &keys.MasterKeyRotation{}, &keys.LogicalKey{}, &keys.KeyImportKey{}, &permissions.RelationTuple{}, &blobs.BlobLinkSigningKey{}, &blobs.BlobLinkRevocation{})
 	if err != nil {
 		t.Fatalf("Failed to migrate schema: %v", err)
 	}
@@ -97,11 +111,41 @@ func SetupTestDB(t *testing.T, dbType string) *TestDBContext {
 	if err != nil {
 		log.Fatalf("Error creating crypto key repository instance: %v", err)
 	}
+	masterKeyRotationRepo, err := NewGormMasterKeyRotationRepository(db, logger)
+	if err != nil {
+		log.Fatalf("Error creating master key rotation repository instance: %v", err)
+	}
+	logicalKeyRepo, err := NewGormLogicalKeyRepository(db, logger)
+	if err != nil {
+		log.Fatalf("Error creating logical key repository instance: %v", err)
+	}
+	keyImportKeyRepo, err := NewGormKeyImportKeyRepository(db, logger)
+	if err != nil {
+		log.Fatalf("Error creating key import key repository instance: %v", err)
+	}
+	relationTupleRepo, err := NewGormRelationTupleRepository(db, logger)
+	if err != nil {
+		log.Fatalf("Error creating relation tuple repository instance: %v", err)
+	}
+	blobLinkSigningKeyRepo, err := NewGormBlobLinkSigningKeyRepository(db, logger)
+	if err != nil {
+		log.Fatalf("Error creating blob link signing key repository instance: %v", err)
+	}
+	blobLinkRevocationRepo, err := NewGormBlobLinkRevocationRepository(db, logger)
+	if err != nil {
+		log.Fatalf("Error creating blob link revocation repository instance: %v", err)
+	}
 
 	return &TestDBContext{
-		DB:            db,
-		BlobRepo:      blobRepo,
-		CryptoKeyRepo: cryptoKeyRepo,
+		DB:                     db,
+		BlobRepo:               blobRepo,
+		CryptoKeyRepo:          cryptoKeyRepo,
+		MasterKeyRotationRepo:  masterKeyRotationRepo,
+		LogicalKeyRepo:         logicalKeyRepo,
+		KeyImportKeyRepo:       keyImportKeyRepo,
+		RelationTupleRepo:      relationTupleRepo,
+		BlobLinkSigningKeyRepo: blobLinkSigningKeyRepo,
+		BlobLinkRevocationRepo: blobLinkRevocationRepo,
 	}
 }
 